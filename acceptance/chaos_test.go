@@ -80,9 +80,10 @@ func TestChaos(t *testing.T) {
 					// These originate from DistSender when, for example, the
 					// leader is down. With more realistic retry options, we
 					// should probably not see them.
-					if _, ok := err.(*roachpb.SendError); ok {
+					switch err.(type) {
+					case *roachpb.SendError, *roachpb.AmbiguousResultError:
 						log.Warning(err)
-					} else {
+					default:
 						errs <- err
 						clients[i].RUnlock()
 						return
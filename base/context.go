@@ -126,6 +126,29 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 	return ctx.clientTLSConfig, nil
 }
 
+// ReloadClientTLSConfig reloads the client TLS config from the Certs
+// directory, so that rotated client certificates are used by the
+// connections established afterwards; established connections are not
+// affected. On error, the previously loaded config remains in use.
+func (ctx *Context) ReloadClientTLSConfig() error {
+	if ctx.Insecure || ctx.Certs == "" {
+		return nil
+	}
+	cfg, err := security.LoadClientTLSConfig(ctx.Certs, ctx.User)
+	if err != nil {
+		return util.Errorf("error reloading client TLS config: %s", err)
+	}
+	ctx.tlsConfigMu.Lock()
+	ctx.clientTLSConfig = cfg
+	ctx.tlsConfigMu.Unlock()
+
+	// The http client is recreated with the new config on next use.
+	ctx.httpClientMu.Lock()
+	ctx.httpClient = nil
+	ctx.httpClientMu.Unlock()
+	return nil
+}
+
 // GetServerTLSConfig returns the context server TLS config, initializing it if needed.
 // If Insecure is true, return a nil config, otherwise load a config based
 // on the Certs directory. Fails if Insecure=false and Certs="".
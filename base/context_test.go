@@ -108,3 +108,33 @@ func TestServerSSLSettings(t *testing.T) {
 		}
 	}
 }
+
+func TestReloadClientTLSConfig(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := &base.Context{Certs: security.EmbeddedCertsDir, User: security.NodeUser}
+	orig, err := ctx.GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReloadClientTLSConfig(); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := ctx.GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded == orig {
+		t.Errorf("expected a newly loaded client TLS config")
+	}
+
+	// A failed reload keeps the previous config.
+	ctx.Certs = "/dev/null"
+	if err := ctx.ReloadClientTLSConfig(); err == nil {
+		t.Fatal("expected reload from invalid certs directory to fail")
+	}
+	if cfg, err := ctx.GetClientTLSConfig(); err != nil {
+		t.Fatal(err)
+	} else if cfg != reloaded {
+		t.Errorf("expected previous client TLS config to remain in use")
+	}
+}
@@ -24,10 +24,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/server"
 )
 
 var maxResults int64
+var valueType string
 
 // pflagValue wraps flag.Value and implements the extra methods of the
 // pflag.Value interface.
@@ -124,6 +126,81 @@ var flagUsage = map[string]string{
 		Adjusts the timeout for stores.  If there's been no gossiped updated
 		from a store after this time, the store is considered unavailable.
         Replicas on an unavailable store will be moved to available ones.
+`,
+	"replica-gc-delay": `
+        The time for which the data of a replica removed from its range is
+        kept on its store, so that an erroneous removal can be undone
+        through the /_admin/pendinggc endpoint. Zero destroys the data
+        immediately.
+`,
+	"max-replicas-per-store": `
+        The maximum number of replicas each store of the node holds. A store
+        at the limit refuses new replicas, which are placed on other stores
+        instead. Zero means no limit.
+`,
+	"drain-timeout": `
+        The maximum amount of time the server waits for in-flight requests to
+        complete when shutting down.
+`,
+	"max-sql-sessions": `
+        The maximum number of SQL sessions which can concurrently execute
+        statements on this node. Zero means no limit.
+`,
+	"sql-idle-timeout": `
+        The duration after which the open transaction of an idle SQL session
+        is aborted, releasing its intents, unless the session has been
+        continued through another node. Zero disables the timeout.
+`,
+	"sql-session-memory-limit": `
+        The amount of memory in bytes which may be used by a request of a SQL
+        session to buffer its results and to sort, group and join rows.
+        Statements exceeding the limit fail. Zero means no limit.
+`,
+	"sql-statement-timeout": `
+        The maximum execution time of a SQL statement, after which the
+        statement fails and its KV requests are abandoned. Zero means no
+        limit.
+`,
+	"sql-slow-query-threshold": `
+        The execution time above which SQL statements are recorded in the slow
+        query log, along with their fingerprint, latency and the number of KV
+        batches, rows and retries they took. Zero disables the log.
+`,
+	"sql-row-ttl-interval": `
+        The interval at which the expired rows of the tables with a row TTL
+        are deleted. Zero disables the deletion of expired rows.
+`,
+	"sql-slow-query-log": `
+        The file to which the slow query log is appended. If empty, slow
+        statements are logged as warnings.
+`,
+	"memory-budget": `
+        The amount of memory in bytes which may be used by the KV requests and
+        SQL results in flight on this node. Requests beyond the budget are
+        queued for a short time and then rejected. Zero disables the budget.
+`,
+	"max-command-size": `
+        The maximum size in bytes of a replicated command. Larger batches are
+        refused and must be split by the client. Zero means the default of
+        64 MB; negative values disable the limit.
+`,
+	"capacity-alert-threshold": `
+        The fraction of a store's disk capacity in use above which the store
+        is considered nearly full. Nearly full stores receive no new replicas
+        and refuse writes of new data to non-system ranges. Zero disables the
+        check.
+`,
+	"queue-dry-run": `
+        Makes the replica queues log the splits, replication changes and
+        garbage collection they would perform instead of performing them,
+        for example to preview the effect of new zone configs.
+`,
+	"verify-integrity": `
+        Verifies the checksums of the stores' data and the consistency of the
+        raft state of each replica at startup. A store whose data is corrupt
+        refuses to start; replicas with inconsistent raft state are
+        quarantined and left untouched for inspection. Reads all of the data
+        and can thus take a long time for large stores.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
@@ -138,6 +215,9 @@ var flagUsage = map[string]string{
 `,
 	"max-results": `
         Define the maximum number of results that will be retrieved.
+`,
+	"value-type": `
+        The type of the values to put: bytes, int, float or time (RFC 3339).
 `,
 	"allow-rebalancing": `
         Enables this server to rebalance replicas to other stores on the cluster.
@@ -192,9 +272,26 @@ func initFlags(ctx *server.Context) {
 
 		// Engine flags.
 		f.Int64Var(&ctx.CacheSize, "cache-size", ctx.CacheSize, flagUsage["cache-size"])
+		f.Int64Var(&ctx.MemoryBudget, "memory-budget", ctx.MemoryBudget, flagUsage["memory-budget"])
+		f.Int64Var(&ctx.MaxCommandSize, "max-command-size", ctx.MaxCommandSize, flagUsage["max-command-size"])
+		f.Float64Var(&ctx.CapacityAlertThreshold, "capacity-alert-threshold", ctx.CapacityAlertThreshold, flagUsage["capacity-alert-threshold"])
+		f.BoolVar(&ctx.QueueDryRun, "queue-dry-run", ctx.QueueDryRun, flagUsage["queue-dry-run"])
+		f.BoolVar(&ctx.VerifyIntegrity, "verify-integrity", ctx.VerifyIntegrity, flagUsage["verify-integrity"])
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime, flagUsage["scan-max-idle-time"])
 		f.DurationVar(&ctx.TimeUntilStoreDead, "time-until-store-dead", ctx.TimeUntilStoreDead, flagUsage["time-until-store-dead"])
+		f.DurationVar(&ctx.ReplicaGCDelay, "replica-gc-delay", ctx.ReplicaGCDelay, flagUsage["replica-gc-delay"])
+		f.IntVar(&ctx.MaxReplicasPerStore, "max-replicas-per-store", ctx.MaxReplicasPerStore, flagUsage["max-replicas-per-store"])
+		f.DurationVar(&ctx.DrainTimeout, "drain-timeout", ctx.DrainTimeout, flagUsage["drain-timeout"])
+
+		// SQL flags.
+		f.IntVar(&ctx.MaxSQLSessions, "max-sql-sessions", ctx.MaxSQLSessions, flagUsage["max-sql-sessions"])
+		f.DurationVar(&ctx.SQLIdleTimeout, "sql-idle-timeout", ctx.SQLIdleTimeout, flagUsage["sql-idle-timeout"])
+		f.Int64Var(&ctx.SQLSessionMemoryLimit, "sql-session-memory-limit", ctx.SQLSessionMemoryLimit, flagUsage["sql-session-memory-limit"])
+		f.DurationVar(&ctx.SQLStatementTimeout, "sql-statement-timeout", ctx.SQLStatementTimeout, flagUsage["sql-statement-timeout"])
+		f.DurationVar(&ctx.SQLSlowQueryThreshold, "sql-slow-query-threshold", ctx.SQLSlowQueryThreshold, flagUsage["sql-slow-query-threshold"])
+		f.StringVar(&ctx.SQLSlowQueryLog, "sql-slow-query-log", ctx.SQLSlowQueryLog, flagUsage["sql-slow-query-log"])
+		f.DurationVar(&ctx.SQLRowTTLInterval, "sql-row-ttl-interval", ctx.SQLRowTTLInterval, flagUsage["sql-row-ttl-interval"])
 
 		if err := startCmd.MarkFlagRequired("gossip"); err != nil {
 			panic(err)
@@ -243,6 +340,12 @@ func initFlags(ctx *server.Context) {
 		f := cmd.Flags()
 		f.Int64Var(&maxResults, "max-results", 1000, flagUsage["max-results"])
 	}
+
+	// Value type flag for put and conditional put.
+	for _, cmd := range []*cobra.Command{putCmd, cPutCmd} {
+		f := cmd.Flags()
+		f.StringVar(&valueType, "value-type", client.ValueTypeBytes, flagUsage["value-type"])
+	}
 }
 
 func init() {
@@ -18,7 +18,6 @@
 package cli
 
 import (
-	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
@@ -37,12 +36,12 @@ func makeDBClient() (*client.DB, *stop.Stopper) {
 
 	// TODO(marc): KV endpoints are now restricted to node users.
 	// This should probably be made more explicit.
-	db, err := client.Open(stopper, fmt.Sprintf(
-		"%s://%s@%s?certs=%s",
-		context.RPCRequestScheme(),
-		security.NodeUser,
-		context.Addr,
-		context.Certs))
+	db, err := client.OpenWith(stopper, client.ConnConfig{
+		Addrs:    []string{context.Addr},
+		User:     security.NodeUser,
+		Insecure: context.Insecure,
+		Certs:    context.Certs,
+	})
 	if err != nil {
 		fmt.Fprintf(osStderr, "failed to initialize KV client: %s\n", err)
 		osExit(1)
@@ -65,6 +64,17 @@ func unquoteArg(arg string, disallowSystem bool) string {
 	return s
 }
 
+// parseValueArg unquotes the provided argument and parses it as a
+// value of the type specified by the --value-type flag.
+func parseValueArg(arg string) interface{} {
+	v, err := client.ParseValue(valueType, unquoteArg(arg, false))
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+	}
+	return v
+}
+
 // A getCmd gets the value for the specified key.
 var getCmd = &cobra.Command{
 	Use:   "get [options] <key>",
@@ -104,7 +114,8 @@ var putCmd = &cobra.Command{
 	Short: "sets the value for one or more keys",
 	Long: `
 Sets the value for one or more keys. Keys and values must be provided
-in pairs on the command line.
+in pairs on the command line. The values are interpreted according to
+--value-type.
 `,
 	Run: runPut,
 }
@@ -119,7 +130,7 @@ func runPut(cmd *cobra.Command, args []string) {
 	for i := 0; i < len(args); i += 2 {
 		b.Put(
 			unquoteArg(args[i], true /* disallow system keys */),
-			unquoteArg(args[i+1], false),
+			parseValueArg(args[i+1]),
 		)
 	}
 
@@ -141,6 +152,7 @@ var cPutCmd = &cobra.Command{
 Conditionally sets a value for a key if the existing value is equal
 to expValue. To conditionally set a value only if there is no existing entry
 pass nil for expValue. The expValue defaults to 1 if not specified.
+The values are interpreted according to --value-type.
 `,
 	Run: runCPut,
 }
@@ -155,10 +167,10 @@ func runCPut(cmd *cobra.Command, args []string) {
 	defer stopper.Stop()
 
 	key := unquoteArg(args[0], true /* disallow system keys */)
-	value := unquoteArg(args[1], false)
+	value := parseValueArg(args[1])
 	var err error
 	if len(args) == 3 {
-		err = kvDB.CPut(key, value, unquoteArg(args[2], false))
+		err = kvDB.CPut(key, value, parseValueArg(args[2]))
 	} else {
 		err = kvDB.CPut(key, value, nil)
 	}
@@ -354,14 +366,7 @@ func initScanArgs(args []string) (startKey, endKey roachpb.Key) {
 
 func showResult(rows []client.KeyValue) {
 	for _, row := range rows {
-		if bytes.HasPrefix(row.Key, []byte{0}) {
-			// TODO(pmattis): Pretty-print system keys.
-			fmt.Printf("%s\n", row.Key)
-			continue
-		}
-
-		key := roachpb.Key(row.Key)
-		fmt.Printf("%s\t%s\n", key, row.PrettyValue())
+		fmt.Printf("%s\t%s\n", row.PrettyKey(), row.PrettyValue())
 	}
 	fmt.Printf("%d result(s)\n", len(rows))
 }
@@ -44,37 +44,28 @@ func runLsRanges(cmd *cobra.Command, args []string) {
 	}
 
 	var startKey roachpb.Key
-	{
-		k := roachpb.KeyMin.Next()
-		if len(args) > 0 {
-			k = roachpb.Key(args[0])
-		}
-		startKey = keys.RangeMetaKey(keys.Addr(k))
+	if len(args) > 0 {
+		startKey = roachpb.Key(unquoteArg(args[0], false))
 	}
-	endKey := keys.Meta2Prefix.PrefixEnd()
 
 	kvDB, stopper := makeDBClient()
 	defer stopper.Stop()
-	rows, err := kvDB.Scan(startKey, endKey, maxResults)
+	descs, err := kvDB.ScanRangeDescriptors(startKey, maxResults)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "scan failed: %s\n", err)
 		osExit(1)
 		return
 	}
 
-	for _, row := range rows {
-		desc := &roachpb.RangeDescriptor{}
-		if err := row.ValueProto(desc); err != nil {
-			fmt.Fprintf(os.Stderr, "%s: unable to unmarshal range descriptor\n", row.Key)
-			continue
-		}
-		fmt.Printf("%s-%s [%d]\n", desc.StartKey, desc.EndKey, desc.RangeID)
+	for _, desc := range descs {
+		fmt.Printf("%s-%s [%d]\n", keys.PrettyPrint(roachpb.Key(desc.StartKey)),
+			keys.PrettyPrint(roachpb.Key(desc.EndKey)), desc.RangeID)
 		for i, replica := range desc.Replicas {
 			fmt.Printf("\t%d: node-id=%d store-id=%d\n",
 				i, replica.NodeID, replica.StoreID)
 		}
 	}
-	fmt.Printf("%d result(s)\n", len(rows))
+	fmt.Printf("%d result(s)\n", len(descs))
 }
 
 // A splitRangeCmd command splits a range.
@@ -74,15 +74,15 @@ OK
 		t.Fatal(err)
 	}
 
-	expectedCols := []string{"Field", "Type", "Null", "Default"}
+	expectedCols := []string{"Field", "Type", "Null", "Default", "Comment"}
 	if !reflect.DeepEqual(expectedCols, cols) {
 		t.Fatalf("expected:\n%v\ngot:\n%v", expectedCols, cols)
 	}
 
 	expectedRows := [][]string{
-		{`parentID`, `INT`, `true`, `NULL`},
-		{`name`, `STRING`, `true`, `NULL`},
-		{`id`, `INT`, `true`, `NULL`},
+		{`parentID`, `INT`, `true`, `NULL`, `NULL`},
+		{`name`, `STRING`, `true`, `NULL`, `NULL`},
+		{`id`, `INT`, `true`, `NULL`, `NULL`},
 	}
 	if !reflect.DeepEqual(expectedRows, rows) {
 		t.Fatalf("expected:\n%v\ngot:\n%v", expectedRows, rows)
@@ -93,13 +93,13 @@ OK
 	}
 
 	expected = `
-+----------+--------+------+---------+
-|  Field   |  Type  | Null | Default |
-+----------+--------+------+---------+
-| parentID | INT    | true | NULL    |
-| name     | STRING | true | NULL    |
-| id       | INT    | true | NULL    |
-+----------+--------+------+---------+
++----------+--------+------+---------+---------+
+|  Field   |  Type  | Null | Default | Comment |
++----------+--------+------+---------+---------+
+| parentID | INT    | true | NULL    | NULL    |
+| name     | STRING | true | NULL    | NULL    |
+| id       | INT    | true | NULL    | NULL    |
++----------+--------+------+---------+---------+
 `
 
 	if a, e := b.String(), expected[1:]; a != e {
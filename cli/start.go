@@ -76,7 +76,7 @@ func initCluster(stopper *stop.Stopper) {
 
 	// Generate a new UUID for cluster ID and bootstrap the cluster.
 	clusterID := uuid.NewUUID4().String()
-	if _, err := server.BootstrapCluster(clusterID, context.Engines, stopper); err != nil {
+	if _, err := server.BootstrapCluster(clusterID, context.Engines, server.InitialSplitKeys, stopper); err != nil {
 		log.Errorf("unable to bootstrap cluster: %s", err)
 		return
 	}
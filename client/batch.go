@@ -21,14 +21,25 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/gogo/protobuf/proto"
 )
 
+// BatchTooLargeError is returned when a batch exceeds the maximum batch
+// size configured for its DB (see DB.SetMaxBatchSize). None of the
+// batch's operations have been sent.
+type BatchTooLargeError struct {
+	Size, MaxSize int64
+}
+
+// Error implements error.
+func (e *BatchTooLargeError) Error() string {
+	return fmt.Sprintf("batch of approximately %d bytes exceeds maximum size of %d bytes",
+		e.Size, e.MaxSize)
+}
+
 // Batch provides for the parallel execution of a number of database
 // operations. Operations are added to the Batch and then the Batch is executed
 // via either DB.Run, Txn.Run or Txn.Commit.
-//
-// TODO(pmattis): Allow a timestamp to be specified which is applied to all
-// operations within the batch.
 type Batch struct {
 	// The DB the batch is associated with. This field may be nil if the batch
 	// was not created via DB.NewBatch or Txn.NewBatch.
@@ -48,14 +59,58 @@ type Batch struct {
 	resultsBuf [8]Result
 	rowsBuf    [8]KeyValue
 	rowsIdx    int
+	// header holds the header fields determined by the read options of
+	// the batch's reads; see setReadOptions.
+	header    roachpb.Header
+	hasHeader bool
 }
 
-func (b *Batch) prepare() error {
+// prepare returns the first error encountered while constructing the
+// batch or, if maxSize is positive and the batch is larger, a
+// BatchTooLargeError. Finally, the batch's requests are checked against
+// the invariants of roachpb.BatchRequest.Validate.
+func (b *Batch) prepare(maxSize int64) error {
 	for _, r := range b.Results {
 		if err := r.Err; err != nil {
 			return err
 		}
 	}
+	if maxSize > 0 {
+		if size := b.ApproximateSize(); size > maxSize {
+			return &BatchTooLargeError{Size: size, MaxSize: maxSize}
+		}
+	}
+	ba := roachpb.BatchRequest{}
+	ba.Add(b.reqs...)
+	return ba.Validate()
+}
+
+// ApproximateSize returns the approximate size in bytes of the
+// operations queued up in the batch, as encoded on the wire. Mutations
+// can use it to split their writes across several batches in order to
+// keep the raft commands they result in reasonably sized.
+func (b *Batch) ApproximateSize() int64 {
+	var size int64
+	for _, args := range b.reqs {
+		size += int64(proto.Size(args))
+	}
+	return size
+}
+
+// setReadOptions records the header fields determined by the options of a
+// read. The header applies to the whole batch, so it is an error for the
+// reads of a batch to use different consistencies or timestamps.
+func (b *Batch) setReadOptions(o readOptions) error {
+	var h roachpb.Header
+	o.fillHeader(&h)
+	if !b.hasHeader {
+		b.header = h
+		b.hasHeader = true
+		return nil
+	}
+	if h.ReadConsistency != b.header.ReadConsistency || !h.Timestamp.Equal(b.header.Timestamp) {
+		return errConflictingReadOptions
+	}
 	return nil
 }
 
@@ -76,6 +131,20 @@ func (b *Batch) initResult(calls, numRows int, err error) {
 	b.Results = append(b.Results, r)
 }
 
+// readResults returns the results of the batch's read-only operations in
+// the order in which they were added to the batch.
+func (b *Batch) readResults() []Result {
+	var reads []Result
+	offset := 0
+	for _, r := range b.Results {
+		if r.calls > 0 && roachpb.IsReadOnly(b.reqs[offset]) {
+			reads = append(reads, r)
+		}
+		offset += r.calls
+	}
+	return reads
+}
+
 func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) error {
 	offset := 0
 	for i := range b.Results {
@@ -161,6 +230,10 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) erro
 			case *roachpb.EndTransactionRequest:
 			case *roachpb.AdminMergeRequest:
 			case *roachpb.AdminSplitRequest:
+			case *roachpb.AdminScatterRequest:
+			case *roachpb.AdminSetQueueExclusionRequest:
+			case *roachpb.IngestRequest:
+			case *roachpb.ExportRequest:
 			case *roachpb.HeartbeatTxnRequest:
 			case *roachpb.GCRequest:
 			case *roachpb.PushTxnRequest:
@@ -215,8 +288,18 @@ func (b *Batch) InternalAddRequest(reqs ...roachpb.Request) {
 //   r, err := db.Get("a")
 //   // string(r.Rows[0].Key) == "a"
 //
-// key can be either a byte slice or a string.
-func (b *Batch) Get(key interface{}) {
+// key can be either a byte slice or a string. The options must not include
+// KeyOnly.
+func (b *Batch) Get(key interface{}, opts ...ReadOption) {
+	o := makeReadOptions(opts)
+	if o.keyOnly {
+		b.initResult(0, 1, errKeyOnlyGet)
+		return
+	}
+	if err := b.setReadOptions(o); err != nil {
+		b.initResult(0, 1, err)
+		return
+	}
 	k, err := marshalKey(key)
 	if err != nil {
 		b.initResult(0, 1, err)
@@ -295,7 +378,12 @@ func (b *Batch) Inc(key interface{}, value int64) {
 	b.initResult(1, 1, nil)
 }
 
-func (b *Batch) scan(s, e interface{}, maxRows int64, isReverse bool) {
+func (b *Batch) scan(s, e interface{}, maxRows int64, isReverse bool, opts []ReadOption) {
+	o := makeReadOptions(opts)
+	if err := b.setReadOptions(o); err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
 	begin, err := marshalKey(s)
 	if err != nil {
 		b.initResult(0, 0, err)
@@ -307,9 +395,13 @@ func (b *Batch) scan(s, e interface{}, maxRows int64, isReverse bool) {
 		return
 	}
 	if !isReverse {
-		b.reqs = append(b.reqs, roachpb.NewScan(roachpb.Key(begin), roachpb.Key(end), maxRows))
+		req := roachpb.NewScan(roachpb.Key(begin), roachpb.Key(end), maxRows).(*roachpb.ScanRequest)
+		req.KeyOnly = o.keyOnly
+		b.reqs = append(b.reqs, req)
 	} else {
-		b.reqs = append(b.reqs, roachpb.NewReverseScan(roachpb.Key(begin), roachpb.Key(end), maxRows))
+		req := roachpb.NewReverseScan(roachpb.Key(begin), roachpb.Key(end), maxRows).(*roachpb.ReverseScanRequest)
+		req.KeyOnly = o.keyOnly
+		b.reqs = append(b.reqs, req)
 	}
 	b.initResult(1, 0, nil)
 }
@@ -321,8 +413,18 @@ func (b *Batch) scan(s, e interface{}, maxRows int64, isReverse bool) {
 // rows and Result.Err will indicate success or failure.
 //
 // key can be either a byte slice or a string.
-func (b *Batch) Scan(s, e interface{}, maxRows int64) {
-	b.scan(s, e, maxRows, false)
+func (b *Batch) Scan(s, e interface{}, maxRows int64, opts ...ReadOption) {
+	b.scan(s, e, maxRows, false, opts)
+}
+
+// ScanKeys is like Scan with the KeyOnly option: the values of the
+// returned rows are omitted (apart from their timestamps). It is useful
+// for existence checks and for counting keys without transferring their
+// values.
+//
+// key can be either a byte slice or a string.
+func (b *Batch) ScanKeys(s, e interface{}, maxRows int64) {
+	b.Scan(s, e, maxRows, KeyOnly())
 }
 
 // ReverseScan retrieves the rows between begin (inclusive) and end (exclusive)
@@ -332,8 +434,49 @@ func (b *Batch) Scan(s, e interface{}, maxRows int64) {
 // rows and Result.Err will indicate success or failure.
 //
 // key can be either a byte slice or a string.
-func (b *Batch) ReverseScan(s, e interface{}, maxRows int64) {
-	b.scan(s, e, maxRows, true)
+func (b *Batch) ReverseScan(s, e interface{}, maxRows int64, opts ...ReadOption) {
+	b.scan(s, e, maxRows, true, opts)
+}
+
+// ReverseScanKeys is like ReverseScan with the KeyOnly option: the values
+// of the returned rows are omitted (apart from their timestamps).
+//
+// key can be either a byte slice or a string.
+func (b *Batch) ReverseScanKeys(s, e interface{}, maxRows int64) {
+	b.ReverseScan(s, e, maxRows, KeyOnly())
+}
+
+// Export retrieves the rows between begin (inclusive) and end (exclusive)
+// which changed after startTime, along with the keys deleted since then, as
+// of the timestamp of the read (see AsOf). A zero startTime exports all the
+// rows. The rows and keys are returned in the ExportResponse of the batch's
+// response rather than in the result, which holds no rows.
+//
+// key can be either a byte slice or a string.
+func (b *Batch) Export(s, e interface{}, maxRows int64, startTime roachpb.Timestamp, opts ...ReadOption) {
+	if err := b.setReadOptions(makeReadOptions(opts)); err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	begin, err := marshalKey(s)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	end, err := marshalKey(e)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	b.reqs = append(b.reqs, &roachpb.ExportRequest{
+		Span: roachpb.Span{
+			Key:    roachpb.Key(begin),
+			EndKey: roachpb.Key(end),
+		},
+		MaxResults: maxRows,
+		StartTime:  startTime,
+	})
+	b.initResult(1, 0, nil)
 }
 
 // Del deletes one or more keys.
@@ -394,6 +537,42 @@ func (b *Batch) adminMerge(key interface{}) {
 	b.initResult(1, 0, nil)
 }
 
+// adminScatter is only exported on DB. It is here for symmetry with the
+// other operations.
+func (b *Batch) adminScatter(key interface{}) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.AdminScatterRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
+
+// adminSetQueueExclusion is only exported on DB. It is here for symmetry
+// with the other operations.
+func (b *Batch) adminSetQueueExclusion(key interface{}, queue string, excluded bool) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.AdminSetQueueExclusionRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+		Queue:    queue,
+		Excluded: excluded,
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
+
 // adminSplit is only exported on DB. It is here for symmetry with the
 // other operations.
 func (b *Batch) adminSplit(splitKey interface{}) {
@@ -38,6 +38,7 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -354,6 +355,113 @@ func TestClientGetAndPut(t *testing.T) {
 	}
 }
 
+// TestClientScanRangeDescriptors verifies that range descriptors can be
+// listed starting at the range containing an arbitrary key.
+func TestClientScanRangeDescriptors(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := createTestClient(t, s.Stopper(), s.ServingAddr())
+
+	for _, splitKey := range []string{"b", "d"} {
+		if err := db.AdminSplit(splitKey); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, startKey := range []string{"b", "c"} {
+		descs, err := db.ScanRangeDescriptors(roachpb.Key(startKey), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(descs) != 1 {
+			t.Fatalf("%s: expected 1 descriptor, got %d", startKey, len(descs))
+		}
+		if !descs[0].StartKey.Equal(roachpb.RKey("b")) || !descs[0].EndKey.Equal(roachpb.RKey("d")) {
+			t.Errorf("%s: expected range [b, d), got [%s, %s)",
+				startKey, descs[0].StartKey, descs[0].EndKey)
+		}
+	}
+
+	descs, err := db.ScanRangeDescriptors(nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(descs) < 3 {
+		t.Fatalf("expected at least 3 descriptors, got %d", len(descs))
+	}
+	if !descs[0].StartKey.Equal(roachpb.RKeyMin) {
+		t.Errorf("expected first range to start at KeyMin, got %s", descs[0].StartKey)
+	}
+	if last := descs[len(descs)-1]; !last.EndKey.Equal(roachpb.RKeyMax) {
+		t.Errorf("expected last range to end at KeyMax, got %s", last.EndKey)
+	}
+}
+
+// TestClientPutParsedValues verifies that values parsed with ParseValue
+// round-trip with their type intact.
+func TestClientPutParsedValues(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := createTestClient(t, s.Stopper(), s.ServingAddr())
+
+	testCases := []struct {
+		typ, value, expected string
+	}{
+		{"", "foo", `"foo"`},
+		{client.ValueTypeBytes, "42", `"42"`},
+		{client.ValueTypeInt, "42", "42"},
+		{client.ValueTypeInt, "-0x10", "-16"},
+		{client.ValueTypeFloat, "1.5", "1.5"},
+	}
+	for i, test := range testCases {
+		v, err := client.ParseValue(test.typ, test.value)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		key := fmt.Sprintf("key-%d", i)
+		if err := db.Put(key, v); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		kv, err := db.Get(key)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if s := kv.PrettyValue(); s != test.expected {
+			t.Errorf("%d: expected %s, got %s", i, test.expected, s)
+		}
+	}
+
+	v, err := client.ParseValue(client.ValueTypeTime, "2015-10-01T12:00:00.5Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put("time", v); err != nil {
+		t.Fatal(err)
+	}
+	kv, err := db.Get("time")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts, err := kv.Value.GetTime(); err != nil {
+		t.Fatal(err)
+	} else if expected := time.Date(2015, 10, 1, 12, 0, 0, 5e8, time.UTC); !ts.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, ts)
+	}
+
+	for _, test := range []struct{ typ, value string }{
+		{client.ValueTypeInt, "foo"},
+		{client.ValueTypeFloat, "foo"},
+		{client.ValueTypeTime, "yesterday"},
+		{"decimal", "1"},
+	} {
+		if _, err := client.ParseValue(test.typ, test.value); err == nil {
+			t.Errorf("%s %q: expected error", test.typ, test.value)
+		}
+	}
+}
+
 // TestClientEmptyValues verifies that empty values are preserved
 // for both empty []byte and integer=0. This used to fail when we
 // allowed the protobufs to be gob-encoded using the default go rpc
@@ -386,6 +494,111 @@ func TestClientEmptyValues(t *testing.T) {
 	}
 }
 
+// TestClientScanKeys verifies that key-only scans return all keys in the
+// requested order without their values.
+func TestClientScanKeys(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := createTestClient(t, s.Stopper(), s.ServingAddr())
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := db.Put(testUser+"/"+key, []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		scan   func(begin, end interface{}, maxRows int64) ([]client.KeyValue, error)
+		expect []string
+	}{
+		{db.ScanKeys, []string{"a", "b", "c"}},
+		{db.ReverseScanKeys, []string{"c", "b", "a"}},
+	} {
+		rows, err := test.scan(testUser+"/a", testUser+"/d", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != len(test.expect) {
+			t.Fatalf("expected %d rows; got %d", len(test.expect), len(rows))
+		}
+		for i, row := range rows {
+			if key := testUser + "/" + test.expect[i]; string(row.Key) != key {
+				t.Errorf("%d: expected key %q; got %q", i, key, row.Key)
+			}
+			if !row.Exists() {
+				t.Errorf("%d: expected row to exist", i)
+			}
+			if b := row.Value.RawBytes; b != nil {
+				t.Errorf("%d: expected no value; got %q", i, b)
+			}
+		}
+	}
+}
+
+// TestClientReadOptions verifies that the options of reads are applied to
+// their batches and that conflicting or unsupported options are rejected.
+func TestClientReadOptions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := createTestClient(t, s.Stopper(), s.ServingAddr())
+
+	keyA, keyB := testUser+"/a", testUser+"/b"
+	if err := db.Put(keyA, "1"); err != nil {
+		t.Fatal(err)
+	}
+	r, err := db.Get(keyA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := *r.Value.Timestamp
+	if err := db.Put(keyA, "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A historical read returns the value as of its timestamp.
+	if r, err := db.Get(keyA, client.AsOf(ts)); err != nil {
+		t.Fatal(err)
+	} else if v := string(r.ValueBytes()); v != "1" {
+		t.Errorf("expected historical value %q; got %q", "1", v)
+	}
+
+	// An inconsistent read returns the latest value.
+	if r, err := db.Get(keyA, client.Inconsistent()); err != nil {
+		t.Fatal(err)
+	} else if v := string(r.ValueBytes()); v != "2" {
+		t.Errorf("expected value %q; got %q", "2", v)
+	}
+
+	// Options combine.
+	rows, err := db.Scan(keyA, keyB, 0, client.Inconsistent(), client.KeyOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Value.RawBytes != nil {
+		t.Errorf("expected a single key-only row; got %v", rows)
+	}
+
+	if _, err := db.Get(keyA, client.KeyOnly()); !testutils.IsError(err, "key-only reads are only supported by scans") {
+		t.Errorf("expected key-only get to fail; got %v", err)
+	}
+
+	b := db.NewBatch()
+	b.Get(keyA)
+	b.Scan(keyA, keyB, 0, client.Inconsistent())
+	if err := db.Run(b); !testutils.IsError(err, "the reads of a batch must use the same consistency and timestamp") {
+		t.Errorf("expected conflicting read options to fail; got %v", err)
+	}
+
+	if err := db.Txn(func(txn *client.Txn) error {
+		_, err := txn.Get(keyA, client.AsOf(ts))
+		return err
+	}); !testutils.IsError(err, "inconsistent and historical reads are not supported in a transaction") {
+		t.Errorf("expected historical read in transaction to fail; got %v", err)
+	}
+}
+
 // TestClientBatch runs a batch of increment calls and then verifies the
 // results.
 // TODO(tschottdorf): some assertions disabled, see #1891.
@@ -535,6 +748,69 @@ func TestClientBatch(t *testing.T) {
 	}
 }
 
+// TestClientCommitInBatchWithReads verifies that the reads of a batch
+// committed with CommitInBatchWithReads observe the transaction's earlier
+// writes but not the batch's own, and that their results are returned in
+// the order in which they were added.
+func TestClientCommitInBatchWithReads(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db := createTestClient(t, s.Stopper(), s.ServingAddr())
+
+	keyA := testUser + "/a"
+	keyB := testUser + "/b"
+	keyC := testUser + "/c"
+	if err := db.Put(keyA, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	var reads []client.Result
+	if err := db.Txn(func(txn *client.Txn) error {
+		if err := txn.Put(keyB, "2"); err != nil {
+			return err
+		}
+		b := &client.Batch{}
+		b.Put(keyA, "3")
+		b.Get(keyA)
+		b.Put(keyC, "4")
+		b.Get(keyB)
+		b.Get(keyC)
+		b.Scan(keyA, testUser+"/d", 0)
+		var err error
+		reads, err = txn.CommitInBatchWithReads(b)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reads) != 4 {
+		t.Fatalf("expected 4 read results; got %d", len(reads))
+	}
+	if v := reads[0].Rows[0].ValueBytes(); string(v) != "1" {
+		t.Errorf("expected the read of %q to observe the value preceding the batch; got %q", keyA, v)
+	}
+	if v := reads[1].Rows[0].ValueBytes(); string(v) != "2" {
+		t.Errorf("expected the read of %q to observe the transaction's earlier write; got %q", keyB, v)
+	}
+	if reads[2].Rows[0].Exists() {
+		t.Errorf("expected the read of %q not to observe the batch's write; got %q", keyC, reads[2].Rows[0].ValueBytes())
+	}
+	if rows := reads[3].Rows; len(rows) != 2 || string(rows[0].ValueBytes()) != "1" || string(rows[1].ValueBytes()) != "2" {
+		t.Errorf("expected the scan to observe the state preceding the batch; got %v", rows)
+	}
+
+	for key, expected := range map[string]string{keyA: "3", keyB: "2", keyC: "4"} {
+		kv, err := db.Get(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := kv.ValueBytes(); string(v) != expected {
+			t.Errorf("expected %q to be %q after the commit; got %q", key, expected, v)
+		}
+	}
+}
+
 // concurrentIncrements starts two Goroutines in parallel, both of which
 // read the integers stored at the other's key and add it onto their own.
 // It is checked that the outcome is serializable, i.e. exactly one of the
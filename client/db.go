@@ -28,7 +28,9 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
@@ -52,6 +54,12 @@ func (kv *KeyValue) Exists() bool {
 	return kv.Value != nil
 }
 
+// PrettyKey returns a human-readable version of the key, with the
+// well-known prefixes of the system keyspace decoded.
+func (kv *KeyValue) PrettyKey() string {
+	return keys.PrettyPrint(kv.Key)
+}
+
 // PrettyValue returns a human-readable version of the value as a string.
 func (kv *KeyValue) PrettyValue() string {
 	if kv.Value == nil {
@@ -82,6 +90,25 @@ func (kv *KeyValue) PrettyValue() string {
 			return fmt.Sprintf("%v", err)
 		}
 		return fmt.Sprintf("%s", v)
+	case roachpb.ValueType_ARRAY:
+		elems, err := kv.Value.GetArray()
+		if err != nil {
+			return fmt.Sprintf("%v", err)
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := range elems {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			elem := KeyValue{}
+			if elems[i].Tag != roachpb.ValueType_UNKNOWN {
+				elem.Value = &elems[i]
+			}
+			buf.WriteString(elem.PrettyValue())
+		}
+		buf.WriteByte(']')
+		return buf.String()
 	}
 	return fmt.Sprintf("%q", kv.Value.RawBytes)
 }
@@ -173,6 +200,16 @@ type DB struct {
 	// ignored.
 	userPriority    int32
 	txnRetryOptions retry.Options
+	// maxBatchSize, if positive, is the maximum approximate size in
+	// bytes of a batch run through this DB or its transactions.
+	maxBatchSize int64
+	// metrics, if set, records the latency and outcome of operations.
+	metrics Metrics
+	// ctx, if set, is the context on behalf of which the DB sends
+	// requests. See WithContext.
+	ctx context.Context
+	// verifyChecksums, if set, verifies the checksums of the values read.
+	verifyChecksums bool
 }
 
 // GetSender returns the underlying Sender. Only exported for tests.
@@ -195,73 +232,236 @@ func NewDBWithPriority(sender Sender, userPriority int32) *DB {
 	return db
 }
 
-// TODO(pmattis): Allow setting the sender/txn retry options.
+// WithContext returns a copy of the DB sending its requests, and those of
+// the transactions created from it, on behalf of the given context. The
+// requests are tagged with the context's trace tags (see WithTraceTag).
+func (db *DB) WithContext(ctx context.Context) *DB {
+	dbCopy := *db
+	dbCopy.ctx = ctx
+	return &dbCopy
+}
 
-// Open creates a new database handle to the cockroach cluster specified by
-// addr. The cluster is identified by a URL with the format:
-//
-//   [<sender>:]//[<user>@]<host>:<port>[?certs=<dir>,priority=<val>]
-//
-// The URL scheme (<sender>) specifies which transport to use for talking to
-// the cockroach cluster. Currently allowable values are: http, https, rpc,
-// rpcs. The rpc and rpcs senders use a variant of Go's builtin rpc library for
-// communication with the cluster. This protocol is lower overhead and more
-// efficient than http. The decision between the encrypted (https, rpcs) and
-// unencrypted senders (http, rpc) depends on the settings of the cluster. A
-// given cluster supports either encrypted or unencrypted traffic, but not
-// both.
-//
-// If not specified, the <user> field defaults to "root".
-//
-// The certs parameter can be used to override the default directory to use for
-// client certificates. In tests, the directory "test_certs" uses the embedded
-// test certificates.
-//
-// The priority parameter can be used to override the default priority for
-// operations.
-func Open(stopper *stop.Stopper, addr string) (*DB, error) {
-	u, err := url.Parse(addr)
-	if err != nil {
-		return nil, err
+// context returns the context on behalf of which the DB sends requests.
+func (db *DB) context() context.Context {
+	if db.ctx == nil {
+		return context.TODO()
+	}
+	return db.ctx
+}
+
+// SetMaxBatchSize sets the maximum approximate size in bytes (see
+// Batch.ApproximateSize) of the batches run through the DB and the
+// transactions created from it afterwards. Running a larger batch
+// returns a BatchTooLargeError without sending it. A size of zero
+// disables the limit.
+func (db *DB) SetMaxBatchSize(size int64) {
+	db.maxBatchSize = size
+}
+
+// SetVerifyChecksums sets whether the DB and the transactions created
+// from it afterwards verify the checksums of the values they read. The
+// checksums are computed by the client writing a value and verified by the
+// replica before the value is written, so that verifying them on read
+// detects corruption introduced anywhere between the writing and the
+// reading client. A read returning a corrupt value fails.
+func (db *DB) SetVerifyChecksums(verify bool) {
+	db.verifyChecksums = verify
+}
+
+// SetMetrics sets the Metrics to which the DB and the transactions
+// created from it afterwards report their operations. A nil Metrics
+// disables the reporting.
+func (db *DB) SetMetrics(metrics Metrics) {
+	db.metrics = metrics
+}
+
+// recordOperation reports an operation which started at the given time
+// and returned err to the DB's metrics, if any.
+func (db *DB) recordOperation(op string, start time.Time, err error) {
+	if db.metrics != nil {
+		db.metrics.RecordOperation(op, time.Since(start), err)
+	}
+}
+
+// ReloadCertificates reloads the client certificates of the DB's sender
+// from its certs directory. Connections established afterwards use the
+// reloaded certificates, so that certificates can be rotated without
+// reopening long-lived DB handles. An error is returned if the sender
+// does not support reloading certificates.
+func (db *DB) ReloadCertificates() error {
+	r, ok := db.sender.(certificateReloader)
+	if !ok {
+		return util.Errorf("sender %T does not support reloading certificates", db.sender)
+	}
+	return r.ReloadCertificates()
+}
+
+// ConnConfig configures a database handle opened with OpenWith.
+type ConnConfig struct {
+	// Addrs are the host:port addresses of the nodes to connect to. They
+	// are tried in order and the first one for which a sender can be
+	// created is used.
+	Addrs []string
+	// Transport is the scheme of the registered sender to use, e.g. "rpc"
+	// or "rpcs". If empty, it defaults to "rpcs", or to "rpc" if Insecure
+	// is set.
+	Transport string
+	// User is the user to connect as. If empty, it defaults to "root".
+	User string
+	// Insecure disables TLS when Transport is not set.
+	Insecure bool
+	// Certs is the directory to load the client certificates from. If
+	// empty, it defaults to "certs". In tests, the directory "test_certs"
+	// uses the embedded test certificates.
+	Certs string
+	// ReloadCertsInterval, if positive, is the interval at which the
+	// client certificates are reloaded from Certs; see ReloadCertificates.
+	ReloadCertsInterval time.Duration
+	// RetryOptions are the options for retrying connection I/O errors. If
+	// nil, the default options are used.
+	RetryOptions *retry.Options
+	// FailFast, if set, disables retrying connection I/O errors. It
+	// overrides RetryOptions.MaxRetries.
+	FailFast bool
+	// UserPriority is the default priority of operations.
+	UserPriority int32
+	// VerifyChecksums enables the verification of the checksums of the
+	// values read; see DB.SetVerifyChecksums.
+	VerifyChecksums bool
+}
+
+// OpenWith creates a new database handle to the cockroach cluster
+// described by the given config.
+func OpenWith(stopper *stop.Stopper, cfg ConnConfig) (*DB, error) {
+	if len(cfg.Addrs) == 0 {
+		return nil, util.Errorf("no addresses specified")
+	}
+	if cfg.ReloadCertsInterval < 0 {
+		return nil, util.Errorf("invalid certificate reload interval %s", cfg.ReloadCertsInterval)
 	}
 	ctx := &base.Context{}
 	ctx.InitDefaults()
-	if u.User != nil {
-		ctx.User = u.User.Username()
+	if cfg.User != "" {
+		ctx.User = cfg.User
 	}
-
-	q := u.Query()
-	if dir := q["certs"]; len(dir) > 0 {
-		ctx.Certs = dir[0]
+	if cfg.Certs != "" {
+		ctx.Certs = cfg.Certs
+	}
+	ctx.Insecure = cfg.Insecure
+	transport := cfg.Transport
+	if transport == "" {
+		transport = ctx.RPCRequestScheme()
 	}
 
 	retryOpts := defaultRetryOptions
-	if failFast := q["failfast"]; len(failFast) > 0 {
+	if cfg.RetryOptions != nil {
+		retryOpts = *cfg.RetryOptions
+	}
+	if cfg.FailFast {
 		retryOpts.MaxRetries = 1
 	}
 
-	sender, err := newSender(u, ctx, retryOpts, stopper)
+	var sender Sender
+	var err error
+	for _, addr := range cfg.Addrs {
+		u := &url.URL{Scheme: transport, Host: addr}
+		if sender, err = newSender(u, ctx, retryOpts, stopper); err == nil {
+			break
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 	if sender == nil {
-		return nil, fmt.Errorf("\"%s\" no sender specified", addr)
+		return nil, fmt.Errorf("%q no sender specified", transport)
 	}
 
 	db := &DB{
 		sender:          sender,
+		userPriority:    cfg.UserPriority,
 		txnRetryOptions: DefaultTxnRetryOptions,
+		verifyChecksums: cfg.VerifyChecksums,
+	}
+
+	if interval := cfg.ReloadCertsInterval; interval > 0 {
+		stopper.RunWorker(func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if err := db.ReloadCertificates(); err != nil {
+						log.Warningf("failed to reload client certificates: %s", err)
+					}
+				case <-stopper.ShouldStop():
+					return
+				}
+			}
+		})
 	}
 
+	return db, nil
+}
+
+// Open creates a new database handle to the cockroach cluster specified by
+// addr. The cluster is identified by a URL with the format:
+//
+//   [<sender>:]//[<user>@]<host>:<port>[?certs=<dir>,priority=<val>,reload_certs=<interval>,failfast=<any>,verify_checksums=<any>]
+//
+// The URL scheme (<sender>) specifies which transport to use for talking to
+// the cockroach cluster. Currently allowable values are: rpc and rpcs. The
+// senders use a variant of Go's builtin rpc library for communication with
+// the cluster. The decision between the encrypted (rpcs) and unencrypted
+// (rpc) senders depends on the settings of the cluster. A given cluster
+// supports either encrypted or unencrypted traffic, but not both.
+//
+// The URL form is a shorthand for OpenWith, and the user and parameters
+// correspond to the fields of ConnConfig: the certs parameter sets Certs,
+// the priority parameter sets UserPriority, the reload_certs parameter
+// (e.g. "1h") sets ReloadCertsInterval, and the presence of the failfast
+// and verify_checksums parameters sets FailFast and VerifyChecksums.
+func Open(stopper *stop.Stopper, addr string) (*DB, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ConnConfig{
+		Addrs:     []string{u.Host},
+		Transport: u.Scheme,
+	}
+	if u.User != nil {
+		cfg.User = u.User.Username()
+	}
+
+	q := u.Query()
+	if dir := q["certs"]; len(dir) > 0 {
+		cfg.Certs = dir[0]
+	}
+	if failFast := q["failfast"]; len(failFast) > 0 {
+		cfg.FailFast = true
+	}
+	if verify := q["verify_checksums"]; len(verify) > 0 {
+		cfg.VerifyChecksums = true
+	}
 	if priority := q["priority"]; len(priority) > 0 {
 		p, err := strconv.Atoi(priority[0])
 		if err != nil {
 			return nil, err
 		}
-		db.userPriority = int32(p)
+		cfg.UserPriority = int32(p)
+	}
+	if reload := q["reload_certs"]; len(reload) > 0 {
+		interval, err := time.ParseDuration(reload[0])
+		if err != nil {
+			return nil, err
+		}
+		if interval <= 0 {
+			return nil, util.Errorf("invalid certificate reload interval %s", interval)
+		}
+		cfg.ReloadCertsInterval = interval
 	}
 
-	return db, nil
+	return OpenWith(stopper, cfg)
 }
 
 // NewBatch creates and returns a new empty batch object for use with the DB.
@@ -276,19 +476,23 @@ func (db *DB) NewBatch() *Batch {
 //   r, err := db.Get("a")
 //   // string(r.Key) == "a"
 //
-// key can be either a byte slice or a string.
-func (db *DB) Get(key interface{}) (KeyValue, error) {
+// key can be either a byte slice or a string. The options must not include
+// KeyOnly.
+func (db *DB) Get(key interface{}, opts ...ReadOption) (KeyValue, error) {
+	start := time.Now()
 	b := db.NewBatch()
-	b.Get(key)
-	return runOneRow(db, b)
+	b.Get(key, opts...)
+	r, err := runOneRow(db, b)
+	db.recordOperation(OpGet, start, err)
+	return r, err
 }
 
 // GetProto retrieves the value for a key and decodes the result as a proto
 // message.
 //
 // key can be either a byte slice or a string.
-func (db *DB) GetProto(key interface{}, msg proto.Message) error {
-	r, err := db.Get(key)
+func (db *DB) GetProto(key interface{}, msg proto.Message, opts ...ReadOption) error {
+	r, err := db.Get(key, opts...)
 	if err != nil {
 		return err
 	}
@@ -300,9 +504,11 @@ func (db *DB) GetProto(key interface{}, msg proto.Message) error {
 // key can be either a byte slice or a string. value can be any key type, a
 // proto.Message or any Go primitive type (bool, int, etc).
 func (db *DB) Put(key, value interface{}) error {
+	start := time.Now()
 	b := db.NewBatch()
 	b.Put(key, value)
 	_, err := runOneResult(db, b)
+	db.recordOperation(OpPut, start, err)
 	return err
 }
 
@@ -313,9 +519,11 @@ func (db *DB) Put(key, value interface{}) error {
 // key can be either a byte slice or a string. value can be any key type, a
 // proto.Message or any Go primitive type (bool, int, etc).
 func (db *DB) CPut(key, value, expValue interface{}) error {
+	start := time.Now()
 	b := db.NewBatch()
 	b.CPut(key, value, expValue)
 	_, err := runOneResult(db, b)
+	db.recordOperation(OpCPut, start, err)
 	return err
 }
 
@@ -325,19 +533,20 @@ func (db *DB) CPut(key, value, expValue interface{}) error {
 //
 // key can be either a byte slice or a string.
 func (db *DB) Inc(key interface{}, value int64) (KeyValue, error) {
+	start := time.Now()
 	b := db.NewBatch()
 	b.Inc(key, value)
-	return runOneRow(db, b)
+	r, err := runOneRow(db, b)
+	db.recordOperation(OpInc, start, err)
+	return r, err
 }
 
-func (db *DB) scan(begin, end interface{}, maxRows int64, isReverse bool) ([]KeyValue, error) {
+func (db *DB) scan(begin, end interface{}, maxRows int64, isReverse bool, opts []ReadOption) ([]KeyValue, error) {
+	start := time.Now()
 	b := db.NewBatch()
-	if !isReverse {
-		b.Scan(begin, end, maxRows)
-	} else {
-		b.ReverseScan(begin, end, maxRows)
-	}
+	b.scan(begin, end, maxRows, isReverse, opts)
 	r, err := runOneResult(db, b)
+	db.recordOperation(OpScan, start, err)
 	return r.Rows, err
 }
 
@@ -347,8 +556,16 @@ func (db *DB) scan(begin, end interface{}, maxRows int64, isReverse bool) ([]Key
 // The returned []KeyValue will contain up to maxRows elements.
 //
 // key can be either a byte slice or a string.
-func (db *DB) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
-	return db.scan(begin, end, maxRows, false)
+func (db *DB) Scan(begin, end interface{}, maxRows int64, opts ...ReadOption) ([]KeyValue, error) {
+	return db.scan(begin, end, maxRows, false, opts)
+}
+
+// ScanKeys is like Scan with the KeyOnly option: the values of the
+// returned rows are omitted (apart from their timestamps).
+//
+// key can be either a byte slice or a string.
+func (db *DB) ScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	return db.Scan(begin, end, maxRows, KeyOnly())
 }
 
 // ReverseScan retrieves the rows between begin (inclusive) and end (exclusive)
@@ -357,17 +574,27 @@ func (db *DB) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
 // The returned []KeyValue will contain up to maxRows elements.
 //
 // key can be either a byte slice or a string.
-func (db *DB) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
-	return db.scan(begin, end, maxRows, true)
+func (db *DB) ReverseScan(begin, end interface{}, maxRows int64, opts ...ReadOption) ([]KeyValue, error) {
+	return db.scan(begin, end, maxRows, true, opts)
+}
+
+// ReverseScanKeys is like ReverseScan with the KeyOnly option: the values
+// of the returned rows are omitted (apart from their timestamps).
+//
+// key can be either a byte slice or a string.
+func (db *DB) ReverseScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	return db.ReverseScan(begin, end, maxRows, KeyOnly())
 }
 
 // Del deletes one or more keys.
 //
 // key can be either a byte slice or a string.
 func (db *DB) Del(keys ...interface{}) error {
+	start := time.Now()
 	b := db.NewBatch()
 	b.Del(keys...)
 	_, err := runOneResult(db, b)
+	db.recordOperation(OpDel, start, err)
 	return err
 }
 
@@ -377,9 +604,11 @@ func (db *DB) Del(keys ...interface{}) error {
 //
 // key can be either a byte slice or a string.
 func (db *DB) DelRange(begin, end interface{}) error {
+	start := time.Now()
 	b := db.NewBatch()
 	b.DelRange(begin, end)
 	_, err := runOneResult(db, b)
+	db.recordOperation(OpDelRange, start, err)
 	return err
 }
 
@@ -396,6 +625,33 @@ func (db *DB) AdminMerge(key interface{}) error {
 	return err
 }
 
+// AdminScatter adds a replica of the range containing key on a randomly
+// chosen store which doesn't already hold one. The replicate queue later
+// removes a replica to restore the configured replication, so repeated
+// scatters spread the replicas of a set of ranges across the cluster.
+//
+// key can be either a byte slice or a string.
+func (db *DB) AdminScatter(key interface{}) error {
+	b := db.NewBatch()
+	b.adminScatter(key)
+	_, err := runOneResult(db, b)
+	return err
+}
+
+// AdminSetQueueExclusion excludes the range containing key from the named
+// replica queue (e.g. "gc"), or lifts such an exclusion. The exclusion is
+// persisted with the range and respected by the stores holding its
+// replicas until it is lifted; a split of the range carries it over to
+// both sides.
+//
+// key can be either a byte slice or a string.
+func (db *DB) AdminSetQueueExclusion(key interface{}, queue string, excluded bool) error {
+	b := db.NewBatch()
+	b.adminSetQueueExclusion(key, queue, excluded)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // AdminSplit splits the range at splitkey.
 //
 // key can be either a byte slice or a string.
@@ -409,13 +665,13 @@ func (db *DB) AdminSplit(splitKey interface{}) error {
 // sendAndFill is a helper which sends the given batch and fills its results,
 // returning the appropriate error which is either from the first failing call,
 // or an "internal" error.
-func sendAndFill(send func(...roachpb.Request) (*roachpb.BatchResponse, *roachpb.Error), b *Batch) (*roachpb.BatchResponse, error) {
+func sendAndFill(send func(roachpb.Header, ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.Error), b *Batch) (*roachpb.BatchResponse, error) {
 	// Errors here will be attached to the results, so we will get them from
 	// the call to fillResults in the regular case in which an individual call
 	// fails. But send() also returns its own errors, so there's some dancing
 	// here to do because we want to run fillResults() so that the individual
 	// result gets initialized with an error from the corresponding call.
-	br, pErr := send(b.reqs...)
+	br, pErr := send(b.header, b.reqs...)
 	if pErr != nil {
 		_ = b.fillResults(nil, pErr)
 		return nil, pErr.GoError()
@@ -440,16 +696,18 @@ func sendAndFill(send func(...roachpb.Request) (*roachpb.BatchResponse, *roachpb
 // operation. The order of the results matches the order the operations were
 // added to the batch.
 func (db *DB) Run(b *Batch) error {
+	start := time.Now()
 	_, err := db.RunWithResponse(b)
+	db.recordOperation(OpBatch, start, err)
 	return err
 }
 
 // RunWithResponse is a version of Run that returns the BatchResponse.
 func (db *DB) RunWithResponse(b *Batch) (*roachpb.BatchResponse, error) {
-	if err := b.prepare(); err != nil {
+	if err := b.prepare(db.maxBatchSize); err != nil {
 		return nil, err
 	}
-	return sendAndFill(db.send, b)
+	return sendAndFill(db.sendWithHeader, b)
 }
 
 // Txn executes retryable in the context of a distributed transaction. The
@@ -460,35 +718,127 @@ func (db *DB) RunWithResponse(b *Batch) (*roachpb.BatchResponse, error) {
 //
 // TODO(pmattis): Allow transaction options to be specified.
 func (db *DB) Txn(retryable func(txn *Txn) error) error {
+	start := time.Now()
 	txn := NewTxn(*db)
 	txn.SetDebugName("", 1)
-	return txn.exec(retryable)
+	err := txn.exec(retryable)
+	db.recordOperation(OpTxn, start, err)
+	return err
+}
+
+// DefaultIdempotentRetryOptions are the retry options used by
+// RunIdempotent.
+// This is exported for testing purposes only.
+var DefaultIdempotentRetryOptions = retry.Options{
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	MaxRetries:     10,
+}
+
+// RunIdempotent runs the operations which fn queues up on the supplied
+// batch in a single batch outside of a transaction. fn is invoked
+// exactly once. If sending the batch fails in a way which leaves it
+// unknown whether it was applied (for instance, because no replica
+// could be reached), the batch is resent under the same client command
+// ID, so that a batch which was applied in the meantime is answered
+// from the response cache instead of being applied again.
+//
+// Upon completion, the batch's Results contain the results of its
+// operations, as with Run.
+func RunIdempotent(db *DB, fn func(*Batch)) error {
+	b := db.NewBatch()
+	fn(b)
+	if err := b.prepare(db.maxBatchSize); err != nil {
+		return err
+	}
+	h := b.header
+	h.CmdID = newClientCmdID()
+	var br *roachpb.BatchResponse
+	var pErr *roachpb.Error
+	for r := retry.Start(DefaultIdempotentRetryOptions); r.Next(); {
+		br, pErr = db.sendWithHeader(h, b.reqs...)
+		if pErr == nil || !isAmbiguousError(pErr) {
+			break
+		}
+		log.Warningf("retrying batch %s after ambiguous error: %s", h.CmdID.TraceID(), pErr)
+	}
+	if pErr != nil {
+		_ = b.fillResults(nil, pErr)
+		return pErr.GoError()
+	}
+	return b.fillResults(br, nil)
+}
+
+// isAmbiguousError returns true if the error leaves it unknown whether
+// the batch it was returned for was applied.
+func isAmbiguousError(pErr *roachpb.Error) bool {
+	switch pErr.GoError().(type) {
+	case *roachpb.SendError, *roachpb.AmbiguousResultError:
+		return true
+	}
+	// Retryable errors without a detail were generated on the client
+	// side, e.g. by the RPC layer, and not by a replica.
+	return pErr.Retryable && pErr.Detail == nil
 }
 
 // send runs the specified calls synchronously in a single batch and
 // returns any errors.
 func (db *DB) send(reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.Error) {
+	return db.sendWithHeader(roachpb.Header{}, reqs...)
+}
+
+// sendWithHeader is like send, but uses the supplied batch header. A new
+// client command ID is generated if the header doesn't specify one.
+func (db *DB) sendWithHeader(h roachpb.Header, reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.Error) {
 	if len(reqs) == 0 {
 		return &roachpb.BatchResponse{}, nil
 	}
 
-	ba := roachpb.BatchRequest{}
+	ba := roachpb.BatchRequest{Header: h}
 	ba.Add(reqs...)
 
 	if ba.UserPriority == nil && db.userPriority != 0 {
 		ba.UserPriority = proto.Int32(db.userPriority)
 	}
-	resetClientCmdID(&ba)
-	br, pErr := db.sender.Send(context.TODO(), ba)
+	if ba.CmdID == (roachpb.ClientCmdID{}) {
+		ba.CmdID = newClientCmdID()
+	}
+	ctx := db.context()
+	if err := ctx.Err(); err != nil {
+		// Don't bother sending requests nobody is waiting for.
+		return nil, roachpb.NewError(err)
+	}
+	ba.TraceTags = TraceTags(ctx)
+	br, pErr := db.sender.Send(ctx, ba)
 	if pErr != nil {
 		if log.V(1) {
 			log.Infof("failed batch: %s", pErr)
 		}
 		return nil, pErr
 	}
+	if db.verifyChecksums {
+		if err := verifyChecksums(ba, br); err != nil {
+			return nil, roachpb.NewError(err)
+		}
+	}
 	return br, nil
 }
 
+// verifyChecksums verifies the checksums of the values in the responses to
+// the requests of the batch.
+func verifyChecksums(ba roachpb.BatchRequest, br *roachpb.BatchResponse) error {
+	for i, union := range br.Responses {
+		if i >= len(ba.Requests) {
+			break
+		}
+		if err := union.GetInner().Verify(ba.Requests[i].GetInner()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Runner only exports the Run method on a batch of operations.
 type Runner interface {
 	Run(b *Batch) error
@@ -510,11 +860,10 @@ func runOneRow(r Runner, b *Batch) (KeyValue, error) {
 	return res.Rows[0], res.Err
 }
 
-// resetClientCmdID sets the client command ID if the call is for a
-// read-write method. The client command ID provides idempotency
-// protection in conjunction with the server.
-func resetClientCmdID(ba *roachpb.BatchRequest) {
-	ba.CmdID = roachpb.ClientCmdID{
+// newClientCmdID returns a new client command ID. The client command
+// ID provides idempotency protection in conjunction with the server.
+func newClientCmdID() roachpb.ClientCmdID {
+	return roachpb.ClientCmdID{
 		WallTime: time.Now().UnixNano(),
 		Random:   rand.Int63(),
 	}
@@ -18,11 +18,18 @@
 package client
 
 import (
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/retry"
 )
 
 // TestClientCommandID verifies that client command ID is set
@@ -44,3 +51,205 @@ func TestClientCommandID(t *testing.T) {
 		t.Errorf("expected test sender to be invoked once; got %d", count)
 	}
 }
+
+// TestRunIdempotent verifies that RunIdempotent retries ambiguous
+// errors under a stable client command ID and doesn't retry other
+// errors.
+func TestRunIdempotent(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(opts retry.Options) { DefaultIdempotentRetryOptions = opts }(DefaultIdempotentRetryOptions)
+	DefaultIdempotentRetryOptions.InitialBackoff = time.Millisecond
+	DefaultIdempotentRetryOptions.MaxBackoff = time.Millisecond
+
+	testCases := []struct {
+		err           error
+		expAttempts   int
+		expSuccessful bool
+	}{
+		{&roachpb.SendError{Message: "boom", Retryable: true}, 3, true},
+		{&roachpb.AmbiguousResultError{Message: "boom"}, 3, true},
+		{&roachpb.ConditionFailedError{}, 1, false},
+	}
+	for i, test := range testCases {
+		var cmdIDs []roachpb.ClientCmdID
+		db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+			cmdIDs = append(cmdIDs, ba.CmdID)
+			if len(cmdIDs) < 3 {
+				return nil, roachpb.NewError(test.err)
+			}
+			return ba.CreateReply(), nil
+		}, nil))
+		var b *Batch
+		err := RunIdempotent(db, func(batch *Batch) {
+			b = batch
+			batch.Put("a", "b")
+		})
+		if (err == nil) != test.expSuccessful {
+			t.Errorf("%d: expected success=%t; got %v", i, test.expSuccessful, err)
+		}
+		if len(cmdIDs) != test.expAttempts {
+			t.Errorf("%d: expected %d attempts; got %d", i, test.expAttempts, len(cmdIDs))
+		}
+		for _, cmdID := range cmdIDs {
+			if cmdID.IsEmpty() || cmdID != cmdIDs[0] {
+				t.Errorf("%d: expected stable client command ID; got %v", i, cmdIDs)
+			}
+		}
+		if len(b.Results) != 1 || (b.Results[0].Err == nil) != test.expSuccessful {
+			t.Errorf("%d: unexpected results %+v", i, b.Results)
+		}
+	}
+}
+
+// TestBatchMaxSize verifies that batches exceeding the DB's maximum
+// batch size are rejected before being sent.
+func TestBatchMaxSize(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	count := 0
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		count++
+		return ba.CreateReply(), nil
+	}, nil))
+
+	b := db.NewBatch()
+	if size := b.ApproximateSize(); size != 0 {
+		t.Fatalf("expected empty batch to have size 0; got %d", size)
+	}
+	b.Put("a", make([]byte, 100))
+	size := b.ApproximateSize()
+	if size < 100 {
+		t.Fatalf("expected batch size of at least 100 bytes; got %d", size)
+	}
+	b.Put("b", "c")
+	if newSize := b.ApproximateSize(); newSize <= size {
+		t.Fatalf("expected batch size to grow beyond %d; got %d", size, newSize)
+	}
+
+	db.SetMaxBatchSize(size)
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected batch to be rejected")
+	} else if _, ok := err.(*BatchTooLargeError); !ok {
+		t.Fatalf("expected BatchTooLargeError; got %T: %s", err, err)
+	}
+	if count != 0 {
+		t.Fatalf("expected rejected batch not to be sent; sent %d times", count)
+	}
+
+	db.SetMaxBatchSize(0)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("expected batch to be sent once; sent %d times", count)
+	}
+}
+
+// TestDBMetrics verifies that DB operations report their latency and
+// errors to the DB's metrics.
+func TestDBMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		if _, ok := ba.GetArg(roachpb.ConditionalPut); ok {
+			return nil, roachpb.NewError(&roachpb.ConditionFailedError{})
+		}
+		return ba.CreateReply(), nil
+	}, nil))
+	registry := metric.NewRegistry()
+	db.SetMetrics(NewRegistryMetrics(registry))
+
+	if err := db.Put("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CPut("a", "c", nil); err == nil {
+		t.Fatal("expected conditional put to fail")
+	}
+
+	values := map[string]int64{}
+	registry.Each(func(name string, value int64) {
+		values[name] = value
+	})
+	for name, expected := range map[string]int64{
+		"client.put.count":   1,
+		"client.put.errors":  0,
+		"client.get.count":   1,
+		"client.cput.count":  1,
+		"client.cput.errors": 1,
+	} {
+		if values[name] != expected {
+			t.Errorf("expected %s=%d, got %d", name, expected, values[name])
+		}
+	}
+}
+
+// TestDBTraceTags verifies that the requests of a DB and its transactions
+// are tagged with the trace tags of the DB's context.
+func TestDBTraceTags(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var names []string
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		names = append(names, ba.TraceName())
+		return ba.CreateReply(), nil
+	}, nil))
+
+	ctx := WithTraceTag(context.Background(), "user", "alice")
+	ctx = WithTraceTag(ctx, "request", "41")
+	ctx = WithTraceTag(ctx, "request", "42")
+	if err := db.WithContext(ctx).Put("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.WithContext(ctx).Txn(func(txn *Txn) error {
+		return txn.Put("a", "c")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tagged := len(names)
+	// The DB itself is unaffected.
+	if err := db.Put("a", "d"); err != nil {
+		t.Fatal(err)
+	}
+
+	if tagged < 2 || len(names) != tagged+1 {
+		t.Fatalf("unexpected batches %q", names)
+	}
+	for i, name := range names[:tagged] {
+		if !strings.HasSuffix(name, " [request=42,user=alice]") {
+			t.Errorf("%d: expected trace tags in trace name %q", i, name)
+		}
+	}
+	if name := names[tagged]; strings.Contains(name, "[") {
+		t.Errorf("expected no trace tags in trace name %q", name)
+	}
+}
+
+// TestDBVerifyChecksums verifies that a DB verifying checksums fails reads
+// returning a value whose checksum doesn't match.
+func TestDBVerifyChecksums(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		br := ba.CreateReply()
+		if gr, ok := br.Responses[0].GetInner().(*roachpb.GetResponse); ok {
+			value := roachpb.MakeValueFromString("value")
+			value.InitChecksum([]byte("a"))
+			value.SetBytes([]byte("corrupt"))
+			gr.Value = &value
+		}
+		return br, nil
+	}, nil))
+
+	if _, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	db.SetVerifyChecksums(true)
+	if _, err := db.Get("a"); !testutils.IsError(err, "invalid checksum") {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+	if err := db.Txn(func(txn *Txn) error {
+		_, err := txn.Get("a")
+		return err
+	}); !testutils.IsError(err, "invalid checksum") {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+}
@@ -318,6 +318,41 @@ func TestOpenArgs(t *testing.T) {
 	}
 }
 
+func TestOpenWith(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	testCases := []struct {
+		cfg       client.ConnConfig
+		expectErr bool
+	}{
+		{client.ConnConfig{Addrs: []string{s.ServingAddr()}, User: security.NodeUser, Certs: security.EmbeddedCertsDir}, false},
+		{client.ConnConfig{Addrs: []string{s.ServingAddr()}, User: security.NodeUser, Certs: security.EmbeddedCertsDir, FailFast: true}, false},
+		{client.ConnConfig{Addrs: []string{"foo:bar", s.ServingAddr()}, User: security.NodeUser, Certs: security.EmbeddedCertsDir}, false},
+		{client.ConnConfig{Addrs: []string{s.ServingAddr()}, Certs: "foo"}, true},
+		{client.ConnConfig{Addrs: []string{s.ServingAddr()}, Transport: "foo", Certs: security.EmbeddedCertsDir}, true},
+		{client.ConnConfig{Certs: security.EmbeddedCertsDir}, true},
+	}
+
+	for i, test := range testCases {
+		db, err := client.OpenWith(s.Stopper(), test.cfg)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: expected no errors; got %v", i, err)
+			continue
+		}
+		if err := db.Put("a", "1"); err != nil {
+			t.Errorf("%d: %s", i, err)
+		}
+	}
+}
+
 func TestDebugName(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, db := setup()
@@ -352,24 +387,31 @@ func TestCommonMethods(t *testing.T) {
 		key{dbType, "GetProto"}:  {},
 		key{txnType, "GetProto"}: {},
 
+		key{batchType, "ApproximateSize"}:         {},
 		key{batchType, "InternalAddRequest"}:      {},
 		key{dbType, "AdminMerge"}:                 {},
+		key{dbType, "AdminScatter"}:               {},
+		key{dbType, "AdminSetQueueExclusion"}:     {},
 		key{dbType, "AdminSplit"}:                 {},
 		key{dbType, "NewBatch"}:                   {},
 		key{dbType, "Run"}:                        {},
 		key{dbType, "RunWithResponse"}:            {},
+		key{dbType, "ScanRangeDescriptors"}:       {},
 		key{dbType, "Txn"}:                        {},
 		key{dbType, "GetSender"}:                  {},
+		key{dbType, "SetMaxBatchSize"}:            {},
 		key{txnType, "Commit"}:                    {},
 		key{txnType, "CommitBy"}:                  {},
 		key{txnType, "CommitInBatch"}:             {},
 		key{txnType, "CommitInBatchWithResponse"}: {},
+		key{txnType, "CommitInBatchWith1PCHint"}:  {},
 		key{txnType, "CommitNoCleanup"}:           {},
 		key{txnType, "Rollback"}:                  {},
 		key{txnType, "Cleanup"}:                   {},
 		key{txnType, "DebugName"}:                 {},
 		key{txnType, "InternalSetPriority"}:       {},
 		key{txnType, "NewBatch"}:                  {},
+		key{txnType, "OnePhaseCommit"}:            {},
 		key{txnType, "Run"}:                       {},
 		key{txnType, "RunWithResponse"}:           {},
 		key{txnType, "SetDebugName"}:              {},
@@ -0,0 +1,89 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// The value types understood by ParseValue.
+const (
+	ValueTypeBytes = "bytes"
+	ValueTypeInt   = "int"
+	ValueTypeFloat = "float"
+	ValueTypeTime  = "time"
+)
+
+// ParseValue parses the string s into a value of the named type which can
+// be passed to Put, CPut and friends. The supported types are "bytes" (the
+// string is stored as-is), "int", "float" and "time" (RFC 3339). An empty
+// type is treated as "bytes".
+func ParseValue(typ, s string) (interface{}, error) {
+	switch typ {
+	case "", ValueTypeBytes:
+		return []byte(s), nil
+	case ValueTypeInt:
+		i, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return nil, util.Errorf("invalid int value %q: %s", s, err)
+		}
+		return i, nil
+	case ValueTypeFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, util.Errorf("invalid float value %q: %s", s, err)
+		}
+		return f, nil
+	case ValueTypeTime:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, util.Errorf("invalid time value %q: %s", s, err)
+		}
+		return t, nil
+	}
+	return nil, util.Errorf("unknown value type %q", typ)
+}
+
+// ScanRangeDescriptors returns the descriptors of at most maxRows ranges
+// (all ranges if maxRows is zero) in key order, starting with the range
+// containing startKey. An empty startKey starts with the first range. The
+// descriptors are read from the meta2 addressing records.
+func (db *DB) ScanRangeDescriptors(startKey roachpb.Key, maxRows int64) ([]roachpb.RangeDescriptor, error) {
+	if len(startKey) == 0 {
+		startKey = roachpb.KeyMin.Next()
+	}
+	// Meta2 records are indexed by the end key of the range they describe,
+	// so the range containing startKey is the first one whose record sorts
+	// after startKey.
+	rows, err := db.Scan(keys.RangeMetaKey(keys.Addr(startKey)).Next(),
+		keys.Meta2Prefix.PrefixEnd(), maxRows)
+	if err != nil {
+		return nil, err
+	}
+	descs := make([]roachpb.RangeDescriptor, len(rows))
+	for i, row := range rows {
+		if err := row.ValueProto(&descs[i]); err != nil {
+			return nil, util.Errorf("%s: unable to unmarshal range descriptor: %s",
+				keys.PrettyPrint(row.Key), err)
+		}
+	}
+	return descs, nil
+}
@@ -42,6 +42,20 @@ below shows a get and a put.
 		log.Printf("%s", gr.ValueBytes())  // "hello"
 	}
 
+The connection options can also be given as a ConnConfig, which is what the URL
+passed to Open is translated into:
+
+	db, err := client.OpenWith(stopper, client.ConnConfig{
+		Addrs: []string{"localhost:26257"},
+		User:  "root",
+	})
+
+Reads accept options which modify them, for instance to read without
+consistency guarantees, to read historical data or to omit the values of
+scanned rows:
+
+	rows, err := db.Scan("a", "c", 0, client.Inconsistent(), client.KeyOnly())
+
 The API is synchronous, but accommodates efficient parallel updates and queries
 using Batch objects. An arbitrary number of calls may be added to a Batch which
 is executed using DB.Run. Note however that the individual calls within a batch
@@ -122,5 +136,26 @@ single RPC. In addition to the reduced number of RPCs to the server, this
 allows writes to the same range to be batched together. In cases where the
 entire transaction affects only a single range, transactions can commit in a
 single round trip.
+
+A batch committing a transaction may mix reads with writes. When committed
+using Txn.CommitInBatchWithReads, the reads observe the transaction's state
+before any of the batch's writes, whatever the order in which the operations
+were added, and their results are returned in the order in which the reads
+were added:
+
+	err := db.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		b.Put("a", "new value")
+		b.Get("a")
+		b.Scan("a", "c", 0)
+
+		reads, err := txn.CommitInBatchWithReads(b)
+		if err != nil {
+			return err
+		}
+		// reads[0] holds the value of "a" before the put; reads[1] the rows
+		// of the scan.
+		return nil
+	})
 */
 package client
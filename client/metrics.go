@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// The operations reported to Metrics.
+const (
+	OpGet         = "get"
+	OpPut         = "put"
+	OpCPut        = "cput"
+	OpInc         = "inc"
+	OpScan        = "scan"
+	OpDel         = "del"
+	OpDelRange    = "delrange"
+	OpBatch       = "batch"
+	OpTxn         = "txn"
+	OpTxnCommit   = "txn.commit"
+	OpTxnRollback = "txn.rollback"
+)
+
+// Metrics is an optional interface through which a DB reports the
+// latency and outcome of its operations, for instance to an
+// application's monitoring system. Implementations must be safe for
+// concurrent use.
+type Metrics interface {
+	// RecordOperation is called when an operation completes. err is the
+	// error the operation returned to the caller, if any.
+	RecordOperation(op string, latency time.Duration, err error)
+}
+
+// registryMetrics implements Metrics by recording into a metric.Registry.
+type registryMetrics struct {
+	registry *metric.Registry
+}
+
+// NewRegistryMetrics returns a Metrics which records, for each operation
+// <op>, the latencies in nanoseconds into the histogram
+// "client.<op>.latency" and the number of calls and of failed calls into
+// the counters "client.<op>.count" and "client.<op>.errors" of the
+// supplied registry.
+func NewRegistryMetrics(registry *metric.Registry) Metrics {
+	return registryMetrics{registry: registry}
+}
+
+// RecordOperation implements Metrics.
+func (m registryMetrics) RecordOperation(op string, latency time.Duration, err error) {
+	prefix := "client." + op
+	m.registry.Histogram(prefix + ".latency").RecordValue(latency.Nanoseconds())
+	m.registry.Counter(prefix + ".count").Inc(1)
+	if err != nil {
+		m.registry.Counter(prefix + ".errors").Inc(1)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+var errKeyOnlyGet = errors.New("key-only reads are only supported by scans")
+var errConflictingReadOptions = errors.New("the reads of a batch must use the same consistency and timestamp")
+var errTxnReadOptions = errors.New("inconsistent and historical reads are not supported in a transaction")
+
+// A ReadOption modifies a read, i.e. a Get, Scan or ReverseScan, on a DB,
+// Txn or Batch:
+//
+//   rows, err := db.Scan("a", "b", 0, client.Inconsistent(), client.KeyOnly())
+//
+// The consistency and timestamp of a read apply to the whole batch it is
+// sent in, so all the reads of a batch must use the same ones. They are
+// not supported in transactions, which read consistently at the
+// transaction's timestamp.
+type ReadOption func(*readOptions)
+
+type readOptions struct {
+	keyOnly     bool
+	consistency roachpb.ReadConsistencyType
+	timestamp   roachpb.Timestamp
+}
+
+// KeyOnly omits the values of the rows returned by a scan (apart from
+// their timestamps). It is useful for existence checks and for counting
+// keys without transferring their values.
+func KeyOnly() ReadOption {
+	return func(o *readOptions) {
+		o.keyOnly = true
+	}
+}
+
+// Inconsistent reads without consistency guarantees: the read is served by
+// any replica, without a leader lease, and ignores intents.
+func Inconsistent() ReadOption {
+	return func(o *readOptions) {
+		o.consistency = roachpb.INCONSISTENT
+	}
+}
+
+// AsOf reads the data as of the given timestamp, which must be within the
+// GC TTL of the data read.
+func AsOf(timestamp roachpb.Timestamp) ReadOption {
+	return func(o *readOptions) {
+		o.timestamp = timestamp
+	}
+}
+
+func makeReadOptions(opts []ReadOption) readOptions {
+	var o readOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// fillHeader populates the fields of a batch header which are determined
+// by the read options.
+func (o readOptions) fillHeader(h *roachpb.Header) {
+	h.ReadConsistency = o.consistency
+	h.Timestamp = o.timestamp
+}
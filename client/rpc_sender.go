@@ -20,13 +20,16 @@ package client
 import (
 	"fmt"
 	"net"
+	gorpc "net/rpc"
 	"net/url"
+	"strings"
 
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/retry"
@@ -49,6 +52,7 @@ const method = "Server.Batch"
 // via RPC to a Cockroach node. Overly-busy nodes will redirect this
 // client to other nodes.
 type rpcSender struct {
+	context   *rpc.Context
 	client    *rpc.Client
 	retryOpts retry.Options
 }
@@ -71,20 +75,36 @@ func newRPCSender(server string, context *base.Context, retryOpts retry.Options,
 	ctx := rpc.NewContext(context, hlc.NewClock(hlc.UnixNano), stopper)
 	client := rpc.NewClient(addr, ctx)
 	return &rpcSender{
+		context:   ctx,
 		client:    client,
 		retryOpts: retryOpts,
 	}, nil
 }
 
+// ReloadCertificates implements the certificateReloader interface. The
+// client certificates are reloaded from the certs directory and used
+// when the connection to the server is next established.
+func (s *rpcSender) ReloadCertificates() error {
+	return s.context.ReloadClientTLSConfig()
+}
+
 // Batch sends a request to Cockroach via RPC. Errors which are retryable are
 // retried with backoff in a loop using the default retry options. Other errors
 // sending the request are retried indefinitely using the same client command
 // ID to avoid reporting failure when in fact the command may have gone through
 // and been executed successfully. We retry here to eventually get through with
-// the same client command ID and be given the cached response.
+// the same client command ID and be given the cached response. If the retries
+// are exhausted after a request which writes has been sent, the outcome of the
+// request is unknown and an AmbiguousResultError is returned. Requests the
+// server refuses because their user could not be authenticated or may not
+// send them fail without retries with a roachpb.AuthenticationFailedError
+// or a roachpb.PermissionDeniedError, respectively.
 func (s *rpcSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
 	var err error
 	var br roachpb.BatchResponse
+	// sent is set once a call has failed after the request may have
+	// reached the server.
+	var sent bool
 	for r := retry.Start(s.retryOpts); r.Next(); {
 		select {
 		case <-s.client.Healthy():
@@ -96,6 +116,11 @@ func (s *rpcSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb
 
 		if err = s.client.Call(method, &ba, &br); err != nil {
 			br.Reset() // don't trust anyone.
+			// Credential problems aren't resolved by retrying.
+			if pErr := authError(ba.GetUser(), err); pErr != nil {
+				return nil, pErr
+			}
+			sent = true
 			// Assume all errors sending request are retryable. The actual
 			// number of things that could go wrong is vast, but we don't
 			// want to miss any which should in theory be retried with the
@@ -111,9 +136,39 @@ func (s *rpcSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb
 		break
 	}
 	if err != nil {
+		if sent && !ba.IsReadOnly() {
+			return nil, roachpb.NewError(&roachpb.AmbiguousResultError{Message: err.Error()})
+		}
 		return nil, roachpb.NewError(err)
 	}
 	pErr := br.Error
 	br.Error = nil
 	return &br, pErr
 }
+
+// authError returns an AuthenticationFailedError or a
+// PermissionDeniedError if err is the server refusing a request sent on
+// behalf of user because the user could not be authenticated or may not
+// send it, respectively. Otherwise, it returns nil.
+func authError(user string, err error) *roachpb.Error {
+	sErr, ok := err.(gorpc.ServerError)
+	if !ok {
+		return nil
+	}
+	msg := string(sErr)
+	switch {
+	case strings.HasPrefix(msg, security.AuthenticationFailedPrefix):
+		return roachpb.NewError(&roachpb.AuthenticationFailedError{
+			User:    user,
+			Method:  method,
+			Message: strings.TrimPrefix(msg, security.AuthenticationFailedPrefix),
+		})
+	case strings.HasPrefix(msg, security.PermissionDeniedPrefix):
+		return roachpb.NewError(&roachpb.PermissionDeniedError{
+			User:    user,
+			Method:  method,
+			Message: strings.TrimPrefix(msg, security.PermissionDeniedPrefix),
+		})
+	}
+	return nil
+}
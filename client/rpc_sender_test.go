@@ -0,0 +1,49 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	gorpc "net/rpc"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestAuthError verifies that the errors of the server refusing requests
+// for lack of credentials or permissions are converted to typed errors.
+func TestAuthError(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		err      error
+		expected error
+	}{
+		{errors.New("connection refused"), nil},
+		{gorpc.ServerError("range not found"), nil},
+		{gorpc.ServerError(security.AuthenticationFailedPrefix + "bad certificate"),
+			&roachpb.AuthenticationFailedError{User: "foo", Method: method, Message: "bad certificate"}},
+		{gorpc.ServerError(security.PermissionDeniedPrefix + "not allowed"),
+			&roachpb.PermissionDeniedError{User: "foo", Method: method, Message: "not allowed"}},
+	}
+	for i, tc := range testCases {
+		if err := authError("foo", tc.err).GoError(); !reflect.DeepEqual(err, tc.expected) {
+			t.Errorf("%d: expected %v, got %v", i, tc.expected, err)
+		}
+	}
+}
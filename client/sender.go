@@ -47,6 +47,12 @@ type Sender interface {
 	Send(context.Context, roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error)
 }
 
+// A certificateReloader is a Sender whose client certificates can be
+// reloaded from disk after they were rotated.
+type certificateReloader interface {
+	ReloadCertificates() error
+}
+
 // SenderFunc is an adapter to allow the use of ordinary functions
 // as Senders.
 type SenderFunc func(context.Context, roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error)
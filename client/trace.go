@@ -0,0 +1,76 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// traceTagsKeyType is a dummy type to avoid naming collisions with other
+// package's context keys.
+type traceTagsKeyType int
+
+// traceTagsKey is the key claimed for storing the trace tags of a
+// context.Context.
+const traceTagsKey traceTagsKeyType = 0
+
+// WithTraceTag returns a copy of ctx carrying the given trace tag in
+// addition to the trace tags of ctx, replacing a tag of ctx with the same
+// key. The requests a DB or Txn sends on behalf of a context (see
+// DB.WithContext) are tagged with its trace tags, which are recorded in
+// the traces of the requests on the nodes executing them.
+func WithTraceTag(ctx context.Context, key, value string) context.Context {
+	existing := TraceTags(ctx)
+	tags := make([]roachpb.TraceTag, 0, len(existing)+1)
+	for _, tag := range existing {
+		if tag.Key != key {
+			tags = append(tags, tag)
+		}
+	}
+	tags = append(tags, roachpb.TraceTag{Key: key, Value: value})
+	sort.Sort(traceTagsByKey(tags))
+	return context.WithValue(ctx, traceTagsKey, tags)
+}
+
+// TraceTags returns the trace tags carried by ctx, sorted by key. The
+// returned slice must not be modified.
+func TraceTags(ctx context.Context) []roachpb.TraceTag {
+	if ctx == nil {
+		return nil
+	}
+	tags, _ := ctx.Value(traceTagsKey).([]roachpb.TraceTag)
+	return tags
+}
+
+// detachContext returns a context carrying the trace tags of ctx but
+// neither its deadline nor its cancellation.
+func detachContext(ctx context.Context) context.Context {
+	detached := context.Background()
+	if tags := TraceTags(ctx); tags != nil {
+		detached = context.WithValue(detached, traceTagsKey, tags)
+	}
+	return detached
+}
+
+type traceTagsByKey []roachpb.TraceTag
+
+func (t traceTagsByKey) Len() int           { return len(t) }
+func (t traceTagsByKey) Less(i, j int) bool { return t[i].Key < t[j].Key }
+func (t traceTagsByKey) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
@@ -46,7 +46,9 @@ var DefaultTxnRetryOptions = retry.Options{
 type txnSender Txn
 
 func (ts *txnSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
-	// Send call through wrapped sender.
+	// Send call through wrapped sender. Each batch is assigned the next
+	// sequence number, which replicas use to detect replays.
+	ts.Proto.Sequence++
 	ba.Txn = &ts.Proto
 	br, pErr := ts.wrapped.Send(ctx, ba)
 	if br != nil && br.Error != nil {
@@ -89,6 +91,21 @@ type Txn struct {
 	// systemDBTrigger is set to true when modifying keys from the
 	// SystemDB span. This sets the SystemDBTrigger on EndTransactionRequest.
 	systemDBTrigger bool
+	// onePhaseCommit is set when the transaction committed in one phase.
+	onePhaseCommit bool
+	// commitAmbiguous is set when an attempt to commit the transaction
+	// failed with an AmbiguousResultError; the transaction may or may not
+	// have committed.
+	commitAmbiguous bool
+	stats           TxnStats
+}
+
+// TxnStats counts the KV batches sent by a transaction and the read and
+// write requests they contained.
+type TxnStats struct {
+	Batches int64
+	Reads   int64
+	Writes  int64
 }
 
 // NewTxn returns a new txn.
@@ -113,6 +130,21 @@ func (txn *Txn) SetDebugName(name string, depth int) {
 	txn.Proto.Name = file + ":" + strconv.Itoa(line) + " " + name
 }
 
+// SetContext sets the context on behalf of which the transaction sends its
+// subsequent requests, overriding that of the DB it was created from (see
+// DB.WithContext). Once ctx is canceled or its deadline is exceeded, the
+// requests fail without being sent, or stop being retried. A nil ctx
+// reverts to the context of the DB.
+func (txn *Txn) SetContext(ctx context.Context) {
+	txn.db.ctx = ctx
+}
+
+// Stats returns the number of KV batches and requests the transaction has
+// sent so far, including those of attempts which were retried.
+func (txn *Txn) Stats() TxnStats {
+	return txn.stats
+}
+
 // DebugName returns the debug name associated with the transaction.
 func (txn *Txn) DebugName() string {
 	return txn.Proto.Name
@@ -150,6 +182,18 @@ func (txn *Txn) SystemDBTrigger() bool {
 	return txn.systemDBTrigger
 }
 
+// Step marks a statement boundary: reads issued after the call observe the
+// writes the transaction made before it, but not the writes it makes after
+// it, until the next step. Writes which read the existing value (such as
+// CPut and Inc) always observe all of the transaction's writes. Until Step
+// is first called, reads observe all of the transaction's writes.
+func (txn *Txn) Step() {
+	// Skip a sequence number so that the next batch is sent with a sequence
+	// exceeding the read sequence.
+	txn.Proto.Sequence++
+	txn.Proto.ReadSequence = txn.Proto.Sequence
+}
+
 // NewBatch creates and returns a new empty batch object for use with the Txn.
 func (txn *Txn) NewBatch() *Batch {
 	return &Batch{DB: &txn.db}
@@ -161,10 +205,11 @@ func (txn *Txn) NewBatch() *Batch {
 //   r, err := db.Get("a")
 //   // string(r.Key) == "a"
 //
-// key can be either a byte slice or a string.
-func (txn *Txn) Get(key interface{}) (KeyValue, error) {
+// key can be either a byte slice or a string. The options must not include
+// KeyOnly.
+func (txn *Txn) Get(key interface{}, opts ...ReadOption) (KeyValue, error) {
 	b := txn.NewBatch()
-	b.Get(key)
+	b.Get(key, opts...)
 	return runOneRow(txn, b)
 }
 
@@ -172,8 +217,8 @@ func (txn *Txn) Get(key interface{}) (KeyValue, error) {
 // message.
 //
 // key can be either a byte slice or a string.
-func (txn *Txn) GetProto(key interface{}, msg proto.Message) error {
-	r, err := txn.Get(key)
+func (txn *Txn) GetProto(key interface{}, msg proto.Message, opts ...ReadOption) error {
+	r, err := txn.Get(key, opts...)
 	if err != nil {
 		return err
 	}
@@ -218,13 +263,9 @@ func (txn *Txn) Inc(key interface{}, value int64) (KeyValue, error) {
 	return runOneRow(txn, b)
 }
 
-func (txn *Txn) scan(begin, end interface{}, maxRows int64, isReverse bool) ([]KeyValue, error) {
+func (txn *Txn) scan(begin, end interface{}, maxRows int64, isReverse bool, opts []ReadOption) ([]KeyValue, error) {
 	b := txn.NewBatch()
-	if !isReverse {
-		b.Scan(begin, end, maxRows)
-	} else {
-		b.ReverseScan(begin, end, maxRows)
-	}
+	b.scan(begin, end, maxRows, isReverse, opts)
 	r, err := runOneResult(txn, b)
 	return r.Rows, err
 }
@@ -235,8 +276,16 @@ func (txn *Txn) scan(begin, end interface{}, maxRows int64, isReverse bool) ([]K
 // The returned []KeyValue will contain up to maxRows elements.
 //
 // key can be either a byte slice or a string.
-func (txn *Txn) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
-	return txn.scan(begin, end, maxRows, false)
+func (txn *Txn) Scan(begin, end interface{}, maxRows int64, opts ...ReadOption) ([]KeyValue, error) {
+	return txn.scan(begin, end, maxRows, false, opts)
+}
+
+// ScanKeys is like Scan with the KeyOnly option: the values of the
+// returned rows are omitted (apart from their timestamps).
+//
+// key can be either a byte slice or a string.
+func (txn *Txn) ScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	return txn.Scan(begin, end, maxRows, KeyOnly())
 }
 
 // ReverseScan retrieves the rows between begin (inclusive) and end (exclusive)
@@ -245,8 +294,16 @@ func (txn *Txn) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error)
 // The returned []KeyValue will contain up to maxRows elements.
 //
 // key can be either a byte slice or a string.
-func (txn *Txn) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
-	return txn.scan(begin, end, maxRows, true)
+func (txn *Txn) ReverseScan(begin, end interface{}, maxRows int64, opts ...ReadOption) ([]KeyValue, error) {
+	return txn.scan(begin, end, maxRows, true, opts)
+}
+
+// ReverseScanKeys is like ReverseScan with the KeyOnly option: the values
+// of the returned rows are omitted (apart from their timestamps).
+//
+// key can be either a byte slice or a string.
+func (txn *Txn) ReverseScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	return txn.ReverseScan(begin, end, maxRows, KeyOnly())
 }
 
 // Del deletes one or more keys.
@@ -290,19 +347,30 @@ func (txn *Txn) Run(b *Batch) error {
 
 // RunWithResponse is a version of Run that returns the BatchResponse.
 func (txn *Txn) RunWithResponse(b *Batch) (*roachpb.BatchResponse, error) {
-	if err := b.prepare(); err != nil {
+	if err := b.prepare(txn.db.maxBatchSize); err != nil {
 		return nil, err
 	}
-	return sendAndFill(txn.send, b)
+	return sendAndFill(txn.sendWithHeader, b)
 }
 
 func (txn *Txn) commit(deadline *roachpb.Timestamp) error {
-	return txn.sendEndTxnReq(true /* commit */, deadline)
+	start := time.Now()
+	err := txn.sendEndTxnReq(true /* commit */, deadline)
+	txn.db.recordOperation(OpTxnCommit, start, err)
+	return err
 }
 
-// Cleanup cleans up the transaction as appropriate based on err.
+// Cleanup cleans up the transaction as appropriate based on err. A
+// transaction whose commit had an ambiguous result is not rolled back:
+// the rollback cannot undo the commit if it was applied, and would race
+// it if it is still in flight.
 func (txn *Txn) Cleanup(err error) {
 	if err != nil {
+		if txn.commitAmbiguous {
+			log.Warningf("not aborting transaction %s whose commit may have been applied: %s",
+				txn.Proto.Short(), err)
+			return
+		}
 		if replyErr := txn.Rollback(); replyErr != nil {
 			log.Errorf("failure aborting transaction: %s; abort caused by: %s", replyErr, err)
 		}
@@ -328,9 +396,52 @@ func (txn *Txn) CommitInBatch(b *Batch) error {
 // CommitInBatchWithResponse is a version of CommitInBatch that returns the
 // BatchResponse.
 func (txn *Txn) CommitInBatchWithResponse(b *Batch) (*roachpb.BatchResponse, error) {
+	start := time.Now()
 	b.reqs = append(b.reqs, endTxnReq(true /* commit */, nil, txn.SystemDBTrigger()))
 	b.initResult(1, 0, nil)
-	return txn.RunWithResponse(b)
+	br, err := txn.RunWithResponse(b)
+	txn.db.recordOperation(OpTxnCommit, start, err)
+	return br, err
+}
+
+// CommitInBatchWithReads is like CommitInBatch, for batches which mix reads
+// (Get, Scan, ReverseScan and their key-only variants) with writes. The
+// reads observe the writes the transaction made before the batch but none
+// of the batch's own writes, regardless of the order in which operations
+// were added to it. The results of the reads are returned in the order in
+// which the reads were added, sparing callers from tracking their positions
+// among those of the writes in b.Results.
+func (txn *Txn) CommitInBatchWithReads(b *Batch) ([]Result, error) {
+	txn.Step()
+	if err := txn.CommitInBatch(b); err != nil {
+		return nil, err
+	}
+	return b.readResults(), nil
+}
+
+// CommitInBatchWith1PCHint is like CommitInBatch, but is intended for
+// callers which rely on the transaction committing in one phase, that is,
+// with all of its writes and the commit in a single batch addressed to a
+// single range. The commit fails if the transaction's writes span
+// multiple ranges. Whether the transaction did in fact commit in one
+// phase (which is not the case after a restart, for instance) is
+// reported by OnePhaseCommit.
+func (txn *Txn) CommitInBatchWith1PCHint(b *Batch) error {
+	et := endTxnReq(true /* commit */, nil, txn.SystemDBTrigger()).(*roachpb.EndTransactionRequest)
+	start := time.Now()
+	et.RequireOnePhaseCommit = true
+	b.reqs = append(b.reqs, et)
+	b.initResult(1, 0, nil)
+	_, err := txn.RunWithResponse(b)
+	txn.db.recordOperation(OpTxnCommit, start, err)
+	return err
+}
+
+// OnePhaseCommit returns true if the transaction committed in one phase:
+// it began writing in the same batch which committed it, and all of its
+// intents were local to a single range and resolved synchronously.
+func (txn *Txn) OnePhaseCommit() bool {
+	return txn.onePhaseCommit
 }
 
 // Commit sends an EndTransactionRequest with Commit=true.
@@ -348,9 +459,18 @@ func (txn *Txn) CommitBy(deadline *roachpb.Timestamp) error {
 	return err
 }
 
-// Rollback sends an EndTransactionRequest with Commit=false.
+// Rollback sends an EndTransactionRequest with Commit=false. The request
+// is sent even if the transaction's context is done, so that the intents
+// of a canceled transaction are cleaned up.
 func (txn *Txn) Rollback() error {
-	return txn.sendEndTxnReq(false /* commit */, nil)
+	if ctx := txn.db.ctx; ctx != nil && ctx.Err() != nil {
+		txn.db.ctx = detachContext(ctx)
+		defer func() { txn.db.ctx = ctx }()
+	}
+	start := time.Now()
+	err := txn.sendEndTxnReq(false /* commit */, nil)
+	txn.db.recordOperation(OpTxnRollback, start, err)
+	return err
 }
 
 func (txn *Txn) sendEndTxnReq(commit bool, deadline *roachpb.Timestamp) error {
@@ -402,6 +522,16 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) error {
 	return err
 }
 
+// sendWithHeader is like send, but fails if the header determined by the
+// read options of a batch asks for an inconsistent or historical read,
+// which transactions don't support.
+func (txn *Txn) sendWithHeader(h roachpb.Header, reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.Error) {
+	if h.ReadConsistency != roachpb.CONSISTENT || !h.Timestamp.Equal(roachpb.ZeroTimestamp) {
+		return nil, roachpb.NewError(errTxnReadOptions)
+	}
+	return txn.send(reqs...)
+}
+
 // send runs the specified calls synchronously in a single batch and
 // returns any errors. If the transaction is read-only or has already
 // been successfully committed or aborted, a potential trailing
@@ -460,7 +590,29 @@ func (txn *Txn) send(reqs ...roachpb.Request) (*roachpb.BatchResponse, *roachpb.
 		reqs = reqs[:lastIndex]
 	}
 
+	if len(reqs) > 0 {
+		txn.stats.Batches++
+		for _, args := range reqs {
+			if roachpb.IsReadOnly(args) {
+				txn.stats.Reads++
+			} else if roachpb.IsTransactionWrite(args) {
+				txn.stats.Writes++
+			}
+		}
+	}
+
 	br, pErr := txn.db.send(reqs...)
+	if haveEndTxn && !elideEndTxn && endTxnRequest.Commit {
+		if _, ok := pErr.GoError().(*roachpb.AmbiguousResultError); ok {
+			txn.commitAmbiguous = true
+		}
+	}
+	if haveEndTxn && !elideEndTxn && pErr == nil && len(br.Responses) > 0 {
+		reply := br.Responses[len(br.Responses)-1].GetInner()
+		if etReply, ok := reply.(*roachpb.EndTransactionResponse); ok {
+			txn.onePhaseCommit = etReply.OnePhaseCommit
+		}
+	}
 	if elideEndTxn && pErr == nil {
 		// This normally happens on the server and sent back in response
 		// headers, but this transaction was optimized away. The caller may
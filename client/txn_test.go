@@ -155,6 +155,33 @@ func TestTxnRequestTxnTimestamp(t *testing.T) {
 	}
 }
 
+// TestTxnStep verifies that batches sent after a step carry a read
+// sequence below their own sequence.
+func TestTxnStep(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var sequences [][2]uint32
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		sequences = append(sequences, [2]uint32{ba.Txn.Sequence, ba.Txn.ReadSequence})
+		return ba.CreateReply(), nil
+	}, nil))
+
+	txn := NewTxn(*db)
+	if _, err := txn.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	txn.Step()
+	if _, err := txn.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Put("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][2]uint32{{1, 0}, {3, 2}, {4, 2}}
+	if !reflect.DeepEqual(sequences, expected) {
+		t.Errorf("expected (sequence, read sequence) pairs %v; got %v", expected, sequences)
+	}
+}
+
 // TestTxnResetTxnOnAbort verifies transaction is reset on abort.
 func TestTxnResetTxnOnAbort(t *testing.T) {
 	defer leaktest.AfterTest(t)
@@ -313,6 +340,30 @@ func TestTxnInsertBeginTransaction(t *testing.T) {
 	}
 }
 
+// TestTxnStats verifies that a transaction counts the batches it sends
+// and the read and write requests they contain.
+func TestTxnStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	db := newDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		return ba.CreateReply(), nil
+	}, nil))
+	var txn *Txn
+	if err := db.Txn(func(tx *Txn) error {
+		txn = tx
+		if _, err := txn.Get("foo"); err != nil {
+			return err
+		}
+		return txn.Put("a", "b")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// The Get, the BeginTransaction and Put, and the EndTransaction.
+	expected := TxnStats{Batches: 3, Reads: 1, Writes: 1}
+	if stats := txn.Stats(); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+}
+
 // TestCommitTransactionOnce verifies that if the transaction is
 // ended explicitly in the retryable func, it is not automatically
 // ended a second time at completion of retryable func.
@@ -335,6 +386,40 @@ func TestCommitTransactionOnce(t *testing.T) {
 	}
 }
 
+// TestCommitInBatchWith1PCHint verifies that CommitInBatchWith1PCHint
+// requests a one-phase commit and that the outcome is surfaced by
+// OnePhaseCommit.
+func TestCommitInBatchWith1PCHint(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	db := NewDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		br := ba.CreateReply()
+		args, ok := ba.GetArg(roachpb.EndTransaction)
+		if !ok {
+			t.Fatalf("expected EndTransaction in %s", ba)
+		}
+		if !args.(*roachpb.EndTransactionRequest).RequireOnePhaseCommit {
+			t.Errorf("expected EndTransaction to require a one-phase commit")
+		}
+		br.Responses[len(br.Responses)-1].GetInner().(*roachpb.EndTransactionResponse).OnePhaseCommit = true
+		return br, nil
+	}, nil))
+	var onePhase bool
+	if err := db.Txn(func(txn *Txn) error {
+		b := &Batch{}
+		b.Put("a", "b")
+		if err := txn.CommitInBatchWith1PCHint(b); err != nil {
+			return err
+		}
+		onePhase = txn.OnePhaseCommit()
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !onePhase {
+		t.Errorf("expected one-phase commit")
+	}
+}
+
 // TestAbortReadOnlyTransaction verifies that aborting a read-only
 // transaction does not prompt an EndTransaction call.
 func TestAbortReadOnlyTransaction(t *testing.T) {
@@ -482,6 +567,7 @@ func TestAbortTransactionOnCommitErrors(t *testing.T) {
 		{&roachpb.RangeNotFoundError{}, true},
 		{&roachpb.RangeKeyMismatchError{}, true},
 		{&roachpb.TransactionStatusError{}, true},
+		{&roachpb.AmbiguousResultError{}, false},
 	}
 
 	for _, test := range testCases {
@@ -550,3 +636,30 @@ func TestTransactionStatus(t *testing.T) {
 		}
 	}
 }
+
+// TestTxnContextCanceled verifies that a transaction doesn't send requests
+// once its context is canceled, but still rolls back.
+func TestTxnContextCanceled(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []roachpb.Method
+	db := newDB(newTestSender(func(ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+		calls = append(calls, ba.Methods()...)
+		return ba.CreateReply(), nil
+	}, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	err := db.Txn(func(txn *Txn) error {
+		txn.SetContext(ctx)
+		if err := txn.Put("a", "b"); err != nil {
+			return err
+		}
+		cancel()
+		return txn.Put("a", "c")
+	})
+	if err == nil || err.Error() != context.Canceled.Error() {
+		t.Fatalf("expected %s, got %v", context.Canceled, err)
+	}
+	expectedCalls := []roachpb.Method{roachpb.BeginTransaction, roachpb.Put, roachpb.EndTransaction}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
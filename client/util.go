@@ -40,6 +40,23 @@ func marshalKey(k interface{}) (roachpb.Key, error) {
 	return nil, fmt.Errorf("unable to marshal key: %T %q", k, k)
 }
 
+// MarshalKeyValue returns the roachpb.KeyValue of a key and value of any
+// of the types accepted by Batch.Put, with the checksum of the value
+// initialized. It is used to build the rows of requests which don't go
+// through a Batch, such as an IngestRequest.
+func MarshalKeyValue(key, value interface{}) (roachpb.KeyValue, error) {
+	k, err := marshalKey(key)
+	if err != nil {
+		return roachpb.KeyValue{}, err
+	}
+	v, err := marshalValue(value)
+	if err != nil {
+		return roachpb.KeyValue{}, err
+	}
+	v.InitChecksum(k)
+	return roachpb.KeyValue{Key: k, Value: v}, nil
+}
+
 // marshalValue returns a roachpb.Value initialized from the source
 // interface{}, returning an error if the types are not compatible.
 func marshalValue(v interface{}) (roachpb.Value, error) {
@@ -74,6 +91,10 @@ func marshalValue(v interface{}) (roachpb.Value, error) {
 		r.SetTime(t)
 		return r, nil
 
+	case roachpb.Value:
+		// A pre-encoded value (e.g. an ARRAY) is passed through as-is.
+		return t, nil
+
 	case proto.Message:
 		err := r.SetProto(t)
 		return r, err
@@ -92,6 +92,11 @@ func (z ZoneConfig) Validate() error {
 		return util.Errorf("RangeMinBytes %d is greater than or equal to RangeMaxBytes %d",
 			z.RangeMinBytes, z.RangeMaxBytes)
 	}
+	for _, preference := range z.LeasePreferences {
+		if len(preference.Attrs) == 0 {
+			return util.Errorf("lease preferences must specify at least one attribute")
+		}
+	}
 	return nil
 }
 
@@ -58,6 +58,12 @@ type ZoneConfig struct {
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
 	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// LeasePreferences is an ordered list of Attributes describing where the
+	// leader lease of the ranges of the zone should be held. The lease is
+	// moved to a replica whose store has all the attributes of the first
+	// preference satisfied by any replica of the range. Without preferences,
+	// the lease may be held by any replica.
+	LeasePreferences []cockroach_roachpb.Attributes `protobuf:"bytes,5,rep,name=lease_preferences" json:"lease_preferences,omitempty" yaml:"lease_preferences,omitempty"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -136,6 +142,18 @@ func (m *ZoneConfig) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.LeasePreferences) > 0 {
+		for _, msg := range m.LeasePreferences {
+			data[i] = 0x2a
+			i++
+			i = encodeVarintConfig(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -218,6 +236,12 @@ func (m *ZoneConfig) Size() (n int) {
 		l = m.GC.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	if len(m.LeasePreferences) > 0 {
+		for _, e := range m.LeasePreferences {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -446,6 +470,37 @@ func (m *ZoneConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasePreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeasePreferences = append(m.LeasePreferences, cockroach_roachpb.Attributes{})
+			if err := m.LeasePreferences[len(m.LeasePreferences)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(data[iNdEx:])
@@ -176,7 +176,7 @@ func TestGetLargestID(t *testing.T) {
 		}, 12, ""},
 
 		// Real SQL layout.
-		{sql.GetInitialSystemValues(), keys.ZonesTableID, ""},
+		{sql.GetInitialSystemValues(), keys.TableStatisticsTableID, ""},
 	}
 
 	cfg := config.SystemConfig{}
@@ -133,6 +133,13 @@ type Gossip struct {
 	resolverIdx int
 	resolvers   []resolver.Resolver
 	triedAll    bool // True when all resolvers have been tried once
+
+	// bootstrapInfo holds the addresses of the nodes learned through
+	// gossip, which are persisted to storage, if set, for use as
+	// bootstrap addresses after a restart.
+	bootstrapMu   sync.Mutex
+	bootstrapInfo BootstrapInfo
+	storage       Storage
 }
 
 // New creates an instance of a gossip node.
@@ -159,6 +166,8 @@ func New(rpcContext *rpc.Context, gossipInterval time.Duration, resolvers []reso
 
 	// Add ourselves as a SystemConfig watcher.
 	g.is.registerCallback(KeySystemConfig, g.updateSystemConfig)
+	// Record the addresses of the nodes for bootstrapping after a restart.
+	g.is.registerCallback(MakePrefixPattern(KeyNodeIDPrefix), g.updateBootstrapInfo)
 	return g
 }
 
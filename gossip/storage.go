@@ -0,0 +1,161 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package gossip
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip/resolver"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// maxBootstrapAddresses is the number of node addresses kept in the
+	// bootstrap information; the least recently seen are dropped.
+	maxBootstrapAddresses = 32
+	// bootstrapAddressTTL is the duration after which the address of a
+	// node which hasn't been seen since is dropped.
+	bootstrapAddressTTL = 7 * 24 * time.Hour
+	// bootstrapAddressRefreshInterval is the interval at which the time a
+	// known address was last seen is persisted again.
+	bootstrapAddressRefreshInterval = time.Hour
+)
+
+// Storage is an interface which allows the gossip instance to read and
+// write the addresses of the nodes it learned about to persistent storage,
+// so that a restarted node can rejoin the gossip network even if none of
+// its configured bootstrap addresses is reachable.
+type Storage interface {
+	// ReadBootstrapInfo fetches the persisted bootstrap information. It
+	// leaves info empty if none was persisted.
+	ReadBootstrapInfo(info *BootstrapInfo) error
+	// WriteBootstrapInfo persists the bootstrap information.
+	WriteBootstrapInfo(info *BootstrapInfo) error
+}
+
+// SetStorage sets the storage of the bootstrap information. The persisted
+// addresses which haven't expired are merged with those learned so far and
+// are tried as bootstrap addresses after the configured resolvers.
+func (g *Gossip) SetStorage(storage Storage) error {
+	var info BootstrapInfo
+	if err := storage.ReadBootstrapInfo(&info); err != nil {
+		return err
+	}
+
+	g.bootstrapMu.Lock()
+	g.storage = storage
+	for _, a := range info.Addresses {
+		g.bootstrapInfo.update(a.Addr, a.LastSeen)
+	}
+	g.bootstrapInfo.prune(time.Now().UnixNano())
+	addrs := append([]BootstrapAddress(nil), g.bootstrapInfo.Addresses...)
+	err := storage.WriteBootstrapInfo(&g.bootstrapInfo)
+	g.bootstrapMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	known := map[string]struct{}{}
+	for _, r := range g.resolvers {
+		known[r.Addr()] = struct{}{}
+	}
+	for i := range addrs {
+		addr := addrs[i].Addr
+		if _, ok := known[addr.String()]; ok {
+			continue
+		}
+		known[addr.String()] = struct{}{}
+		g.resolvers = append(g.resolvers, resolver.NewResolverFromAddress(addr))
+	}
+	return nil
+}
+
+// updateBootstrapInfo is a callback for the gossiped node descriptors which
+// records the addresses of the nodes in the bootstrap information,
+// persisting it if a storage is set and the address is new or wasn't
+// persisted recently.
+func (g *Gossip) updateBootstrapInfo(key string, content []byte) {
+	var desc roachpb.NodeDescriptor
+	if err := proto.Unmarshal(content, &desc); err != nil {
+		log.Errorf("unable to unmarshal node descriptor %s: %s", key, err)
+		return
+	}
+	if desc.NodeID == g.GetNodeID() {
+		return
+	}
+
+	g.bootstrapMu.Lock()
+	defer g.bootstrapMu.Unlock()
+	now := time.Now().UnixNano()
+	changed := g.bootstrapInfo.update(desc.Address, now)
+	if g.bootstrapInfo.prune(now) {
+		changed = true
+	}
+	if !changed || g.storage == nil {
+		return
+	}
+	if err := g.storage.WriteBootstrapInfo(&g.bootstrapInfo); err != nil {
+		log.Warningf("unable to persist gossip bootstrap info: %s", err)
+	}
+}
+
+// update records that the node at addr was seen at lastSeen, keeping the
+// addresses ordered from the most to the least recently seen and dropping
+// the least recently seen ones beyond maxBootstrapAddresses. The time of a
+// known address is only updated once it is older than
+// bootstrapAddressRefreshInterval. Returns whether the info changed.
+func (bi *BootstrapInfo) update(addr util.UnresolvedAddr, lastSeen int64) bool {
+	for i, a := range bi.Addresses {
+		if a.Addr.String() != addr.String() {
+			continue
+		}
+		if lastSeen-a.LastSeen < int64(bootstrapAddressRefreshInterval) {
+			return false
+		}
+		bi.Addresses = append(bi.Addresses[:i], bi.Addresses[i+1:]...)
+		break
+	}
+	idx := sort.Search(len(bi.Addresses), func(i int) bool {
+		return bi.Addresses[i].LastSeen <= lastSeen
+	})
+	bi.Addresses = append(bi.Addresses, BootstrapAddress{})
+	copy(bi.Addresses[idx+1:], bi.Addresses[idx:])
+	bi.Addresses[idx] = BootstrapAddress{Addr: addr, LastSeen: lastSeen}
+	if len(bi.Addresses) > maxBootstrapAddresses {
+		bi.Addresses = bi.Addresses[:maxBootstrapAddresses]
+	}
+	return true
+}
+
+// prune drops the addresses which haven't been seen within
+// bootstrapAddressTTL of now. Returns whether any address was dropped.
+func (bi *BootstrapInfo) prune(now int64) bool {
+	// Addresses are ordered by decreasing time last seen.
+	idx := sort.Search(len(bi.Addresses), func(i int) bool {
+		return now-bi.Addresses[i].LastSeen > int64(bootstrapAddressTTL)
+	})
+	if idx == len(bi.Addresses) {
+		return false
+	}
+	bi.Addresses = bi.Addresses[:idx]
+	return true
+}
@@ -0,0 +1,140 @@
+// Copyright 2014 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package gossip
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/gossip/resolver"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// testStorage is an in-memory gossip Storage.
+type testStorage struct {
+	info   BootstrapInfo
+	writes int
+}
+
+func (s *testStorage) ReadBootstrapInfo(info *BootstrapInfo) error {
+	*info = s.info
+	return nil
+}
+
+func (s *testStorage) WriteBootstrapInfo(info *BootstrapInfo) error {
+	s.info = BootstrapInfo{Addresses: append([]BootstrapAddress(nil), info.Addresses...)}
+	s.writes++
+	return nil
+}
+
+func bootstrapAddrs(bi BootstrapInfo) []string {
+	var addrs []string
+	for _, a := range bi.Addresses {
+		addrs = append(addrs, a.Addr.String())
+	}
+	return addrs
+}
+
+func TestBootstrapInfoUpdate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	addr := func(port string) util.UnresolvedAddr {
+		return util.MakeUnresolvedAddr("tcp", "127.0.0.1:"+port)
+	}
+	now := int64(bootstrapAddressTTL) * 2
+	var bi BootstrapInfo
+	for i, port := range []string{"9000", "9001", "9002"} {
+		if !bi.update(addr(port), now+int64(i)) {
+			t.Errorf("expected new address %s to change the info", port)
+		}
+	}
+	if expected, addrs := []string{"127.0.0.1:9002", "127.0.0.1:9001", "127.0.0.1:9000"}, bootstrapAddrs(bi); !reflect.DeepEqual(expected, addrs) {
+		t.Errorf("expected %v; got %v", expected, addrs)
+	}
+
+	// A recently refreshed address isn't updated again; a stale one moves
+	// to the front.
+	if bi.update(addr("9000"), now+int64(time.Minute)) {
+		t.Error("expected recent refresh to leave the info unchanged")
+	}
+	if !bi.update(addr("9000"), now+int64(2*bootstrapAddressRefreshInterval)) {
+		t.Error("expected stale address to be refreshed")
+	}
+	if expected, addrs := []string{"127.0.0.1:9000", "127.0.0.1:9002", "127.0.0.1:9001"}, bootstrapAddrs(bi); !reflect.DeepEqual(expected, addrs) {
+		t.Errorf("expected %v; got %v", expected, addrs)
+	}
+
+	// Addresses not seen within the TTL are dropped.
+	if !bi.prune(now + int64(bootstrapAddressTTL) + int64(time.Second)) {
+		t.Error("expected addresses to be pruned")
+	}
+	if expected, addrs := []string{"127.0.0.1:9000"}, bootstrapAddrs(bi); !reflect.DeepEqual(expected, addrs) {
+		t.Errorf("expected %v; got %v", expected, addrs)
+	}
+
+	// The number of addresses is bounded.
+	bi = BootstrapInfo{}
+	for i := 0; i < maxBootstrapAddresses+5; i++ {
+		bi.update(util.MakeUnresolvedAddr("tcp", fmt.Sprintf("127.0.0.1:%d", 10000+i)), now+int64(i))
+	}
+	if len(bi.Addresses) != maxBootstrapAddresses {
+		t.Errorf("expected %d addresses; got %d", maxBootstrapAddresses, len(bi.Addresses))
+	}
+}
+
+// TestGossipSetStorage verifies that the persisted addresses are added to
+// the bootstrap resolvers and merged with the addresses learned before.
+func TestGossipSetStorage(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	configured, err := resolver.NewResolver(&base.Context{}, "127.0.0.1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := New(nil, TestInterval, []resolver.Resolver{configured})
+
+	now := time.Now().UnixNano()
+	g.bootstrapMu.Lock()
+	g.bootstrapInfo.update(util.MakeUnresolvedAddr("tcp", "127.0.0.1:9003"), now)
+	g.bootstrapMu.Unlock()
+
+	storage := &testStorage{info: BootstrapInfo{Addresses: []BootstrapAddress{
+		{Addr: util.MakeUnresolvedAddr("tcp", "127.0.0.1:9001"), LastSeen: now - int64(time.Minute)},
+		{Addr: util.MakeUnresolvedAddr("tcp", "127.0.0.1:9000"), LastSeen: now - int64(time.Hour)},
+		{Addr: util.MakeUnresolvedAddr("tcp", "127.0.0.1:9002"), LastSeen: now - int64(2*bootstrapAddressTTL)},
+	}}}
+	if err := g.SetStorage(storage); err != nil {
+		t.Fatal(err)
+	}
+
+	// The expired address is dropped and the merged info is persisted.
+	expected := []string{"127.0.0.1:9003", "127.0.0.1:9001", "127.0.0.1:9000"}
+	if addrs := bootstrapAddrs(storage.info); !reflect.DeepEqual(expected, addrs) {
+		t.Errorf("expected %v to be persisted; got %v", expected, addrs)
+	}
+	// The configured resolver comes first and isn't duplicated.
+	var resolvers []string
+	g.mu.Lock()
+	for _, r := range g.resolvers {
+		resolvers = append(resolvers, r.Addr())
+	}
+	g.mu.Unlock()
+	if expected := []string{"127.0.0.1:9000", "127.0.0.1:9003", "127.0.0.1:9001"}; !reflect.DeepEqual(expected, resolvers) {
+		t.Errorf("expected resolvers %v; got %v", expected, resolvers)
+	}
+}
@@ -56,6 +56,13 @@ var (
 	// localStoreIdentSuffix stores an immutable identifier for this
 	// store, created when the store is first bootstrapped.
 	localStoreIdentSuffix = []byte("iden")
+	// localStoreReplicaDescriptorSuffix is the suffix for the store-local
+	// index of the range descriptors of the store's replicas. The Range
+	// ID is appended as additional detail.
+	localStoreReplicaDescriptorSuffix = []byte("rdsc")
+	// localStoreGossipSuffix stores the gossip bootstrap information: the
+	// addresses of the nodes learned through gossip.
+	localStoreGossipSuffix = []byte("goss")
 
 	// LocalRangeIDPrefix is the prefix identifying per-range data
 	// indexed by Range ID. The Range ID is appended to this prefix,
@@ -72,6 +79,9 @@ var (
 	// NOTE: if this value changes, it must be updated in C++
 	// (storage/engine/rocksdb/db.cc).
 	LocalResponseCacheSuffix = []byte("res-")
+	// localSequenceCacheSuffix is the suffix for keys storing the highest
+	// sequence number applied per transaction (see SequenceCache).
+	localSequenceCacheSuffix = []byte("seq-")
 	// localRaftLeaderLeaseSuffix is the suffix for the raft leader lease.
 	localRaftLeaderLeaseSuffix = []byte("rfll")
 	// localRaftTombstoneSuffix is the suffix for the raft tombstone.
@@ -91,6 +101,15 @@ var (
 	// localRangeLastVerificationTimestampSuffix is the suffix for a range's
 	// last verification timestamp (for checking integrity of on-disk data).
 	localRangeLastVerificationTimestampSuffix = []byte("rlvt")
+	// localRangePendingGCSuffix is the suffix for the marker of a replica
+	// which was removed from its range and whose data is awaiting garbage
+	// collection.
+	localRangePendingGCSuffix = []byte("rpgc")
+	// localRangeQueueExclusionSuffix is the suffix for keys recording the
+	// exclusion of a range from a replica queue. The additional detail is
+	// the name of the queue. The value is the timestamp at which the
+	// exclusion was set.
+	localRangeQueueExclusionSuffix = []byte("rqex")
 	// localRangeStatsSuffix is the suffix for range statistics.
 	localRangeStatsSuffix = []byte("stat")
 
@@ -111,11 +130,16 @@ var (
 	// localRangeTreeNodeSuffix is the suffix for keys storing
 	// range tree nodes.  The value is a struct of type RangeTreeNode.
 	localRangeTreeNodeSuffix = roachpb.RKey("rtn-")
-	// localTransactionSuffix specifies the key suffix for
+	// localRangeChangeHistorySuffix is the suffix for keys storing the
+	// recent history of replica changes of a range. The additional detail
+	// is the encoded timestamp of the change. The value is a struct of
+	// type storage.RangeLogEvent.
+	localRangeChangeHistorySuffix = roachpb.RKey("rchh")
+	// LocalTransactionSuffix specifies the key suffix for
 	// transaction records. The additional detail is the transaction id.
 	// NOTE: if this value changes, it must be updated in C++
 	// (storage/engine/rocksdb/db.cc).
-	localTransactionSuffix = roachpb.RKey("txn-")
+	LocalTransactionSuffix = roachpb.RKey("txn-")
 
 	// LocalMax is the end of the local key range.
 	LocalMax = roachpb.Key(localPrefix).PrefixEnd()
@@ -156,6 +180,9 @@ var (
 	StoreIDGenerator = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("store-idgen")))
 	// RangeTreeRoot specifies the root range in the range tree.
 	RangeTreeRoot = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("range-tree-root")))
+	// RowTTLLeaseKey holds the lease of the node which deletes the expired
+	// rows of the tables with a row TTL.
+	RowTTLLeaseKey = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("row-ttl-lease")))
 
 	// StatusPrefix specifies the key prefix to store all status details.
 	StatusPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("status-")))
@@ -164,6 +191,21 @@ var (
 	// StatusNodePrefix stores all status info for nodes.
 	StatusNodePrefix = roachpb.Key(MakeKey(StatusPrefix, roachpb.RKey("node-")))
 
+	// NodeLivenessPrefix specifies the key prefix for the node liveness
+	// records which back epoch-based leader leases.
+	NodeLivenessPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("liveness-")))
+	// NodeLivenessKeyMax is the end of the node liveness span.
+	NodeLivenessKeyMax = NodeLivenessPrefix.PrefixEnd()
+
+	// RangeLogPrefix specifies the key prefix for the range event log,
+	// which records range lifecycle events ordered by time.
+	RangeLogPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("rangelog-")))
+	// RangeLogKeyMax is the end of the range event log span.
+	RangeLogKeyMax = RangeLogPrefix.PrefixEnd()
+
+	// TimeseriesPrefix specifies the key prefix for time series data.
+	TimeseriesPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("tsd")))
+
 	// TableDataPrefix prefixes all table data. It is specifically chosen to
 	// occur after the range of common user data prefixes so that tests which use
 	// those prefixes will not see table data.
@@ -181,16 +223,27 @@ const (
 	// It is here only so that we may define keys/ranges using it.
 	MaxReservedDescID = 999
 
+	// MaxSystemConfigDescID is the maximum ID of the system tables whose
+	// rows are part of the system config, which is gossiped to all nodes.
+	// The rows of the system tables with greater IDs, which can be large or
+	// frequently written, are not gossiped.
+	MaxSystemConfigDescID = ZonesTableID
+
 	// RootNamespaceID is the ID of the root namespace.
 	RootNamespaceID = 0
 
 	// SystemDatabaseID and following are the database/table IDs for objects
 	// in the system span.
 	// NOTE: IDs should remain <= MaxReservedDescID.
-	SystemDatabaseID  = 1
-	NamespaceTableID  = 2
-	DescriptorTableID = 3
-	LeaseTableID      = 4
-	UsersTableID      = 5
-	ZonesTableID      = 6
+	SystemDatabaseID       = 1
+	NamespaceTableID       = 2
+	DescriptorTableID      = 3
+	LeaseTableID           = 4
+	UsersTableID           = 5
+	ZonesTableID           = 6
+	JobsTableID            = 7
+	RoleMembersTableID     = 8
+	NodesTableID           = 9
+	EventLogTableID        = 10
+	TableStatisticsTableID = 11
 )
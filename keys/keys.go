@@ -45,6 +45,24 @@ func StoreIdentKey() roachpb.Key {
 	return MakeStoreKey(localStoreIdentSuffix, roachpb.RKey{})
 }
 
+// StoreGossipKey returns a store-local key for the gossip bootstrap
+// information.
+func StoreGossipKey() roachpb.Key {
+	return MakeStoreKey(localStoreGossipSuffix, nil)
+}
+
+// StoreReplicaDescriptorPrefix returns the store-local key prefix for
+// the index of the range descriptors of the store's replicas.
+func StoreReplicaDescriptorPrefix() roachpb.Key {
+	return MakeStoreKey(localStoreReplicaDescriptorSuffix, nil)
+}
+
+// StoreReplicaDescriptorKey returns a store-local key for the indexed
+// range descriptor of the store's replica of the specified range.
+func StoreReplicaDescriptorKey(rangeID roachpb.RangeID) roachpb.Key {
+	return MakeStoreKey(localStoreReplicaDescriptorSuffix, encoding.EncodeUvarint(nil, uint64(rangeID)))
+}
+
 // StoreStatusKey returns the key for accessing the store status for the
 // specified store ID.
 func StoreStatusKey(storeID int32) roachpb.Key {
@@ -57,12 +75,40 @@ func NodeStatusKey(nodeID int32) roachpb.Key {
 	return MakeKey(StatusNodePrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
 }
 
+// NodeLivenessKey returns the key for the liveness record of the
+// specified node ID.
+func NodeLivenessKey(nodeID roachpb.NodeID) roachpb.Key {
+	return MakeKey(NodeLivenessPrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
+}
+
+// RangeLogKey returns the key for the range event log entry recording an
+// event on the given range at the given timestamp. Entries sort by
+// timestamp.
+func RangeLogKey(timestamp roachpb.Timestamp, rangeID roachpb.RangeID) roachpb.Key {
+	key := MakeKey(RangeLogPrefix, encoding.EncodeUvarint(nil, uint64(timestamp.WallTime)))
+	key = encoding.EncodeUvarint(key, uint64(timestamp.Logical))
+	return encoding.EncodeUvarint(key, uint64(rangeID))
+}
+
 // MakeRangeIDPrefix creates a range-local key prefix from
 // rangeID.
 func MakeRangeIDPrefix(rangeID roachpb.RangeID) roachpb.Key {
 	return MakeKey(LocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(rangeID)))
 }
 
+// DecodeRangeIDPrefix decodes the Range ID from a key created with
+// MakeRangeIDPrefix or MakeRangeIDKey.
+func DecodeRangeIDPrefix(key roachpb.Key) (roachpb.RangeID, error) {
+	if !bytes.HasPrefix(key, LocalRangeIDPrefix) {
+		return 0, util.Errorf("key %q does not have %q prefix", key, LocalRangeIDPrefix)
+	}
+	_, rangeID, err := encoding.DecodeUvarint(key[len(LocalRangeIDPrefix):])
+	if err != nil {
+		return 0, err
+	}
+	return roachpb.RangeID(rangeID), nil
+}
+
 // MakeRangeIDKey creates a range-local key based on the range's
 // Range ID, metadata key suffix, and optional detail (e.g. the
 // encoded command ID for a response cache entry, etc.).
@@ -133,6 +179,14 @@ func ResponseCacheKey(rangeID roachpb.RangeID, cmdID *roachpb.ClientCmdID) roach
 	return MakeRangeIDKey(rangeID, LocalResponseCacheSuffix, detail)
 }
 
+// SequenceCacheKey returns a range-local key by Range ID for a
+// sequence cache entry, with detail specified by the supplied
+// transaction ID. A nil transaction ID yields the prefix of all sequence
+// cache entries for the range.
+func SequenceCacheKey(rangeID roachpb.RangeID, txnID []byte) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localSequenceCacheSuffix, roachpb.RKey(txnID))
+}
+
 // MakeRangeKey creates a range-local key based on the range
 // start key, metadata key suffix, and optional detail (e.g. the
 // transaction ID for a txn record, etc.).
@@ -178,18 +232,51 @@ func RangeGCMetadataKey(rangeID roachpb.RangeID) roachpb.Key {
 	return MakeRangeIDKey(rangeID, localRangeGCMetadataSuffix, roachpb.RKey{})
 }
 
+// RangePendingGCKey returns a range-local key for the marker of a replica
+// pending garbage collection.
+func RangePendingGCKey(rangeID roachpb.RangeID) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localRangePendingGCSuffix, roachpb.RKey{})
+}
+
 // RangeLastVerificationTimestampKey returns a range-local key for
 // the range's last verification timestamp.
 func RangeLastVerificationTimestampKey(rangeID roachpb.RangeID) roachpb.Key {
 	return MakeRangeIDKey(rangeID, localRangeLastVerificationTimestampSuffix, roachpb.RKey{})
 }
 
+// RangeQueueExclusionKey returns a range-local key recording the exclusion
+// of the range from the named replica queue.
+func RangeQueueExclusionKey(rangeID roachpb.RangeID, queue string) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localRangeQueueExclusionSuffix, roachpb.RKey(queue))
+}
+
+// RangeQueueExclusionPrefix returns the prefix of the keys recording the
+// queue exclusions of the range.
+func RangeQueueExclusionPrefix(rangeID roachpb.RangeID) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localRangeQueueExclusionSuffix, roachpb.RKey{})
+}
+
 // RangeTreeNodeKey returns a range-local key for the the range's
 // node in the range tree.
 func RangeTreeNodeKey(key roachpb.RKey) roachpb.Key {
 	return MakeRangeKey(key, localRangeTreeNodeSuffix, roachpb.RKey{})
 }
 
+// RangeChangeHistoryKey returns a range-local key for the entry recording
+// a replica change of the range with the specified start key at the given
+// timestamp. Entries sort by timestamp.
+func RangeChangeHistoryKey(key roachpb.RKey, timestamp roachpb.Timestamp) roachpb.Key {
+	detail := encoding.EncodeUvarint(nil, uint64(timestamp.WallTime))
+	detail = encoding.EncodeUvarint(detail, uint64(timestamp.Logical))
+	return MakeRangeKey(key, localRangeChangeHistorySuffix, detail)
+}
+
+// RangeChangeHistoryPrefix returns the prefix of the keys recording the
+// replica changes of the range with the specified start key.
+func RangeChangeHistoryPrefix(key roachpb.RKey) roachpb.Key {
+	return MakeRangeKey(key, localRangeChangeHistorySuffix, roachpb.RKey{})
+}
+
 // RangeDescriptorKey returns a range-local key for the descriptor
 // for the range with specified key.
 func RangeDescriptorKey(key roachpb.RKey) roachpb.Key {
@@ -200,7 +287,7 @@ func RangeDescriptorKey(key roachpb.RKey) roachpb.Key {
 // transaction key and ID. The base key is encoded in order to
 // guarantee that all transaction records for a range sort together.
 func TransactionKey(key roachpb.Key, id []byte) roachpb.Key {
-	return MakeRangeKey(Addr(key), localTransactionSuffix, roachpb.RKey(id))
+	return MakeRangeKey(Addr(key), LocalTransactionSuffix, roachpb.RKey(id))
 }
 
 // Addr returns the address for the key, used to lookup the range containing
@@ -63,6 +63,7 @@ func TestKeyAddress(t *testing.T) {
 		{roachpb.Key{}, roachpb.RKeyMin},
 		{roachpb.Key("123"), roachpb.RKey("123")},
 		{RangeDescriptorKey(roachpb.RKey("foo")), roachpb.RKey("foo")},
+		{RangeChangeHistoryKey(roachpb.RKey("bar"), roachpb.Timestamp{WallTime: 1}), roachpb.RKey("bar")},
 		{TransactionKey(roachpb.Key("baz"), uuid.NewUUID4()), roachpb.RKey("baz")},
 		{TransactionKey(roachpb.KeyMax, roachpb.RKey(uuid.NewUUID4())), roachpb.RKeyMax},
 		{nil, nil},
@@ -284,6 +285,54 @@ func TestValidateRangeMetaKey(t *testing.T) {
 	}
 }
 
+func TestDecodeRangeIDPrefix(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	for _, rangeID := range []roachpb.RangeID{1, 127, 128, 1 << 40} {
+		for _, key := range []roachpb.Key{
+			MakeRangeIDPrefix(rangeID),
+			RaftLogKey(rangeID, 10),
+			RaftHardStateKey(rangeID),
+		} {
+			decoded, err := DecodeRangeIDPrefix(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decoded != rangeID {
+				t.Errorf("%q: expected range ID %d, got %d", key, rangeID, decoded)
+			}
+		}
+	}
+	if _, err := DecodeRangeIDPrefix(RangeDescriptorKey(roachpb.RKey("a"))); err == nil {
+		t.Error("expected error decoding key without range ID prefix")
+	}
+}
+
+func TestPrettyPrint(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		key      roachpb.Key
+		expected string
+	}{
+		{StoreIdentKey(), "/Local/Store/StoreIdent"},
+		{StoreGossipKey(), "/Local/Store/Gossip"},
+		{RaftHardStateKey(5), "/Local/RangeID/5/RaftHardState"},
+		{RangeStatsKey(1 << 20), "/Local/RangeID/1048576/RangeStats"},
+		{RangeDescriptorKey(roachpb.RKey("a")), `/Local/Range/"a"/RangeDescriptor`},
+		{TransactionKey(roachpb.Key("b"), []byte("id")), `/Local/Range/"b"/Transaction/"id"`},
+		{RangeMetaKey(roachpb.RKey("c")), `/Meta2/"c"`},
+		{RangeMetaKey(roachpb.RKey(RangeMetaKey(roachpb.RKey("c")))), `/Meta1/"c"`},
+		{DescIDGenerator, `/System/"desc-idgen"`},
+		{roachpb.Key(MakeTablePrefix(51)), "/Table/51"},
+		{roachpb.Key(MakeKey(MakeTablePrefix(1000), roachpb.Key("x"))), `/Table/1000/"x"`},
+		{roachpb.Key("foo"), `"foo"`},
+	}
+	for i, test := range testCases {
+		if s := PrettyPrint(test.key); s != test.expected {
+			t.Errorf("%d: expected %s, got %s", i, test.expected, s)
+		}
+	}
+}
+
 func TestBatchRange(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	testCases := []struct {
@@ -0,0 +1,133 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// PrettyPrint returns a human-readable representation of the key,
+// rendering the well-known prefixes of the system keyspace by name
+// and decoding the embedded range IDs, range keys and table IDs. For
+// example, a range descriptor key renders as
+// "/Local/Range/\"a\"/RangeDescriptor" and a key in the table with
+// ID 51 as "/Table/51/...". Keys which cannot be decoded fall back to
+// their quoted representation.
+func PrettyPrint(key roachpb.Key) string {
+	switch {
+	case bytes.HasPrefix(key, localStorePrefix):
+		return "/Local/Store" + prettySuffix(storeSuffixNames, key[len(localStorePrefix):])
+	case bytes.HasPrefix(key, LocalRangeIDPrefix):
+		return "/Local/RangeID" + prettyRangeIDKey(key[len(LocalRangeIDPrefix):])
+	case bytes.HasPrefix(key, LocalRangePrefix):
+		return "/Local/Range" + prettyRangeKey(key[len(LocalRangePrefix):])
+	case bytes.HasPrefix(key, localPrefix):
+		return "/Local" + quoteRemainder(key[len(localPrefix):])
+	case bytes.HasPrefix(key, Meta1Prefix):
+		return "/Meta1" + quoteRemainder(key[len(Meta1Prefix):])
+	case bytes.HasPrefix(key, Meta2Prefix):
+		return "/Meta2" + quoteRemainder(key[len(Meta2Prefix):])
+	case bytes.HasPrefix(key, SystemPrefix):
+		return "/System" + quoteRemainder(key[len(SystemPrefix):])
+	case bytes.HasPrefix(key, TableDataPrefix):
+		return "/Table" + prettyTableKey(key[len(TableDataPrefix):])
+	}
+	return strconv.Quote(string(key))
+}
+
+// storeSuffixNames and localSuffixNames map the store-local and
+// range-local key suffixes to the names used when pretty printing.
+var storeSuffixNames = map[string]string{
+	string(localStoreIdentSuffix):             "StoreIdent",
+	string(localStoreReplicaDescriptorSuffix): "ReplicaDescriptor",
+	string(localStoreGossipSuffix):            "Gossip",
+}
+
+var localSuffixNames = map[string]string{
+	string(LocalResponseCacheSuffix):                  "ResponseCache",
+	string(localSequenceCacheSuffix):                  "SequenceCache",
+	string(localRaftLeaderLeaseSuffix):                "RaftLeaderLease",
+	string(localRaftTombstoneSuffix):                  "RaftTombstone",
+	string(localRaftHardStateSuffix):                  "RaftHardState",
+	string(localRaftAppliedIndexSuffix):               "RaftAppliedIndex",
+	string(localRaftLogSuffix):                        "RaftLog",
+	string(localRaftTruncatedStateSuffix):             "RaftTruncatedState",
+	string(localRaftLastIndexSuffix):                  "RaftLastIndex",
+	string(localRangeGCMetadataSuffix):                "RangeGCMetadata",
+	string(localRangeLastVerificationTimestampSuffix): "RangeLastVerificationTimestamp",
+	string(localRangePendingGCSuffix):                 "RangePendingGC",
+	string(localRangeQueueExclusionSuffix):            "RangeQueueExclusion",
+	string(localRangeStatsSuffix):                     "RangeStats",
+	string(LocalRangeDescriptorSuffix):                "RangeDescriptor",
+	string(localRangeTreeNodeSuffix):                  "RangeTreeNode",
+	string(localRangeChangeHistorySuffix):             "RangeChangeHistory",
+	string(LocalTransactionSuffix):                    "Transaction",
+}
+
+// prettySuffix renders a local key suffix (and its trailing detail).
+func prettySuffix(names map[string]string, b []byte) string {
+	if len(b) < localSuffixLength {
+		return quoteRemainder(b)
+	}
+	name, ok := names[string(b[:localSuffixLength])]
+	if !ok {
+		return quoteRemainder(b)
+	}
+	return "/" + name + quoteRemainder(b[localSuffixLength:])
+}
+
+// prettyRangeIDKey renders the remainder of a key which followed
+// LocalRangeIDPrefix.
+func prettyRangeIDKey(b []byte) string {
+	rest, rangeID, err := encoding.DecodeUvarint(b)
+	if err != nil {
+		return quoteRemainder(b)
+	}
+	return fmt.Sprintf("/%d", rangeID) + prettySuffix(localSuffixNames, rest)
+}
+
+// prettyRangeKey renders the remainder of a key which followed
+// LocalRangePrefix.
+func prettyRangeKey(b []byte) string {
+	rest, startKey, err := encoding.DecodeBytes(b, nil)
+	if err != nil {
+		return quoteRemainder(b)
+	}
+	return "/" + strconv.Quote(string(startKey)) + prettySuffix(localSuffixNames, rest)
+}
+
+// prettyTableKey renders the remainder of a key which followed
+// TableDataPrefix.
+func prettyTableKey(b []byte) string {
+	rest, tableID, err := encoding.DecodeUvarint(b)
+	if err != nil {
+		return quoteRemainder(b)
+	}
+	return fmt.Sprintf("/%d", tableID) + quoteRemainder(rest)
+}
+
+// quoteRemainder renders undecoded trailing key bytes, if any.
+func quoteRemainder(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return "/" + strconv.Quote(string(b))
+}
@@ -29,6 +29,13 @@ var (
 	// SystemDBSpan is the range of system objects for structured data.
 	SystemDBSpan = roachpb.Span{Key: TableDataPrefix, EndKey: UserTableDataMin}
 
+	// SystemConfigSpan is the portion of the SystemDBSpan holding the
+	// system config, which is gossiped whenever it changes.
+	SystemConfigSpan = roachpb.Span{
+		Key:    TableDataPrefix,
+		EndKey: roachpb.Key(MakeTablePrefix(MaxSystemConfigDescID + 1)),
+	}
+
 	// NoSplitSpans describes the ranges that should never be split.
 	// Meta1Span: needed to find other ranges.
 	// SystemDBSpan: system objects have interdepencies.
@@ -18,6 +18,8 @@
 package kv
 
 import (
+	"sync/atomic"
+
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/base"
@@ -29,25 +31,29 @@ import (
 )
 
 var allExternalMethods = [...]roachpb.Request{
-	roachpb.Get:              &roachpb.GetRequest{},
-	roachpb.Put:              &roachpb.PutRequest{},
-	roachpb.ConditionalPut:   &roachpb.ConditionalPutRequest{},
-	roachpb.Increment:        &roachpb.IncrementRequest{},
-	roachpb.Delete:           &roachpb.DeleteRequest{},
-	roachpb.DeleteRange:      &roachpb.DeleteRangeRequest{},
-	roachpb.Scan:             &roachpb.ScanRequest{},
-	roachpb.ReverseScan:      &roachpb.ReverseScanRequest{},
-	roachpb.BeginTransaction: &roachpb.BeginTransactionRequest{},
-	roachpb.EndTransaction:   &roachpb.EndTransactionRequest{},
-	roachpb.AdminSplit:       &roachpb.AdminSplitRequest{},
-	roachpb.AdminMerge:       &roachpb.AdminMergeRequest{},
+	roachpb.Get:                    &roachpb.GetRequest{},
+	roachpb.Put:                    &roachpb.PutRequest{},
+	roachpb.ConditionalPut:         &roachpb.ConditionalPutRequest{},
+	roachpb.Increment:              &roachpb.IncrementRequest{},
+	roachpb.Delete:                 &roachpb.DeleteRequest{},
+	roachpb.DeleteRange:            &roachpb.DeleteRangeRequest{},
+	roachpb.Scan:                   &roachpb.ScanRequest{},
+	roachpb.ReverseScan:            &roachpb.ReverseScanRequest{},
+	roachpb.BeginTransaction:       &roachpb.BeginTransactionRequest{},
+	roachpb.EndTransaction:         &roachpb.EndTransactionRequest{},
+	roachpb.AdminSplit:             &roachpb.AdminSplitRequest{},
+	roachpb.AdminMerge:             &roachpb.AdminMergeRequest{},
+	roachpb.AdminScatter:           &roachpb.AdminScatterRequest{},
+	roachpb.AdminSetQueueExclusion: &roachpb.AdminSetQueueExclusionRequest{},
 }
 
 // A DBServer provides an HTTP server endpoint serving the key-value API.
 // It accepts either JSON or serialized protobuf content types.
 type DBServer struct {
-	context *base.Context
-	sender  client.Sender
+	context  *base.Context
+	sender   client.Sender
+	draining int32 // Accessed atomically; non-zero while draining.
+	inFlight int32 // Accessed atomically; number of batches being executed.
 }
 
 // NewDBServer allocates and returns a new DBServer.
@@ -55,6 +61,21 @@ func NewDBServer(ctx *base.Context, sender client.Sender) *DBServer {
 	return &DBServer{context: ctx, sender: sender}
 }
 
+// SetDraining puts the server into (or takes it out of) draining mode.
+// While draining, all incoming batches are rejected.
+func (s *DBServer) SetDraining(drain bool) {
+	var v int32
+	if drain {
+		v = 1
+	}
+	atomic.StoreInt32(&s.draining, v)
+}
+
+// NumInFlight returns the number of batches currently being executed.
+func (s *DBServer) NumInFlight() int {
+	return int(atomic.LoadInt32(&s.inFlight))
+}
+
 // RegisterRPC registers the RPC endpoints.
 func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 	const method = "Server.Batch"
@@ -65,6 +86,13 @@ func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 // via the local sender.
 func (s *DBServer) executeCmd(argsI proto.Message) (proto.Message, error) {
 	ba := argsI.(*roachpb.BatchRequest)
+	// The batch is counted before the draining check so that a drain which
+	// observes no batches in flight won't miss this one.
+	atomic.AddInt32(&s.inFlight, 1)
+	defer atomic.AddInt32(&s.inFlight, -1)
+	if atomic.LoadInt32(&s.draining) != 0 {
+		return nil, util.Errorf("server is draining, not accepting new requests")
+	}
 	if err := verifyRequest(ba); err != nil {
 		return nil, err
 	}
@@ -115,6 +115,8 @@ type DistSender struct {
 	// outside of tests.
 	rpcSend         rpcSendFn
 	rpcRetryOptions retry.Options
+	// packedResponses asks nodes to return the rows of scans in packed form.
+	packedResponses bool
 }
 
 var _ client.Sender = &DistSender{}
@@ -142,6 +144,10 @@ type DistSenderContext struct {
 	// for testing purposes.
 	RPCSend           rpcSendFn
 	RangeDescriptorDB rangeDescriptorDB
+	// PackedResponses asks nodes to return the rows of scans in packed form,
+	// which is cheaper to encode and decode for large scans. Nodes unaware
+	// of packed responses return the rows as usual.
+	PackedResponses bool
 }
 
 // NewDistSender returns a batch.Sender instance which connects to the
@@ -188,6 +194,7 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 	if ctx.RPCRetryOptions != nil {
 		ds.rpcRetryOptions = *ctx.RPCRetryOptions
 	}
+	ds.packedResponses = ctx.PackedResponses
 
 	return ds
 }
@@ -329,6 +336,7 @@ func (ds *DistSender) sendRPC(trace *tracer.Trace, rangeID roachpb.RangeID, repl
 	// still route the request appropriately by key, but won't receive
 	// RangeNotFoundErrors.
 	ba.RangeID = rangeID
+	ba.PackedResponses = ds.packedResponses
 
 	// Set RPC opts with stipulation that one of N RPCs must succeed.
 	rpcOpts := rpc.Options{
@@ -373,7 +381,11 @@ func (ds *DistSender) sendRPC(trace *tracer.Trace, rangeID roachpb.RangeID, repl
 	if err != nil {
 		return nil, err
 	}
-	return replies[0].(*roachpb.BatchResponse), nil
+	br := replies[0].(*roachpb.BatchResponse)
+	if err := br.UnpackRows(); err != nil {
+		return nil, err
+	}
+	return br, nil
 }
 
 // getDescriptors looks up the range descriptor to use for a query over the
@@ -432,7 +444,7 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest,
 
 	// If this request needs to go to a leader and we know who that is, move
 	// it to the front.
-	if !(ba.IsReadOnly() && ba.ReadConsistency == roachpb.INCONSISTENT) &&
+	if !(ba.ReadConsistency == roachpb.INCONSISTENT && ba.IsFollowerReadable()) &&
 		leader.StoreID > 0 {
 		if i := replicas.FindReplica(leader.StoreID); i >= 0 {
 			replicas.MoveToFront(i)
@@ -447,6 +459,11 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest,
 	// Untangle the error from the received response.
 	pErr := br.Error
 	br.Error = nil // scrub the response error
+	if pErr != nil && pErr.Now != nil {
+		// Errors don't carry a transaction timestamp, so update our clock
+		// from the reading of the node which produced the error.
+		ds.clock.Update(*pErr.Now)
+	}
 	return br, pErr
 }
 
@@ -466,6 +483,14 @@ func (ds *DistSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roach
 		ba.Timestamp = ds.clock.Now()
 	}
 
+	// Record the node the batch enters the cluster at, which lets ranges
+	// move their leader lease towards the traffic they receive.
+	if ba.GatewayNodeID == 0 {
+		if desc := (*roachpb.NodeDescriptor)(atomic.LoadPointer(&ds.nodeDescriptor)); desc != nil {
+			ba.GatewayNodeID = desc.NodeID
+		}
+	}
+
 	// TODO(tschottdorf): provisional instantiation.
 	return newChunkingSender(ds.sendChunk).Send(ctx, ba)
 }
@@ -495,6 +520,15 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 		var needAnother bool
 		var pErr *roachpb.Error
 		for r := retry.Start(ds.rpcRetryOptions); r.Next(); {
+			// Stop once the client has given up on the request, e.g. because
+			// the SQL statement it was sent for timed out. An ambiguous
+			// previous attempt is reported as such below.
+			if err := ctx.Err(); err != nil {
+				if _, ok := pErr.GoError().(*roachpb.SendError); !ok {
+					pErr = roachpb.NewError(err)
+				}
+				break
+			}
 			// Get range descriptor (or, when spanning range, descriptors). Our
 			// error handling below may clear them on certain errors, so we
 			// refresh (likely from the cache) on every retry.
@@ -640,6 +674,12 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 
 		// Immediately return if querying a range failed non-retryably.
 		if pErr != nil {
+			if sErr, ok := pErr.GoError().(*roachpb.SendError); ok && !ba.IsReadOnly() {
+				// The RPCs which failed may have reached a replica before
+				// failing, so a write may or may not have been applied.
+				// Callers must not blindly retry it.
+				pErr = roachpb.NewError(&roachpb.AmbiguousResultError{Message: sErr.Error()})
+			}
 			return nil, pErr
 		}
 
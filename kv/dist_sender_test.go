@@ -517,6 +517,40 @@ func TestEvictCacheOnError(t *testing.T) {
 	}
 }
 
+// TestSendErrorAmbiguousForWrites verifies that an RPC error which ends
+// the attempts to send a write is returned as an AmbiguousResultError,
+// while the same error for a read is returned unchanged.
+func TestSendErrorAmbiguousForWrites(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, s := makeTestGossip(t)
+	defer s()
+
+	var testFn rpcSendFn = func(_ rpc.Options, _ string, _ []net.Addr, _ func(addr net.Addr) proto.Message, _ func() proto.Message, _ *rpc.Context) ([]proto.Message, error) {
+		return nil, rpc.NewSendError("boom", false)
+	}
+	ctx := &DistSenderContext{
+		RPCSend: testFn,
+		RangeDescriptorDB: mockRangeDescriptorDB(func(_ roachpb.RKey, _ lookupOptions) ([]roachpb.RangeDescriptor, error) {
+			return []roachpb.RangeDescriptor{testRangeDescriptor}, nil
+		}),
+	}
+	ds := NewDistSender(ctx, g)
+
+	put := roachpb.NewPut(roachpb.Key("a"), roachpb.MakeValueFromString("value"))
+	if _, err := client.SendWrapped(ds, nil, put); err == nil {
+		t.Fatal("expected put to fail")
+	} else if _, ok := err.(*roachpb.AmbiguousResultError); !ok {
+		t.Errorf("expected AmbiguousResultError for put; got %T: %s", err, err)
+	}
+
+	get := roachpb.NewGet(roachpb.Key("a"))
+	if _, err := client.SendWrapped(ds, nil, get); err == nil {
+		t.Fatal("expected get to fail")
+	} else if _, ok := err.(*roachpb.SendError); !ok {
+		t.Errorf("expected SendError for get; got %T: %s", err, err)
+	}
+}
+
 // TestRetryOnWrongReplicaError sets up a DistSender on a minimal gossip
 // network and a mock of rpc.Send, and verifies that the DistSender correctly
 // retries upon encountering a stale entry in its range descriptor cache.
@@ -24,9 +24,11 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/tracer"
@@ -40,6 +42,7 @@ type LocalSender struct {
 
 var _ client.Sender = &LocalSender{}
 var _ rangeDescriptorDB = &LocalSender{}
+var _ gossip.Storage = &LocalSender{}
 
 // NewLocalSender returns a local-only sender which directly accesses
 // a collection of stores.
@@ -107,6 +110,33 @@ func (ls *LocalSender) VisitStores(visitor func(s *storage.Store) error) error {
 	return nil
 }
 
+// ReadBootstrapInfo implements the gossip.Storage interface, reading the
+// gossip bootstrap information from the store which was updated most
+// recently.
+func (ls *LocalSender) ReadBootstrapInfo(bi *gossip.BootstrapInfo) error {
+	var latest int64
+	return ls.VisitStores(func(s *storage.Store) error {
+		var storeBI gossip.BootstrapInfo
+		ok, err := engine.MVCCGetProto(s.Engine(), keys.StoreGossipKey(), roachpb.ZeroTimestamp, true, nil, &storeBI)
+		if err != nil || !ok || len(storeBI.Addresses) == 0 {
+			return err
+		}
+		if lastSeen := storeBI.Addresses[0].LastSeen; lastSeen > latest {
+			latest = lastSeen
+			*bi = storeBI
+		}
+		return nil
+	})
+}
+
+// WriteBootstrapInfo implements the gossip.Storage interface, writing the
+// gossip bootstrap information to all stores.
+func (ls *LocalSender) WriteBootstrapInfo(bi *gossip.BootstrapInfo) error {
+	return ls.VisitStores(func(s *storage.Store) error {
+		return engine.MVCCPutProto(s.Engine(), nil, keys.StoreGossipKey(), roachpb.ZeroTimestamp, nil, bi)
+	})
+}
+
 // Send implements the client.Sender interface. The store is looked up from the
 // store map if specified by the request; otherwise, the command is being
 // executed locally, and the replica is determined via lookup through each
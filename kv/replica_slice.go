@@ -47,6 +47,10 @@ func newReplicaSlice(gossip *gossip.Gossip, desc *roachpb.RangeDescriptor) repli
 	}
 	replicas := make(replicaSlice, 0, len(desc.Replicas))
 	for _, r := range desc.Replicas {
+		if r.Witness {
+			// Witnesses hold no data and can't serve requests.
+			continue
+		}
 		nd, err := gossip.GetNodeDescriptor(r.NodeID)
 		if err != nil {
 			if log.V(1) {
@@ -150,6 +150,22 @@ func (tm *txnMetadata) intents() []roachpb.Intent {
 	return intents
 }
 
+// mergeIntents coalesces the overlapping and adjacent key ranges of the
+// given intents, which carry no transaction, so that intents covered by
+// others, for example {[a,b), a}, aren't resolved twice.
+func mergeIntents(intents []roachpb.Intent) []roachpb.Intent {
+	spans := make([]roachpb.Span, len(intents))
+	for i, intent := range intents {
+		spans[i] = roachpb.Span{Key: intent.Key, EndKey: intent.EndKey}
+	}
+	spans = roachpb.MergeSpans(spans)
+	merged := make([]roachpb.Intent, len(spans))
+	for i, span := range spans {
+		merged[i] = roachpb.Intent{Key: span.Key, EndKey: span.EndKey}
+	}
+	return merged
+}
+
 // txnCoordStats tallies up statistics about the transactions which have
 // completed on this sender.
 type txnCoordStats struct {
@@ -360,11 +376,7 @@ func (tc *TxnCoordSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*r
 				// outstanding intents to EndTransaction, though.
 				// TODO(tschottdorf): possible issues when the batch fails,
 				// but the intents have been added anyways.
-				// TODO(tschottdorf): some of these intents may be covered
-				// by others, for example {[a,b), a}). This can lead to
-				// some extra requests when those are non-local to the txn
-				// record. But it doesn't seem worth optimizing now.
-				et.Intents = append(et.Intents, intents...)
+				et.Intents = mergeIntents(append(et.Intents, intents...))
 			} else if !metaOK {
 				// If we don't have the transaction, then this must be a retry
 				// by the client. We can no longer reconstruct a correct
@@ -609,6 +621,9 @@ func (tc *TxnCoordSender) heartbeat(id string, trace *tracer.Trace, ctx context.
 	ba.Timestamp = tc.clock.Now()
 	ba.CmdID = ba.GetOrCreateCmdID(ba.Timestamp.WallTime)
 	ba.Txn = txn.Clone()
+	// Heartbeats are not part of the client's sequence of batches and
+	// must not be subjected to replay protection.
+	ba.Txn.Sequence = 0
 	ba.Add(hb)
 
 	epochEnds := trace.Epoch("heartbeat")
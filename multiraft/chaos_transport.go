@@ -0,0 +1,166 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// A ChaosRule describes the faults injected into the messages sent from
+// one store to another by a ChaosTransport.
+type ChaosRule struct {
+	// DropRate is the probability with which a message is dropped.
+	DropRate float64
+	// Delay is the maximum delay of a message; each message is delayed by
+	// a random duration up to Delay, which may reorder messages.
+	Delay time.Duration
+}
+
+type chaosLink struct {
+	from, to roachpb.StoreID
+}
+
+// ChaosTransport is a Transport for testing which wraps another Transport
+// and drops, delays or partitions the messages sent through it according
+// to rules which can be changed while the test runs. Dropped messages are
+// silently discarded, as a lossy network would.
+type ChaosTransport struct {
+	Transport
+	stopper *stop.Stopper
+
+	mu          sync.Mutex
+	rng         *rand.Rand
+	sides       map[roachpb.StoreID]int
+	rules       map[chaosLink]ChaosRule
+	defaultRule ChaosRule
+	dropped     int64
+	delayed     int64
+}
+
+// NewChaosTransport wraps the given Transport into a ChaosTransport which
+// initially delivers all messages. The faults are chosen by a pseudo-random
+// number generator seeded with the given seed, which tests should log so
+// that failures can be reproduced.
+func NewChaosTransport(wrapped Transport, stopper *stop.Stopper, seed int64) *ChaosTransport {
+	return &ChaosTransport{
+		Transport: wrapped,
+		stopper:   stopper,
+		rng:       rand.New(rand.NewSource(seed)),
+		sides:     map[roachpb.StoreID]int{},
+		rules:     map[chaosLink]ChaosRule{},
+	}
+}
+
+// Partition splits the stores into the given sides; messages between stores
+// on different sides are dropped. Stores which aren't listed form an
+// additional side of their own. A previous partition is replaced.
+func (ct *ChaosTransport) Partition(sides ...[]roachpb.StoreID) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.sides = map[roachpb.StoreID]int{}
+	for i, side := range sides {
+		for _, storeID := range side {
+			ct.sides[storeID] = i + 1
+		}
+	}
+}
+
+// SetRule sets the rule for the messages sent from one store to another,
+// overriding the default rule.
+func (ct *ChaosTransport) SetRule(from, to roachpb.StoreID, rule ChaosRule) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.rules[chaosLink{from, to}] = rule
+}
+
+// SetDefaultRule sets the rule for the messages between stores for which
+// no specific rule is set.
+func (ct *ChaosTransport) SetDefaultRule(rule ChaosRule) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.defaultRule = rule
+}
+
+// Heal removes all partitions and rules, so that all messages sent from
+// now on are delivered. Messages which are already delayed are still
+// delivered late.
+func (ct *ChaosTransport) Heal() {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.sides = map[roachpb.StoreID]int{}
+	ct.rules = map[chaosLink]ChaosRule{}
+	ct.defaultRule = ChaosRule{}
+}
+
+// Stats returns the number of messages dropped and delayed so far.
+func (ct *ChaosTransport) Stats() (dropped, delayed int64) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return ct.dropped, ct.delayed
+}
+
+// Send implements the Transport interface, applying the partitions and
+// rules in effect to the message.
+func (ct *ChaosTransport) Send(req *RaftMessageRequest) error {
+	drop, delay := ct.fate(req.FromReplica.StoreID, req.ToReplica.StoreID)
+	if drop {
+		return nil
+	}
+	if delay == 0 {
+		return ct.Transport.Send(req)
+	}
+	ct.stopper.RunAsyncTask(func() {
+		select {
+		case <-time.After(delay):
+		case <-ct.stopper.ShouldStop():
+			return
+		}
+		if err := ct.Transport.Send(req); err != nil {
+			log.Warningf("sending delayed message failed: %s", err)
+		}
+	})
+	return nil
+}
+
+// fate decides whether the message between the given stores is dropped
+// and for how long it is delayed otherwise.
+func (ct *ChaosTransport) fate(from, to roachpb.StoreID) (bool, time.Duration) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.sides[from] != ct.sides[to] {
+		ct.dropped++
+		return true, 0
+	}
+	rule, ok := ct.rules[chaosLink{from, to}]
+	if !ok {
+		rule = ct.defaultRule
+	}
+	if rule.DropRate > 0 && ct.rng.Float64() < rule.DropRate {
+		ct.dropped++
+		return true, 0
+	}
+	if rule.Delay > 0 {
+		ct.delayed++
+		return false, time.Duration(ct.rng.Int63n(int64(rule.Delay)) + 1)
+	}
+	return false, 0
+}
@@ -0,0 +1,103 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// channelTransport delivers the messages it is asked to send on a channel.
+type channelTransport struct {
+	Transport
+	sent chan *RaftMessageRequest
+}
+
+func (t *channelTransport) Send(req *RaftMessageRequest) error {
+	t.sent <- req
+	return nil
+}
+
+func chaosMessage(from, to roachpb.StoreID) *RaftMessageRequest {
+	return &RaftMessageRequest{
+		FromReplica: roachpb.ReplicaDescriptor{StoreID: from},
+		ToReplica:   roachpb.ReplicaDescriptor{StoreID: to},
+	}
+}
+
+// TestChaosTransportPartition verifies that messages are dropped between
+// the sides of a partition, and delivered again once it heals.
+func TestChaosTransportPartition(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	wrapped := &recordingTransport{}
+	ct := NewChaosTransport(wrapped, stopper, 0)
+
+	ct.Partition([]roachpb.StoreID{1})
+	for _, link := range [][2]roachpb.StoreID{{1, 2}, {2, 1}, {2, 3}, {3, 1}} {
+		if err := ct.Send(chaosMessage(link[0], link[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(wrapped.sent) != 1 || wrapped.sent[0].ToReplica.StoreID != 3 {
+		t.Errorf("expected only the message from 2 to 3 to be delivered; got %+v", wrapped.sent)
+	}
+	if dropped, _ := ct.Stats(); dropped != 3 {
+		t.Errorf("expected 3 dropped messages; got %d", dropped)
+	}
+
+	ct.Heal()
+	if err := ct.Send(chaosMessage(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if len(wrapped.sent) != 2 {
+		t.Errorf("expected message to be delivered after healing; got %+v", wrapped.sent)
+	}
+}
+
+// TestChaosTransportRules verifies that links drop and delay messages
+// according to their rules.
+func TestChaosTransportRules(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	wrapped := &channelTransport{sent: make(chan *RaftMessageRequest, 10)}
+	ct := NewChaosTransport(wrapped, stopper, 0)
+
+	ct.SetDefaultRule(ChaosRule{DropRate: 1})
+	ct.SetRule(1, 2, ChaosRule{Delay: 10 * time.Millisecond})
+	for _, link := range [][2]roachpb.StoreID{{1, 3}, {2, 1}, {1, 2}} {
+		if err := ct.Send(chaosMessage(link[0], link[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case req := <-wrapped.sent:
+		if req.FromReplica.StoreID != 1 || req.ToReplica.StoreID != 2 {
+			t.Errorf("unexpected message delivered: %+v", req)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("delayed message was not delivered")
+	}
+	if dropped, delayed := ct.Stats(); dropped != 2 || delayed != 1 {
+		t.Errorf("expected 2 dropped and 1 delayed messages; got %d and %d", dropped, delayed)
+	}
+}
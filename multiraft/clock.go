@@ -17,7 +17,11 @@
 
 package multiraft
 
-import "time"
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/randutil"
+)
 
 // Ticker encapsulates the timing-related parts of the raft protocol.
 type Ticker interface {
@@ -46,6 +50,54 @@ func (t *realTicker) Close() {
 	t.Ticker.Stop()
 }
 
+// spreadTicker is a Ticker which fires once per interval, at a random
+// offset in [0, spread) from the start of each interval. Like
+// time.Ticker, it drops ticks for slow receivers.
+type spreadTicker struct {
+	ch      chan time.Time
+	stopper chan struct{}
+}
+
+func newSpreadTicker(interval, spread time.Duration) Ticker {
+	t := &spreadTicker{
+		ch:      make(chan time.Time, 1),
+		stopper: make(chan struct{}),
+	}
+	go t.run(interval, spread)
+	return t
+}
+
+func (t *spreadTicker) run(interval, spread time.Duration) {
+	rng, _ := randutil.NewPseudoRand()
+	start := time.Now()
+	for {
+		start = start.Add(interval)
+		// Don't try to catch up on intervals missed during a stall.
+		if now := time.Now(); start.Before(now) {
+			start = now
+		}
+		timer := time.NewTimer(start.Sub(time.Now()) + time.Duration(rng.Int63n(int64(spread))))
+		select {
+		case now := <-timer.C:
+			select {
+			case t.ch <- now:
+			default:
+			}
+		case <-t.stopper:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (t *spreadTicker) Chan() <-chan time.Time {
+	return t.ch
+}
+
+func (t *spreadTicker) Close() {
+	close(t.stopper)
+}
+
 // manualTicker is a fake implementation of the Ticker interface.  With this ticker
 // time does not flow normally, but time-based events can be triggered manually with
 // the Tick method.
@@ -20,12 +20,17 @@ package multiraft
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/cache"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
@@ -37,6 +42,11 @@ const (
 
 	reqBufferSize = 100
 
+	// maxStepBatchSize is the maximum number of incoming messages which
+	// are drained from the request channel and stepped in a single
+	// iteration of the state loop.
+	maxStepBatchSize = reqBufferSize
+
 	// TODO(bdarnell): Determine the right size for this cache. Should
 	// the cache be partitioned so that replica descriptors from the
 	// range descriptors (which are the bulk of the data and can be
@@ -46,14 +56,51 @@ const (
 	maxReplicaDescCacheSize = 1000
 )
 
+// defaultListenRetryOptions are the retry options used when the Transport
+// fails to listen and Config.ListenRetryOptions is not set.
+var defaultListenRetryOptions = retry.Options{
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Multiplier:     2,
+	MaxRetries:     5,
+}
+
 // ErrGroupDeleted is returned for commands which are pending while their
 // group is deleted.
 var ErrGroupDeleted = errors.New("raft group deleted")
 
+// ErrGroupNotFound is returned for commands proposed to a group which
+// doesn't exist on this node, or of which this node is not a member.
+var ErrGroupNotFound = errors.New("raft group not found")
+
 // ErrStopped is returned for commands that could not be completed before the
 // node was stopped.
 var ErrStopped = errors.New("raft processing stopped")
 
+// ErrProposalDropped is returned for commands which raft refused to
+// propose. The command was not appended to the log and may be retried.
+var ErrProposalDropped = errors.New("raft proposal dropped")
+
+// ErrNotLeader is returned for membership changes proposed while the group
+// has no known leader, which raft would drop. Leader is the last known
+// leader of the group, or all zeros if there is none.
+type ErrNotLeader struct {
+	Leader roachpb.ReplicaDescriptor
+}
+
+// Error implements the error interface.
+func (e *ErrNotLeader) Error() string {
+	if e.Leader.ReplicaID == 0 {
+		return "raft group has no leader"
+	}
+	return fmt.Sprintf("raft group leader is %s", e.Leader)
+}
+
+// ErrProposalThrottled is returned for commands which are rejected because
+// the uncommitted commands of their group exceed MaxUncommittedBytes. The
+// command may be retried once the group has caught up.
+var ErrProposalThrottled = errors.New("raft group has too many uncommitted commands")
+
 // Config contains the parameters necessary to construct a MultiRaft object.
 type Config struct {
 	Storage   Storage
@@ -76,6 +123,31 @@ type Config struct {
 	HeartbeatIntervalTicks int
 	TickInterval           time.Duration
 
+	// ElectionTimeoutJitterTicks, if positive, adds a random number of
+	// ticks in [0, ElectionTimeoutJitterTicks) to the election timeout
+	// of each group. Raft seeds its own randomization with the replica
+	// ID, so without this all groups in which this node holds the same
+	// replica ID time out in lockstep.
+	ElectionTimeoutJitterTicks int
+	// TickSpread, if positive, delays each tick of the real ticker by a
+	// random duration in [0, TickSpread) so that the stores of a cluster
+	// do not process their ticks (and call elections) at the same
+	// instant. Must be less than TickInterval. Ignored if Ticker is set.
+	TickSpread time.Duration
+
+	// MaxUncommittedBytes, if positive, bounds the size of the commands
+	// which have been proposed to a group but not committed yet. Further
+	// proposals are rejected with ErrProposalThrottled, so that a leader
+	// which cannot reach a quorum doesn't buffer an unbounded number of
+	// proposals in memory. A single command is always accepted, however
+	// large.
+	MaxUncommittedBytes int
+
+	// ListenRetryOptions controls the retries of Transport.Listen, which
+	// may fail transiently (e.g. on a port conflict). Zero options use
+	// defaultListenRetryOptions.
+	ListenRetryOptions retry.Options
+
 	EntryFormatter raft.EntryFormatter
 }
 
@@ -94,6 +166,15 @@ func (c *Config) validate() error {
 	if c.TickInterval <= 0 {
 		return util.Errorf("TickInterval must be greater than zero")
 	}
+	if c.ElectionTimeoutJitterTicks < 0 {
+		return util.Errorf("ElectionTimeoutJitterTicks must not be negative")
+	}
+	if c.TickSpread < 0 || c.TickSpread >= c.TickInterval {
+		return util.Errorf("TickSpread must be in [0, TickInterval)")
+	}
+	if c.MaxUncommittedBytes < 0 {
+		return util.Errorf("MaxUncommittedBytes must not be negative")
+	}
 	return nil
 }
 
@@ -112,6 +193,15 @@ type MultiRaft struct {
 	proposalChan    chan *proposal
 	// callbackChan is a generic hook to run a callback in the raft thread.
 	callbackChan chan func()
+	// peerHealthChan receives peer health reports from the Transport.
+	peerHealthChan chan peerHealth
+	// msgStats counts the raft messages exchanged with other stores.
+	msgStats messageStats
+	// loopStats records the time the state loop spends in each stage.
+	loopStats loopStats
+	// listening is non-zero while the store is registered with the
+	// Transport. Accessed atomically.
+	listening int32
 }
 
 // multiraftServer is a type alias to separate RPC methods
@@ -131,7 +221,11 @@ func NewMultiRaft(nodeID roachpb.NodeID, storeID roachpb.StoreID, config *Config
 	}
 
 	if config.Ticker == nil {
-		config.Ticker = newTicker(config.TickInterval)
+		if config.TickSpread > 0 {
+			config.Ticker = newSpreadTicker(config.TickInterval, config.TickSpread)
+		} else {
+			config.Ticker = newTicker(config.TickInterval)
+		}
 		stopper.AddCloser(config.Ticker)
 	}
 
@@ -164,20 +258,71 @@ func NewMultiRaft(nodeID roachpb.NodeID, storeID roachpb.StoreID, config *Config
 		removeGroupChan: make(chan *removeGroupOp),
 		proposalChan:    make(chan *proposal),
 		callbackChan:    make(chan func()),
+		peerHealthChan:  make(chan peerHealth),
 	}
 
-	if err := m.Transport.Listen(storeID, (*multiraftServer)(m)); err != nil {
+	if err := m.listen(); err != nil {
+		m.multiNode.Stop()
 		return nil, err
 	}
 
 	return m, nil
 }
 
+// listen registers the store with the Transport, retrying with backoff
+// according to ListenRetryOptions if the Transport fails to listen.
+func (m *MultiRaft) listen() error {
+	opts := m.ListenRetryOptions
+	if opts == (retry.Options{}) {
+		opts = defaultListenRetryOptions
+	}
+	opts.Stopper = m.stopper
+	var err error
+	for r := retry.Start(opts); r.Next(); {
+		if err = m.Transport.Listen(m.storeID, (*multiraftServer)(m)); err == nil {
+			atomic.StoreInt32(&m.listening, 1)
+			return nil
+		}
+		log.Warningf("node %s: store %s failed to listen: %s", m.nodeID, m.storeID, err)
+	}
+	return util.Errorf("store %s failed to listen: %s", m.storeID, err)
+}
+
+// Rebind unregisters the store from the Transport and registers it again,
+// retrying as NewMultiRaft does. It allows a store to recover its raft
+// endpoint, e.g. after the Transport's listener failed, without
+// restarting the process. On error, the store is left unregistered and
+// Rebind may be called again.
+func (m *MultiRaft) Rebind() error {
+	if atomic.SwapInt32(&m.listening, 0) != 0 {
+		m.Transport.Stop(m.storeID)
+	}
+	return m.listen()
+}
+
+// Listening returns whether the store is registered with the Transport and
+// can receive raft messages.
+func (m *MultiRaft) Listening() bool {
+	return atomic.LoadInt32(&m.listening) != 0
+}
+
 // Start runs the raft algorithm in a background goroutine.
 func (m *MultiRaft) Start() {
 	newState(m).start()
 }
 
+// MessageStats returns the number of raft messages exchanged with each
+// peer store, broken down by message type and outcome.
+func (m *MultiRaft) MessageStats() MessageStats {
+	return m.msgStats.snapshot()
+}
+
+// LoopStats returns the number of times the state loop entered each stage
+// of its processing and the time it spent there.
+func (m *MultiRaft) LoopStats() LoopStats {
+	return m.loopStats.snapshot()
+}
+
 // RaftMessage implements ServerInterface; this method is called by net/rpc
 // when we receive a message. It returns as soon as the request has been
 // enqueued without waiting for it to be processed.
@@ -241,10 +386,13 @@ func (s *state) fanoutHeartbeat(req *RaftMessageRequest) {
 			}
 
 			if err := s.multiNode.Step(context.Background(), uint64(groupID), groupMsg); err != nil {
+				s.msgStats.record(req.FromReplica.StoreID, groupMsg.Type, MessageStepFailed)
 				if log.V(4) {
 					log.Infof("node %v: coalesced heartbeat step to group %v failed for message %s", s.nodeID, groupID,
 						raft.DescribeMessage(req.Message, s.EntryFormatter))
 				}
+			} else {
+				s.msgStats.record(req.FromReplica.StoreID, groupMsg.Type, MessageStepped)
 			}
 		}
 	}
@@ -273,6 +421,7 @@ func (s *state) fanoutHeartbeatResponse(req *RaftMessageRequest) {
 	fromID := roachpb.NodeID(req.Message.From)
 	originNode, ok := s.nodes[fromID]
 	if !ok {
+		s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
 		log.Warningf("node %v: not fanning out heartbeat response from unknown node %v",
 			s.nodeID, fromID)
 		return
@@ -314,9 +463,12 @@ func (s *state) fanoutHeartbeatResponse(req *RaftMessageRequest) {
 		}
 
 		if err := s.multiNode.Step(context.Background(), uint64(groupID), msg); err != nil {
+			s.msgStats.record(req.FromReplica.StoreID, msg.Type, MessageStepFailed)
 			if log.V(4) {
 				log.Infof("node %v: coalesced heartbeat response step to group %v failed", s.nodeID, groupID)
 			}
+		} else {
+			s.msgStats.record(req.FromReplica.StoreID, msg.Type, MessageStepped)
 		}
 		cnt++
 	}
@@ -353,28 +505,30 @@ func (m *MultiRaft) RemoveGroup(groupID roachpb.RangeID) error {
 // SubmitCommand sends a command (a binary blob) to the cluster. This method returns
 // when the command has been successfully sent, not when it has been committed.
 // An error or nil will be written to the returned channel when the command has
-// been committed or aborted.
+// been committed or aborted. The command is aborted with ErrGroupNotFound,
+// ErrGroupDeleted, ErrStopped, ErrProposalThrottled or ErrProposalDropped.
 func (m *MultiRaft) SubmitCommand(groupID roachpb.RangeID, commandID string, command []byte) <-chan error {
 	if log.V(6) {
 		log.Infof("node %v submitting command to group %v", m.nodeID, groupID)
 	}
 	ch := make(chan error, 1)
-	m.proposalChan <- &proposal{
+	m.submitProposal(&proposal{
 		groupID:   groupID,
 		commandID: commandID,
-		fn: func() {
-			if err := m.multiNode.Propose(context.Background(), uint64(groupID),
-				encodeCommand(commandID, command)); err != nil {
-				log.Errorf("node %v: error proposing command to group %v: %s", m.nodeID, groupID, err)
-			}
+		size:      len(command),
+		fn: func() error {
+			return m.multiNode.Propose(context.Background(), uint64(groupID),
+				encodeCommand(commandID, command))
 		},
 		ch: ch,
-	}
+	})
 	return ch
 }
 
 // ChangeGroupMembership submits a proposed membership change to the cluster.
 // Payload is an opaque blob that will be returned in EventMembershipChangeCommitted.
+// Besides the errors of SubmitCommand, the change may be aborted with
+// ErrNotLeader.
 func (m *MultiRaft) ChangeGroupMembership(groupID roachpb.RangeID, commandID string,
 	changeType raftpb.ConfChangeType, replica roachpb.ReplicaDescriptor, payload []byte) <-chan error {
 	if log.V(6) {
@@ -385,10 +539,12 @@ func (m *MultiRaft) ChangeGroupMembership(groupID roachpb.RangeID, commandID str
 		ch <- err
 		return ch
 	}
-	m.proposalChan <- &proposal{
-		groupID:   groupID,
-		commandID: commandID,
-		fn: func() {
+	m.submitProposal(&proposal{
+		groupID:    groupID,
+		commandID:  commandID,
+		size:       len(payload),
+		confChange: true,
+		fn: func() error {
 			ctx := ConfChangeContext{
 				CommandID: commandID,
 				Payload:   payload,
@@ -396,42 +552,60 @@ func (m *MultiRaft) ChangeGroupMembership(groupID roachpb.RangeID, commandID str
 			}
 			encodedCtx, err := ctx.Marshal()
 			if err != nil {
-				log.Errorf("node %v: error encoding context protobuf", m.nodeID)
-				return
+				return err
 			}
-			if err := m.multiNode.ProposeConfChange(context.Background(), uint64(groupID),
+			return m.multiNode.ProposeConfChange(context.Background(), uint64(groupID),
 				raftpb.ConfChange{
 					Type:    changeType,
 					NodeID:  uint64(replica.ReplicaID),
 					Context: encodedCtx,
 				},
-			); err != nil {
-				log.Errorf("node %v: error proposing membership change to node %v: %s", m.nodeID,
-					groupID, err)
-				return
-			}
+			)
 		},
 		ch: ch,
-	}
+	})
 	return ch
 }
 
+// submitProposal hands the proposal to the state loop, or fails it with
+// ErrStopped if the node is stopping.
+func (m *MultiRaft) submitProposal(prop *proposal) {
+	select {
+	case m.proposalChan <- prop:
+	case <-m.stopper.ShouldStop():
+		prop.ch <- ErrStopped
+	}
+}
+
 // Status returns the current status of the given group.
 func (m *MultiRaft) Status(groupID roachpb.RangeID) *raft.Status {
 	return m.multiNode.Status(uint64(groupID))
 }
 
+// CampaignGroup causes the local replica of the given group to campaign
+// for leadership of the group. It disrupts the group's current leader, if
+// any, and should only be used when the local replica has to lead the
+// group.
+func (m *MultiRaft) CampaignGroup(groupID roachpb.RangeID) error {
+	return m.multiNode.Campaign(context.Background(), uint64(groupID))
+}
+
 type proposal struct {
-	groupID   roachpb.RangeID
-	commandID string
-	fn        func()
-	ch        chan<- error
+	groupID    roachpb.RangeID
+	commandID  string
+	size       int  // the size of the command, for MaxUncommittedBytes
+	confChange bool // whether the proposal is a membership change
+	fn         func() error
+	ch         chan<- error
 }
 
 // group represents the state of a consensus group.
 type group struct {
 	groupID   roachpb.RangeID
 	replicaID roachpb.ReplicaID
+	// witness is true if the local replica of the group is a witness
+	// (see roachpb.ReplicaDescriptor), which never leads the group.
+	witness bool
 
 	// committedTerm is the term of the most recently committed entry.
 	committedTerm uint64
@@ -445,6 +619,8 @@ type group struct {
 	// is written to proposal.ch and it is removed from this
 	// map.
 	pending map[string]*proposal
+	// pendingBytes is the total size of the commands in pending.
+	pendingBytes int
 	// writing is true while an active writeTask exists for this group.
 	// We need to keep track of this since groups can be removed and
 	// re-added at any time, and we don't want a writeTask started by
@@ -500,13 +676,22 @@ type state struct {
 	pendingEvents []interface{}
 
 	readyGroups map[uint64]raft.Ready
+
+	// outages holds the peer stores which are believed to be unreachable.
+	outages map[roachpb.StoreID]*peerOutage
+
+	// rand is used to jitter the election timeouts of new groups.
+	rand *rand.Rand
 }
 
 func newState(m *MultiRaft) *state {
+	rng, _ := randutil.NewPseudoRand()
 	return &state{
 		MultiRaft: m,
+		rand:      rng,
 		groups:    make(map[roachpb.RangeID]*group),
 		nodes:     make(map[roachpb.NodeID]*node),
+		outages:   make(map[roachpb.StoreID]*peerOutage),
 		writeTask: newWriteTask(m.Storage),
 		replicaDescCache: cache.NewUnorderedCache(cache.Config{
 			Policy: cache.CacheLRU,
@@ -531,6 +716,10 @@ func (s *state) start() {
 		var writingGroups map[uint64]raft.Ready
 		// Counts up to heartbeat interval and is then reset.
 		ticks := 0
+		// Attributes the time of each iteration to the stages of the loop.
+		// pendingSince is the time at which events became pending.
+		timer := loopTimer{stats: &s.loopStats}
+		var pendingSince time.Time
 		for {
 			// raftReady signals that the Raft state machine has pending
 			// work. That work is supplied over the raftReady channel as a map
@@ -562,38 +751,47 @@ func (s *state) start() {
 			// if it's free to accept pending events.
 			if len(s.pendingEvents) > 0 {
 				eventsChan = s.Events
+				if pendingSince.IsZero() {
+					pendingSince = time.Now()
+				}
 			}
 
 			if log.V(8) {
 				log.Infof("node %v: selecting", s.nodeID)
 			}
+			timer.start = time.Now()
 			select {
 			case <-s.stopper.ShouldStop():
 				return
 
 			case req := <-s.reqChan:
-				if log.V(5) {
-					log.Infof("node %v: group %v got message %.200s", s.nodeID, req.GroupID,
-						raft.DescribeMessage(req.Message, s.EntryFormatter))
-				}
-				s.handleMessage(req)
+				timer.lap(StageIdle)
+				s.handleMessages(batchMessages(req, s.reqChan, maxStepBatchSize))
+				timer.lap(StageMessages)
 
 			case op := <-s.createGroupChan:
+				timer.lap(StageIdle)
 				if log.V(6) {
 					log.Infof("node %v: got op %#v", s.nodeID, op)
 				}
 				op.ch <- s.createGroup(op.groupID, 0)
+				timer.lap(StageGroupOps)
 
 			case op := <-s.removeGroupChan:
+				timer.lap(StageIdle)
 				if log.V(6) {
 					log.Infof("node %v: got op %#v", s.nodeID, op)
 				}
 				op.ch <- s.removeGroup(op.groupID)
+				timer.lap(StageGroupOps)
 
 			case prop := <-s.proposalChan:
+				timer.lap(StageIdle)
 				s.propose(prop)
+				timer.lap(StageProposals)
 
 			case s.readyGroups = <-raftReady:
+				timer.lap(StageIdle)
 				// readyGroups are saved in a local variable until they can be sent to
 				// the write task (and then the real work happens after the write is
 				// complete). All we do for now is log them.
@@ -607,17 +805,21 @@ func (s *state) start() {
 				s.handleWriteReady()
 				writingGroups = s.readyGroups
 				s.readyGroups = nil
+				timer.lap(StageReady)
 
 				select {
 				case resp := <-s.writeTask.out:
+					timer.lap(StageWriteWait)
 					s.handleWriteResponse(resp, writingGroups)
 					s.multiNode.Advance(writingGroups)
 					writingGroups = nil
+					timer.lap(StageWriteResponse)
 				case <-s.stopper.ShouldStop():
 					return
 				}
 
 			case <-s.Ticker.Chan():
+				timer.lap(StageIdle)
 				if log.V(8) {
 					log.Infof("node %v: got tick", s.nodeID)
 				}
@@ -627,18 +829,29 @@ func (s *state) start() {
 					ticks = 0
 					s.coalescedHeartbeat()
 				}
+				timer.lap(StageTick)
 
 			case cb := <-s.callbackChan:
+				timer.lap(StageIdle)
 				if log.V(8) {
 					log.Infof("node %v: got callback", s.nodeID)
 				}
 				cb()
+				timer.lap(StageCallback)
+
+			case health := <-s.peerHealthChan:
+				timer.lap(StageIdle)
+				s.handlePeerHealth(health)
+				timer.lap(StagePeerHealth)
 
 			case eventsChan <- s.pendingEvents:
+				timer.lap(StageIdle)
 				if log.V(8) {
 					log.Infof("node %v: send pendingEvents len %d", s.nodeID, len(s.pendingEvents))
 				}
 				s.pendingEvents = nil
+				s.loopStats.record(StageEventSend, time.Since(pendingSince))
+				pendingSince = time.Time{}
 			}
 		}
 	})
@@ -655,7 +868,10 @@ func (s *state) removePending(g *group, prop *proposal, err error) {
 		prop.ch = nil
 	}
 	if g != nil {
-		delete(g.pending, prop.commandID)
+		if _, ok := g.pending[prop.commandID]; ok {
+			delete(g.pending, prop.commandID)
+			g.pendingBytes -= prop.size
+		}
 	}
 }
 
@@ -687,13 +903,15 @@ func (s *state) stop() {
 		log.Infof("store %s stopping", s.storeID)
 	}
 	s.MultiRaft.multiNode.Stop()
-	s.MultiRaft.Transport.Stop(s.storeID)
+	if atomic.SwapInt32(&s.MultiRaft.listening, 0) != 0 {
+		s.MultiRaft.Transport.Stop(s.storeID)
+	}
 
 	// Ensure that any remaining commands are not left hanging.
 	for _, g := range s.groups {
 		for _, p := range g.pending {
 			if p.ch != nil {
-				p.ch <- util.Errorf("shutting down")
+				p.ch <- ErrStopped
 			}
 		}
 	}
@@ -745,68 +963,215 @@ func (s *state) removeNode(nodeID roachpb.NodeID, g *group) error {
 	return nil
 }
 
-func (s *state) handleMessage(req *RaftMessageRequest) {
+// A messageBatch holds the incoming messages for a single group, in
+// order of arrival.
+type messageBatch struct {
+	groupID roachpb.RangeID
+	reqs    []*RaftMessageRequest
+}
+
+// batchMessages drains the messages which are already queued on reqChan,
+// up to a total of max messages including req, and sorts them into
+// per-group batches. The batches are ordered by the arrival of their
+// group's first message; messages within a batch keep their order.
+func batchMessages(req *RaftMessageRequest, reqChan <-chan *RaftMessageRequest, max int) []messageBatch {
+	var batches []messageBatch
+	indexes := map[roachpb.RangeID]int{}
+	for n := 0; ; n++ {
+		if i, ok := indexes[req.GroupID]; ok {
+			batches[i].reqs = append(batches[i].reqs, req)
+		} else {
+			indexes[req.GroupID] = len(batches)
+			batches = append(batches, messageBatch{groupID: req.GroupID, reqs: []*RaftMessageRequest{req}})
+		}
+		if n+1 >= max {
+			return batches
+		}
+		select {
+		case req = <-reqChan:
+		default:
+			return batches
+		}
+	}
+}
+
+// handleMessages steps the batched messages into their groups, one
+// group at a time. The messages of a group are admitted first, then the
+// group is looked up, and created or recreated if needed, once for the
+// whole batch, and its messages are stepped in a single pass. The Ready
+// which raft then builds for the group covers the whole batch, so that it
+// is persisted in a single write.
+func (s *state) handleMessages(batches []messageBatch) {
+	for _, batch := range batches {
+		var reqs []*RaftMessageRequest
+		for _, req := range batch.reqs {
+			if log.V(5) {
+				log.Infof("node %v: group %v got message %.200s", s.nodeID, req.GroupID,
+					raft.DescribeMessage(req.Message, s.EntryFormatter))
+			}
+			if s.admitMessage(req) {
+				reqs = append(reqs, req)
+			}
+		}
+		s.stepMessages(batch.groupID, reqs)
+	}
+}
+
+// admitMessage returns whether the message should be stepped into its
+// group. Messages which are not destined for a single group, such as
+// coalesced heartbeats, are handled here, as are the messages which have
+// to be dropped.
+func (s *state) admitMessage(req *RaftMessageRequest) bool {
+	// A message from a store proves that it is reachable again.
+	s.peerReachable(req.FromReplica.StoreID)
+
 	// We only want to lazily create the group if it's not heartbeat-related;
 	// our heartbeats are coalesced and contain a dummy GroupID.
 	switch req.Message.Type {
 	case raftpb.MsgHeartbeat:
 		s.fanoutHeartbeat(req)
-		return
+		return false
 
 	case raftpb.MsgHeartbeatResp:
 		s.fanoutHeartbeatResponse(req)
-		return
+		return false
+
+	case raftpb.MsgUnreachable:
+		// Only sent over the wire by paused followers.
+		s.handlePausedNotice(req)
+		return false
+
+	case raftpb.MsgSnapStatus:
+		// Only sent over the wire by followers rejecting a snapshot.
+		if req.SnapshotRejection != nil {
+			s.handleSnapshotRejection(req)
+		}
+		return false
+	}
 
+	// Heartbeats are never filtered: dropping them would make the leaders
+	// on a blocklisted store look unreachable and trigger elections.
+	if f, ok := s.Storage.(PeerFilter); ok && !f.AcceptMessageFrom(req.FromReplica.StoreID) {
+		s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+		return false
+	}
+
+	if req.GroupID == noGroup || req.ToReplica.StoreID != s.storeID {
+		s.rejectMessage(req, fmt.Sprintf("malformed message for group %s addressed to store %s",
+			req.GroupID, req.ToReplica.StoreID))
+		return false
+	}
+
+	if s.replicaPaused(req) {
+		s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+		s.sendPausedNotice(req)
+		return false
+	}
+
+	switch req.Message.Type {
 	case raftpb.MsgSnap:
-		if !s.Storage.CanApplySnapshot(req.GroupID, req.Message.Snapshot) {
+		if rejection := s.Storage.CanApplySnapshot(req.GroupID, req.Message.Snapshot); rejection != nil {
 			// If the storage cannot accept the snapshot, drop it before
 			// passing it to multiNode.Step, since our error handling
-			// options past that point are limited.
-			return
+			// options past that point are limited, and tell the sender why.
+			s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+			if rejection.Reason == SNAPSHOT_INVALID {
+				s.rejectMessage(req, fmt.Sprintf("invalid snapshot for group %s", req.GroupID))
+			}
+			s.sendSnapshotRejection(req, rejection)
+			return false
 		}
 	}
 
 	s.CacheReplicaDescriptor(req.GroupID, req.FromReplica)
 	s.CacheReplicaDescriptor(req.GroupID, req.ToReplica)
-	if g, ok := s.groups[req.GroupID]; ok {
-		if g.replicaID > req.ToReplica.ReplicaID {
-			log.Warningf("node %v: got message for group %s with stale replica ID %s (expected %s)",
-				s.nodeID, req.GroupID, req.ToReplica.ReplicaID, g.replicaID)
-			return
-		} else if g.replicaID < req.ToReplica.ReplicaID {
+	return true
+}
+
+// stepMessages steps the admitted messages of a group into it, in order.
+// The group is created if it doesn't exist, or recreated if the newest
+// replica ID the messages are addressed to is newer than the group's.
+// Messages addressed to an older replica ID are dropped.
+func (s *state) stepMessages(groupID roachpb.RangeID, reqs []*RaftMessageRequest) {
+	if len(reqs) == 0 {
+		return
+	}
+	newest := reqs[0]
+	for _, req := range reqs[1:] {
+		if req.ToReplica.ReplicaID > newest.ToReplica.ReplicaID {
+			newest = req
+		}
+	}
+	dropAll := func() {
+		for _, req := range reqs {
+			s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+		}
+	}
+
+	if g, ok := s.groups[groupID]; ok {
+		if g.replicaID < newest.ToReplica.ReplicaID {
 			// The message has a newer ReplicaID than we know about. This
 			// means that this node has been removed from a group and
 			// re-added to it, before our GC process was able to remove the
 			// remnants of the old group.
 			log.Infof("node %v: got message for group %s with newer replica ID (%s vs %s), recreating group",
-				s.nodeID, req.GroupID, req.ToReplica.ReplicaID, g.replicaID)
-			if err := s.removeGroup(req.GroupID); err != nil {
+				s.nodeID, groupID, newest.ToReplica.ReplicaID, g.replicaID)
+			if err := s.removeGroup(groupID); err != nil {
 				log.Warningf("Error removing group %d (in response to incoming message): %s",
-					req.GroupID, err)
+					groupID, err)
+				dropAll()
 				return
 			}
-			if err := s.createGroup(req.GroupID, req.ToReplica.ReplicaID); err != nil {
+			if err := s.createGroup(groupID, newest.ToReplica.ReplicaID); err != nil {
 				log.Warningf("Error recreating group %d (in response to incoming message): %s",
-					req.GroupID, err)
+					groupID, err)
+				dropAll()
 				return
 			}
 		}
 	} else {
 		if log.V(1) {
-			log.Infof("node %v: got message for unknown group %d; creating it", s.nodeID, req.GroupID)
+			log.Infof("node %v: got message for unknown group %d; creating it", s.nodeID, groupID)
 		}
-		if err := s.createGroup(req.GroupID, req.ToReplica.ReplicaID); err != nil {
+		if err := s.createGroup(groupID, newest.ToReplica.ReplicaID); err != nil {
 			log.Warningf("Error creating group %d (in response to incoming message): %s",
-				req.GroupID, err)
+				groupID, err)
+			dropAll()
 			return
 		}
+		if o, ok := s.Storage.(GroupCreationObserver); ok {
+			o.GroupCreatedByMessage(groupID, newest.FromReplica, newest.Message.Type)
+		}
 	}
 
-	if err := s.multiNode.Step(context.Background(), uint64(req.GroupID), req.Message); err != nil {
-		if log.V(4) {
-			log.Infof("node %v: multinode step to group %v failed for message %.200s", s.nodeID, req.GroupID,
-				raft.DescribeMessage(req.Message, s.EntryFormatter))
+	g := s.groups[groupID]
+	for _, req := range reqs {
+		if g.replicaID > req.ToReplica.ReplicaID {
+			log.Warningf("node %v: got message for group %s with stale replica ID %s (expected %s)",
+				s.nodeID, groupID, req.ToReplica.ReplicaID, g.replicaID)
+			s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+			continue
 		}
+		if err := s.multiNode.Step(context.Background(), uint64(groupID), req.Message); err != nil {
+			s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageStepFailed)
+			if log.V(4) {
+				log.Infof("node %v: multinode step to group %v failed for message %.200s", s.nodeID, groupID,
+					raft.DescribeMessage(req.Message, s.EntryFormatter))
+			}
+			continue
+		}
+		s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageStepped)
+	}
+}
+
+// rejectMessage drops a message which is malformed, i.e. badly addressed or
+// carrying an invalid snapshot, reporting it to the Storage if it is a
+// PeerFilter. Messages for stale replicas or deleted groups are a normal
+// consequence of rebalancing and are dropped without being reported.
+func (s *state) rejectMessage(req *RaftMessageRequest, reason string) {
+	s.msgStats.record(req.FromReplica.StoreID, req.Message.Type, MessageDropped)
+	if f, ok := s.Storage.(PeerFilter); ok {
+		f.MessageRejected(req.FromReplica.StoreID, reason)
 	}
 }
 
@@ -864,10 +1229,17 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 		return util.Errorf("couldn't find replica ID for this store (%s) in range %d",
 			s.storeID, groupID)
 	}
+	// A group created in response to an incoming message learns whether
+	// its replica is a witness from the descriptor cached off the message.
+	var witness bool
+	if repDesc, err := s.ReplicaDescriptor(groupID, replicaID); err == nil {
+		witness = repDesc.Witness
+	}
 	s.CacheReplicaDescriptor(groupID, roachpb.ReplicaDescriptor{
 		ReplicaID: replicaID,
 		NodeID:    s.nodeID,
 		StoreID:   s.storeID,
+		Witness:   witness,
 	})
 
 	var appliedIndex uint64
@@ -878,10 +1250,20 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 		}
 	}
 
+	electionTicks := s.ElectionTimeoutTicks
+	if s.ElectionTimeoutJitterTicks > 0 {
+		electionTicks += s.rand.Intn(s.ElectionTimeoutJitterTicks)
+	}
+	if witness {
+		// A witness holds no user data, so it must never lead the group: it
+		// would send snapshots without user data to the replicas it brings
+		// up to date. It votes, but never times out to start an election.
+		electionTicks = math.MaxInt32
+	}
 	raftCfg := &raft.Config{
 		ID:            uint64(replicaID),
 		Applied:       appliedIndex,
-		ElectionTick:  s.ElectionTimeoutTicks,
+		ElectionTick:  electionTicks,
 		HeartbeatTick: s.HeartbeatIntervalTicks,
 		Storage:       gs,
 		// TODO(bdarnell): make these configurable; evaluate defaults.
@@ -895,6 +1277,7 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 	g := &group{
 		groupID:   groupID,
 		replicaID: replicaID,
+		witness:   witness,
 		pending:   map[string]*proposal{},
 	}
 	s.groups[groupID] = g
@@ -932,7 +1315,7 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 		if err != nil {
 			return err
 		}
-		if replica.StoreID == s.storeID {
+		if replica.StoreID == s.storeID && !witness {
 			log.Infof("node %s campaigning because initial confstate is %v", s.nodeID, cs.Nodes)
 			if err := s.multiNode.Campaign(context.Background(), uint64(groupID)); err != nil {
 				return err
@@ -977,7 +1360,7 @@ func (s *state) removeGroup(groupID roachpb.RangeID) error {
 func (s *state) propose(p *proposal) {
 	g, ok := s.groups[p.groupID]
 	if !ok {
-		s.removePending(nil /* group */, p, ErrGroupDeleted)
+		s.removePending(nil /* group */, p, ErrGroupNotFound)
 		return
 	}
 
@@ -990,20 +1373,51 @@ func (s *state) propose(p *proposal) {
 	}
 	if !found {
 		// If we are not a member of the group, don't allow any proposals.
-		s.removePending(nil, p, ErrGroupDeleted)
+		s.removePending(nil, p, ErrGroupNotFound)
 		return
 	}
+	// Raft drops proposals made while no leader is known. Commands stay
+	// pending and are re-proposed once a leader is elected, but a pending
+	// membership change would hold up its transaction until then, so it
+	// fails fast instead.
+	if p.confChange && g.leader.ReplicaID == 0 {
+		if _, ok := g.pending[p.commandID]; !ok {
+			s.removePending(nil, p, &ErrNotLeader{Leader: g.leader})
+			return
+		}
+	}
+	// Proposals which are already pending are being re-proposed and
+	// are not subject to the limit.
+	if _, ok := g.pending[p.commandID]; !ok {
+		if max := s.MaxUncommittedBytes; max > 0 && len(g.pending) > 0 && g.pendingBytes+p.size > max {
+			if log.V(1) {
+				log.Infof("group %d: rejecting proposal %x; %d bytes of commands uncommitted",
+					p.groupID, p.commandID, g.pendingBytes)
+			}
+			s.removePending(nil, p, ErrProposalThrottled)
+			return
+		}
+		g.pending[p.commandID] = p
+		g.pendingBytes += p.size
+	}
+
 	// If configuration change callback is pending, wait for it.
 	if g.waitForCallback > 0 {
-		g.pending[p.commandID] = p
 		return
 	}
 
 	if log.V(3) {
 		log.Infof("group %d: new proposal %x", p.groupID, p.commandID)
 	}
-	g.pending[p.commandID] = p
-	p.fn()
+	if err := p.fn(); err != nil {
+		if err == raft.ErrStopped {
+			err = ErrStopped
+		} else {
+			log.Errorf("node %v: error proposing command to group %v: %s", s.nodeID, p.groupID, err)
+			err = ErrProposalDropped
+		}
+		s.removePending(g, p, err)
+	}
 }
 
 func (s *state) logRaftReady() {
@@ -1038,7 +1452,7 @@ func (s *state) handleWriteReady() {
 	if log.V(6) {
 		log.Infof("node %v write ready, preparing request", s.nodeID)
 	}
-	writeRequest := newWriteRequest()
+	writeRequest := s.writeTask.nextRequest()
 	for groupID, ready := range s.readyGroups {
 		raftGroupID := roachpb.RangeID(groupID)
 		g, ok := s.groups[raftGroupID]
@@ -1050,7 +1464,7 @@ func (s *state) handleWriteReady() {
 		}
 		g.writing = true
 
-		gwr := &groupWriteRequest{}
+		gwr := writeRequest.addGroup(raftGroupID)
 		var err error
 		gwr.replicaID, err = s.Storage.ReplicaIDForStore(roachpb.RangeID(groupID), s.storeID)
 		if err != nil {
@@ -1069,7 +1483,6 @@ func (s *state) handleWriteReady() {
 		if len(ready.Entries) > 0 {
 			gwr.entries = ready.Entries
 		}
-		writeRequest.groups[raftGroupID] = gwr
 	}
 	s.writeTask.in <- writeRequest
 }
@@ -1198,14 +1611,38 @@ func (s *state) sendMessage(g *group, msg raftpb.Message) {
 		toReplica, err = s.ReplicaDescriptor(groupID, roachpb.ReplicaID(msg.To))
 		if err != nil {
 			log.Warningf("failed to lookup recipient replica %d in group %d: %s", msg.To, groupID, err)
+			s.msgStats.record(0, msg.Type, MessageDropped)
 			return
 		}
 		fromReplica, err = s.ReplicaDescriptor(groupID, roachpb.ReplicaID(msg.From))
 		if err != nil {
 			log.Warningf("failed to lookup sender replica %d in group %d: %s", msg.From, groupID, err)
+			s.msgStats.record(toReplica.StoreID, msg.Type, MessageDropped)
 			return
 		}
 	}
+	if g != nil && g.witness && (msg.Type == raftpb.MsgVote || msg.Type == raftpb.MsgSnap) {
+		// Witnesses never start elections, nor lead their group; this is
+		// merely a safeguard against them doing so after all.
+		log.Warningf("node %v: witness of group %v dropping %s to %v", s.nodeID, groupID, msg.Type, toReplica)
+		s.msgStats.record(toReplica.StoreID, msg.Type, MessageDropped)
+		if msg.Type == raftpb.MsgSnap {
+			s.multiNode.ReportSnapshot(msg.To, uint64(groupID), raft.SnapshotFailure)
+		}
+		return
+	}
+	if msg.Type == raftpb.MsgSnap && toReplica.Witness {
+		// Witnesses must never receive user data.
+		snap, err := s.Storage.WitnessSnapshot(groupID, msg.Snapshot)
+		if err != nil {
+			log.Warningf("node %v: failed to prepare snapshot of group %v for witness %v: %s",
+				s.nodeID, groupID, toReplica, err)
+			s.msgStats.record(toReplica.StoreID, msg.Type, MessageDropped)
+			s.multiNode.ReportSnapshot(msg.To, uint64(groupID), raft.SnapshotFailure)
+			return
+		}
+		msg.Snapshot = snap
+	}
 	if _, ok := s.nodes[toReplica.NodeID]; !ok {
 		if log.V(4) {
 			log.Infof("node %v: connecting to new node %v", s.nodeID, toReplica.NodeID)
@@ -1223,11 +1660,17 @@ func (s *state) sendMessage(g *group, msg raftpb.Message) {
 	})
 	snapStatus := raft.SnapshotFinish
 	if err != nil {
+		s.msgStats.record(toReplica.StoreID, msg.Type, MessageSendFailed)
 		log.Warningf("node %v failed to send message to %v: %s", s.nodeID, toReplica.NodeID, err)
 		if groupID != noGroup {
-			s.multiNode.ReportUnreachable(msg.To, uint64(groupID))
+			s.reportUnreachable(groupID, toReplica)
 		}
 		snapStatus = raft.SnapshotFailure
+	} else {
+		s.msgStats.record(toReplica.StoreID, msg.Type, MessageSent)
+		if msg.Type == raftpb.MsgSnap && g != nil {
+			s.reportSnapshotSent(g, toReplica, msg)
+		}
 	}
 	if msg.Type == raftpb.MsgSnap {
 		// TODO(bdarnell): add an ack for snapshots and don't report status until
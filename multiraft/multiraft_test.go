@@ -30,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
@@ -203,8 +204,43 @@ func TestProposeBadGroup(t *testing.T) {
 	cluster := newTestCluster(nil, 3, stopper, t)
 	defer stopper.Stop()
 	err := <-cluster.nodes[1].SubmitCommand(7, "asdf", []byte{})
-	if err == nil {
-		t.Fatal("did not get expected error")
+	if err != ErrGroupNotFound {
+		t.Fatalf("expected %s; got %v", ErrGroupNotFound, err)
+	}
+}
+
+// TestProposeAfterStop verifies that proposals made after the node has
+// stopped fail with ErrStopped instead of blocking.
+func TestProposeAfterStop(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	groupID := roachpb.RangeID(1)
+	cluster.createGroup(groupID, 0, 3)
+	stopper.Stop()
+
+	if err := <-cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("command")); err != ErrStopped {
+		t.Fatalf("expected %s; got %v", ErrStopped, err)
+	}
+}
+
+// TestMembershipChangeWithoutLeader verifies that membership changes
+// proposed while the group has no leader fail with ErrNotLeader.
+func TestMembershipChangeWithoutLeader(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	defer stopper.Stop()
+	groupID := roachpb.RangeID(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	replica := roachpb.ReplicaDescriptor{NodeID: 4, StoreID: 4, ReplicaID: 4}
+	err := <-cluster.nodes[0].ChangeGroupMembership(groupID, makeCommandID(),
+		raftpb.ConfChangeAddNode, replica, nil)
+	if nlErr, ok := err.(*ErrNotLeader); !ok {
+		t.Fatalf("expected ErrNotLeader; got %v", err)
+	} else if nlErr.Leader.ReplicaID != 0 {
+		t.Fatalf("expected no known leader; got %s", nlErr.Leader)
 	}
 }
 
@@ -291,6 +327,49 @@ func TestCommand(t *testing.T) {
 	}
 }
 
+// TestMessageStats verifies that messages sent and stepped during an
+// election are counted per peer.
+func TestMessageStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	defer stopper.Stop()
+	groupID := roachpb.RangeID(1)
+	cluster.createGroup(groupID, 0, 3)
+	cluster.elect(0, groupID)
+
+	for i := 1; i < 3; i++ {
+		peer := cluster.nodes[i].storeID
+		sent := MessageStatsKey{Peer: peer, Type: raftpb.MsgVote, Outcome: MessageSent}
+		if n := cluster.nodes[0].MessageStats()[sent]; n == 0 {
+			t.Errorf("expected votes to be sent to store %d", peer)
+		}
+		stepped := MessageStatsKey{Peer: cluster.nodes[0].storeID, Type: raftpb.MsgVote, Outcome: MessageStepped}
+		if n := cluster.nodes[i].MessageStats()[stepped]; n == 0 {
+			t.Errorf("expected store %d to step votes from store %d", peer, cluster.nodes[0].storeID)
+		}
+	}
+}
+
+// TestLoopStats verifies that the state loop records the time spent in
+// the stages it went through during an election.
+func TestLoopStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	defer stopper.Stop()
+	groupID := roachpb.RangeID(1)
+	cluster.createGroup(groupID, 0, 3)
+	cluster.elect(0, groupID)
+
+	stats := cluster.nodes[0].LoopStats()
+	for _, stage := range []LoopStage{StageIdle, StageGroupOps, StageReady, StageWriteWait, StageEventSend} {
+		if s := stats[stage]; s.Count == 0 || s.P99 < s.P50 {
+			t.Errorf("unexpected stats for stage %s: %+v", stage, s)
+		}
+	}
+}
+
 func TestSlowStorage(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
@@ -348,6 +427,61 @@ func TestSlowStorage(t *testing.T) {
 	}
 }
 
+// TestMaxUncommittedBytes verifies that proposals are rejected while the
+// uncommitted commands of their group exceed MaxUncommittedBytes.
+func TestMaxUncommittedBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	defer stopper.Stop()
+	groupID := roachpb.RangeID(1)
+	cluster.createGroup(groupID, 0, 3)
+	cluster.elect(0, groupID)
+
+	// Set the limit from the node's goroutine.
+	done := make(chan struct{})
+	cluster.nodes[0].callbackChan <- func() {
+		cluster.nodes[0].MaxUncommittedBytes = 10
+		close(done)
+	}
+	<-done
+
+	// Without the followers, no command can be committed.
+	cluster.storages[1].Block()
+	cluster.storages[2].Block()
+
+	// The first command is accepted, even though it's larger than the
+	// limit; the second one exceeds the limit.
+	ch1 := cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("first command"))
+	ch2 := cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("second"))
+	if err := <-ch2; err != ErrProposalThrottled {
+		t.Fatalf("expected %s; got %v", ErrProposalThrottled, err)
+	}
+
+	// Once the followers catch up, the first command is committed and
+	// further commands are accepted.
+	cluster.storages[1].Unblock()
+	cluster.storages[2].Unblock()
+	for i := 0; ; i++ {
+		select {
+		case err := <-ch1:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(5 * time.Millisecond):
+			if i == 100 {
+				t.Fatal("first command was not committed")
+			}
+			cluster.tickers[0].Tick()
+			continue
+		}
+		break
+	}
+	if err := <-cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("third")); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestMembershipChange(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
@@ -628,4 +762,177 @@ func TestConfigValidation(t *testing.T) {
 		"TickInterval must be greater than zero") {
 		t.Errorf("Unexpected error of validate: %s", err)
 	}
+
+	config = validConfig
+	config.ElectionTimeoutJitterTicks = -1
+	if err := config.validate(); !testutils.IsError(err,
+		"ElectionTimeoutJitterTicks must not be negative") {
+		t.Errorf("Unexpected error of validate: %s", err)
+	}
+
+	config = validConfig
+	config.TickSpread = -1 * time.Second
+	if err := config.validate(); !testutils.IsError(err,
+		`TickSpread must be in \[0, TickInterval\)`) {
+		t.Errorf("Unexpected error of validate: %s", err)
+	}
+
+	config = validConfig
+	config.TickSpread = config.TickInterval
+	if err := config.validate(); !testutils.IsError(err,
+		`TickSpread must be in \[0, TickInterval\)`) {
+		t.Errorf("Unexpected error of validate: %s", err)
+	}
+
+	config = validConfig
+	config.MaxUncommittedBytes = -1
+	if err := config.validate(); !testutils.IsError(err,
+		"MaxUncommittedBytes must not be negative") {
+		t.Errorf("Unexpected error of validate: %s", err)
+	}
+}
+
+// flakyListenTransport is a Transport whose Listen fails a given number of
+// times before succeeding.
+type flakyListenTransport struct {
+	Transport
+	failures int32 // The number of remaining Listen failures; accessed atomically.
+}
+
+func (ft *flakyListenTransport) Listen(id roachpb.StoreID, server ServerInterface) error {
+	if atomic.AddInt32(&ft.failures, -1) >= 0 {
+		return fmt.Errorf("address already in use")
+	}
+	return ft.Transport.Listen(id, server)
+}
+
+// TestListenRetry verifies that NewMultiRaft and Rebind retry failures of
+// Transport.Listen and that Listening reports whether the store is
+// registered with the Transport.
+func TestListenRetry(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	transport := &flakyListenTransport{Transport: NewLocalInterceptableTransport(stopper)}
+	stopper.AddCloser(transport)
+	config := &Config{
+		Transport:              transport,
+		Storage:                NewMemoryStorage(),
+		Ticker:                 newManualTicker(),
+		ElectionTimeoutTicks:   2,
+		HeartbeatIntervalTicks: 1,
+		TickInterval:           time.Hour, // not in use
+		ListenRetryOptions: retry.Options{
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+			Multiplier:     2,
+			MaxRetries:     3,
+		},
+	}
+
+	// Listen fails more often than it is retried.
+	atomic.StoreInt32(&transport.failures, 5)
+	if _, err := NewMultiRaft(1, 1, config, stopper); !testutils.IsError(err, "address already in use") {
+		t.Fatalf("expected listen failure, got %v", err)
+	}
+
+	// Listen succeeds on a retry.
+	atomic.StoreInt32(&transport.failures, 2)
+	mr, err := NewMultiRaft(1, 1, config, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Listening() {
+		t.Fatal("expected store to be listening")
+	}
+
+	// A failed rebind leaves the store unregistered until a later rebind
+	// succeeds.
+	atomic.StoreInt32(&transport.failures, 5)
+	if err := mr.Rebind(); !testutils.IsError(err, "address already in use") {
+		t.Fatalf("expected listen failure, got %v", err)
+	}
+	if mr.Listening() {
+		t.Fatal("expected store not to be listening")
+	}
+	atomic.StoreInt32(&transport.failures, 1)
+	if err := mr.Rebind(); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Listening() {
+		t.Fatal("expected store to be listening")
+	}
+
+	mr.Start()
+}
+
+// TestBatchMessages verifies that queued messages are drained into
+// per-group batches which preserve the order of each group's messages.
+func TestBatchMessages(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	reqChan := make(chan *RaftMessageRequest, 10)
+	var reqs []*RaftMessageRequest
+	for i, groupID := range []roachpb.RangeID{1, 2, 1, 3, 2, 1} {
+		req := &RaftMessageRequest{GroupID: groupID, Message: raftpb.Message{Index: uint64(i)}}
+		reqs = append(reqs, req)
+		if i > 0 {
+			reqChan <- req
+		}
+	}
+
+	batches := batchMessages(reqs[0], reqChan, 5)
+	expected := []messageBatch{
+		{groupID: 1, reqs: []*RaftMessageRequest{reqs[0], reqs[2]}},
+		{groupID: 2, reqs: []*RaftMessageRequest{reqs[1], reqs[4]}},
+		{groupID: 3, reqs: []*RaftMessageRequest{reqs[3]}},
+	}
+	if !reflect.DeepEqual(batches, expected) {
+		t.Fatalf("expected batches %+v, got %+v", expected, batches)
+	}
+
+	// The message beyond the limit remains queued.
+	batches = batchMessages(<-reqChan, reqChan, 5)
+	expected = []messageBatch{{groupID: 1, reqs: []*RaftMessageRequest{reqs[5]}}}
+	if !reflect.DeepEqual(batches, expected) {
+		t.Fatalf("expected batches %+v, got %+v", expected, batches)
+	}
+}
+
+// TestWriteRequestReuse verifies that writeRequests and writeResponses
+// are emptied by reset and reuse their allocations.
+func TestWriteRequestReuse(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	w := newWriteTask(NewMemoryStorage())
+	entries := []raftpb.Entry{{Index: 1}}
+
+	req := w.nextRequest()
+	gwr := req.addGroup(1)
+	gwr.replicaID = 2
+	gwr.entries = entries
+	req.addGroup(3)
+	w.response.addGroup(1, entries).lastIndex = 1
+
+	if req = w.nextRequest(); len(req.groups) != 0 {
+		t.Fatalf("expected empty request; got %+v", req.groups)
+	}
+	if reused := req.addGroup(4); !reflect.DeepEqual(*reused, groupWriteRequest{}) {
+		t.Errorf("expected empty group write request; got %+v", reused)
+	}
+	w.response.reset()
+	if resp := w.response.addGroup(4, nil); resp.lastIndex != -1 || resp.lastTerm != -1 {
+		t.Errorf("expected unchanged group write response; got %+v", resp)
+	}
+
+	// Once warmed up, preparing requests and responses doesn't allocate.
+	allocs := testing.AllocsPerRun(100, func() {
+		req := w.nextRequest()
+		req.addGroup(1).entries = entries
+		req.addGroup(3)
+		w.response.reset()
+		w.response.addGroup(1, entries)
+		w.response.addGroup(3, nil)
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations; got %.1f", allocs)
+	}
 }
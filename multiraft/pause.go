@@ -0,0 +1,86 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// PausableStorage is an optional interface which a Storage may implement
+// to temporarily pause individual replicas, for instance while their disk
+// is undergoing maintenance. A paused replica drops incoming log appends
+// and snapshots, and tells the sender that it did so.
+type PausableStorage interface {
+	// ReplicaPaused returns true if the local replica of the given group
+	// is paused.
+	ReplicaPaused(groupID roachpb.RangeID) bool
+}
+
+// An EventReplicaPaused is broadcast on the leader of a group whenever a
+// follower drops a log append or snapshot because it is paused.
+type EventReplicaPaused struct {
+	GroupID roachpb.RangeID
+	Replica roachpb.ReplicaDescriptor
+}
+
+// replicaPaused returns true if the given message must be dropped because
+// the recipient replica is paused.
+func (s *state) replicaPaused(req *RaftMessageRequest) bool {
+	switch req.Message.Type {
+	case raftpb.MsgApp, raftpb.MsgSnap:
+	default:
+		return false
+	}
+	ps, ok := s.Storage.(PausableStorage)
+	return ok && ps.ReplicaPaused(req.GroupID)
+}
+
+// sendPausedNotice tells the sender of a dropped message that the
+// recipient is paused. The notice is encoded as an MsgUnreachable, which
+// is never sent over the wire otherwise.
+func (s *state) sendPausedNotice(req *RaftMessageRequest) {
+	if err := s.Transport.Send(&RaftMessageRequest{
+		GroupID:     req.GroupID,
+		FromReplica: req.ToReplica,
+		ToReplica:   req.FromReplica,
+		Message: raftpb.Message{
+			Type: raftpb.MsgUnreachable,
+			From: req.Message.To,
+			To:   req.Message.From,
+		},
+	}); err != nil {
+		if log.V(1) {
+			log.Infof("node %v: failed to send paused notice for group %v to %v: %s",
+				s.nodeID, req.GroupID, req.FromReplica, err)
+		}
+	}
+}
+
+// handlePausedNotice processes a notice from a paused follower. The
+// follower is moved into probing mode so that the leader stops streaming
+// appends to it, and the application is informed.
+func (s *state) handlePausedNotice(req *RaftMessageRequest) {
+	if _, ok := s.groups[req.GroupID]; !ok {
+		return
+	}
+	s.multiNode.ReportUnreachable(req.Message.From, uint64(req.GroupID))
+	s.sendEvent(&EventReplicaPaused{
+		GroupID: req.GroupID,
+		Replica: req.FromReplica,
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// A PeerHealthListener is notified by a Transport with failure detection
+// (for instance through connection health checks) when a peer store
+// becomes unreachable or reachable again. The ServerInterface which
+// MultiRaft passes to Transport.Listen implements it.
+type PeerHealthListener interface {
+	ReportPeerHealth(storeID roachpb.StoreID, healthy bool)
+}
+
+// A peerHealth is a report of a peer store's health, handed to the
+// state loop by ReportPeerHealth.
+type peerHealth struct {
+	storeID roachpb.StoreID
+	healthy bool
+}
+
+// ReportPeerHealth implements PeerHealthListener.
+func (ms *multiraftServer) ReportPeerHealth(storeID roachpb.StoreID, healthy bool) {
+	select {
+	case ms.peerHealthChan <- peerHealth{storeID: storeID, healthy: healthy}:
+	case <-ms.stopper.ShouldStop():
+	}
+}
+
+// handlePeerHealth processes a report of a peer store's health.
+func (s *state) handlePeerHealth(health peerHealth) {
+	if health.healthy {
+		s.peerReachable(health.storeID)
+	} else {
+		s.peerUnreachable(health.storeID)
+	}
+}
+
+// A peerOutage tracks a peer store which is believed to be unreachable,
+// along with the groups which have been told so.
+type peerOutage struct {
+	nodeID roachpb.NodeID
+	groups map[roachpb.RangeID]struct{}
+}
+
+// peerUnreachable records the start of an outage of the given store.
+// Groups are told about it as their messages to the store fail.
+func (s *state) peerUnreachable(storeID roachpb.StoreID) {
+	if _, ok := s.outages[storeID]; ok {
+		return
+	}
+	if log.V(1) {
+		log.Infof("node %v: store %v is unreachable", s.nodeID, storeID)
+	}
+	s.outages[storeID] = &peerOutage{groups: map[roachpb.RangeID]struct{}{}}
+}
+
+// reportUnreachable tells the group that the given replica is
+// unreachable, but only once per outage of the replica's store. Raft
+// would otherwise keep resetting the replica's progress on every failed
+// message.
+func (s *state) reportUnreachable(groupID roachpb.RangeID, replica roachpb.ReplicaDescriptor) {
+	outage, ok := s.outages[replica.StoreID]
+	if !ok {
+		s.peerUnreachable(replica.StoreID)
+		outage = s.outages[replica.StoreID]
+	}
+	outage.nodeID = replica.NodeID
+	if _, ok := outage.groups[groupID]; ok {
+		return
+	}
+	outage.groups[groupID] = struct{}{}
+	s.multiNode.ReportUnreachable(uint64(replica.ReplicaID), uint64(groupID))
+}
+
+// peerReachable ends an outage of the given store. If any group was told
+// that the store is unreachable, a heartbeat is sent to the store's node
+// right away; the responses make the affected groups probe their
+// followers instead of waiting for the next heartbeat interval.
+func (s *state) peerReachable(storeID roachpb.StoreID) {
+	outage, ok := s.outages[storeID]
+	if !ok {
+		return
+	}
+	delete(s.outages, storeID)
+	if log.V(1) {
+		log.Infof("node %v: store %v is reachable again", s.nodeID, storeID)
+	}
+	if len(outage.groups) == 0 || outage.nodeID == s.nodeID {
+		return
+	}
+	s.sendMessage(nil, raftpb.Message{
+		From: uint64(s.nodeID),
+		To:   uint64(outage.nodeID),
+		Type: raftpb.MsgHeartbeat,
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// unreachableMultiNode records the unreachable reports it receives.
+type unreachableMultiNode struct {
+	raft.MultiNode
+	reports []uint64
+}
+
+func (n *unreachableMultiNode) ReportUnreachable(id, group uint64) {
+	n.reports = append(n.reports, group)
+}
+
+// recordingTransport records the messages it is asked to send.
+type recordingTransport struct {
+	Transport
+	sent []*RaftMessageRequest
+}
+
+func (t *recordingTransport) Send(req *RaftMessageRequest) error {
+	t.sent = append(t.sent, req)
+	return nil
+}
+
+// TestReportUnreachableOncePerOutage verifies that a group is told about
+// an unreachable peer only once per outage, and that the end of an
+// outage sends a heartbeat to the peer right away.
+func TestReportUnreachableOncePerOutage(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	multiNode := &unreachableMultiNode{}
+	transport := &recordingTransport{}
+	s := &state{
+		MultiRaft: &MultiRaft{
+			Config:    Config{Transport: transport},
+			multiNode: multiNode,
+			nodeID:    1,
+		},
+		nodes:   map[roachpb.NodeID]*node{},
+		outages: map[roachpb.StoreID]*peerOutage{},
+	}
+	peer := roachpb.ReplicaDescriptor{NodeID: 2, StoreID: 2, ReplicaID: 3}
+
+	for i := 0; i < 3; i++ {
+		s.reportUnreachable(10, peer)
+		s.reportUnreachable(11, peer)
+	}
+	if len(multiNode.reports) != 2 {
+		t.Fatalf("expected one report for each of two groups, got %v", multiNode.reports)
+	}
+	if len(transport.sent) != 0 {
+		t.Fatalf("expected no messages to be sent during the outage, got %d", len(transport.sent))
+	}
+
+	s.peerReachable(peer.StoreID)
+	if len(transport.sent) != 1 || transport.sent[0].Message.Type != raftpb.MsgHeartbeat ||
+		transport.sent[0].ToReplica.NodeID != peer.NodeID {
+		t.Fatalf("expected a heartbeat to node %d, got %+v", peer.NodeID, transport.sent)
+	}
+
+	// A new outage is reported again.
+	s.peerUnreachable(peer.StoreID)
+	s.reportUnreachable(10, peer)
+	if len(multiNode.reports) != 3 {
+		t.Fatalf("expected the new outage to be reported, got %v", multiNode.reports)
+	}
+}
@@ -7,11 +7,13 @@
 
 	It is generated from these files:
 		cockroach/multiraft/rpc.proto
+		cockroach/multiraft/snapshot.proto
 
 	It has these top-level messages:
 		RaftMessageRequest
 		RaftMessageResponse
 		ConfChangeContext
+		SnapshotRejection
 */
 package multiraft
 
@@ -39,6 +41,9 @@ type RaftMessageRequest struct {
 	FromReplica cockroach_roachpb.ReplicaDescriptor              `protobuf:"bytes,2,opt,name=from_replica" json:"from_replica"`
 	ToReplica   cockroach_roachpb.ReplicaDescriptor              `protobuf:"bytes,3,opt,name=to_replica" json:"to_replica"`
 	Message     raftpb.Message                                   `protobuf:"bytes,4,opt,name=message" json:"message"`
+	// If set, the message is a notice that the sender refused to apply a
+	// snapshot, telling the recipient why.
+	SnapshotRejection *SnapshotRejection `protobuf:"bytes,5,opt,name=snapshot_rejection" json:"snapshot_rejection,omitempty"`
 }
 
 func (m *RaftMessageRequest) Reset()         { *m = RaftMessageRequest{} }
@@ -110,6 +115,16 @@ func (m *RaftMessageRequest) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n3
+	if m.SnapshotRejection != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.SnapshotRejection.Size()))
+		n5, err := m.SnapshotRejection.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -204,6 +219,10 @@ func (m *RaftMessageRequest) Size() (n int) {
 	n += 1 + l + sovRpc(uint64(l))
 	l = m.Message.Size()
 	n += 1 + l + sovRpc(uint64(l))
+	if m.SnapshotRejection != nil {
+		l = m.SnapshotRejection.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -378,6 +397,39 @@ func (m *RaftMessageRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRejection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotRejection == nil {
+				m.SnapshotRejection = &SnapshotRejection{}
+			}
+			if err := m.SnapshotRejection.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
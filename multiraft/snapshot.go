@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// An EventSnapshotRejected is broadcast on the sender of a snapshot
+// whenever the recipient refuses to apply it.
+type EventSnapshotRejected struct {
+	GroupID   roachpb.RangeID
+	Replica   roachpb.ReplicaDescriptor
+	Rejection SnapshotRejection
+}
+
+// An EventSnapshotSent is broadcast on the leader of a group whenever it
+// sends a snapshot to a follower. Match is the index up to which the
+// follower's log was known to match the leader's, so that the follower
+// could have caught up from the log by receiving the entries from Match+1
+// through Index, had they not been truncated.
+type EventSnapshotSent struct {
+	GroupID roachpb.RangeID
+	Replica roachpb.ReplicaDescriptor
+	Index   uint64
+	Match   uint64
+	Size    int
+}
+
+// Error implements the error interface.
+func (r *SnapshotRejection) Error() string {
+	if r.Detail == "" {
+		return fmt.Sprintf("snapshot rejected: %s", r.Reason)
+	}
+	return fmt.Sprintf("snapshot rejected: %s: %s", r.Reason, r.Detail)
+}
+
+// sendSnapshotRejection tells the sender of a dropped snapshot why the
+// recipient refused to apply it. The notice is encoded as an
+// MsgSnapStatus, which is never sent over the wire otherwise.
+func (s *state) sendSnapshotRejection(req *RaftMessageRequest, rejection *SnapshotRejection) {
+	if log.V(1) {
+		log.Infof("node %v: rejecting snapshot of group %v from %v: %s",
+			s.nodeID, req.GroupID, req.FromReplica, rejection)
+	}
+	if err := s.Transport.Send(&RaftMessageRequest{
+		GroupID:     req.GroupID,
+		FromReplica: req.ToReplica,
+		ToReplica:   req.FromReplica,
+		Message: raftpb.Message{
+			Type:   raftpb.MsgSnapStatus,
+			From:   req.Message.To,
+			To:     req.Message.From,
+			Reject: true,
+		},
+		SnapshotRejection: rejection,
+	}); err != nil {
+		if log.V(1) {
+			log.Infof("node %v: failed to send snapshot rejection for group %v to %v: %s",
+				s.nodeID, req.GroupID, req.FromReplica, err)
+		}
+	}
+}
+
+// handleSnapshotRejection processes a notice from a follower which
+// refused to apply a snapshot. The follower is moved into probing mode
+// so that the leader backs off instead of immediately sending another
+// snapshot, and the application is informed.
+func (s *state) handleSnapshotRejection(req *RaftMessageRequest) {
+	if _, ok := s.groups[req.GroupID]; !ok {
+		return
+	}
+	s.multiNode.ReportUnreachable(req.Message.From, uint64(req.GroupID))
+	s.sendEvent(&EventSnapshotRejected{
+		GroupID:   req.GroupID,
+		Replica:   req.FromReplica,
+		Rejection: *req.SnapshotRejection,
+	})
+}
+
+// reportSnapshotSent informs the application that a snapshot was sent to
+// a follower, and how far behind the follower was.
+func (s *state) reportSnapshotSent(g *group, toReplica roachpb.ReplicaDescriptor, msg raftpb.Message) {
+	var match uint64
+	if status := s.multiNode.Status(uint64(g.groupID)); status != nil {
+		match = status.Progress[msg.To].Match
+	}
+	s.sendEvent(&EventSnapshotSent{
+		GroupID: g.groupID,
+		Replica: toReplica,
+		Index:   msg.Snapshot.Metadata.Index,
+		Match:   match,
+		Size:    len(msg.Snapshot.Data),
+	})
+}
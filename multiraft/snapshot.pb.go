@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-gogo.
+// source: cockroach/multiraft/snapshot.proto
+// DO NOT EDIT!
+
+package multiraft
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// discarding unused import gogoproto "github.com/cockroachdb/gogoproto"
+
+import github_com_cockroachdb_cockroach_roachpb "github.com/cockroachdb/cockroach/roachpb"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// SnapshotRejectionReason enumerates the reasons for which a store
+// refuses to apply a snapshot.
+type SnapshotRejectionReason int32
+
+const (
+	// The snapshot could not be decoded.
+	SNAPSHOT_INVALID SnapshotRejectionReason = 0
+	// The key span of the snapshot overlaps that of a replica of another
+	// range on the store. The conflict is resolved once that range splits.
+	SNAPSHOT_OVERLAPPING_RANGE SnapshotRejectionReason = 1
+	// The key span of the snapshot overlaps that of a replica which has
+	// been removed from its range but not garbage collected yet.
+	SNAPSHOT_AWAITING_GC SnapshotRejectionReason = 2
+)
+
+var SnapshotRejectionReason_name = map[int32]string{
+	0: "SNAPSHOT_INVALID",
+	1: "SNAPSHOT_OVERLAPPING_RANGE",
+	2: "SNAPSHOT_AWAITING_GC",
+}
+var SnapshotRejectionReason_value = map[string]int32{
+	"SNAPSHOT_INVALID":           0,
+	"SNAPSHOT_OVERLAPPING_RANGE": 1,
+	"SNAPSHOT_AWAITING_GC":       2,
+}
+
+func (x SnapshotRejectionReason) Enum() *SnapshotRejectionReason {
+	p := new(SnapshotRejectionReason)
+	*p = x
+	return p
+}
+func (x SnapshotRejectionReason) String() string {
+	return proto.EnumName(SnapshotRejectionReason_name, int32(x))
+}
+func (x *SnapshotRejectionReason) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(SnapshotRejectionReason_value, data, "SnapshotRejectionReason")
+	if err != nil {
+		return err
+	}
+	*x = SnapshotRejectionReason(value)
+	return nil
+}
+
+// A SnapshotRejection is sent back to the sender of a snapshot which the
+// recipient refused to apply, telling it why.
+type SnapshotRejection struct {
+	Reason SnapshotRejectionReason `protobuf:"varint,1,opt,name=reason,enum=cockroach.multiraft.SnapshotRejectionReason" json:"reason"`
+	// The ID of the range whose replica overlaps the snapshot, if any.
+	ConflictingRangeID github_com_cockroachdb_cockroach_roachpb.RangeID `protobuf:"varint,2,opt,name=conflicting_range_id,casttype=github.com/cockroachdb/cockroach/roachpb.RangeID" json:"conflicting_range_id"`
+	// A description of the rejection for humans.
+	Detail string `protobuf:"bytes,3,opt,name=detail" json:"detail"`
+}
+
+func (m *SnapshotRejection) Reset()         { *m = SnapshotRejection{} }
+func (m *SnapshotRejection) String() string { return proto.CompactTextString(m) }
+func (*SnapshotRejection) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterEnum("cockroach.multiraft.SnapshotRejectionReason", SnapshotRejectionReason_name, SnapshotRejectionReason_value)
+}
+func (m *SnapshotRejection) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SnapshotRejection) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintSnapshot(data, i, uint64(m.Reason))
+	data[i] = 0x10
+	i++
+	i = encodeVarintSnapshot(data, i, uint64(m.ConflictingRangeID))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintSnapshot(data, i, uint64(len(m.Detail)))
+	i += copy(data[i:], m.Detail)
+	return i, nil
+}
+
+func encodeFixed64Snapshot(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Snapshot(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintSnapshot(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SnapshotRejection) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovSnapshot(uint64(m.Reason))
+	n += 1 + sovSnapshot(uint64(m.ConflictingRangeID))
+	l = len(m.Detail)
+	n += 1 + l + sovSnapshot(uint64(l))
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SnapshotRejection) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotRejection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotRejection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Reason |= (SnapshotRejectionReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingRangeID", wireType)
+			}
+			m.ConflictingRangeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ConflictingRangeID |= (github_com_cockroachdb_cockroach_roachpb.RangeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSnapshot(data[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSnapshot = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,217 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// MessageOutcome describes what happened to a raft message sent to or
+// received from a peer.
+type MessageOutcome int
+
+const (
+	// MessageSent is recorded for outgoing messages handed to the
+	// transport.
+	MessageSent MessageOutcome = iota
+	// MessageSendFailed is recorded for outgoing messages which the
+	// transport failed to send.
+	MessageSendFailed
+	// MessageStepped is recorded for incoming messages which were
+	// stepped into their group.
+	MessageStepped
+	// MessageStepFailed is recorded for incoming messages which raft
+	// refused to step.
+	MessageStepFailed
+	// MessageDropped is recorded for messages which were discarded
+	// before reaching the transport or raft, for instance because
+	// their recipient could not be resolved.
+	MessageDropped
+)
+
+var messageOutcomeNames = [...]string{
+	MessageSent:       "sent",
+	MessageSendFailed: "send-failed",
+	MessageStepped:    "stepped",
+	MessageStepFailed: "step-failed",
+	MessageDropped:    "dropped",
+}
+
+// String implements fmt.Stringer.
+func (o MessageOutcome) String() string {
+	if o >= 0 && int(o) < len(messageOutcomeNames) {
+		return messageOutcomeNames[o]
+	}
+	return fmt.Sprintf("MessageOutcome(%d)", int(o))
+}
+
+// MessageStatsKey identifies a class of raft messages exchanged with a
+// peer. Peer is the remote store, which is zero if it could not be
+// determined.
+type MessageStatsKey struct {
+	Peer    roachpb.StoreID
+	Type    raftpb.MessageType
+	Outcome MessageOutcome
+}
+
+// MessageStats counts raft messages by peer, type and outcome.
+type MessageStats map[MessageStatsKey]int64
+
+// messageStats is a MessageStats which is updated by the state loop and
+// may be read concurrently.
+type messageStats struct {
+	sync.Mutex
+	stats MessageStats
+}
+
+func (ms *messageStats) record(peer roachpb.StoreID, typ raftpb.MessageType, outcome MessageOutcome) {
+	ms.Lock()
+	defer ms.Unlock()
+	if ms.stats == nil {
+		ms.stats = MessageStats{}
+	}
+	ms.stats[MessageStatsKey{Peer: peer, Type: typ, Outcome: outcome}]++
+}
+
+// snapshot returns a copy of the current counts.
+func (ms *messageStats) snapshot() MessageStats {
+	ms.Lock()
+	defer ms.Unlock()
+	stats := make(MessageStats, len(ms.stats))
+	for k, v := range ms.stats {
+		stats[k] = v
+	}
+	return stats
+}
+
+// A LoopStage is a stage of the processing done by the raft state loop.
+type LoopStage int
+
+const (
+	// StageIdle is the time spent waiting for work.
+	StageIdle LoopStage = iota
+	// StageMessages is the handling of incoming raft messages.
+	StageMessages
+	// StageGroupOps is the creation and removal of groups.
+	StageGroupOps
+	// StageProposals is the handling of proposed commands.
+	StageProposals
+	// StageReady is the processing of ready groups before they are
+	// handed to the write task.
+	StageReady
+	// StageWriteWait is the time spent waiting for the write task to
+	// persist the ready groups.
+	StageWriteWait
+	// StageWriteResponse is the processing of persisted ready groups,
+	// which sends their messages and queues their events.
+	StageWriteResponse
+	// StageTick is the ticking of the groups and sending of heartbeats.
+	StageTick
+	// StageCallback is the running of callbacks in the state loop.
+	StageCallback
+	// StagePeerHealth is the handling of peer health reports.
+	StagePeerHealth
+	// StageEventSend is the time events are pending until the consumer
+	// of the Events channel accepts them.
+	StageEventSend
+	numLoopStages
+)
+
+var loopStageNames = [...]string{
+	StageIdle:          "idle",
+	StageMessages:      "messages",
+	StageGroupOps:      "group-ops",
+	StageProposals:     "proposals",
+	StageReady:         "ready",
+	StageWriteWait:     "write-wait",
+	StageWriteResponse: "write-response",
+	StageTick:          "tick",
+	StageCallback:      "callback",
+	StagePeerHealth:    "peer-health",
+	StageEventSend:     "event-send",
+}
+
+// String implements fmt.Stringer.
+func (s LoopStage) String() string {
+	if s >= 0 && s < numLoopStages {
+		return loopStageNames[s]
+	}
+	return fmt.Sprintf("LoopStage(%d)", int(s))
+}
+
+// LoopStageStats summarizes the time the state loop spent in a stage.
+type LoopStageStats struct {
+	Count    int64         // The number of times the stage was entered
+	Total    time.Duration // The combined time spent in the stage
+	P50, P99 time.Duration // Percentiles over the most recent durations
+}
+
+// LoopStats summarizes the time the state loop spent in each stage.
+type LoopStats map[LoopStage]LoopStageStats
+
+// loopStats records the durations of the state loop's stages. It is
+// updated by the state loop and may be read concurrently.
+type loopStats struct {
+	stages [numLoopStages]struct {
+		count, nanos metric.Counter
+		durations    metric.Histogram
+	}
+}
+
+func (ls *loopStats) record(stage LoopStage, d time.Duration) {
+	s := &ls.stages[stage]
+	s.count.Inc(1)
+	s.nanos.Inc(d.Nanoseconds())
+	s.durations.RecordValue(d.Nanoseconds())
+}
+
+// snapshot returns the current summary of the stages which were entered
+// at least once.
+func (ls *loopStats) snapshot() LoopStats {
+	stats := LoopStats{}
+	for i := range ls.stages {
+		s := &ls.stages[i]
+		if count := s.count.Count(); count > 0 {
+			stats[LoopStage(i)] = LoopStageStats{
+				Count: count,
+				Total: time.Duration(s.nanos.Count()),
+				P50:   time.Duration(s.durations.Quantile(0.5)),
+				P99:   time.Duration(s.durations.Quantile(0.99)),
+			}
+		}
+	}
+	return stats
+}
+
+// A loopTimer attributes the time of the state loop to its stages.
+type loopTimer struct {
+	stats *loopStats
+	start time.Time
+}
+
+// lap records the time since the previous lap as spent in the given
+// stage.
+func (t *loopTimer) lap(stage LoopStage) {
+	now := time.Now()
+	t.stats.record(stage, now.Sub(t.start))
+	t.start = now
+}
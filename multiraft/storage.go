@@ -53,11 +53,18 @@ type Storage interface {
 	ReplicaIDForStore(groupID roachpb.RangeID, storeID roachpb.StoreID) (roachpb.ReplicaID, error)
 	ReplicasFromSnapshot(snap raftpb.Snapshot) ([]roachpb.ReplicaDescriptor, error)
 
-	// CanApplySnapshot should return false if attempting to apply the
-	// given snapshot would result in an error. This allows snapshots to
-	// be dropped cleanly since errors deep inside raft often result in
-	// panics.
-	CanApplySnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) bool
+	// CanApplySnapshot should return a SnapshotRejection describing why
+	// if attempting to apply the given snapshot would result in an error,
+	// and nil otherwise. This allows snapshots to be dropped cleanly since
+	// errors deep inside raft often result in panics. The rejection is
+	// sent back to the sender of the snapshot.
+	CanApplySnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) *SnapshotRejection
+
+	// WitnessSnapshot returns a copy of the given snapshot of the
+	// specified group which is suitable for sending to a witness
+	// replica (see roachpb.ReplicaDescriptor), i.e. one which contains
+	// no user data.
+	WitnessSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) (raftpb.Snapshot, error)
 
 	// GroupLocker returns a lock which (if non-nil) will be acquired
 	// when a group is being created (which entails multiple calls to
@@ -67,6 +74,51 @@ type Storage interface {
 	GroupLocker() sync.Locker
 }
 
+// GroupCommitStorage is an optional interface which a Storage may
+// implement to persist the raft state of all groups written in a single
+// Ready cycle together (group commit), e.g. with one engine batch and a
+// single sync instead of one per group.
+type GroupCommitStorage interface {
+	// NewAppendBatch returns a new AppendBatch, or nil if group commit is
+	// disabled, in which case each group is written individually.
+	NewAppendBatch() AppendBatch
+}
+
+// GroupCreationObserver is an optional interface which a Storage may
+// implement to learn about the groups created in response to incoming
+// messages, e.g. to track replicas which are waiting for a snapshot.
+type GroupCreationObserver interface {
+	// GroupCreatedByMessage is called after the given group was created
+	// in response to a message of the given type from the given replica.
+	GroupCreatedByMessage(groupID roachpb.RangeID, from roachpb.ReplicaDescriptor, msgType raftpb.MessageType)
+}
+
+// A PeerFilter is an optional interface which a Storage may implement to
+// drop the messages of misbehaving stores before they are processed.
+type PeerFilter interface {
+	// AcceptMessageFrom returns whether the messages of the given store
+	// are to be processed. It is not consulted for heartbeats.
+	AcceptMessageFrom(storeID roachpb.StoreID) bool
+	// MessageRejected is called when a message of the given store is
+	// dropped because it is badly addressed or carries an invalid
+	// snapshot.
+	MessageRejected(storeID roachpb.StoreID, reason string)
+}
+
+// AppendBatch accumulates HardState updates and log appends for multiple
+// groups, none of which are visible until Commit is called.
+type AppendBatch interface {
+	// SetHardState stages a HardState update for the given group.
+	SetHardState(group WriteableGroupStorage, st raftpb.HardState) error
+	// Append stages log entries for the given group. Each group is
+	// passed to Append at most once per batch.
+	Append(group WriteableGroupStorage, entries []raftpb.Entry) error
+	// Commit atomically persists all staged writes.
+	Commit() error
+	// Close releases the resources held by the batch.
+	Close()
+}
+
 // The StateMachine interface is supplied by the application to manage a persistent
 // state machine (in Cockroach the StateMachine and the Storage are the same thing
 // but they are logically distinct and systems like etcd keep them separate).
@@ -125,8 +177,14 @@ func (m *MemoryStorage) ReplicasFromSnapshot(_ raftpb.Snapshot) ([]roachpb.Repli
 }
 
 // CanApplySnapshot implements the Storage interface.
-func (m *MemoryStorage) CanApplySnapshot(_ roachpb.RangeID, _ raftpb.Snapshot) bool {
-	return true
+func (m *MemoryStorage) CanApplySnapshot(_ roachpb.RangeID, _ raftpb.Snapshot) *SnapshotRejection {
+	return nil
+}
+
+// WitnessSnapshot implements the Storage interface. Snapshots of a
+// MemoryStorage hold no data, so the snapshot is returned unchanged.
+func (m *MemoryStorage) WitnessSnapshot(_ roachpb.RangeID, snap raftpb.Snapshot) (raftpb.Snapshot, error) {
+	return snap, nil
 }
 
 // GroupLocker implements the Storage interface by returning nil.
@@ -145,11 +203,38 @@ type groupWriteRequest struct {
 // writeRequest is a collection of groupWriteRequests.
 type writeRequest struct {
 	groups map[roachpb.RangeID]*groupWriteRequest
+	// free holds the groupWriteRequests released by reset, which are
+	// reused by addGroup.
+	free []*groupWriteRequest
 }
 
 // newWriteRequest creates a writeRequest.
 func newWriteRequest() *writeRequest {
-	return &writeRequest{make(map[roachpb.RangeID]*groupWriteRequest)}
+	return &writeRequest{groups: make(map[roachpb.RangeID]*groupWriteRequest)}
+}
+
+// addGroup returns an empty groupWriteRequest for the given group, which
+// is added to the request.
+func (w *writeRequest) addGroup(groupID roachpb.RangeID) *groupWriteRequest {
+	var gwr *groupWriteRequest
+	if n := len(w.free); n > 0 {
+		gwr, w.free = w.free[n-1], w.free[:n-1]
+	} else {
+		gwr = &groupWriteRequest{}
+	}
+	w.groups[groupID] = gwr
+	return gwr
+}
+
+// reset empties the request so that it can be reused, keeping its map
+// and groupWriteRequests allocated. References to raft entries and
+// snapshots are dropped so they can be collected.
+func (w *writeRequest) reset() {
+	for groupID, gwr := range w.groups {
+		*gwr = groupWriteRequest{}
+		w.free = append(w.free, gwr)
+		delete(w.groups, groupID)
+	}
 }
 
 // groupWriteResponse represents the final state of a persistent group.
@@ -166,6 +251,39 @@ type groupWriteResponse struct {
 // writeResponse is a collection of groupWriteResponses.
 type writeResponse struct {
 	groups map[roachpb.RangeID]*groupWriteResponse
+	// free holds the groupWriteResponses released by reset, which are
+	// reused by addGroup.
+	free []*groupWriteResponse
+}
+
+// newWriteResponse creates a writeResponse.
+func newWriteResponse() *writeResponse {
+	return &writeResponse{groups: make(map[roachpb.RangeID]*groupWriteResponse)}
+}
+
+// addGroup returns a groupWriteResponse for the given group, initialized
+// with unchanged state and the given entries, which is added to the
+// response.
+func (w *writeResponse) addGroup(groupID roachpb.RangeID, entries []raftpb.Entry) *groupWriteResponse {
+	var gwr *groupWriteResponse
+	if n := len(w.free); n > 0 {
+		gwr, w.free = w.free[n-1], w.free[:n-1]
+	} else {
+		gwr = &groupWriteResponse{}
+	}
+	*gwr = groupWriteResponse{raftpb.HardState{}, -1, -1, entries}
+	w.groups[groupID] = gwr
+	return gwr
+}
+
+// reset empties the response so that it can be reused, keeping its map
+// and groupWriteResponses allocated.
+func (w *writeResponse) reset() {
+	for groupID, gwr := range w.groups {
+		*gwr = groupWriteResponse{}
+		w.free = append(w.free, gwr)
+		delete(w.groups, groupID)
+	}
 }
 
 // writeTask manages a goroutine that interacts with the storage system.
@@ -179,18 +297,34 @@ type writeTask struct {
 	// For every request written to 'in', one response will be written to 'out'.
 	in  chan *writeRequest
 	out chan *writeResponse
+
+	// request and response are reused for every write. This is safe
+	// because a request is only prepared once the response to the
+	// previous one has been handled; see nextRequest.
+	request  *writeRequest
+	response *writeResponse
 }
 
 // newWriteTask creates a writeTask. The caller should start the task after creating it.
 func newWriteTask(storage Storage) *writeTask {
 	return &writeTask{
-		storage: storage,
-		ready:   make(chan struct{}),
-		in:      make(chan *writeRequest, 1),
-		out:     make(chan *writeResponse, 1),
+		storage:  storage,
+		ready:    make(chan struct{}),
+		in:       make(chan *writeRequest, 1),
+		out:      make(chan *writeResponse, 1),
+		request:  newWriteRequest(),
+		response: newWriteResponse(),
 	}
 }
 
+// nextRequest returns an empty writeRequest to be filled and sent to the
+// task. The request is reused, so it must not be called again until the
+// response to the previous request has been received and handled.
+func (w *writeTask) nextRequest() *writeRequest {
+	w.request.reset()
+	return w.request
+}
+
 // start runs the storage loop in a goroutine.
 func (w *writeTask) start(stopper *stop.Stopper) {
 	stopper.RunWorker(func() {
@@ -206,7 +340,14 @@ func (w *writeTask) start(stopper *stop.Stopper) {
 			if log.V(6) {
 				log.Infof("writeTask got request %#v", *request)
 			}
-			response := &writeResponse{make(map[roachpb.RangeID]*groupWriteResponse)}
+			response := w.response
+			response.reset()
+
+			var batch AppendBatch
+			var batched int
+			if gcs, ok := w.storage.(GroupCommitStorage); ok {
+				batch = gcs.NewAppendBatch()
+			}
 
 			for groupID, groupReq := range request.groups {
 				group, err := w.storage.GroupStorage(groupID, groupReq.replicaID)
@@ -219,8 +360,25 @@ func (w *writeTask) start(stopper *stop.Stopper) {
 					log.Fatalf("GroupStorage(group %s, replica %s) failed: %s", groupID,
 						groupReq.replicaID, err)
 				}
-				groupResp := &groupWriteResponse{raftpb.HardState{}, -1, -1, groupReq.entries}
-				response.groups[groupID] = groupResp
+				groupResp := response.addGroup(groupID, groupReq.entries)
+				// Snapshots replace the group's state wholesale and are
+				// rare, so groups which apply one bypass the batch.
+				if batch != nil && raft.IsEmptySnap(groupReq.snapshot) {
+					if !raft.IsEmptyHardState(groupReq.state) {
+						if err := batch.SetHardState(group, groupReq.state); err != nil {
+							log.Fatalf("group commit of HardState for group %s failed: %s", groupID, err)
+						}
+						groupResp.state = groupReq.state
+					}
+					if len(groupReq.entries) > 0 {
+						if err := batch.Append(group, groupReq.entries); err != nil {
+							log.Fatalf("group commit of %d log entries for group %s failed: %s",
+								len(groupReq.entries), groupID, err)
+						}
+					}
+					batched++
+					continue
+				}
 				if !raft.IsEmptyHardState(groupReq.state) {
 					err := group.SetHardState(groupReq.state)
 					if err != nil {
@@ -241,6 +399,12 @@ func (w *writeTask) start(stopper *stop.Stopper) {
 					}
 				}
 			}
+			if batch != nil {
+				if err := batch.Commit(); err != nil {
+					log.Fatalf("group commit of %d groups failed: %s", batched, err)
+				}
+				batch.Close()
+			}
 			w.out <- response
 		}
 	})
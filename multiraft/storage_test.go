@@ -53,10 +53,14 @@ func (b *BlockableStorage) ReplicasFromSnapshot(snap raftpb.Snapshot) ([]roachpb
 	return b.storage.ReplicasFromSnapshot(snap)
 }
 
-func (b *BlockableStorage) CanApplySnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) bool {
+func (b *BlockableStorage) CanApplySnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) *SnapshotRejection {
 	return b.storage.CanApplySnapshot(groupID, snap)
 }
 
+func (b *BlockableStorage) WitnessSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) (raftpb.Snapshot, error) {
+	return b.storage.WitnessSnapshot(groupID, snap)
+}
+
 func (b *BlockableStorage) GroupLocker() sync.Locker {
 	return b.storage.GroupLocker()
 }
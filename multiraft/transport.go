@@ -109,7 +109,14 @@ func (lt *localRPCTransport) Listen(id roachpb.StoreID, server ServerInterface)
 	lt.servers[id] = rpcServer
 	lt.mu.Unlock()
 
-	return rpcServer.Start()
+	if err := rpcServer.Start(); err != nil {
+		// Unregister the server so that Listen may be retried.
+		lt.mu.Lock()
+		delete(lt.servers, id)
+		lt.mu.Unlock()
+		return err
+	}
+	return nil
 }
 
 func (lt *localRPCTransport) Stop(id roachpb.StoreID) {
@@ -60,14 +60,15 @@ func (ccid ClientCmdID) TraceName() string {
 }
 
 const (
-	isAdmin    = 1 << iota // admin cmds don't go through raft, but run on leader
-	isRead                 // read-only cmds don't go through raft, but may run on leader
-	isWrite                // write cmds go through raft and must be proposed on leader
-	isTxn                  // txn commands may be part of a transaction
-	isTxnWrite             // txn write cmds start heartbeat and are marked for intent resolution
-	isRange                // range commands may span multiple keys
-	isReverse              // reverse commands traverse ranges in descending direction
-	isAlone                // requests which must be alone in a batch
+	isAdmin        = 1 << iota // admin cmds don't go through raft, but run on leader
+	isRead                     // read-only cmds don't go through raft, but may run on leader
+	isWrite                    // write cmds go through raft and must be proposed on leader
+	isTxn                      // txn commands may be part of a transaction
+	isTxnWrite                 // txn write cmds start heartbeat and are marked for intent resolution
+	isRange                    // range commands may span multiple keys
+	isReverse                  // reverse commands traverse ranges in descending direction
+	isAlone                    // requests which must be alone in a batch
+	isFollowerRead             // read-only cmds which may be served by any replica, not only the leader
 )
 
 // IsReadOnly returns true iff the request is read-only.
@@ -88,6 +89,13 @@ func IsRange(args Request) bool {
 	return (args.flags() & isRange) != 0
 }
 
+// IsFollowerReadable returns true if the request may be served by any
+// replica of its range when it does not require consistency, instead of
+// only by the leader.
+func IsFollowerReadable(args Request) bool {
+	return (args.flags() & isFollowerRead) != 0
+}
+
 // Request is an interface for RPC requests.
 type Request interface {
 	proto.Message
@@ -182,6 +190,31 @@ func (rr *ResolveIntentRangeResponse) Combine(c Response) error {
 	return nil
 }
 
+// Combine implements the Combinable interface.
+func (ir *IngestResponse) Combine(c Response) error {
+	otherIR := c.(*IngestResponse)
+	if ir != nil {
+		ir.ExistingKeys = append(ir.ExistingKeys, otherIR.ExistingKeys...)
+		if err := ir.Header().Combine(otherIR.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Combine implements the Combinable interface.
+func (er *ExportResponse) Combine(c Response) error {
+	otherER := c.(*ExportResponse)
+	if er != nil {
+		er.Rows = append(er.Rows, otherER.Rows...)
+		er.DeletedKeys = append(er.DeletedKeys, otherER.DeletedKeys...)
+		if err := er.Header().Combine(otherER.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Header implements the Request interface for RequestHeader.
 func (rh *Span) Header() *Span {
 	return rh
@@ -264,6 +297,16 @@ func (sr *ReverseScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in ExportRequest.
+func (er *ExportRequest) GetBound() int64 {
+	return er.MaxResults
+}
+
+// SetBound sets the MaxResults field in ExportRequest.
+func (er *ExportRequest) SetBound(bound int64) {
+	er.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
@@ -280,6 +323,11 @@ func (sr *ReverseScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of rows and deleted keys in ExportResponse.
+func (er *ExportResponse) Count() int64 {
+	return int64(len(er.Rows) + len(er.DeletedKeys))
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -316,6 +364,18 @@ func (*AdminSplitRequest) Method() Method { return AdminSplit }
 // Method implements the Request interface.
 func (*AdminMergeRequest) Method() Method { return AdminMerge }
 
+// Method implements the Request interface.
+func (*AdminScatterRequest) Method() Method { return AdminScatter }
+
+// Method implements the Request interface.
+func (*AdminSetQueueExclusionRequest) Method() Method { return AdminSetQueueExclusion }
+
+// Method implements the Request interface.
+func (*IngestRequest) Method() Method { return Ingest }
+
+// Method implements the Request interface.
+func (*ExportRequest) Method() Method { return Export }
+
 // Method implements the Request interface.
 func (*HeartbeatTxnRequest) Method() Method { return HeartbeatTxn }
 
@@ -382,6 +442,20 @@ func (*AdminSplitRequest) CreateReply() Response { return &AdminSplitResponse{}
 // CreateReply implements the Request interface.
 func (*AdminMergeRequest) CreateReply() Response { return &AdminMergeResponse{} }
 
+// CreateReply implements the Request interface.
+func (*AdminScatterRequest) CreateReply() Response { return &AdminScatterResponse{} }
+
+// CreateReply implements the Request interface.
+func (*AdminSetQueueExclusionRequest) CreateReply() Response {
+	return &AdminSetQueueExclusionResponse{}
+}
+
+// CreateReply implements the Request interface.
+func (*IngestRequest) CreateReply() Response { return &IngestResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ExportRequest) CreateReply() Response { return &ExportResponse{} }
+
 // CreateReply implements the Request interface.
 func (*HeartbeatTxnRequest) CreateReply() Response { return &HeartbeatTxnResponse{} }
 
@@ -507,25 +581,29 @@ func NewReverseScan(key, endKey Key, maxResults int64) Request {
 	}
 }
 
-func (*GetRequest) flags() int                { return isRead | isTxn }
-func (*PutRequest) flags() int                { return isWrite | isTxn | isTxnWrite }
-func (*ConditionalPutRequest) flags() int     { return isRead | isWrite | isTxn | isTxnWrite }
-func (*IncrementRequest) flags() int          { return isRead | isWrite | isTxn | isTxnWrite }
-func (*DeleteRequest) flags() int             { return isWrite | isTxn | isTxnWrite }
-func (*DeleteRangeRequest) flags() int        { return isWrite | isTxn | isTxnWrite | isRange }
-func (*ScanRequest) flags() int               { return isRead | isRange | isTxn }
-func (*ReverseScanRequest) flags() int        { return isRead | isRange | isReverse | isTxn }
-func (*BeginTransactionRequest) flags() int   { return isWrite | isTxn }
-func (*EndTransactionRequest) flags() int     { return isWrite | isTxn | isAlone }
-func (*AdminSplitRequest) flags() int         { return isAdmin | isAlone }
-func (*AdminMergeRequest) flags() int         { return isAdmin | isAlone }
-func (*HeartbeatTxnRequest) flags() int       { return isWrite | isTxn }
-func (*GCRequest) flags() int                 { return isWrite | isRange }
-func (*PushTxnRequest) flags() int            { return isWrite }
-func (*RangeLookupRequest) flags() int        { return isRead | isTxn }
-func (*ResolveIntentRequest) flags() int      { return isWrite }
-func (*ResolveIntentRangeRequest) flags() int { return isWrite | isRange }
-func (*NoopRequest) flags() int               { return isRead } // slightly special
-func (*MergeRequest) flags() int              { return isWrite }
-func (*TruncateLogRequest) flags() int        { return isWrite }
-func (*LeaderLeaseRequest) flags() int        { return isWrite }
+func (*GetRequest) flags() int                    { return isRead | isTxn | isFollowerRead }
+func (*PutRequest) flags() int                    { return isWrite | isTxn | isTxnWrite }
+func (*ConditionalPutRequest) flags() int         { return isRead | isWrite | isTxn | isTxnWrite }
+func (*IncrementRequest) flags() int              { return isRead | isWrite | isTxn | isTxnWrite }
+func (*DeleteRequest) flags() int                 { return isWrite | isTxn | isTxnWrite }
+func (*DeleteRangeRequest) flags() int            { return isWrite | isTxn | isTxnWrite | isRange }
+func (*ScanRequest) flags() int                   { return isRead | isRange | isTxn | isFollowerRead }
+func (*ReverseScanRequest) flags() int            { return isRead | isRange | isReverse | isTxn | isFollowerRead }
+func (*BeginTransactionRequest) flags() int       { return isWrite | isTxn }
+func (*EndTransactionRequest) flags() int         { return isWrite | isTxn | isAlone }
+func (*AdminSplitRequest) flags() int             { return isAdmin | isAlone }
+func (*AdminMergeRequest) flags() int             { return isAdmin | isAlone }
+func (*AdminScatterRequest) flags() int           { return isAdmin | isAlone }
+func (*AdminSetQueueExclusionRequest) flags() int { return isWrite }
+func (*IngestRequest) flags() int                 { return isWrite | isRange }
+func (*ExportRequest) flags() int                 { return isRead | isRange }
+func (*HeartbeatTxnRequest) flags() int           { return isWrite | isTxn }
+func (*GCRequest) flags() int                     { return isWrite | isRange }
+func (*PushTxnRequest) flags() int                { return isWrite }
+func (*RangeLookupRequest) flags() int            { return isRead | isTxn | isFollowerRead }
+func (*ResolveIntentRequest) flags() int          { return isWrite }
+func (*ResolveIntentRangeRequest) flags() int     { return isWrite | isRange }
+func (*NoopRequest) flags() int                   { return isRead } // slightly special
+func (*MergeRequest) flags() int                  { return isWrite }
+func (*TruncateLogRequest) flags() int            { return isWrite }
+func (*LeaderLeaseRequest) flags() int            { return isWrite }
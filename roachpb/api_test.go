@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util/retry"
 )
 
@@ -205,3 +206,67 @@ func TestBatchSplit(t *testing.T) {
 		}
 	}
 }
+
+func TestBatchIsFollowerReadable(t *testing.T) {
+	get := &GetRequest{}
+	scan := &ScanRequest{}
+	rv := &ReverseScanRequest{}
+	rl := &RangeLookupRequest{}
+	noop := &NoopRequest{}
+	put := &PutRequest{}
+	testCases := []struct {
+		reqs   []Request
+		expect bool
+	}{
+		{nil, false},
+		{[]Request{get}, true},
+		{[]Request{get, scan, rv, rl}, true},
+		{[]Request{noop}, false},
+		{[]Request{get, noop}, false},
+		{[]Request{get, put}, false},
+	}
+
+	for i, test := range testCases {
+		ba := BatchRequest{}
+		ba.Add(test.reqs...)
+		if readable := ba.IsFollowerReadable(); readable != test.expect {
+			t.Errorf("%d: expected follower-readable=%t, got %t", i, test.expect, readable)
+		}
+	}
+}
+
+func TestBatchValidate(t *testing.T) {
+	get := &GetRequest{Span: Span{Key: Key("a")}}
+	put := &PutRequest{Span: Span{Key: Key("a")}}
+	scan := &ScanRequest{Span: Span{Key: Key("a"), EndKey: Key("b")}}
+	et := &EndTransactionRequest{}
+	spl := &AdminSplitRequest{Span: Span{Key: Key("a")}}
+	testCases := []struct {
+		reqs   []Request
+		expErr string
+	}{
+		{nil, ""},
+		{[]Request{get, put, scan, et}, ""},
+		{[]Request{spl}, ""},
+		{[]Request{spl, get}, "must be the only request"},
+		{[]Request{put, et, put}, "must be the last request"},
+		{[]Request{et, et}, "must be the last request"},
+		{[]Request{&ScanRequest{Span: Span{Key: Key("a")}}}, "requires an end key"},
+		{[]Request{&ScanRequest{Span: Span{Key: Key("b"), EndKey: Key("a")}}}, "must be greater than start key"},
+		{[]Request{&ScanRequest{Span: Span{Key: Key("a"), EndKey: Key("a")}}}, "must be greater than start key"},
+		{[]Request{&GetRequest{Span: Span{Key: Key("a"), EndKey: Key("b")}}}, "must not have an end key"},
+	}
+
+	for i, test := range testCases {
+		ba := BatchRequest{}
+		ba.Add(test.reqs...)
+		err := ba.Validate()
+		if test.expErr == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+		} else if !testutils.IsError(err, test.expErr) {
+			t.Errorf("%d: expected error %q, got %v", i, test.expErr, err)
+		}
+	}
+}
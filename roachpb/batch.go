@@ -18,6 +18,7 @@
 package roachpb
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -61,6 +62,52 @@ func (ba *BatchRequest) IsRange() bool {
 	return (ba.flags() & isRange) != 0
 }
 
+// IsFollowerReadable returns true iff the BatchRequest is not empty and
+// all requests within may be served by any replica when consistency is
+// not required.
+func (ba *BatchRequest) IsFollowerReadable() bool {
+	if len(ba.Requests) == 0 {
+		return false
+	}
+	for _, union := range ba.Requests {
+		if !IsFollowerReadable(union.GetInner()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the invariants which must hold for the requests of any
+// batch, regardless of where it is sent:
+// - an admin request must be the only request in its batch.
+// - there is at most one EndTransaction request, which must be the last
+//   request in the batch.
+// - ranged requests must have an end key which sorts after their start
+//   key; other requests must not have an end key.
+func (ba *BatchRequest) Validate() error {
+	for i, union := range ba.Requests {
+		req := union.GetInner()
+		if req.flags()&isAdmin != 0 && len(ba.Requests) > 1 {
+			return fmt.Errorf("admin request %s must be the only request in its batch", req.Method())
+		}
+		if req.Method() == EndTransaction && i != len(ba.Requests)-1 {
+			return fmt.Errorf("%s must be the last request in its batch", req.Method())
+		}
+		h := req.Header()
+		if IsRange(req) {
+			if len(h.EndKey) == 0 {
+				return fmt.Errorf("%s requires an end key", req.Method())
+			}
+			if bytes.Compare(h.Key, h.EndKey) >= 0 {
+				return fmt.Errorf("%s end key %q must be greater than start key %q", req.Method(), h.EndKey, h.Key)
+			}
+		} else if len(h.EndKey) != 0 {
+			return fmt.Errorf("%s must not have an end key (%q)", req.Method(), h.EndKey)
+		}
+	}
+	return nil
+}
+
 // GetArg returns the first request of the given type, if possible.
 func (ba *BatchRequest) GetArg(method Method) (Request, bool) {
 	// TODO(tschottdorf): when looking for EndTransaction, just look at the
@@ -284,12 +331,23 @@ func (ba BatchRequest) TraceID() string {
 }
 
 // TraceName implements tracer.Traceable and behaves like TraceID, but using
-// the TraceName of the object delegated to.
+// the TraceName of the object delegated to. The trace tags of the batch, if
+// any, are appended to the name.
 func (ba BatchRequest) TraceName() string {
+	var name string
 	if r := ba.Txn.TraceID(); r != "" {
-		return ba.Txn.TraceName()
+		name = ba.Txn.TraceName()
+	} else {
+		name = ba.CmdID.TraceName()
+	}
+	if len(ba.TraceTags) == 0 {
+		return name
+	}
+	tags := make([]string, len(ba.TraceTags))
+	for i, tag := range ba.TraceTags {
+		tags[i] = tag.Key + "=" + tag.Value
 	}
-	return ba.CmdID.TraceName()
+	return name + " [" + strings.Join(tags, ",") + "]"
 }
 
 // TODO(marc): we should assert
@@ -114,7 +114,11 @@ func MakeKey(keys ...[]byte) []byte {
 // Returns the next possible byte by appending an \x00.
 func bytesNext(b []byte) []byte {
 	// TODO(spencer): Do we need to enforce KeyMaxLength here?
-	return append(append([]byte(nil), b...), 0)
+	// Allocate the exact length up front; append would grow the slice
+	// twice for the copy plus the trailing \x00.
+	next := make([]byte, len(b)+1)
+	copy(next, b)
+	return next
 }
 
 func bytesPrefixEnd(b []byte) []byte {
@@ -135,6 +139,14 @@ func (k Key) Next() Key {
 	return Key(bytesNext(k))
 }
 
+// NextInto is like Next, but appends the result to buf[:0] instead of
+// allocating, so callers in tight loops can reuse a single buffer. The
+// returned key aliases buf (if it had sufficient capacity) and is only
+// valid until buf is reused.
+func (k Key) NextInto(buf []byte) Key {
+	return Key(append(append(buf[:0], k...), 0))
+}
+
 // IsPrev is a more efficient version of k.Next().Equal(m).
 func (k Key) IsPrev(m Key) bool {
 	l := len(m) - 1
@@ -157,6 +169,26 @@ func (k Key) PrefixEnd() Key {
 	return Key(bytesPrefixEnd(k))
 }
 
+// PrefixEndInto is like PrefixEnd, but writes the result into buf[:0]
+// instead of allocating. As with NextInto, the returned key aliases buf
+// (if it had sufficient capacity) and is only valid until buf is reused.
+// Unlike PrefixEnd, the result never aliases k or KeyMax, so it is always
+// safe to pass it back in as the next buffer.
+func (k Key) PrefixEndInto(buf []byte) Key {
+	if len(k) == 0 {
+		return Key(append(buf[:0], RKeyMax...))
+	}
+	end := append(buf[:0], k...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i] = end[i] + 1
+		if end[i] != 0 {
+			return Key(end)
+		}
+	}
+	// k is already maximal; undo the carry.
+	return Key(append(end[:0], k...))
+}
+
 // PrefixEnd determines the key directly after the last key which has
 // this key as a prefix. See comments for Key.
 func (k EncodedKey) PrefixEnd() EncodedKey {
@@ -392,6 +424,21 @@ func (v *Value) SetTime(t time.Time) {
 	v.Tag = ValueType_TIME
 }
 
+// SetArray encodes the specified element values into the bytes field of the
+// receiver and sets the tag. Each element retains its own tag; an element
+// with a nil bytes field and an UNKNOWN tag represents a missing (NULL)
+// element. Element timestamps and checksums are not encoded.
+func (v *Value) SetArray(elems []Value) {
+	b := encoding.EncodeUvarint(nil, uint64(len(elems)))
+	for _, e := range elems {
+		b = encoding.EncodeUvarint(b, uint64(e.Tag))
+		b = encoding.EncodeUvarint(b, uint64(len(e.RawBytes)))
+		b = append(b, e.RawBytes...)
+	}
+	v.RawBytes = b
+	v.Tag = ValueType_ARRAY
+}
+
 // GetBytes returns the bytes field of the receiver. If the tag is not
 // BYTES an error will be returned.
 func (v Value) GetBytes() ([]byte, error) {
@@ -465,6 +512,44 @@ func (v Value) GetTime() (time.Time, error) {
 	return t, nil
 }
 
+// GetArray decodes the element values from the bytes field of the receiver.
+// If the tag is not ARRAY or the bytes field is malformed an error will be
+// returned.
+func (v Value) GetArray() ([]Value, error) {
+	if tag := v.Tag; tag != ValueType_ARRAY {
+		return nil, fmt.Errorf("value type is not %s: %s", ValueType_ARRAY, tag)
+	}
+	b, n, err := encoding.DecodeUvarint(v.RawBytes)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(b)) {
+		return nil, fmt.Errorf("array length %d exceeds encoded size %d", n, len(b))
+	}
+	elems := make([]Value, n)
+	for i := range elems {
+		var tag, length uint64
+		if b, tag, err = encoding.DecodeUvarint(b); err != nil {
+			return nil, err
+		}
+		if b, length, err = encoding.DecodeUvarint(b); err != nil {
+			return nil, err
+		}
+		if length > uint64(len(b)) {
+			return nil, fmt.Errorf("array element %d should be %d bytes: %d", i, length, len(b))
+		}
+		elems[i].Tag = ValueType(tag)
+		if tag != uint64(ValueType_UNKNOWN) || length > 0 {
+			elems[i].RawBytes = b[:length:length]
+		}
+		b = b[length:]
+	}
+	if len(b) > 0 {
+		return nil, fmt.Errorf("array value has %d trailing bytes", len(b))
+	}
+	return elems, nil
+}
+
 var crc32Pool = sync.Pool{
 	New: func() interface{} {
 		return crc32.NewIEEE()
@@ -657,6 +742,12 @@ func (t *Transaction) Update(o *Transaction) {
 	// We can't assert against regression here since it can actually happen
 	// that we update from a transaction which isn't Writing.
 	t.Writing = t.Writing || o.Writing
+	if t.Sequence < o.Sequence {
+		t.Sequence = o.Sequence
+	}
+	if t.ReadSequence < o.ReadSequence {
+		t.ReadSequence = o.ReadSequence
+	}
 }
 
 // UpgradePriority sets transaction priority to the maximum of current
@@ -722,6 +813,9 @@ var _ fmt.Stringer = &Lease{}
 
 func (l Lease) String() string {
 	t := time.Unix(l.Start.WallTime/1E9, 0).UTC()
+	if l.Epoch != 0 {
+		return fmt.Sprintf("replica %s %s epoch %d", l.Replica, t, l.Epoch)
+	}
 	return fmt.Sprintf("replica %s %s +%.3fs", l.Replica, t, float64(l.Expiration.WallTime-l.Start.WallTime)/1E9)
 }
 
@@ -737,6 +831,12 @@ func (l Lease) OwnedBy(storeID StoreID) bool {
 	return l.Replica.StoreID == storeID
 }
 
+// IsLive returns whether the liveness record has not yet expired at the
+// given timestamp.
+func (l Liveness) IsLive(now Timestamp) bool {
+	return now.Less(l.Expiration)
+}
+
 // RSpan is a key range with an inclusive start RKey and an exclusive end RKey.
 type RSpan struct {
 	Key, EndKey RKey
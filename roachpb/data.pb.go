@@ -31,6 +31,9 @@ const (
 	ValueType_FLOAT   ValueType = 2
 	ValueType_BYTES   ValueType = 3
 	ValueType_TIME    ValueType = 4
+	// ARRAY is applied to values which contain a sequence of tagged element
+	// values. See Value.SetArray.
+	ValueType_ARRAY ValueType = 5
 	// TIMESERIES is applied to values which contain InternalTimeSeriesData.
 	ValueType_TIMESERIES ValueType = 100
 )
@@ -41,6 +44,7 @@ var ValueType_name = map[int32]string{
 	2:   "FLOAT",
 	3:   "BYTES",
 	4:   "TIME",
+	5:   "ARRAY",
 	100: "TIMESERIES",
 }
 var ValueType_value = map[string]int32{
@@ -49,6 +53,7 @@ var ValueType_value = map[string]int32{
 	"FLOAT":      2,
 	"BYTES":      3,
 	"TIME":       4,
+	"ARRAY":      5,
 	"TIMESERIES": 100,
 }
 
@@ -294,12 +299,22 @@ type ChangeReplicasTrigger struct {
 	// The new replica list with this change applied.
 	UpdatedReplicas []ReplicaDescriptor `protobuf:"bytes,3,rep,name=updated_replicas" json:"updated_replicas"`
 	NextReplicaID   ReplicaID           `protobuf:"varint,4,opt,name=next_replica_id,casttype=ReplicaID" json:"next_replica_id"`
+	// The range descriptor the change was computed against. Replicas refuse
+	// to apply the change unless their descriptor matches it.
+	OriginalDesc *RangeDescriptor `protobuf:"bytes,5,opt,name=original_desc" json:"original_desc,omitempty"`
 }
 
 func (m *ChangeReplicasTrigger) Reset()         { *m = ChangeReplicasTrigger{} }
 func (m *ChangeReplicasTrigger) String() string { return proto.CompactTextString(m) }
 func (*ChangeReplicasTrigger) ProtoMessage()    {}
 
+func (m *ChangeReplicasTrigger) GetOriginalDesc() *RangeDescriptor {
+	if m != nil {
+		return m.OriginalDesc
+	}
+	return nil
+}
+
 // ModifiedSpanTrigger indicates that a specific span has been modified.
 // This can be used to trigger scan-and-gossip for the given span.
 type ModifiedSpanTrigger struct {
@@ -421,6 +436,16 @@ type Transaction struct {
 	// Writing is true if the transaction has previously executed a successful
 	// write request, i.e. a request that may have left intents (across retries).
 	Writing bool `protobuf:"varint,13,opt,name=Writing" json:"Writing"`
+	// sequence is incremented by the coordinator for each batch of requests
+	// sent on behalf of the transaction. Replicas remember the highest
+	// sequence number applied for each transaction and reject batches which
+	// do not exceed it as replays.
+	Sequence uint32 `protobuf:"varint,14,opt,name=sequence" json:"sequence"`
+	// read_sequence, if nonzero, limits the transaction's reads of its own
+	// writes to those sent in batches with a sequence no greater than it.
+	// It is advanced at statement boundaries (see client.Txn.Step) so that a
+	// statement doesn't observe its own writes.
+	ReadSequence uint32 `protobuf:"varint,15,opt,name=read_sequence" json:"read_sequence"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -437,11 +462,44 @@ type Lease struct {
 	Expiration Timestamp `protobuf:"bytes,2,opt,name=expiration" json:"expiration"`
 	// The address of the would-be lease holder.
 	Replica ReplicaDescriptor `protobuf:"bytes,3,opt,name=replica" json:"replica"`
+	// The epoch of the holder's node liveness record which this lease is tied
+	// to. Zero for expiration-based leases; an epoch-based lease remains valid
+	// for as long as the holder's liveness epoch is unchanged and live.
+	Epoch int64 `protobuf:"varint,4,opt,name=epoch" json:"epoch"`
 }
 
 func (m *Lease) Reset()      { *m = Lease{} }
 func (*Lease) ProtoMessage() {}
 
+// Liveness holds information about a node's latest heartbeat and epoch.
+// Epoch-based leader leases remain valid for as long as the holder's
+// liveness epoch is unchanged and the record has not expired.
+type Liveness struct {
+	NodeID NodeID `protobuf:"varint,1,opt,name=node_id,casttype=NodeID" json:"node_id"`
+	// The epoch is incremented by other nodes which find the record expired,
+	// invalidating all epoch-based leases held by the node.
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch" json:"epoch"`
+	// The expiration is the timestamp at which the record, unless extended
+	// by a heartbeat, ceases to be live.
+	Expiration Timestamp `protobuf:"bytes,3,opt,name=expiration" json:"expiration"`
+}
+
+func (m *Liveness) Reset()         { *m = Liveness{} }
+func (m *Liveness) String() string { return proto.CompactTextString(m) }
+func (*Liveness) ProtoMessage()    {}
+
+// SequenceCacheEntry holds the highest sequence number of a transaction's
+// batches applied on a range, along with the timestamp of the batch which
+// recorded it.
+type SequenceCacheEntry struct {
+	Sequence  uint32    `protobuf:"varint,1,opt,name=sequence" json:"sequence"`
+	Timestamp Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
+}
+
+func (m *SequenceCacheEntry) Reset()         { *m = SequenceCacheEntry{} }
+func (m *SequenceCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*SequenceCacheEntry) ProtoMessage()    {}
+
 // Intent is used to communicate the location of an intent.
 type Intent struct {
 	Key    Key         `protobuf:"bytes,1,opt,name=key,casttype=Key" json:"key,omitempty"`
@@ -733,6 +791,16 @@ func (m *ChangeReplicasTrigger) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x20
 	i++
 	i = encodeVarintData(data, i, uint64(m.NextReplicaID))
+	if m.OriginalDesc != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintData(data, i, uint64(m.OriginalDesc.Size()))
+		n7, err := m.OriginalDesc.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
 	return i, nil
 }
 
@@ -949,6 +1017,12 @@ func (m *Transaction) MarshalTo(data []byte) (int, error) {
 		data[i] = 0
 	}
 	i++
+	data[i] = 0x70
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	data[i] = 0x78
+	i++
+	i = encodeVarintData(data, i, uint64(m.ReadSequence))
 	return i, nil
 }
 
@@ -991,6 +1065,70 @@ func (m *Lease) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n20
+	data[i] = 0x20
+	i++
+	i = encodeVarintData(data, i, uint64(m.Epoch))
+	return i, nil
+}
+
+func (m *Liveness) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Liveness) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.NodeID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.Epoch))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+	nl, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += nl
+	return i, nil
+}
+
+func (m *SequenceCacheEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceCacheEntry) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
+	n1, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -1179,6 +1317,10 @@ func (m *ChangeReplicasTrigger) Size() (n int) {
 		}
 	}
 	n += 1 + sovData(uint64(m.NextReplicaID))
+	if m.OriginalDesc != nil {
+		l = m.OriginalDesc.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
 	return n
 }
 
@@ -1254,6 +1396,8 @@ func (m *Transaction) Size() (n int) {
 	l = m.CertainNodes.Size()
 	n += 1 + l + sovData(uint64(l))
 	n += 2
+	n += 1 + sovData(uint64(m.Sequence))
+	n += 1 + sovData(uint64(m.ReadSequence))
 	return n
 }
 
@@ -1266,6 +1410,26 @@ func (m *Lease) Size() (n int) {
 	n += 1 + l + sovData(uint64(l))
 	l = m.Replica.Size()
 	n += 1 + l + sovData(uint64(l))
+	n += 1 + sovData(uint64(m.Epoch))
+	return n
+}
+
+func (m *Liveness) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.NodeID))
+	n += 1 + sovData(uint64(m.Epoch))
+	l = m.Expiration.Size()
+	n += 1 + l + sovData(uint64(l))
+	return n
+}
+
+func (m *SequenceCacheEntry) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Sequence))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovData(uint64(l))
 	return n
 }
 
@@ -2208,6 +2372,39 @@ func (m *ChangeReplicasTrigger) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDesc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OriginalDesc == nil {
+				m.OriginalDesc = &RangeDescriptor{}
+			}
+			if err := m.OriginalDesc.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(data[iNdEx:])
@@ -2956,6 +3153,44 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				}
 			}
 			m.Writing = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadSequence", wireType)
+			}
+			m.ReadSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ReadSequence |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(data[iNdEx:])
@@ -3096,6 +3331,243 @@ func (m *Lease) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Liveness) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Liveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Liveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SequenceCacheEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceCacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceCacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(data[iNdEx:])
@@ -58,6 +58,23 @@ func TestKeyNext(t *testing.T) {
 	}
 }
 
+func TestKeyNextInto(t *testing.T) {
+	var buf []byte
+	for i, k := range []Key{nil, Key(""), Key("test key"), Key("\xff"), Key("xoxo\x00")} {
+		buf = k.NextInto(buf)
+		if next := k.Next(); !bytes.Equal(buf, next) {
+			t.Errorf("%d: expected NextInto(%q) = %q, got %q", i, k, next, buf)
+		}
+	}
+
+	// A buffer with sufficient capacity must be reused.
+	buf = make([]byte, 0, 16)
+	next := Key("a").NextInto(buf)
+	if &next[0] != &buf[:1][0] {
+		t.Errorf("expected NextInto to reuse the supplied buffer")
+	}
+}
+
 func TestKeyPrefixEnd(t *testing.T) {
 	a := Key("a1")
 	aNext := a.Next()
@@ -141,6 +158,50 @@ func TestKeyCompare(t *testing.T) {
 
 // TestNextKey tests that the method for creating successors of a Key
 // works as expected.
+func TestKeyPrefixEndInto(t *testing.T) {
+	var buf []byte
+	for i, k := range []Key{{}, {0}, {0xff}, {0xff, 0xfe}, {0x00, 0xff, 0xff}, KeyMax} {
+		end := k.PrefixEndInto(buf)
+		if expEnd := k.PrefixEnd(); !bytes.Equal(end, expEnd) {
+			t.Errorf("%d: expected PrefixEndInto(%q) = %q, got %q", i, k, expEnd, end)
+		}
+		buf = end[:0]
+	}
+}
+
+// benchKeySink keeps the compiler from optimizing away benchmarked calls.
+var benchKeySink Key
+
+func BenchmarkKeyNext(b *testing.B) {
+	k := Key("some/reasonably/long/test/key")
+	for i := 0; i < b.N; i++ {
+		benchKeySink = k.Next()
+	}
+}
+
+func BenchmarkKeyNextInto(b *testing.B) {
+	k := Key("some/reasonably/long/test/key")
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		buf = k.NextInto(buf)
+	}
+}
+
+func BenchmarkKeyPrefixEnd(b *testing.B) {
+	k := Key("some/reasonably/long/test/key")
+	for i := 0; i < b.N; i++ {
+		benchKeySink = k.PrefixEnd()
+	}
+}
+
+func BenchmarkKeyPrefixEndInto(b *testing.B) {
+	k := Key("some/reasonably/long/test/key")
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		buf = k.PrefixEndInto(buf)
+	}
+}
+
 func TestNextKey(t *testing.T) {
 	testCases := []struct {
 		key  Key
@@ -237,6 +298,24 @@ func TestTimestampPrev(t *testing.T) {
 	}
 }
 
+func TestLivenessIsLive(t *testing.T) {
+	l := Liveness{NodeID: 1, Epoch: 1, Expiration: makeTS(10, 0)}
+	testCases := []struct {
+		now     Timestamp
+		expLive bool
+	}{
+		{makeTS(9, 0), true},
+		{makeTS(9, math.MaxInt32), true},
+		{makeTS(10, 0), false},
+		{makeTS(11, 0), false},
+	}
+	for i, c := range testCases {
+		if live := l.IsLive(c.now); live != c.expLive {
+			t.Errorf("%d: expected live=%t at %s; got %t", i, c.expLive, c.now, live)
+		}
+	}
+}
+
 func TestValueChecksumEmpty(t *testing.T) {
 	k := []byte("key")
 	v := Value{}
@@ -310,6 +389,49 @@ func TestSetGetChecked(t *testing.T) {
 	if _, err := v.GetTime(); err != nil {
 		t.Fatal(err)
 	}
+
+	v.SetArray(nil)
+	if _, err := v.GetArray(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValueArray(t *testing.T) {
+	var i, f, b Value
+	i.SetInt(7)
+	f.SetFloat(1.5)
+	b.SetBytes([]byte{})
+	testCases := [][]Value{
+		nil,
+		{i},
+		{i, {}, f},
+		{b, MakeValueFromString("abc"), {}},
+	}
+	for j, elems := range testCases {
+		var v Value
+		v.SetArray(elems)
+		decoded, err := v.GetArray()
+		if err != nil {
+			t.Fatalf("%d: %s", j, err)
+		}
+		if len(decoded) != len(elems) {
+			t.Fatalf("%d: expected %d elements, got %d", j, len(elems), len(decoded))
+		}
+		for k := range elems {
+			if elems[k].Tag != decoded[k].Tag ||
+				!bytes.Equal(elems[k].RawBytes, decoded[k].RawBytes) ||
+				(elems[k].RawBytes == nil) != (decoded[k].RawBytes == nil) {
+				t.Errorf("%d: expected element %d to be %+v, got %+v", j, k, elems[k], decoded[k])
+			}
+		}
+		// Truncated encodings must be rejected.
+		for n := 0; n < len(v.RawBytes); n++ {
+			truncated := Value{RawBytes: v.RawBytes[:n], Tag: ValueType_ARRAY}
+			if _, err := truncated.GetArray(); err == nil {
+				t.Errorf("%d: expected error decoding %d of %d bytes", j, n, len(v.RawBytes))
+			}
+		}
+	}
 }
 
 func TestTxnEqual(t *testing.T) {
@@ -417,6 +539,8 @@ func TestTransactionUpdate(t *testing.T) {
 		MaxTimestamp:  makeTS(40, 41),
 		CertainNodes:  nodes,
 		Writing:       true,
+		Sequence:      123,
+		ReadSequence:  120,
 	}
 
 	noZeroField := func(txn Transaction) error {
@@ -154,6 +154,13 @@ func (e *Error) GoError() error {
 	return err
 }
 
+// SetOrigin records the replica at which the error was produced along
+// with the clock reading of its node.
+func (e *Error) SetOrigin(origin ReplicaDescriptor, now Timestamp) {
+	e.OriginReplica = &origin
+	e.Now = &now
+}
+
 // SetGoError sets Error using err.
 func (e *Error) SetGoError(err error) {
 	if e.Message != "" {
@@ -206,6 +213,48 @@ func (s SendError) Error() string {
 // CanRetry implements the Retryable interface.
 func (s SendError) CanRetry() bool { return s.Retryable }
 
+// Error formats error.
+func (e *ServerOverloadedError) Error() string {
+	return "server overloaded: " + e.Message
+}
+
+// CanRetry indicates that the request may succeed once the load on the
+// node has subsided.
+func (e *ServerOverloadedError) CanRetry() bool {
+	return true
+}
+
+// Error formats error.
+func (e *StoreNearlyFullError) Error() string {
+	return fmt.Sprintf("store %d is nearly full; refusing write", e.StoreID)
+}
+
+// Error formats error.
+func (e *AmbiguousResultError) Error() string {
+	return "result is ambiguous: " + e.Message
+}
+
+// Error formats error.
+func (e *RangeUnavailableError) Error() string {
+	return fmt.Sprintf("range %d is unavailable: %s; raft status: %s", e.RangeID, e.Message, e.RaftStatus)
+}
+
+// Error formats error.
+func (e *AuthenticationFailedError) Error() string {
+	return fmt.Sprintf("authentication of user %s calling %s failed: %s", e.User, e.Method, e.Message)
+}
+
+// Error formats error.
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("user %s may not call %s: %s", e.User, e.Method, e.Message)
+}
+
+// Error formats error.
+func (e *CommandTooLargeError) Error() string {
+	return fmt.Sprintf("range %d: command of %d bytes exceeds the maximum command size of %d bytes; split the batch",
+		e.RangeID, e.CommandSize, e.MaxCommandSize)
+}
+
 // NewRangeNotFoundError initializes a new RangeNotFoundError.
 func NewRangeNotFoundError(rangeID RangeID) *RangeNotFoundError {
 	return &RangeNotFoundError{
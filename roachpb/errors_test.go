@@ -0,0 +1,174 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package roachpb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// TestErrorOrigin verifies that the origin of an error and the clock
+// reading at its origin survive encoding and conversion to a Go error.
+func TestErrorOrigin(t *testing.T) {
+	origin := ReplicaDescriptor{NodeID: 1, StoreID: 2, ReplicaID: 3}
+	now := Timestamp{WallTime: 10, Logical: 1}
+
+	pErr := NewError(&NotLeaderError{RangeID: 1})
+	pErr.SetOrigin(origin, now)
+
+	data, err := proto.Marshal(pErr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Error
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(pErr, &decoded) {
+		t.Errorf("expected %+v; got %+v", pErr, decoded)
+	}
+	if decoded.OriginReplica == nil || *decoded.OriginReplica != origin {
+		t.Errorf("expected origin %s; got %v", origin, decoded.OriginReplica)
+	}
+	if decoded.Now == nil || !decoded.Now.Equal(now) {
+		t.Errorf("expected clock reading %s; got %v", now, decoded.Now)
+	}
+	if _, ok := decoded.GoError().(*NotLeaderError); !ok {
+		t.Errorf("expected NotLeaderError; got %T", decoded.GoError())
+	}
+}
+
+// TestServerOverloadedError verifies that a ServerOverloadedError survives
+// encoding and is retryable.
+func TestServerOverloadedError(t *testing.T) {
+	pErr := NewError(&ServerOverloadedError{Message: "memory budget exhausted"})
+	if !pErr.Retryable {
+		t.Errorf("expected %s to be retryable", pErr)
+	}
+
+	data, err := proto.Marshal(pErr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Error
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(pErr, &decoded) {
+		t.Errorf("expected %+v; got %+v", pErr, decoded)
+	}
+	if _, ok := decoded.GoError().(*ServerOverloadedError); !ok {
+		t.Errorf("expected ServerOverloadedError; got %T", decoded.GoError())
+	}
+}
+
+// TestStoreNearlyFullError verifies that a StoreNearlyFullError survives
+// encoding and is not retryable.
+func TestStoreNearlyFullError(t *testing.T) {
+	pErr := NewError(&StoreNearlyFullError{StoreID: 3})
+	if pErr.Retryable {
+		t.Errorf("expected %s not to be retryable", pErr)
+	}
+
+	data, err := proto.Marshal(pErr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Error
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(pErr, &decoded) {
+		t.Errorf("expected %+v; got %+v", pErr, decoded)
+	}
+	if e, ok := decoded.GoError().(*StoreNearlyFullError); !ok || e.StoreID != 3 {
+		t.Errorf("expected StoreNearlyFullError for store 3; got %v", decoded.GoError())
+	}
+}
+
+// TestAmbiguousResultError verifies that an AmbiguousResultError survives
+// encoding and is not retryable.
+func TestAmbiguousResultError(t *testing.T) {
+	pErr := NewError(&AmbiguousResultError{Message: "connection lost"})
+	if pErr.Retryable {
+		t.Errorf("expected %s not to be retryable", pErr)
+	}
+
+	data, err := proto.Marshal(pErr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Error
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(pErr, &decoded) {
+		t.Errorf("expected %+v; got %+v", pErr, decoded)
+	}
+	if e, ok := decoded.GoError().(*AmbiguousResultError); !ok || e.Message != "connection lost" {
+		t.Errorf("expected AmbiguousResultError; got %v", decoded.GoError())
+	}
+}
+
+// TestAuthErrors verifies that AuthenticationFailedErrors and
+// PermissionDeniedErrors survive encoding and are not retryable.
+func TestAuthErrors(t *testing.T) {
+	for _, goErr := range []error{
+		&AuthenticationFailedError{User: "foo", Method: "Server.Batch", Message: "bad certificate"},
+		&PermissionDeniedError{User: "foo", Method: "Server.Batch", Message: "not allowed"},
+	} {
+		pErr := NewError(goErr)
+		if pErr.Retryable {
+			t.Errorf("expected %s not to be retryable", pErr)
+		}
+
+		data, err := proto.Marshal(pErr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Error
+		if err := proto.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(pErr, &decoded) {
+			t.Errorf("expected %+v; got %+v", pErr, decoded)
+		}
+		if e := decoded.GoError(); !reflect.DeepEqual(e, goErr) {
+			t.Errorf("expected %+v; got %+v", goErr, e)
+		}
+	}
+}
+
+func TestCommandTooLargeError(t *testing.T) {
+	goErr := &CommandTooLargeError{RangeID: 3, CommandSize: 2 << 20, MaxCommandSize: 1 << 20}
+	data, err := proto.Marshal(NewError(goErr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Error
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if e := decoded.GoError(); !reflect.DeepEqual(e, goErr) {
+		t.Errorf("expected %+v; got %+v", goErr, e)
+	}
+	if !strings.Contains(decoded.GoError().Error(), "split the batch") {
+		t.Errorf("expected the error to advise splitting the batch; got %s", decoded.GoError())
+	}
+}
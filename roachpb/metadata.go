@@ -19,6 +19,7 @@
 package roachpb
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -152,6 +153,14 @@ func (r *RangeDescriptor) Validate() error {
 	return nil
 }
 
+// String implements fmt.Stringer.
+func (r ReplicaDescriptor) String() string {
+	if r.Witness {
+		return fmt.Sprintf("{%s %s %s witness}", r.NodeID, r.StoreID, r.ReplicaID)
+	}
+	return fmt.Sprintf("{%s %s %s}", r.NodeID, r.StoreID, r.ReplicaID)
+}
+
 // Validate performs some basic validation of the contents of a replica descriptor.
 func (r ReplicaDescriptor) Validate() error {
 	if r.NodeID == 0 {
@@ -174,6 +183,12 @@ func (sc StoreCapacity) FractionUsed() float64 {
 	return float64(sc.Capacity-sc.Available) / float64(sc.Capacity)
 }
 
+// RefusesReplicas returns whether the store is nearly full or holds its
+// maximum number of replicas and thus refuses new ones.
+func (sc StoreCapacity) RefusesReplicas() bool {
+	return sc.NearlyFull || (sc.MaxRangeCount > 0 && sc.RangeCount >= sc.MaxRangeCount)
+}
+
 // CombinedAttrs returns the full list of attributes for the store, including
 // both the node and store attributes.
 func (s StoreDescriptor) CombinedAttrs() *Attributes {
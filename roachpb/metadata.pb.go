@@ -37,11 +37,14 @@ type ReplicaDescriptor struct {
 	// a store and then re-added to the same store, the new instance will have a
 	// higher replica_id.
 	ReplicaID ReplicaID `protobuf:"varint,3,opt,name=replica_id,casttype=ReplicaID" json:"replica_id"`
+	// witness is set for replicas which take part in raft elections and
+	// store the raft log but hold no user data. Witnesses never serve
+	// reads or hold the leader lease.
+	Witness bool `protobuf:"varint,4,opt,name=witness" json:"witness"`
 }
 
-func (m *ReplicaDescriptor) Reset()         { *m = ReplicaDescriptor{} }
-func (m *ReplicaDescriptor) String() string { return proto.CompactTextString(m) }
-func (*ReplicaDescriptor) ProtoMessage()    {}
+func (m *ReplicaDescriptor) Reset()      { *m = ReplicaDescriptor{} }
+func (*ReplicaDescriptor) ProtoMessage() {}
 
 // RangeDescriptor is the value stored in a range metadata key.
 // A range is described using an inclusive start key, a non-inclusive end key,
@@ -95,6 +98,13 @@ type StoreCapacity struct {
 	Capacity   int64 `protobuf:"varint,1,opt,name=Capacity" json:"Capacity"`
 	Available  int64 `protobuf:"varint,2,opt,name=Available" json:"Available"`
 	RangeCount int32 `protobuf:"varint,3,opt,name=RangeCount" json:"RangeCount"`
+	// MaxRangeCount is the maximum number of replicas the store accepts, or
+	// zero if unlimited. Stores at their maximum refuse new replicas.
+	MaxRangeCount int32 `protobuf:"varint,4,opt,name=MaxRangeCount" json:"MaxRangeCount"`
+	// NearlyFull is set when the store's disk usage exceeds its capacity
+	// alert threshold. Such stores refuse new replicas and writes of new data
+	// to non-system ranges.
+	NearlyFull bool `protobuf:"varint,5,opt,name=NearlyFull" json:"NearlyFull"`
 }
 
 func (m *StoreCapacity) Reset()         { *m = StoreCapacity{} }
@@ -182,6 +192,14 @@ func (m *ReplicaDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.ReplicaID))
+	data[i] = 0x20
+	i++
+	if m.Witness {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -331,6 +349,17 @@ func (m *StoreCapacity) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.RangeCount))
+	data[i] = 0x20
+	i++
+	i = encodeVarintMetadata(data, i, uint64(m.MaxRangeCount))
+	data[i] = 0x28
+	i++
+	if m.NearlyFull {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -461,6 +490,7 @@ func (m *ReplicaDescriptor) Size() (n int) {
 	n += 1 + sovMetadata(uint64(m.NodeID))
 	n += 1 + sovMetadata(uint64(m.StoreID))
 	n += 1 + sovMetadata(uint64(m.ReplicaID))
+	n += 2
 	return n
 }
 
@@ -525,6 +555,8 @@ func (m *StoreCapacity) Size() (n int) {
 	n += 1 + sovMetadata(uint64(m.Capacity))
 	n += 1 + sovMetadata(uint64(m.Available))
 	n += 1 + sovMetadata(uint64(m.RangeCount))
+	n += 1 + sovMetadata(uint64(m.MaxRangeCount))
+	n += 2
 	return n
 }
 
@@ -730,6 +762,26 @@ func (m *ReplicaDescriptor) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Witness = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(data[iNdEx:])
@@ -1272,6 +1324,45 @@ func (m *StoreCapacity) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRangeCount", wireType)
+			}
+			m.MaxRangeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MaxRangeCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NearlyFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NearlyFull = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(data[iNdEx:])
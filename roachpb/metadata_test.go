@@ -83,3 +83,21 @@ func TestRangeDescriptorMissingReplica(t *testing.T) {
 		t.Fatalf("unexpected return (%d, %s) on missing replica", i, r)
 	}
 }
+
+func TestStoreCapacityRefusesReplicas(t *testing.T) {
+	testCases := []struct {
+		capacity StoreCapacity
+		expected bool
+	}{
+		{StoreCapacity{RangeCount: 100}, false},
+		{StoreCapacity{RangeCount: 9, MaxRangeCount: 10}, false},
+		{StoreCapacity{RangeCount: 10, MaxRangeCount: 10}, true},
+		{StoreCapacity{RangeCount: 11, MaxRangeCount: 10}, true},
+		{StoreCapacity{RangeCount: 1, NearlyFull: true}, true},
+	}
+	for i, test := range testCases {
+		if a, e := test.capacity.RefusesReplicas(), test.expected; a != e {
+			t.Errorf("%d: expected %t; got %t", i, e, a)
+		}
+	}
+}
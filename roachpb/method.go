@@ -103,6 +103,18 @@ const (
 	TruncateLog
 	// LeaderLease requests a leader lease for a replica.
 	LeaderLease
+	// AdminScatter is called to add a replica of a range on a randomly
+	// chosen store.
+	AdminScatter
+	// AdminSetQueueExclusion is called to exclude a range from a replica
+	// queue, or to lift such an exclusion.
+	AdminSetQueueExclusion
+	// Ingest writes a sorted set of key/value pairs directly, without
+	// transactional intents, to bulk load the data of a new table.
+	Ingest
+	// Export returns the data of a span which changed since a given time,
+	// range by range, to back it up.
+	Export
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
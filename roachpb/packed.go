@@ -0,0 +1,209 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package roachpb
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Flags of a packed key/value pair, describing which of the optional
+// fields of its value are present.
+const (
+	packedHasChecksum = 1 << iota
+	packedHasTimestamp
+)
+
+var errCorruptPackedRows = errors.New("corrupt packed key/value pairs")
+
+// PackKeyValues encodes a sequence of key/value pairs in packed form. The
+// encoding consists of the number of pairs and the length of a block of
+// metadata, followed by the metadata block and a data block. For each pair
+// in order, the metadata block holds the lengths of the key and of the raw
+// bytes of the value, the value's tag and the optional checksum and
+// timestamp of the value; the data block holds the key followed by the raw
+// bytes of the value. Compared to the encoding of each KeyValue message,
+// this saves the framing of the nested messages and allows all pairs to be
+// decoded with a constant number of allocations.
+func PackKeyValues(rows []KeyValue) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	meta := make([]byte, 0, len(rows)*8)
+	dataLen := 0
+	for i := range rows {
+		kv := &rows[i]
+		meta = appendUvarint(meta, &buf, uint64(len(kv.Key)))
+		meta = appendUvarint(meta, &buf, uint64(len(kv.Value.RawBytes)))
+		meta = appendUvarint(meta, &buf, uint64(kv.Value.Tag))
+		var flags byte
+		if kv.Value.Checksum != nil {
+			flags |= packedHasChecksum
+		}
+		if kv.Value.Timestamp != nil {
+			flags |= packedHasTimestamp
+		}
+		meta = append(meta, flags)
+		if kv.Value.Checksum != nil {
+			meta = appendUvarint(meta, &buf, uint64(*kv.Value.Checksum))
+		}
+		if ts := kv.Value.Timestamp; ts != nil {
+			meta = append(meta, buf[:binary.PutVarint(buf[:], ts.WallTime)]...)
+			meta = append(meta, buf[:binary.PutVarint(buf[:], int64(ts.Logical))]...)
+		}
+		dataLen += len(kv.Key) + len(kv.Value.RawBytes)
+	}
+
+	packed := make([]byte, 0, 2*binary.MaxVarintLen64+len(meta)+dataLen)
+	packed = appendUvarint(packed, &buf, uint64(len(rows)))
+	packed = appendUvarint(packed, &buf, uint64(len(meta)))
+	packed = append(packed, meta...)
+	for i := range rows {
+		packed = append(packed, rows[i].Key...)
+		packed = append(packed, rows[i].Value.RawBytes...)
+	}
+	return packed
+}
+
+// UnpackKeyValues decodes key/value pairs encoded by PackKeyValues. The
+// keys and values of the returned pairs refer to the supplied buffer, which
+// must not be modified afterwards.
+func UnpackKeyValues(packed []byte) ([]KeyValue, error) {
+	count, packed, err := readUvarint(packed)
+	if err != nil {
+		return nil, err
+	}
+	metaLen, packed, err := readUvarint(packed)
+	if err != nil {
+		return nil, err
+	}
+	// Each pair occupies at least four bytes of metadata, which bounds the
+	// allocations made for corrupt input.
+	if metaLen > uint64(len(packed)) || count > metaLen/4 {
+		return nil, errCorruptPackedRows
+	}
+	meta, data := packed[:metaLen], packed[metaLen:]
+
+	rows := make([]KeyValue, count)
+	var checksums []uint32
+	var timestamps []Timestamp
+	for i := range rows {
+		var keyLen, valLen, tag, checksum uint64
+		if keyLen, meta, err = readUvarint(meta); err != nil {
+			return nil, err
+		}
+		if valLen, meta, err = readUvarint(meta); err != nil {
+			return nil, err
+		}
+		if tag, meta, err = readUvarint(meta); err != nil {
+			return nil, err
+		}
+		if len(meta) == 0 || keyLen+valLen > uint64(len(data)) {
+			return nil, errCorruptPackedRows
+		}
+		flags := meta[0]
+		meta = meta[1:]
+
+		kv := &rows[i]
+		// Limit the capacity of the slices so that appending to a key or value
+		// doesn't overwrite the next one.
+		kv.Key = Key(data[:keyLen:keyLen])
+		data = data[keyLen:]
+		kv.Value.RawBytes = data[:valLen:valLen]
+		data = data[valLen:]
+		kv.Value.Tag = ValueType(tag)
+		if flags&packedHasChecksum != 0 {
+			if checksum, meta, err = readUvarint(meta); err != nil {
+				return nil, err
+			}
+			if checksums == nil {
+				checksums = make([]uint32, count)
+			}
+			checksums[i] = uint32(checksum)
+			kv.Value.Checksum = &checksums[i]
+		}
+		if flags&packedHasTimestamp != 0 {
+			wallTime, n := binary.Varint(meta)
+			if n <= 0 {
+				return nil, errCorruptPackedRows
+			}
+			meta = meta[n:]
+			logical, n := binary.Varint(meta)
+			if n <= 0 {
+				return nil, errCorruptPackedRows
+			}
+			meta = meta[n:]
+			if timestamps == nil {
+				timestamps = make([]Timestamp, count)
+			}
+			timestamps[i] = Timestamp{WallTime: wallTime, Logical: int32(logical)}
+			kv.Value.Timestamp = &timestamps[i]
+		}
+	}
+	if len(meta) != 0 || len(data) != 0 {
+		return nil, errCorruptPackedRows
+	}
+	return rows, nil
+}
+
+func appendUvarint(b []byte, buf *[binary.MaxVarintLen64]byte, v uint64) []byte {
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func readUvarint(b []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, nil, errCorruptPackedRows
+	}
+	return v, b[n:], nil
+}
+
+// PackRows replaces the rows of the scan responses in the batch by their
+// packed form. It is called by a node replying to a request whose header
+// asks for packed responses.
+func (br *BatchResponse) PackRows() {
+	for _, union := range br.Responses {
+		switch r := union.GetInner().(type) {
+		case *ScanResponse:
+			r.PackedRows, r.Rows = PackKeyValues(r.Rows), nil
+		case *ReverseScanResponse:
+			r.PackedRows, r.Rows = PackKeyValues(r.Rows), nil
+		}
+	}
+}
+
+// UnpackRows replaces the packed rows of the scan responses in the batch by
+// the decoded rows. Responses which aren't packed, e.g. because they were
+// returned by a node unaware of packed responses, are left untouched.
+func (br *BatchResponse) UnpackRows() error {
+	for _, union := range br.Responses {
+		var err error
+		switch r := union.GetInner().(type) {
+		case *ScanResponse:
+			if r.PackedRows != nil {
+				r.Rows, err = UnpackKeyValues(r.PackedRows)
+				r.PackedRows = nil
+			}
+		case *ReverseScanResponse:
+			if r.PackedRows != nil {
+				r.Rows, err = UnpackKeyValues(r.PackedRows)
+				r.PackedRows = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
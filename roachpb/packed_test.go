@@ -0,0 +1,116 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package roachpb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+func makePackedTestRows() []KeyValue {
+	checksum := uint32(0xdeadbeef)
+	var rows []KeyValue
+	for i, k := range []string{"a", "b", "c", ""} {
+		kv := KeyValue{Key: Key(k)}
+		kv.Value.SetBytes([]byte(k + "-value"))
+		if i%2 == 0 {
+			kv.Value.Timestamp = &Timestamp{WallTime: int64(i) << 40, Logical: int32(i)}
+		}
+		if i == 1 {
+			kv.Value.Checksum = &checksum
+		}
+		rows = append(rows, kv)
+	}
+	rows[3].Value.RawBytes = nil
+	return rows
+}
+
+func TestPackKeyValues(t *testing.T) {
+	for _, rows := range [][]KeyValue{nil, makePackedTestRows()} {
+		unpacked, err := UnpackKeyValues(PackKeyValues(rows))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(unpacked) != len(rows) {
+			t.Fatalf("expected %d rows; got %d", len(rows), len(unpacked))
+		}
+		for i := range rows {
+			// The empty key and value are unpacked as empty, non-nil slices.
+			if len(rows[i].Key) == 0 && len(unpacked[i].Key) == 0 {
+				unpacked[i].Key = rows[i].Key
+			}
+			if len(rows[i].Value.RawBytes) == 0 && len(unpacked[i].Value.RawBytes) == 0 {
+				unpacked[i].Value.RawBytes = rows[i].Value.RawBytes
+			}
+			if !reflect.DeepEqual(rows[i], unpacked[i]) {
+				t.Errorf("%d: expected %+v; got %+v", i, rows[i], unpacked[i])
+			}
+		}
+	}
+}
+
+func TestUnpackKeyValuesCorrupt(t *testing.T) {
+	packed := PackKeyValues(makePackedTestRows())
+	for i := 0; i < len(packed); i++ {
+		if _, err := UnpackKeyValues(packed[:i]); err == nil {
+			t.Errorf("%d: expected error unpacking truncated rows", i)
+		}
+	}
+	if _, err := UnpackKeyValues(append(packed, 'x')); err == nil {
+		t.Error("expected error unpacking rows with trailing data")
+	}
+}
+
+// TestBatchResponsePackRows verifies that packed rows survive the encoding
+// of a batch response and are restored by UnpackRows.
+func TestBatchResponsePackRows(t *testing.T) {
+	rows := makePackedTestRows()[:3]
+	br := &BatchResponse{}
+	br.Add(&ScanResponse{Rows: rows})
+	br.Add(&ReverseScanResponse{Rows: rows})
+	br.Add(&GetResponse{Value: &rows[0].Value})
+	br.PackRows()
+	if sr := br.Responses[0].GetInner().(*ScanResponse); sr.Rows != nil || sr.PackedRows == nil {
+		t.Fatalf("expected packed rows; got %+v", sr)
+	}
+
+	data, err := proto.Marshal(br)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &BatchResponse{}
+	if err := proto.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnpackRows(); err != nil {
+		t.Fatal(err)
+	}
+	if sr := decoded.Responses[0].GetInner().(*ScanResponse); !reflect.DeepEqual(sr.Rows, rows) || sr.PackedRows != nil {
+		t.Errorf("expected %+v; got %+v", rows, sr)
+	}
+	if sr := decoded.Responses[1].GetInner().(*ReverseScanResponse); !reflect.DeepEqual(sr.Rows, rows) || sr.PackedRows != nil {
+		t.Errorf("expected %+v; got %+v", rows, sr)
+	}
+	// Unpacking is a no-op for responses which aren't packed.
+	if err := decoded.UnpackRows(); err != nil {
+		t.Fatal(err)
+	}
+	if sr := decoded.Responses[0].GetInner().(*ScanResponse); !reflect.DeepEqual(sr.Rows, rows) {
+		t.Errorf("expected %+v; got %+v", rows, sr.Rows)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package roachpb
+
+import (
+	"bytes"
+	"sort"
+)
+
+// end returns the exclusive end key of the span. A span with an empty
+// EndKey addresses the single key Key and ends at Key.Next().
+func (s Span) end() Key {
+	if len(s.EndKey) == 0 {
+		return s.Key.Next()
+	}
+	return s.EndKey
+}
+
+// makeSpan returns the span [start, end), using the single key form
+// when the span addresses only start.
+func makeSpan(start, end Key) Span {
+	if start.IsPrev(end) {
+		return Span{Key: start}
+	}
+	return Span{Key: start, EndKey: end}
+}
+
+// Overlaps returns whether the two spans have at least one key in
+// common.
+func (s Span) Overlaps(o Span) bool {
+	return bytes.Compare(s.Key, o.end()) < 0 && bytes.Compare(o.Key, s.end()) < 0
+}
+
+// Contains returns whether every key of the given span is also
+// contained in this span.
+func (s Span) Contains(o Span) bool {
+	return bytes.Compare(s.Key, o.Key) <= 0 && bytes.Compare(o.end(), s.end()) <= 0
+}
+
+// spansByKey sorts spans by their start key.
+type spansByKey []Span
+
+func (s spansByKey) Len() int           { return len(s) }
+func (s spansByKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s spansByKey) Less(i, j int) bool { return bytes.Compare(s[i].Key, s[j].Key) < 0 }
+
+// MergeSpans returns the smallest set of disjoint spans, sorted by key,
+// which covers exactly the keys of the given spans. Overlapping and
+// adjacent spans are coalesced. The input is not modified.
+func MergeSpans(spans []Span) []Span {
+	if len(spans) == 0 {
+		return nil
+	}
+	sorted := append([]Span(nil), spans...)
+	sort.Sort(spansByKey(sorted))
+
+	var merged []Span
+	start, end := sorted[0].Key, sorted[0].end()
+	for _, s := range sorted[1:] {
+		if bytes.Compare(s.Key, end) <= 0 {
+			if e := s.end(); bytes.Compare(e, end) > 0 {
+				end = e
+			}
+			continue
+		}
+		merged = append(merged, makeSpan(start, end))
+		start, end = s.Key, s.end()
+	}
+	return append(merged, makeSpan(start, end))
+}
+
+// SubtractSpans returns the keys of spans which are not contained in
+// any of sub, as a set of disjoint spans sorted by key.
+func SubtractSpans(spans, sub []Span) []Span {
+	spans, sub = MergeSpans(spans), MergeSpans(sub)
+	var result []Span
+	j := 0
+	for _, s := range spans {
+		start, end := s.Key, s.end()
+		// The spans are sorted and disjoint, so subtrahends ending before
+		// this span don't affect any of the following spans either.
+		for j < len(sub) && bytes.Compare(sub[j].end(), start) <= 0 {
+			j++
+		}
+		for k := j; k < len(sub) && bytes.Compare(sub[k].Key, end) < 0; k++ {
+			if bytes.Compare(start, sub[k].Key) < 0 {
+				result = append(result, makeSpan(start, sub[k].Key))
+			}
+			if e := sub[k].end(); bytes.Compare(start, e) < 0 {
+				start = e
+			}
+		}
+		if bytes.Compare(start, end) < 0 {
+			result = append(result, makeSpan(start, end))
+		}
+	}
+	return result
+}
+
+// IntersectSpans returns the keys contained in both a and b, as a set
+// of disjoint spans sorted by key.
+func IntersectSpans(a, b []Span) []Span {
+	a, b = MergeSpans(a), MergeSpans(b)
+	var result []Span
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, aEnd, bEnd := a[i].Key, a[i].end(), b[j].end()
+		if bytes.Compare(start, b[j].Key) < 0 {
+			start = b[j].Key
+		}
+		end := aEnd
+		if bytes.Compare(bEnd, end) < 0 {
+			end = bEnd
+		}
+		if bytes.Compare(start, end) < 0 {
+			result = append(result, makeSpan(start, end))
+		}
+		if bytes.Compare(aEnd, bEnd) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
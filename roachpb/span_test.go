@@ -0,0 +1,142 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package roachpb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// makeSpans parses a list of spans such as "a-c,d,f-g", in which "d"
+// addresses the single key d.
+func makeSpans(s string) []Span {
+	if s == "" {
+		return nil
+	}
+	var spans []Span
+	for _, part := range strings.Split(s, ",") {
+		bounds := strings.Split(part, "-")
+		span := Span{Key: Key(bounds[0])}
+		if len(bounds) > 1 {
+			span.EndKey = Key(bounds[1])
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func TestSpanOverlapsAndContains(t *testing.T) {
+	testCases := []struct {
+		a, b               string
+		overlaps, contains bool
+	}{
+		{"a-c", "b-d", true, false},
+		{"a-c", "c-d", false, false},
+		{"a-d", "b-c", true, true},
+		{"a-c", "b", true, true},
+		{"a-c", "c", false, false},
+		{"b", "b", true, true},
+		{"b", "a-c", true, false},
+		{"b", "b\x00", false, false},
+	}
+	for i, test := range testCases {
+		a, b := makeSpans(test.a)[0], makeSpans(test.b)[0]
+		if overlaps := a.Overlaps(b); overlaps != test.overlaps {
+			t.Errorf("%d: expected overlaps=%t; got %t", i, test.overlaps, overlaps)
+		}
+		if overlaps := b.Overlaps(a); overlaps != test.overlaps {
+			t.Errorf("%d: expected symmetric overlaps=%t; got %t", i, test.overlaps, overlaps)
+		}
+		if contains := a.Contains(b); contains != test.contains {
+			t.Errorf("%d: expected contains=%t; got %t", i, test.contains, contains)
+		}
+	}
+}
+
+func TestMergeSpans(t *testing.T) {
+	testCases := []struct {
+		spans, expected string
+	}{
+		{"", ""},
+		{"a", "a"},
+		{"a,a", "a"},
+		{"b,a", "a,b"},
+		{"a,a\x00", "a-a\x00\x00"},
+		{"a-c,b-d", "a-d"},
+		{"a-c,c-d", "a-d"},
+		{"a-b,c-d", "a-b,c-d"},
+		{"c-d,a-z,e", "a-z"},
+		{"b,a-c,d", "a-c,d"},
+		{"e-f,a-b,b", "a-b\x00,e-f"},
+	}
+	for i, test := range testCases {
+		spans := makeSpans(test.spans)
+		if merged := MergeSpans(spans); !reflect.DeepEqual(merged, makeSpans(test.expected)) {
+			t.Errorf("%d: expected %v; got %v", i, makeSpans(test.expected), merged)
+		}
+		if !reflect.DeepEqual(spans, makeSpans(test.spans)) {
+			t.Errorf("%d: input was modified: %v", i, spans)
+		}
+	}
+}
+
+func TestSubtractSpans(t *testing.T) {
+	testCases := []struct {
+		spans, sub, expected string
+	}{
+		{"a-c", "", "a-c"},
+		{"", "a-c", ""},
+		{"a-c", "a-c", ""},
+		{"a-c", "b-d", "a-b"},
+		{"b-d", "a-c", "c-d"},
+		{"a-z", "c-d,f-g", "a-c,d-f,g-z"},
+		{"a-z", "b", "a-b,b\x00-z"},
+		{"a-c,d-f", "b-e", "a-b,e-f"},
+		{"b", "a-c", ""},
+		{"b", "c-d", "b"},
+		{"a-d", "a\x00-d", "a"},
+	}
+	for i, test := range testCases {
+		result := SubtractSpans(makeSpans(test.spans), makeSpans(test.sub))
+		if !reflect.DeepEqual(result, makeSpans(test.expected)) {
+			t.Errorf("%d: expected %v; got %v", i, makeSpans(test.expected), result)
+		}
+	}
+}
+
+func TestIntersectSpans(t *testing.T) {
+	testCases := []struct {
+		a, b, expected string
+	}{
+		{"a-c", "", ""},
+		{"a-c", "b-d", "b-c"},
+		{"a-c", "c-d", ""},
+		{"a-z", "c-d,f-g", "c-d,f-g"},
+		{"a-c,d-f", "b-e", "b-c,d-e"},
+		{"a-z", "b", "b"},
+		{"b,d", "a-c", "b"},
+	}
+	for i, test := range testCases {
+		a, b, expected := makeSpans(test.a), makeSpans(test.b), makeSpans(test.expected)
+		if result := IntersectSpans(a, b); !reflect.DeepEqual(result, expected) {
+			t.Errorf("%d: expected %v; got %v", i, expected, result)
+		}
+		if result := IntersectSpans(b, a); !reflect.DeepEqual(result, expected) {
+			t.Errorf("%d: expected symmetric %v; got %v", i, expected, result)
+		}
+	}
+}
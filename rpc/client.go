@@ -76,12 +76,15 @@ type internalConn struct {
 
 // Client is a Cockroach-specific RPC client.
 type Client struct {
-	key       string // cache key for later removal from cache
-	addr      util.UnresolvedAddr
-	Closed    chan struct{}
-	conn      unsafe.Pointer // holds a `internalConn`
-	healthy   atomic.Value   // holds a `chan struct{}` exposed in `Healthy`
-	tlsConfig *tls.Config
+	key     string // cache key for later removal from cache
+	addr    util.UnresolvedAddr
+	Closed  chan struct{}
+	conn    unsafe.Pointer // holds a `internalConn`
+	healthy atomic.Value   // holds a `chan struct{}` exposed in `Healthy`
+	// tlsConfig returns the TLS config for new connections. It is
+	// consulted on every connection attempt so that reloaded client
+	// certificates take effect on reconnect.
+	tlsConfig func() (*tls.Config, error)
 
 	clock        *hlc.Clock
 	remoteClocks *RemoteClockMonitor
@@ -112,8 +115,7 @@ func NewClient(addr net.Addr, context *Context) *Client {
 		}
 	}
 
-	tlsConfig, err := context.GetClientTLSConfig()
-	if err != nil {
+	if _, err := context.GetClientTLSConfig(); err != nil {
 		log.Fatal(err)
 	}
 
@@ -121,7 +123,7 @@ func NewClient(addr net.Addr, context *Context) *Client {
 		Closed:       make(chan struct{}),
 		key:          key,
 		addr:         unresolvedAddr,
-		tlsConfig:    tlsConfig,
+		tlsConfig:    context.GetClientTLSConfig,
 		clock:        context.localClock,
 		remoteClocks: context.RemoteClocks,
 	}
@@ -162,8 +164,12 @@ func (c *Client) internalConn() *internalConn {
 
 // connect attempts a single connection attempt. On success, updates `c.conn`.
 func (c *Client) connect() error {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return err
+	}
 	conn, err := codec.TLSDialHTTP(
-		c.addr.NetworkField, c.addr.AddressField, base.NetworkTimeout, c.tlsConfig)
+		c.addr.NetworkField, c.addr.AddressField, base.NetworkTimeout, tlsConfig)
 	if err != nil {
 		return err
 	}
@@ -127,14 +127,10 @@ func TestUpdateOffsetOnHeartbeat(t *testing.T) {
 	// it will update the server's remote clocks map. We create the
 	// client manually here to allow us to set the remote offset
 	// before the first heartbeat.
-	tlsConfig, err := nodeContext.GetClientTLSConfig()
-	if err != nil {
-		t.Fatal(err)
-	}
 	client := &Client{
 		Closed:       make(chan struct{}),
 		addr:         util.MakeUnresolvedAddr(s.Addr().Network(), s.Addr().String()),
-		tlsConfig:    tlsConfig,
+		tlsConfig:    nodeContext.GetClientTLSConfig,
 		clock:        nodeContext.localClock,
 		remoteClocks: nodeContext.RemoteClocks,
 		remoteOffset: RemoteOffset{
@@ -143,7 +139,7 @@ func TestUpdateOffsetOnHeartbeat(t *testing.T) {
 			MeasuredAt:  20,
 		},
 	}
-	if err = client.connect(); err != nil {
+	if err := client.connect(); err != nil {
 		t.Fatal(err)
 	}
 
@@ -19,6 +19,7 @@ package security
 
 import (
 	"crypto/tls"
+	"fmt"
 	"log"
 	"strings"
 
@@ -33,6 +34,18 @@ const (
 	RootUser = "root"
 )
 
+const (
+	// AuthenticationFailedPrefix prefixes the messages of the errors an
+	// authentication hook returns for requests whose user could not be
+	// authenticated. The errors reach RPC clients as plain strings; the
+	// prefix lets them tell these errors apart from other failures.
+	AuthenticationFailedPrefix = "authentication failed: "
+	// PermissionDeniedPrefix prefixes the messages of the errors an
+	// authentication hook returns for requests whose user may not call the
+	// method.
+	PermissionDeniedPrefix = "permission denied: "
+)
+
 // LogTLSState logs information about TLS state in the form:
 // "<method>: peer certs: [<Subject.CommonName>...], chain: [[<CommonName>...][..]]"
 func LogTLSState(method string, tlsState *tls.ConnectionState) {
@@ -105,11 +118,11 @@ func AuthenticationHook(insecureMode bool, tlsState *tls.ConnectionState) (
 		// TODO(marc): we may eventually need stricter user syntax rules.
 		requestedUser := requestWithUser.GetUser()
 		if len(requestedUser) == 0 {
-			return util.Errorf("missing User in request: %+v", request)
+			return fmt.Errorf(AuthenticationFailedPrefix+"missing User in request: %+v", request)
 		}
 
 		if !public && requestedUser != NodeUser {
-			return util.Errorf("user %s is not allowed", requestedUser)
+			return fmt.Errorf(PermissionDeniedPrefix+"user %s is not allowed", requestedUser)
 		}
 
 		// If running in insecure mode, we have nothing to verify it against.
@@ -121,7 +134,8 @@ func AuthenticationHook(insecureMode bool, tlsState *tls.ConnectionState) (
 		// except if the certificate user is NodeUser, which is allowed to
 		// act on behalf of all other users.
 		if !(certUser == NodeUser || certUser == requestedUser) {
-			return util.Errorf("requested user is %s, but certificate is for %s", requestedUser, certUser)
+			return fmt.Errorf(AuthenticationFailedPrefix+"requested user is %s, but certificate is for %s",
+				requestedUser, certUser)
 		}
 
 		return nil
@@ -22,10 +22,12 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/gogo/protobuf/proto"
 )
@@ -131,3 +133,29 @@ func TestAuthenticationHook(t *testing.T) {
 		}
 	}
 }
+
+// TestAuthenticationHookErrors verifies that the errors of the
+// authentication hook tell authentication failures and permission denials
+// apart.
+func TestAuthenticationHookErrors(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	hook, err := security.AuthenticationHook(false, makeFakeTLSState([]string{"foo"}, []int{1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		user   string
+		public bool
+		prefix string
+	}{
+		{"", true, security.AuthenticationFailedPrefix},
+		{"bar", true, security.AuthenticationFailedPrefix},
+		{"foo", false, security.PermissionDeniedPrefix},
+	}
+	for i, tc := range testCases {
+		err := hook(&driver.Request{User: tc.user}, tc.public)
+		if err == nil || !strings.HasPrefix(err.Error(), tc.prefix) {
+			t.Errorf("%d: expected error with prefix %q, got %v", i, tc.prefix, err)
+		}
+	}
+}
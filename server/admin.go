@@ -19,13 +19,17 @@
 package server
 
 import (
+	"bytes"
+	"encoding/hex"
 	// This is imported for its side-effect of registering expvar
 	// endpoints with the http.DefaultServeMux.
 	_ "expvar"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
+	"golang.org/x/net/context"
 	// Register the net/trace endpoint with http.DefaultServeMux.
 	"golang.org/x/net/trace"
 	// This is imported for its side-effect of registering pprof
@@ -33,7 +37,12 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
@@ -59,8 +68,51 @@ const (
 	healthPath = adminEndpoint + "health"
 	// quitPath is the quit endpoint.
 	quitPath = adminEndpoint + "quit"
+	// metaPath is the endpoint which verifies, and on request repairs, the
+	// range addressing records.
+	metaPath = adminEndpoint + "meta"
+	// rangeLogPath is the endpoint which lists the range event log. The
+	// optional range_id parameter restricts the output to a single range.
+	// The optional limit parameter bounds the number of events listed, and
+	// the resume parameter continues an earlier, truncated listing.
+	rangeLogPath = adminEndpoint + "rangelog"
+	// rangeHistoryPath is the endpoint which lists the recent replica
+	// changes of the range given by the range_id parameter, which must
+	// have a replica on this node.
+	rangeHistoryPath = adminEndpoint + "rangehistory"
+	// leaseHistoryPath is the endpoint which lists the recent leader lease
+	// holders of the range given by the range_id parameter, as seen by its
+	// replicas on this node.
+	leaseHistoryPath = adminEndpoint + "leasehistory"
+	// verifyPath is the endpoint which verifies the on-disk checksums of
+	// the local replicas of the range given by the range_id parameter.
+	// The optional timeout parameter bounds the duration of the scan, and
+	// the resume parameter continues an earlier, unfinished scan.
+	verifyPath = adminEndpoint + "verify"
+	// verifyStatusPath is the endpoint which reports when the local
+	// replicas of the range given by the range_id parameter were last
+	// verified, and whether the most recent verification failed.
+	verifyStatusPath = adminEndpoint + "verifystatus"
+	// pausePath is the endpoint which reports the pause state of the local
+	// replicas of the range given by the range_id parameter. The duration
+	// parameter pauses them for maintenance for the given duration, and
+	// unpause=true lifts an earlier pause.
+	pausePath = adminEndpoint + "pause"
+	// hotRangesPath is the endpoint which lists the local replicas serving
+	// the most requests, with their spans and lease state. The optional
+	// count parameter bounds the number of replicas listed per store.
+	hotRangesPath = adminEndpoint + "hotranges"
+	// pendingGCPath is the endpoint which lists the replicas of this node's
+	// stores which were removed from their ranges and whose data is kept
+	// until the replica GC delay has passed. The recover parameter adds the
+	// replica of the given range ID back to its store.
+	pendingGCPath = adminEndpoint + "pendinggc"
 )
 
+// defaultHotRangesCount is the default number of replicas listed per store
+// by the hot ranges endpoint.
+const defaultHotRangesCount = 10
+
 // An actionHandler is an interface which provides Get, Put & Delete
 // to satisfy administrative REST APIs.
 type actionHandler interface {
@@ -72,23 +124,37 @@ type actionHandler interface {
 // A adminServer provides a RESTful HTTP API to administration of
 // the cockroach cluster.
 type adminServer struct {
-	db      *client.DB    // Key-value database client
-	stopper *stop.Stopper // Used to shutdown the server
+	db      *client.DB      // Key-value database client
+	stores  *kv.LocalSender // Node-local stores
+	stopper *stop.Stopper   // Used to shutdown the server
+	drain   func()          // Drains the server before shutdown
 	mux     *http.ServeMux
 }
 
 // newAdminServer allocates and returns a new REST server for
 // administrative APIs.
-func newAdminServer(db *client.DB, stopper *stop.Stopper) *adminServer {
+func newAdminServer(db *client.DB, stores *kv.LocalSender, stopper *stop.Stopper,
+	drain func()) *adminServer {
 	server := &adminServer{
 		db:      db,
+		stores:  stores,
 		stopper: stopper,
+		drain:   drain,
 		mux:     http.NewServeMux(),
 	}
 
 	server.mux.HandleFunc(debugEndpoint, server.handleDebug)
 	server.mux.HandleFunc(healthPath, server.handleHealth)
 	server.mux.HandleFunc(quitPath, server.handleQuit)
+	server.mux.HandleFunc(metaPath, server.handleMeta)
+	server.mux.HandleFunc(rangeLogPath, server.handleRangeLog)
+	server.mux.HandleFunc(rangeHistoryPath, server.handleRangeHistory)
+	server.mux.HandleFunc(leaseHistoryPath, server.handleLeaseHistory)
+	server.mux.HandleFunc(verifyPath, server.handleVerify)
+	server.mux.HandleFunc(verifyStatusPath, server.handleVerifyStatus)
+	server.mux.HandleFunc(pausePath, server.handlePause)
+	server.mux.HandleFunc(hotRangesPath, server.handleHotRanges)
+	server.mux.HandleFunc(pendingGCPath, server.handlePendingGC)
 	return server
 }
 
@@ -110,10 +176,446 @@ func (s *adminServer) handleQuit(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintln(w, "ok")
 	go func() {
 		time.Sleep(50 * time.Millisecond)
+		if s.drain != nil {
+			s.drain()
+		}
 		s.stopper.Stop()
 	}()
 }
 
+// handleMeta verifies the meta1 and meta2 range addressing records against
+// the range descriptors and reports any inconsistencies. Missing records are
+// rewritten and orphaned ones deleted if the "repair" query parameter is
+// set to "true".
+func (s *adminServer) handleMeta(w http.ResponseWriter, r *http.Request) {
+	report, err := storage.VerifyRangeAddressing(s.db, r.URL.Query().Get("repair") == "true")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	fmt.Fprintf(w, "ranges: %d\n", report.RangeCount)
+	for _, key := range report.Missing {
+		fmt.Fprintf(w, "missing: %s\n", key)
+	}
+	for _, key := range report.Orphaned {
+		fmt.Fprintf(w, "orphaned: %s\n", key)
+	}
+	if report.Repaired {
+		fmt.Fprintln(w, "repaired")
+	}
+}
+
+// handleRangeLog lists the events recorded in the range event log, oldest
+// first, one per line. At most limit events are listed; if more may follow,
+// the last line reports the key to pass as the resume parameter to list
+// them.
+func (s *adminServer) handleRangeLog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var rangeID roachpb.RangeID
+	if param := query.Get("range_id"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid range ID %q: %s", param, err), http.StatusBadRequest)
+			return
+		}
+		rangeID = roachpb.RangeID(id)
+	}
+	limit := storage.DefaultRangeLogReadLimit
+	if param := query.Get("limit"); param != "" {
+		var err error
+		if limit, err = strconv.Atoi(param); err != nil || limit <= 0 {
+			http.Error(w, fmt.Sprintf("invalid limit %q", param), http.StatusBadRequest)
+			return
+		}
+	}
+	var start roachpb.Key
+	if param := query.Get("resume"); param != "" {
+		var err error
+		if start, err = hex.DecodeString(param); err != nil {
+			http.Error(w, fmt.Sprintf("invalid resume key %q: %s", param, err), http.StatusBadRequest)
+			return
+		}
+	}
+	events, resume, err := storage.ReadRangeLog(s.db, rangeID, start, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeRangeLogEvents(w, events)
+	if resume != nil {
+		fmt.Fprintf(w, "resume: %s\n", hex.EncodeToString(resume))
+	}
+}
+
+// handleRangeHistory lists the recent replica changes of a range: which
+// replica was added or removed, by which store and for what reason.
+func (s *adminServer) handleRangeHistory(w http.ResponseWriter, r *http.Request) {
+	param := r.URL.Query().Get("range_id")
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid range ID %q: %s", param, err), http.StatusBadRequest)
+		return
+	}
+	rangeID := roachpb.RangeID(id)
+	var startKey roachpb.RKey
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		if rng, err := store.GetReplica(rangeID); err == nil {
+			startKey = rng.Desc().StartKey
+		}
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if startKey == nil {
+		http.Error(w, roachpb.NewRangeNotFoundError(rangeID).Error(), http.StatusNotFound)
+		return
+	}
+	events, err := storage.ReadRangeChangeHistory(s.db, startKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeRangeLogEvents(w, events)
+}
+
+// handleLeaseHistory lists the recent leader lease holders of a range as
+// remembered by each of its replicas on this node, oldest first, so that
+// latency spikes can be correlated with lease changes.
+func (s *adminServer) handleLeaseHistory(w http.ResponseWriter, r *http.Request) {
+	param := r.URL.Query().Get("range_id")
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid range ID %q: %s", param, err), http.StatusBadRequest)
+		return
+	}
+	rangeID := roachpb.RangeID(id)
+	type storeHistory struct {
+		storeID roachpb.StoreID
+		entries []storage.LeaseHistoryEntry
+	}
+	var histories []storeHistory
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		if rng, err := store.GetReplica(rangeID); err == nil {
+			histories = append(histories, storeHistory{store.StoreID(), rng.LeaseHistory()})
+		}
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if histories == nil {
+		http.Error(w, roachpb.NewRangeNotFoundError(rangeID).Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	for _, h := range histories {
+		for _, e := range h.entries {
+			fmt.Fprintf(w, "%s store=%d reason=%s holder=%s start=%s expiration=%s extensions=%d",
+				e.Applied.UTC(), h.storeID, e.Reason, e.Lease.Replica, e.Lease.Start,
+				e.Lease.Expiration, e.Extensions)
+			if e.HandedOff {
+				fmt.Fprint(w, " handed-off")
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
+
+// writeRangeLogEvents writes the given range events as plain text, one per
+// line.
+func writeRangeLogEvents(w http.ResponseWriter, events []storage.RangeLogEvent) {
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	for _, event := range events {
+		fmt.Fprintf(w, "%s %s range=%d store=%d", event.Timestamp.GoTime().UTC(),
+			event.EventType, event.RangeID, event.StoreID)
+		switch event.EventType {
+		case storage.RANGE_SPLIT, storage.RANGE_MERGE:
+			fmt.Fprintf(w, " other=%d", event.OtherRangeID)
+		case storage.RANGE_ADD_REPLICA, storage.RANGE_REMOVE_REPLICA:
+			fmt.Fprintf(w, " replica=%s reason=%s", event.Replica, event.Reason)
+		}
+		fmt.Fprintf(w, " span=[%s,%s) replicas=%s\n", event.UpdatedDesc.StartKey,
+			event.UpdatedDesc.EndKey, event.UpdatedDesc.Replicas)
+	}
+}
+
+// handleVerify verifies the on-disk checksums of the range's replicas on
+// this node's stores, reporting one line per replica. A scan which is cut
+// short by the timeout, by the client going away or by the server shutting
+// down reports the key to pass as the resume parameter to continue it.
+func (s *adminServer) handleVerify(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	id, err := strconv.ParseInt(query.Get("range_id"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid range ID %q: %s", query.Get("range_id"), err), http.StatusBadRequest)
+		return
+	}
+	rangeID := roachpb.RangeID(id)
+	var resumeKey roachpb.EncodedKey
+	if param := query.Get("resume"); param != "" {
+		if resumeKey, err = hex.DecodeString(param); err != nil {
+			http.Error(w, fmt.Sprintf("invalid resume key %q: %s", param, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := context.Background()
+	if param := query.Get("timeout"); param != "" {
+		timeout, err := time.ParseDuration(param)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid timeout %q: %s", param, err), http.StatusBadRequest)
+			return
+		}
+		var cancelTimeout func()
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var closeNotify <-chan bool
+	if cn, ok := w.(http.CloseNotifier); ok {
+		closeNotify = cn.CloseNotify()
+	}
+	go func() {
+		select {
+		case <-closeNotify:
+		case <-s.stopper.ShouldStop():
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	var reports []storage.VerifyReport
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		if _, err := store.GetReplica(rangeID); err != nil {
+			return nil
+		}
+		report, err := store.VerifyRange(ctx, rangeID, resumeKey)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, report)
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(reports) == 0 {
+		http.Error(w, roachpb.NewRangeNotFoundError(rangeID).Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	for _, report := range reports {
+		fmt.Fprintf(w, "range=%d store=%d keys=%d bytes=%d duration=%s", report.RangeID,
+			report.StoreID, report.KeyCount, report.ByteCount, report.Duration)
+		switch {
+		case report.Err != nil:
+			fmt.Fprintf(w, " error: %s\n", report.Err)
+		case report.ResumeKey != nil:
+			fmt.Fprintf(w, " resume: %s\n", hex.EncodeToString(report.ResumeKey))
+		default:
+			fmt.Fprintln(w, " ok")
+		}
+	}
+}
+
+// handlePause pauses or unpauses the local replicas of a range and
+// reports their pause state, one line per replica.
+func (s *adminServer) handlePause(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	id, err := strconv.ParseInt(query.Get("range_id"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid range ID %q: %s", query.Get("range_id"), err), http.StatusBadRequest)
+		return
+	}
+	rangeID := roachpb.RangeID(id)
+	var duration time.Duration
+	if param := query.Get("duration"); param != "" {
+		if duration, err = time.ParseDuration(param); err != nil || duration <= 0 {
+			http.Error(w, fmt.Sprintf("invalid duration %q", param), http.StatusBadRequest)
+			return
+		}
+	}
+	unpause := query.Get("unpause") == "true"
+
+	var statuses []storage.ReplicaPauseStatus
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		if _, err := store.GetReplica(rangeID); err != nil {
+			return nil
+		}
+		switch {
+		case unpause:
+			if err := store.UnpauseReplica(rangeID); err != nil {
+				return err
+			}
+		case duration > 0:
+			if err := store.PauseReplica(rangeID, duration); err != nil {
+				return err
+			}
+		}
+		status, err := store.ReplicaPauseStatus(rangeID)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(statuses) == 0 {
+		http.Error(w, roachpb.NewRangeNotFoundError(rangeID).Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	for _, status := range statuses {
+		fmt.Fprintf(w, "range=%d store=%d", status.RangeID, status.StoreID)
+		if status.Paused() {
+			fmt.Fprintf(w, " paused until %s", status.PausedUntil)
+		} else {
+			fmt.Fprint(w, " active")
+		}
+		if len(status.PausedFollowers) > 0 {
+			fmt.Fprintf(w, " paused followers: %v", status.PausedFollowers)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// handleVerifyStatus reports the checksum verification status of the
+// range's replicas on this node's stores, one line per replica.
+func (s *adminServer) handleVerifyStatus(w http.ResponseWriter, r *http.Request) {
+	param := r.URL.Query().Get("range_id")
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid range ID %q: %s", param, err), http.StatusBadRequest)
+		return
+	}
+	rangeID := roachpb.RangeID(id)
+	var buf bytes.Buffer
+	found := false
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		rng, err := store.GetReplica(rangeID)
+		if err != nil {
+			return nil
+		}
+		found = true
+		status, err := rng.VerificationStatus()
+		if err != nil {
+			return err
+		}
+		verifiedAt := status.Timestamp.GoTime()
+		fmt.Fprintf(&buf, "store=%d range=%d verified=%s age=%s", store.StoreID(), rangeID,
+			verifiedAt.UTC(), store.Clock().PhysicalTime().Sub(verifiedAt))
+		if status.Err != nil {
+			fmt.Fprintf(&buf, " result=failed: %s\n", status.Err)
+		} else {
+			fmt.Fprint(&buf, " result=ok\n")
+		}
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		http.Error(w, roachpb.NewRangeNotFoundError(rangeID).Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Warningf("failed to write verification status: %s", err)
+	}
+}
+
+// handleHotRanges lists the replicas of the node's stores serving the most
+// requests per second, hottest first.
+func (s *adminServer) handleHotRanges(w http.ResponseWriter, r *http.Request) {
+	count := defaultHotRangesCount
+	if param := r.URL.Query().Get("count"); param != "" {
+		var err error
+		if count, err = strconv.Atoi(param); err != nil || count <= 0 {
+			http.Error(w, fmt.Sprintf("invalid count %q", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		for _, h := range store.HottestReplicas(count) {
+			fmt.Fprintf(&buf, "store=%d range=%d span=[%s, %s) qps=%.2f bytes/s=%.2f",
+				store.StoreID(), h.RangeID, keys.PrettyPrint(roachpb.Key(h.StartKey)),
+				keys.PrettyPrint(roachpb.Key(h.EndKey)), h.QPS, h.BytesPerSecond)
+			switch {
+			case h.LeaseHolder:
+				fmt.Fprint(&buf, " lease=held")
+			case h.Lease.Replica.StoreID != 0:
+				fmt.Fprintf(&buf, " lease=store %d", h.Lease.Replica.StoreID)
+			default:
+				fmt.Fprint(&buf, " lease=none")
+			}
+			fmt.Fprintln(&buf)
+		}
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Warningf("failed to write hot ranges: %s", err)
+	}
+}
+
+// handlePendingGC lists the replicas pending GC on this node's stores, one
+// per line, after recovering the replica of the range given by the recover
+// parameter, if any.
+func (s *adminServer) handlePendingGC(w http.ResponseWriter, r *http.Request) {
+	if param := r.URL.Query().Get("recover"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid range ID %q: %s", param, err), http.StatusBadRequest)
+			return
+		}
+		rangeID := roachpb.RangeID(id)
+		recovered := false
+		if err := s.stores.VisitStores(func(store *storage.Store) error {
+			for _, info := range store.PendingGCReplicas() {
+				if info.Desc.RangeID == rangeID {
+					recovered = true
+					return store.RecoverPendingGCReplica(rangeID)
+				}
+			}
+			return nil
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !recovered {
+			http.Error(w, fmt.Sprintf("range %d has no replica pending GC on this node", rangeID), http.StatusNotFound)
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		for _, info := range store.PendingGCReplicas() {
+			fmt.Fprintf(&buf, "store=%d range=%d [%s,%s) since %s\n",
+				store.StoreID(), info.Desc.RangeID, keys.PrettyPrint(roachpb.Key(info.Desc.StartKey)),
+				keys.PrettyPrint(roachpb.Key(info.Desc.EndKey)), info.Since)
+		}
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, util.PlaintextContentType)
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Warningf("failed to write replicas pending GC: %s", err)
+	}
+}
+
 // handleDebug passes requests with the debugPathPrefix onto the default
 // serve mux, which is preconfigured (by import of expvar and net/http/pprof)
 // to serve endpoints which access exported variables and pprof tools.
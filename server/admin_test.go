@@ -118,3 +118,60 @@ func TestAdminNetTrace(t *testing.T) {
 		}
 	}
 }
+
+// TestAdminHotRanges verifies that the hot ranges endpoint lists the local
+// replicas serving requests, and rejects invalid counts.
+func TestAdminHotRanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	if err := s.DB().Put("a", "value"); err != nil {
+		t.Fatal(err)
+	}
+
+	url := s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + hotRangesPath
+	body, err := getText(url + "?count=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := bytes.Count(body, []byte("\n")); lines != 1 {
+		t.Errorf("expected a single hot range, got %d:\n%s", lines, body)
+	}
+	if exp := "store=1 range="; !bytes.HasPrefix(body, []byte(exp)) {
+		t.Errorf("expected %q to start with %q", body, exp)
+	}
+
+	body, err = getText(url + "?count=-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "invalid count"; !bytes.Contains(body, []byte(exp)) {
+		t.Errorf("expected %q to contain %q", body, exp)
+	}
+}
+
+// TestAdminPendingGC verifies that the pending GC endpoint lists no
+// replicas on a fresh server and rejects the recovery of unknown ones.
+func TestAdminPendingGC(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	url := s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + pendingGCPath
+	body, err := getText(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != 0 {
+		t.Errorf("expected no replicas pending GC, got:\n%s", body)
+	}
+
+	body, err = getText(url + "?recover=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "has no replica pending GC"; !bytes.Contains(body, []byte(exp)) {
+		t.Errorf("expected %q to contain %q", body, exp)
+	}
+}
@@ -36,15 +36,24 @@ import (
 
 // Context defaults.
 const (
-	defaultAddr               = ":26257"
-	defaultMaxOffset          = 250 * time.Millisecond
-	defaultGossipInterval     = 2 * time.Second
-	defaultCacheSize          = 1 << 30 // GB
-	defaultScanInterval       = 10 * time.Minute
-	defaultScanMaxIdleTime    = 5 * time.Second
-	defaultMetricsFrequency   = 10 * time.Second
-	defaultTimeUntilStoreDead = 5 * time.Minute
-	defaultAllowRebalancing   = false
+	defaultAddr                   = ":26257"
+	defaultMaxOffset              = 250 * time.Millisecond
+	defaultGossipInterval         = 2 * time.Second
+	defaultCacheSize              = 1 << 30 // GB
+	defaultScanInterval           = 10 * time.Minute
+	defaultScanMaxIdleTime        = 5 * time.Second
+	defaultMetricsFrequency       = 10 * time.Second
+	defaultTimeUntilStoreDead     = 5 * time.Minute
+	defaultReplicaGCDelay         = time.Hour
+	defaultAllowRebalancing       = false
+	defaultDrainTimeout           = 10 * time.Second
+	defaultMaxSQLSessions         = 1000
+	defaultSQLIdleTimeout         = 30 * time.Minute
+	defaultSQLRowTTLInterval      = 5 * time.Minute
+	defaultSQLSessionMemoryLimit  = 256 << 20 // MB
+	defaultMemoryBudget           = 1 << 30   // GB
+	defaultMemoryBudgetWait       = time.Second
+	defaultCapacityAlertThreshold = 0.95
 )
 
 // Context holds parameters needed to setup a server.
@@ -129,20 +138,106 @@ type Context struct {
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it is considered dead.
 	TimeUntilStoreDead time.Duration
+
+	// ReplicaGCDelay is the time for which the data of a replica removed
+	// from its range is kept on its store, so that an erroneous removal can
+	// be undone. Zero destroys the data immediately.
+	ReplicaGCDelay time.Duration
+
+	// MaxReplicasPerStore is the maximum number of replicas each store of
+	// the node holds before refusing new ones. Zero means no limit.
+	MaxReplicasPerStore int
+
+	// DrainTimeout bounds the time the server waits for in-flight requests
+	// to complete when shutting down.
+	DrainTimeout time.Duration
+
+	// MaxSQLSessions is the maximum number of SQL sessions which can
+	// concurrently execute statements on this node. Zero means no limit.
+	MaxSQLSessions int
+
+	// SQLIdleTimeout is the duration after which the open transaction of an
+	// idle SQL session is aborted. Zero disables the timeout.
+	SQLIdleTimeout time.Duration
+
+	// SQLSessionMemoryLimit is the amount of memory in bytes which may be
+	// used by a request of a SQL session to buffer its results and to sort,
+	// group and join rows. Statements exceeding it fail. Zero means no
+	// limit.
+	SQLSessionMemoryLimit int64
+
+	// SQLStatementTimeout is the maximum execution time of a SQL statement.
+	// The KV requests of a statement running for longer are abandoned and
+	// the statement fails. Zero means no limit.
+	SQLStatementTimeout time.Duration
+
+	// SQLSlowQueryThreshold is the execution time above which SQL
+	// statements are recorded in the slow query log, along with their
+	// latency and the number of KV batches, rows and retries they took.
+	// Zero disables the slow query log.
+	SQLSlowQueryThreshold time.Duration
+
+	// SQLSlowQueryLog is the file to which the slow query log is appended.
+	// If empty, slow statements are logged as warnings.
+	SQLSlowQueryLog string
+
+	// SQLRowTTLInterval is the interval at which the expired rows of the
+	// tables with a row TTL are deleted. Zero disables the deletion of
+	// expired rows.
+	SQLRowTTLInterval time.Duration
+
+	// MemoryBudget is the amount of memory in bytes which may be used by the
+	// KV requests and SQL results in flight on this node. Work beyond the
+	// budget is queued for up to MemoryBudgetWait and then rejected. Zero
+	// disables the budget.
+	MemoryBudget int64
+
+	// MemoryBudgetWait is the maximum time a request waits for memory to
+	// become available.
+	MemoryBudgetWait time.Duration
+
+	// CapacityAlertThreshold is the fraction of a store's disk capacity in
+	// use above which the store refuses new replicas and writes of new data
+	// to non-system ranges. Zero disables the check.
+	CapacityAlertThreshold float64
+
+	// MaxCommandSize is the maximum size in bytes of an encoded raft
+	// command. Larger batches are refused; zero means the store default.
+	MaxCommandSize int64
+
+	// QueueDryRun makes the replica queues of all stores log the splits,
+	// replication changes and garbage collection they would perform
+	// instead of performing them.
+	QueueDryRun bool
+
+	// VerifyIntegrity makes the stores verify the checksums of their data
+	// and the consistency of their replicas' raft state when starting.
+	// A store whose data is corrupt refuses to start; replicas with
+	// inconsistent raft state are quarantined.
+	VerifyIntegrity bool
 }
 
 // NewContext returns a Context with default values.
 func NewContext() *Context {
 	ctx := &Context{
-		Addr:               defaultAddr,
-		MaxOffset:          defaultMaxOffset,
-		GossipInterval:     defaultGossipInterval,
-		CacheSize:          defaultCacheSize,
-		ScanInterval:       defaultScanInterval,
-		ScanMaxIdleTime:    defaultScanMaxIdleTime,
-		MetricsFrequency:   defaultMetricsFrequency,
-		TimeUntilStoreDead: defaultTimeUntilStoreDead,
-		AllowRebalancing:   defaultAllowRebalancing,
+		Addr:                   defaultAddr,
+		MaxOffset:              defaultMaxOffset,
+		GossipInterval:         defaultGossipInterval,
+		CacheSize:              defaultCacheSize,
+		ScanInterval:           defaultScanInterval,
+		ScanMaxIdleTime:        defaultScanMaxIdleTime,
+		MetricsFrequency:       defaultMetricsFrequency,
+		TimeUntilStoreDead:     defaultTimeUntilStoreDead,
+		ReplicaGCDelay:         defaultReplicaGCDelay,
+		AllowRebalancing:       defaultAllowRebalancing,
+		DrainTimeout:           defaultDrainTimeout,
+		MaxSQLSessions:         defaultMaxSQLSessions,
+		SQLIdleTimeout:         defaultSQLIdleTimeout,
+		SQLSessionMemoryLimit:  defaultSQLSessionMemoryLimit,
+		SQLRowTTLInterval:      defaultSQLRowTTLInterval,
+		MemoryBudget:           defaultMemoryBudget,
+		MemoryBudgetWait:       defaultMemoryBudgetWait,
+		CapacityAlertThreshold: defaultCapacityAlertThreshold,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
@@ -0,0 +1,58 @@
+// Copyright 2014 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// metricsEndpoint exposes the metrics of the node and its stores in the
+// Prometheus text exposition format.
+const metricsEndpoint = "/metrics"
+
+// handleMetrics writes the node-level metrics and those of all stores,
+// labeled with the node ID and, for store metrics, the store ID.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	nodeID := strconv.FormatInt(int64(s.node.Descriptor.NodeID), 10)
+	registries := []metric.LabeledRegistry{{
+		Registry: s.registry,
+		Labels:   []metric.Label{{Name: "node", Value: nodeID}},
+	}}
+	if err := s.node.lSender.VisitStores(func(store *storage.Store) error {
+		registries = append(registries, metric.LabeledRegistry{
+			Registry: store.Registry(),
+			Labels: []metric.Label{
+				{Name: "node", Value: nodeID},
+				{Name: "store", Value: strconv.FormatInt(int64(store.StoreID()), 10)},
+			},
+		})
+		return nil
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(util.ContentTypeHeader, "text/plain; version=0.0.4")
+	if err := metric.WritePrometheus(w, registries); err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestMetricsEndpoint verifies that the metrics of the node's stores are
+// exported in the Prometheus text format, labeled with node and store.
+func TestMetricsEndpoint(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	// Issue a SQL statement so that the node-level metrics are populated.
+	if _, _, err := s.sqlServer.Execute(driver.Request{User: "root", Sql: "SHOW DATABASES"}); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := getText(s.Ctx.HTTPRequestScheme() + "://" + s.ServingAddr() + metricsEndpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"# TYPE cockroach_requests_latency summary\n",
+		`cockroach_requests_latency{node="1",store="1",quantile="0.5"} `,
+		"# TYPE cockroach_sql_statements_count counter\n",
+		`cockroach_sql_statements_count{node="1"} `,
+	} {
+		if !bytes.Contains(body, []byte(expected)) {
+			t.Errorf("expected %q in metrics:\n%s", expected, body)
+		}
+	}
+}
@@ -32,11 +32,13 @@ import (
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/budget"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/stop"
@@ -69,6 +71,10 @@ type Node struct {
 	feed       status.NodeEventFeed   // Feed publisher for local events
 	status     *status.NodeStatusMonitor
 	startedAt  int64
+	memory     *budget.Pool // Memory budget for requests in flight; may be nil
+	// joined is set if the node allocated its node ID on this start, i.e.
+	// joined the cluster for the first time.
+	joined bool
 }
 
 // allocateNodeID increments the node id generator key to allocate
@@ -92,13 +98,24 @@ func allocateStoreIDs(nodeID roachpb.NodeID, inc int64, db *client.DB) (roachpb.
 	return roachpb.StoreID(r.ValueInt() - inc + 1), nil
 }
 
+// InitialSplitKeys are the keys at which the key space of a new cluster is
+// split when it is bootstrapped: the time series data, the system tables
+// (which hold the system config) and the user tables each start out in a
+// range of their own.
+var InitialSplitKeys = []roachpb.RKey{
+	roachpb.RKey(keys.TimeseriesPrefix),
+	roachpb.RKey(keys.SystemDBSpan.Key),
+	roachpb.RKey(keys.UserTableDataMin),
+}
+
 // BootstrapCluster bootstraps a multiple stores using the provided engines and
-// cluster ID. The first bootstrapped store contains a single range spanning
-// all keys. Initial range lookup metadata is populated for the range.
+// cluster ID. The first bootstrapped store contains the initial ranges, which
+// span all keys and are split at the given split keys (a single range if
+// there are none). Initial range lookup metadata is populated for the ranges.
 //
 // Returns a KV client for unittest purposes. Caller should close the returned
 // client.
-func BootstrapCluster(clusterID string, engines []engine.Engine, stopper *stop.Stopper) (*client.DB, error) {
+func BootstrapCluster(clusterID string, engines []engine.Engine, splits []roachpb.RKey, stopper *stop.Stopper) (*client.DB, error) {
 	ctx := storage.StoreContext{}
 	ctx.ScanInterval = 10 * time.Minute
 	ctx.Clock = hlc.NewClock(hlc.UnixNano)
@@ -127,14 +144,14 @@ func BootstrapCluster(clusterID string, engines []engine.Engine, stopper *stop.S
 		if err := s.Bootstrap(sIdent, stopper); err != nil {
 			return nil, err
 		}
-		// Create first range, writing directly to engine. Note this does
-		// not create the range, just its data.  Only do this if this is the
-		// first store.
+		// Create the initial ranges, writing directly to engine. Note this
+		// does not create the ranges, just their data.  Only do this if this
+		// is the first store.
 		if i == 0 {
 			// TODO(marc): this is better than having storage/ import sql, but still
 			// not great. Find a better place to keep those.
 			initialValues := sql.GetInitialSystemValues()
-			if err := s.BootstrapRange(initialValues); err != nil {
+			if err := s.BootstrapRange(initialValues, splits); err != nil {
 				return nil, err
 			}
 		}
@@ -173,6 +190,12 @@ func (n *Node) context() context.Context {
 	return log.Add(context.Background(), log.NodeID, n.Descriptor.NodeID)
 }
 
+// ClusterNow returns a timestamp which is safe for consistent reads across
+// all ranges of the cluster. See storage.ClusterNow.
+func (n *Node) ClusterNow() roachpb.Timestamp {
+	return storage.ClusterNow(n.ctx.Clock)
+}
+
 // initDescriptor initializes the node descriptor with the server
 // address and the node attributes.
 func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes) {
@@ -208,6 +231,7 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 		if id == 0 {
 			log.Fatal("new node allocated illegal ID 0")
 		}
+		n.joined = true
 
 	} else {
 		log.Infof("node ID %d initialized", id)
@@ -248,6 +272,7 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 
 	n.startPublishStatuses(stopper)
 	n.startGossip(stopper)
+	n.recordJoinEvent(stopper)
 	log.Infoc(n.context(), "Started node with %v engine(s) and attributes %v", engines, attrs.Attrs)
 	return nil
 }
@@ -292,6 +317,12 @@ func (n *Node) initStores(engines []engine.Engine, stopper *stop.Stopper) error
 		return err
 	}
 
+	// Persist the addresses of the nodes learned through gossip in the
+	// stores, and try those persisted before the restart when bootstrapping.
+	if err := n.ctx.Gossip.SetStorage(n.lSender); err != nil {
+		return util.Errorf("failed to initialize the gossip storage: %s", err)
+	}
+
 	// Connect gossip before starting bootstrap. For new nodes, connecting
 	// to the gossip network is necessary to get the cluster ID.
 	n.connectGossip()
@@ -362,12 +393,48 @@ func (n *Node) bootstrapStores(bootstraps *list.List, stopper *stop.Stopper) {
 		n.lSender.AddStore(s)
 		sIdent.StoreID++
 		log.Infof("bootstrapped store %s", s)
+		n.recordStoreAddedEvent(s.Ident.StoreID)
 		// Done regularly in Node.startGossip, but this cuts down the time
 		// until this store is used for range allocations.
 		s.GossipStore()
 	}
 }
 
+// recordJoinEvent records the start of the node in the event log, as a
+// node join if the node joined the cluster on this start and as a node
+// restart otherwise. The event is recorded asynchronously since the
+// cluster may not be available yet.
+func (n *Node) recordJoinEvent(stopper *stop.Stopper) {
+	eventType := sql.EventLogNodeRestart
+	if n.joined {
+		eventType = sql.EventLogNodeJoin
+	}
+	desc := n.Descriptor
+	stopper.RunAsyncTask(func() {
+		n.recordEvent(eventType, int32(desc.NodeID), struct {
+			Descriptor roachpb.NodeDescriptor
+			StartedAt  int64
+		}{desc, n.startedAt})
+	})
+}
+
+// recordStoreAddedEvent records the bootstrap of a new store of the node in
+// the event log.
+func (n *Node) recordStoreAddedEvent(storeID roachpb.StoreID) {
+	n.recordEvent(sql.EventLogStoreAdded, int32(storeID), nil)
+}
+
+// recordEvent records an event reported by the node in the event log. A
+// failure to do so is logged and otherwise ignored.
+func (n *Node) recordEvent(eventType sql.EventLogType, targetID int32, info interface{}) {
+	nodeID := n.Descriptor.NodeID
+	if err := n.ctx.DB.Txn(func(txn *client.Txn) error {
+		return sql.InsertEventRecord(txn, eventType, targetID, int32(nodeID), security.NodeUser, info)
+	}); err != nil {
+		log.Warningf("unable to record %s event of node %d: %s", eventType, nodeID, err)
+	}
+}
+
 // connectGossip connects to gossip network and reads cluster ID. If
 // this node is already part of a cluster, the cluster ID is verified
 // for a match. If not part of a cluster, the cluster ID is set. The
@@ -449,10 +516,37 @@ func (n *Node) publishStoreStatuses() error {
 	})
 }
 
+// setDraining sets or clears the draining mode of every store on the node.
+func (n *Node) setDraining(drain bool) error {
+	return n.lSender.VisitStores(func(store *storage.Store) error {
+		store.SetDraining(drain)
+		return nil
+	})
+}
+
+// drainLeases hands off the leader leases held by the node's stores.
+func (n *Node) drainLeases() error {
+	return n.lSender.VisitStores(func(store *storage.Store) error {
+		if count := store.DrainLeases(); count > 0 {
+			log.Infof("store %d: handed off %d leader leases", store.StoreID(), count)
+		}
+		return nil
+	})
+}
+
 // executeCmd interprets the given message as a *roachpb.BatchRequest and sends it
 // via the local sender.
 func (n *Node) executeCmd(argsI proto.Message) (proto.Message, error) {
 	ba := argsI.(*roachpb.BatchRequest)
+	// Account for the memory held by the request while it is in flight. The
+	// size of the encoded request is used as an approximation.
+	res, err := n.memory.Reserve(int64(ba.Size()))
+	if err != nil {
+		br := &roachpb.BatchResponse{}
+		br.Error = roachpb.NewError(&roachpb.ServerOverloadedError{Message: err.Error()})
+		return br, nil
+	}
+	defer res.Release()
 	// TODO(tschottdorf) get a hold of the client's ID, add it to the
 	// context before dispatching, and create an ID for tracing the request.
 	ba.CmdID = ba.GetOrCreateCmdID(n.ctx.Clock.PhysicalNow())
@@ -471,5 +565,16 @@ func (n *Node) executeCmd(argsI proto.Message) (proto.Message, error) {
 	}
 	n.feed.CallComplete(*ba, pErr)
 	br.Error = pErr
+	if ba.PackedResponses {
+		br.PackRows()
+	}
+	// The response is held until it has been sent, so it is accounted for
+	// as well. A read-only batch can be retried safely, so its response is
+	// dropped if it doesn't fit in the budget; a write has been applied
+	// already, and its response must be returned regardless.
+	if err := res.Grow(int64(br.Size())); err != nil && ba.IsReadOnly() {
+		br = &roachpb.BatchResponse{}
+		br.Error = roachpb.NewError(&roachpb.ServerOverloadedError{Message: err.Error()})
+	}
 	return br, nil
 }
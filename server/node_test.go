@@ -111,7 +111,7 @@ func TestBootstrapCluster(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
 	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
-	localDB, err := BootstrapCluster("cluster-1", []engine.Engine{e}, stopper)
+	localDB, err := BootstrapCluster("cluster-1", []engine.Engine{e}, nil, stopper)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -156,7 +156,7 @@ func TestBootstrapNewStore(t *testing.T) {
 	defer engineStopper.Stop()
 	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, engineStopper)
 	eagerStopper := stop.NewStopper()
-	if _, err := BootstrapCluster("cluster-1", []engine.Engine{e}, eagerStopper); err != nil {
+	if _, err := BootstrapCluster("cluster-1", []engine.Engine{e}, nil, eagerStopper); err != nil {
 		t.Fatal(err)
 	}
 	eagerStopper.Stop()
@@ -197,7 +197,7 @@ func TestNodeJoin(t *testing.T) {
 	defer engineStopper.Stop()
 	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, engineStopper)
 	stopper := stop.NewStopper()
-	_, err := BootstrapCluster("cluster-1", []engine.Engine{e}, stopper)
+	_, err := BootstrapCluster("cluster-1", []engine.Engine{e}, nil, stopper)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -253,7 +253,7 @@ func TestCorruptedClusterID(t *testing.T) {
 	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, engineStopper)
 	defer engineStopper.Stop()
 	eagerStopper := stop.NewStopper()
-	_, err := BootstrapCluster("cluster-1", []engine.Engine{e}, eagerStopper)
+	_, err := BootstrapCluster("cluster-1", []engine.Engine{e}, nil, eagerStopper)
 	if err != nil {
 		t.Fatal(err)
 	}
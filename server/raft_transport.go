@@ -115,13 +115,29 @@ func (t *rpcTransport) Stop(id roachpb.StoreID) {
 	delete(t.servers, id)
 }
 
+// reportPeerHealth tells the local MultiRaft instances that the given
+// store became reachable or unreachable.
+func (t *rpcTransport) reportPeerHealth(storeID roachpb.StoreID, healthy bool) {
+	var listeners []multiraft.PeerHealthListener
+	t.mu.Lock()
+	for _, server := range t.servers {
+		if l, ok := server.(multiraft.PeerHealthListener); ok {
+			listeners = append(listeners, l)
+		}
+	}
+	t.mu.Unlock()
+	// The listeners must be called without holding the lock, since they
+	// wait for the raft goroutine, which may be blocked in Send.
+	for _, l := range listeners {
+		l.ReportPeerHealth(storeID, healthy)
+	}
+}
+
 // processQueue creates a client and sends messages from its designated queue
 // via that client, exiting when the client fails or when it idles out. All
 // messages remaining in the queue at that point are lost and a new instance of
-// processQueue should be started by the next message to be sent.
-// TODO(tschottdorf) should let MultiRaft know if the node is down;
-// need a feedback mechanism for that. Potentially easiest is to arrange for
-// the next call to Send() to fail appropriately.
+// processQueue should be started by the next message to be sent. MultiRaft
+// is told when the client becomes healthy and when it fails.
 func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.StoreID) {
 	t.mu.Lock()
 	ch, ok := t.queues[storeID]
@@ -139,6 +155,7 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 	addr, err := t.gossip.GetNodeIDAddress(nodeID)
 	if err != nil {
 		log.Errorf("could not get address for node %d: %s", nodeID, err)
+		t.reportPeerHealth(storeID, false)
 		return
 	}
 	client := rpc.NewClient(addr, t.rpcContext)
@@ -147,13 +164,17 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 		return
 	case <-client.Closed:
 		log.Warningf("raft client for node %d was closed", nodeID)
+		t.reportPeerHealth(storeID, false)
 		return
 	case <-time.After(raftIdleTimeout):
 		// Should never happen.
 		log.Errorf("raft client for node %d stuck connecting", nodeID)
+		t.reportPeerHealth(storeID, false)
 		return
 	case <-client.Healthy():
 	}
+	healthy := true
+	t.reportPeerHealth(storeID, healthy)
 
 	done := make(chan *gorpc.Call, cap(ch))
 	var req *multiraft.RaftMessageRequest
@@ -169,10 +190,18 @@ func (t *rpcTransport) processQueue(nodeID roachpb.NodeID, storeID roachpb.Store
 			return
 		case <-client.Closed:
 			log.Warningf("raft client for node %d closed", nodeID)
+			t.reportPeerHealth(storeID, false)
 			return
 		case call := <-done:
 			if call.Error != nil {
 				log.Errorf("raft message to node %d failed: %s", nodeID, call.Error)
+				if healthy {
+					healthy = false
+					t.reportPeerHealth(storeID, healthy)
+				}
+			} else if !healthy {
+				healthy = true
+				t.reportPeerHealth(storeID, healthy)
 			}
 			continue
 		case req = <-ch:
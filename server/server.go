@@ -24,8 +24,10 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/c-snappy"
@@ -43,8 +45,10 @@ import (
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/ui"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/budget"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/tracer"
 	assetfs "github.com/elazarl/go-bindata-assetfs"
@@ -66,6 +70,7 @@ type Server struct {
 	rpc           *rpc.Server
 	gossip        *gossip.Gossip
 	storePool     *storage.StorePool
+	nodeLiveness  *storage.NodeLiveness
 	db            *client.DB
 	kvDB          *kv.DBServer
 	sqlServer     sql.Server
@@ -76,7 +81,10 @@ type Server struct {
 	tsDB          *ts.DB
 	tsServer      *ts.Server
 	raftTransport multiraft.Transport
+	registry      *metric.Registry // Node-level metrics of the client and SQL
 	stopper       *stop.Stopper
+	draining      int32 // Accessed atomically; non-zero while draining.
+	inFlight      int32 // Accessed atomically; number of HTTP requests being served.
 }
 
 // NewServer creates a Server from a server.Context.
@@ -102,10 +110,11 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	}
 
 	s := &Server{
-		ctx:     ctx,
-		mux:     http.NewServeMux(),
-		clock:   hlc.NewClock(hlc.UnixNano),
-		stopper: stopper,
+		ctx:      ctx,
+		mux:      http.NewServeMux(),
+		clock:    hlc.NewClock(hlc.UnixNano),
+		registry: metric.NewRegistry(),
+		stopper:  stopper,
 	}
 	s.clock.SetMaxOffset(ctx.MaxOffset)
 
@@ -122,9 +131,10 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	feed := util.NewFeed(stopper)
 	tracer := tracer.NewTracer(feed, addr)
 
-	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock}, s.gossip)
+	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock, PackedResponses: true}, s.gossip)
 	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, tracer, s.stopper)
 	s.db = client.NewDB(sender)
+	s.db.SetMetrics(client.NewRegistryMetrics(s.registry))
 
 	var err error
 	s.raftTransport, err = newRPCTransport(s.gossip, s.rpc, rpcContext)
@@ -138,28 +148,61 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		return nil, err
 	}
 
-	s.sqlServer = sql.MakeServer(&s.ctx.Context, *s.db, s.gossip, s.clock)
+	s.sqlServer = sql.MakeServer(&s.ctx.Context, *s.db, s.gossip, s.clock, rpcContext)
+	s.sqlServer.SetMetrics(s.registry)
+	s.sqlServer.SetSessionMemoryLimit(ctx.SQLSessionMemoryLimit)
+	s.sqlServer.SetStatementTimeout(ctx.SQLStatementTimeout)
+	if ctx.SQLSlowQueryThreshold > 0 {
+		var w io.Writer
+		if ctx.SQLSlowQueryLog != "" {
+			f, err := os.OpenFile(ctx.SQLSlowQueryLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				return nil, util.Errorf("unable to open slow query log: %s", err)
+			}
+			s.stopper.AddCloser(stop.CloserFn(func() { _ = f.Close() }))
+			w = f
+		}
+		s.sqlServer.SetSlowQueryLog(ctx.SQLSlowQueryThreshold, w)
+	}
 	if err := s.sqlServer.RegisterRPC(s.rpc); err != nil {
 		return nil, err
 	}
 
+	s.nodeLiveness = storage.NewNodeLiveness(s.clock, s.db, s.gossip,
+		storage.DefaultLivenessThreshold, storage.DefaultLivenessHeartbeatInterval)
+
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
-		Clock:           s.clock,
-		DB:              s.db,
-		Gossip:          s.gossip,
-		Transport:       s.raftTransport,
-		ScanInterval:    s.ctx.ScanInterval,
-		ScanMaxIdleTime: s.ctx.ScanMaxIdleTime,
-		EventFeed:       feed,
-		Tracer:          tracer,
-		StorePool:       s.storePool,
+		Clock:                      s.clock,
+		DB:                         s.db,
+		Gossip:                     s.gossip,
+		Transport:                  s.raftTransport,
+		ScanInterval:               s.ctx.ScanInterval,
+		ScanMaxIdleTime:            s.ctx.ScanMaxIdleTime,
+		BackgroundLatencyThreshold: storage.DefaultBackgroundLatencyThreshold,
+		BackgroundIOThreshold:      storage.DefaultBackgroundIOThreshold,
+		RangeLogTTL:                storage.DefaultRangeLogTTL,
+		ReplicaGCDelay:             s.ctx.ReplicaGCDelay,
+		MaxReplicas:                s.ctx.MaxReplicasPerStore,
+		CapacityAlertThreshold:     s.ctx.CapacityAlertThreshold,
+		MaxCommandSize:             s.ctx.MaxCommandSize,
+		QueueDryRun:                s.ctx.QueueDryRun,
+		VerifyIntegrity:            s.ctx.VerifyIntegrity,
+		EventFeed:                  feed,
+		Tracer:                     tracer,
+		StorePool:                  s.storePool,
+		NodeLiveness:               s.nodeLiveness,
 		RebalancingOptions: storage.RebalancingOptions{
 			AllowRebalance: s.ctx.AllowRebalancing,
 		},
 	}
 	s.node = NewNode(nCtx)
-	s.admin = newAdminServer(s.db, s.stopper)
+	if ctx.MemoryBudget > 0 {
+		pool := budget.NewPool(ctx.MemoryBudget, ctx.MemoryBudgetWait)
+		s.node.memory = pool
+		s.sqlServer.SetMemoryPool(pool)
+	}
+	s.admin = newAdminServer(s.db, s.node.lSender, s.stopper, func() { s.Drain(s.ctx.DrainTimeout) })
 	s.status = newStatusServer(s.db, s.gossip, ctx)
 	s.tsDB = ts.NewDB(s.db)
 	s.tsServer = ts.NewServer(s.tsDB)
@@ -189,6 +232,10 @@ func (s *Server) Start(selfBootstrap bool) error {
 		return err
 	}
 
+	// Begin heartbeating the node's liveness record, which backs its
+	// epoch-based leader leases.
+	s.nodeLiveness.StartHeartbeat(s.stopper, s.node.Descriptor.NodeID)
+
 	// Begin recording runtime statistics.
 	runtime := status.NewRuntimeStatRecorder(s.node.Descriptor.NodeID, s.clock)
 	s.tsDB.PollSource(runtime, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
@@ -201,6 +248,8 @@ func (s *Server) Start(selfBootstrap bool) error {
 	s.startWriteSummaries()
 
 	s.sqlServer.SetNodeID(s.node.Descriptor.NodeID)
+	s.sqlServer.StartSessionMonitor(s.ctx.MaxSQLSessions, s.ctx.SQLIdleTimeout, s.stopper)
+	s.sqlServer.StartRowTTLDeleter(s.ctx.SQLRowTTLInterval, s.stopper)
 
 	log.Infof("starting %s server at %s", s.ctx.HTTPRequestScheme(), s.rpc.Addr())
 	s.initHTTP()
@@ -220,6 +269,7 @@ func (s *Server) initHTTP() {
 	s.mux.Handle(debugEndpoint, s.admin)
 	s.mux.Handle(statusPrefix, s.status)
 	s.mux.Handle(ts.URLPrefix, s.tsServer)
+	s.mux.HandleFunc(metricsEndpoint, s.handleMetrics)
 
 	// The SQL endpoints handles its own authentication, verifying user
 	// credentials against the requested user.
@@ -281,15 +331,68 @@ func (s *Server) writeSummaries() error {
 	return nil
 }
 
-// Stop stops the server.
+// Drain places the server into draining mode and waits, for at most the
+// supplied duration, for in-flight requests to complete. While draining,
+// new HTTP, SQL and KV client requests are rejected and the node's stores
+// stop acquiring leader leases. Once the in-flight requests have completed,
+// or the timeout has passed, the leases held by the node's stores are
+// handed off to other replicas. Drain returns true if all in-flight
+// requests completed in time.
+func (s *Server) Drain(timeout time.Duration) bool {
+	if !atomic.CompareAndSwapInt32(&s.draining, 0, 1) {
+		// Already draining.
+		return false
+	}
+	log.Info("draining server")
+	s.kvDB.SetDraining(true)
+	s.sqlServer.SetDraining(true)
+	if err := s.node.setDraining(true); err != nil {
+		log.Warningf("unable to drain stores: %s", err)
+	}
+
+	drained := true
+	deadline := time.Now().Add(timeout)
+	for n := s.numInFlight(); n > 0; n = s.numInFlight() {
+		if time.Now().After(deadline) {
+			log.Warningf("drain timeout reached with %d requests in flight", n)
+			drained = false
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := s.node.drainLeases(); err != nil {
+		log.Warningf("unable to drain leader leases: %s", err)
+	}
+	return drained
+}
+
+// numInFlight returns the number of client requests being served.
+func (s *Server) numInFlight() int {
+	return int(atomic.LoadInt32(&s.inFlight)) + s.kvDB.NumInFlight()
+}
+
+// Stop drains and then stops the server.
 func (s *Server) Stop() {
+	s.Drain(s.ctx.DrainTimeout)
 	s.stopper.Stop()
 }
 
 // ServeHTTP is necessary to implement the http.Handler interface. It
 // will snappy a response if the appropriate request headers are set.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	// Check if we're draining; if so return 503, service unavailable.
+	// Check if we're draining; if so return 503, service unavailable. The
+	// admin endpoints remain available so that a drain can be monitored and
+	// completed.
+	if !strings.HasPrefix(r.URL.Path, adminEndpoint) {
+		// The request is counted before the draining check so that a drain
+		// which observes no requests in flight won't miss this one.
+		atomic.AddInt32(&s.inFlight, 1)
+		defer atomic.AddInt32(&s.inFlight, -1)
+		if atomic.LoadInt32(&s.draining) != 0 {
+			http.Error(w, "service is draining", http.StatusServiceUnavailable)
+			return
+		}
+	}
 	if !s.stopper.RunTask(func() {
 		// Disable caching of responses.
 		w.Header().Set("Cache-control", "no-cache")
@@ -39,6 +39,7 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
@@ -176,6 +177,45 @@ func TestHealth(t *testing.T) {
 	}
 }
 
+// TestDrain verifies that a draining server rejects new requests outside
+// of the admin endpoints and stops acquiring leader leases.
+func TestDrain(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	if !s.Drain(5 * time.Second) {
+		t.Fatal("expected drain to complete")
+	}
+	if err := s.node.lSender.VisitStores(func(store *storage.Store) error {
+		if !store.IsDraining() {
+			t.Errorf("expected store %d to be draining", store.StoreID())
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient, err := testContext.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, expCode := range map[string]int{
+		healthPath:   http.StatusOK,
+		statusPrefix: http.StatusServiceUnavailable,
+	} {
+		url := testContext.HTTPRequestScheme() + "://" + s.ServingAddr() + path
+		resp, err := httpClient.Get(url)
+		if err != nil {
+			t.Fatalf("error requesting %s: %s", url, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != expCode {
+			t.Errorf("%s: expected status %d, got %d", path, expCode, resp.StatusCode)
+		}
+	}
+}
+
 // TestPlainHTTPServer verifies that we can serve plain http and talk to it.
 // This is controlled by -cert=""
 func TestPlainHTTPServer(t *testing.T) {
@@ -518,6 +518,7 @@ func (s *statusServer) handleNodesStatus(w http.ResponseWriter, r *http.Request,
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		s.updateNodeStatusFromGossip(nodeStatus)
 		nodeStatuses = append(nodeStatuses, *nodeStatus)
 	}
 	respondAsJSON(w, r, nodeStatuses)
@@ -538,6 +539,7 @@ func (s *statusServer) handleNodeStatus(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	s.updateNodeStatusFromGossip(nodeStatus)
 
 	respondAsJSON(w, r, nodeStatus)
 }
@@ -562,6 +564,7 @@ func (s *statusServer) handleStoresStatus(w http.ResponseWriter, r *http.Request
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		s.updateStoreStatusFromGossip(storeStatus)
 		storeStatuses = append(storeStatuses, *storeStatus)
 	}
 	respondAsJSON(w, r, storeStatuses)
@@ -585,9 +588,39 @@ func (s *statusServer) handleStoreStatus(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	s.updateStoreStatusFromGossip(storeStatus)
 	respondAsJSON(w, r, storeStatus)
 }
 
+// updateNodeStatusFromGossip replaces the node descriptor in the supplied
+// status record with the most recently gossiped one, if available. Status
+// records are only written periodically, while gossip reflects the current
+// cluster membership.
+func (s *statusServer) updateNodeStatusFromGossip(nodeStatus *status.NodeStatus) {
+	desc, err := s.gossip.GetNodeDescriptor(nodeStatus.Desc.NodeID)
+	if err != nil {
+		if log.V(1) {
+			log.Info(err)
+		}
+		return
+	}
+	nodeStatus.Desc = *desc
+}
+
+// updateStoreStatusFromGossip replaces the store descriptor in the supplied
+// status record with the most recently gossiped one, if available, so that
+// the reported capacity is as fresh as the allocator's view of it.
+func (s *statusServer) updateStoreStatusFromGossip(storeStatus *storage.StoreStatus) {
+	var desc roachpb.StoreDescriptor
+	if err := s.gossip.GetInfoProto(gossip.MakeStoreKey(storeStatus.Desc.StoreID), &desc); err != nil {
+		if log.V(1) {
+			log.Infof("unable to lookup gossiped descriptor for store %d: %s", storeStatus.Desc.StoreID, err)
+		}
+		return
+	}
+	storeStatus.Desc = desc
+}
+
 func respondAsJSON(w http.ResponseWriter, r *http.Request, response interface{}) {
 	b, contentType, err := util.MarshalResponse(r, response, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
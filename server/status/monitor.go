@@ -26,6 +26,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/tracer"
 )
 
@@ -37,11 +38,16 @@ type StoreStatusMonitor struct {
 	ID        roachpb.StoreID
 	desc      *roachpb.StoreDescriptor
 	startedAt int64
+	metrics   *metric.Registry // Store-specific metrics, sampled by the recorder.
 
 	// replication counts.
 	leaderRangeCount     int32
 	replicatedRangeCount int32
 	availableRangeCount  int32
+
+	// verification status.
+	oldestVerifiedAt        int64
+	failedVerificationCount int32
 }
 
 // NodeStatusMonitor monitors the status of a server node. Status information
@@ -152,6 +158,9 @@ func (nsm *NodeStatusMonitor) OnMergeRange(event *storage.MergeRangeEvent) {
 func (nsm *NodeStatusMonitor) OnStartStore(event *storage.StartStoreEvent) {
 	ssm := nsm.GetStoreMonitor(event.StoreID)
 	atomic.StoreInt64(&ssm.startedAt, event.StartedAt)
+	ssm.Lock()
+	ssm.metrics = event.Metrics
+	ssm.Unlock()
 }
 
 // OnBeginScanRanges receives BeginScanRangesEvents retrieved from a storage
@@ -190,6 +199,17 @@ func (nsm *NodeStatusMonitor) OnReplicationStatus(event *storage.ReplicationStat
 	ssm.availableRangeCount = event.AvailableRangeCount
 }
 
+// OnVerificationStatus receives VerificationStatusEvents retrieved from a
+// storage event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnVerificationStatus(event *storage.VerificationStatusEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.oldestVerifiedAt = event.OldestVerifiedAt
+	ssm.failedVerificationCount = event.FailedVerificationCount
+}
+
 // OnStartNode receives StartNodeEvents from a node event subscription. This
 // method is part of the implementation of NodeEventListener.
 func (nsm *NodeStatusMonitor) OnStartNode(event *StartNodeEvent) {
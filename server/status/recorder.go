@@ -138,6 +138,13 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 			data = append(data, ssr.recordInt("capacity", int64(capacity.Capacity)))
 			data = append(data, ssr.recordInt("capacity.available", int64(capacity.Available)))
 		}
+
+		// Record metrics from the store's registry, if it has been received.
+		if ssr.metrics != nil {
+			ssr.metrics.Each(func(name string, value int64) {
+				data = append(data, ssr.recordInt(name, value))
+			})
+		}
 	})
 	nsr.lastDataCount = len(data)
 	return data
@@ -186,15 +193,17 @@ func (nsr *NodeStatusRecorder) GetStatusSummaries() (*NodeStatus, []storage.Stor
 			return
 		}
 		status := storage.StoreStatus{
-			Desc:                 *ssm.desc,
-			NodeID:               nsr.desc.NodeID,
-			UpdatedAt:            now,
-			StartedAt:            ssm.startedAt,
-			Stats:                ssm.stats,
-			RangeCount:           int32(ssm.rangeCount),
-			LeaderRangeCount:     ssm.leaderRangeCount,
-			ReplicatedRangeCount: ssm.replicatedRangeCount,
-			AvailableRangeCount:  ssm.availableRangeCount,
+			Desc:                    *ssm.desc,
+			NodeID:                  nsr.desc.NodeID,
+			UpdatedAt:               now,
+			StartedAt:               ssm.startedAt,
+			Stats:                   ssm.stats,
+			RangeCount:              int32(ssm.rangeCount),
+			LeaderRangeCount:        ssm.leaderRangeCount,
+			ReplicatedRangeCount:    ssm.replicatedRangeCount,
+			AvailableRangeCount:     ssm.availableRangeCount,
+			OldestVerifiedAt:        ssm.oldestVerifiedAt,
+			FailedVerificationCount: ssm.failedVerificationCount,
 		}
 		storeStats = append(storeStats, status)
 	})
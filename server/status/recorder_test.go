@@ -30,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
 )
 
 // byTimeAndName is a slice of ts.TimeSeriesData.
@@ -129,9 +130,13 @@ func TestNodeStatusRecorder(t *testing.T) {
 		Desc:      nodeDesc,
 		StartedAt: 50,
 	})
+	metrics := metric.NewRegistry()
+	metrics.Counter("raft.proposals").Inc(4)
+	metrics.Gauge("replicas").Update(2)
 	monitor.OnStartStore(&storage.StartStoreEvent{
 		StoreID:   roachpb.StoreID(1),
 		StartedAt: 60,
+		Metrics:   metrics,
 	})
 	monitor.OnStartStore(&storage.StartStoreEvent{
 		StoreID:   roachpb.StoreID(2),
@@ -193,6 +198,11 @@ func TestNodeStatusRecorder(t *testing.T) {
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
 	})
+	monitor.OnVerificationStatus(&storage.VerificationStatusEvent{
+		StoreID:                 roachpb.StoreID(1),
+		OldestVerifiedAt:        50,
+		FailedVerificationCount: 1,
+	})
 	// Node Events.
 	monitor.OnCallSuccess(&CallSuccessEvent{
 		NodeID: roachpb.NodeID(1),
@@ -255,6 +265,8 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(1, "ranges.replicated", 100, 0),
 		generateStoreData(1, "capacity", 100, 100),
 		generateStoreData(1, "capacity.available", 100, 50),
+		generateStoreData(1, "raft.proposals", 100, 4),
+		generateStoreData(1, "replicas", 100, 2),
 
 		// Store 2 should have accumulated 1 copy of stats
 		generateStoreData(2, "livebytes", 100, 1),
@@ -302,14 +314,16 @@ func TestNodeStatusRecorder(t *testing.T) {
 	}
 	expectedStoreSummaries := []storage.StoreStatus{
 		{
-			Desc:                 storeDesc1,
-			NodeID:               roachpb.NodeID(1),
-			UpdatedAt:            100,
-			StartedAt:            60,
-			RangeCount:           2,
-			LeaderRangeCount:     1,
-			AvailableRangeCount:  2,
-			ReplicatedRangeCount: 0,
+			Desc:                    storeDesc1,
+			NodeID:                  roachpb.NodeID(1),
+			UpdatedAt:               100,
+			StartedAt:               60,
+			RangeCount:              2,
+			LeaderRangeCount:        1,
+			AvailableRangeCount:     2,
+			ReplicatedRangeCount:    0,
+			OldestVerifiedAt:        50,
+			FailedVerificationCount: 1,
 		},
 		{
 			Desc:                 storeDesc2,
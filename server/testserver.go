@@ -181,13 +181,15 @@ func (ts *TestServer) StartWithStopper(stopper *stop.Stopper) error {
 
 	if !ts.SkipBootstrap {
 		stopper := stop.NewStopper()
-		_, err := BootstrapCluster("cluster-1", ts.Ctx.Engines, stopper)
+		_, err := BootstrapCluster("cluster-1", ts.Ctx.Engines, nil, stopper)
 		if err != nil {
 			return util.Errorf("could not bootstrap cluster: %s", err)
 		}
 		stopper.Stop()
 	}
-	if err := ts.Server.Start(true); err != nil {
+	// A server given gossip resolvers joins the cluster they belong to
+	// instead of gossiping with itself.
+	if err := ts.Server.Start(len(ts.Ctx.GossipBootstrapResolvers) == 0); err != nil {
 		return err
 	}
 
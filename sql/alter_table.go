@@ -19,6 +19,7 @@ package sql
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/sql/parser"
@@ -60,6 +61,9 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if err := p.checkPrivilege(tableDesc, privilege.CREATE); err != nil {
 		return nil, err
@@ -132,6 +136,24 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 			}
 			newTableDesc.Indexes = append(newTableDesc.Indexes[:i], newTableDesc.Indexes[i+1:]...)
 
+		case *parser.AlterTableSetTTL:
+			if t.TTL == nil {
+				newTableDesc.RowTTL = nil
+				continue
+			}
+			ttl, err := p.evalRowTTL(t.TTL)
+			if err != nil {
+				return nil, err
+			}
+			i, err := newTableDesc.FindColumnByName(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			newTableDesc.RowTTL = &RowTTL{
+				ColumnID: newTableDesc.Columns[i].ID,
+				Duration: int64(ttl),
+			}
+
 		default:
 			return nil, util.Errorf("unsupported alter cmd: %T", cmd)
 		}
@@ -140,6 +162,9 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 	if err := newTableDesc.AllocateIDs(); err != nil {
 		return nil, err
 	}
+	if err := newTableDesc.ValidateUpdate(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if err := p.backfillBatch(&b, n.Table, tableDesc, newTableDesc); err != nil {
 		return nil, err
@@ -155,5 +180,33 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 		return nil, convertBatchError(newTableDesc, b, err)
 	}
 
+	if err := p.logEvent(EventLogAlterTable, newTableDesc.ID,
+		schemaChangeEventInfo{Name: n.Table.String(), Statement: n.String()}); err != nil {
+		return nil, err
+	}
 	return &valuesNode{}, nil
 }
+
+// evalRowTTL evaluates the duration of a table's row TTL, which is given
+// either as an interval or as a string parsed by time.ParseDuration.
+func (p *planner) evalRowTTL(expr parser.Expr) (time.Duration, error) {
+	d, err := expr.Eval(p.evalCtx)
+	if err != nil {
+		return 0, err
+	}
+	var ttl time.Duration
+	switch t := d.(type) {
+	case parser.DInterval:
+		ttl = t.Duration
+	case parser.DString:
+		if ttl, err = time.ParseDuration(string(t)); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("row TTL must be an interval or a string, not %s", d)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("row TTL must be positive: %s", ttl)
+	}
+	return ttl, nil
+}
@@ -167,6 +167,18 @@ func simplifyNotExpr(n *parser.NotExpr) (parser.Expr, bool) {
 			op = parser.NotSimilarTo
 		case parser.NotSimilarTo:
 			op = parser.SimilarTo
+		case parser.ILike:
+			op = parser.NotILike
+		case parser.NotILike:
+			op = parser.ILike
+		case parser.RegMatch:
+			op = parser.NotRegMatch
+		case parser.NotRegMatch:
+			op = parser.RegMatch
+		case parser.RegIMatch:
+			op = parser.NotRegIMatch
+		case parser.NotRegIMatch:
+			op = parser.RegIMatch
 		default:
 			return parser.DBool(true), false
 		}
@@ -1294,10 +1306,11 @@ func simplifyComparisonExpr(n *parser.ComparisonExpr) (parser.Expr, bool) {
 		case parser.Like:
 			// a LIKE 'foo%' -> a >= "foo" AND a < "fop"
 			if d, ok := n.Right.(parser.DString); ok {
-				if i := strings.IndexAny(string(d), "_%"); i >= 0 {
-					return makePrefixRange(d[:i], n.Left, false), false
+				pattern := parser.LikeEscape(string(d))
+				if re, err := regexp.Compile(pattern); err == nil {
+					prefix, complete := re.LiteralPrefix()
+					return makePrefixRange(parser.DString(prefix), n.Left, complete), false
 				}
-				return makePrefixRange(d, n.Left, true), false
 			}
 			// TODO(pmattis): Support parser.DBytes?
 		case parser.SimilarTo:
@@ -1310,6 +1323,17 @@ func simplifyComparisonExpr(n *parser.ComparisonExpr) (parser.Expr, bool) {
 				}
 			}
 			// TODO(pmattis): Support parser.DBytes?
+		case parser.RegMatch:
+			// a ~ '^foo' -> a >= "foo" AND a < "fop"
+			//
+			// Regular expressions are not anchored, so only a prefix following
+			// a leading ^ constrains the matching values.
+			if d, ok := n.Right.(parser.DString); ok && strings.HasPrefix(string(d), "^") {
+				if re, err := regexp.Compile(string(d)); err == nil {
+					prefix, complete := re.LiteralPrefix()
+					return makePrefixRange(parser.DString(prefix), n.Left, complete), false
+				}
+			}
 		}
 	}
 	return parser.DBool(true), false
@@ -213,6 +213,12 @@ func TestSimplifyExpr(t *testing.T) {
 		{`i LIKE 'foo%'`, `i >= 'foo' AND i < 'fop'`, false},
 		{`i LIKE 'foo_'`, `i >= 'foo' AND i < 'fop'`, false},
 		{`i LIKE 'bar_foo%'`, `i >= 'bar' AND i < 'bas'`, false},
+		{`i LIKE 'foo\_%'`, "i >= 'foo_' AND i < 'foo`'", false},
+		{`i ILIKE 'foo%'`, `true`, false},
+		{`i ~ 'foo'`, `true`, false},
+		{`i ~ '^foo'`, `i >= 'foo' AND i < 'fop'`, false},
+		{`i ~ '^foo$'`, `i = 'foo'`, false},
+		{`i ~* '^foo'`, `true`, false},
 		{`i SIMILAR TO '%'`, `true`, false},
 		{`i SIMILAR TO 'foo'`, `i = 'foo'`, false},
 		{`i SIMILAR TO 'foo%'`, `i >= 'foo' AND i < 'fop'`, false},
@@ -273,6 +279,9 @@ func TestSimplifyNotExpr(t *testing.T) {
 		{`NOT i NOT LIKE 'foo'`, `i = 'foo'`, false, false},
 		{`NOT i SIMILAR TO 'foo'`, `true`, false, false},
 		{`NOT i NOT SIMILAR TO 'foo'`, `i = 'foo'`, false, false},
+		{`NOT i NOT ILIKE 'foo'`, `true`, false, false},
+		{`NOT i ~ '^foo'`, `true`, false, false},
+		{`NOT i !~ '^foo'`, `i >= 'foo' AND i < 'fop'`, false, false},
 		{`NOT (a != 1 AND b != 1)`, `a = 1 OR b = 1`, true, false},
 		{`NOT (a != 1 OR a < 1)`, `a = 1`, true, false},
 	}
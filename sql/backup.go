@@ -0,0 +1,664 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// backupDescriptorName is the name of the file holding the
+	// BackupDescriptor at the location of a backup. It is written last, so
+	// that incomplete backups are never mistaken for complete ones.
+	backupDescriptorName = "BACKUP"
+
+	// backupChunkSize is the maximum number of keys in a data file of a
+	// backup.
+	backupChunkSize = 10000
+
+	// backupBatchSize is the number of keys read or written at a time while
+	// backing up or restoring a table.
+	backupBatchSize = 1000
+)
+
+// Backup writes the data of the target databases or tables as of the time of
+// the statement's transaction to a directory on the local filesystem of the
+// node executing the statement. An incremental backup only writes the
+// changes since the last of the backups it is based on.
+// Privileges: "root" user.
+//   Notes: postgres and mysql back up through external tools.
+func (p *planner) Backup(n *parser.Backup) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to back up", security.RootUser)
+	}
+	dir, err := p.evalBackupLocation(n.To)
+	if err != nil {
+		return nil, err
+	}
+	prevDirs := make([]string, len(n.IncrementalFrom))
+	for i, expr := range n.IncrementalFrom {
+		if prevDirs[i], err = p.evalBackupLocation(expr); err != nil {
+			return nil, err
+		}
+	}
+	descs, err := p.backupTargetDescs(n.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := p.createJob("BACKUP", n.String())
+	if err != nil {
+		return nil, err
+	}
+	desc, keyCount, err := p.backup(jobID, dir, prevDirs, descs)
+	p.finishJob(jobID, err)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{"job_id", "keys", "bytes"}}
+	v.rows = append(v.rows, parser.DTuple{
+		parser.DInt(jobID), parser.DInt(keyCount), parser.DInt(desc.DataSize),
+	})
+	return v, nil
+}
+
+// Restore recreates the target databases or tables from a full backup and
+// any incremental backups on top of it. The restored descriptors are
+// assigned new IDs. Tables are restored into existing databases of the same
+// name as in the backup; databases must not exist yet.
+// Privileges: "root" user.
+//   Notes: postgres and mysql restore through external tools.
+func (p *planner) Restore(n *parser.Restore) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to restore", security.RootUser)
+	}
+	dirs := make([]string, len(n.From))
+	for i, expr := range n.From {
+		var err error
+		if dirs[i], err = p.evalBackupLocation(expr); err != nil {
+			return nil, err
+		}
+	}
+	backups, err := readBackupChain(dirs)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := p.createJob("RESTORE", n.String())
+	if err != nil {
+		return nil, err
+	}
+	err = p.restore(jobID, n.Targets, dirs, backups)
+	p.finishJob(jobID, err)
+	if err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+func (p *planner) evalBackupLocation(expr parser.Expr) (string, error) {
+	d, err := expr.Eval(p.evalCtx)
+	if err != nil {
+		return "", err
+	}
+	s, ok := d.(parser.DString)
+	if !ok || s == "" {
+		return "", fmt.Errorf("backup location must be a non-empty string, not %s", d)
+	}
+	return string(s), nil
+}
+
+// backupTargetDescs returns the descriptors of the databases and tables
+// targeted by a backup. When backing up tables, the descriptors of their
+// databases are included, so that the tables can be matched by name on
+// restore.
+func (p *planner) backupTargetDescs(targets parser.TargetList) ([]Descriptor, error) {
+	var descs []Descriptor
+	seen := map[ID]struct{}{}
+	add := func(desc descriptorProto) {
+		if _, ok := seen[desc.GetID()]; !ok {
+			seen[desc.GetID()] = struct{}{}
+			descs = append(descs, *wrapDescriptor(desc))
+		}
+	}
+
+	if targets.Databases != nil {
+		if len(targets.Databases) == 0 {
+			return nil, errNoDatabase
+		}
+		for _, name := range targets.Databases {
+			dbDesc, err := p.getDatabaseDesc(name)
+			if err != nil {
+				return nil, err
+			}
+			add(dbDesc)
+			tableNames, err := p.getTableNames(dbDesc)
+			if err != nil {
+				return nil, err
+			}
+			for _, tableName := range tableNames {
+				tableDesc, err := p.getTableDesc(tableName)
+				if err != nil {
+					return nil, err
+				}
+				add(tableDesc)
+			}
+		}
+		return descs, nil
+	}
+
+	if len(targets.Tables) == 0 {
+		return nil, errNoTable
+	}
+	for _, tableName := range targets.Tables {
+		tableDesc, err := p.getTableDesc(tableName)
+		if err != nil {
+			return nil, err
+		}
+		dbDesc, err := p.getDatabaseDesc(tableName.Database())
+		if err != nil {
+			return nil, err
+		}
+		add(dbDesc)
+		add(tableDesc)
+	}
+	return descs, nil
+}
+
+// backup writes the data of the tables among the given descriptors to dir
+// and returns the descriptor of the backup and the number of keys written.
+// The data is exported by the ranges holding it as of a timestamp which
+// observes all the writes committed before the backup started, and is
+// streamed to the files of the backup in key order.
+func (p *planner) backup(jobID int64, dir string, prevDirs []string, descs []Descriptor) (
+	*BackupDescriptor, int, error) {
+	if _, err := os.Stat(filepath.Join(dir, backupDescriptorName)); err == nil {
+		return nil, 0, fmt.Errorf("a backup already exists in %s", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, 0, err
+	}
+
+	desc := &BackupDescriptor{Descriptors: descs}
+	if len(prevDirs) > 0 {
+		prevBackups, err := readBackupChain(prevDirs)
+		if err != nil {
+			return nil, 0, err
+		}
+		desc.StartTime = prevBackups[len(prevBackups)-1].EndTime
+	}
+	desc.EndTime = storage.ClusterNow(p.leaseMgr.clock)
+
+	w := backupWriter{dir: dir, desc: desc}
+	tables := backupTables(descs)
+	for i, table := range tables {
+		start := roachpb.Key(keys.MakeTablePrefix(uint32(table.ID)))
+		end := start.PrefixEnd()
+		for {
+			b := &client.Batch{}
+			b.Export(start, end, backupBatchSize, desc.StartTime, client.AsOf(desc.EndTime))
+			br, err := p.db.RunWithResponse(b)
+			if err != nil {
+				return nil, 0, err
+			}
+			resp := br.Responses[0].GetInner().(*roachpb.ExportResponse)
+			// The rows and the deleted keys are each sorted; merge them.
+			rows, deleted := resp.Rows, resp.DeletedKeys
+			for len(rows) > 0 || len(deleted) > 0 {
+				if len(deleted) == 0 || (len(rows) > 0 && rows[0].Key.Compare(deleted[0]) < 0) {
+					kv := rows[0]
+					rows = rows[1:]
+					// Only the contents of values are backed up; the checksums
+					// are computed anew when the values are restored.
+					kv.Value.Checksum = nil
+					kv.Value.Timestamp = nil
+					if err := w.add(kv); err != nil {
+						return nil, 0, err
+					}
+					start = kv.Key.Next()
+				} else {
+					key := deleted[0]
+					deleted = deleted[1:]
+					if err := w.addDeletion(key); err != nil {
+						return nil, 0, err
+					}
+					start = key.Next()
+				}
+			}
+			if resp.Count() < backupBatchSize {
+				break
+			}
+		}
+		p.updateJob(jobID, float64(i+1)/float64(len(tables)+1))
+	}
+	if err := w.flush(); err != nil {
+		return nil, 0, err
+	}
+	if err := writeBackupDescriptor(dir, desc); err != nil {
+		return nil, 0, err
+	}
+	return desc, w.count, nil
+}
+
+// backupTables returns the tables among the given descriptors, ordered by
+// ID, which is the order of their data.
+func backupTables(descs []Descriptor) []*TableDescriptor {
+	var tables []*TableDescriptor
+	for _, d := range descs {
+		if table := d.GetTable(); table != nil {
+			tables = append(tables, table)
+		}
+	}
+	sort.Sort(tablesByID(tables))
+	return tables
+}
+
+type tablesByID []*TableDescriptor
+
+func (t tablesByID) Len() int           { return len(t) }
+func (t tablesByID) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tablesByID) Less(i, j int) bool { return t[i].ID < t[j].ID }
+
+// restore recreates the target databases or tables from the given backups,
+// of which the last one holds the descriptors to restore. The databases are
+// created right away; the tables are created in the ADD state and only made
+// public once their data has been ingested, which is streamed from the
+// backups. If the restore fails, the databases and tables it created are
+// removed again.
+func (p *planner) restore(jobID int64, targets parser.TargetList, dirs []string,
+	backups []BackupDescriptor) error {
+	descs := backups[len(backups)-1].Descriptors
+	databases := map[ID]*DatabaseDescriptor{}
+	for _, d := range descs {
+		if db := d.GetDatabase(); db != nil {
+			databases[db.ID] = db
+		}
+	}
+
+	var newDBs []*DatabaseDescriptor
+	var newTables []*TableDescriptor
+	err := func() error {
+		// Map the IDs of the tables to restore to the IDs of the databases
+		// to restore them into.
+		parentIDs := map[ID]ID{}
+		if targets.Databases != nil {
+			if len(targets.Databases) == 0 {
+				return errNoDatabase
+			}
+			for _, name := range targets.Databases {
+				var oldDB *DatabaseDescriptor
+				for _, db := range databases {
+					if db.Name == name {
+						oldDB = db
+					}
+				}
+				if oldDB == nil {
+					return fmt.Errorf("database %q not found in backup", name)
+				}
+				newDB := proto.Clone(oldDB).(*DatabaseDescriptor)
+				if err := p.commitDescriptor(databaseKey{name}, newDB); err != nil {
+					return err
+				}
+				newDBs = append(newDBs, newDB)
+				for _, d := range descs {
+					if table := d.GetTable(); table != nil && table.ParentID == oldDB.ID {
+						parentIDs[table.ID] = newDB.ID
+					}
+				}
+			}
+		} else {
+			if len(targets.Tables) == 0 {
+				return errNoTable
+			}
+			for _, tableName := range targets.Tables {
+				if err := tableName.NormalizeTableName(p.session.Database); err != nil {
+					return err
+				}
+				var oldTable *TableDescriptor
+				for _, d := range descs {
+					table := d.GetTable()
+					if table == nil || table.Name != tableName.Table() {
+						continue
+					}
+					if db, ok := databases[table.ParentID]; ok && db.Name == tableName.Database() {
+						oldTable = table
+					}
+				}
+				if oldTable == nil {
+					return fmt.Errorf("table %q not found in backup", tableName)
+				}
+				dbDesc, err := p.getDatabaseDesc(tableName.Database())
+				if err != nil {
+					return err
+				}
+				parentIDs[oldTable.ID] = dbDesc.ID
+			}
+		}
+
+		var oldTables []*TableDescriptor
+		for _, oldTable := range backupTables(descs) {
+			parentID, ok := parentIDs[oldTable.ID]
+			if !ok {
+				continue
+			}
+			newTable := proto.Clone(oldTable).(*TableDescriptor)
+			newTable.ParentID = parentID
+			newTable.State = TableDescriptor_ADD.Enum()
+			if err := p.commitDescriptor(tableKey{parentID, newTable.Name}, newTable); err != nil {
+				return err
+			}
+			oldTables = append(oldTables, oldTable)
+			newTables = append(newTables, newTable)
+		}
+
+		if err := p.restoreData(jobID, dirs, backups, oldTables, newTables); err != nil {
+			return err
+		}
+		for _, newTable := range newTables {
+			if err := p.publishTable(newTable); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		for _, newTable := range newTables {
+			if newTable.State != nil {
+				p.dropUnpublishedTable(newTable)
+			}
+		}
+		for _, newDB := range newDBs {
+			p.dropRestoredDatabase(newDB)
+		}
+	}
+	return err
+}
+
+// restoreData streams the data of the given tables, which are ordered by
+// ID, from the chain of backups and ingests it into the new tables which
+// they are restored as, rewriting the keys to the prefixes of the new IDs.
+func (p *planner) restoreData(jobID int64, dirs []string, backups []BackupDescriptor,
+	oldTables, newTables []*TableDescriptor) error {
+	it := newBackupIterator(dirs, backups)
+	key, value, err := it.next()
+	for i, oldTable := range oldTables {
+		newTable := newTables[i]
+		oldPrefix := roachpb.Key(keys.MakeTablePrefix(uint32(oldTable.ID)))
+		newPrefix := keys.MakeTablePrefix(uint32(newTable.ID))
+		var kvs kvCollector
+		for ; err == nil && key != nil; key, value, err = it.next() {
+			if key.Compare(oldPrefix) < 0 {
+				// Data of a table which is not restored.
+				continue
+			}
+			if !bytes.HasPrefix(key, oldPrefix) {
+				break
+			}
+			newKey := make(roachpb.Key, 0, len(newPrefix)+len(key)-len(oldPrefix))
+			newKey = append(append(newKey, newPrefix...), key[len(oldPrefix):]...)
+			value.InitChecksum(newKey)
+			kvs = append(kvs, roachpb.KeyValue{Key: newKey, Value: *value})
+			if len(kvs) == backupBatchSize {
+				if err := ingestKVs(p.db, newTable, kvs); err != nil {
+					return err
+				}
+				kvs = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if len(kvs) > 0 {
+			if err := ingestKVs(p.db, newTable, kvs); err != nil {
+				return err
+			}
+		}
+		p.updateJob(jobID, float64(i+1)/float64(len(oldTables)+1))
+	}
+	return err
+}
+
+// commitDescriptor creates the given descriptor in a transaction of its own
+// and commits it right away.
+func (p *planner) commitDescriptor(plainKey descriptorKey, desc descriptorProto) error {
+	return p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		tp := planner{txn: txn, session: p.session, user: p.user, evalCtx: p.evalCtx}
+		return tp.createDescriptor(plainKey, desc, false)
+	})
+}
+
+// dropRestoredDatabase removes a database created by a failed restore.
+func (p *planner) dropRestoredDatabase(dbDesc *DatabaseDescriptor) {
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		b := &client.Batch{}
+		b.Del(MakeDescMetadataKey(dbDesc.ID))
+		b.Del(databaseKey{dbDesc.Name}.Key())
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		log.Warningf("unable to remove database of failed restore %s: %s", dbDesc.Name, err)
+	}
+}
+
+// backupWriter writes the data of a backup to files of at most
+// backupChunkSize keys.
+type backupWriter struct {
+	dir   string
+	desc  *BackupDescriptor
+	chunk BackupChunk
+	count int
+}
+
+func (w *backupWriter) add(kv roachpb.KeyValue) error {
+	w.chunk.KVs = append(w.chunk.KVs, kv)
+	w.desc.DataSize += int64(len(kv.Key) + len(kv.Value.RawBytes))
+	return w.added()
+}
+
+func (w *backupWriter) addDeletion(key roachpb.Key) error {
+	w.chunk.DeletedKeys = append(w.chunk.DeletedKeys, key)
+	w.desc.DataSize += int64(len(key))
+	return w.added()
+}
+
+func (w *backupWriter) added() error {
+	w.count++
+	if len(w.chunk.KVs)+len(w.chunk.DeletedKeys) >= backupChunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush writes the pending data, if any, to a new file.
+func (w *backupWriter) flush() error {
+	if len(w.chunk.KVs) == 0 && len(w.chunk.DeletedKeys) == 0 {
+		return nil
+	}
+	name := fmt.Sprintf("data-%d", len(w.desc.Files))
+	if err := writeProtoFile(filepath.Join(w.dir, name), &w.chunk); err != nil {
+		return err
+	}
+	w.desc.Files = append(w.desc.Files, name)
+	w.chunk = BackupChunk{}
+	return nil
+}
+
+func writeBackupDescriptor(dir string, desc *BackupDescriptor) error {
+	// Write to a temporary file first, so that the descriptor only appears
+	// once it is complete.
+	tmp := filepath.Join(dir, backupDescriptorName+".tmp")
+	if err := writeProtoFile(tmp, desc); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, backupDescriptorName))
+}
+
+func writeProtoFile(path string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func readProtoFile(path string, msg proto.Message) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+// readBackupChain reads the descriptors of the backups in the given
+// directories and verifies that they form a chain: a full backup followed
+// by incremental backups, each starting where the previous one ended.
+func readBackupChain(dirs []string) ([]BackupDescriptor, error) {
+	if len(dirs) == 0 {
+		return nil, util.Errorf("no backup specified")
+	}
+	backups := make([]BackupDescriptor, len(dirs))
+	for i, dir := range dirs {
+		if err := readProtoFile(filepath.Join(dir, backupDescriptorName), &backups[i]); err != nil {
+			return nil, fmt.Errorf("unable to read backup in %s: %s", dir, err)
+		}
+	}
+	return backups, verifyBackupChain(dirs, backups)
+}
+
+func verifyBackupChain(dirs []string, backups []BackupDescriptor) error {
+	if !backups[0].StartTime.Equal(roachpb.ZeroTimestamp) {
+		return fmt.Errorf("backup in %s is not a full backup", dirs[0])
+	}
+	for i := 1; i < len(backups); i++ {
+		if !backups[i].StartTime.Equal(backups[i-1].EndTime) {
+			return fmt.Errorf("backup in %s does not start at the end of the backup in %s",
+				dirs[i], dirs[i-1])
+		}
+	}
+	return nil
+}
+
+// backupReader reads the data of a backup in key order, one file at a
+// time.
+type backupReader struct {
+	dir   string
+	files []string
+	chunk BackupChunk
+	// The positions of the next KV and deleted key of the chunk.
+	kvIdx, delIdx int
+}
+
+// fill reads the next file while the current chunk is exhausted and returns
+// whether there is data left.
+func (r *backupReader) fill() (bool, error) {
+	for r.kvIdx == len(r.chunk.KVs) && r.delIdx == len(r.chunk.DeletedKeys) {
+		if len(r.files) == 0 {
+			return false, nil
+		}
+		r.chunk = BackupChunk{}
+		r.kvIdx, r.delIdx = 0, 0
+		if err := readProtoFile(filepath.Join(r.dir, r.files[0]), &r.chunk); err != nil {
+			return false, err
+		}
+		r.files = r.files[1:]
+	}
+	return true, nil
+}
+
+// peek returns the next key of the backup and its value, which is nil if the
+// key was deleted. It must only be called after fill returned true.
+func (r *backupReader) peek() (roachpb.Key, *roachpb.Value) {
+	if r.delIdx == len(r.chunk.DeletedKeys) ||
+		(r.kvIdx < len(r.chunk.KVs) && r.chunk.KVs[r.kvIdx].Key.Compare(roachpb.Key(r.chunk.DeletedKeys[r.delIdx])) < 0) {
+		kv := &r.chunk.KVs[r.kvIdx]
+		return kv.Key, &kv.Value
+	}
+	return r.chunk.DeletedKeys[r.delIdx], nil
+}
+
+// advance skips the key returned by peek.
+func (r *backupReader) advance() {
+	if _, value := r.peek(); value != nil {
+		r.kvIdx++
+	} else {
+		r.delIdx++
+	}
+}
+
+// backupIterator merges the data of a chain of backups in key order, where
+// the value of a key in a backup supersedes those in the backups it is based
+// on.
+type backupIterator struct {
+	readers []*backupReader
+}
+
+func newBackupIterator(dirs []string, backups []BackupDescriptor) *backupIterator {
+	it := &backupIterator{}
+	for i, backup := range backups {
+		it.readers = append(it.readers, &backupReader{dir: dirs[i], files: backup.Files})
+	}
+	return it
+}
+
+// next returns the next key present as of the end of the chain and its
+// value, or a nil key once the data is exhausted.
+func (it *backupIterator) next() (roachpb.Key, *roachpb.Value, error) {
+	for {
+		var key roachpb.Key
+		var value *roachpb.Value
+		var current []*backupReader
+		for _, r := range it.readers {
+			if ok, err := r.fill(); err != nil {
+				return nil, nil, err
+			} else if !ok {
+				continue
+			}
+			k, v := r.peek()
+			if c := k.Compare(key); key == nil || c < 0 {
+				key, value, current = k, v, []*backupReader{r}
+			} else if c == 0 {
+				// Later backups supersede earlier ones.
+				value = v
+				current = append(current, r)
+			}
+		}
+		if key == nil {
+			return nil, nil, nil
+		}
+		for _, r := range current {
+			r.advance()
+		}
+		if value != nil {
+			return key, value, nil
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/config"
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestBackupRestore verifies that a database is restored from a full backup
+// and an incremental backup on top of it.
+func TestBackupRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer config.TestingDisableTableSplits()()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	dir := util.CreateTempDir(t, "backup")
+	defer util.CleanupDir(dir)
+	full, inc := filepath.Join(dir, "full"), filepath.Join(dir, "inc")
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT);
+CREATE INDEX foo ON t.kv (v);
+INSERT INTO t.kv VALUES ('a', 1), ('b', 2), ('c', 3);
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`BACKUP DATABASE t TO $1`, full); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`BACKUP DATABASE t TO $1`, full); !testutils.IsError(err, "a backup already exists") {
+		t.Fatalf("expected existing backup error; got %v", err)
+	}
+
+	if _, err := sqlDB.Exec(`
+UPDATE t.kv SET v = 4 WHERE k = 'a';
+DELETE FROM t.kv WHERE k = 'b';
+INSERT INTO t.kv VALUES ('d', 5);
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`BACKUP DATABASE t TO $1 INCREMENTAL FROM $2`, inc, full); err != nil {
+		t.Fatal(err)
+	}
+
+	// A chain of backups has to start with a full backup.
+	if _, err := sqlDB.Exec(`RESTORE DATABASE t FROM $1`, inc); !testutils.IsError(err, "is not a full backup") {
+		t.Fatalf("expected chain error; got %v", err)
+	}
+	if _, err := sqlDB.Exec(`RESTORE DATABASE t FROM $1, $2`, full, inc); !testutils.IsError(err, "already exists") {
+		t.Fatalf("expected existing database error; got %v", err)
+	}
+
+	if _, err := sqlDB.Exec(`DROP DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`RESTORE DATABASE t FROM $1, $2`, full, inc); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]interface{}{{"a", 4}, {"c", 3}, {"d", 5}}
+	for _, query := range []string{
+		`SELECT k, v FROM t.kv`,
+		`SELECT k, v FROM t.kv@foo ORDER BY k`,
+	} {
+		if rows := queryKV(t, sqlDB, query); !reflect.DeepEqual(rows, expected) {
+			t.Errorf("%s: expected %v; got %v", query, expected, rows)
+		}
+	}
+
+	// A table is restored into an existing database from the full backup
+	// alone.
+	if _, err := sqlDB.Exec(`DROP TABLE t.kv`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`RESTORE t.kv FROM $1`, full); err != nil {
+		t.Fatal(err)
+	}
+	expected = [][]interface{}{{"a", 1}, {"b", 2}, {"c", 3}}
+	if rows := queryKV(t, sqlDB, `SELECT k, v FROM t.kv`); !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %v; got %v", expected, rows)
+	}
+
+	// Every backup and restore is recorded as a job.
+	var count int
+	if err := sqlDB.QueryRow(
+		`SELECT COUNT(*) FROM system.jobs WHERE status = 'succeeded' AND fraction = 1.0`,
+	).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Errorf("expected 4 succeeded jobs; got %d", count)
+	}
+}
+
+// TestBackupRestoreMultiNode verifies that the data of a table split across
+// ranges is backed up through one node of a cluster and restored through
+// another.
+func TestBackupRestoreMultiNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// Two more nodes join the cluster through the first one.
+	dbs, stopNodes := addTestNodes(t, s, 2)
+	defer stopNodes()
+	sqlDBs := append([]*sql.DB{sqlDB}, dbs...)
+
+	dir := util.CreateTempDir(t, "backup")
+	defer util.CleanupDir(dir)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT);
+CREATE INDEX foo ON t.kv (v);
+`); err != nil {
+		t.Fatal(err)
+	}
+	var expected [][]interface{}
+	for i := 0; i < 100; i++ {
+		k := fmt.Sprintf("k%03d", i)
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, k, i); err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, []interface{}{k, i})
+	}
+
+	// Split the table's primary and secondary index into ranges of their own.
+	var tableID int
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 'kv'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+	for _, indexID := range []csql.IndexID{1, 2} {
+		if err := kvDB.AdminSplit(csql.MakeIndexKeyPrefix(csql.ID(tableID), indexID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := sqlDBs[1].Exec(`BACKUP DATABASE t TO $1`, dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`DROP DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDBs[2].Exec(`RESTORE DATABASE t FROM $1`, dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{
+		`SELECT k, v FROM t.kv`,
+		`SELECT k, v FROM t.kv@foo ORDER BY k`,
+	} {
+		if rows := queryKV(t, sqlDB, query); !reflect.DeepEqual(rows, expected) {
+			t.Errorf("%s: expected %v; got %v", query, expected, rows)
+		}
+	}
+}
+
+// A queryer is a *sql.DB or a *sql.Tx.
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func queryKV(t *testing.T, sqlDB queryer, query string) [][]interface{} {
+	rows, err := sqlDB.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var result [][]interface{}
+	for rows.Next() {
+		var k string
+		var v int
+		if err := rows.Scan(&k, &v); err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, []interface{}{k, v})
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// CommentOnTable sets or removes the comment of a table.
+// Privileges: CREATE on table.
+//   Notes: postgres requires ownership of the table.
+//          mysql requires ALTER on the table.
+func (p *planner) CommentOnTable(n *parser.CommentOnTable) (planNode, error) {
+	tableDesc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(tableDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+	tableDesc.Comment = commentText(n.Comment)
+	if err := p.writeTableDescComment(tableDesc); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// CommentOnColumn sets or removes the comment of a column.
+// Privileges: CREATE on table.
+//   Notes: postgres requires ownership of the table.
+//          mysql requires ALTER on the table.
+func (p *planner) CommentOnColumn(n *parser.CommentOnColumn) (planNode, error) {
+	// The last component of the name is the column, the preceding ones
+	// name the table.
+	name := n.Column
+	if len(name.Indirect) == 0 {
+		return nil, fmt.Errorf("column name must be qualified by its table: %s", name)
+	}
+	colName, ok := name.Indirect[len(name.Indirect)-1].(parser.NameIndirection)
+	if !ok {
+		return nil, fmt.Errorf("invalid column name: %s", name)
+	}
+	tableName := &parser.QualifiedName{Base: name.Base, Indirect: name.Indirect[:len(name.Indirect)-1]}
+
+	tableDesc, err := p.getTableDesc(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(tableDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+	i, err := tableDesc.FindColumnByName(string(colName))
+	if err != nil {
+		return nil, err
+	}
+	tableDesc.Columns[i].Comment = commentText(n.Comment)
+	if err := p.writeTableDescComment(tableDesc); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// writeTableDescComment writes the table descriptor after a change of a
+// comment.
+func (p *planner) writeTableDescComment(tableDesc *TableDescriptor) error {
+	// Bump the descriptor version in place, as the other statements which
+	// modify a table descriptor directly do.
+	p.hackNoteSchemaChange(tableDesc)
+
+	if err := tableDesc.Validate(); err != nil {
+		return err
+	}
+	return p.txn.Put(MakeDescMetadataKey(tableDesc.GetID()), wrapDescriptor(tableDesc))
+}
+
+// commentText returns the comment to store for the comment of a COMMENT ON
+// statement. NULL, like the empty string, removes the comment.
+func commentText(comment *string) string {
+	if comment == nil {
+		return ""
+	}
+	return *comment
+}
+
+// commentDatum returns the datum of a comment in the output of SHOW
+// statements, which is NULL if there is no comment.
+func commentDatum(comment string) parser.Datum {
+	if comment == "" {
+		return parser.DNull
+	}
+	return parser.DString(comment)
+}
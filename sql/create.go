@@ -45,6 +45,12 @@ func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, error) {
 	if err := p.createDescriptor(databaseKey{string(n.Name)}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
+	if desc.ID != 0 {
+		if err := p.logEvent(EventLogCreateDatabase, desc.ID,
+			schemaChangeEventInfo{Name: string(n.Name), Statement: n.String()}); err != nil {
+			return nil, err
+		}
+	}
 	return &valuesNode{}, nil
 }
 
@@ -57,6 +63,9 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if _, err := tableDesc.FindIndexByName(string(n.Name)); err == nil {
 		if n.IfNotExists {
@@ -73,9 +82,9 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	indexDesc := IndexDescriptor{
 		Name:             string(n.Name),
 		Unique:           n.Unique,
-		ColumnNames:      n.Columns,
 		StoreColumnNames: n.Storing,
 	}
+	fillIndexColumns(&indexDesc, n.Columns)
 
 	newTableDesc := proto.Clone(tableDesc).(*TableDescriptor)
 
@@ -86,6 +95,9 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	if err := newTableDesc.AllocateIDs(); err != nil {
 		return nil, err
 	}
+	if err := newTableDesc.ValidateUpdate(tableDesc); err != nil {
+		return nil, err
+	}
 
 	b := client.Batch{}
 	if err := p.backfillBatch(&b, n.Table, tableDesc, newTableDesc); err != nil {
@@ -102,13 +114,43 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 		return nil, convertBatchError(newTableDesc, b, err)
 	}
 
+	if err := p.logEvent(EventLogCreateIndex, newTableDesc.ID,
+		schemaChangeEventInfo{Name: n.Table.String() + "@" + string(n.Name), Statement: n.String()}); err != nil {
+		return nil, err
+	}
 	return &valuesNode{}, nil
 }
 
+// fillIndexColumns sets the column names and directions of an index. The
+// directions are only recorded if one of the columns is descending so that
+// the descriptors of ascending indexes are unchanged.
+func fillIndexColumns(desc *IndexDescriptor, columns parser.IndexElemList) {
+	desc.ColumnNames = make([]string, 0, len(columns))
+	desc.ColumnDirections = nil
+	for i, c := range columns {
+		desc.ColumnNames = append(desc.ColumnNames, string(c.Column))
+		if c.Direction == parser.Descending {
+			for len(desc.ColumnDirections) < i {
+				desc.ColumnDirections = append(desc.ColumnDirections, IndexDescriptor_ASC)
+			}
+			desc.ColumnDirections = append(desc.ColumnDirections, IndexDescriptor_DESC)
+		}
+	}
+}
+
 // CreateTable creates a table.
 // Privileges: CREATE on database.
 //   Notes: postgres/mysql require CREATE on database.
 func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
+	if _, err := p.createTable(n, TableDescriptor_PUBLIC); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// createTable creates a table in the given state and returns its descriptor,
+// whose ID is zero if the table already existed and n.IfNotExists is set.
+func (p *planner) createTable(n *parser.CreateTable, state TableDescriptor_State) (*TableDescriptor, error) {
 	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
 		return nil, err
 	}
@@ -128,6 +170,9 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 	}
 	// Inherit permissions from the database descriptor.
 	desc.Privileges = dbDesc.GetPrivileges()
+	if state != TableDescriptor_PUBLIC {
+		desc.State = state.Enum()
+	}
 
 	if err := desc.AllocateIDs(); err != nil {
 		return nil, err
@@ -136,5 +181,11 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 	if err := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
-	return &valuesNode{}, nil
+	if desc.ID != 0 {
+		if err := p.logEvent(EventLogCreateTable, desc.ID,
+			schemaChangeEventInfo{Name: n.Table.String(), Statement: n.String()}); err != nil {
+			return nil, err
+		}
+	}
+	return &desc, nil
 }
@@ -34,6 +34,9 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if err := p.checkPrivilege(tableDesc, privilege.DELETE); err != nil {
 		return nil, err
@@ -42,9 +45,11 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	// TODO(tamird,pmattis): avoid going through Select to avoid encoding
 	// and decoding keys.
 	rows, err := p.Select(&parser.Select{
-		Exprs: parser.SelectExprs{parser.StarSelectExpr()},
-		From:  parser.TableExprs{n.Table},
-		Where: n.Where,
+		Exprs:   parser.SelectExprs{parser.StarSelectExpr()},
+		From:    parser.TableExprs{n.Table},
+		Where:   n.Where,
+		OrderBy: n.OrderBy,
+		Limit:   n.Limit,
 	})
 	if err != nil {
 		return nil, err
@@ -57,49 +62,30 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		return nil, err
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
-
 	b := client.Batch{}
 	result := &valuesNode{}
+	// Writes to the zones table are recorded in the event log.
+	var zoneIDs []ID
 	for rows.Next() {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
-		if err != nil {
-			return nil, err
-		}
-
-		// Delete the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
-		if err != nil {
+		if err := deleteRow(&b, tableDesc, colIDtoRowIndex, rowVals); err != nil {
 			return nil, err
 		}
 
-		for _, secondaryIndexEntry := range secondaryIndexEntries {
-			if log.V(2) {
-				log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
+		if tableDesc.ID == ZonesTable.ID {
+			if id, ok := zoneConfigTarget(colIDtoRowIndex, rowVals); ok {
+				zoneIDs = append(zoneIDs, id)
 			}
-			b.Del(secondaryIndexEntry.key)
-		}
-
-		// Delete the row.
-		rowStartKey := roachpb.Key(primaryIndexKey)
-		rowEndKey := rowStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %s - %s", prettyKey(rowStartKey, 0), prettyKey(rowEndKey, 0))
 		}
-		b.DelRange(rowStartKey, rowEndKey)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	if IsSystemID(tableDesc.GetID()) {
+	if IsSystemConfigID(tableDesc.GetID()) {
 		// Mark transaction as operating on the system DB.
 		p.txn.SetSystemDBTrigger()
 	}
@@ -107,5 +93,44 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		return nil, err
 	}
 
+	if err := p.logZoneConfigEvents(EventLogRemoveZoneConfig, zoneIDs); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
+
+// deleteRow adds the deletions of the keys of the row, in the primary index
+// and in the secondary indexes, to the batch.
+func deleteRow(b *client.Batch, tableDesc *TableDescriptor,
+	colIDtoRowIndex map[ColumnID]int, rowVals parser.DTuple) error {
+	primaryIndex := tableDesc.PrimaryIndex
+	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	primaryIndexKey, _, err := encodeIndexKey(
+		primaryIndex.ColumnIDs, primaryIndex.ColumnDirections, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+	if err != nil {
+		return err
+	}
+
+	// Delete the secondary indexes.
+	secondaryIndexEntries, err := encodeSecondaryIndexes(
+		tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
+
+	for _, secondaryIndexEntry := range secondaryIndexEntries {
+		if log.V(2) {
+			log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
+		}
+		b.Del(secondaryIndexEntry.key)
+	}
+
+	// Delete the row.
+	rowStartKey := roachpb.Key(primaryIndexKey)
+	rowEndKey := rowStartKey.PrefixEnd()
+	if log.V(2) {
+		log.Infof("DelRange %s - %s", prettyKey(rowStartKey, 0), prettyKey(rowEndKey, 0))
+	}
+	b.DelRange(rowStartKey, rowEndKey)
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
@@ -34,6 +35,7 @@ var (
 	errEmptyDatabaseName = errors.New("empty database name")
 	errNoDatabase        = errors.New("no database specified")
 	errNoTable           = errors.New("no table specified")
+	errEmptyRoleName     = errors.New("empty role name")
 )
 
 var _ descriptorProto = &DatabaseDescriptor{}
@@ -61,15 +63,49 @@ type descriptorProto interface {
 	Validate() error
 }
 
-// checkPrivilege verifies that p.user has `privilege` on `descriptor`.
+// checkPrivilege verifies that p.user, or one of the roles it is a member
+// of, has `privilege` on `descriptor`.
 func (p *planner) checkPrivilege(descriptor descriptorProto, privilege privilege.Kind) error {
-	if descriptor.GetPrivileges().CheckPrivilege(p.user, privilege) {
+	privileges := descriptor.GetPrivileges()
+	if privileges.CheckPrivilege(p.user, privilege) {
 		return nil
 	}
+	roles, err := p.getRoles()
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if privileges.CheckPrivilege(role, privilege) {
+			return nil
+		}
+	}
 	return fmt.Errorf("user %s does not have %s privilege on %s %s",
 		p.user, privilege, descriptor.TypeName(), descriptor.GetName())
 }
 
+// anyPrivilege returns whether p.user, or one of the roles it is a member of,
+// has any privilege on `descriptor`. It is used to only show the objects a
+// user has access to. The root user can see every object.
+func (p *planner) anyPrivilege(descriptor descriptorProto) (bool, error) {
+	if p.user == security.RootUser {
+		return true, nil
+	}
+	privileges := descriptor.GetPrivileges()
+	if privileges.AnyPrivilege(p.user) {
+		return true, nil
+	}
+	roles, err := p.getRoles()
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if privileges.AnyPrivilege(role) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // createDescriptor takes a Table or Database descriptor and creates it
 // if needed, incrementing the descriptor counter.
 func (p *planner) createDescriptor(plainKey descriptorKey, descriptor descriptorProto, ifNotExists bool) error {
@@ -117,6 +153,15 @@ func (p *planner) createDescriptor(plainKey descriptorKey, descriptor descriptor
 // getDescriptor looks up the descriptor for `key`, validates it,
 // and unmarshals it into `descriptor`.
 func (p *planner) getDescriptor(plainKey descriptorKey, descriptor descriptorProto) error {
+	if err := p.readDescriptor(plainKey, descriptor); err != nil {
+		return err
+	}
+	return descriptor.Validate()
+}
+
+// readDescriptor looks up the descriptor for `key` and unmarshals it into
+// `descriptor` without validating it.
+func (p *planner) readDescriptor(plainKey descriptorKey, descriptor descriptorProto) error {
 	gr, err := p.txn.Get(plainKey.Key())
 	if err != nil {
 		return err
@@ -145,8 +190,7 @@ func (p *planner) getDescriptor(plainKey descriptorKey, descriptor descriptorPro
 		}
 		*t = *database
 	}
-
-	return descriptor.Validate()
+	return nil
 }
 
 // getDescriptorFromTargetList examines a TargetList and fetches the
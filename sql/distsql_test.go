@@ -0,0 +1,130 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestDistSQL verifies that scans and aggregations executed in distributed
+// mode through a node which holds none of the data return all rows, in
+// order, including when the rows span several batches of a remote flow.
+func TestDistSQL(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// The test servers don't replicate ranges, so all data stays on the first
+	// node and the second node has to fetch it remotely.
+	dbs, stopNodes := addTestNodes(t, s, 1)
+	defer stopNodes()
+	gateway := dbs[0]
+	// The DistSQL setting is part of the session, which is kept per
+	// connection.
+	gateway.SetMaxOpenConns(1)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT);
+CREATE INDEX foo ON t.kv (v);
+`); err != nil {
+		t.Fatal(err)
+	}
+	const count = 2500
+	var buf bytes.Buffer
+	buf.WriteString(`INSERT INTO t.kv VALUES `)
+	var expected [][]interface{}
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		k := fmt.Sprintf("k%04d", i)
+		fmt.Fprintf(&buf, "('%s', %d)", k, count-i)
+		expected = append(expected, []interface{}{k, count - i})
+	}
+	if _, err := sqlDB.Exec(buf.String()); err != nil {
+		t.Fatal(err)
+	}
+	var reversed [][]interface{}
+	for i := len(expected) - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+
+	var tableID int
+	if err := sqlDB.QueryRow(`SELECT id FROM system.namespace WHERE name = 'kv'`).Scan(&tableID); err != nil {
+		t.Fatal(err)
+	}
+	for _, indexID := range []csql.IndexID{1, 2} {
+		if err := kvDB.AdminSplit(csql.MakeIndexKeyPrefix(csql.ID(tableID), indexID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := gateway.Exec(`SET DISTSQL = on`); err != nil {
+		t.Fatal(err)
+	}
+	// The first read of a transaction, which determines its timestamp, is
+	// always executed on the gateway. Read from another table first so that
+	// the queries below are executed remotely.
+	tx, err := gateway.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM system.namespace`).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+
+	var level int
+	var typ, desc string
+	if err := tx.QueryRow(`EXPLAIN SELECT k, v FROM t.kv`).Scan(&level, &typ, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if typ != "flow" {
+		t.Fatalf("expected a distributed plan; got %s %s", typ, desc)
+	}
+
+	testData := []struct {
+		query    string
+		expected [][]interface{}
+	}{
+		{`SELECT k, v FROM t.kv`, expected},
+		{`SELECT k, v FROM t.kv WHERE v > 1000`, expected[:count-1000]},
+		{`SELECT k, v FROM t.kv@foo`, reversed},
+	}
+	for _, d := range testData {
+		if rows := queryKV(t, tx, d.query); !reflect.DeepEqual(rows, d.expected) {
+			t.Errorf("%s: expected %d rows in order, got %d rows", d.query, len(d.expected), len(rows))
+		}
+	}
+
+	var sum int
+	if err := tx.QueryRow(`SELECT COUNT(k), SUM(v) FROM t.kv`).Scan(&n, &sum); err != nil {
+		t.Fatal(err)
+	}
+	if n != count || sum != count*(count+1)/2 {
+		t.Errorf("expected COUNT %d and SUM %d; got %d and %d", count, count*(count+1)/2, n, sum)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
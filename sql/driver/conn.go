@@ -52,30 +52,48 @@ func (c *conn) Begin() (driver.Tx, error) {
 	return &tx{conn: c}, nil
 }
 
+// Exec executes the statement(s). The rows affected by a batch of several
+// statements are those affected by all of them; a batch made up of DDL
+// statements only affects no rows.
 func (c *conn) Exec(stmt string, args []driver.Value) (driver.Result, error) {
-	result, err := c.internalQuery(stmt, args)
+	results, err := c.internalQuery(stmt, args)
 	if err != nil {
 		return nil, err
 	}
-	switch t := result.GetUnion().(type) {
-	case nil:
-		return nil, nil
-	case *Response_Result_DDL_:
-		return driver.ResultNoRows, nil
-	case *Response_Result_RowsAffected:
-		return driver.RowsAffected(int(t.RowsAffected)), nil
-	case *Response_Result_Rows_:
-		return driver.RowsAffected(len(t.Rows.Rows)), nil
-	default:
-		return nil, util.Errorf("unexpected result %s of type %T", t, t)
+	var res driver.Result
+	var rowsAffected int
+	for _, result := range results {
+		switch t := result.GetUnion().(type) {
+		case nil:
+			continue
+		case *Response_Result_DDL_:
+			if res == nil {
+				res = driver.ResultNoRows
+			}
+			continue
+		case *Response_Result_RowsAffected:
+			rowsAffected += int(t.RowsAffected)
+		case *Response_Result_Rows_:
+			rowsAffected += len(t.Rows.Rows)
+		default:
+			return nil, util.Errorf("unexpected result %s of type %T", t, t)
+		}
+		res = driver.RowsAffected(rowsAffected)
 	}
+	return res, nil
 }
 
+// Query executes the statement(s), returning the rows of the last one.
 func (c *conn) Query(stmt string, args []driver.Value) (driver.Rows, error) {
-	result, err := c.internalQuery(stmt, args)
+	results, err := c.internalQuery(stmt, args)
 	if err != nil {
 		return nil, err
 	}
+	// Only use the last result.
+	var result *Response_Result
+	if index := len(results); index != 0 {
+		result = &results[index-1]
+	}
 
 	driverRows := &rows{}
 
@@ -102,7 +120,7 @@ func (c *conn) Query(stmt string, args []driver.Value) (driver.Rows, error) {
 	return driverRows, nil
 }
 
-func (c *conn) internalQuery(stmt string, args []driver.Value) (*Response_Result, error) {
+func (c *conn) internalQuery(stmt string, args []driver.Value) ([]Response_Result, error) {
 	if c.beginTransaction {
 		stmt = "BEGIN TRANSACTION; " + stmt
 		c.beginTransaction = false
@@ -119,8 +137,9 @@ func (c *conn) internalQuery(stmt string, args []driver.Value) (*Response_Result
 	return c.send(stmt, dArgs)
 }
 
-// send sends the statement to the server.
-func (c *conn) send(stmt string, dArgs []Datum) (*Response_Result, error) {
+// send sends the statement(s) to the server, which executes them in order in
+// a single round trip and returns the result of each.
+func (c *conn) send(stmt string, dArgs []Datum) ([]Response_Result, error) {
 	args := Request{
 		Session: c.session,
 		Sql:     stmt,
@@ -147,12 +166,7 @@ func (c *conn) send(stmt string, dArgs []Datum) (*Response_Result, error) {
 			return nil, errors.New(*result.Error)
 		}
 	}
-
-	// Only use the last result.
-	if index := len(resp.Results); index != 0 {
-		return &resp.Results[index-1], nil
-	}
-	return nil, nil
+	return resp.Results, nil
 }
 
 // Execute all the URL settings against the db to create
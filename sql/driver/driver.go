@@ -20,6 +20,7 @@ package driver
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/base64"
 	"net/url"
 
 	"github.com/cockroachdb/cockroach/base"
@@ -63,8 +64,30 @@ func (roachDriver) Open(dsn string) (driver.Conn, error) {
 		return nil, err
 	}
 	conn := conn{sender: sender}
+	if token, ok := params["session"]; ok {
+		if conn.session, err = DecodeSessionToken(token); err != nil {
+			return nil, err
+		}
+	}
 	if err := conn.applySettings(params); err != nil {
 		return nil, err
 	}
 	return &conn, nil
 }
+
+// EncodeSessionToken encodes the serialized state of a session into a
+// token, as returned by SHOW SESSION_TOKEN. Passing the token as the
+// "session" parameter of a connection URL resumes the session, along with
+// its open transaction, on the connection, which may be to another node.
+func EncodeSessionToken(session []byte) string {
+	return base64.URLEncoding.EncodeToString(session)
+}
+
+// DecodeSessionToken decodes a token produced by EncodeSessionToken.
+func DecodeSessionToken(token string) ([]byte, error) {
+	session, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, util.Errorf("invalid session token: %s", err)
+	}
+	return session, nil
+}
@@ -385,6 +385,53 @@ func TestConnectionSettings(t *testing.T) {
 	}
 }
 
+func TestMultiStatementBatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t, time.UTC)
+	defer cleanup(s, db)
+
+	if result, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR)`); err != nil {
+		t.Fatal(err)
+	} else if _, err := result.RowsAffected(); !testutils.IsError(err, "no RowsAffected available after DDL statement") {
+		t.Error(err)
+	}
+
+	// The rows affected by a batch are summed over its statements.
+	const insert = `INSERT INTO t.kv VALUES ('a', 'b'), ('c', 'd'); INSERT INTO t.kv VALUES ('e', 'f')`
+	if result, err := db.Exec(insert); err != nil {
+		t.Fatal(err)
+	} else if n, err := result.RowsAffected(); err != nil {
+		t.Fatal(err)
+	} else if n != 3 {
+		t.Errorf("expected 3 rows affected, got %d", n)
+	}
+
+	// A batch is executed in the session's transaction: the failure of a
+	// statement aborts the statements following it.
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const conflict = `INSERT INTO t.kv VALUES ('g', 'h'); INSERT INTO t.kv VALUES ('a', 'b'); INSERT INTO t.kv VALUES ('i', 'j')`
+	if _, err := tx.Exec(conflict); !testutils.IsError(err, "duplicate key value") {
+		t.Fatalf("expected duplicate key error, got %v", err)
+	}
+	if _, err := tx.Exec(`SELECT * FROM t.kv`); !testutils.IsError(err, "current transaction is aborted") {
+		t.Fatalf("expected aborted transaction error, got %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rows of the last statement of a batch are returned.
+	var count int
+	if err := db.QueryRow(`SELECT * FROM t.kv WHERE k = 'a'; SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 3 {
+		t.Errorf("expected 3 rows, got %d", count)
+	}
+}
+
 func TestProtocols(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
@@ -89,6 +89,10 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 	if err := p.txn.Run(b); err != nil {
 		return nil, err
 	}
+	if err := p.logEvent(EventLogDropDatabase, dbDesc.ID,
+		schemaChangeEventInfo{Name: string(n.Name), Statement: n.String()}); err != nil {
+		return nil, err
+	}
 	return &valuesNode{}, nil
 }
 
@@ -97,6 +101,11 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 //   Notes: postgres allows only the index owner to DROP an index.
 //          mysql requires the INDEX privilege on the table.
 func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
+	type droppedIndex struct {
+		tableID ID
+		name    string
+	}
+	var dropped []droppedIndex
 	b := client.Batch{}
 	for _, indexQualifiedName := range n.Names {
 		if err := indexQualifiedName.NormalizeTableName(p.session.Database); err != nil {
@@ -130,18 +139,25 @@ func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
 			return nil, err
 		}
 
-		if err := newTableDesc.Validate(); err != nil {
+		if err := newTableDesc.ValidateUpdate(tableDesc); err != nil {
 			return nil, err
 		}
 
 		descKey := MakeDescMetadataKey(newTableDesc.GetID())
 		b.Put(descKey, wrapDescriptor(newTableDesc))
+		dropped = append(dropped, droppedIndex{newTableDesc.ID, indexQualifiedName.String()})
 	}
 
 	if err := p.txn.Run(&b); err != nil {
 		return nil, err
 	}
 
+	for _, d := range dropped {
+		if err := p.logEvent(EventLogDropIndex, d.tableID,
+			schemaChangeEventInfo{Name: d.name, Statement: n.String()}); err != nil {
+			return nil, err
+		}
+	}
 	return &valuesNode{}, nil
 }
 
@@ -209,6 +225,10 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 		if err := p.txn.Run(b); err != nil {
 			return nil, err
 		}
+		if err := p.logEvent(EventLogDropTable, tableDesc.ID,
+			schemaChangeEventInfo{Name: tableQualifiedName.String(), Statement: n.String()}); err != nil {
+			return nil, err
+		}
 	}
 	return &valuesNode{}, nil
 }
@@ -58,25 +58,31 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 	result := b.Results[index]
 	if _, ok := err.(*roachpb.ConditionFailedError); ok {
 		for _, row := range result.Rows {
-			indexID, key, err := decodeIndexKeyPrefix(tableDesc, row.Key)
-			if err != nil {
-				return err
-			}
-			index, err := tableDesc.FindIndexByID(indexID)
-			if err != nil {
-				return err
-			}
-			valTypes, err := makeKeyVals(tableDesc, index.ColumnIDs)
-			if err != nil {
-				return err
-			}
-			vals := make([]parser.Datum, len(valTypes))
-			if _, err := decodeKeyVals(valTypes, vals, key); err != nil {
-				return err
-			}
-
-			return errUniquenessConstraintViolation{index: index, vals: vals}
+			return uniquenessViolation(tableDesc, row.Key)
 		}
 	}
 	return err
 }
+
+// uniquenessViolation returns the error reporting that the row or index
+// entry with the given key already exists.
+func uniquenessViolation(tableDesc *TableDescriptor, key roachpb.Key) error {
+	indexID, rest, err := decodeIndexKeyPrefix(tableDesc, key)
+	if err != nil {
+		return err
+	}
+	index, err := tableDesc.FindIndexByID(indexID)
+	if err != nil {
+		return err
+	}
+	valTypes, err := makeKeyVals(tableDesc, index.ColumnIDs)
+	if err != nil {
+		return err
+	}
+	vals := make([]parser.Datum, len(valTypes))
+	if _, err := decodeKeyVals(valTypes, vals, index.ColumnDirections, rest); err != nil {
+		return err
+	}
+
+	return errUniquenessConstraintViolation{index: index, vals: vals}
+}
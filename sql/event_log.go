@@ -0,0 +1,140 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// EventLogType is the type of an event recorded in the system.eventlog
+// table.
+type EventLogType string
+
+// Event types recorded in the system.eventlog table. The target of an
+// event is the node, store, database or table identified by its targetID
+// column.
+const (
+	// EventLogNodeJoin is recorded when a node joins the cluster for the
+	// first time, allocating its node ID.
+	EventLogNodeJoin EventLogType = "node_join"
+	// EventLogNodeRestart is recorded when a node which already has a node
+	// ID starts. The node which bootstrapped the cluster records its first
+	// start as a restart.
+	EventLogNodeRestart EventLogType = "node_restart"
+	// EventLogStoreAdded is recorded when a new store is bootstrapped on a
+	// node of the cluster.
+	EventLogStoreAdded EventLogType = "store_added"
+
+	// EventLogCreateDatabase is recorded when a database is created.
+	EventLogCreateDatabase EventLogType = "create_database"
+	// EventLogDropDatabase is recorded when a database is dropped.
+	EventLogDropDatabase EventLogType = "drop_database"
+	// EventLogCreateTable is recorded when a table is created.
+	EventLogCreateTable EventLogType = "create_table"
+	// EventLogDropTable is recorded when a table is dropped.
+	EventLogDropTable EventLogType = "drop_table"
+	// EventLogAlterTable is recorded when the columns or constraints of a
+	// table are altered.
+	EventLogAlterTable EventLogType = "alter_table"
+	// EventLogCreateIndex is recorded when an index is created.
+	EventLogCreateIndex EventLogType = "create_index"
+	// EventLogDropIndex is recorded when an index is dropped.
+	EventLogDropIndex EventLogType = "drop_index"
+
+	// EventLogSetZoneConfig is recorded when the zone config of a database
+	// or table is set.
+	EventLogSetZoneConfig EventLogType = "set_zone_config"
+	// EventLogRemoveZoneConfig is recorded when the zone config of a
+	// database or table is removed.
+	EventLogRemoveZoneConfig EventLogType = "remove_zone_config"
+)
+
+// schemaChangeEventInfo is the info recorded for the events of schema
+// changes.
+type schemaChangeEventInfo struct {
+	// Name is the qualified name of the database, table or index.
+	Name string
+	// Statement is the statement which caused the schema change.
+	Statement string
+}
+
+// zoneConfigEventInfo is the info recorded for the events of zone config
+// changes. Changes made by writing to the system.zones table directly are
+// recorded without info.
+type zoneConfigEventInfo struct {
+	// Statement is the statement which changed the zone config.
+	Statement string
+}
+
+// InsertEventRecord records an event in the system.eventlog table as part
+// of the given transaction. The targetID identifies the object the event
+// is about and the reportingID the node recording the event; the actor is
+// the user responsible for the event. The info, which may be nil, is
+// recorded in its JSON encoding.
+func InsertEventRecord(txn *client.Txn, eventType EventLogType, targetID, reportingID int32,
+	actor string, info interface{}) error {
+	infoDatum := parser.Datum(parser.DNull)
+	if info != nil {
+		buf, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		infoDatum = parser.DString(buf)
+	}
+	p := planner{txn: txn, user: security.RootUser}
+	const insertEvent = `INSERT INTO system.eventlog VALUES ('%s'::timestamp, %s, %d, %d, %s, %s)`
+	_, err := p.exec(fmt.Sprintf(insertEvent, parser.DTimestamp{Time: time.Now()},
+		parser.DString(eventType), targetID, reportingID, parser.DString(actor), infoDatum))
+	return err
+}
+
+// logEvent records an event caused by the planner's user in the event log,
+// as part of the planner's transaction.
+func (p *planner) logEvent(eventType EventLogType, targetID ID, info interface{}) error {
+	var reportingID int32
+	if p.leaseMgr != nil {
+		reportingID = int32(p.leaseMgr.nodeID)
+	}
+	return InsertEventRecord(p.txn, eventType, int32(targetID), reportingID, p.user, info)
+}
+
+// zoneConfigTarget returns the ID of the database or table whose zone
+// config is held by the given row of the zones table.
+func zoneConfigTarget(colIDtoRowIndex map[ColumnID]int, rowVals parser.DTuple) (ID, bool) {
+	i, ok := colIDtoRowIndex[ZonesTable.PrimaryIndex.ColumnIDs[0]]
+	if !ok {
+		return 0, false
+	}
+	id, ok := rowVals[i].(parser.DInt)
+	return ID(id), ok
+}
+
+// logZoneConfigEvents records the changes of the zone configs of the given
+// databases and tables in the event log.
+func (p *planner) logZoneConfigEvents(eventType EventLogType, ids []ID) error {
+	for _, id := range ids {
+		if err := p.logEvent(eventType, id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
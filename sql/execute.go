@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Execute runs the wrapped statement to completion and, with DISCARD ROWS,
+// throws its result rows away on the server, returning only the number of
+// rows produced and the time taken to plan and execute the statement. This
+// lets the execution engine be benchmarked without the cost of encoding
+// the results and shipping them to the client.
+//
+// Privileges: the same privileges as the wrapped statement.
+func (p *planner) Execute(n *parser.Execute) (planNode, error) {
+	if !n.DiscardRows {
+		return nil, fmt.Errorf("unsupported EXECUTE statement: %s", n)
+	}
+
+	start := time.Now()
+	plan, err := p.makePlan(n.Statement)
+	if err != nil {
+		return nil, err
+	}
+	var rows int64
+	for plan.Next() {
+		rows++
+	}
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+
+	v := &valuesNode{columns: []string{"Rows", "Time"}}
+	v.rows = append(v.rows, parser.DTuple{
+		parser.DInt(rows),
+		parser.DInterval{Duration: time.Since(start)},
+	})
+	return v, nil
+}
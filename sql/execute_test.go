@@ -0,0 +1,62 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestExecuteDiscardRows verifies that EXECUTE ... DISCARD ROWS runs the
+// statement but returns only its row count and execution time.
+func TestExecuteDiscardRows(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 3), (2, 2), (3, 1);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		stmt string
+		rows int64
+	}{
+		{`EXECUTE SELECT * FROM t.kv DISCARD ROWS`, 3},
+		{`EXECUTE SELECT * FROM t.kv WHERE v > 1 DISCARD ROWS`, 2},
+		{`EXECUTE INSERT INTO t.kv VALUES (4, 0), (5, 0) DISCARD ROWS`, 2},
+		{`EXECUTE SELECT * FROM t.kv DISCARD ROWS`, 5},
+	}
+	for _, test := range testCases {
+		var rows int64
+		var elapsed time.Duration
+		if err := sqlDB.QueryRow(test.stmt).Scan(&rows, &elapsed); err != nil {
+			t.Fatalf("%s: %s", test.stmt, err)
+		}
+		if rows != test.rows {
+			t.Errorf("%s: expected %d rows, got %d", test.stmt, test.rows, rows)
+		}
+		if elapsed <= 0 {
+			t.Errorf("%s: expected a positive execution time, got %s", test.stmt, elapsed)
+		}
+	}
+}
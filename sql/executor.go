@@ -23,21 +23,31 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"golang.org/x/net/context"
+
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/budget"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/gogo/protobuf/proto"
 )
 
 var errNoTransactionInProgress = errors.New("there is no transaction in progress")
 var errTransactionAborted = errors.New("current transaction is aborted, commands ignored until end of transaction block")
 var errTransactionInProgress = errors.New("there is already a transaction in progress")
+var errDraining = errors.New("server is draining, not accepting new statements")
+var errStatementTimeout = errors.New("statement timed out")
 
 // An Executor executes SQL statements.
 type Executor struct {
@@ -45,6 +55,22 @@ type Executor struct {
 	nodeID   uint32
 	reCache  *parser.RegexpCache
 	leaseMgr *LeaseManager
+	clock    *hlc.Clock
+	flows    flowContext
+	stores   storeCache
+	nodes    nodeTable
+	plans    *planCache
+	stats    tableStatsCache
+	draining int32 // Accessed atomically; non-zero while draining.
+	sessions sessionRegistry
+	memory   *budget.Pool     // may be nil
+	metrics  *metric.Registry // may be nil
+	// The memory which may be used by a request of a session, in bytes.
+	// Zero means no limit.
+	sessionMemoryLimit int64
+	// The maximum execution time of a statement. Zero means no limit.
+	stmtTimeout time.Duration
+	slowQueries slowQueryLog
 
 	// System Config and mutex.
 	systemConfig   *config.SystemConfig
@@ -53,13 +79,22 @@ type Executor struct {
 
 // newExecutor creates an Executor and registers a callback on the
 // system config.
-func newExecutor(db client.DB, gossip *gossip.Gossip, clock *hlc.Clock) *Executor {
+func newExecutor(db client.DB, g *gossip.Gossip, clock *hlc.Clock, rpcContext *rpc.Context) *Executor {
 	exec := &Executor{
 		db:       db,
 		reCache:  parser.NewRegexpCache(512),
+		plans:    newPlanCache(planCacheSize),
 		leaseMgr: NewLeaseManager(0, db, clock),
+		clock:    clock,
+		flows: flowContext{
+			db:         db,
+			gossip:     g,
+			rpcContext: rpcContext,
+		},
 	}
-	gossip.RegisterSystemConfigCallback(exec.updateSystemConfig)
+	g.RegisterSystemConfigCallback(exec.updateSystemConfig)
+	g.RegisterCallback(gossip.MakePrefixPattern(gossip.KeyStorePrefix), exec.stores.storeGossipUpdate)
+	g.RegisterCallback(gossip.MakePrefixPattern(gossip.KeyStorePrefix), exec.nodesGossipUpdate)
 	return exec
 }
 
@@ -68,6 +103,110 @@ func newExecutor(db client.DB, gossip *gossip.Gossip, clock *hlc.Clock) *Executo
 func (e *Executor) SetNodeID(nodeID roachpb.NodeID) {
 	e.nodeID = uint32(nodeID)
 	e.leaseMgr.nodeID = e.nodeID
+	e.flows.nodeID = nodeID
+}
+
+// SetDraining puts the Executor into (or takes it out of) draining mode.
+// While draining, all requests are rejected so that clients retry them
+// against another node.
+func (e *Executor) SetDraining(drain bool) {
+	var v int32
+	if drain {
+		v = 1
+	}
+	atomic.StoreInt32(&e.draining, v)
+}
+
+// SetMemoryPool sets the memory budget shared by the requests in flight.
+// Requests are rejected if the memory needed to buffer their results exceeds
+// the budget. This method must be called before actually using the Executor.
+func (e *Executor) SetMemoryPool(pool *budget.Pool) {
+	e.memory = pool
+}
+
+// SetSessionMemoryLimit limits the memory which may be used by a request of
+// a session, to buffer its results and to sort, group and join rows, to
+// limit bytes. Statements exceeding the limit fail. Zero means no limit. This
+// method must be called before actually using the Executor.
+func (e *Executor) SetSessionMemoryLimit(limit int64) {
+	e.sessionMemoryLimit = limit
+}
+
+// SetStatementTimeout limits the execution time of a statement to timeout.
+// The KV requests of a statement running for longer are abandoned and the
+// statement fails. Zero means no limit. This method must be called before
+// actually using the Executor.
+func (e *Executor) SetStatementTimeout(timeout time.Duration) {
+	e.stmtTimeout = timeout
+}
+
+// SetMetrics sets the registry into which the Executor records the number,
+// the failures and the latency of the statements it executes, as
+// "sql.statements.count", "sql.statements.errors" and
+// "sql.statements.latency" respectively. This method must be called before
+// actually using the Executor.
+func (e *Executor) SetMetrics(registry *metric.Registry) {
+	e.metrics = registry
+}
+
+// StartSessionMonitor limits the number of SQL sessions executing a request
+// to maxSessions and starts a worker which aborts the open transactions of
+// sessions which have been idle for longer than idleTimeout. A zero value
+// disables the respective limit.
+func (e *Executor) StartSessionMonitor(maxSessions int, idleTimeout time.Duration, stopper *stop.Stopper) {
+	e.sessions.Lock()
+	e.sessions.maxSessions = maxSessions
+	e.sessions.idleTimeout = idleTimeout
+	e.sessions.Unlock()
+	if idleTimeout == 0 {
+		return
+	}
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(idleTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				for _, t := range e.sessions.expire(now) {
+					t := t
+					stopper.RunTask(func() {
+						if e.abortIdleTxn(t) {
+							e.sessions.markExpired(t.ID, now)
+						}
+					})
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// abortIdleTxn aborts the transaction of an expired session unless it is
+// still in use or has ended, returning whether it was aborted. The client may have
+// continued the session through another node, whose coordinator keeps the
+// transaction alive by heartbeating it. A cleanup push only aborts a
+// transaction whose heartbeat has expired, so such a transaction is left
+// alone.
+func (e *Executor) abortIdleTxn(txn roachpb.Transaction) bool {
+	b := &client.Batch{}
+	b.InternalAddRequest(&roachpb.PushTxnRequest{
+		Span: roachpb.Span{
+			Key: txn.Key,
+		},
+		Now:       e.clock.Now(),
+		PusheeTxn: txn,
+		PushType:  roachpb.CLEANUP_TXN,
+	})
+	br, err := e.db.RunWithResponse(b)
+	if err != nil {
+		if _, ok := err.(*roachpb.TransactionPushError); !ok {
+			log.Warningf("unable to abort transaction of idle session: %s", err)
+		}
+		return false
+	}
+	pushee := br.Responses[0].GetInner().(*roachpb.PushTxnResponse).PusheeTxn
+	return pushee != nil && pushee.Status == roachpb.ABORTED
 }
 
 // updateSystemConfig is called whenever the system config gossip entry is updated.
@@ -88,7 +227,11 @@ func (e *Executor) getSystemConfig() *config.SystemConfig {
 // Execute the statement(s) in the given request and return a response.
 // On error, the returned integer is an HTTP error code.
 func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
+	if atomic.LoadInt32(&e.draining) != 0 {
+		return args.CreateReply(), http.StatusServiceUnavailable, errDraining
+	}
 	planMaker := &planner{
+		db:   &e.db,
 		user: args.GetUser(),
 		evalCtx: parser.EvalContext{
 			NodeID:  e.nodeID,
@@ -96,12 +239,48 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 		},
 		leaseMgr:     e.leaseMgr,
 		systemConfig: e.getSystemConfig(),
+		flows:        &e.flows,
+		stores:       &e.stores,
+		planCache:    e.plans,
+		tableStats:   &e.stats,
+		sessions:     &e.sessions,
 	}
 
 	// Pick up current session state.
 	if err := proto.Unmarshal(args.Session, &planMaker.session); err != nil {
 		return args.CreateReply(), http.StatusBadRequest, err
 	}
+	var sessionTxn *roachpb.Transaction
+	if planMaker.session.Txn != nil {
+		sessionTxn = &planMaker.session.Txn.Txn
+	}
+	openID, expired, err := e.sessions.begin(sessionTxn)
+	if err != nil {
+		return args.CreateReply(), http.StatusServiceUnavailable, err
+	}
+	// Account for the memory held by the request itself; the reservation grows
+	// as results are buffered and rows are sorted, grouped and joined.
+	reservation, err := e.memory.Reserve(int64(args.Size()))
+	if err != nil {
+		e.sessions.end(openID, planMaker.user, sessionTxn)
+		return args.CreateReply(), http.StatusServiceUnavailable, &roachpb.ServerOverloadedError{Message: err.Error()}
+	}
+	defer reservation.Release()
+	planMaker.mem = &sessionMemory{reservation: reservation, limit: e.sessionMemoryLimit}
+	planMaker.stmtMemory.mem = planMaker.mem
+	defer func() {
+		var txn *roachpb.Transaction
+		if planMaker.txn != nil && !planMaker.sessionHandedOff {
+			txn = &planMaker.txn.Proto
+		}
+		e.sessions.end(openID, planMaker.user, txn)
+	}()
+	req := &sessionRequest{user: planMaker.user, start: time.Now(), mem: planMaker.mem}
+	if sessionTxn != nil {
+		req.txnID = sessionTxn.ID
+	}
+	e.sessions.track(req)
+	defer e.sessions.untrack(req)
 	// Resume a pending transaction if present.
 	if planMaker.session.Txn != nil {
 		txn := client.NewTxn(e.db)
@@ -109,6 +288,11 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 		if planMaker.session.MutatesSystemDB {
 			txn.SetSystemDBTrigger()
 		}
+		if expired {
+			// The transaction was aborted because the session was idle for too
+			// long. The client needs to roll it back before continuing.
+			txn.Proto.Status = roachpb.ABORTED
+		}
 		planMaker.setTxn(txn, planMaker.session.Txn.Timestamp.GoTime())
 	}
 	planMaker.evalCtx.GetLocation = planMaker.session.getLocation
@@ -119,13 +303,7 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 
 	// Send back the session state even if there were application-level errors.
 	// Add transaction to session state.
-	if planMaker.txn != nil {
-		planMaker.session.Txn = &Session_Transaction{Txn: planMaker.txn.Proto, Timestamp: driver.Timestamp(planMaker.evalCtx.TxnTimestamp.Time)}
-		planMaker.session.MutatesSystemDB = planMaker.txn.SystemDBTrigger()
-	} else {
-		planMaker.session.Txn = nil
-		planMaker.session.MutatesSystemDB = false
-	}
+	planMaker.session = planMaker.sessionState()
 	bytes, err := proto.Marshal(&planMaker.session)
 	if err != nil {
 		return args.CreateReply(), http.StatusInternalServerError, err
@@ -135,8 +313,11 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 	return reply, 0, nil
 }
 
-// exec executes the request. Any error encountered is returned; it is
-// the caller's responsibility to update the response.
+// execStmts executes the semicolon-separated statements of a request in
+// order, returning a result for each of them. The statements go through the
+// session's transaction state one after the other: once a statement fails
+// in a transaction, the statements following it in the batch fail as well
+// until the transaction is rolled back.
 func (e *Executor) execStmts(sql string, params parameters, planMaker *planner) driver.Response {
 	var resp driver.Response
 	stmts, err := parser.Parse(sql, parser.Syntax(planMaker.session.Syntax))
@@ -147,7 +328,12 @@ func (e *Executor) execStmts(sql string, params parameters, planMaker *planner)
 		return resp
 	}
 	for _, stmt := range stmts {
-		result, err := e.execStmt(stmt, params, planMaker)
+		start := time.Now()
+		var stats stmtStats
+		result, err := e.execStmt(stmt, params, planMaker, &stats)
+		latency := time.Since(start)
+		e.recordStatement(latency, err)
+		e.maybeLogSlowQuery(stmt, latency, &stats, err)
 		if err != nil {
 			result = makeResultFromError(planMaker, err)
 		}
@@ -162,7 +348,21 @@ func (e *Executor) execStmts(sql string, params parameters, planMaker *planner)
 	return resp
 }
 
-func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker *planner) (driver.Response_Result, error) {
+// recordStatement records the execution of a statement in the Executor's
+// metrics, if any.
+func (e *Executor) recordStatement(latency time.Duration, err error) {
+	if e.metrics == nil {
+		return
+	}
+	e.metrics.Histogram("sql.statements.latency").RecordValue(latency.Nanoseconds())
+	e.metrics.Counter("sql.statements.count").Inc(1)
+	if err != nil {
+		e.metrics.Counter("sql.statements.errors").Inc(1)
+	}
+}
+
+func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker *planner,
+	stats *stmtStats) (driver.Response_Result, error) {
 	var result driver.Response_Result
 	switch stmt.(type) {
 	case *parser.BeginTransaction:
@@ -202,12 +402,32 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	// TODO(pmattis): Should this be a separate function? Perhaps we should move
 	// some of the common code back out into execStmts and have execStmt contain
 	// only the body of this closure.
+	resultMemory := memoryAccount{mem: planMaker.mem} // the memory used by the rows of result
+	// The memory used by the plan nodes is released once the statement
+	// completes.
+	defer planMaker.stmtMemory.close()
 	f := func(timestamp time.Time) error {
+		// Release the memory used by a previous attempt.
+		resultMemory.close()
+		planMaker.stmtMemory.close()
+
+		// The statement observes the writes of the preceding statements of
+		// the transaction, but not its own, which would let e.g. an UPDATE
+		// revisit the rows it updated.
+		planMaker.txn.Step()
+
 		planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: timestamp}
+		stats.attempts++
+		planStart := time.Now()
 		plan, err := planMaker.makePlan(stmt)
+		stats.planning += time.Since(planStart)
 		if err != nil {
 			return err
 		}
+		execStart := time.Now()
+		defer func() {
+			stats.execution += time.Since(execStart)
+		}()
 
 		switch stmt.StatementType() {
 		case parser.DDL:
@@ -218,6 +438,7 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 			for plan.Next() {
 				resultRowsAffected.RowsAffected++
 			}
+			stats.rows = int64(resultRowsAffected.RowsAffected)
 
 		case parser.Rows:
 			resultRows := &driver.Response_Result_Rows{
@@ -231,74 +452,77 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 				values := plan.Values()
 				row := driver.Response_Result_Rows_Row{Values: make([]driver.Datum, 0, len(values))}
 				for _, val := range values {
-					if val == parser.DNull {
-						row.Values = append(row.Values, driver.Datum{})
-						continue
-					}
-
-					switch vt := val.(type) {
-					case parser.DBool:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
-						})
-					case parser.DInt:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
-						})
-					case parser.DFloat:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
-						})
-					case parser.DBytes:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
-						})
-					case parser.DString:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_StringVal{StringVal: string(vt)},
-						})
-					case parser.DDate:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_DateVal{DateVal: int64(vt)},
-						})
-					case parser.DTimestamp:
-						wireTimestamp := driver.Timestamp(vt.Time)
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_TimeVal{
-								TimeVal: &wireTimestamp,
-							},
-						})
-					case parser.DInterval:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
-						})
-					default:
-						return fmt.Errorf("unsupported result type: %s", val.Type())
+					wireVal, err := makeDriverDatum(val)
+					if err != nil {
+						return err
 					}
+					row.Values = append(row.Values, wireVal)
+				}
+				if err := resultMemory.grow(int64(row.Size()), "results"); err != nil {
+					return err
 				}
 				resultRows.Rows = append(resultRows.Rows, row)
 			}
+			stats.rows = int64(len(resultRows.Rows))
 		}
 
 		return plan.Err()
 	}
 
+	// The KV requests of the statement are sent on behalf of a context which
+	// is canceled once the statement completes or times out, so that a
+	// statement which is given up on stops its work in the KV layer.
+	ctx, cancel := e.statementContext()
+	defer cancel()
+
 	// If there is a pending transaction.
-	if planMaker.txn != nil {
+	if txn := planMaker.txn; txn != nil {
+		txn.SetContext(ctx)
+		defer txn.SetContext(nil)
+		before := txn.Stats()
 		err := f(time.Now())
-		return result, err
+		after := txn.Stats()
+		stats.kv = client.TxnStats{
+			Batches: after.Batches - before.Batches,
+			Reads:   after.Reads - before.Reads,
+			Writes:  after.Writes - before.Writes,
+		}
+		return result, statementError(ctx, err)
 	}
 
 	// No transaction. Run the command as a retryable block in an
 	// auto-transaction.
-	err := e.db.Txn(func(txn *client.Txn) error {
+	var autoTxn *client.Txn
+	err := e.db.WithContext(ctx).Txn(func(txn *client.Txn) error {
+		autoTxn = txn
 		timestamp := time.Now()
 		planMaker.setTxn(txn, timestamp)
 		err := f(timestamp)
 		planMaker.resetTxn()
 		return err
 	})
-	return result, err
+	if autoTxn != nil {
+		stats.kv = autoTxn.Stats()
+	}
+	return result, statementError(ctx, err)
+}
+
+// statementContext returns the context on behalf of which a statement
+// sends its KV requests, along with the function canceling it.
+func (e *Executor) statementContext() (context.Context, context.CancelFunc) {
+	if e.stmtTimeout > 0 {
+		return context.WithTimeout(context.Background(), e.stmtTimeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// statementError returns the error to report for a statement which failed
+// with err, replacing the errors caused by the statement timing out.
+func statementError(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return errStatementTimeout
+	}
+	return err
 }
 
 // If we hit an error and there is a pending transaction, rollback
@@ -336,27 +560,85 @@ func (p parameters) Arg(name string) (parser.Datum, bool) {
 	if i < 1 || int(i) > len(p) {
 		return nil, false
 	}
-	arg := p[i-1].Payload
+	return makeDatum(p[i-1]), true
+}
+
+// makeDriverDatum converts a parser.Datum into its wire representation.
+func makeDriverDatum(val parser.Datum) (driver.Datum, error) {
+	if val == parser.DNull {
+		return driver.Datum{}, nil
+	}
+
+	switch vt := val.(type) {
+	case parser.DBool:
+		return driver.Datum{
+			Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
+		}, nil
+	case parser.DInt:
+		return driver.Datum{
+			Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
+		}, nil
+	case parser.DFloat:
+		return driver.Datum{
+			Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
+		}, nil
+	case parser.DBytes:
+		return driver.Datum{
+			Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
+		}, nil
+	case parser.DString:
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: string(vt)},
+		}, nil
+	case parser.DDate:
+		return driver.Datum{
+			Payload: &driver.Datum_DateVal{DateVal: int64(vt)},
+		}, nil
+	case parser.DTimestamp:
+		wireTimestamp := driver.Timestamp(vt.Time)
+		return driver.Datum{
+			Payload: &driver.Datum_TimeVal{
+				TimeVal: &wireTimestamp,
+			},
+		}, nil
+	case parser.DInterval:
+		return driver.Datum{
+			Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
+		}, nil
+	case *parser.DArray:
+		// database/sql has no array values, so arrays are returned in their SQL
+		// string form (e.g. ARRAY[1, 2]).
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.String()},
+		}, nil
+	default:
+		return driver.Datum{}, fmt.Errorf("unsupported result type: %s", val.Type())
+	}
+}
+
+// makeDatum converts a wire datum into a parser.Datum.
+func makeDatum(d driver.Datum) parser.Datum {
+	arg := d.Payload
 	if arg == nil {
-		return parser.DNull, true
+		return parser.DNull
 	}
 	switch t := arg.(type) {
 	case *driver.Datum_BoolVal:
-		return parser.DBool(t.BoolVal), true
+		return parser.DBool(t.BoolVal)
 	case *driver.Datum_IntVal:
-		return parser.DInt(t.IntVal), true
+		return parser.DInt(t.IntVal)
 	case *driver.Datum_FloatVal:
-		return parser.DFloat(t.FloatVal), true
+		return parser.DFloat(t.FloatVal)
 	case *driver.Datum_BytesVal:
-		return parser.DBytes(t.BytesVal), true
+		return parser.DBytes(t.BytesVal)
 	case *driver.Datum_StringVal:
-		return parser.DString(t.StringVal), true
+		return parser.DString(t.StringVal)
 	case *driver.Datum_DateVal:
-		return parser.DDate(t.DateVal), true
+		return parser.DDate(t.DateVal)
 	case *driver.Datum_TimeVal:
-		return parser.DTimestamp{Time: t.TimeVal.GoTime()}, true
+		return parser.DTimestamp{Time: t.TimeVal.GoTime()}
 	case *driver.Datum_IntervalVal:
-		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}, true
+		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}
 	default:
 		panic(fmt.Sprintf("unexpected type %T", t))
 	}
@@ -18,8 +18,10 @@
 package sql
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
@@ -31,16 +33,25 @@ const (
 	explainNone explainMode = iota
 	explainDebug
 	explainPlan
+	explainAnalyze
+	explainTypes
 )
 
 // Explain executes the explain statement, providing debugging and analysis
-// info about a DELETE, INSERT, SELECT or UPDATE statement.
+// info about a DELETE, INSERT, SELECT or UPDATE statement. EXPLAIN ANALYZE
+// runs the statement and reports the execution statistics of each node of
+// its plan. EXPLAIN (TYPES) reports the resolved type of the result columns
+// and of every expression of each node of the plan.
 //
 // Privileges: the same privileges as the statement being explained.
 func (p *planner) Explain(n *parser.Explain) (planNode, error) {
 	mode := explainNone
 	if len(n.Options) == 1 && strings.EqualFold(n.Options[0], "DEBUG") {
 		mode = explainDebug
+	} else if len(n.Options) == 1 && strings.EqualFold(n.Options[0], "ANALYZE") {
+		mode = explainAnalyze
+	} else if len(n.Options) == 1 && strings.EqualFold(n.Options[0], "TYPES") {
+		mode = explainTypes
 	} else if len(n.Options) == 0 {
 		mode = explainPlan
 	}
@@ -64,6 +75,24 @@ func (p *planner) Explain(n *parser.Explain) (planNode, error) {
 		v.columns = []string{"Level", "Type", "Description"}
 		populateExplain(v, plan, 0)
 		plan = v
+	case explainAnalyze:
+		analyzed, stats := instrumentPlan(plan)
+		for analyzed.Next() {
+		}
+		if err := analyzed.Err(); err != nil {
+			return nil, err
+		}
+		v := &valuesNode{}
+		v.columns = []string{"Level", "Type", "Description", "Rows", "Batches", "Bytes", "Time"}
+		populateAnalyze(v, stats, 0)
+		return v, nil
+	case explainTypes:
+		v := &valuesNode{}
+		v.columns = []string{"Level", "Type", "Element", "Description"}
+		if err := populateTypes(v, plan, 0); err != nil {
+			return nil, err
+		}
+		plan = v
 	default:
 		return nil, fmt.Errorf("unsupported EXPLAIN mode: %d", mode)
 	}
@@ -81,6 +110,9 @@ func markDebug(plan planNode, mode explainMode) (planNode, error) {
 	case *indexJoinNode:
 		return markDebug(t.index, mode)
 
+	case *flowNode:
+		return markDebug(t.scan, mode)
+
 	case *sortNode:
 		return markDebug(t.plan, mode)
 
@@ -103,3 +135,279 @@ func populateExplain(v *valuesNode, plan planNode, level int) {
 		populateExplain(v, child, level+1)
 	}
 }
+
+// execStats are the statistics collected while executing a node of a plan
+// for EXPLAIN ANALYZE. The wall time of a node includes the time spent in
+// the nodes below it.
+type execStats struct {
+	rows     int64
+	batches  int64
+	bytes    int64
+	wallTime time.Duration
+}
+
+// recordNext records a call to Next which started at the given time and
+// returned the given result.
+func (s *execStats) recordNext(start time.Time, ok bool) {
+	s.wallTime += time.Since(start)
+	if ok {
+		s.rows++
+	}
+}
+
+// planStats holds the description and execution statistics of a node of a
+// plan and its children. The statistics are nil for nodes which are not
+// executed locally.
+type planStats struct {
+	name        string
+	description string
+	stats       *execStats
+	children    []*planStats
+}
+
+// analyzeNode collects the execution statistics of the node it wraps.
+type analyzeNode struct {
+	planNode
+	stats *execStats
+}
+
+func (n *analyzeNode) Next() bool {
+	start := time.Now()
+	ok := n.planNode.Next()
+	n.stats.recordNext(start, ok)
+	return ok
+}
+
+// instrumentPlan sets up the collection of execution statistics for the plan
+// and its children, returning the plan to execute and the statistics to
+// report once it has been executed. The statistics tree is captured before
+// execution, as some nodes replace their children while running (e.g. a
+// sortNode replaces its child with the sorted rows).
+func instrumentPlan(plan planNode) (planNode, *planStats) {
+	name, description, children := plan.ExplainPlan()
+	ps := &planStats{name: name, description: description, stats: &execStats{}}
+	var child *planStats
+	switch n := plan.(type) {
+	case *scanNode:
+		// Scans collect their own statistics, including the KV batches they
+		// issue.
+		n.stats = ps.stats
+		return n, ps
+	case *indexJoinNode:
+		_, index := instrumentPlan(n.index)
+		_, table := instrumentPlan(n.table)
+		ps.children = []*planStats{index, table}
+	case *sortNode:
+		n.plan, child = instrumentPlan(n.plan)
+		ps.children = []*planStats{child}
+	case *groupNode:
+		n.plan, child = instrumentPlan(n.plan)
+		ps.children = []*planStats{child}
+	case *distinctNode:
+		n.planNode, child = instrumentPlan(n.planNode)
+		ps.children = []*planStats{child}
+	case *limitNode:
+		n.planNode, child = instrumentPlan(n.planNode)
+		ps.children = []*planStats{child}
+	default:
+		// The children of other nodes, such as the scan of a flow which
+		// executes on remote nodes, are not executed directly.
+		for _, c := range children {
+			ps.children = append(ps.children, makePlanStats(c))
+		}
+	}
+	return &analyzeNode{planNode: plan, stats: ps.stats}, ps
+}
+
+// makePlanStats returns the description of a plan without statistics.
+func makePlanStats(plan planNode) *planStats {
+	name, description, children := plan.ExplainPlan()
+	ps := &planStats{name: name, description: description}
+	for _, c := range children {
+		ps.children = append(ps.children, makePlanStats(c))
+	}
+	return ps
+}
+
+func populateAnalyze(v *valuesNode, ps *planStats, level int) {
+	row := parser.DTuple{
+		parser.DInt(level),
+		parser.DString(ps.name),
+		parser.DString(ps.description),
+		parser.DNull, parser.DNull, parser.DNull, parser.DNull,
+	}
+	if s := ps.stats; s != nil {
+		row[3] = parser.DInt(s.rows)
+		row[4] = parser.DInt(s.batches)
+		row[5] = parser.DInt(s.bytes)
+		row[6] = parser.DInterval{Duration: s.wallTime}
+	}
+	v.rows = append(v.rows, row)
+
+	for _, child := range ps.children {
+		populateAnalyze(v, child, level+1)
+	}
+}
+
+// populateTypes adds the rows of EXPLAIN (TYPES) for the given plan and its
+// children: the types of the result columns of each node, followed by its
+// expressions with the type of every sub-expression annotated.
+func populateTypes(v *valuesNode, plan planNode, level int) error {
+	name, _, children := plan.ExplainPlan()
+	addRow := func(element, description string) {
+		v.rows = append(v.rows, parser.DTuple{
+			parser.DInt(level),
+			parser.DString(name),
+			parser.DString(element),
+			parser.DString(description),
+		})
+	}
+
+	types, err := columnTypes(plan)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("(")
+	for i, col := range plan.Columns() {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s %s", col, types[i])
+	}
+	buf.WriteString(")")
+	addRow("result", buf.String())
+
+	var render []parser.Expr
+	var filter parser.Expr
+	switch n := plan.(type) {
+	case *scanNode:
+		render, filter = n.render, n.filter
+	case *groupNode:
+		render = n.render
+	}
+	for i, expr := range render {
+		typed, err := annotateTypes(expr)
+		if err != nil {
+			return err
+		}
+		addRow(fmt.Sprintf("render %d", i), typed.String())
+	}
+	if filter != nil {
+		typed, err := annotateTypes(filter)
+		if err != nil {
+			return err
+		}
+		addRow("filter", typed.String())
+	}
+
+	for _, child := range children {
+		if err := populateTypes(v, child, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnTypes returns the names of the types of the result columns of the
+// given plan. Nodes which neither render nor produce rows of their own pass
+// through the types of the node below them.
+func columnTypes(plan planNode) ([]string, error) {
+	var render []parser.Expr
+	switch n := plan.(type) {
+	case *scanNode:
+		render = n.render
+	case *groupNode:
+		render = n.render
+	case *indexJoinNode:
+		return columnTypes(n.table)
+	case *valuesNode:
+		types := make([]string, len(n.columns))
+		for i := range types {
+			types[i] = parser.DNull.Type()
+			for _, row := range n.rows {
+				if row[i] != parser.DNull {
+					types[i] = row[i].Type()
+					break
+				}
+			}
+		}
+		return types, nil
+	default:
+		columns := plan.Columns()
+		_, _, children := plan.ExplainPlan()
+		if len(children) == 0 {
+			return nil, util.Errorf("unable to determine the column types of %T", plan)
+		}
+		types, err := columnTypes(children[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(types) < len(columns) {
+			return nil, util.Errorf("unable to determine the column types of %T", plan)
+		}
+		return types[:len(columns)], nil
+	}
+
+	types := make([]string, len(render))
+	for i, expr := range render {
+		d, err := expr.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		types[i] = d.Type()
+	}
+	return types, nil
+}
+
+// typedExpr annotates an expression with its resolved type.
+type typedExpr struct {
+	parser.Expr
+	typ string
+}
+
+func (e *typedExpr) String() string {
+	return fmt.Sprintf("(%s)[%s]", e.Expr, e.typ)
+}
+
+// typeAnnotator is a parser.Visitor which wraps every sub-expression of an
+// expression in a typedExpr. The type of an expression is resolved before
+// its children are wrapped, so that type checking sees the original
+// expression tree.
+type typeAnnotator struct {
+	types []string
+	err   error
+}
+
+var _ parser.Visitor = &typeAnnotator{}
+
+func (v *typeAnnotator) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser.Expr) {
+	if v.err != nil {
+		return nil, expr
+	}
+	if !pre {
+		typ := v.types[len(v.types)-1]
+		v.types = v.types[:len(v.types)-1]
+		return nil, &typedExpr{Expr: expr, typ: typ}
+	}
+	d, err := expr.TypeCheck()
+	if err != nil {
+		v.err = err
+		return nil, expr
+	}
+	if _, ok := expr.(parser.VariableExpr); ok {
+		// Variables are leaves: their children are the values they are bound
+		// to while running.
+		return nil, &typedExpr{Expr: expr, typ: d.Type()}
+	}
+	v.types = append(v.types, d.Type())
+	return v, expr
+}
+
+// annotateTypes returns the given expression with the type of every
+// sub-expression annotated. The expression is modified in place.
+func annotateTypes(expr parser.Expr) (parser.Expr, error) {
+	v := typeAnnotator{}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.err
+}
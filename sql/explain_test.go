@@ -0,0 +1,84 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestExplainAnalyze verifies that EXPLAIN ANALYZE reports the rows produced
+// and the KV batches issued by each node of the plan.
+func TestExplainAnalyze(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, INDEX foo (v));
+INSERT INTO t.kv VALUES (1, 3), (2, 2), (3, 1);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := sqlDB.Query(`EXPLAIN ANALYZE SELECT * FROM t.kv@foo WHERE v > 1 ORDER BY k`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	type nodeStats struct {
+		level   int
+		typ     string
+		rows    int64
+		batches int64
+	}
+	expected := []nodeStats{
+		{0, "sort", 2, 0},
+		{1, "index-join", 2, 0},
+		{2, "scan", 2, 1},
+		{2, "scan", 2, 1},
+	}
+	var i int
+	for ; rows.Next(); i++ {
+		var n nodeStats
+		var description string
+		var rowCount, batches, bytes sql.NullInt64
+		var wallTime interface{}
+		if err := rows.Scan(&n.level, &n.typ, &description, &rowCount, &batches, &bytes, &wallTime); err != nil {
+			t.Fatal(err)
+		}
+		n.rows, n.batches = rowCount.Int64, batches.Int64
+		if i >= len(expected) {
+			t.Fatalf("unexpected node %+v", n)
+		}
+		if n != expected[i] {
+			t.Errorf("%d: expected %+v; got %+v", i, expected[i], n)
+		}
+		if n.typ == "scan" && bytes.Int64 == 0 {
+			t.Errorf("%d: expected scan to read bytes", i)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(expected) {
+		t.Errorf("expected %d nodes; got %d", len(expected), i)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// externalClient is the HTTP client fetching the files of external tables.
+// Only the response headers are subject to a timeout, as the bodies of
+// large files are streamed for as long as the scan lasts.
+var externalClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ResponseHeaderTimeout: 30 * time.Second,
+	},
+}
+
+// CreateExternalTable creates a read-only table whose rows are read from
+// delimited text files served over HTTP every time the table is scanned.
+// No data is stored in the cluster, so the table has no secondary indexes
+// and scans of it are never distributed.
+// Privileges: "root" user.
+//   Notes: postgres uses CREATE FOREIGN TABLE; mysql uses the CSV storage engine.
+func (p *planner) CreateExternalTable(n *parser.CreateExternalTable) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to create external tables", security.RootUser)
+	}
+	source := &ExternalSource{URLs: make([]string, len(n.Files))}
+	for i, expr := range n.Files {
+		d, err := expr.Eval(p.evalCtx)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := d.(parser.DString)
+		if !ok || s == "" {
+			return nil, fmt.Errorf("external table URL must be a non-empty string, not %s", d)
+		}
+		u, err := url.Parse(string(s))
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("external table URL %q must use http or https", string(s))
+		}
+		source.URLs[i] = string(s)
+	}
+	var err error
+	if source.Delimiter, source.Comment, err = p.evalCSVOptions("external table", n.Options); err != nil {
+		return nil, err
+	}
+
+	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
+		return nil, err
+	}
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	desc, err := makeTableDesc(&parser.CreateTable{Table: n.Table, Defs: n.Defs}, dbDesc.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(desc.Indexes) > 0 {
+		return nil, fmt.Errorf("external table %q cannot have secondary indexes", desc.Name)
+	}
+	desc.External = source
+	desc.Privileges = dbDesc.GetPrivileges()
+	if err := desc.AllocateIDs(); err != nil {
+		return nil, err
+	}
+	if err := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, false); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// checkNotExternal returns an error if the table is an external table,
+// which can only be read.
+func checkNotExternal(tableDesc *TableDescriptor) error {
+	if tableDesc.External != nil {
+		return fmt.Errorf("table %q is an external table and is read-only", tableDesc.Name)
+	}
+	return nil
+}
+
+// An externalScan reads the rows of an external table from its files in
+// order, fetching each file only once the previous one has been read.
+type externalScan struct {
+	source *ExternalSource
+	cols   []ColumnDescriptor
+	file   int           // the index of the file being read
+	body   io.ReadCloser // the body of the file being read, if any
+	reader *csv.Reader
+	record int // the number of the last record read from the file
+}
+
+// read returns the next row of the external table, or nil once all files
+// have been read.
+func (e *externalScan) read() (parser.DTuple, error) {
+	for e.file < len(e.source.URLs) {
+		if e.body == nil {
+			if err := e.open(); err != nil {
+				return nil, err
+			}
+		}
+		fields, err := e.reader.Read()
+		if err == io.EOF {
+			e.close()
+			e.file++
+			continue
+		}
+		u := e.source.URLs[e.file]
+		if err != nil {
+			e.close()
+			return nil, fmt.Errorf("%s: %s", u, err)
+		}
+		e.record++
+		row, err := convertRecord(e.cols, fields)
+		if err != nil {
+			e.close()
+			return nil, fmt.Errorf("%s: record %d: %s", u, e.record, err)
+		}
+		return row, nil
+	}
+	return nil, nil
+}
+
+func (e *externalScan) open() error {
+	u := e.source.URLs[e.file]
+	resp, err := externalClient.Get(u)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("%s: %s", u, resp.Status)
+	}
+	e.body = resp.Body
+	e.reader = newCSVReader(resp.Body, e.source.Delimiter, e.source.Comment, e.cols)
+	e.record = 0
+	return nil
+}
+
+// close closes the body of the file being read, if any. Plan nodes aren't
+// closed, so the body of a file is left open when a scan stops before its
+// end, e.g. because of a LIMIT, until the server closes the connection.
+func (e *externalScan) close() {
+	if e.body != nil {
+		e.body.Close()
+		e.body = nil
+		e.reader = nil
+	}
+}
+
+// nextExternal advances the scan of an external table to the next row
+// matching the filter.
+func (n *scanNode) nextExternal() bool {
+	if n.explain == explainDebug {
+		n.err = fmt.Errorf("EXPLAIN (DEBUG) is not supported for external table %q", n.desc.Name)
+		return false
+	}
+	if n.external == nil {
+		n.external = &externalScan{source: n.desc.External, cols: n.desc.Columns}
+	}
+	for {
+		var row parser.DTuple
+		if row, n.err = n.external.read(); n.err != nil || row == nil {
+			return false
+		}
+		for i, col := range n.desc.Columns {
+			if qval, ok := n.qvals[col.ID]; ok {
+				qval.datum = row[i]
+			}
+		}
+		output := n.filterRow()
+		if n.err != nil {
+			return false
+		}
+		if output {
+			n.renderRow()
+			return n.err == nil
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestExternalTable verifies that the rows of an external table are read
+// from its files on every scan and that the table cannot be modified.
+func TestExternalTable(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	var mu sync.Mutex
+	files := map[string]string{
+		"/a.csv": "1|Berlin\n# comment\n2|Paris\n3|\n",
+		"/b.csv": "4|Rome\n",
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		data, ok := files[r.URL.Path]
+		mu.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(data))
+	}))
+	defer ts.Close()
+
+	if _, err := sqlDB.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`
+CREATE EXTERNAL TABLE t.cities (id INT PRIMARY KEY, name STRING)
+CSV DATA ($1, $2) WITH delimiter = '|', comment = '#'`,
+		ts.URL+"/a.csv", ts.URL+"/b.csv",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := sqlDB.Query(`SELECT name FROM t.cities WHERE id > 1 AND name != '' ORDER BY name DESC`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"Rome", "Paris"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v; got %v", expected, names)
+	}
+
+	// Changes to the files are visible to the next scan.
+	mu.Lock()
+	files["/b.csv"] = "4|Rome\n5|Oslo\n"
+	mu.Unlock()
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.cities`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Errorf("expected 5 rows; got %d", count)
+	}
+
+	for _, stmt := range []string{
+		`INSERT INTO t.cities VALUES (6, 'Madrid')`,
+		`UPDATE t.cities SET name = 'Lisbon' WHERE id = 1`,
+		`DELETE FROM t.cities`,
+		`CREATE INDEX foo ON t.cities (name)`,
+	} {
+		if _, err := sqlDB.Exec(stmt); !testutils.IsError(err, "is an external table and is read-only") {
+			t.Errorf("%s: expected read-only error; got %v", stmt, err)
+		}
+	}
+
+	if _, err := sqlDB.Exec(
+		`CREATE EXTERNAL TABLE t.missing (id INT PRIMARY KEY) CSV DATA ($1)`, ts.URL+"/missing.csv",
+	); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`SELECT * FROM t.missing`); !testutils.IsError(err, "404 Not Found") {
+		t.Errorf("expected not found error; got %v", err)
+	}
+	if _, err := sqlDB.Exec(
+		`CREATE EXTERNAL TABLE t.local (id INT PRIMARY KEY) CSV DATA ('/tmp/a.csv')`,
+	); !testutils.IsError(err, "must use http or https") {
+		t.Errorf("expected scheme error; got %v", err)
+	}
+}
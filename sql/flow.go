@@ -0,0 +1,779 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	gorpc "net/rpc"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+// A distributed query ("flow") is planned on the gateway node which received
+// the statement. If the session has DistSQL enabled and the plan consists of
+// a scan of a single index, the spans of the scan are partitioned by the
+// ranges holding them and the filter and render expressions are shipped to a
+// node holding a replica of each range. The rows, or partial aggregates if all
+// aggregations can be computed in parts, are streamed back to the gateway,
+// which consumes the partitions in order so that the ordering of the scan is
+// preserved. Rows are returned in batches of flowBatchSize rows; the gateway
+// requests the next batch of a partition as soon as it receives the current
+// one, so that remote nodes produce rows while the gateway consumes them.
+//
+// Remote flows read at the timestamp of the gateway's transaction. Any error
+// encountered while executing a flow remotely causes the gateway to execute
+// the partition itself, so that errors (such as transaction restarts) are
+// handled by the gateway's transaction.
+
+const (
+	// flowMethod is the RPC method name for executing a flow.
+	flowMethod = "SQL.Flow"
+
+	// flowConnectTimeout is the duration to wait for a healthy connection to
+	// a remote node before executing its flows on the gateway instead.
+	flowConnectTimeout = time.Second
+
+	// rangeLookupBatchSize is the number of range descriptors read at once
+	// when partitioning the spans of a flow.
+	rangeLookupBatchSize = 100
+
+	// flowBatchSize is the number of rows returned at once by a remote flow.
+	flowBatchSize = 1000
+
+	// flowKVBatchSize is the number of key/value pairs read at once by a flow
+	// which returns its rows in batches.
+	flowKVBatchSize = 1000
+)
+
+// flowContext holds the dependencies of the gateway and remote sides of
+// distributed query execution.
+type flowContext struct {
+	db         client.DB
+	gossip     *gossip.Gossip
+	rpcContext *rpc.Context
+	nodeID     roachpb.NodeID
+}
+
+// A flowPartition is a set of consecutive spans whose rows are produced by
+// the flow on a single node.
+type flowPartition struct {
+	nodeID roachpb.NodeID
+	spans  []span
+}
+
+// distribute returns a flowNode executing the supplied plan on the nodes
+// holding its data, or the plan itself if it cannot be distributed or would
+// not benefit from it.
+func (p *planner) distribute(scan *scanNode, group *groupNode, plan planNode) (planNode, error) {
+	if !p.session.DistSQL || p.flows == nil || p.flows.rpcContext == nil {
+		return plan, nil
+	}
+	if plan != planNode(scan) || scan.desc == nil || scan.desc.External != nil || scan.reverse || scan.explain != explainNone {
+		return plan, nil
+	}
+	if p.txn == nil || p.txn.Proto.Writing {
+		return plan, nil
+	}
+	for _, s := range scan.spans {
+		if s.count != 0 {
+			return plan, nil
+		}
+	}
+
+	req := FlowRequest{
+		Table:   *scan.desc,
+		IndexID: scan.index.ID,
+	}
+	if scan.filter != nil {
+		if !isDistributable(scan.filter) {
+			return plan, nil
+		}
+		req.Filter = scan.filter.String()
+	}
+	for _, r := range scan.render {
+		if !isDistributable(r) {
+			return plan, nil
+		}
+		req.Render = append(req.Render, r.String())
+	}
+	pushAggregates := group != nil
+	if group != nil {
+		for _, f := range group.funcs {
+			if !isPartialAggregate(f) {
+				pushAggregates = false
+				break
+			}
+		}
+	}
+
+	spans := scan.spans
+	if len(spans) == 0 {
+		start := roachpb.Key(MakeIndexKeyPrefix(scan.desc.ID, scan.index.ID))
+		spans = []span{{start: start, end: start.PrefixEnd()}}
+	}
+	partitions, err := p.flows.partition(scan, spans)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitions) == 1 && partitions[0].nodeID == p.flows.nodeID {
+		return plan, nil
+	}
+
+	if pushAggregates {
+		for _, f := range group.funcs {
+			name := strings.ToLower(string(f.val.expr.Name.Base))
+			req.Aggregates = append(req.Aggregates, name)
+			if _, ok := f.impl.(*countAggregate); ok {
+				// The partial counts need to be added up.
+				f.impl = &countPartialsAggregate{}
+			}
+		}
+	}
+	return &flowNode{
+		planner:    p,
+		scan:       scan,
+		req:        req,
+		partitions: partitions,
+	}, nil
+}
+
+// isPartialAggregate returns true if the aggregate function can be computed
+// by combining the results of the function applied to parts of the input.
+func isPartialAggregate(f *aggregateFunc) bool {
+	if f.seen != nil {
+		return false
+	}
+	switch f.impl.(type) {
+	case *countAggregate, *maxAggregate, *minAggregate, *sumAggregate:
+		return true
+	}
+	return false
+}
+
+type distributableVisitor struct {
+	ok bool
+}
+
+var _ parser.Visitor = &distributableVisitor{}
+
+func (v *distributableVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser.Expr) {
+	if !pre || !v.ok {
+		return nil, expr
+	}
+	switch t := expr.(type) {
+	case *qvalue:
+		// The column name needs to be parsed back into a reference to the
+		// column on the remote node.
+		e, err := parser.ParseExpr(t.col.Name, parser.Traditional)
+		if _, ok := e.(*parser.QualifiedName); err != nil || !ok {
+			v.ok = false
+		}
+		return nil, expr
+	case *parser.AndExpr, *parser.OrExpr, *parser.NotExpr, *parser.ParenExpr,
+		*parser.ComparisonExpr, *parser.RangeCond, *parser.IsOfTypeExpr,
+		*parser.BinaryExpr, *parser.UnaryExpr, parser.Tuple, parser.DTuple,
+		parser.DBool, parser.DInt, parser.DString:
+		return v, expr
+	}
+	if expr != parser.DNull {
+		v.ok = false
+	}
+	return nil, expr
+}
+
+// isDistributable returns true if the expression can be shipped to a remote
+// node as a string and evaluated there with the same result. Expressions
+// which depend on the evaluation context of the gateway, such as function
+// calls and subqueries, are not distributable.
+func isDistributable(expr parser.Expr) bool {
+	v := distributableVisitor{ok: true}
+	_ = parser.WalkExpr(&v, expr)
+	return v.ok
+}
+
+// partition splits the spans of the scan at the boundaries of the ranges
+// holding them and assigns each piece to a node holding a replica of its
+// range, preferring the local node. Consecutive pieces assigned to the same
+// node are combined into a single partition.
+func (fc *flowContext) partition(scan *scanNode, spans []span) ([]flowPartition, error) {
+	descs, err := fc.lookupRanges(spans[0].start, spans[len(spans)-1].end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the keys at which the spans need to be cut, along with the node
+	// producing the rows before each cut. A nil cut key stands for the end of
+	// the key space.
+	type cut struct {
+		key    roachpb.Key
+		nodeID roachpb.NodeID
+	}
+	var cuts []cut
+	for _, desc := range descs {
+		key, err := rowBoundary(scan, desc.EndKey.AsRawKey())
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			// The range ends in the middle of a row which can't be decoded. The rows
+			// up to the next range boundary are produced by the node of the next
+			// range.
+			continue
+		}
+		cuts = append(cuts, cut{key: key, nodeID: fc.chooseNode(desc)})
+	}
+	cuts = append(cuts, cut{nodeID: fc.nodeID})
+
+	var partitions []flowPartition
+	add := func(nodeID roachpb.NodeID, s span) {
+		if n := len(partitions); n > 0 && partitions[n-1].nodeID == nodeID {
+			partitions[n-1].spans = append(partitions[n-1].spans, s)
+			return
+		}
+		partitions = append(partitions, flowPartition{nodeID: nodeID, spans: []span{s}})
+	}
+	i := 0
+	for _, s := range spans {
+		start := s.start
+		for bytes.Compare(start, s.end) < 0 {
+			for cuts[i].key != nil && bytes.Compare(cuts[i].key, start) <= 0 {
+				i++
+			}
+			end := s.end
+			if cuts[i].key != nil && bytes.Compare(cuts[i].key, end) < 0 {
+				end = cuts[i].key
+			}
+			add(cuts[i].nodeID, span{start: start, end: end})
+			start = end
+		}
+	}
+	return partitions, nil
+}
+
+// lookupRanges returns the descriptors of the ranges holding the keys between
+// start and end, in key order.
+func (fc *flowContext) lookupRanges(start, end roachpb.Key) ([]roachpb.RangeDescriptor, error) {
+	endKey := keys.Addr(end)
+	metaKey := keys.RangeMetaKey(keys.Addr(start.Next()))
+	var descs []roachpb.RangeDescriptor
+	for {
+		rows, err := fc.db.Scan(metaKey, keys.Meta2Prefix.PrefixEnd(), rangeLookupBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			var desc roachpb.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return nil, err
+			}
+			descs = append(descs, desc)
+			if !desc.EndKey.Less(endKey) {
+				return descs, nil
+			}
+		}
+		if len(rows) < rangeLookupBatchSize {
+			return descs, nil
+		}
+		metaKey = roachpb.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// rowBoundary returns the first key of the row containing the given key of
+// the scanned index, so that the rows of the primary index, which span
+// multiple keys, are never split between partitions. Returns nil if the key
+// is within the index but can't be decoded.
+func rowBoundary(scan *scanNode, key roachpb.Key) (roachpb.Key, error) {
+	if scan.isSecondaryIndex ||
+		!bytes.HasPrefix(key, MakeIndexKeyPrefix(scan.desc.ID, scan.index.ID)) {
+		// Every key of a secondary index is a row of its own.
+		return key, nil
+	}
+	valTypes, err := makeKeyVals(scan.desc, scan.index.ColumnIDs)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]parser.Datum, len(valTypes))
+	remaining, err := decodeIndexKey(scan.desc, *scan.index, valTypes, vals, key)
+	if err != nil {
+		return nil, nil
+	}
+	return key[:len(key)-len(remaining)], nil
+}
+
+// chooseNode returns the node which should produce the rows of the given
+// range: the local node if it holds a replica, otherwise the node holding the
+// first replica.
+func (fc *flowContext) chooseNode(desc roachpb.RangeDescriptor) roachpb.NodeID {
+	if len(desc.Replicas) == 0 {
+		return fc.nodeID
+	}
+	for _, r := range desc.Replicas {
+		if r.NodeID == fc.nodeID {
+			return fc.nodeID
+		}
+	}
+	return desc.Replicas[0].NodeID
+}
+
+// A flowNode produces the rows of a scan by executing it in partitions, some
+// of them on remote nodes.
+type flowNode struct {
+	planner    *planner
+	scan       *scanNode
+	req        FlowRequest
+	partitions []flowPartition
+	clients    []*rpc.Client // the client of the remote flow of each partition, if any
+	calls      []*gorpc.Call // the outstanding batch request of each partition, if any
+	started    bool
+	partIdx    int
+	rows       []parser.DTuple
+	row        parser.DTuple
+	err        error
+}
+
+func (n *flowNode) Columns() []string {
+	return n.scan.Columns()
+}
+
+func (n *flowNode) Ordering() ([]int, int) {
+	return n.scan.Ordering()
+}
+
+func (n *flowNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *flowNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.started {
+		n.started = true
+		if n.err = n.start(); n.err != nil {
+			return false
+		}
+	}
+	for len(n.rows) == 0 {
+		if n.partIdx == len(n.partitions) {
+			return false
+		}
+		var done bool
+		n.rows, done, n.err = n.nextBatch(n.partIdx)
+		if n.err != nil {
+			return false
+		}
+		if done {
+			n.partIdx++
+		}
+	}
+	n.row = n.rows[0]
+	n.rows = n.rows[1:]
+	return true
+}
+
+func (n *flowNode) Err() error {
+	return n.err
+}
+
+func (n *flowNode) ExplainPlan() (name, description string, children []planNode) {
+	strs := make([]string, 0, len(n.partitions))
+	for _, part := range n.partitions {
+		strs = append(strs, fmt.Sprintf("n%d %s", part.nodeID, prettySpans(part.spans, 2)))
+	}
+	description = strings.Join(strs, "; ")
+	if len(n.req.Aggregates) > 0 {
+		description += fmt.Sprintf(" (partial %s)", strings.Join(n.req.Aggregates, ", "))
+	}
+	return "flow", description, []planNode{n.scan}
+}
+
+// start dispatches the remote flows. If the transaction has not performed
+// any reads yet, it is not assigned a timestamp: the first partition is then
+// executed locally beforehand so that all flows read at the same timestamp.
+func (n *flowNode) start() error {
+	p := n.planner
+	if len(p.txn.Proto.ID) == 0 {
+		var err error
+		if n.rows, err = n.runLocal(makeRoachSpans(n.partitions[0].spans)); err != nil {
+			return err
+		}
+		n.partIdx = 1
+	}
+
+	session, err := proto.Marshal(&p.session)
+	if err != nil {
+		return err
+	}
+	n.req.Txn = p.txn.Proto
+	n.req.Session = session
+	n.req.TxnTimestamp = driver.Timestamp(p.evalCtx.TxnTimestamp.Time)
+	n.req.StmtTimestamp = driver.Timestamp(p.evalCtx.StmtTimestamp.Time)
+	if len(n.req.Aggregates) == 0 {
+		n.req.MaxRows = flowBatchSize
+	}
+
+	n.clients = make([]*rpc.Client, len(n.partitions))
+	n.calls = make([]*gorpc.Call, len(n.partitions))
+	clients := map[roachpb.NodeID]*rpc.Client{}
+	for i := n.partIdx; i < len(n.partitions); i++ {
+		nodeID := n.partitions[i].nodeID
+		if nodeID == p.flows.nodeID {
+			continue
+		}
+		client, ok := clients[nodeID]
+		if !ok {
+			client = p.flows.connect(nodeID)
+			clients[nodeID] = client
+		}
+		if client == nil {
+			continue
+		}
+		n.clients[i] = client
+		n.requestBatch(i, makeRoachSpans(n.partitions[i].spans))
+	}
+	return nil
+}
+
+// requestBatch requests the next batch of rows of the i-th partition, which
+// starts at the given spans, from its remote flow.
+func (n *flowNode) requestBatch(i int, spans []roachpb.Span) {
+	req := n.req
+	req.Spans = spans
+	n.calls[i] = n.clients[i].Go(flowMethod, &req, &FlowResponse{}, make(chan *gorpc.Call, 1))
+}
+
+// connect returns a healthy RPC client for the given node, or nil if there is
+// none.
+func (fc *flowContext) connect(nodeID roachpb.NodeID) *rpc.Client {
+	addr, err := fc.gossip.GetNodeIDAddress(nodeID)
+	if err != nil {
+		log.Warningf("could not get address for node %d: %s", nodeID, err)
+		return nil
+	}
+	client := rpc.NewClient(addr, fc.rpcContext)
+	select {
+	case <-client.Healthy():
+		return client
+	case <-client.Closed:
+	case <-time.After(flowConnectTimeout):
+	}
+	if log.V(1) {
+		log.Infof("no healthy connection to node %d; executing its flows locally", nodeID)
+	}
+	return nil
+}
+
+// nextBatch returns the next batch of rows of the i-th partition, waiting for
+// its remote flow if there is one, and whether the partition is done. The
+// following batch is requested before the rows are returned. If the remote
+// flow fails, the remainder of the partition is executed on the gateway.
+func (n *flowNode) nextBatch(i int) ([]parser.DTuple, bool, error) {
+	spans := makeRoachSpans(n.partitions[i].spans)
+	if call := n.calls[i]; call != nil {
+		<-call.Done
+		n.calls[i] = nil
+		spans = call.Args.(*FlowRequest).Spans
+		resp := call.Reply.(*FlowResponse)
+		err := call.Error
+		if err == nil {
+			err = resp.Error.GoError()
+		}
+		if err == nil {
+			if len(resp.ResumeSpans) > 0 {
+				n.requestBatch(i, resp.ResumeSpans)
+			}
+			rows := make([]parser.DTuple, 0, len(resp.Rows))
+			for _, r := range resp.Rows {
+				row := make(parser.DTuple, 0, len(r.Values))
+				for _, d := range r.Values {
+					row = append(row, makeDatum(d))
+				}
+				rows = append(rows, row)
+			}
+			return rows, n.calls[i] == nil, nil
+		}
+		if log.V(1) {
+			log.Infof("flow on node %d failed; executing locally: %s", n.partitions[i].nodeID, err)
+		}
+	}
+	rows, err := n.runLocal(spans)
+	return rows, true, err
+}
+
+// runLocal executes the flow on the given spans on the gateway.
+func (n *flowNode) runLocal(spans []roachpb.Span) ([]parser.DTuple, error) {
+	req := n.req
+	req.Spans = spans
+	req.MaxRows = 0
+	rows, _, err := n.planner.runFlow(&req)
+	return rows, err
+}
+
+func makeRoachSpans(spans []span) []roachpb.Span {
+	result := make([]roachpb.Span, 0, len(spans))
+	for _, s := range spans {
+		result = append(result, roachpb.Span{Key: s.start, EndKey: s.end})
+	}
+	return result
+}
+
+// executeFlow executes a flow on behalf of a gateway node.
+func (e *Executor) executeFlow(args *FlowRequest) *FlowResponse {
+	p := &planner{
+		user: security.NodeUser,
+		evalCtx: parser.EvalContext{
+			NodeID:        e.nodeID,
+			ReCache:       e.reCache,
+			StmtTimestamp: parser.DTimestamp{Time: args.StmtTimestamp.GoTime()},
+		},
+		leaseMgr:     e.leaseMgr,
+		systemConfig: e.getSystemConfig(),
+	}
+	reply := &FlowResponse{}
+	if err := proto.Unmarshal(args.Session, &p.session); err != nil {
+		reply.Error = roachpb.NewError(err)
+		return reply
+	}
+	txn := client.NewTxn(e.db)
+	txn.Proto = args.Txn
+	p.setTxn(txn, args.TxnTimestamp.GoTime())
+	p.evalCtx.GetLocation = p.session.getLocation
+
+	rows, resume, err := p.runFlow(args)
+	if err != nil {
+		reply.Error = roachpb.NewError(err)
+		return reply
+	}
+	reply.ResumeSpans = resume
+	reply.Rows = make([]driver.Response_Result_Rows_Row, 0, len(rows))
+	for _, row := range rows {
+		r := driver.Response_Result_Rows_Row{Values: make([]driver.Datum, 0, len(row))}
+		for _, d := range row {
+			wireVal, err := makeDriverDatum(d)
+			if err != nil {
+				return &FlowResponse{Error: roachpb.NewError(err)}
+			}
+			r.Values = append(r.Values, wireVal)
+		}
+		reply.Rows = append(reply.Rows, r)
+	}
+	return reply
+}
+
+// runFlow executes the flow described by the request using the planner's
+// transaction and returns the resulting rows, along with the spans remaining
+// to be scanned if the flow returned early because of req.MaxRows.
+func (p *planner) runFlow(req *FlowRequest) ([]parser.DTuple, []roachpb.Span, error) {
+	scan, err := p.makeFlowScan(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(req.Aggregates) == 0 {
+		if req.MaxRows > 0 {
+			return scanBatch(scan, req.MaxRows)
+		}
+		var rows []parser.DTuple
+		for scan.Next() {
+			rows = append(rows, append(parser.DTuple(nil), scan.Values()...))
+		}
+		return rows, nil, scan.Err()
+	}
+
+	if len(req.Aggregates) != len(req.Render) {
+		return nil, nil, util.Errorf("%d aggregates for %d render expressions", len(req.Aggregates), len(req.Render))
+	}
+	funcs := make([]aggregateImpl, 0, len(req.Aggregates))
+	for _, name := range req.Aggregates {
+		impl, ok := aggregates[name]
+		if !ok {
+			return nil, nil, util.Errorf("unknown aggregate function: %s", name)
+		}
+		funcs = append(funcs, impl.New())
+	}
+	for scan.Next() {
+		for i, d := range scan.Values() {
+			if err := funcs[i].Add(d); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, nil, err
+	}
+	row := make(parser.DTuple, 0, len(funcs))
+	for _, f := range funcs {
+		d, err := f.Result()
+		if err != nil {
+			return nil, nil, err
+		}
+		row = append(row, d)
+	}
+	return []parser.DTuple{row}, nil, nil
+}
+
+// scanBatch returns the rows of the scan until at least maxRows rows have
+// been produced, along with the spans remaining to be scanned. Key/value
+// pairs are read flowKVBatchSize at a time, extended to the end of the last
+// row read, so that only about as much data is read as is returned.
+func scanBatch(scan *scanNode, maxRows int64) ([]parser.DTuple, []roachpb.Span, error) {
+	var rows []parser.DTuple
+	spans := scan.spans
+	for len(spans) > 0 {
+		s := spans[0]
+		spans = spans[1:]
+		scan.spans = []span{{start: s.start, end: s.end, count: flowKVBatchSize}}
+		scan.kvs, scan.kvIndex = nil, 0
+		if !scan.initScan() {
+			return nil, nil, scan.err
+		}
+		if scan.kvs == nil {
+			scan.kvs = []client.KeyValue{}
+		}
+
+		// next is the key at which the rest of the span starts.
+		next := s.end
+		if len(scan.kvs) == flowKVBatchSize {
+			// The span has more keys than were read. Read the remaining keys of
+			// the last row, which may have been cut off, and scan the rest of the
+			// span later.
+			last := roachpb.Key(scan.kvs[len(scan.kvs)-1].Key)
+			next = last.Next()
+			if !scan.isSecondaryIndex {
+				rowKey, err := rowBoundary(scan, last)
+				if err != nil {
+					return nil, nil, err
+				}
+				if rowKey == nil {
+					return nil, nil, util.Errorf("unable to decode row of key %s", last)
+				}
+				if next = rowKey.PrefixEnd(); bytes.Compare(next, s.end) > 0 {
+					next = s.end
+				}
+				rest, err := scan.txn.Scan(last.Next(), next, 0)
+				if err != nil {
+					return nil, nil, err
+				}
+				scan.kvs = append(scan.kvs, rest...)
+			}
+		}
+
+		for scan.Next() {
+			rows = append(rows, append(parser.DTuple(nil), scan.Values()...))
+			if int64(len(rows)) < maxRows {
+				continue
+			}
+			if scan.kvIndex < len(scan.kvs) {
+				// Resume at the first key of the next row.
+				next = scan.kvs[scan.kvIndex].Key
+			}
+			break
+		}
+		if err := scan.Err(); err != nil {
+			return nil, nil, err
+		}
+		if bytes.Compare(next, s.end) < 0 {
+			spans = append([]span{{start: next, end: s.end}}, spans...)
+		}
+		if int64(len(rows)) >= maxRows {
+			return rows, makeRoachSpans(spans), nil
+		}
+	}
+	return rows, nil, nil
+}
+
+// makeFlowScan creates the scanNode executing the flow described by the
+// request.
+func (p *planner) makeFlowScan(req *FlowRequest) (*scanNode, error) {
+	scan := &scanNode{planner: p, txn: p.txn, desc: &req.Table}
+	if req.IndexID == scan.desc.PrimaryIndex.ID {
+		scan.initIndex(&scan.desc.PrimaryIndex)
+	} else {
+		for i := range scan.desc.Indexes {
+			if scan.desc.Indexes[i].ID == req.IndexID {
+				scan.initIndex(&scan.desc.Indexes[i])
+				break
+			}
+		}
+		if scan.index == nil {
+			return nil, util.Errorf("%s: index %d not found", scan.desc.Name, req.IndexID)
+		}
+	}
+	for _, s := range req.Spans {
+		scan.spans = append(scan.spans, span{start: s.Key, end: s.EndKey})
+	}
+
+	if req.Filter != "" {
+		expr, err := parser.ParseExpr(req.Filter, parser.Traditional)
+		if err != nil {
+			return nil, err
+		}
+		if err := scan.initWhere(&parser.Where{Expr: expr}); err != nil {
+			return nil, err
+		}
+	}
+	targets := make(parser.SelectExprs, 0, len(req.Render))
+	for _, r := range req.Render {
+		expr, err := parser.ParseExpr(r, parser.Traditional)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, parser.SelectExpr{Expr: expr})
+	}
+	if err := scan.initTargets(targets); err != nil {
+		return nil, err
+	}
+	scan.initOrdering(0)
+	return scan, nil
+}
+
+// countPartialsAggregate computes a count from the partial counts of a
+// distributed aggregation.
+type countPartialsAggregate struct {
+	count parser.DInt
+}
+
+var _ aggregateImpl = &countPartialsAggregate{}
+
+func (a *countPartialsAggregate) New() aggregateImpl {
+	return &countPartialsAggregate{}
+}
+
+func (a *countPartialsAggregate) Add(datum parser.Datum) error {
+	count, ok := datum.(parser.DInt)
+	if !ok {
+		return fmt.Errorf("unexpected partial COUNT type: %s", datum.Type())
+	}
+	a.count += count
+	return nil
+}
+
+func (a *countPartialsAggregate) Result() (parser.Datum, error) {
+	return a.count, nil
+}
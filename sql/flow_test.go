@@ -0,0 +1,72 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestIsDistributable(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		expr     string
+		expected bool
+	}{
+		{`a`, true},
+		{`a > 1 AND b < 2`, true},
+		{`a IN (1, 2, 3)`, true},
+		{`NOT c OR d`, true},
+		{`a IS NULL`, true},
+		{`a + b * 2`, true},
+		{`i LIKE 'foo%'`, true},
+		{`h > 1.5`, false},
+		{`LENGTH(i) > 1`, false},
+	}
+	for _, d := range testData {
+		expr, _ := parseAndNormalizeExpr(t, d.expr)
+		if ok := isDistributable(expr); ok != d.expected {
+			t.Errorf("%s: expected %t, but found %t", d.expr, d.expected, ok)
+		}
+	}
+}
+
+func TestCountPartialsAggregate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	a := (&countPartialsAggregate{}).New()
+	if d, err := a.Result(); err != nil {
+		t.Fatal(err)
+	} else if d != parser.DInt(0) {
+		t.Fatalf("expected 0, but found %s", d)
+	}
+	for _, count := range []parser.DInt{2, 0, 3} {
+		if err := a.Add(count); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d, err := a.Result(); err != nil {
+		t.Fatal(err)
+	} else if d != parser.DInt(5) {
+		t.Fatalf("expected 5, but found %s", d)
+	}
+	if err := a.Add(parser.DNull); err == nil {
+		t.Fatal("expected error adding NULL partial count")
+	}
+}
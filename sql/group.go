@@ -83,6 +83,7 @@ func (p *planner) groupBy(n *parser.Select, s *scanNode) (*groupNode, error) {
 	s.columns = make([]string, 0, len(funcs))
 	s.render = make([]parser.Expr, 0, len(funcs))
 	for _, f := range funcs {
+		f.mem = &p.stmtMemory
 		if len(f.val.expr.Exprs) != 1 {
 			panic(fmt.Sprintf("%s has %d arguments (expected 1)", f.val.expr.Name, len(f.val.expr.Exprs)))
 		}
@@ -338,6 +339,7 @@ type aggregateFunc struct {
 	val  aggregateValue
 	impl aggregateImpl
 	seen map[string]struct{}
+	mem  *memoryAccount // accounts for the values in seen; may be nil
 }
 
 func (a *aggregateFunc) Add(d parser.Datum) error {
@@ -351,6 +353,11 @@ func (a *aggregateFunc) Add(d parser.Datum) error {
 			// skip
 			return nil
 		}
+		if a.mem != nil {
+			if err := a.mem.grow(int64(len(e))+sizeOfSeenEntry, "group"); err != nil {
+				return err
+			}
+		}
 		a.seen[e] = struct{}{}
 	}
 	return a.impl.Add(d)
@@ -0,0 +1,487 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	gorpc "net/rpc"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// importMethod is the RPC method name for importing files on a node.
+	importMethod = "SQL.Import"
+
+	// importChunkSize is the number of rows whose KVs are ingested with a
+	// single request.
+	importChunkSize = 500
+
+	// importConcurrency is the number of files a node converts at once.
+	importConcurrency = 4
+)
+
+// Import creates a table and fills it with the rows of delimited text files.
+// The table is created in a transaction of its own in the ADD state, which
+// hides it from all statements until it has been filled and is made public.
+// Files given by http(s) URLs are divided among the nodes of the cluster,
+// while files given by paths are read by the node executing the statement.
+// The rows of each file are converted into KVs using the table descriptor
+// and ingested by the ranges in sorted chunks, bypassing transactions. If the
+// import fails, the table and the rows written so far are removed again.
+// Privileges: "root" user.
+//   Notes: postgres uses COPY FROM; mysql uses LOAD DATA INFILE.
+func (p *planner) Import(n *parser.Import) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to import", security.RootUser)
+	}
+	files := make([]string, len(n.Files))
+	for i, expr := range n.Files {
+		d, err := expr.Eval(p.evalCtx)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := d.(parser.DString)
+		if !ok || s == "" {
+			return nil, fmt.Errorf("import file must be a non-empty string, not %s", d)
+		}
+		files[i] = string(s)
+	}
+	var req ImportRequest
+	var err error
+	if req.Delimiter, req.Comment, err = p.evalCSVOptions("import", n.Options); err != nil {
+		return nil, err
+	}
+
+	tableDesc, err := p.createImportTable(&parser.CreateTable{Table: n.Table, Defs: n.Defs})
+	if err != nil {
+		return nil, err
+	}
+	req.Table = *tableDesc
+
+	jobID, err := p.createJob("IMPORT", n.String())
+	if err == nil {
+		if err = p.importFiles(jobID, req, files); err == nil {
+			err = p.publishTable(tableDesc)
+		}
+		p.finishJob(jobID, err)
+	}
+	if err != nil {
+		p.dropUnpublishedTable(tableDesc)
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// createImportTable creates the table of an import in the ADD state and
+// commits it right away, so that the nodes converting the files can read
+// its descriptor while it stays invisible to statements.
+func (p *planner) createImportTable(n *parser.CreateTable) (*TableDescriptor, error) {
+	var desc *TableDescriptor
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		tp := planner{txn: txn, session: p.session, user: p.user, evalCtx: p.evalCtx}
+		var err error
+		desc, err = tp.createTable(n, TableDescriptor_ADD)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// publishTable makes a table created in the ADD state, such as the table of
+// a finished import or restore, public.
+func (p *planner) publishTable(tableDesc *TableDescriptor) error {
+	tableDesc.State = nil
+	p.hackNoteSchemaChange(tableDesc)
+	if err := tableDesc.Validate(); err != nil {
+		return err
+	}
+	return p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		return txn.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+	})
+}
+
+// dropUnpublishedTable removes the rows and the descriptor of a table
+// created in the ADD state, such as the table of a failed import or restore.
+func (p *planner) dropUnpublishedTable(tableDesc *TableDescriptor) {
+	prefix := roachpb.Key(keys.MakeTablePrefix(uint32(tableDesc.ID)))
+	if err := p.db.DelRange(prefix, prefix.PrefixEnd()); err != nil {
+		log.Warningf("unable to remove rows of unpublished table %s: %s", tableDesc.Name, err)
+	}
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		b := &client.Batch{}
+		b.Del(MakeDescMetadataKey(tableDesc.ID))
+		b.Del(tableKey{tableDesc.ParentID, tableDesc.Name}.Key())
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		log.Warningf("unable to remove unpublished table %s: %s", tableDesc.Name, err)
+	}
+}
+
+// evalCSVOptions evaluates the delimiter and comment options of a statement
+// reading delimited text files. The options name their single character;
+// the empty string stands for the default.
+func (p *planner) evalCSVOptions(stmt string, opts parser.KVOptions) (delimiter, comment string, err error) {
+	for _, opt := range opts {
+		d, err := opt.Value.Eval(p.evalCtx)
+		if err != nil {
+			return "", "", err
+		}
+		s, ok := d.(parser.DString)
+		if !ok || utf8.RuneCountInString(string(s)) != 1 {
+			return "", "", fmt.Errorf("%s option %s must be a single character, not %s", stmt, opt.Key, d)
+		}
+		switch strings.ToLower(string(opt.Key)) {
+		case "delimiter":
+			delimiter = string(s)
+		case "comment":
+			comment = string(s)
+		default:
+			return "", "", fmt.Errorf("unknown %s option %q", stmt, string(opt.Key))
+		}
+	}
+	return delimiter, comment, nil
+}
+
+// newCSVReader returns a reader of the records of delimited text with the
+// given delimiter and comment characters, each of which may be empty for
+// the default. Every record must hold a field for each column.
+func newCSVReader(r io.Reader, delimiter, comment string, cols []ColumnDescriptor) *csv.Reader {
+	cr := csv.NewReader(r)
+	if delimiter != "" {
+		cr.Comma, _ = utf8.DecodeRuneInString(delimiter)
+	}
+	if comment != "" {
+		cr.Comment, _ = utf8.DecodeRuneInString(comment)
+	}
+	cr.FieldsPerRecord = len(cols)
+	return cr
+}
+
+// importFiles divides the files given by URLs round-robin among the nodes
+// of the cluster and imports the files given by paths on this node, waiting
+// for all of them to be imported and recording the progress of the job as
+// nodes finish. The files of nodes which cannot be reached are imported
+// locally.
+func (p *planner) importFiles(jobID int64, req ImportRequest, files []string) error {
+	nodeIDs := p.importNodes()
+	gateway := -1
+	for i, nodeID := range nodeIDs {
+		if nodeID == p.flows.nodeID {
+			gateway = i
+		}
+	}
+	if gateway == -1 {
+		gateway = len(nodeIDs)
+		nodeIDs = append(nodeIDs, p.flows.nodeID)
+	}
+	parts := make([]ImportRequest, len(nodeIDs))
+	for i := range parts {
+		parts[i] = req
+		parts[i].Files = nil
+	}
+	var next int
+	for _, file := range files {
+		part := &parts[gateway]
+		if isImportURL(file) {
+			part = &parts[next%len(parts)]
+			next++
+		}
+		part.Files = append(part.Files, file)
+	}
+
+	calls := make([]*gorpc.Call, len(parts))
+	for i := range parts {
+		if len(parts[i].Files) == 0 || i == gateway || p.flows.rpcContext == nil {
+			continue
+		}
+		if client := p.flows.connect(nodeIDs[i]); client != nil {
+			calls[i] = client.Go(importMethod, &parts[i], &ImportResponse{}, make(chan *gorpc.Call, 1))
+		}
+	}
+
+	// All remote imports have to be waited for even after an error, so that
+	// no rows are written after they have been removed.
+	var firstErr error
+	var imported int
+	for i := range parts {
+		if len(parts[i].Files) == 0 {
+			continue
+		}
+		var err error
+		if call := calls[i]; call != nil {
+			<-call.Done
+			if err = call.Error; err == nil {
+				err = call.Reply.(*ImportResponse).Error.GoError()
+			}
+		} else if firstErr == nil {
+			_, err = importCSV(p.db, &parts[i])
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		imported += len(parts[i].Files)
+		p.updateJob(jobID, float64(imported)/float64(len(files)))
+	}
+	return firstErr
+}
+
+// importNodes returns the IDs of the nodes to import on, which are the nodes
+// with gossiped stores or just the local node if there are none.
+func (p *planner) importNodes() []roachpb.NodeID {
+	seen := map[roachpb.NodeID]struct{}{}
+	var nodeIDs []roachpb.NodeID
+	if p.stores != nil {
+		for _, desc := range p.stores.descriptors() {
+			if _, ok := seen[desc.Node.NodeID]; !ok {
+				seen[desc.Node.NodeID] = struct{}{}
+				nodeIDs = append(nodeIDs, desc.Node.NodeID)
+			}
+		}
+	}
+	if len(nodeIDs) == 0 {
+		return []roachpb.NodeID{p.flows.nodeID}
+	}
+	sort.Sort(roachpb.NodeIDSlice(nodeIDs))
+	return nodeIDs
+}
+
+// isImportURL returns whether an import file is given by an http(s) URL,
+// which every node can read, rather than by a path on the local node.
+func isImportURL(file string) bool {
+	return strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://")
+}
+
+// executeImport imports the files of the request on this node.
+func (e *Executor) executeImport(args *ImportRequest) *ImportResponse {
+	reply := &ImportResponse{}
+	rows, err := importCSV(&e.db, args)
+	reply.Rows = rows
+	if err != nil {
+		reply.Error = roachpb.NewError(err)
+	}
+	return reply
+}
+
+// importCSV converts the rows of the files of the request into KVs and
+// ingests them, importing up to importConcurrency files at once. It returns
+// the number of rows ingested.
+func importCSV(db *client.DB, req *ImportRequest) (int64, error) {
+	var mu sync.Mutex
+	var rows int64
+	var firstErr error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, importConcurrency)
+	for _, file := range req.Files {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(file string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			n, err := importFile(db, &req.Table, file, req.Delimiter, req.Comment)
+			mu.Lock()
+			defer mu.Unlock()
+			rows += n
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}(file)
+	}
+	wg.Wait()
+	return rows, firstErr
+}
+
+// importFile converts the rows of a file into KVs and ingests them in
+// chunks of importChunkSize rows. Each line of the file holds the values of
+// all columns of the table in order; empty fields of columns which are not
+// strings or bytes are NULL.
+func importFile(db *client.DB, tableDesc *TableDescriptor, file string, delimiter, comment string) (int64, error) {
+	f, err := openImportFile(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := newCSVReader(f, delimiter, comment, tableDesc.Columns)
+
+	ri := makeRowInserter(tableDesc, tableDesc.Columns)
+	var count, rows int64
+	var kvs kvCollector
+	flush := func() error {
+		if rows == 0 {
+			return nil
+		}
+		if err := ingestKVs(db, tableDesc, kvs); err != nil {
+			return err
+		}
+		count += rows
+		rows = 0
+		kvs = kvs[:0]
+		return nil
+	}
+
+	for record := 1; ; record++ {
+		fields, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("%s: %s", file, err)
+		}
+		row, err := convertRecord(tableDesc.Columns, fields)
+		if err != nil {
+			return count, fmt.Errorf("%s: record %d: %s", file, record, err)
+		}
+		if err := ri.insertRow(&kvs, row); err != nil {
+			return count, err
+		}
+		if rows++; rows == importChunkSize {
+			if err := flush(); err != nil {
+				return count, err
+			}
+		}
+	}
+	return count, flush()
+}
+
+// openImportFile opens an import file, which is either fetched from an
+// http(s) URL or read from a path on the local node.
+func openImportFile(file string) (io.ReadCloser, error) {
+	if !isImportURL(file) {
+		return os.Open(file)
+	}
+	resp, err := externalClient.Get(file)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", file, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// kvCollector collects the KVs written by a rowInserter, with the checksums
+// of their values initialized, instead of writing them.
+type kvCollector []roachpb.KeyValue
+
+var _ putter = &kvCollector{}
+
+// CPut implements the putter interface. Since the KVs of an import are
+// ingested, the expected value is ignored; ingestKVs detects existing keys.
+func (c *kvCollector) CPut(key, value, _ interface{}) {
+	kv, err := client.MarshalKeyValue(key, value)
+	if err != nil {
+		// The rowInserter only writes keys and values of the types accepted
+		// by client.Batch.
+		panic(err)
+	}
+	*c = append(*c, kv)
+}
+
+func (c kvCollector) Len() int           { return len(c) }
+func (c kvCollector) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c kvCollector) Less(i, j int) bool { return c[i].Key.Compare(c[j].Key) < 0 }
+
+// ingestKVs sorts the KVs of a chunk of rows and ingests them with a single
+// request spanning them, which each range of the span applies as a single
+// command. A key which occurs twice or which has already been ingested
+// violates a uniqueness constraint.
+func ingestKVs(db *client.DB, tableDesc *TableDescriptor, kvs kvCollector) error {
+	sort.Sort(kvs)
+	for i := 1; i < len(kvs); i++ {
+		if kvs[i-1].Key.Equal(kvs[i].Key) {
+			return uniquenessViolation(tableDesc, kvs[i].Key)
+		}
+	}
+	b := &client.Batch{}
+	b.InternalAddRequest(&roachpb.IngestRequest{
+		Span: roachpb.Span{
+			Key:    kvs[0].Key,
+			EndKey: kvs[len(kvs)-1].Key.Next(),
+		},
+		Rows: kvs,
+	})
+	br, err := db.RunWithResponse(b)
+	if err != nil {
+		return err
+	}
+	if existing := br.Responses[0].GetInner().(*roachpb.IngestResponse).ExistingKeys; len(existing) > 0 {
+		return uniquenessViolation(tableDesc, existing[0])
+	}
+	return nil
+}
+
+// convertRecord converts the fields of a record into the values of the
+// columns by casting them to the column types.
+func convertRecord(cols []ColumnDescriptor, fields []string) (parser.DTuple, error) {
+	row := make(parser.DTuple, len(cols))
+	for i, col := range cols {
+		if fields[i] == "" && col.Type.Kind != ColumnType_STRING && col.Type.Kind != ColumnType_BYTES {
+			row[i] = parser.DNull
+			continue
+		}
+		var typ parser.ColumnType
+		switch col.Type.Kind {
+		case ColumnType_BOOL:
+			typ = &parser.BoolType{}
+		case ColumnType_INT:
+			typ = &parser.IntType{}
+		case ColumnType_FLOAT:
+			typ = &parser.FloatType{}
+		case ColumnType_STRING:
+			typ = &parser.StringType{}
+		case ColumnType_BYTES:
+			typ = &parser.BytesType{}
+		case ColumnType_DATE:
+			typ = &parser.DateType{}
+		case ColumnType_TIMESTAMP:
+			typ = &parser.TimestampType{}
+		case ColumnType_INTERVAL:
+			typ = &parser.IntervalType{}
+		default:
+			return nil, fmt.Errorf("unsupported column type %s for column %q", col.Type.Kind, col.Name)
+		}
+		d, err := (&parser.CastExpr{Expr: parser.DString(fields[i]), Type: typ}).Eval(parser.EvalContext{})
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %s", col.Name, err)
+		}
+		row[i] = d
+	}
+	return row, nil
+}
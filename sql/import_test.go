@@ -0,0 +1,163 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestImport verifies that IMPORT creates a table holding the rows of the
+// imported files and that a failed import leaves no table behind.
+func TestImport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer config.TestingDisableTableSplits()()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	dir := util.CreateTempDir(t, "import")
+	defer util.CleanupDir(dir)
+	files := map[string]string{
+		"a.csv":    "a|1\n# comment\nb|\n",
+		"b.csv":    "c|3\n",
+		"dup.csv":  "a|1\na|2\n",
+		"dup2.csv": "a|2\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := sqlDB.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`
+IMPORT TABLE t.kv (k CHAR PRIMARY KEY, v INT, INDEX foo (v))
+CSV DATA ($1, $2) WITH delimiter = '|', comment = '#'`,
+		filepath.Join(dir, "a.csv"), filepath.Join(dir, "b.csv"),
+	); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := sqlDB.Query(`SELECT k, v FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var result [][]interface{}
+	for rows.Next() {
+		var k string
+		var v *int
+		if err := rows.Scan(&k, &v); err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, []interface{}{k, v != nil})
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]interface{}{{"a", true}, {"b", false}, {"c", true}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v; got %v", expected, result)
+	}
+	expectedKV := [][]interface{}{{"a", 1}, {"c", 3}}
+	if rows := queryKV(t, sqlDB, `SELECT k, v FROM t.kv@foo WHERE v > 0`); !reflect.DeepEqual(rows, expectedKV) {
+		t.Errorf("expected %v; got %v", expectedKV, rows)
+	}
+
+	// Duplicate keys fail the import, and the table is not created.
+	if _, err := sqlDB.Exec(
+		`IMPORT TABLE t.dup (k CHAR PRIMARY KEY, v INT) CSV DATA ($1) WITH delimiter = '|'`,
+		filepath.Join(dir, "dup.csv"),
+	); !testutils.IsError(err, "duplicate key value") {
+		t.Fatalf("expected duplicate key error; got %v", err)
+	}
+	if _, err := sqlDB.Exec(`SELECT * FROM t.dup`); !testutils.IsError(err, "does not exist") {
+		t.Fatalf("expected table not to exist; got %v", err)
+	}
+
+	// So do keys ingested by different chunks.
+	if _, err := sqlDB.Exec(
+		`IMPORT TABLE t.dup (k CHAR PRIMARY KEY, v INT) CSV DATA ($1, $2) WITH delimiter = '|'`,
+		filepath.Join(dir, "a.csv"), filepath.Join(dir, "dup2.csv"),
+	); !testutils.IsError(err, "duplicate key value") {
+		t.Fatalf("expected duplicate key error; got %v", err)
+	}
+	if _, err := sqlDB.Exec(`SELECT * FROM t.dup`); !testutils.IsError(err, "does not exist") {
+		t.Fatalf("expected table not to exist; got %v", err)
+	}
+
+	// Files are fetched from URLs, and the table stays invisible while it
+	// is being filled.
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte("x,7\n"))
+	}))
+	defer ts.Close()
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := sqlDB.Exec(`IMPORT TABLE t.web (k CHAR PRIMARY KEY, v INT) CSV DATA ($1)`, ts.URL+"/web.csv")
+		errCh <- err
+	}()
+	util.SucceedsWithin(t, 3*time.Second, func() error {
+		var count int
+		if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM system.namespace WHERE name = 'web'`).Scan(&count); err != nil {
+			return err
+		}
+		if count != 1 {
+			return util.Errorf("table web not created yet")
+		}
+		return nil
+	})
+	if _, err := sqlDB.Exec(`SELECT * FROM t.web`); !testutils.IsError(err, "does not exist") {
+		t.Fatalf("expected table being imported to be invisible; got %v", err)
+	}
+	close(release)
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+	expectedKV = [][]interface{}{{"x", 7}}
+	if rows := queryKV(t, sqlDB, `SELECT k, v FROM t.web`); !reflect.DeepEqual(rows, expectedKV) {
+		t.Errorf("expected %v; got %v", expectedKV, rows)
+	}
+
+	// All imports are recorded as jobs.
+	var succeeded, failed int
+	if err := sqlDB.QueryRow(
+		`SELECT COUNT(*) FROM system.jobs WHERE type = 'IMPORT' AND status = 'succeeded'`,
+	).Scan(&succeeded); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.QueryRow(
+		`SELECT COUNT(*) FROM system.jobs WHERE type = 'IMPORT' AND status = 'failed'`,
+	).Scan(&failed); err != nil {
+		t.Fatal(err)
+	}
+	if succeeded != 2 || failed != 2 {
+		t.Errorf("expected 2 succeeded and 2 failed imports; got %d and %d", succeeded, failed)
+	}
+}
@@ -41,6 +41,9 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if err := p.checkPrivilege(tableDesc, privilege.INSERT); err != nil {
 		return nil, err
@@ -71,12 +74,10 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	}
 
 	// Verify we have at least the columns that are part of the primary key.
-	primaryKeyCols := map[ColumnID]struct{}{}
 	for i, id := range tableDesc.PrimaryIndex.ColumnIDs {
 		if _, ok := colIDtoRowIndex[id]; !ok {
 			return nil, fmt.Errorf("missing %q primary key column", tableDesc.PrimaryIndex.ColumnNames[i])
 		}
-		primaryKeyCols[id] = struct{}{}
 	}
 
 	// Construct the default expressions. The returned slice will be nil if no
@@ -102,13 +103,12 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, fmt.Errorf("INSERT has more expressions than target columns: %d/%d", expressions, columns)
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
-
-	marshalled := make([]interface{}, len(cols))
+	ri := makeRowInserter(tableDesc, cols)
 
 	b := client.Batch{}
 	result := &valuesNode{}
+	// Writes to the zones table are recorded in the event log.
+	var zoneIDs []ID
 	for rows.Next() {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
@@ -128,90 +128,148 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 			rowVals = append(rowVals, d)
 		}
 
-		// Check to see if NULL is being inserted into any non-nullable column.
-		for _, col := range tableDesc.Columns {
-			if !col.Nullable {
-				if i, ok := colIDtoRowIndex[col.ID]; !ok || rowVals[i] == parser.DNull {
-					return nil, fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
-				}
-			}
+		if err := ri.insertRow(&b, rowVals); err != nil {
+			return nil, err
 		}
-
-		// Check that the row value types match the column types. This needs to
-		// happen before index encoding because certain datum types (i.e. tuple)
-		// cannot be used as index values.
-		for i, val := range rowVals {
-			// Make sure the value can be written to the column before proceeding.
-			var err error
-			if marshalled[i], err = marshalColumnValue(cols[i], val); err != nil {
-				return nil, err
+		if tableDesc.ID == ZonesTable.ID {
+			if id, ok := zoneConfigTarget(colIDtoRowIndex, rowVals); ok {
+				zoneIDs = append(zoneIDs, id)
 			}
 		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
-		if err != nil {
-			return nil, err
-		}
+	if IsSystemConfigID(tableDesc.GetID()) {
+		// Mark transaction as operating on the system DB.
+		p.txn.SetSystemDBTrigger()
+	}
+	if err := p.txn.Run(&b); err != nil {
+		return nil, convertBatchError(tableDesc, b, err)
+	}
 
-		// Write the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
-		if err != nil {
-			return nil, err
-		}
+	if err := p.logZoneConfigEvents(EventLogSetZoneConfig, zoneIDs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-		for _, secondaryIndexEntry := range secondaryIndexEntries {
-			if log.V(2) {
-				log.Infof("CPut %s -> %v", prettyKey(secondaryIndexEntry.key, 0),
-					secondaryIndexEntry.value)
+// rowInserter encodes rows of a table into the KV writes which insert them.
+type rowInserter struct {
+	tableDesc             *TableDescriptor
+	cols                  []ColumnDescriptor
+	colIDtoRowIndex       map[ColumnID]int
+	primaryKeyCols        map[ColumnID]struct{}
+	primaryIndexKeyPrefix []byte
+	marshalled            []interface{}
+}
+
+// makeRowInserter creates a rowInserter for rows holding the values of the
+// given columns of the table, which must include the primary key columns.
+func makeRowInserter(tableDesc *TableDescriptor, cols []ColumnDescriptor) rowInserter {
+	// Construct a map from column ID to the index the value appears at within a
+	// row.
+	colIDtoRowIndex := make(map[ColumnID]int, len(cols))
+	for i, c := range cols {
+		colIDtoRowIndex[c.ID] = i
+	}
+	primaryKeyCols := map[ColumnID]struct{}{}
+	for _, id := range tableDesc.PrimaryIndex.ColumnIDs {
+		primaryKeyCols[id] = struct{}{}
+	}
+	return rowInserter{
+		tableDesc:             tableDesc,
+		cols:                  cols,
+		colIDtoRowIndex:       colIDtoRowIndex,
+		primaryKeyCols:        primaryKeyCols,
+		primaryIndexKeyPrefix: MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID),
+		marshalled:            make([]interface{}, len(cols)),
+	}
+}
+
+// putter is the destination of the KV writes of a rowInserter, such as a
+// client.Batch.
+type putter interface {
+	CPut(key, value, expValue interface{})
+}
+
+// insertRow adds the writes inserting the row to the batch. The writes are
+// conditional puts which fail if the row or one of its unique index entries
+// already exists.
+func (ri *rowInserter) insertRow(b putter, rowVals parser.DTuple) error {
+	// Check to see if NULL is being inserted into any non-nullable column.
+	for _, col := range ri.tableDesc.Columns {
+		if !col.Nullable {
+			if i, ok := ri.colIDtoRowIndex[col.ID]; !ok || rowVals[i] == parser.DNull {
+				return fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
 			}
-			b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
 		}
+	}
 
-		// Write the row sentinel.
-		if log.V(2) {
-			log.Infof("CPut %s -> NULL", prettyKey(primaryIndexKey, 0))
+	// Check that the row value types match the column types. This needs to
+	// happen before index encoding because certain datum types (i.e. tuple)
+	// cannot be used as index values.
+	for i, val := range rowVals {
+		// Make sure the value can be written to the column before proceeding.
+		var err error
+		if ri.marshalled[i], err = marshalColumnValue(ri.cols[i], val); err != nil {
+			return err
 		}
-		b.CPut(primaryIndexKey, nil, nil)
-
-		// Write the row columns.
-		for i, val := range rowVals {
-			col := cols[i]
-			if _, ok := primaryKeyCols[col.ID]; ok {
-				// Skip primary key columns as their values are encoded in the row
-				// sentinel key which is guaranteed to exist for as long as the row
-				// exists.
-				continue
-			}
+	}
 
-			if marshalled[i] != nil {
-				// We only output non-NULL values. Non-existent column keys are
-				// considered NULL during scanning and the row sentinel ensures we know
-				// the row exists.
+	primaryIndexKey, _, err := encodeIndexKey(
+		ri.tableDesc.PrimaryIndex.ColumnIDs, ri.tableDesc.PrimaryIndex.ColumnDirections,
+		ri.colIDtoRowIndex, rowVals, ri.primaryIndexKeyPrefix)
+	if err != nil {
+		return err
+	}
 
-				key := MakeColumnKey(col.ID, primaryIndexKey)
-				if log.V(2) {
-					log.Infof("CPut %s -> %v", prettyKey(key, 0), val)
-				}
+	// Write the secondary indexes.
+	secondaryIndexEntries, err := encodeSecondaryIndexes(
+		ri.tableDesc.ID, ri.tableDesc.Indexes, ri.colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
 
-				b.CPut(key, marshalled[i], nil)
-			}
+	for _, secondaryIndexEntry := range secondaryIndexEntries {
+		if log.V(2) {
+			log.Infof("CPut %s -> %v", prettyKey(secondaryIndexEntry.key, 0),
+				secondaryIndexEntry.value)
 		}
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
 	}
 
-	if IsSystemID(tableDesc.GetID()) {
-		// Mark transaction as operating on the system DB.
-		p.txn.SetSystemDBTrigger()
-	}
-	if err := p.txn.Run(&b); err != nil {
-		return nil, convertBatchError(tableDesc, b, err)
+	// Write the row sentinel.
+	if log.V(2) {
+		log.Infof("CPut %s -> NULL", prettyKey(primaryIndexKey, 0))
 	}
+	b.CPut(primaryIndexKey, nil, nil)
+
+	// Write the row columns.
+	for i, val := range rowVals {
+		col := ri.cols[i]
+		if _, ok := ri.primaryKeyCols[col.ID]; ok {
+			// Skip primary key columns as their values are encoded in the row
+			// sentinel key which is guaranteed to exist for as long as the row
+			// exists.
+			continue
+		}
 
-	return result, nil
+		if ri.marshalled[i] != nil {
+			// We only output non-NULL values. Non-existent column keys are
+			// considered NULL during scanning and the row sentinel ensures we know
+			// the row exists.
+
+			key := MakeColumnKey(col.ID, primaryIndexKey)
+			if log.V(2) {
+				log.Infof("CPut %s -> %v", prettyKey(key, 0), val)
+			}
+
+			b.CPut(key, ri.marshalled[i], nil)
+		}
+	}
+	return nil
 }
 
 func (p *planner) processColumns(tableDesc *TableDescriptor,
@@ -0,0 +1,98 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// The statuses of the jobs in the jobs table.
+const (
+	jobStatusRunning   = "running"
+	jobStatusSucceeded = "succeeded"
+	jobStatusFailed    = "failed"
+)
+
+// createJob records a running job of the given type in the jobs table and
+// returns its ID. Jobs are recorded outside of the transaction of the
+// statement which runs them, so that their progress can be followed while
+// they run and failed jobs remain visible. The records of jobs which haven't
+// been modified for jobsRetention are deleted.
+func (p *planner) createJob(jobType, description string) (int64, error) {
+	var id int64
+	err := p.db.Txn(func(txn *client.Txn) error {
+		jp := planner{txn: txn, user: security.RootUser, evalCtx: p.evalCtx}
+		values, err := jp.queryRow(`SELECT experimental_unique_int()`)
+		if err != nil {
+			return err
+		}
+		id = int64(values[0].(parser.DInt))
+
+		now := parser.DTimestamp{Time: time.Now()}
+		const insertJob = `INSERT INTO system.jobs VALUES ` +
+			`(%d, %s, %s, '%s', '%s'::timestamp, '%s'::timestamp, 0.0)`
+		sql := fmt.Sprintf(insertJob, id, parser.DString(jobType), parser.DString(description),
+			jobStatusRunning, now, now)
+		count, err := jp.exec(sql)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			return util.Errorf("%s: expected 1 result, found %d", sql, count)
+		}
+		return nil
+	})
+	return id, err
+}
+
+// updateJob records the progress of a running job.
+func (p *planner) updateJob(id int64, fraction float64) {
+	const updateJob = `UPDATE system.jobs SET modified = '%s'::timestamp, fraction = %f WHERE id = %d`
+	p.execJobUpdate(id, fmt.Sprintf(updateJob, parser.DTimestamp{Time: time.Now()}, fraction, id))
+}
+
+// finishJob records the outcome of a job. A failed job keeps the fraction it
+// had completed.
+func (p *planner) finishJob(id int64, err error) {
+	now := parser.DTimestamp{Time: time.Now()}
+	if err != nil {
+		const failJob = `UPDATE system.jobs SET status = '%s', modified = '%s'::timestamp WHERE id = %d`
+		p.execJobUpdate(id, fmt.Sprintf(failJob, jobStatusFailed, now, id))
+		return
+	}
+	const finishJob = `UPDATE system.jobs SET status = '%s', modified = '%s'::timestamp, ` +
+		`fraction = 1.0 WHERE id = %d`
+	p.execJobUpdate(id, fmt.Sprintf(finishJob, jobStatusSucceeded, now, id))
+}
+
+// execJobUpdate runs the given update of the jobs table. Failures are only
+// logged, as they must not fail the job itself.
+func (p *planner) execJobUpdate(id int64, sql string) {
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		jp := planner{txn: txn, user: security.RootUser}
+		_, err := jp.exec(sql)
+		return err
+	}); err != nil {
+		log.Warningf("unable to update job %d: %s", id, err)
+	}
+}
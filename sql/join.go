@@ -35,6 +35,8 @@ type indexJoinNode struct {
 	table            *scanNode
 	primaryKeyPrefix roachpb.Key
 	colIDtoRowIndex  map[ColumnID]int
+	mem              *memoryAccount // accounts for the batch of primary keys
+	batchBytes       int64          // the memory used by the batch of primary keys
 	err              error
 }
 
@@ -105,6 +107,7 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 		table:            table,
 		primaryKeyPrefix: primaryKeyPrefix,
 		colIDtoRowIndex:  colIDtoRowIndex,
+		mem:              &indexScan.planner.stmtMemory,
 	}, nil
 }
 
@@ -139,6 +142,8 @@ func (n *indexJoinNode) Next() bool {
 		n.table.kvs = nil
 		n.table.kvIndex = 0
 		n.table.spans = n.table.spans[0:0]
+		n.mem.shrink(n.batchBytes)
+		n.batchBytes = 0
 
 		for len(n.table.spans) < joinBatchSize {
 			if !n.index.Next() {
@@ -155,12 +160,17 @@ func (n *indexJoinNode) Next() bool {
 
 			vals := n.index.Values()
 			var primaryIndexKey []byte
-			primaryIndexKey, _, n.err = encodeIndexKey(
-				n.table.index.ColumnIDs, n.colIDtoRowIndex, vals, n.primaryKeyPrefix)
+			primaryIndexKey, _, n.err = encodeIndexKey(n.table.index.ColumnIDs,
+				n.table.index.ColumnDirections, n.colIDtoRowIndex, vals, n.primaryKeyPrefix)
 			if n.err != nil {
 				return false
 			}
 			key := roachpb.Key(primaryIndexKey)
+			size := sizeOfSpan + 2*int64(len(key))
+			if n.err = n.mem.grow(size, "join"); n.err != nil {
+				return false
+			}
+			n.batchBytes += size
 			n.table.spans = append(n.table.spans, span{
 				start: key,
 				end:   key.PrefixEnd(),
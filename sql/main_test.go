@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip/resolver"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
@@ -45,7 +46,7 @@ func TestMain(m *testing.M) {
 }
 
 // checkEndTransactionTrigger verifies that an EndTransactionRequest
-// that includes intents for the SystemDB keys sets the proper trigger.
+// that includes intents for the system config keys sets the proper trigger.
 func checkEndTransactionTrigger(req roachpb.Request, _ roachpb.Header) error {
 	args, ok := req.(*roachpb.EndTransactionRequest)
 	if !ok {
@@ -63,7 +64,7 @@ func checkEndTransactionTrigger(req roachpb.Request, _ roachpb.Header) error {
 	var hasSystemKey bool
 	for _, it := range args.Intents {
 		addr := keys.Addr(it.Key)
-		if bytes.Compare(addr, keys.SystemDBSpan.Key) >= 0 && bytes.Compare(addr, keys.SystemDBSpan.EndKey) < 0 {
+		if bytes.Compare(addr, keys.SystemConfigSpan.Key) >= 0 && bytes.Compare(addr, keys.SystemConfigSpan.EndKey) < 0 {
 			hasSystemKey = true
 			break
 		}
@@ -94,7 +95,7 @@ func setup(t *testing.T) (*server.TestServer, *sql.DB, *client.DB) {
 }
 
 func setupWithContext(t *testing.T, ctx *server.Context) (*server.TestServer, *sql.DB, *client.DB) {
-	s := setupTestServer(t)
+	s := setupTestServerWithContext(t, ctx)
 	// SQL requests use "root" which has ALL permissions on everything.
 	sqlDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
 		security.RootUser, s.ServingAddr()))
@@ -120,3 +121,42 @@ func cleanup(s *server.TestServer, db *sql.DB) {
 	_ = db.Close()
 	cleanupTestServer(s)
 }
+
+// addTestNodes starts the given number of servers which join the cluster of
+// the supplied server, and returns a SQL client for each of them along with
+// a function stopping them.
+func addTestNodes(t *testing.T, s *server.TestServer, count int) ([]*sql.DB, func()) {
+	var servers []*server.TestServer
+	var dbs []*sql.DB
+	stop := func() {
+		for _, db := range dbs {
+			_ = db.Close()
+		}
+		for _, ts := range servers {
+			ts.Stop()
+		}
+	}
+	for i := 0; i < count; i++ {
+		ctx := server.NewTestContext()
+		r, err := resolver.NewResolver(&ctx.Context, s.ServingAddr())
+		if err != nil {
+			stop()
+			t.Fatal(err)
+		}
+		ctx.GossipBootstrapResolvers = []resolver.Resolver{r}
+		ts := &server.TestServer{Ctx: ctx, SkipBootstrap: true}
+		if err := ts.Start(); err != nil {
+			stop()
+			t.Fatal(err)
+		}
+		servers = append(servers, ts)
+		db, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
+			security.RootUser, ts.ServingAddr()))
+		if err != nil {
+			stop()
+			t.Fatal(err)
+		}
+		dbs = append(dbs, db)
+	}
+	return dbs, stop
+}
@@ -0,0 +1,136 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/budget"
+)
+
+// sessionMemory accounts for the memory used by a request of a SQL session:
+// the rows buffered for the results of its statements and the rows held by
+// the sort, group and join nodes of the statement being executed. The
+// memory is drawn from the node's memory budget, if any, and is limited by
+// the session budget. A nil *sessionMemory imposes no limit.
+type sessionMemory struct {
+	reservation *budget.Reservation // the node's budget; may be nil
+	limit       int64               // zero for no limit
+	used        int64               // accessed atomically
+}
+
+// grow accounts for n more bytes, failing if this would exceed the session
+// budget or the node's budget. what describes the use of the memory.
+func (m *sessionMemory) grow(n int64, what string) error {
+	if m == nil {
+		return nil
+	}
+	if m.limit > 0 && atomic.LoadInt64(&m.used)+n > m.limit {
+		return fmt.Errorf("%s: session memory budget of %d bytes exceeded", what, m.limit)
+	}
+	if err := m.reservation.Grow(n); err != nil {
+		return &roachpb.ServerOverloadedError{Message: err.Error()}
+	}
+	atomic.AddInt64(&m.used, n)
+	return nil
+}
+
+// shrink returns n bytes accounted for by grow.
+func (m *sessionMemory) shrink(n int64) {
+	if m == nil || n == 0 {
+		return
+	}
+	m.reservation.Shrink(n)
+	atomic.AddInt64(&m.used, -n)
+}
+
+// Used returns the number of bytes currently accounted for.
+func (m *sessionMemory) Used() int64 {
+	if m == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&m.used)
+}
+
+// A memoryAccount is the portion of a session's memory used by one part of
+// a request, such as the results of a statement or the plan nodes executing
+// it, allowing that part to be released as a whole.
+type memoryAccount struct {
+	mem  *sessionMemory // may be nil
+	used int64
+}
+
+// grow accounts for n more bytes. what describes the use of the memory.
+func (a *memoryAccount) grow(n int64, what string) error {
+	if err := a.mem.grow(n, what); err != nil {
+		return err
+	}
+	a.used += n
+	return nil
+}
+
+// shrink releases n of the bytes accounted for.
+func (a *memoryAccount) shrink(n int64) {
+	a.mem.shrink(n)
+	a.used -= n
+}
+
+// close releases all of the bytes accounted for.
+func (a *memoryAccount) close() {
+	a.shrink(a.used)
+}
+
+// sizeOfDatum is the size of a datum as held in a tuple, excluding any data
+// it refers to.
+const sizeOfDatum = int64(unsafe.Sizeof(parser.Datum(nil)))
+
+// sizeOfSeenEntry is an estimate of the memory held by an entry of the seen
+// map of a DISTINCT aggregate function, excluding the bytes of its key.
+const sizeOfSeenEntry = int64(unsafe.Sizeof(""))
+
+// sizeOfSpan is the size of a span, excluding the bytes of its keys.
+const sizeOfSpan = int64(unsafe.Sizeof(span{}))
+
+// datumSize returns an estimate of the memory held by a datum.
+func datumSize(d parser.Datum) int64 {
+	switch t := d.(type) {
+	case parser.DString:
+		return sizeOfDatum + int64(len(t))
+	case parser.DBytes:
+		return sizeOfDatum + int64(len(t))
+	case parser.DTimestamp:
+		return sizeOfDatum + int64(unsafe.Sizeof(t))
+	case parser.DTuple:
+		return tupleSize(t)
+	case *parser.DArray:
+		return sizeOfDatum + tupleSize(t.Elements)
+	default:
+		return sizeOfDatum
+	}
+}
+
+// tupleSize returns an estimate of the memory held by a tuple.
+func tupleSize(t parser.DTuple) int64 {
+	size := int64(unsafe.Sizeof(t))
+	for _, d := range t {
+		size += datumSize(d)
+	}
+	return size
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSessionMemoryLimit verifies that statements which sort or group more
+// rows than fit in the session memory budget fail, while statements within
+// the budget succeed.
+func TestSessionMemoryLimit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := server.NewTestContext()
+	ctx.SQLSessionMemoryLimit = 4096
+	s, sqlDB, _ := setupWithContext(t, ctx)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v CHAR);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, i, fmt.Sprintf("%03d%s", i, strings.Repeat("x", 100))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 100 {
+		t.Fatalf("expected 100 rows; got %d", count)
+	}
+
+	for _, tc := range []struct {
+		query string
+		err   string
+	}{
+		{`SELECT k FROM t.kv ORDER BY v`, "sort: session memory budget of 4096 bytes exceeded"},
+		{`SELECT COUNT(DISTINCT v) FROM t.kv`, "group: session memory budget of 4096 bytes exceeded"},
+		{`SELECT * FROM t.kv`, "results: session memory budget of 4096 bytes exceeded"},
+	} {
+		if _, err := sqlDB.Query(tc.query); !testutils.IsError(err, tc.err) {
+			t.Errorf("%s: expected %q; got %v", tc.query, tc.err, err)
+		}
+	}
+
+	// Memory is released when statements complete.
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestShowSessions verifies that SHOW SESSIONS reports the session executing
+// it and the idle sessions holding an open transaction.
+func TestShowSessions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := sqlDB.Query(`SHOW SESSIONS`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var active, idle int
+	for rows.Next() {
+		var user string
+		var txn *string
+		var isActive bool
+		var age interface{}
+		var memory int64
+		if err := rows.Scan(&user, &txn, &isActive, &age, &memory); err != nil {
+			t.Fatal(err)
+		}
+		if user != security.RootUser {
+			t.Errorf("expected sessions of %s; got %s", security.RootUser, user)
+		}
+		if isActive {
+			active++
+		} else {
+			if txn == nil {
+				t.Errorf("expected the idle session to have a transaction")
+			}
+			idle++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if active != 1 || idle != 1 {
+		t.Errorf("expected 1 active and 1 idle session; got %d and %d", active, idle)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+// nodeRowRefreshInterval is the interval at which the rows of the local
+// stores in the system.nodes table are rewritten even though their topology
+// didn't change. It must be comfortably smaller than nodesRetention, after
+// which rows which weren't rewritten are deleted as stale.
+const nodeRowRefreshInterval = time.Hour
+
+// nodeTable keeps the rows of the local stores in the system.nodes table up
+// to date with the store descriptors gossiped by this node. Each node only
+// writes the rows of its own stores, when their topology changed or every
+// nodeRowRefreshInterval, so that the table is not rewritten on every gossip
+// interval. The rows of stores which have been removed or whose node is
+// gone are not refreshed, and expire.
+type nodeTable struct {
+	mu      sync.Mutex
+	written map[roachpb.StoreID]writtenNodeRow
+}
+
+// writtenNodeRow is a row written to the nodes table, along with the time
+// it was written at.
+type writtenNodeRow struct {
+	nodeRow
+	at time.Time
+}
+
+// nodeRow is the part of a store descriptor recorded in the nodes table.
+type nodeRow struct {
+	nodeID     roachpb.NodeID
+	storeID    roachpb.StoreID
+	address    string
+	nodeAttrs  string
+	storeAttrs string
+}
+
+func makeNodeRow(desc roachpb.StoreDescriptor) nodeRow {
+	return nodeRow{
+		nodeID:     desc.Node.NodeID,
+		storeID:    desc.StoreID,
+		address:    desc.Node.Address.String(),
+		nodeAttrs:  desc.Node.Attrs.SortedString(),
+		storeAttrs: desc.Attrs.SortedString(),
+	}
+}
+
+// nodesGossipUpdate is the gossip callback for store descriptors. Gossip
+// callbacks are run on their own goroutine, so the table is written
+// synchronously.
+func (e *Executor) nodesGossipUpdate(_ string, content []byte) {
+	var desc roachpb.StoreDescriptor
+	if err := proto.Unmarshal(content, &desc); err != nil {
+		log.Error(err)
+		return
+	}
+	// The node ID is only known once the node has joined the cluster;
+	// descriptors gossiped before that are picked up on the next interval.
+	if e.nodeID == 0 || desc.Node.NodeID != roachpb.NodeID(e.nodeID) {
+		return
+	}
+	row := makeNodeRow(desc)
+
+	e.nodes.mu.Lock()
+	defer e.nodes.mu.Unlock()
+	now := time.Now()
+	if prev, ok := e.nodes.written[row.storeID]; ok && prev.nodeRow == row &&
+		now.Sub(prev.at) < nodeRowRefreshInterval {
+		return
+	}
+	if err := e.writeNodeRow(row, now); err != nil {
+		log.Warningf("unable to update system.nodes for store %d: %s", row.storeID, err)
+		return
+	}
+	if e.nodes.written == nil {
+		e.nodes.written = map[roachpb.StoreID]writtenNodeRow{}
+	}
+	e.nodes.written[row.storeID] = writtenNodeRow{nodeRow: row, at: now}
+}
+
+// writeNodeRow replaces the row of the given store in the nodes table,
+// recording the given time as its update time.
+func (e *Executor) writeNodeRow(row nodeRow, now time.Time) error {
+	return e.db.Txn(func(txn *client.Txn) error {
+		p := planner{txn: txn, user: security.RootUser}
+		const deleteNode = `DELETE FROM system.nodes WHERE nodeID = %d AND storeID = %d`
+		if _, err := p.exec(fmt.Sprintf(deleteNode, row.nodeID, row.storeID)); err != nil {
+			return err
+		}
+		const insertNode = `INSERT INTO system.nodes VALUES (%d, %d, %s, %s, %s, '%s'::timestamp)`
+		_, err := p.exec(fmt.Sprintf(insertNode, row.nodeID, row.storeID,
+			parser.DString(row.address), parser.DString(row.nodeAttrs),
+			parser.DString(row.storeAttrs), parser.DTimestamp{Time: now}))
+		return err
+	})
+}
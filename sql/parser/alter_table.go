@@ -63,6 +63,7 @@ func (*AlterTableAddColumn) alterTableCmd()      {}
 func (*AlterTableAddConstraint) alterTableCmd()  {}
 func (*AlterTableDropColumn) alterTableCmd()     {}
 func (*AlterTableDropConstraint) alterTableCmd() {}
+func (*AlterTableSetTTL) alterTableCmd()         {}
 
 // AlterTableAddColumn represents an ADD COLUMN command.
 type AlterTableAddColumn struct {
@@ -122,3 +123,17 @@ type AlterTableDropConstraint struct {
 func (node *AlterTableDropConstraint) String() string {
 	return fmt.Sprintf("DROP CONSTRAINT %s", node.Constraint)
 }
+
+// AlterTableSetTTL represents a SET TTL or RESET TTL command. The TTL is nil
+// for RESET TTL.
+type AlterTableSetTTL struct {
+	TTL    Expr
+	Column string
+}
+
+func (node *AlterTableSetTTL) String() string {
+	if node.TTL == nil {
+		return "RESET TTL"
+	}
+	return fmt.Sprintf("SET TTL %s ON %s", node.TTL, Name(node.Column))
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Backup represents a BACKUP statement. The To expression evaluates to the
+// location the backup is written to. If IncrementalFrom is not empty, it
+// holds the locations of the previous backups the backup builds upon, and
+// only the changes since the last of them are written.
+type Backup struct {
+	Targets         TargetList
+	To              Expr
+	IncrementalFrom Exprs
+}
+
+func (node *Backup) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "BACKUP %s TO %s", node.Targets, node.To)
+	if node.IncrementalFrom != nil {
+		fmt.Fprintf(&buf, " INCREMENTAL FROM %s", node.IncrementalFrom)
+	}
+	return buf.String()
+}
+
+// Restore represents a RESTORE statement. The From expressions evaluate to
+// the locations of a full backup followed by any incremental backups on top
+// of it.
+type Restore struct {
+	Targets TargetList
+	From    Exprs
+}
+
+func (node *Restore) String() string {
+	return fmt.Sprintf("RESTORE %s FROM %s", node.Targets, node.From)
+}
@@ -0,0 +1,49 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// CommentOnTable represents a COMMENT ON TABLE statement. A nil comment
+// removes the comment of the table.
+type CommentOnTable struct {
+	Table   *QualifiedName
+	Comment *string
+}
+
+func (node *CommentOnTable) String() string {
+	return fmt.Sprintf("COMMENT ON TABLE %s IS %s", node.Table, commentText(node.Comment))
+}
+
+// CommentOnColumn represents a COMMENT ON COLUMN statement. The column is
+// named by a qualified name whose last component is the column and whose
+// preceding components name the table. A nil comment removes the comment
+// of the column.
+type CommentOnColumn struct {
+	Column  *QualifiedName
+	Comment *string
+}
+
+func (node *CommentOnColumn) String() string {
+	return fmt.Sprintf("COMMENT ON COLUMN %s IS %s", node.Column, commentText(node.Comment))
+}
+
+func commentText(comment *string) string {
+	if comment == nil {
+		return "NULL"
+	}
+	return encodeSQLString(*comment)
+}
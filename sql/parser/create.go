@@ -44,13 +44,40 @@ func (node *CreateDatabase) String() string {
 	return buf.String()
 }
 
+// IndexElem represents a column of an index and its direction.
+type IndexElem struct {
+	Column    Name
+	Direction Direction
+}
+
+func (node IndexElem) String() string {
+	if node.Direction == DefaultDirection {
+		return node.Column.String()
+	}
+	return fmt.Sprintf("%s %s", node.Column, node.Direction)
+}
+
+// IndexElemList is a list of IndexElem.
+type IndexElemList []IndexElem
+
+func (l IndexElemList) String() string {
+	var buf bytes.Buffer
+	for i, elem := range l {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(elem.String())
+	}
+	return buf.String()
+}
+
 // CreateIndex represents a CREATE INDEX statement.
 type CreateIndex struct {
 	Name        Name
 	Table       *QualifiedName
 	Unique      bool
 	IfNotExists bool
-	Columns     NameList
+	Columns     IndexElemList
 	Storing     NameList
 }
 
@@ -113,12 +140,13 @@ const (
 // ColumnTableDef represents a column definition within a CREATE TABLE
 // statement.
 type ColumnTableDef struct {
-	Name        Name
-	Type        ColumnType
-	Nullable    Nullability
-	PrimaryKey  bool
-	Unique      bool
-	DefaultExpr Expr
+	Name         Name
+	Type         ColumnType
+	Nullable     Nullability
+	PrimaryKey   bool
+	Unique       bool
+	DefaultExpr  Expr
+	OnUpdateExpr Expr
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
@@ -132,6 +160,8 @@ func newColumnTableDef(name Name, typ ColumnType,
 		switch t := c.(type) {
 		case *ColumnDefault:
 			d.DefaultExpr = t.Expr
+		case *ColumnOnUpdate:
+			d.OnUpdateExpr = t.Expr
 		case NotNullConstraint:
 			d.Nullable = NotNull
 		case NullConstraint:
@@ -168,6 +198,9 @@ func (node *ColumnTableDef) String() string {
 	if node.DefaultExpr != nil {
 		fmt.Fprintf(&buf, " DEFAULT %s", node.DefaultExpr)
 	}
+	if node.OnUpdateExpr != nil {
+		fmt.Fprintf(&buf, " ON UPDATE %s", node.OnUpdateExpr)
+	}
 	return buf.String()
 }
 
@@ -177,6 +210,7 @@ type ColumnQualification interface {
 }
 
 func (*ColumnDefault) columnQualification()       {}
+func (*ColumnOnUpdate) columnQualification()      {}
 func (NotNullConstraint) columnQualification()    {}
 func (NullConstraint) columnQualification()       {}
 func (PrimaryKeyConstraint) columnQualification() {}
@@ -187,6 +221,11 @@ type ColumnDefault struct {
 	Expr Expr
 }
 
+// ColumnOnUpdate represents an ON UPDATE clause for a column.
+type ColumnOnUpdate struct {
+	Expr Expr
+}
+
 // NotNullConstraint represents NOT NULL on a column.
 type NotNullConstraint struct{}
 
@@ -259,6 +298,23 @@ func (node *UniqueConstraintTableDef) String() string {
 	return buf.String()
 }
 
+// CreateExternalTable represents a CREATE EXTERNAL TABLE statement.
+type CreateExternalTable struct {
+	Table   *QualifiedName
+	Defs    TableDefs
+	Files   Exprs
+	Options KVOptions
+}
+
+func (node *CreateExternalTable) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE EXTERNAL TABLE %s (%s) CSV DATA (%s)", node.Table, node.Defs, node.Files)
+	if node.Options != nil {
+		fmt.Fprintf(&buf, " WITH %s", node.Options)
+	}
+	return buf.String()
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
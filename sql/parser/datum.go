@@ -61,6 +61,7 @@ var (
 	_ Datum = DummyTimestamp
 	_ Datum = DummyInterval
 	_ Datum = dummyTuple
+	_ Datum = dummyArray
 	_ Datum = DNull
 
 	boolType      = reflect.TypeOf(DummyBool)
@@ -72,6 +73,7 @@ var (
 	timestampType = reflect.TypeOf(DummyTimestamp)
 	intervalType  = reflect.TypeOf(DummyInterval)
 	tupleType     = reflect.TypeOf(dummyTuple)
+	arrayType     = reflect.TypeOf(dummyArray)
 )
 
 // A Datum holds either a bool, int64, float64, string or []Datum.
@@ -550,15 +552,17 @@ func (d DTuple) IsMin() bool {
 }
 
 func (d DTuple) String() string {
+	return fmt.Sprintf("(%s)", d.elems())
+}
+
+func (d DTuple) elems() string {
 	var buf bytes.Buffer
-	_ = buf.WriteByte('(')
 	for i, v := range d {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
 		buf.WriteString(v.String())
 	}
-	_ = buf.WriteByte(')')
 	return buf.String()
 }
 
@@ -594,6 +598,116 @@ func (d *DTuple) makeUnique() {
 	*d = (*d)[:n]
 }
 
+// DArray is the one-dimensional array Datum. All of the non-NULL elements of
+// an array have the same type. Arrays are indexed starting at 1.
+type DArray struct {
+	Elements DTuple
+}
+
+// Placeholder DArray values, one per supported element type. A placeholder
+// array holds a single placeholder element of the array's element type (or no
+// element if the element type is unknown). The placeholders are canonical so
+// that type checking can compare them by identity.
+var (
+	dummyArray   = &DArray{}
+	dummyArrays  = map[reflect.Type]*DArray{}
+	arrayDummies = []Datum{DummyBool, DummyInt, DummyFloat, DummyString,
+		DummyBytes, DummyDate, DummyTimestamp, DummyInterval}
+)
+
+func init() {
+	for _, d := range arrayDummies {
+		dummyArrays[reflect.TypeOf(d)] = &DArray{Elements: DTuple{d}}
+	}
+}
+
+// arrayPlaceholder returns the placeholder DArray for arrays with elements of
+// the type of elem. An error is returned if elem cannot be an array element.
+func arrayPlaceholder(elem Datum) (*DArray, error) {
+	if elem == DNull {
+		return dummyArray, nil
+	}
+	if a, ok := dummyArrays[reflect.TypeOf(elem)]; ok {
+		return a, nil
+	}
+	return nil, fmt.Errorf("arrays of %s are not supported", elem.Type())
+}
+
+// elemType returns the first non-NULL element of the array, or DNull if the
+// array has no non-NULL elements.
+func (d *DArray) elemType() Datum {
+	for _, e := range d.Elements {
+		if e != DNull {
+			return e
+		}
+	}
+	return DNull
+}
+
+// Type implements the Datum interface.
+func (d *DArray) Type() string {
+	if e := d.elemType(); e != DNull {
+		return e.Type() + "[]"
+	}
+	return "array"
+}
+
+// Compare implements the Datum interface.
+func (d *DArray) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(*DArray)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	return d.Elements.Compare(v.Elements)
+}
+
+// Next implements the Datum interface.
+func (d *DArray) Next() Datum {
+	// Appending a NULL element yields the smallest array greater than d.
+	n := make(DTuple, len(d.Elements), len(d.Elements)+1)
+	copy(n, d.Elements)
+	return &DArray{Elements: append(n, DNull)}
+}
+
+// IsMax implements the Datum interface.
+func (d *DArray) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d *DArray) IsMin() bool {
+	return len(d.Elements) == 0
+}
+
+func (d *DArray) String() string {
+	return fmt.Sprintf("ARRAY[%s]", d.Elements.elems())
+}
+
+// contains returns true if every element of other is equal to some element of
+// d. NULL elements are not equal to anything.
+func (d *DArray) contains(other *DArray) bool {
+	for _, e := range other.Elements {
+		if e == DNull {
+			return false
+		}
+		found := false
+		for _, f := range d.Elements {
+			if f != DNull && f.Compare(e) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 type dNull struct{}
 
 // Type implements the Datum interface.
@@ -27,11 +27,13 @@ import "fmt"
 
 // Delete represents a DELETE statement.
 type Delete struct {
-	Table TableExpr
-	Where *Where
+	Table   TableExpr
+	Where   *Where
+	OrderBy OrderBy
+	Limit   *Limit
 }
 
 func (node *Delete) String() string {
-	return fmt.Sprintf("DELETE FROM %s%s",
-		node.Table, node.Where)
+	return fmt.Sprintf("DELETE FROM %s%s%s%s",
+		node.Table, node.Where, node.OrderBy, node.Limit)
 }
@@ -18,6 +18,7 @@
 package parser
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -25,7 +26,6 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -446,12 +446,13 @@ var cmpOps = map[cmpArgs]cmpOp{
 
 	cmpArgs{Like, stringType, stringType}: {
 		fn: func(ctx EvalContext, left Datum, right Datum) (DBool, error) {
-			key := likeKey(right.(DString))
-			re, err := ctx.ReCache.GetRegexp(key)
-			if err != nil {
-				panic(fmt.Sprintf("LIKE regexp compilations should not fail: %v", err))
-			}
-			return DBool(re.MatchString(string(left.(DString)))), nil
+			return matchLike(ctx, left, right, false)
+		},
+	},
+
+	cmpArgs{ILike, stringType, stringType}: {
+		fn: func(ctx EvalContext, left Datum, right Datum) (DBool, error) {
+			return matchLike(ctx, left, right, true)
 		},
 	},
 
@@ -465,6 +466,39 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(re.MatchString(string(left.(DString)))), nil
 		},
 	},
+
+	cmpArgs{RegMatch, stringType, stringType}: {
+		fn: func(ctx EvalContext, left Datum, right Datum) (DBool, error) {
+			return matchRegexp(ctx, left, right, false)
+		},
+	},
+
+	cmpArgs{RegIMatch, stringType, stringType}: {
+		fn: func(ctx EvalContext, left Datum, right Datum) (DBool, error) {
+			return matchRegexp(ctx, left, right, true)
+		},
+	},
+
+	cmpArgs{EQ, arrayType, arrayType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	},
+	cmpArgs{LT, arrayType, arrayType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
+	cmpArgs{LE, arrayType, arrayType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
+	cmpArgs{Contains, arrayType, arrayType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.(*DArray).contains(right.(*DArray))), nil
+		},
+	},
 }
 
 var evalTupleEQ = cmpOp{
@@ -773,6 +807,26 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 			// An integer duration represents a duration in nanoseconds.
 			return DInterval{Duration: time.Duration(d.(DInt))}, nil
 		}
+	case *ArrayType:
+		switch v := d.(type) {
+		case *DArray:
+			elemType := expr.Type.(*ArrayType).ElemType
+			array := &DArray{Elements: make(DTuple, len(v.Elements))}
+			for i, e := range v.Elements {
+				if e == DNull {
+					array.Elements[i] = DNull
+					continue
+				}
+				var err error
+				if array.Elements[i], err = (&CastExpr{Expr: e, Type: elemType}).Eval(ctx); err != nil {
+					return DNull, err
+				}
+			}
+			return array, nil
+		case dNull:
+			return DNull, nil
+		}
+
 		// TODO(pmattis): unimplemented.
 		// case *DecimalType:
 	}
@@ -1071,8 +1125,83 @@ func (expr *UnaryExpr) Eval(ctx EvalContext) (Datum, error) {
 }
 
 // Eval implements the Expr interface.
-func (t Array) Eval(_ EvalContext) (Datum, error) {
-	return nil, util.Errorf("unhandled type %T", t)
+func (t Array) Eval(ctx EvalContext) (Datum, error) {
+	array := &DArray{Elements: make(DTuple, 0, len(t))}
+	for _, v := range t {
+		d, err := v.Eval(ctx)
+		if err != nil {
+			return DNull, err
+		}
+		array.Elements = append(array.Elements, d)
+	}
+	elem, err := arrayElemType(array.Elements)
+	if err != nil {
+		return DNull, err
+	}
+	if _, err := arrayPlaceholder(elem); err != nil {
+		return DNull, err
+	}
+	return array, nil
+}
+
+// Eval implements the Expr interface.
+func (expr *SubscriptExpr) Eval(ctx EvalContext) (Datum, error) {
+	d, err := expr.Expr.Eval(ctx)
+	if err != nil {
+		return DNull, err
+	}
+	begin, beginNull, err := evalSubscript(ctx, expr.Index.Begin)
+	if err != nil {
+		return DNull, err
+	}
+	end, endNull := begin, false
+	if expr.Index.End != nil {
+		if end, endNull, err = evalSubscript(ctx, expr.Index.End); err != nil {
+			return DNull, err
+		}
+	}
+	if d == DNull || beginNull || endNull {
+		return DNull, nil
+	}
+	array, ok := d.(*DArray)
+	if !ok {
+		return DNull, fmt.Errorf("cannot subscript type %s because it is not an array", d.Type())
+	}
+	n := len(array.Elements)
+	if expr.Index.End == nil {
+		// As in PostgreSQL, out of range subscripts yield NULL.
+		if begin < 1 || begin > n {
+			return DNull, nil
+		}
+		return array.Elements[begin-1], nil
+	}
+	// Slice bounds are clamped to the bounds of the array.
+	if begin < 1 {
+		begin = 1
+	}
+	if end > n {
+		end = n
+	}
+	if begin > end {
+		return &DArray{}, nil
+	}
+	return &DArray{Elements: array.Elements[begin-1 : end]}, nil
+}
+
+// evalSubscript evaluates an array subscript expression, returning the 1-based
+// index and whether the subscript is NULL.
+func evalSubscript(ctx EvalContext, e Expr) (int, bool, error) {
+	d, err := e.Eval(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	switch t := d.(type) {
+	case DInt:
+		return int(t), false, nil
+	case dNull:
+		return 0, true, nil
+	}
+	return 0, false, fmt.Errorf("array subscript must be type int, not %s", d.Type())
 }
 
 // Eval implements the Expr interface.
@@ -1170,6 +1299,11 @@ func (t DTuple) Eval(_ EvalContext) (Datum, error) {
 	return t, nil
 }
 
+// Eval implements the Expr interface.
+func (t *DArray) Eval(_ EvalContext) (Datum, error) {
+	return t, nil
+}
+
 func evalComparison(ctx EvalContext, op ComparisonOp, left, right Datum) (Datum, error) {
 	if left == DNull || right == DNull {
 		return DNull, nil
@@ -1206,6 +1340,18 @@ func foldComparisonExpr(op ComparisonOp, dummyLeft, dummyRight Datum) (Compariso
 	case NotSimilarTo:
 		// NotSimilarTo(left, right) is implemented as !SimilarTo(left, right)
 		return SimilarTo, dummyLeft, dummyRight, true
+	case NotILike:
+		// NotILike(left, right) is implemented as !ILike(left, right)
+		return ILike, dummyLeft, dummyRight, true
+	case NotRegMatch:
+		// NotRegMatch(left, right) is implemented as !RegMatch(left, right)
+		return RegMatch, dummyLeft, dummyRight, true
+	case NotRegIMatch:
+		// NotRegIMatch(left, right) is implemented as !RegIMatch(left, right)
+		return RegIMatch, dummyLeft, dummyRight, true
+	case ContainedBy:
+		// ContainedBy(left, right) is implemented as Contains(right, left)
+		return Contains, dummyRight, dummyLeft, false
 	case IsDistinctFrom:
 		// IsDistinctFrom(left, right) is implemented as !EQ(left, right)
 		//
@@ -1280,14 +1426,62 @@ func (ctx EvalContext) ParseTimestamp(s DString) (DTimestamp, error) {
 	return DummyTimestamp, err
 }
 
-type likeKey string
+func matchLike(ctx EvalContext, left, right Datum, caseInsensitive bool) (DBool, error) {
+	key := likeKey{s: string(right.(DString)), caseInsensitive: caseInsensitive}
+	re, err := ctx.ReCache.GetRegexp(key)
+	if err != nil {
+		panic(fmt.Sprintf("LIKE regexp compilations should not fail: %v", err))
+	}
+	return DBool(re.MatchString(string(left.(DString)))), nil
+}
+
+func matchRegexp(ctx EvalContext, left, right Datum, caseInsensitive bool) (DBool, error) {
+	key := regexpKey{sqlPattern: string(right.(DString))}
+	if caseInsensitive {
+		key.sqlFlags = "i"
+	}
+	re, err := ctx.ReCache.GetRegexp(key)
+	if err != nil {
+		return DBool(false), fmt.Errorf("invalid regular expression: %v", err)
+	}
+	return DBool(re.MatchString(string(left.(DString)))), nil
+}
+
+type likeKey struct {
+	s               string
+	caseInsensitive bool
+}
 
 func (k likeKey) pattern() (string, error) {
-	pattern := regexp.QuoteMeta(string(k))
-	// Replace LIKE specific wildcards with standard wildcards
-	pattern = strings.Replace(pattern, "%", ".*", -1)
-	pattern = strings.Replace(pattern, "_", ".", -1)
-	return anchorPattern(pattern, false), nil
+	return anchorPattern(LikeEscape(k.s), k.caseInsensitive), nil
+}
+
+// LikeEscape converts a LIKE pattern to an unanchored regexp. The wildcards %
+// and _ match any sequence of characters and any single character, including
+// newlines; a backslash matches the character following it literally.
+func LikeEscape(pattern string) string {
+	var buf bytes.Buffer
+	afterEscape := false
+	for _, c := range pattern {
+		switch {
+		case afterEscape:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+			afterEscape = false
+		case c == '\\':
+			afterEscape = true
+		case c == '%':
+			buf.WriteString("(?s:.*)")
+		case c == '_':
+			buf.WriteString("(?s:.)")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if afterEscape {
+		// A trailing backslash matches itself.
+		buf.WriteString(`\\`)
+	}
+	return buf.String()
 }
 
 type similarToKey string
@@ -1361,7 +1555,9 @@ func similarEscapeCustomChar(pattern string, escapeChar rune) string {
 }
 
 // anchorPattern surrounds the transformed input string with
-//   ^(?: ... )$
+//
+//	^(?: ... )$
+//
 // which requires some explanation.  We need "^" and "$" to force
 // the pattern to match the entire input string as per SQL99 spec.
 // The "(?:" and ")" are a non-capturing set of parens; we have to have
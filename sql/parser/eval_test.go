@@ -144,6 +144,21 @@ func TestEval(t *testing.T) {
 		{`'TEST' NOT LIKE '%E%'`, `false`},
 		{`'TEST' NOT LIKE 'TES_'`, `false`},
 		{`'TEST' NOT LIKE 'TE_'`, `true`},
+		{`'TE%T' LIKE 'TE\%T'`, `true`},
+		{`'TEST' LIKE 'TE\%T'`, `false`},
+		{`'TE_T' LIKE 'TE\_T'`, `true`},
+		{`e'TE\nST' LIKE 'TE%'`, `true`},
+		// ILIKE and NOT ILIKE
+		{`'TEST' ILIKE 'te%'`, `true`},
+		{`'TEST' ILIKE 'TE_'`, `false`},
+		{`'TEST' NOT ILIKE '%e%'`, `false`},
+		// Regular expression matches.
+		{`'abc' ~ 'b'`, `true`},
+		{`'abc' ~ '^b'`, `false`},
+		{`'abc' ~ 'B'`, `false`},
+		{`'abc' ~* 'B'`, `true`},
+		{`'abc' !~ 'b'`, `false`},
+		{`'abc' !~* '^B'`, `true`},
 		// SIMILAR TO and NOT SIMILAR TO
 		{`'abc' SIMILAR TO 'abc'`, `true`},
 		{`'abc' SIMILAR TO 'a'`, `false`},
@@ -327,6 +342,31 @@ func TestEval(t *testing.T) {
 		{`'NaN'::float(4)`, `NaN`},
 		{`'NaN'::real`, `NaN`},
 		{`'NaN'::double precision`, `NaN`},
+		// Arrays.
+		{`ARRAY[1, 2, 3]`, `ARRAY[1, 2, 3]`},
+		{`ARRAY[]`, `ARRAY[]`},
+		{`ARRAY['a', NULL]`, `ARRAY['a', NULL]`},
+		{`(ARRAY[1, 2, 3])[1]`, `1`},
+		{`(ARRAY[1, 2, 3])[3]`, `3`},
+		{`(ARRAY[1, 2, 3])[0]`, `NULL`},
+		{`(ARRAY[1, 2, 3])[4]`, `NULL`},
+		{`(ARRAY[1, 2, 3])[NULL]`, `NULL`},
+		{`(ARRAY[1, 2, 3])[2:3]`, `ARRAY[2, 3]`},
+		{`(ARRAY[1, 2, 3])[0:2]`, `ARRAY[1, 2]`},
+		{`(ARRAY[1, 2, 3])[3:2]`, `ARRAY[]`},
+		{`(ARRAY[1, 2, 3])[1 + 1]`, `2`},
+		{`ARRAY[1, 2] = ARRAY[1, 2]`, `true`},
+		{`ARRAY[1, 2] < ARRAY[1, 3]`, `true`},
+		{`ARRAY[1, 2] < ARRAY[1, 2, 3]`, `true`},
+		{`ARRAY[1, 2] > ARRAY[1]`, `true`},
+		{`ARRAY[1, 2, 3] @> ARRAY[3, 1]`, `true`},
+		{`ARRAY[1, 2, 3] @> ARRAY[4]`, `false`},
+		{`ARRAY[1, 2, 3] @> ARRAY[]`, `true`},
+		{`ARRAY[1, NULL] @> ARRAY[NULL]`, `false`},
+		{`ARRAY[2] <@ ARRAY[1, 2]`, `true`},
+		{`ARRAY[1, 2] <@ ARRAY[2]`, `false`},
+		{`ARRAY['1', '2']::INT[]`, `ARRAY[1, 2]`},
+		{`ARRAY[1, NULL]::STRING[]`, `ARRAY['1', NULL]`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
@@ -359,6 +399,7 @@ func TestEvalError(t *testing.T) {
 		{`'11h2m'::interval / 0`, `division by zero`},
 		{`'hello' || b'world'`, `unsupported binary operator: <string> || <bytes>`},
 		{`b'\xff\xfe\xfd'::string`, `invalid utf8: "\xff\xfe\xfd"`},
+		{`ARRAY['a']::INT[]`, `invalid syntax`},
 		// TODO(pmattis): Check for overflow.
 		// {`~0 + 1`, `0`},
 	}
@@ -386,6 +427,10 @@ func TestEvalComparisonExprCaching(t *testing.T) {
 		// LIKE and NOT LIKE
 		{Like, `TEST`, `T%T`, 1},
 		{NotLike, `TEST`, `%E%`, 1},
+		{ILike, `TEST`, `t%t`, 1},
+		// Regular expression matches.
+		{RegMatch, `TEST`, `E.T`, 1},
+		{NotRegIMatch, `TEST`, `e.t`, 1},
 		// SIMILAR TO and NOT SIMILAR TO
 		{SimilarTo, `abc`, `(b|c)%`, 1},
 		{NotSimilarTo, `abc`, `%(b|d)%`, 1},
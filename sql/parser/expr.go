@@ -107,6 +107,14 @@ const (
 	NotLike
 	SimilarTo
 	NotSimilarTo
+	ILike
+	NotILike
+	RegMatch
+	NotRegMatch
+	RegIMatch
+	NotRegIMatch
+	Contains
+	ContainedBy
 	IsDistinctFrom
 	IsNotDistinctFrom
 	Is
@@ -126,6 +134,14 @@ var comparisonOpName = [...]string{
 	NotLike:           "NOT LIKE",
 	SimilarTo:         "SIMILAR TO",
 	NotSimilarTo:      "NOT SIMILAR TO",
+	ILike:             "ILIKE",
+	NotILike:          "NOT ILIKE",
+	RegMatch:          "~",
+	NotRegMatch:       "!~",
+	RegIMatch:         "~*",
+	NotRegIMatch:      "!~*",
+	Contains:          "@>",
+	ContainedBy:       "<@",
 	IsDistinctFrom:    "IS DISTINCT FROM",
 	IsNotDistinctFrom: "IS NOT DISTINCT FROM",
 	Is:                "IS",
@@ -289,6 +305,9 @@ type QualifiedName struct {
 	Base       Name
 	Indirect   Indirection
 	normalized nameType
+	// database is the database qualifying a column name normalized from the
+	// form database.table.column.
+	database Name
 }
 
 // Variable implements the VariableExpr interface.
@@ -369,6 +388,9 @@ func (n *QualifiedName) NormalizeTableName(database string) error {
 //   column[array-indirection]
 //   table.column
 //   table.column[array-indirection]
+//   database.table.*
+//   database.table.column
+//   database.table.column[array-indirection]
 //
 // Note that "table" may be the empty string. On successful normalization the
 // qualified name will have one of the forms:
@@ -376,6 +398,9 @@ func (n *QualifiedName) NormalizeTableName(database string) error {
 //   table.*
 //   table.column
 //   table.column[array-indirection]
+//
+// where the database qualifying the table, if any, is available through
+// Database.
 func (n *QualifiedName) NormalizeColumnName() error {
 	if n == nil {
 		return fmt.Errorf("empty column name: %s", n)
@@ -393,6 +418,20 @@ func (n *QualifiedName) NormalizeColumnName() error {
 		n.normalized = columnName
 		return nil
 	}
+	if n.normalized != columnName && len(n.Indirect) >= 2 {
+		if table, ok := n.Indirect[0].(NameIndirection); ok {
+			switch n.Indirect[1].(type) {
+			case NameIndirection, StarIndirection:
+				// database.table.column -> table.column
+				//
+				// Accomplished by moving n.Base to n.database and the table name
+				// from the indirection to n.Base.
+				n.database = n.Base
+				n.Base = Name(table)
+				n.Indirect = n.Indirect[1:]
+			}
+		}
+	}
 	if len(n.Indirect) > 2 {
 		return fmt.Errorf("invalid column name: %s", n)
 	}
@@ -422,11 +461,15 @@ func (n *QualifiedName) NormalizeColumnName() error {
 	return nil
 }
 
-// Database returns the database portion of the name. Note that the returned
-// string is not quoted even if the name is a keyword.
+// Database returns the database portion of the name, which is empty for a
+// column name not qualified by a database. Note that the returned string is
+// not quoted even if the name is a keyword.
 func (n *QualifiedName) Database() string {
-	if n.normalized != tableName {
-		panic(fmt.Sprintf("%s is not a table name", n))
+	if n.normalized != tableName && n.normalized != columnName {
+		panic(fmt.Sprintf("%s is not a table or column name", n))
+	}
+	if n.normalized == columnName {
+		return string(n.database)
 	}
 	// The database portion of the name is n.Base.
 	return string(n.Base)
@@ -484,6 +527,9 @@ func (n *QualifiedName) String() string {
 	if n.Base == "" && len(n.Indirect) == 1 && n.Indirect[0] == unqualifiedStar {
 		return n.Indirect[0].String()
 	}
+	if n.database != "" {
+		return fmt.Sprintf("%s.%s%s", n.database, n.Base, n.Indirect)
+	}
 	return fmt.Sprintf("%s%s", n.Base, n.Indirect)
 }
 
@@ -524,6 +570,17 @@ func (node Array) String() string {
 	return fmt.Sprintf("ARRAY[%s]", Exprs(node))
 }
 
+// SubscriptExpr represents an array subscript "<expr>[<index>]" or an array
+// slice "<expr>[<begin>:<end>]".
+type SubscriptExpr struct {
+	Expr  Expr
+	Index *ArrayIndirection
+}
+
+func (node *SubscriptExpr) String() string {
+	return fmt.Sprintf("%s%s", node.Expr, node.Index)
+}
+
 // Exprs represents a list of value expressions. It's not a valid expression
 // because it's not parenthesized.
 type Exprs []Expr
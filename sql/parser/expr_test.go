@@ -108,10 +108,13 @@ func TestNormalizeColumnName(t *testing.T) {
 		{`foo.*`, `foo.*`, ``},
 		{`foo.bar[blah]`, `foo.bar[blah]`, ``},
 		{`foo[bar]`, `"".foo[bar]`, ``},
+		{`test.foo.bar`, `test.foo.bar`, ``},
+		{`test.foo.*`, `test.foo.*`, ``},
+		{`test.foo.bar[blah]`, `test.foo.bar[blah]`, ``},
 
 		{`""`, ``, `empty column name`},
-		{`test.foo.bar`, ``, `invalid column name: test.foo.bar`},
-		{`test.foo.*`, ``, `invalid column name: test.foo.*`},
+		{`test.foo.bar.baz`, ``, `invalid column name: test.foo.bar.baz`},
+		{`test.foo.*.baz`, ``, `invalid column name: test.foo.*.baz`},
 		{`test.foo@bar`, ``, `invalid column name: test.foo@bar`},
 		{`test.foo.bar@blah`, ``, `invalid column name: test.foo.bar@blah`},
 	}
@@ -0,0 +1,59 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Import represents an IMPORT statement, which creates a table and fills it
+// with the rows of the given CSV files.
+type Import struct {
+	Table   *QualifiedName
+	Defs    TableDefs
+	Files   Exprs
+	Options KVOptions
+}
+
+func (node *Import) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "IMPORT TABLE %s (%s) CSV DATA (%s)", node.Table, node.Defs, node.Files)
+	if node.Options != nil {
+		fmt.Fprintf(&buf, " WITH %s", node.Options)
+	}
+	return buf.String()
+}
+
+// KVOption is a key-value option of a statement.
+type KVOption struct {
+	Key   Name
+	Value Expr
+}
+
+// KVOptions represents a list of key-value options.
+type KVOptions []KVOption
+
+func (o KVOptions) String() string {
+	var buf bytes.Buffer
+	for i, opt := range o {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s = %s", opt.Key, opt.Value)
+	}
+	return buf.String()
+}
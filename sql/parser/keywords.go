@@ -16,6 +16,7 @@ var keywords = map[string]int{
 	"ASC":               ASC,
 	"ASYMMETRIC":        ASYMMETRIC,
 	"AT":                AT,
+	"BACKUP":            BACKUP,
 	"BEGIN":             BEGIN,
 	"BETWEEN":           BETWEEN,
 	"BIGINT":            BIGINT,
@@ -37,13 +38,16 @@ var keywords = map[string]int{
 	"COLLATION":         COLLATION,
 	"COLUMN":            COLUMN,
 	"COLUMNS":           COLUMNS,
+	"COMMENT":           COMMENT,
 	"COMMIT":            COMMIT,
 	"COMMITTED":         COMMITTED,
+	"CONFIGURE":         CONFIGURE,
 	"CONFLICT":          CONFLICT,
 	"CONSTRAINT":        CONSTRAINT,
 	"COVERING":          COVERING,
 	"CREATE":            CREATE,
 	"CROSS":             CROSS,
+	"CSV":               CSV,
 	"CUBE":              CUBE,
 	"CURRENT":           CURRENT,
 	"CURRENT_CATALOG":   CURRENT_CATALOG,
@@ -64,6 +68,7 @@ var keywords = map[string]int{
 	"DEFERRABLE":        DEFERRABLE,
 	"DELETE":            DELETE,
 	"DESC":              DESC,
+	"DISCARD":           DISCARD,
 	"DISTINCT":          DISTINCT,
 	"DO":                DO,
 	"DOUBLE":            DOUBLE,
@@ -71,8 +76,10 @@ var keywords = map[string]int{
 	"ELSE":              ELSE,
 	"END":               END,
 	"EXCEPT":            EXCEPT,
+	"EXECUTE":           EXECUTE,
 	"EXISTS":            EXISTS,
 	"EXPLAIN":           EXPLAIN,
+	"EXTERNAL":          EXTERNAL,
 	"EXTRACT":           EXTRACT,
 	"FALSE":             FALSE,
 	"FETCH":             FETCH,
@@ -93,7 +100,10 @@ var keywords = map[string]int{
 	"HOUR":              HOUR,
 	"IF":                IF,
 	"IFNULL":            IFNULL,
+	"ILIKE":             ILIKE,
+	"IMPORT":            IMPORT,
 	"IN":                IN,
+	"INCREMENTAL":       INCREMENTAL,
 	"INDEX":             INDEX,
 	"INITIALLY":         INITIALLY,
 	"INNER":             INNER,
@@ -160,14 +170,18 @@ var keywords = map[string]int{
 	"REFERENCES":        REFERENCES,
 	"RENAME":            RENAME,
 	"REPEATABLE":        REPEATABLE,
+	"RESET":             RESET,
+	"RESTORE":           RESTORE,
 	"RESTRICT":          RESTRICT,
 	"RETURNING":         RETURNING,
 	"REVOKE":            REVOKE,
 	"RIGHT":             RIGHT,
+	"ROLE":              ROLE,
 	"ROLLBACK":          ROLLBACK,
 	"ROLLUP":            ROLLUP,
 	"ROW":               ROW,
 	"ROWS":              ROWS,
+	"SCATTER":           SCATTER,
 	"SEARCH":            SEARCH,
 	"SECOND":            SECOND,
 	"SELECT":            SELECT,
@@ -181,6 +195,7 @@ var keywords = map[string]int{
 	"SMALLINT":          SMALLINT,
 	"SNAPSHOT":          SNAPSHOT,
 	"SOME":              SOME,
+	"SPLIT":             SPLIT,
 	"SQL":               SQL,
 	"STORING":           STORING,
 	"STRICT":            STRICT,
@@ -200,6 +215,7 @@ var keywords = map[string]int{
 	"TRIM":              TRIM,
 	"TRUE":              TRUE,
 	"TRUNCATE":          TRUNCATE,
+	"TTL":               TTL,
 	"TYPE":              TYPE,
 	"UNBOUNDED":         UNBOUNDED,
 	"UNCOMMITTED":       UNCOMMITTED,
@@ -20,6 +20,7 @@ package parser
 import (
 	"fmt"
 	"math"
+	"math/big"
 )
 
 type normalizableExpr interface {
@@ -303,6 +304,7 @@ func (expr DTuple) normalize(_ *normalizeVisitor) Expr {
 //   (a)                   -> a
 //   ROW(a, b, c)          -> (a, b, c)
 //   a = 1 + 1             -> a = 2
+//   a = 0.1 + 0.2         -> a = 0.3
 //   a + 1 = 2             -> a = 1
 //   a BETWEEN b AND c     -> (a >= b) AND (a <= c)
 //   a NOT BETWEEN b AND c -> (a < b) OR (a > c)
@@ -333,6 +335,12 @@ func (v *normalizeVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
 	}
 
 	if pre {
+		// Fold arithmetic on numeric literals exactly, before the literals are
+		// evaluated (and rounded) on the upward traversal.
+		if folded, ok := foldNumericConstant(expr); ok {
+			return v, folded
+		}
+
 		switch expr.(type) {
 		case *CaseExpr, *IfExpr, *NullIfExpr, *CoalesceExpr:
 			// Conditional expressions need to be evaluated during the downward
@@ -358,6 +366,90 @@ func (v *normalizeVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
 	return v, expr
 }
 
+// foldNumericConstant evaluates an arithmetic expression whose operands are
+// all numeric literals using exact arithmetic, so that 0.1 + 0.2 evaluates
+// to the same value as 0.3. The result is an integer if every operand is an
+// integer and no division is involved, and a float otherwise. Expressions
+// which cannot be folded exactly (e.g. a division by zero or an integer
+// result out of range) are left alone to be evaluated as usual.
+func foldNumericConstant(expr Expr) (Datum, bool) {
+	switch expr.(type) {
+	case *BinaryExpr, *UnaryExpr:
+	default:
+		return nil, false
+	}
+	r, isInt, ok := numericConstant(expr)
+	if !ok {
+		return nil, false
+	}
+	if !isInt {
+		f, _ := r.Float64()
+		return DFloat(f), true
+	}
+	if i := r.Num(); i.BitLen() < 64 || i.Cmp(minInt64) == 0 {
+		return DInt(i.Int64()), true
+	}
+	return nil, false
+}
+
+var minInt64 = big.NewInt(math.MinInt64)
+
+// numericConstant returns the exact value of an arithmetic expression over
+// numeric literals and whether that value is an integer.
+func numericConstant(expr Expr) (r *big.Rat, isInt bool, ok bool) {
+	switch t := expr.(type) {
+	case IntVal:
+		// IntVal uses math.MinInt64 to represent 1 << 63; see IntVal.String.
+		r, ok = new(big.Rat).SetString(t.String())
+		return r, true, ok
+
+	case NumVal:
+		r, ok = new(big.Rat).SetString(string(t))
+		return r, false, ok
+
+	case *ParenExpr:
+		return numericConstant(t.Expr)
+
+	case *UnaryExpr:
+		r, isInt, ok = numericConstant(t.Expr)
+		if !ok {
+			return nil, false, false
+		}
+		switch t.Operator {
+		case UnaryPlus:
+			return r, isInt, true
+		case UnaryMinus:
+			return r.Neg(r), isInt, true
+		}
+
+	case *BinaryExpr:
+		left, leftInt, ok := numericConstant(t.Left)
+		if !ok {
+			return nil, false, false
+		}
+		right, rightInt, ok := numericConstant(t.Right)
+		if !ok {
+			return nil, false, false
+		}
+		isInt = leftInt && rightInt
+		switch t.Operator {
+		case Plus:
+			return left.Add(left, right), isInt, true
+		case Minus:
+			return left.Sub(left, right), isInt, true
+		case Mult:
+			return left.Mul(left, right), isInt, true
+		case Div:
+			// Division of integers yields a float, as in BinaryExpr.Eval.
+			if right.Sign() == 0 {
+				return nil, false, false
+			}
+			return left.Quo(left, right), false, true
+		}
+	}
+	return nil, false, false
+}
+
 func invertComparisonOp(op ComparisonOp) ComparisonOp {
 	switch op {
 	case EQ:
@@ -39,6 +39,13 @@ func TestNormalizeExpr(t *testing.T) {
 		{`1+1+a`, `2 + a`},
 		{`a=1+1`, `a = 2`},
 		{`a=1+(2*3)-4`, `a = 3`},
+		{`0.1+0.2`, `0.3`},
+		{`0.1+0.2=0.3`, `true`},
+		{`a=0.1+0.2`, `a = 0.3`},
+		{`a=-(0.7-0.4)`, `a = -0.3`},
+		{`1+0.5`, `1.5`},
+		{`1/3*3=1.0`, `true`},
+		{`-(9223372036854775807+1)`, `-9223372036854775808`},
 		{`true OR a`, `true`},
 		{`false OR a`, `a`},
 		{`NULL OR a`, `NULL OR a`},
@@ -103,6 +110,8 @@ func TestNormalizeExprError(t *testing.T) {
 		expected string
 	}{
 		{`9223372036854775808`, `integer value out of range`},
+		{`9223372036854775807+9223372036854775808`, `integer value out of range`},
+		{`1/0`, `division by zero`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
@@ -34,6 +34,9 @@ func TestParse(t *testing.T) {
 		{``},
 		{`VALUES ("")`},
 
+		{`ANALYZE a`},
+		{`ANALYZE db.a`},
+
 		{`BEGIN TRANSACTION`},
 		{`BEGIN TRANSACTION ISOLATION LEVEL SNAPSHOT`},
 		{`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
@@ -47,6 +50,7 @@ func TestParse(t *testing.T) {
 		{`CREATE INDEX a ON b.c (d)`},
 		{`CREATE INDEX ON a (b)`},
 		{`CREATE INDEX ON a (b) STORING (c)`},
+		{`CREATE INDEX ON a (b ASC, c DESC)`},
 		{`CREATE UNIQUE INDEX a ON b (c)`},
 		{`CREATE UNIQUE INDEX a ON b (c) STORING (d)`},
 		{`CREATE UNIQUE INDEX a ON b.c (d)`},
@@ -64,6 +68,10 @@ func TestParse(t *testing.T) {
 		{`CREATE TABLE a (b INT NULL PRIMARY KEY)`},
 		{`CREATE TABLE a (b INT DEFAULT 1)`},
 		{`CREATE TABLE a (b INT DEFAULT now())`},
+		{`CREATE TABLE a (b TIMESTAMP ON UPDATE now())`},
+		{`CREATE TABLE a (b TIMESTAMP NOT NULL DEFAULT now() ON UPDATE now())`},
+		{`CREATE TABLE a (b INT[])`},
+		{`CREATE TABLE a (b STRING[] DEFAULT ARRAY['c'])`},
 		// "0" lost quotes previously.
 		{`CREATE TABLE a (b INT, c TEXT, PRIMARY KEY (b, c, "0"))`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX (b, c))`},
@@ -79,6 +87,9 @@ func TestParse(t *testing.T) {
 		{`DELETE FROM a`},
 		{`DELETE FROM a.b`},
 		{`DELETE FROM a WHERE a = b`},
+		{`DELETE FROM a WHERE a = b LIMIT c`},
+		{`DELETE FROM a WHERE a = b ORDER BY c`},
+		{`DELETE FROM a WHERE a = b ORDER BY c DESC LIMIT d`},
 
 		{`DROP DATABASE a`},
 		{`DROP DATABASE IF EXISTS a`},
@@ -91,6 +102,7 @@ func TestParse(t *testing.T) {
 
 		{`EXPLAIN SELECT 1`},
 		{`EXPLAIN (DEBUG) SELECT 1`},
+		{`EXPLAIN (ANALYZE) SELECT 1`},
 		{`EXPLAIN (A, B, C) SELECT 1`},
 
 		{`SHOW BARFOO`},
@@ -122,6 +134,11 @@ func TestParse(t *testing.T) {
 		// GRANT x ON TABLE y. However, the stringer does not output TABLE.
 		{`GRANT SELECT ON foo TO root`},
 		{`GRANT SELECT, DELETE, UPDATE ON foo, db.foo TO root, bar`},
+		{`GRANT foo TO bar`},
+		{`GRANT foo TO bar, baz`},
+		{`REVOKE foo FROM bar, baz`},
+		{`CREATE ROLE foo`},
+		{`DROP ROLE foo`},
 		{`GRANT DROP ON DATABASE foo TO root`},
 		{`GRANT ALL ON DATABASE foo TO root, test`},
 		{`GRANT SELECT, INSERT ON DATABASE bar TO foo, bar, baz`},
@@ -207,6 +224,11 @@ func TestParse(t *testing.T) {
 
 		{`SELECT "FROM" FROM t`},
 		{`SELECT CAST(1 AS TEXT)`},
+		{`SELECT CAST(a AS INT[])`},
+		{`SELECT ARRAY[1, 2]`},
+		{`SELECT ARRAY[]`},
+		{`SELECT (ARRAY[1, 2])[1]`},
+		{`SELECT (ARRAY[1, 2, 3])[a:b]`},
 		{`SELECT FROM t AS bar`},
 		{`SELECT FROM (SELECT 1 FROM t)`},
 		{`SELECT FROM (SELECT 1 FROM t) AS bar`},
@@ -233,6 +255,16 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t WHERE a NOT LIKE b`},
 		{`SELECT FROM t WHERE a SIMILAR TO b`},
 		{`SELECT FROM t WHERE a NOT SIMILAR TO b`},
+		{`SELECT FROM t WHERE a ILIKE b`},
+		{`SELECT FROM t WHERE a NOT ILIKE b`},
+		{`SELECT FROM t WHERE a ~ b`},
+		{`SELECT FROM t WHERE a !~ b`},
+		{`SELECT FROM t WHERE a ~* b`},
+		{`SELECT FROM t WHERE a !~* b`},
+		{`SELECT FROM t WHERE a || b ~ c`},
+		{`SELECT FROM t WHERE a @> b`},
+		{`SELECT FROM t WHERE a <@ b`},
+		{`SELECT FROM t WHERE a || b @> c`},
 		{`SELECT FROM t WHERE a BETWEEN b AND c`},
 		{`SELECT FROM t WHERE a NOT BETWEEN b AND c`},
 		{`SELECT FROM t WHERE a IS NULL`},
@@ -329,6 +361,12 @@ func TestParse(t *testing.T) {
 		{`TRUNCATE TABLE a`},
 		{`TRUNCATE TABLE a, b.c`},
 
+		{`EXECUTE SELECT * FROM t DISCARD ROWS`},
+		{`EXECUTE INSERT INTO t VALUES (1) DISCARD ROWS`},
+		{`VALIDATE foo`},
+		{`VALIDATE foo, db.foo`},
+		{`VALIDATE DATABASE foo, bar`},
+
 		{`UPDATE a SET b = 3`},
 		{`UPDATE a.b SET b = 3`},
 		{`UPDATE a SET b.c = 3`},
@@ -337,6 +375,9 @@ func TestParse(t *testing.T) {
 		{`UPDATE a SET (b, c) = (3, DEFAULT)`},
 		{`UPDATE a SET (b, c) = (SELECT 3, 4)`},
 		{`UPDATE a SET b = 3 WHERE a = b`},
+		{`UPDATE a SET b = 3 WHERE a = b LIMIT c`},
+		{`UPDATE a SET b = 3 WHERE a = b ORDER BY c`},
+		{`UPDATE a SET b = 3 WHERE a = b ORDER BY c DESC LIMIT d`},
 		{`UPDATE T AS "0" SET K = ''`},                 // "0" lost its quotes
 		{`SELECT * FROM "0" JOIN "0" USING (id, "0")`}, // last "0" lost its quotes.
 
@@ -348,6 +389,32 @@ func TestParse(t *testing.T) {
 		{`ALTER TABLE a RENAME COLUMN c1 TO c2`},
 		{`ALTER TABLE IF EXISTS a RENAME COLUMN c1 TO c2`},
 
+		{`ALTER DATABASE a CONFIGURE ZONE 'range_max_bytes: 67108864'`},
+		{`ALTER DATABASE a CONFIGURE ZONE NULL`},
+		{`ALTER TABLE a.b CONFIGURE ZONE $1`},
+		{`ALTER TABLE a SPLIT AT (1)`},
+		{`ALTER TABLE a.b SPLIT AT (1, 'x', $1)`},
+		{`ALTER TABLE a SCATTER`},
+
+		{`COMMENT ON TABLE foo IS 'a table'`},
+		{`COMMENT ON TABLE foo.bar IS NULL`},
+		{`COMMENT ON COLUMN foo.a IS e'it\'s a column'`},
+		{`COMMENT ON COLUMN foo.bar.a IS NULL`},
+
+		{`BACKUP foo TO 'bar'`},
+		{`BACKUP foo.foo, baz.baz TO 'bar'`},
+		{`BACKUP DATABASE foo TO 'bar'`},
+		{`BACKUP DATABASE foo, baz TO 'bar' INCREMENTAL FROM 'baz', 'qux'`},
+		{`RESTORE foo FROM 'bar'`},
+		{`RESTORE foo.foo FROM $1, $2`},
+		{`RESTORE DATABASE foo, baz FROM 'bar', 'baz'`},
+
+		{`IMPORT TABLE foo (k INT PRIMARY KEY, v STRING) CSV DATA ('a.csv')`},
+		{`IMPORT TABLE foo.bar (k INT PRIMARY KEY, v STRING, INDEX (v)) CSV DATA ('a.csv', $1) WITH delimiter = '|'`},
+		{`IMPORT TABLE foo (k INT PRIMARY KEY) CSV DATA ('a.csv') WITH delimiter = e'\t', comment = '#'`},
+		{`CREATE EXTERNAL TABLE foo (k INT PRIMARY KEY, v STRING) CSV DATA ('http://example.com/a.csv')`},
+		{`CREATE EXTERNAL TABLE foo.bar (k INT PRIMARY KEY) CSV DATA ('a.csv', 'b.csv') WITH delimiter = '|'`},
+
 		{`ALTER TABLE a ADD b INT, ADD CONSTRAINT a_idx UNIQUE (a)`},
 		{`ALTER TABLE a ADD IF NOT EXISTS b INT, ADD CONSTRAINT a_idx UNIQUE (a)`},
 		{`ALTER TABLE IF EXISTS a ADD b INT, ADD CONSTRAINT a_idx UNIQUE (a)`},
@@ -365,6 +432,9 @@ func TestParse(t *testing.T) {
 		{`ALTER TABLE a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
+		{`ALTER TABLE a SET TTL '24h' ON created`},
+		{`ALTER TABLE a RESET TTL`},
+		{`ALTER TABLE a ADD b INT, SET TTL '1h' ON c`},
 	}
 	for _, d := range testData {
 		stmts, err := ParseTraditional(d.sql)
@@ -386,11 +456,13 @@ func TestParse2(t *testing.T) {
 		sql      string
 		expected string
 	}{
-		{`CREATE INDEX ON a (b ASC, c DESC)`, `CREATE INDEX ON a (b, c)`},
 		{`CREATE TABLE a (b INT, UNIQUE INDEX foo (b))`,
 			`CREATE TABLE a (b INT, CONSTRAINT foo UNIQUE (b))`},
 		{`CREATE INDEX ON a (b) COVERING (c)`, `CREATE INDEX ON a (b) STORING (c)`},
 
+		{`EXPLAIN ANALYZE SELECT 1`, `EXPLAIN (ANALYZE) SELECT 1`},
+		{`ANALYSE a`, `ANALYZE a`},
+
 		{`SELECT BOOL 'foo'`, `SELECT CAST('foo' AS BOOL)`},
 		{`SELECT INT 'foo'`, `SELECT CAST('foo' AS INT)`},
 		{`SELECT REAL 'foo'`, `SELECT CAST('foo' AS REAL)`},
@@ -441,9 +513,21 @@ func TestParse2(t *testing.T) {
 		// We allow OFFSET before LIMIT, but always output LIMIT first.
 		{`SELECT FROM t OFFSET a LIMIT b`,
 			`SELECT FROM t LIMIT b OFFSET a`},
+		// LIMIT ALL is the same as no LIMIT.
+		{`DELETE FROM a LIMIT ALL`,
+			`DELETE FROM a`},
 		// Shorthand type cast.
 		{`SELECT '1'::INT`,
 			`SELECT CAST('1' AS INT)`},
+		// Array types.
+		{`CREATE TABLE a (b INT ARRAY)`,
+			`CREATE TABLE a (b INT[])`},
+		{`CREATE TABLE a (b INT ARRAY[3])`,
+			`CREATE TABLE a (b INT[])`},
+		{`CREATE TABLE a (b INT[3])`,
+			`CREATE TABLE a (b INT[])`},
+		{`SELECT a::STRING[]`,
+			`SELECT CAST(a AS STRING[])`},
 		// Double negation. See #1800.
 		{`SELECT *,-/* comment */-5`,
 			`SELECT *, - - 5`},
@@ -533,6 +617,10 @@ b = 2
 		{`SET TIME ZONE INTERVAL 'foobar'`, `cannot evaluate to an interval type at or near "EOF"
 SET TIME ZONE INTERVAL 'foobar'
                                ^
+`},
+		{`DELETE FROM t LIMIT 1 OFFSET 2`, `syntax error at or near "OFFSET"
+DELETE FROM t LIMIT 1 OFFSET 2
+                      ^
 `},
 		{`SELECT 1 /* hello`, `unterminated comment
 SELECT 1 /* hello
@@ -599,6 +687,13 @@ CREATE TABLE a (b INT DEFAULT c)
 			`default expression contains a subquery at or near ")"
 CREATE TABLE a (b INT DEFAULT (SELECT 1))
                                         ^
+`,
+		},
+		{
+			`CREATE TABLE a (b INT ON UPDATE c + 1)`,
+			`on update expression contains a variable at or near ")"
+CREATE TABLE a (b INT ON UPDATE c + 1)
+                                     ^
 `,
 		},
 	}
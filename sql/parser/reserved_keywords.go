@@ -29,6 +29,7 @@ var reservedKeywords = map[string]struct{}{
 	"DEFAULT":           {},
 	"DEFERRABLE":        {},
 	"DESC":              {},
+	"DISCARD":           {},
 	"DISTINCT":          {},
 	"DO":                {},
 	"ELSE":              {},
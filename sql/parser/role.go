@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// CreateRole represents a CREATE ROLE statement.
+type CreateRole struct {
+	Name Name
+}
+
+func (node *CreateRole) String() string {
+	return fmt.Sprintf("CREATE ROLE %s", node.Name)
+}
+
+// DropRole represents a DROP ROLE statement.
+type DropRole struct {
+	Name Name
+}
+
+func (node *DropRole) String() string {
+	return fmt.Sprintf("DROP ROLE %s", node.Name)
+}
+
+// GrantRole represents a GRANT statement granting membership in a role.
+type GrantRole struct {
+	Role    Name
+	Members NameList
+}
+
+func (node *GrantRole) String() string {
+	return fmt.Sprintf("GRANT %s TO %s", node.Role, node.Members)
+}
+
+// RevokeRole represents a REVOKE statement revoking membership in a role.
+type RevokeRole struct {
+	Role    Name
+	Members NameList
+}
+
+func (node *RevokeRole) String() string {
+	return fmt.Sprintf("REVOKE %s FROM %s", node.Role, node.Members)
+}
@@ -81,7 +81,7 @@ func (s *scanner) Lex(lval *sqlSymType) int {
 	switch lval.id {
 	case NOT:
 		switch s.nextTok.id {
-		case BETWEEN, IN, LIKE, SIMILAR:
+		case BETWEEN, ILIKE, IN, LIKE, SIMILAR:
 			lval.id = NOT_LA
 		}
 
@@ -261,6 +261,25 @@ func (s *scanner) scan(lval *sqlSymType) {
 			s.pos++
 			lval.id = NOT_EQUALS
 			return
+		case '~': // !~
+			s.pos++
+			switch s.peek() {
+			case '*': // !~*
+				s.pos++
+				lval.id = NOT_REGIMATCH
+				return
+			}
+			lval.id = NOT_REGMATCH
+			return
+		}
+		return
+
+	case '~':
+		switch s.peek() {
+		case '*': // ~*
+			s.pos++
+			lval.id = REGIMATCH
+			return
 		}
 		return
 
@@ -278,6 +297,19 @@ func (s *scanner) scan(lval *sqlSymType) {
 			s.pos++
 			lval.id = LESS_EQUALS
 			return
+		case '@': // <@
+			s.pos++
+			lval.id = CONTAINED_BY
+			return
+		}
+		return
+
+	case '@':
+		switch s.peek() {
+		case '>': // @>
+			s.pos++
+			lval.id = CONTAINS
+			return
 		}
 		return
 
@@ -38,10 +38,17 @@ func TestScanner(t *testing.T) {
 		{`..`, []int{DOT_DOT}},
 		{`!`, []int{'!'}},
 		{`!=`, []int{NOT_EQUALS}},
+		{`!~`, []int{NOT_REGMATCH}},
+		{`!~*`, []int{NOT_REGIMATCH}},
+		{`~`, []int{'~'}},
+		{`~*`, []int{REGIMATCH}},
 		{`<`, []int{'<'}},
 		{`<>`, []int{NOT_EQUALS}},
 		{`<=`, []int{LESS_EQUALS}},
 		{`<<`, []int{LSHIFT}},
+		{`<@`, []int{CONTAINED_BY}},
+		{`@`, []int{'@'}},
+		{`@>`, []int{CONTAINS}},
 		{`>`, []int{'>'}},
 		{`>=`, []int{GREATER_EQUALS}},
 		{`>>`, []int{RSHIFT}},
@@ -82,6 +89,7 @@ func TestScanner(t *testing.T) {
 		{`NOT BETWEEN`, []int{NOT_LA, BETWEEN}},
 		{`NOT IN`, []int{NOT_LA, IN}},
 		{`NOT SIMILAR`, []int{NOT_LA, SIMILAR}},
+		{`NOT ILIKE`, []int{NOT_LA, ILIKE}},
 		{`NULLS`, []int{NULLS}},
 		{`WITH`, []int{WITH}},
 		{`WITH TIME`, []int{WITH_LA, TIME}},
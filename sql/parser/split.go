@@ -0,0 +1,38 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// Split represents an ALTER TABLE ... SPLIT AT statement. The expressions
+// are the values of the leading primary key columns at which to split.
+type Split struct {
+	Table *QualifiedName
+	Exprs Exprs
+}
+
+func (node *Split) String() string {
+	return fmt.Sprintf("ALTER TABLE %s SPLIT AT (%s)", node.Table, node.Exprs)
+}
+
+// Scatter represents an ALTER TABLE ... SCATTER statement.
+type Scatter struct {
+	Table *QualifiedName
+}
+
+func (node *Scatter) String() string {
+	return fmt.Sprintf("ALTER TABLE %s SCATTER", node.Table)
+}
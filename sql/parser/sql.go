@@ -57,9 +57,14 @@ type sqlSymType struct {
 	order          *Order
 	groupBy        GroupBy
 	dir            Direction
+	idxElem        IndexElem
+	idxElems       IndexElemList
 	alterTableCmd  AlterTableCmd
 	alterTableCmds AlterTableCmds
 	isoLevel       IsolationLevel
+	kvOption       KVOption
+	kvOptions      KVOptions
+	strPtr         *string
 }
 
 const IDENT = 57346
@@ -73,236 +78,257 @@ const DOT_DOT = 57353
 const LESS_EQUALS = 57354
 const GREATER_EQUALS = 57355
 const NOT_EQUALS = 57356
-const ERROR = 57357
-const ACTION = 57358
-const ADD = 57359
-const ALL = 57360
-const ALTER = 57361
-const ANALYSE = 57362
-const ANALYZE = 57363
-const AND = 57364
-const ANY = 57365
-const ARRAY = 57366
-const AS = 57367
-const ASC = 57368
-const ASYMMETRIC = 57369
-const AT = 57370
-const BEGIN = 57371
-const BETWEEN = 57372
-const BIGINT = 57373
-const BIT = 57374
-const BLOB = 57375
-const BOOL = 57376
-const BOOLEAN = 57377
-const BOTH = 57378
-const BY = 57379
-const BYTES = 57380
-const CASCADE = 57381
-const CASE = 57382
-const CAST = 57383
-const CHAR = 57384
-const CHARACTER = 57385
-const CHECK = 57386
-const COALESCE = 57387
-const COLLATE = 57388
-const COLLATION = 57389
-const COLUMN = 57390
-const COLUMNS = 57391
-const COMMIT = 57392
-const COMMITTED = 57393
-const CONCAT = 57394
-const CONFLICT = 57395
-const CONSTRAINT = 57396
-const COVERING = 57397
-const CREATE = 57398
-const CROSS = 57399
-const CUBE = 57400
-const CURRENT = 57401
-const CURRENT_CATALOG = 57402
-const CURRENT_DATE = 57403
-const CURRENT_ROLE = 57404
-const CURRENT_TIME = 57405
-const CURRENT_TIMESTAMP = 57406
-const CURRENT_USER = 57407
-const CYCLE = 57408
-const DATA = 57409
-const DATABASE = 57410
-const DATABASES = 57411
-const DATE = 57412
-const DAY = 57413
-const DEC = 57414
-const DECIMAL = 57415
-const DEFAULT = 57416
-const DEFERRABLE = 57417
-const DELETE = 57418
-const DESC = 57419
-const DISTINCT = 57420
-const DO = 57421
-const DOUBLE = 57422
-const DROP = 57423
-const ELSE = 57424
-const END = 57425
-const ESCAPE = 57426
-const EXCEPT = 57427
-const EXISTS = 57428
-const EXPLAIN = 57429
-const EXTRACT = 57430
-const FALSE = 57431
-const FETCH = 57432
-const FILTER = 57433
-const FIRST = 57434
-const FLOAT = 57435
-const FOLLOWING = 57436
-const FOR = 57437
-const FOREIGN = 57438
-const FROM = 57439
-const FULL = 57440
-const GRANT = 57441
-const GRANTS = 57442
-const GREATEST = 57443
-const GROUP = 57444
-const GROUPING = 57445
-const HAVING = 57446
-const HOUR = 57447
-const IF = 57448
-const IFNULL = 57449
-const IN = 57450
-const INDEX = 57451
-const INITIALLY = 57452
-const INNER = 57453
-const INSERT = 57454
-const INT = 57455
-const INT64 = 57456
-const INTEGER = 57457
-const INTERSECT = 57458
-const INTERVAL = 57459
-const INTO = 57460
-const IS = 57461
-const ISOLATION = 57462
-const JOIN = 57463
-const KEY = 57464
-const LATERAL = 57465
-const LEADING = 57466
-const LEAST = 57467
-const LEFT = 57468
-const LEVEL = 57469
-const LIKE = 57470
-const LIMIT = 57471
-const LOCAL = 57472
-const LOCALTIME = 57473
-const LOCALTIMESTAMP = 57474
-const LSHIFT = 57475
-const MATCH = 57476
-const MINUTE = 57477
-const MONTH = 57478
-const NAME = 57479
-const NAMES = 57480
-const NATURAL = 57481
-const NEXT = 57482
-const NO = 57483
-const NOT = 57484
-const NOTHING = 57485
-const NULL = 57486
-const NULLIF = 57487
-const NULLS = 57488
-const NUMERIC = 57489
-const OF = 57490
-const OFF = 57491
-const OFFSET = 57492
-const ON = 57493
-const ONLY = 57494
-const OR = 57495
-const ORDER = 57496
-const ORDINALITY = 57497
-const OUT = 57498
-const OUTER = 57499
-const OVER = 57500
-const OVERLAPS = 57501
-const OVERLAY = 57502
-const PARTIAL = 57503
-const PARTITION = 57504
-const PLACING = 57505
-const POSITION = 57506
-const PRECEDING = 57507
-const PRECISION = 57508
-const PRIMARY = 57509
-const RANGE = 57510
-const READ = 57511
-const REAL = 57512
-const RECURSIVE = 57513
-const REF = 57514
-const REFERENCES = 57515
-const RENAME = 57516
-const REPEATABLE = 57517
-const RESTRICT = 57518
-const RETURNING = 57519
-const REVOKE = 57520
-const RIGHT = 57521
-const ROLLBACK = 57522
-const ROLLUP = 57523
-const ROW = 57524
-const ROWS = 57525
-const RSHIFT = 57526
-const SEARCH = 57527
-const SECOND = 57528
-const SELECT = 57529
-const SERIALIZABLE = 57530
-const SESSION = 57531
-const SESSION_USER = 57532
-const SET = 57533
-const SHOW = 57534
-const SIMILAR = 57535
-const SIMPLE = 57536
-const SMALLINT = 57537
-const SNAPSHOT = 57538
-const SOME = 57539
-const SQL = 57540
-const STRICT = 57541
-const STRING = 57542
-const STORING = 57543
-const SUBSTRING = 57544
-const SYMMETRIC = 57545
-const TABLE = 57546
-const TABLES = 57547
-const TEXT = 57548
-const THEN = 57549
-const TIME = 57550
-const TIMESTAMP = 57551
-const TO = 57552
-const TRAILING = 57553
-const TRANSACTION = 57554
-const TREAT = 57555
-const TRIM = 57556
-const TRUE = 57557
-const TRUNCATE = 57558
-const TYPE = 57559
-const UNBOUNDED = 57560
-const UNCOMMITTED = 57561
-const UNION = 57562
-const UNIQUE = 57563
-const UNKNOWN = 57564
-const UPDATE = 57565
-const USER = 57566
-const USING = 57567
-const VALID = 57568
-const VALIDATE = 57569
-const VALUE = 57570
-const VALUES = 57571
-const VARCHAR = 57572
-const VARIADIC = 57573
-const VARYING = 57574
-const WHEN = 57575
-const WHERE = 57576
-const WINDOW = 57577
-const WITH = 57578
-const WITHIN = 57579
-const WITHOUT = 57580
-const YEAR = 57581
-const ZONE = 57582
-const NOT_LA = 57583
-const WITH_LA = 57584
-const POSTFIXOP = 57585
-const UMINUS = 57586
+const NOT_REGMATCH = 57357
+const REGIMATCH = 57358
+const NOT_REGIMATCH = 57359
+const CONTAINS = 57360
+const CONTAINED_BY = 57361
+const ERROR = 57362
+const ACTION = 57363
+const ADD = 57364
+const ALL = 57365
+const ALTER = 57366
+const ANALYSE = 57367
+const ANALYZE = 57368
+const AND = 57369
+const ANY = 57370
+const ARRAY = 57371
+const AS = 57372
+const ASC = 57373
+const ASYMMETRIC = 57374
+const AT = 57375
+const BACKUP = 57376
+const BEGIN = 57377
+const BETWEEN = 57378
+const BIGINT = 57379
+const BIT = 57380
+const BLOB = 57381
+const BOOL = 57382
+const BOOLEAN = 57383
+const BOTH = 57384
+const BY = 57385
+const BYTES = 57386
+const CASCADE = 57387
+const CASE = 57388
+const CAST = 57389
+const CHAR = 57390
+const CHARACTER = 57391
+const CHECK = 57392
+const COALESCE = 57393
+const COLLATE = 57394
+const COLLATION = 57395
+const COLUMN = 57396
+const COLUMNS = 57397
+const COMMENT = 57398
+const COMMIT = 57399
+const COMMITTED = 57400
+const CONCAT = 57401
+const CONFIGURE = 57402
+const CONFLICT = 57403
+const CONSTRAINT = 57404
+const COVERING = 57405
+const CREATE = 57406
+const CROSS = 57407
+const CSV = 57408
+const CUBE = 57409
+const CURRENT = 57410
+const CURRENT_CATALOG = 57411
+const CURRENT_DATE = 57412
+const CURRENT_ROLE = 57413
+const CURRENT_TIME = 57414
+const CURRENT_TIMESTAMP = 57415
+const CURRENT_USER = 57416
+const CYCLE = 57417
+const DATA = 57418
+const DATABASE = 57419
+const DATABASES = 57420
+const DATE = 57421
+const DAY = 57422
+const DEC = 57423
+const DECIMAL = 57424
+const DEFAULT = 57425
+const DEFERRABLE = 57426
+const DELETE = 57427
+const DESC = 57428
+const DISCARD = 57429
+const DISTINCT = 57430
+const DO = 57431
+const DOUBLE = 57432
+const DROP = 57433
+const ELSE = 57434
+const END = 57435
+const ESCAPE = 57436
+const EXCEPT = 57437
+const EXECUTE = 57438
+const EXISTS = 57439
+const EXPLAIN = 57440
+const EXTERNAL = 57441
+const EXTRACT = 57442
+const FALSE = 57443
+const FETCH = 57444
+const FILTER = 57445
+const FIRST = 57446
+const FLOAT = 57447
+const FOLLOWING = 57448
+const FOR = 57449
+const FOREIGN = 57450
+const FROM = 57451
+const FULL = 57452
+const GRANT = 57453
+const GRANTS = 57454
+const GREATEST = 57455
+const GROUP = 57456
+const GROUPING = 57457
+const HAVING = 57458
+const HOUR = 57459
+const IF = 57460
+const IFNULL = 57461
+const ILIKE = 57462
+const IMPORT = 57463
+const IN = 57464
+const INCREMENTAL = 57465
+const INDEX = 57466
+const INITIALLY = 57467
+const INNER = 57468
+const INSERT = 57469
+const INT = 57470
+const INT64 = 57471
+const INTEGER = 57472
+const INTERSECT = 57473
+const INTERVAL = 57474
+const INTO = 57475
+const IS = 57476
+const ISOLATION = 57477
+const JOIN = 57478
+const KEY = 57479
+const LATERAL = 57480
+const LEADING = 57481
+const LEAST = 57482
+const LEFT = 57483
+const LEVEL = 57484
+const LIKE = 57485
+const LIMIT = 57486
+const LOCAL = 57487
+const LOCALTIME = 57488
+const LOCALTIMESTAMP = 57489
+const LSHIFT = 57490
+const MATCH = 57491
+const MINUTE = 57492
+const MONTH = 57493
+const NAME = 57494
+const NAMES = 57495
+const NATURAL = 57496
+const NEXT = 57497
+const NO = 57498
+const NOT = 57499
+const NOTHING = 57500
+const NULL = 57501
+const NULLIF = 57502
+const NULLS = 57503
+const NUMERIC = 57504
+const OF = 57505
+const OFF = 57506
+const OFFSET = 57507
+const ON = 57508
+const ONLY = 57509
+const OR = 57510
+const ORDER = 57511
+const ORDINALITY = 57512
+const OUT = 57513
+const OUTER = 57514
+const OVER = 57515
+const OVERLAPS = 57516
+const OVERLAY = 57517
+const PARTIAL = 57518
+const PARTITION = 57519
+const PLACING = 57520
+const POSITION = 57521
+const PRECEDING = 57522
+const PRECISION = 57523
+const PRIMARY = 57524
+const RANGE = 57525
+const READ = 57526
+const REAL = 57527
+const RECURSIVE = 57528
+const REF = 57529
+const REFERENCES = 57530
+const RENAME = 57531
+const REPEATABLE = 57532
+const RESET = 57533
+const RESTORE = 57534
+const RESTRICT = 57535
+const RETURNING = 57536
+const REVOKE = 57537
+const RIGHT = 57538
+const ROLE = 57539
+const ROLLBACK = 57540
+const ROLLUP = 57541
+const ROW = 57542
+const ROWS = 57543
+const RSHIFT = 57544
+const SCATTER = 57545
+const SEARCH = 57546
+const SECOND = 57547
+const SELECT = 57548
+const SERIALIZABLE = 57549
+const SESSION = 57550
+const SESSION_USER = 57551
+const SET = 57552
+const SHOW = 57553
+const SIMILAR = 57554
+const SIMPLE = 57555
+const SMALLINT = 57556
+const SNAPSHOT = 57557
+const SOME = 57558
+const SPLIT = 57559
+const SQL = 57560
+const STRICT = 57561
+const STRING = 57562
+const STORING = 57563
+const SUBSTRING = 57564
+const SYMMETRIC = 57565
+const TABLE = 57566
+const TABLES = 57567
+const TEXT = 57568
+const THEN = 57569
+const TIME = 57570
+const TIMESTAMP = 57571
+const TO = 57572
+const TRAILING = 57573
+const TRANSACTION = 57574
+const TREAT = 57575
+const TRIM = 57576
+const TRUE = 57577
+const TRUNCATE = 57578
+const TTL = 57579
+const TYPE = 57580
+const UNBOUNDED = 57581
+const UNCOMMITTED = 57582
+const UNION = 57583
+const UNIQUE = 57584
+const UNKNOWN = 57585
+const UPDATE = 57586
+const USER = 57587
+const USING = 57588
+const VALID = 57589
+const VALIDATE = 57590
+const VALUE = 57591
+const VALUES = 57592
+const VARCHAR = 57593
+const VARIADIC = 57594
+const VARYING = 57595
+const WHEN = 57596
+const WHERE = 57597
+const WINDOW = 57598
+const WITH = 57599
+const WITHIN = 57600
+const WITHOUT = 57601
+const YEAR = 57602
+const ZONE = 57603
+const NOT_LA = 57604
+const WITH_LA = 57605
+const POSTFIXOP = 57606
+const UMINUS = 57607
 
 var sqlToknames = [...]string{
 	"$end",
@@ -319,6 +345,11 @@ var sqlToknames = [...]string{
 	"LESS_EQUALS",
 	"GREATER_EQUALS",
 	"NOT_EQUALS",
+	"NOT_REGMATCH",
+	"REGIMATCH",
+	"NOT_REGIMATCH",
+	"CONTAINS",
+	"CONTAINED_BY",
 	"ERROR",
 	"ACTION",
 	"ADD",
@@ -333,6 +364,7 @@ var sqlToknames = [...]string{
 	"ASC",
 	"ASYMMETRIC",
 	"AT",
+	"BACKUP",
 	"BEGIN",
 	"BETWEEN",
 	"BIGINT",
@@ -354,14 +386,17 @@ var sqlToknames = [...]string{
 	"COLLATION",
 	"COLUMN",
 	"COLUMNS",
+	"COMMENT",
 	"COMMIT",
 	"COMMITTED",
 	"CONCAT",
+	"CONFIGURE",
 	"CONFLICT",
 	"CONSTRAINT",
 	"COVERING",
 	"CREATE",
 	"CROSS",
+	"CSV",
 	"CUBE",
 	"CURRENT",
 	"CURRENT_CATALOG",
@@ -382,6 +417,7 @@ var sqlToknames = [...]string{
 	"DEFERRABLE",
 	"DELETE",
 	"DESC",
+	"DISCARD",
 	"DISTINCT",
 	"DO",
 	"DOUBLE",
@@ -390,8 +426,10 @@ var sqlToknames = [...]string{
 	"END",
 	"ESCAPE",
 	"EXCEPT",
+	"EXECUTE",
 	"EXISTS",
 	"EXPLAIN",
+	"EXTERNAL",
 	"EXTRACT",
 	"FALSE",
 	"FETCH",
@@ -412,7 +450,10 @@ var sqlToknames = [...]string{
 	"HOUR",
 	"IF",
 	"IFNULL",
+	"ILIKE",
+	"IMPORT",
 	"IN",
+	"INCREMENTAL",
 	"INDEX",
 	"INITIALLY",
 	"INNER",
@@ -480,15 +521,19 @@ var sqlToknames = [...]string{
 	"REFERENCES",
 	"RENAME",
 	"REPEATABLE",
+	"RESET",
+	"RESTORE",
 	"RESTRICT",
 	"RETURNING",
 	"REVOKE",
 	"RIGHT",
+	"ROLE",
 	"ROLLBACK",
 	"ROLLUP",
 	"ROW",
 	"ROWS",
 	"RSHIFT",
+	"SCATTER",
 	"SEARCH",
 	"SECOND",
 	"SELECT",
@@ -502,6 +547,7 @@ var sqlToknames = [...]string{
 	"SMALLINT",
 	"SNAPSHOT",
 	"SOME",
+	"SPLIT",
 	"SQL",
 	"STRICT",
 	"STRING",
@@ -521,6 +567,7 @@ var sqlToknames = [...]string{
 	"TRIM",
 	"TRUE",
 	"TRUNCATE",
+	"TTL",
 	"TYPE",
 	"UNBOUNDED",
 	"UNCOMMITTED",
@@ -551,6 +598,7 @@ var sqlToknames = [...]string{
 	"'>'",
 	"'='",
 	"POSTFIXOP",
+	"'~'",
 	"'|'",
 	"'^'",
 	"'#'",
@@ -561,7 +609,6 @@ var sqlToknames = [...]string{
 	"'/'",
 	"'%'",
 	"UMINUS",
-	"'~'",
 	"'['",
 	"']'",
 	"'('",
@@ -569,8 +616,8 @@ var sqlToknames = [...]string{
 	"'.'",
 	"';'",
 	"','",
-	"'@'",
 	"':'",
+	"'@'",
 }
 var sqlStatenames = [...]string{}
 
@@ -578,2163 +625,2493 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlInitialStackSize = 16
 
-//line sql.y:3701
+//line sql.y:3998
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
-	1, 19,
-	263, 19,
-	-2, 289,
+	1, 28,
+	284, 28,
+	-2, 332,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 260,
-	151, 260,
-	261, 260,
-	263, 260,
-	-2, 270,
-	-1, 38,
-	1, 263,
-	151, 263,
-	261, 263,
-	263, 263,
-	-2, 269,
-	-1, 47,
-	1, 19,
-	263, 19,
-	-2, 289,
-	-1, 83,
-	1, 127,
-	263, 127,
-	-2, 738,
-	-1, 234,
-	129, 299,
-	150, 299,
-	-2, 266,
-	-1, 237,
-	129, 298,
-	150, 298,
-	-2, 264,
-	-1, 339,
-	129, 298,
-	150, 298,
-	-2, 267,
-	-1, 396,
-	260, 688,
-	-2, 683,
-	-1, 397,
-	260, 689,
-	-2, 684,
-	-1, 403,
-	6, 417,
-	260, 417,
-	-2, 811,
-	-1, 425,
-	6, 387,
-	-2, 790,
-	-1, 426,
-	6, 414,
-	260, 414,
-	-2, 791,
-	-1, 427,
-	6, 395,
+	-1, 45,
+	1, 303,
+	87, 303,
+	166, 303,
+	282, 303,
+	284, 303,
+	-2, 313,
+	-1, 57,
+	1, 306,
+	87, 306,
+	166, 306,
+	282, 306,
+	284, 306,
+	-2, 312,
+	-1, 66,
+	1, 28,
+	284, 28,
+	-2, 332,
+	-1, 245,
+	166, 122,
+	285, 122,
+	-2, 779,
+	-1, 246,
+	166, 118,
+	285, 118,
+	-2, 781,
+	-1, 247,
+	166, 121,
+	285, 121,
 	-2, 792,
-	-1, 428,
-	6, 394,
-	-2, 793,
-	-1, 429,
-	6, 414,
-	260, 414,
-	-2, 795,
-	-1, 430,
-	6, 414,
-	260, 414,
-	-2, 796,
-	-1, 431,
-	6, 415,
-	-2, 798,
-	-1, 432,
-	6, 382,
-	-2, 799,
-	-1, 433,
-	6, 382,
-	-2, 800,
-	-1, 434,
-	6, 397,
-	-2, 803,
-	-1, 435,
-	6, 383,
-	-2, 808,
-	-1, 436,
-	6, 384,
-	-2, 809,
-	-1, 437,
-	6, 385,
-	-2, 810,
-	-1, 438,
-	6, 382,
-	-2, 814,
-	-1, 439,
-	6, 388,
-	-2, 819,
-	-1, 440,
-	6, 386,
-	-2, 821,
-	-1, 441,
-	6, 416,
-	-2, 825,
-	-1, 442,
-	6, 412,
-	260, 412,
-	-2, 829,
-	-1, 683,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 519,
-	-1, 691,
-	260, 668,
-	-2, 662,
-	-1, 876,
+	-1, 248,
+	166, 123,
+	285, 123,
+	-2, 849,
+	-1, 264,
+	1, 162,
+	284, 162,
+	-2, 801,
+	-1, 290,
+	144, 342,
+	165, 342,
+	-2, 309,
+	-1, 293,
+	144, 341,
+	165, 341,
+	-2, 307,
+	-1, 411,
+	144, 341,
+	165, 341,
+	-2, 310,
+	-1, 468,
+	281, 743,
+	-2, 738,
+	-1, 469,
+	281, 744,
+	-2, 739,
+	-1, 475,
+	6, 462,
+	281, 462,
+	-2, 880,
+	-1, 497,
+	6, 432,
+	-2, 859,
+	-1, 498,
+	6, 459,
+	281, 459,
+	-2, 860,
+	-1, 499,
+	6, 440,
+	-2, 861,
+	-1, 500,
+	6, 439,
+	-2, 862,
+	-1, 501,
+	6, 459,
+	281, 459,
+	-2, 864,
+	-1, 502,
+	6, 459,
+	281, 459,
+	-2, 865,
+	-1, 503,
+	6, 460,
+	-2, 867,
+	-1, 504,
+	6, 427,
+	-2, 868,
+	-1, 505,
+	6, 427,
+	-2, 869,
+	-1, 506,
+	6, 442,
+	-2, 872,
+	-1, 507,
+	6, 428,
+	-2, 877,
+	-1, 508,
+	6, 429,
+	-2, 878,
+	-1, 509,
+	6, 430,
+	-2, 879,
+	-1, 510,
+	6, 427,
+	-2, 883,
+	-1, 511,
+	6, 433,
+	-2, 888,
+	-1, 512,
+	6, 431,
+	-2, 890,
+	-1, 513,
+	6, 461,
+	-2, 894,
+	-1, 514,
+	6, 457,
+	281, 457,
+	-2, 898,
+	-1, 781,
+	95, 313,
+	131, 313,
+	144, 313,
+	165, 313,
+	169, 313,
+	241, 313,
+	-2, 574,
+	-1, 789,
+	281, 723,
+	-2, 717,
+	-1, 980,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 450,
-	-1, 877,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 495,
+	-1, 981,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 451,
-	-1, 878,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 496,
+	-1, 982,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 452,
-	-1, 882,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 497,
+	-1, 986,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 456,
-	-1, 883,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 501,
+	-1, 987,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 457,
-	-1, 884,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 502,
+	-1, 988,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 458,
-	-1, 887,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 463,
-	-1, 918,
-	159, 589,
-	-2, 592,
-	-1, 1064,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 340,
-	-1, 1072,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 464,
-	-1, 1077,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 465,
-	-1, 1096,
-	159, 588,
-	-2, 591,
-	-1, 1233,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 466,
-	-1, 1238,
-	119, 0,
-	-2, 476,
-	-1, 1247,
-	159, 590,
-	-2, 593,
-	-1, 1287,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 503,
+	-1, 997,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 514,
+	-1, 1003,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 516,
+	-1, 1029,
+	174, 644,
+	-2, 647,
+	-1, 1187,
+	95, 313,
+	131, 313,
+	144, 313,
+	165, 313,
+	169, 313,
+	241, 313,
+	-2, 385,
+	-1, 1196,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 515,
+	-1, 1197,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 517,
+	-1, 1202,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 518,
+	-1, 1221,
+	174, 643,
+	-2, 646,
+	-1, 1364,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 519,
+	-1, 1369,
+	134, 0,
+	-2, 529,
+	-1, 1379,
+	174, 645,
+	-2, 648,
+	-1, 1418,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 500,
-	-1, 1288,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 553,
+	-1, 1419,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 501,
-	-1, 1289,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 554,
+	-1, 1420,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 502,
-	-1, 1293,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 555,
+	-1, 1424,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 506,
-	-1, 1294,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 559,
+	-1, 1425,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 507,
-	-1, 1295,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 560,
+	-1, 1426,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 508,
-	-1, 1387,
-	119, 0,
-	-2, 477,
-	-1, 1391,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 480,
-	-1, 1392,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 482,
-	-1, 1471,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 481,
-	-1, 1472,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 483,
-	-1, 1480,
-	119, 0,
-	-2, 509,
-	-1, 1517,
-	119, 0,
-	-2, 510,
-	-1, 1562,
-	30, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 789,
+	264, 0,
+	265, 0,
+	266, 0,
+	-2, 561,
+	-1, 1522,
+	134, 0,
+	-2, 530,
+	-1, 1526,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 533,
+	-1, 1527,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 535,
+	-1, 1611,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 534,
+	-1, 1612,
+	36, 0,
+	120, 0,
+	122, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 536,
+	-1, 1621,
+	134, 0,
+	-2, 562,
+	-1, 1662,
+	134, 0,
+	-2, 563,
+	-1, 1712,
+	36, 0,
+	120, 0,
+	143, 0,
+	212, 0,
+	262, 0,
+	-2, 858,
 }
 
-const sqlNprod = 921
+const sqlNprod = 992
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 18232
+const sqlLast = 21102
 
 var sqlAct = [...]int{
 
-	915, 1544, 1561, 1582, 370, 1522, 1546, 1428, 1545, 1560,
-	817, 1488, 769, 1267, 1358, 1453, 395, 1325, 394, 265,
-	387, 1461, 1373, 1359, 686, 238, 1060, 1213, 762, 801,
-	1153, 1239, 1154, 243, 28, 688, 1052, 481, 1222, 455,
-	1099, 803, 84, 931, 460, 739, 770, 1367, 748, 621,
-	262, 1048, 804, 262, 935, 271, 903, 828, 262, 28,
-	281, 970, 900, 1063, 13, 717, 499, 245, 37, 637,
-	925, 798, 463, 721, 643, 1240, 465, 369, 18, 10,
-	237, 28, 248, 6, 360, 57, 88, 510, 826, 641,
-	59, 286, 526, 37, 806, 342, 282, 973, 343, 284,
-	490, 825, 38, 341, 61, 60, 497, 501, 397, 62,
-	66, 1455, 275, 443, 39, 37, 242, 483, 458, 458,
-	81, 353, 456, 456, 483, 457, 457, 491, 1558, 767,
-	763, 1452, 928, 1552, 261, 1551, 821, 268, 821, 87,
-	235, 1543, 276, 242, 1390, 290, 287, 644, 1092, 644,
-	87, 87, 234, 1538, 87, 1510, 821, 87, 87, 87,
-	1021, 1300, 87, 87, 87, 87, 929, 289, 1519, 1246,
-	1513, 1390, 291, 821, 1501, 1498, 389, 821, 1452, 1473,
-	1032, 1468, 1390, 279, 821, 87, 87, 1451, 1448, 1433,
-	1452, 821, 821, 737, 1050, 1432, 930, 927, 821, 1413,
-	1393, 1389, 1092, 1092, 1390, 1335, 1243, 1204, 821, 1092,
-	482, 1034, 821, 1200, 43, 956, 482, 1171, 1169, 1168,
-	1172, 1092, 1092, 482, 1167, 1096, 1098, 1092, 1092, 1094,
-	1093, 45, 486, 262, 1095, 1092, 822, 736, 488, 821,
-	735, 489, 43, 911, 816, 792, 645, 932, 354, 307,
-	260, 47, 525, 1092, 484, 321, 46, 361, 361, 45,
-	340, 484, 1559, 41, 1557, 1514, 1450, 461, 452, 42,
-	1418, 1414, 1406, 334, 1405, 339, 1400, 43, 262, 476,
-	450, 1399, 1398, 1397, 46, 1384, 43, 40, 1352, 454,
-	1315, 41, 1310, 1126, 45, 1309, 1308, 42, 1250, 1228,
-	926, 1212, 1174, 45, 1173, 1161, 1152, 1125, 1122, 1120,
-	1109, 281, 1103, 281, 1021, 766, 645, 333, 1036, 46,
-	1033, 1070, 1489, 458, 445, 985, 41, 456, 46, 281,
-	457, 942, 42, 941, 444, 908, 694, 87, 353, 87,
-	352, 87, 482, 1269, 1509, 629, 631, 1490, 235, 618,
-	58, 1482, 638, 1464, 1458, 1447, 87, 1425, 1411, 40,
-	234, 1378, 276, 1356, 1382, 677, 678, 679, 680, 681,
-	617, 1237, 87, 1227, 684, 1210, 1209, 1207, 1186, 1185,
-	1151, 1117, 87, 87, 1116, 87, 474, 1108, 1089, 1085,
-	905, 722, 725, 646, 697, 999, 998, 1351, 980, 1126,
-	290, 290, 940, 820, 727, 715, 714, 691, 529, 495,
-	713, 648, 712, 909, 711, 87, 1140, 87, 494, 710,
-	709, 514, 289, 289, 521, 613, 708, 291, 291, 647,
-	528, 87, 646, 87, 87, 530, 87, 639, 707, 627,
-	706, 626, 705, 610, 625, 87, 614, 704, 615, 235,
-	648, 703, 235, 235, 702, 646, 701, 692, 729, 734,
-	690, 633, 40, 87, 634, 635, 87, 1141, 647, 999,
-	619, 685, 266, 648, 402, 262, 357, 1470, 761, 1469,
-	689, 1230, 773, 1229, 359, 451, 1354, 777, 1022, 1071,
-	281, 647, 328, 520, 730, 719, 720, 281, 316, 346,
-	699, 1368, 763, 1270, 936, 646, 718, 723, 1018, 28,
-	1528, 246, 726, 753, 755, 1571, 1343, 1497, 225, 742,
-	1112, 1028, 28, 648, 728, 1441, 1440, 1198, 1178, 779,
-	284, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 1177,
-	765, 647, 311, 529, 529, 466, 1107, 467, 232, 731,
-	733, 363, 1106, 59, 255, 662, 37, 1105, 1126, 758,
-	1104, 745, 87, 1126, 1073, 528, 528, 61, 60, 315,
-	530, 530, 62, 1572, 781, 87, 290, 287, 662, 87,
-	892, 782, 87, 785, 780, 783, 87, 760, 87, 87,
-	695, 87, 759, 866, 87, 87, 87, 1496, 289, 741,
-	229, 87, 87, 291, 1530, 1197, 663, 902, 1188, 468,
-	741, 51, 529, 778, 447, 1381, 740, 446, 797, 466,
-	784, 467, 466, 262, 467, 477, 932, 1259, 662, 663,
-	814, 815, 1430, 399, 528, 1579, 651, 652, 653, 530,
-	1127, 1128, 1129, 1130, 1131, 902, 361, 52, 1013, 262,
-	867, 868, 869, 870, 871, 872, 873, 874, 875, 876,
-	877, 878, 879, 880, 881, 882, 883, 884, 885, 886,
-	887, 936, 823, 649, 650, 651, 652, 653, 932, 663,
-	472, 1140, 313, 468, 230, 471, 468, 890, 1491, 1027,
-	865, 1585, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 233, 1010, 54, 943, 928, 954, 800, 964, 966,
-	971, 974, 975, 976, 749, 1029, 646, 314, 1189, 1478,
-	1571, 87, 831, 1540, 1578, 916, 730, 87, 87, 946,
-	830, 730, 1141, 483, 648, 716, 461, 682, 1541, 929,
-	349, 350, 449, 990, 55, 656, 649, 650, 651, 652,
-	653, 1126, 647, 87, 1115, 469, 87, 984, 53, 355,
-	907, 1195, 529, 1223, 1014, 891, 752, 331, 906, 930,
-	927, 281, 738, 646, 242, 1547, 464, 49, 1431, 281,
-	988, 345, 1548, 1075, 528, 888, 1435, 912, 917, 530,
-	920, 648, 1570, 1583, 1568, 1577, 949, 994, 1134, 1127,
-	1128, 1129, 1130, 1131, 1366, 965, 1129, 1130, 1131, 647,
-	1016, 977, 978, 979, 989, 1038, 1024, 638, 50, 788,
-	932, 901, 996, 837, 810, 789, 324, 308, 1584, 469,
-	950, 1009, 469, 306, 262, 1409, 1434, 751, 791, 56,
-	241, 1423, 1339, 1586, 1020, 1549, 790, 87, 87, 87,
-	889, 1256, 1037, 87, 28, 1025, 87, 1035, 1026, 1030,
-	951, 948, 87, 87, 87, 87, 87, 1031, 87, 87,
-	484, 240, 1592, 926, 1140, 87, 1180, 87, 1550, 290,
-	1296, 1257, 64, 87, 993, 1043, 1041, 1065, 37, 1072,
-	1059, 750, 87, 1077, 1017, 87, 662, 632, 811, 1045,
-	1044, 289, 1023, 624, 1046, 1410, 291, 620, 1069, 242,
-	1338, 952, 1091, 48, 1255, 1523, 87, 344, 87, 87,
-	67, 87, 1100, 837, 723, 1141, 726, 616, 720, 719,
-	87, 1066, 932, 496, 344, 87, 87, 1113, 87, 1424,
-	72, 1118, 1591, 1001, 1297, 68, 1097, 663, 1076, 1342,
-	1298, 1000, 1376, 1074, 1055, 345, 1341, 649, 650, 651,
-	652, 653, 684, 69, 947, 1218, 67, 1058, 971, 971,
-	971, 856, 1217, 312, 898, 239, 71, 1221, 1051, 329,
-	274, 855, 1056, 240, 336, 896, 72, 1214, 1176, 1111,
-	1049, 68, 1127, 1128, 1129, 1130, 1131, 939, 1481, 1183,
-	1408, 1155, 1236, 1088, 1121, 1084, 786, 1090, 644, 69,
-	327, 654, 655, 656, 649, 650, 651, 652, 653, 1055,
-	1101, 1102, 71, 461, 1340, 325, 773, 322, 273, 1158,
-	1159, 1160, 1058, 1156, 700, 1057, 1175, 612, 894, 938,
-	893, 1322, 1053, 1192, 899, 1194, 1182, 1056, 1193, 1201,
-	1191, 70, 1179, 1082, 1039, 262, 1196, 812, 262, 1150,
-	1054, 1264, 809, 487, 1080, 485, 480, 1203, 1202, 473,
-	1163, 856, 1232, 470, 1233, 1442, 1206, 347, 258, 1572,
-	516, 855, 741, 818, 741, 1238, 1208, 73, 756, 1184,
-	754, 1220, 318, 1248, 75, 1224, 1225, 70, 1444, 1248,
-	1057, 757, 1199, 646, 1455, 3, 646, 1493, 87, 1516,
-	1215, 895, 351, 1265, 1511, 768, 1086, 1087, 897, 1078,
-	640, 836, 1274, 1083, 648, 1276, 1252, 1253, 1254, 1068,
-	87, 1249, 1589, 73, 819, 1590, 63, 348, 259, 647,
-	1126, 87, 647, 87, 224, 87, 646, 1383, 87, 1258,
-	1260, 1261, 1216, 267, 1275, 1219, 1305, 1306, 1271, 87,
-	319, 1126, 87, 382, 74, 1312, 1313, 1314, 1316, 1262,
-	87, 309, 310, 87, 1147, 1148, 1149, 793, 226, 227,
-	794, 1231, 1170, 983, 982, 1304, 1303, 1244, 981, 933,
-	1079, 795, 1395, 1263, 85, 1273, 796, 1081, 693, 228,
-	1346, 1429, 1277, 1317, 65, 249, 249, 611, 323, 264,
-	1402, 1539, 264, 270, 264, 1369, 1114, 264, 277, 264,
-	85, 836, 262, 262, 87, 1477, 262, 1364, 1460, 1363,
-	937, 1365, 1321, 1307, 28, 698, 23, 1387, 1361, 1357,
-	85, 85, 1391, 1392, 375, 1323, 1181, 1394, 805, 1301,
-	531, 517, 1396, 506, 398, 1380, 326, 500, 957, 509,
-	1311, 858, 837, 945, 857, 1353, 448, 1401, 400, 1388,
-	834, 1404, 401, 835, 724, 1336, 1337, 388, 832, 285,
-	833, 771, 934, 1110, 1140, 696, 87, 87, 87, 374,
-	1234, 1235, 380, 379, 87, 87, 837, 1355, 913, 371,
-	87, 1412, 87, 837, 87, 87, 87, 87, 1407, 79,
-	80, 1015, 1350, 1370, 764, 813, 87, 1379, 87, 1371,
-	1372, 628, 1190, 1377, 231, 1123, 87, 87, 963, 955,
-	87, 953, 332, 459, 837, 1141, 87, 87, 1427, 772,
-	358, 320, 1436, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
-	1295, 858, 1299, 1457, 857, 1419, 1443, 944, 1364, 1422,
-	1363, 1459, 1365, 824, 1445, 1420, 1465, 1456, 87, 1067,
-	833, 262, 1438, 1439, 356, 1454, 1471, 1472, 636, 257,
-	256, 802, 264, 317, 85, 1463, 337, 787, 1135, 1132,
-	1133, 1134, 1127, 1128, 1129, 1130, 1131, 475, 330, 1492,
-	856, 249, 1527, 1187, 837, 44, 1485, 17, 16, 1437,
-	855, 15, 1476, 14, 12, 11, 1487, 264, 1042, 9,
-	8, 87, 7, 87, 22, 87, 21, 264, 264, 1486,
-	478, 20, 87, 1483, 856, 5, 1449, 4, 461, 2,
-	1, 856, 957, 957, 855, 0, 1500, 0, 0, 1502,
-	0, 855, 0, 0, 1504, 87, 1474, 1506, 1467, 1364,
-	264, 1363, 264, 1365, 0, 87, 1503, 87, 1466, 0,
-	0, 0, 856, 0, 0, 87, 85, 87, 264, 85,
-	0, 85, 855, 0, 730, 0, 1526, 0, 1505, 0,
-	623, 0, 0, 1515, 0, 0, 0, 0, 0, 1532,
-	957, 957, 957, 0, 1518, 0, 0, 0, 249, 0,
-	837, 642, 0, 1533, 1535, 1537, 1364, 1531, 1363, 1554,
-	1365, 0, 1536, 0, 1534, 773, 1529, 0, 1553, 0,
-	0, 1565, 1565, 1555, 1556, 0, 0, 1331, 1426, 87,
-	87, 1566, 1567, 87, 1512, 1569, 1508, 0, 0, 1573,
-	836, 0, 856, 1565, 87, 1576, 1575, 837, 0, 0,
-	0, 0, 855, 87, 0, 1588, 1587, 1332, 0, 1524,
-	1525, 0, 0, 0, 1574, 0, 0, 0, 837, 0,
-	1565, 0, 1593, 0, 836, 0, 0, 0, 87, 87,
-	87, 836, 87, 519, 507, 518, 0, 512, 0, 0,
-	1331, 0, 1326, 0, 1542, 0, 0, 264, 0, 87,
-	1324, 0, 0, 0, 1480, 0, 957, 957, 0, 0,
-	746, 0, 836, 0, 264, 0, 0, 264, 0, 87,
-	1332, 264, 0, 775, 776, 1327, 264, 1328, 0, 264,
-	85, 85, 0, 0, 0, 0, 264, 642, 0, 837,
-	0, 0, 0, 1051, 0, 0, 0, 0, 856, 0,
-	1330, 0, 0, 522, 0, 0, 1333, 0, 855, 957,
-	957, 957, 957, 957, 957, 957, 957, 957, 957, 957,
-	957, 957, 957, 957, 957, 957, 957, 1517, 957, 0,
-	858, 0, 0, 857, 1055, 0, 0, 0, 1327, 0,
-	1328, 0, 836, 0, 0, 856, 524, 1058, 0, 833,
-	0, 0, 0, 0, 1329, 855, 0, 1053, 0, 523,
-	0, 0, 1056, 1330, 858, 0, 856, 857, 0, 1333,
-	0, 858, 0, 0, 857, 1054, 855, 0, 0, 0,
-	0, 0, 0, 833, 376, 29, 0, 1375, 0, 0,
-	833, 0, 0, 0, 214, 0, 0, 0, 0, 0,
-	0, 0, 858, 0, 0, 857, 799, 0, 223, 0,
-	29, 0, 264, 746, 0, 1057, 0, 1329, 0, 0,
-	0, 833, 236, 0, 0, 244, 0, 0, 0, 0,
-	0, 0, 29, 0, 0, 0, 0, 856, 264, 216,
-	0, 85, 0, 244, 0, 0, 0, 855, 836, 0,
-	1126, 0, 1142, 1143, 1144, 0, 0, 0, 215, 217,
-	0, 1374, 1386, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	513, 508, 858, 0, 648, 857, 673, 0, 0, 0,
-	218, 0, 1139, 0, 0, 836, 0, 0, 0, 219,
-	0, 833, 647, 0, 0, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 957, 0, 836, 0, 0, 0,
+	469, 1711, 1726, 1691, 1703, 1736, 1566, 1667, 1692, 1710,
+	1693, 874, 1630, 922, 1398, 867, 1602, 324, 461, 1456,
+	331, 1495, 294, 467, 466, 459, 1494, 929, 1509, 1594,
+	73, 73, 784, 1503, 315, 44, 1183, 414, 889, 892,
+	1326, 73, 1343, 73, 73, 786, 1175, 73, 1277, 1370,
+	1278, 713, 1352, 73, 1042, 891, 648, 1134, 527, 843,
+	1171, 73, 73, 875, 265, 73, 1046, 1015, 73, 73,
+	73, 1012, 834, 819, 1081, 815, 233, 21, 1036, 442,
+	932, 301, 56, 729, 673, 1186, 530, 658, 1129, 535,
+	220, 235, 26, 234, 17, 299, 441, 734, 236, 11,
+	432, 232, 684, 350, 323, 894, 304, 344, 413, 70,
+	223, 548, 415, 262, 675, 671, 293, 57, 219, 930,
+	56, 56, 223, 431, 244, 337, 58, 302, 418, 1596,
+	327, 327, 223, 425, 325, 325, 649, 868, 326, 326,
+	298, 237, 1039, 21, 313, 298, 1743, 313, 56, 321,
+	1708, 649, 1705, 1593, 1699, 897, 1698, 638, 26, 638,
+	17, 253, 735, 1219, 897, 11, 74, 1142, 1220, 1690,
+	1371, 283, 1525, 1685, 291, 1677, 638, 735, 897, 1040,
+	435, 1218, 1664, 290, 312, 1525, 897, 318, 872, 306,
+	1657, 1644, 1640, 638, 638, 1593, 1613, 515, 1609, 1525,
+	1592, 638, 1588, 1593, 1571, 638, 62, 638, 1570, 1084,
+	1654, 638, 1041, 1038, 1550, 1528, 1524, 897, 897, 1525,
+	1431, 1377, 73, 73, 64, 888, 830, 1173, 73, 73,
+	73, 73, 73, 1467, 1449, 1374, 638, 897, 897, 1333,
+	354, 1329, 329, 1295, 329, 1293, 1296, 1149, 897, 238,
+	65, 1292, 1291, 73, 897, 897, 1223, 60, 73, 73,
+	652, 897, 1221, 61, 1023, 897, 926, 1043, 829, 638,
+	655, 828, 921, 656, 904, 299, 736, 426, 638, 329,
+	374, 59, 73, 311, 650, 73, 66, 699, 73, 73,
+	391, 1709, 1707, 1659, 1590, 1555, 346, 1551, 1543, 650,
+	1542, 223, 737, 1537, 73, 1536, 1535, 313, 1250, 1534,
+	223, 62, 330, 412, 62, 73, 1519, 375, 1485, 1446,
+	56, 342, 1037, 1441, 1440, 739, 551, 551, 737, 64,
+	73, 1439, 64, 1381, 73, 73, 73, 73, 1142, 73,
+	348, 547, 351, 1194, 738, 1358, 522, 338, 411, 404,
+	1250, 739, 1342, 736, 1339, 65, 327, 1298, 65, 1151,
+	325, 313, 60, 1297, 326, 1285, 1276, 1249, 61, 1246,
+	738, 1244, 1233, 73, 73, 1227, 329, 1159, 73, 73,
+	1148, 1096, 1053, 1052, 354, 354, 871, 1020, 1631, 59,
+	792, 709, 551, 73, 524, 73, 73, 425, 73, 424,
+	1400, 403, 1653, 1645, 1632, 694, 355, 73, 73, 637,
+	1623, 1605, 1599, 639, 640, 313, 643, 1250, 1587, 291,
+	1586, 1562, 737, 1548, 1514, 421, 422, 73, 290, 62,
+	73, 427, 521, 1484, 1491, 1368, 1361, 356, 634, 1357,
+	753, 1340, 1338, 1336, 1310, 739, 1309, 64, 1275, 1241,
+	733, 1240, 223, 719, 1232, 338, 1214, 223, 1210, 708,
+	1017, 641, 820, 823, 738, 1110, 669, 1109, 1021, 1099,
+	1091, 712, 1051, 65, 223, 925, 914, 825, 813, 812,
+	60, 299, 811, 810, 809, 808, 61, 789, 1264, 807,
+	806, 657, 552, 552, 754, 805, 668, 804, 517, 660,
+	688, 695, 803, 700, 239, 1250, 701, 802, 801, 705,
+	800, 706, 799, 704, 790, 788, 1110, 59, 693, 681,
+	692, 717, 686, 553, 553, 710, 73, 718, 665, 316,
+	429, 1610, 1518, 731, 1191, 787, 291, 73, 1359, 291,
+	291, 1216, 1265, 73, 381, 725, 523, 73, 726, 727,
+	355, 355, 385, 1744, 1263, 1264, 1488, 1143, 552, 1250,
+	1195, 748, 745, 746, 747, 740, 741, 742, 743, 744,
+	856, 1251, 1252, 1253, 1254, 1255, 837, 817, 818, 821,
+	832, 356, 356, 398, 824, 386, 666, 256, 797, 553,
+	1704, 740, 741, 742, 743, 744, 868, 696, 724, 1504,
+	1401, 1236, 1047, 816, 1139, 827, 1673, 1639, 1721, 1265,
+	848, 850, 1258, 1251, 1252, 1253, 1254, 1255, 1564, 826,
+	1722, 536, 313, 537, 1475, 854, 859, 853, 287, 836,
+	1057, 278, 1155, 1579, 1578, 1323, 1302, 1322, 1301, 73,
+	1231, 1230, 840, 1264, 1229, 73, 698, 73, 73, 225,
+	285, 1228, 73, 73, 73, 783, 836, 354, 1039, 697,
+	297, 1198, 855, 737, 835, 793, 73, 1004, 759, 760,
+	761, 762, 763, 383, 887, 858, 1259, 1256, 1257, 1258,
+	1251, 1252, 1253, 1254, 1255, 857, 739, 742, 743, 744,
+	538, 226, 373, 1638, 328, 1040, 296, 1265, 1067, 970,
+	551, 334, 406, 1014, 73, 738, 1060, 1014, 1360, 384,
+	73, 73, 752, 282, 252, 68, 1675, 883, 346, 870,
+	1568, 900, 1312, 644, 878, 1043, 654, 901, 1041, 1038,
+	1390, 223, 516, 1733, 298, 1687, 73, 56, 543, 73,
+	1156, 844, 882, 1061, 903, 919, 920, 649, 288, 912,
+	927, 454, 902, 1688, 1739, 1126, 941, 885, 545, 351,
+	886, 884, 69, 1047, 1259, 1256, 1257, 1258, 1251, 1252,
+	1253, 1254, 1255, 1695, 551, 284, 1062, 1059, 1633, 1721,
+	1619, 71, 71, 1043, 1192, 1043, 474, 687, 682, 313,
+	541, 910, 241, 289, 71, 255, 814, 228, 266, 847,
+	1239, 753, 547, 969, 71, 1320, 295, 547, 227, 540,
+	1732, 909, 305, 305, 1154, 313, 71, 780, 1353, 71,
+	320, 71, 401, 355, 1253, 1254, 1255, 298, 1694, 911,
+	1720, 1063, 833, 1027, 73, 73, 73, 934, 1037, 1696,
+	73, 1313, 1095, 73, 230, 379, 380, 539, 1718, 73,
+	73, 73, 73, 73, 356, 754, 1250, 417, 73, 73,
+	941, 1502, 67, 1137, 915, 1737, 552, 544, 1387, 394,
+	737, 333, 73, 846, 73, 1546, 1697, 1019, 1569, 1105,
+	73, 1001, 519, 518, 1731, 1018, 1058, 1097, 73, 73,
+	1133, 471, 377, 739, 1200, 650, 73, 553, 1013, 73,
+	372, 1388, 1738, 224, 1573, 354, 1130, 299, 935, 1572,
+	1471, 1560, 738, 1101, 73, 73, 73, 229, 73, 1740,
+	1098, 758, 748, 745, 746, 747, 740, 741, 742, 743,
+	744, 73, 73, 845, 73, 1748, 416, 1304, 1104, 1145,
+	552, 916, 1121, 716, 231, 1474, 1250, 1150, 711, 1386,
+	1547, 223, 1473, 1161, 1668, 1132, 1043, 417, 416, 223,
+	707, 1189, 1138, 670, 1512, 999, 1427, 1002, 1561, 299,
+	1144, 553, 1141, 71, 332, 1146, 1112, 1111, 1160, 71,
+	339, 341, 71, 266, 1470, 1068, 1153, 1158, 998, 1157,
+	1348, 1347, 382, 1207, 1264, 1162, 399, 1024, 1028, 866,
+	1031, 865, 1167, 336, 266, 1165, 1205, 56, 753, 266,
+	266, 1747, 1107, 313, 1188, 1076, 1182, 1169, 1178, 1168,
+	1193, 1088, 1089, 1090, 1170, 296, 1472, 407, 821, 536,
+	824, 537, 1010, 71, 1181, 1428, 266, 1344, 1174, 408,
+	410, 1429, 818, 817, 1351, 1008, 1172, 1050, 1265, 1179,
+	1622, 1545, 1279, 299, 1222, 305, 1367, 1000, 1245, 1131,
+	1209, 1128, 754, 898, 1203, 735, 71, 397, 1208, 395,
+	1201, 355, 392, 1199, 378, 376, 335, 1280, 798, 249,
+	667, 71, 703, 1178, 1264, 71, 71, 71, 71, 1049,
+	645, 1453, 1317, 1315, 1303, 1163, 917, 913, 538, 1181,
+	663, 1006, 356, 1005, 1180, 653, 661, 1011, 299, 1176,
+	651, 73, 1235, 647, 1179, 546, 1256, 1257, 1258, 1251,
+	1252, 1253, 1254, 1255, 71, 659, 250, 1177, 542, 71,
+	659, 1308, 747, 740, 741, 742, 743, 744, 1265, 1511,
+	1204, 1395, 662, 73, 266, 1580, 71, 266, 1206, 266,
+	73, 419, 73, 1282, 1283, 1284, 1299, 1330, 266, 715,
+	309, 347, 1722, 923, 73, 388, 1591, 1489, 1306, 1180,
+	690, 1327, 3, 1316, 73, 1318, 1493, 73, 305, 1007,
+	1334, 332, 1582, 836, 836, 73, 852, 1009, 73, 1661,
+	1596, 851, 849, 1068, 1068, 1321, 1122, 1328, 541, 737,
+	1635, 1346, 1332, 1337, 1349, 1335, 1331, 1345, 423, 1251,
+	1252, 1253, 1254, 1255, 277, 737, 420, 540, 924, 1510,
+	831, 251, 878, 240, 1350, 310, 1655, 1213, 873, 941,
+	732, 1215, 1354, 1355, 1745, 1746, 960, 1250, 739, 317,
+	73, 738, 389, 1225, 1226, 737, 1517, 1383, 1384, 1385,
+	1068, 1068, 1068, 313, 1447, 539, 313, 738, 905, 664,
+	1393, 906, 941, 1362, 279, 280, 1380, 254, 1294, 941,
+	1094, 1093, 1092, 1044, 907, 1462, 1532, 71, 1394, 908,
+	1174, 791, 1274, 281, 1389, 1391, 1392, 1567, 841, 29,
+	243, 702, 393, 1287, 71, 1539, 1406, 1686, 71, 1402,
+	941, 1238, 73, 73, 73, 1618, 1601, 1048, 1463, 1300,
+	73, 73, 796, 36, 1497, 447, 73, 1454, 73, 1305,
+	73, 73, 73, 73, 73, 1178, 893, 1435, 1434, 554,
+	1468, 1469, 691, 680, 470, 396, 674, 73, 1325, 73,
+	960, 1181, 1448, 1452, 683, 1056, 520, 472, 73, 73,
+	938, 1176, 73, 1487, 473, 939, 1179, 1490, 73, 73,
+	822, 460, 936, 349, 1500, 1499, 1501, 876, 1045, 1177,
+	1234, 794, 446, 452, 451, 1507, 1508, 1492, 1515, 1513,
+	1068, 1068, 1458, 1025, 1459, 443, 260, 941, 433, 433,
+	864, 1464, 261, 1136, 1483, 1516, 71, 528, 880, 881,
+	1479, 73, 869, 71, 266, 266, 1486, 1461, 635, 636,
+	918, 1180, 720, 1465, 1404, 1314, 286, 841, 1523, 1247,
+	1074, 1408, 1066, 1064, 1375, 402, 526, 313, 313, 877,
+	430, 313, 1068, 1068, 1068, 1068, 1068, 1068, 1068, 1068,
+	1068, 1068, 1068, 1068, 1068, 1068, 1068, 1068, 1068, 1068,
+	390, 1068, 1438, 1725, 73, 659, 73, 1544, 73, 862,
+	1055, 71, 841, 928, 1190, 428, 73, 1460, 728, 308,
+	959, 307, 890, 387, 899, 642, 400, 1634, 1672, 1311,
+	63, 27, 1556, 25, 24, 1432, 23, 71, 22, 20,
+	266, 19, 73, 1557, 941, 18, 1442, 721, 723, 1166,
+	16, 15, 14, 73, 730, 73, 13, 12, 1500, 1499,
+	1501, 1581, 1589, 73, 35, 73, 33, 775, 776, 777,
+	778, 779, 1559, 1584, 940, 1327, 782, 1597, 1583, 1595,
+	32, 34, 10, 1576, 1577, 1608, 9, 1604, 8, 7,
+	1607, 6, 5, 4, 941, 1565, 795, 2, 737, 1,
+	0, 0, 0, 0, 0, 1506, 1617, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 941, 0, 0,
+	0, 739, 0, 0, 959, 0, 0, 0, 1627, 73,
+	73, 0, 1600, 73, 0, 71, 1102, 1103, 0, 0,
+	738, 841, 313, 0, 1108, 1643, 0, 73, 1624, 1646,
+	1113, 1114, 1116, 1118, 1119, 0, 73, 0, 1648, 1124,
+	1125, 1650, 737, 547, 0, 1647, 0, 1500, 1499, 1501,
+	962, 961, 299, 71, 0, 1140, 1656, 0, 940, 937,
+	0, 71, 73, 73, 73, 739, 73, 0, 0, 659,
+	1147, 1660, 941, 0, 1068, 0, 1575, 715, 0, 0,
+	659, 1678, 1669, 1670, 738, 73, 1676, 0, 0, 0,
+	0, 1649, 0, 0, 0, 266, 841, 71, 0, 1164,
+	1684, 1683, 0, 1663, 0, 1681, 73, 1500, 1499, 1501,
+	1682, 1680, 1185, 1185, 1700, 71, 753, 1702, 0, 0,
+	0, 0, 0, 0, 1706, 0, 1614, 0, 1716, 0,
+	1674, 0, 1719, 1717, 0, 73, 0, 0, 1723, 960,
+	1728, 1211, 1212, 1671, 1730, 1729, 0, 0, 1724, 0,
+	0, 0, 0, 0, 962, 961, 1068, 1742, 1741, 0,
+	0, 0, 0, 937, 0, 0, 0, 0, 0, 0,
+	754, 0, 960, 0, 73, 1068, 1749, 1751, 0, 960,
+	753, 0, 0, 0, 0, 878, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1271, 1272,
+	1273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	960, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1642, 0, 0,
+	0, 0, 0, 1068, 754, 0, 0, 0, 0, 1652,
+	0, 740, 741, 742, 743, 744, 0, 433, 0, 0,
+	0, 971, 972, 973, 974, 975, 976, 977, 978, 979,
+	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
+	990, 991, 992, 993, 994, 995, 996, 997, 0, 1003,
+	0, 0, 0, 0, 0, 1679, 0, 0, 0, 0,
+	0, 0, 332, 0, 0, 0, 0, 960, 0, 0,
+	0, 1689, 745, 746, 747, 740, 741, 742, 743, 744,
+	0, 0, 1054, 0, 1065, 0, 1075, 1077, 1082, 1085,
+	1086, 1087, 0, 0, 71, 0, 0, 0, 1365, 1366,
+	0, 841, 0, 715, 0, 0, 0, 0, 0, 528,
+	0, 0, 0, 1100, 0, 1341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 71, 0,
+	0, 0, 448, 45, 1120, 0, 1356, 1123, 0, 1185,
+	0, 0, 1127, 959, 0, 0, 0, 0, 0, 1135,
+	1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418,
+	1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 0, 1430,
+	0, 45, 45, 0, 960, 267, 959, 0, 1152, 0,
+	0, 0, 0, 959, 0, 0, 0, 0, 0, 292,
+	0, 1399, 300, 0, 276, 0, 0, 940, 0, 45,
+	0, 0, 730, 0, 0, 0, 0, 536, 0, 537,
+	0, 0, 0, 0, 959, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 960, 0, 269, 0, 0, 0,
+	940, 0, 0, 0, 0, 0, 0, 940, 0, 0,
+	0, 0, 0, 0, 0, 533, 0, 960, 268, 270,
+	0, 0, 0, 1450, 1451, 841, 0, 0, 0, 0,
+	0, 332, 332, 0, 0, 0, 0, 1476, 940, 1477,
+	0, 1478, 71, 1480, 1481, 1482, 538, 0, 0, 1196,
+	1197, 0, 0, 271, 0, 1202, 0, 0, 332, 0,
+	841, 0, 1496, 962, 961, 272, 0, 0, 0, 71,
+	71, 959, 937, 71, 1217, 0, 0, 0, 0, 332,
+	1185, 0, 0, 1224, 0, 0, 0, 0, 0, 0,
+	0, 0, 960, 0, 0, 0, 962, 961, 1237, 0,
+	0, 0, 1242, 962, 961, 937, 950, 965, 942, 958,
+	957, 0, 937, 943, 0, 0, 0, 967, 966, 0,
+	0, 0, 1540, 782, 0, 940, 0, 0, 0, 1082,
+	1082, 1082, 1563, 0, 962, 961, 0, 0, 0, 0,
+	0, 45, 300, 937, 534, 0, 541, 0, 963, 0,
+	955, 954, 0, 0, 0, 0, 0, 0, 532, 953,
+	1307, 0, 0, 0, 0, 540, 273, 0, 0, 274,
+	0, 0, 531, 275, 952, 841, 0, 1558, 959, 266,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	0, 0, 0, 0, 528, 0, 0, 946, 947, 948,
+	0, 698, 0, 539, 292, 0, 1496, 0, 1250, 0,
+	1266, 1267, 1268, 332, 1621, 0, 0, 0, 0, 0,
+	0, 962, 961, 0, 71, 1521, 1603, 0, 959, 0,
+	937, 956, 940, 1629, 71, 737, 332, 755, 756, 757,
+	759, 760, 761, 762, 763, 0, 1363, 0, 0, 1364,
+	0, 959, 764, 1462, 951, 1457, 0, 1263, 739, 0,
+	1369, 771, 0, 0, 0, 1455, 0, 1378, 0, 0,
+	0, 0, 0, 0, 1152, 0, 0, 738, 0, 0,
+	0, 0, 940, 949, 752, 0, 1463, 1396, 0, 945,
+	0, 1662, 0, 0, 0, 944, 1405, 0, 964, 1407,
+	1636, 1637, 0, 0, 1641, 940, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1496, 0, 0, 266, 0,
+	968, 292, 0, 0, 292, 292, 959, 332, 962, 961,
+	1436, 1437, 1269, 0, 0, 0, 0, 937, 0, 1443,
+	1444, 1445, 0, 0, 0, 768, 1264, 772, 781, 0,
+	0, 0, 785, 332, 332, 71, 0, 266, 0, 770,
+	1458, 0, 1459, 0, 0, 0, 0, 0, 766, 1464,
+	0, 0, 0, 753, 0, 1496, 1603, 0, 962, 961,
+	940, 0, 0, 0, 0, 1461, 0, 937, 0, 0,
+	0, 1465, 0, 765, 0, 1505, 0, 71, 0, 0,
+	1265, 962, 961, 0, 1250, 0, 1266, 1267, 1268, 0,
+	937, 0, 0, 0, 0, 0, 0, 0, 1522, 0,
+	0, 1520, 0, 1526, 1527, 0, 1727, 754, 1529, 0,
+	0, 0, 0, 1533, 0, 0, 0, 769, 0, 0,
+	0, 0, 0, 0, 0, 1460, 0, 0, 1538, 0,
+	0, 0, 1541, 1263, 0, 0, 0, 0, 0, 0,
+	0, 0, 1260, 1261, 1262, 1727, 0, 1259, 1256, 1257,
+	1258, 1251, 1252, 1253, 1254, 1255, 962, 961, 0, 0,
+	0, 0, 1549, 0, 0, 937, 0, 767, 0, 749,
+	750, 751, 0, 758, 748, 745, 746, 747, 740, 741,
+	742, 743, 744, 0, 0, 1530, 0, 0, 0, 0,
+	0, 1531, 0, 0, 0, 0, 0, 1250, 0, 1266,
+	1267, 1268, 0, 0, 1574, 0, 0, 0, 1269, 0,
+	0, 0, 0, 0, 1373, 0, 0, 0, 0, 0,
+	0, 0, 1264, 0, 0, 45, 0, 0, 0, 1598,
+	0, 0, 0, 0, 0, 0, 0, 0, 45, 0,
+	0, 0, 1606, 0, 0, 0, 1263, 0, 0, 0,
+	0, 1611, 1612, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1616, 737, 0, 755, 756, 757, 759, 760,
+	761, 762, 763, 0, 0, 0, 1265, 0, 0, 0,
+	764, 0, 0, 1626, 0, 0, 739, 0, 1250, 771,
+	1266, 1267, 1268, 1628, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 738, 0, 0, 0, 0,
+	0, 0, 752, 0, 0, 0, 0, 528, 931, 0,
+	0, 1269, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1264, 0, 1263, 1260, 1261,
+	1262, 0, 0, 1259, 1256, 1257, 1258, 1251, 1252, 1253,
+	1254, 1255, 0, 0, 1250, 1016, 1266, 1267, 1268, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1372, 0, 768, 737, 772, 755, 756, 757, 759,
+	760, 761, 762, 763, 0, 0, 0, 770, 0, 1265,
+	0, 764, 0, 0, 0, 0, 766, 739, 0, 0,
+	771, 753, 0, 1263, 1701, 0, 0, 0, 0, 0,
+	0, 0, 1269, 0, 0, 0, 738, 0, 1715, 1715,
+	0, 765, 0, 752, 0, 0, 1264, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1715, 0, 0, 0, 0, 0, 0,
+	0, 1260, 1261, 1262, 300, 754, 1259, 1256, 1257, 1258,
+	1251, 1252, 1253, 1254, 1255, 769, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1750, 1715, 0, 1269, 0,
+	1265, 0, 0, 0, 768, 0, 772, 0, 0, 0,
+	0, 0, 1264, 0, 0, 0, 0, 0, 770, 0,
+	0, 0, 0, 0, 0, 0, 0, 766, 45, 0,
+	0, 0, 753, 0, 0, 767, 1187, 749, 750, 751,
+	0, 758, 748, 745, 746, 747, 740, 741, 742, 743,
+	744, 0, 765, 860, 0, 0, 0, 0, 0, 861,
+	0, 0, 1260, 1261, 1262, 0, 1265, 1259, 1256, 1257,
+	1258, 1251, 1252, 1253, 1254, 1255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 754, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 769, 0, 0, 0,
+	0, 0, 0, 0, 0, 1016, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	781, 0, 0, 0, 0, 0, 0, 0, 1260, 1261,
+	1262, 0, 0, 1259, 1256, 1257, 1258, 1251, 1252, 1253,
+	1254, 1255, 0, 0, 0, 0, 767, 0, 749, 750,
+	751, 0, 758, 748, 745, 746, 747, 740, 741, 742,
+	743, 744, 0, 0, 0, 0, 1666, 0, 0, 468,
+	456, 457, 458, 455, 444, 781, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 1033, 77,
+	0, 0, 0, 0, 450, 0, 0, 0, 78, 79,
+	80, 178, 497, 498, 81, 499, 500, 0, 82, 183,
+	83, 465, 483, 501, 502, 0, 493, 0, 476, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	359, 91, 92, 93, 0, 477, 479, 0, 478, 480,
+	94, 95, 96, 97, 503, 98, 504, 505, 0, 0,
+	99, 0, 0, 1034, 0, 496, 101, 0, 0, 0,
+	0, 102, 449, 103, 104, 484, 463, 0, 105, 106,
+	506, 107, 0, 0, 0, 360, 931, 108, 494, 931,
+	194, 0, 109, 490, 492, 361, 110, 0, 111, 0,
+	0, 362, 112, 507, 508, 509, 0, 475, 0, 363,
+	113, 364, 114, 0, 0, 495, 365, 115, 366, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 439, 124, 464, 491, 125, 510, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 368, 129, 369,
+	485, 130, 131, 0, 486, 132, 207, 0, 133, 134,
+	511, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 370, 143, 144, 145, 453, 146, 0, 147, 148,
+	149, 0, 150, 151, 481, 152, 153, 371, 154, 512,
+	155, 0, 156, 157, 159, 211, 158, 487, 0, 0,
+	160, 161, 0, 213, 513, 0, 0, 162, 488, 489,
+	462, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	482, 0, 170, 171, 172, 217, 514, 1032, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 440, 0, 0,
+	0, 0, 45, 438, 0, 0, 0, 0, 436, 437,
+	1035, 0, 0, 0, 0, 0, 445, 1030, 0, 0,
+	931, 931, 0, 0, 931, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1585, 0, 0, 0, 0, 0, 0, 0, 550,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 931, 75, 76, 555, 77,
+	556, 557, 558, 559, 560, 561, 562, 563, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 564, 82, 183,
+	83, 565, 566, 184, 185, 567, 186, 568, 358, 569,
+	84, 85, 86, 87, 0, 88, 89, 570, 90, 571,
+	359, 91, 92, 93, 572, 573, 574, 575, 576, 577,
+	94, 95, 96, 97, 187, 98, 188, 189, 578, 579,
+	99, 580, 581, 582, 583, 100, 101, 584, 585, 781,
+	586, 102, 190, 103, 104, 191, 587, 588, 105, 106,
+	192, 107, 589, 590, 591, 360, 592, 108, 193, 593,
+	194, 594, 109, 195, 196, 361, 110, 595, 111, 596,
+	597, 362, 112, 197, 198, 199, 598, 200, 599, 363,
+	113, 364, 114, 600, 601, 201, 365, 115, 366, 602,
+	116, 603, 604, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 605, 124, 606, 202, 125, 203, 126, 127,
+	607, 608, 609, 610, 611, 128, 204, 368, 129, 369,
+	205, 130, 131, 612, 206, 132, 207, 613, 133, 134,
+	208, 135, 136, 614, 137, 138, 139, 140, 141, 615,
+	142, 370, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 616, 150, 151, 617, 152, 153, 371, 154, 210,
+	155, 618, 156, 157, 159, 211, 158, 212, 619, 620,
+	160, 161, 621, 213, 214, 622, 623, 162, 215, 216,
+	624, 163, 164, 165, 166, 167, 625, 626, 168, 169,
+	627, 628, 170, 171, 172, 217, 218, 629, 173, 630,
+	631, 632, 633, 174, 175, 176, 177, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 75, 76, 555, 77, 556, 557,
+	558, 559, 560, 561, 562, 563, 78, 79, 80, 178,
+	179, 180, 81, 181, 182, 564, 82, 183, 83, 565,
+	566, 184, 185, 567, 186, 568, 358, 569, 84, 85,
+	86, 87, 0, 88, 89, 570, 90, 571, 359, 91,
+	92, 93, 572, 573, 574, 575, 576, 577, 94, 95,
+	96, 97, 187, 98, 188, 189, 578, 579, 99, 580,
+	581, 582, 583, 100, 101, 584, 585, 0, 586, 102,
+	190, 103, 104, 191, 587, 588, 105, 106, 192, 107,
+	589, 590, 591, 360, 592, 108, 193, 593, 194, 594,
+	109, 195, 196, 361, 110, 595, 111, 596, 597, 362,
+	112, 197, 198, 199, 598, 200, 599, 363, 113, 364,
+	114, 600, 601, 201, 365, 115, 366, 602, 116, 603,
+	604, 0, 117, 118, 119, 120, 121, 367, 122, 123,
+	605, 124, 606, 202, 125, 203, 126, 127, 607, 608,
+	609, 610, 611, 128, 204, 368, 129, 369, 205, 130,
+	131, 612, 206, 132, 207, 613, 133, 134, 208, 135,
+	136, 614, 137, 138, 139, 140, 141, 615, 142, 370,
+	143, 144, 145, 209, 146, 0, 147, 148, 149, 616,
+	150, 151, 617, 152, 153, 371, 154, 210, 155, 618,
+	156, 157, 159, 211, 158, 212, 619, 620, 160, 161,
+	621, 213, 214, 622, 623, 162, 215, 216, 624, 163,
+	164, 165, 166, 167, 625, 626, 168, 169, 627, 628,
+	170, 171, 172, 217, 218, 629, 173, 630, 631, 632,
+	633, 174, 175, 176, 177, 468, 456, 457, 458, 455,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 76, 0, 77, 0, 0, 0, 0,
+	450, 0, 0, 0, 78, 79, 80, 178, 497, 498,
+	81, 499, 500, 0, 82, 183, 83, 465, 483, 501,
+	502, 0, 493, 0, 476, 0, 84, 85, 86, 87,
+	0, 88, 89, 0, 90, 0, 359, 91, 92, 93,
+	0, 477, 479, 0, 478, 480, 94, 95, 96, 97,
+	503, 98, 504, 505, 529, 0, 99, 0, 0, 0,
+	0, 496, 101, 0, 0, 0, 0, 102, 449, 103,
+	104, 484, 463, 0, 105, 106, 506, 107, 0, 0,
+	0, 360, 0, 108, 494, 0, 194, 0, 109, 490,
+	492, 361, 110, 0, 111, 0, 0, 362, 112, 507,
+	508, 509, 0, 475, 0, 363, 113, 364, 114, 0,
+	0, 495, 365, 115, 366, 0, 116, 0, 0, 0,
+	117, 118, 119, 120, 121, 367, 122, 123, 439, 124,
+	464, 491, 125, 510, 126, 127, 0, 0, 0, 0,
+	0, 128, 204, 368, 129, 369, 485, 130, 131, 0,
+	486, 132, 207, 0, 133, 134, 511, 135, 136, 0,
+	137, 138, 139, 140, 141, 0, 142, 370, 143, 144,
+	145, 453, 146, 0, 147, 148, 149, 62, 150, 151,
+	481, 152, 153, 371, 154, 512, 155, 0, 156, 157,
+	159, 211, 158, 487, 0, 64, 160, 161, 0, 213,
+	513, 0, 0, 162, 488, 489, 462, 163, 164, 165,
+	166, 167, 0, 0, 168, 169, 482, 0, 170, 171,
+	172, 357, 514, 0, 173, 0, 0, 0, 60, 174,
+	175, 176, 177, 440, 61, 0, 0, 0, 0, 438,
+	0, 0, 0, 0, 436, 437, 468, 456, 457, 458,
+	455, 444, 445, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 75, 76, 0, 77, 0, 0, 0,
+	0, 450, 0, 0, 0, 78, 79, 80, 178, 497,
+	498, 81, 499, 500, 0, 82, 183, 83, 465, 483,
+	501, 502, 0, 493, 0, 476, 0, 84, 85, 86,
+	87, 0, 88, 89, 0, 90, 0, 359, 91, 92,
+	93, 0, 477, 479, 0, 478, 480, 94, 95, 96,
+	97, 503, 98, 504, 505, 0, 0, 99, 0, 0,
+	0, 0, 496, 101, 0, 0, 0, 0, 102, 449,
+	103, 104, 484, 463, 0, 105, 106, 506, 107, 0,
+	0, 0, 360, 0, 108, 494, 0, 194, 0, 109,
+	490, 492, 361, 110, 0, 111, 0, 0, 362, 112,
+	507, 508, 509, 0, 475, 0, 363, 113, 364, 114,
+	0, 0, 495, 365, 115, 366, 0, 116, 0, 0,
+	0, 117, 118, 119, 120, 121, 367, 122, 123, 439,
+	124, 464, 491, 125, 510, 126, 127, 0, 0, 0,
+	0, 0, 128, 204, 368, 129, 369, 485, 130, 131,
+	0, 486, 132, 207, 0, 133, 134, 511, 135, 136,
+	0, 137, 138, 139, 140, 141, 0, 142, 370, 143,
+	144, 145, 453, 146, 0, 147, 148, 149, 62, 150,
+	151, 481, 152, 153, 371, 154, 512, 155, 0, 156,
+	157, 159, 211, 158, 487, 0, 64, 160, 161, 0,
+	213, 513, 0, 0, 162, 488, 489, 462, 163, 164,
+	165, 166, 167, 0, 0, 168, 169, 482, 0, 170,
+	171, 172, 357, 514, 0, 173, 0, 0, 0, 60,
+	174, 175, 176, 177, 440, 61, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 436, 437, 468, 456, 457,
+	458, 455, 444, 445, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 75, 76, 0, 77, 0, 0,
+	0, 0, 450, 0, 0, 0, 78, 79, 80, 178,
+	497, 498, 81, 499, 500, 1078, 82, 183, 83, 465,
+	483, 501, 502, 0, 493, 0, 476, 0, 84, 85,
+	86, 87, 0, 88, 89, 0, 90, 0, 359, 91,
+	92, 93, 0, 477, 479, 0, 478, 480, 94, 95,
+	96, 97, 503, 98, 504, 505, 0, 0, 99, 0,
+	0, 0, 0, 496, 101, 0, 0, 0, 0, 102,
+	449, 103, 104, 484, 463, 0, 105, 106, 506, 107,
+	0, 0, 1083, 360, 0, 108, 494, 0, 194, 0,
+	109, 490, 492, 361, 110, 0, 111, 0, 0, 362,
+	112, 507, 508, 509, 0, 475, 0, 363, 113, 364,
+	114, 0, 1079, 495, 365, 115, 366, 0, 116, 0,
+	0, 0, 117, 118, 119, 120, 121, 367, 122, 123,
+	439, 124, 464, 491, 125, 510, 126, 127, 0, 0,
+	0, 0, 0, 128, 204, 368, 129, 369, 485, 130,
+	131, 0, 486, 132, 207, 0, 133, 134, 511, 135,
+	136, 0, 137, 138, 139, 140, 141, 0, 142, 370,
+	143, 144, 145, 453, 146, 0, 147, 148, 149, 0,
+	150, 151, 481, 152, 153, 371, 154, 512, 155, 0,
+	156, 157, 159, 211, 158, 487, 0, 0, 160, 161,
+	0, 213, 513, 0, 1080, 162, 488, 489, 462, 163,
+	164, 165, 166, 167, 0, 0, 168, 169, 482, 0,
+	170, 171, 172, 217, 514, 0, 173, 0, 0, 0,
+	0, 174, 175, 176, 177, 440, 0, 0, 0, 0,
+	0, 438, 0, 0, 0, 0, 436, 437, 468, 456,
+	457, 458, 455, 444, 445, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 76, 0, 77, 0,
+	0, 0, 0, 450, 0, 0, 0, 78, 79, 80,
+	178, 497, 498, 81, 499, 500, 0, 82, 183, 83,
+	465, 483, 501, 502, 0, 493, 0, 476, 0, 84,
+	85, 86, 87, 0, 88, 89, 0, 90, 0, 359,
+	91, 92, 93, 0, 477, 479, 0, 478, 480, 94,
+	95, 96, 97, 503, 98, 504, 505, 0, 0, 99,
+	0, 0, 0, 0, 496, 101, 0, 0, 0, 0,
+	102, 449, 103, 104, 484, 463, 0, 105, 106, 506,
+	107, 0, 0, 0, 360, 0, 108, 494, 0, 194,
+	0, 109, 490, 492, 361, 110, 0, 111, 0, 0,
+	362, 112, 507, 508, 509, 0, 475, 0, 363, 113,
+	364, 114, 0, 0, 495, 365, 115, 366, 0, 116,
+	0, 0, 0, 117, 118, 119, 120, 121, 367, 122,
+	123, 439, 124, 464, 491, 125, 510, 126, 127, 0,
+	0, 0, 0, 0, 128, 204, 368, 129, 369, 485,
+	130, 131, 0, 486, 132, 207, 0, 133, 134, 511,
+	135, 136, 0, 137, 138, 139, 140, 141, 0, 142,
+	370, 143, 144, 145, 453, 146, 0, 147, 148, 149,
+	0, 150, 151, 481, 152, 153, 371, 154, 512, 155,
+	0, 156, 157, 159, 211, 158, 487, 0, 0, 160,
+	161, 0, 213, 513, 0, 0, 162, 488, 489, 462,
+	163, 164, 165, 166, 167, 0, 0, 168, 169, 482,
+	0, 170, 171, 172, 217, 514, 0, 173, 0, 0,
+	0, 0, 174, 175, 176, 177, 440, 0, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 436, 437, 468,
+	456, 457, 458, 455, 444, 445, 1433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 450, 0, 0, 0, 78, 79,
+	80, 178, 497, 498, 81, 499, 500, 0, 82, 183,
+	83, 465, 483, 501, 502, 0, 493, 0, 476, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	359, 91, 92, 93, 0, 477, 479, 0, 478, 480,
+	94, 95, 96, 97, 503, 98, 504, 505, 0, 0,
+	99, 0, 0, 0, 0, 496, 101, 0, 0, 0,
+	0, 102, 449, 103, 104, 484, 463, 0, 105, 106,
+	506, 107, 0, 0, 0, 360, 0, 108, 494, 0,
+	194, 0, 109, 490, 492, 361, 110, 0, 111, 0,
+	0, 362, 112, 507, 508, 509, 0, 475, 0, 363,
+	113, 364, 114, 0, 0, 495, 365, 115, 366, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 439, 124, 464, 491, 125, 510, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 368, 129, 369,
+	485, 130, 131, 0, 486, 132, 207, 0, 133, 134,
+	511, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 370, 143, 144, 145, 453, 146, 0, 147, 148,
+	149, 0, 150, 151, 481, 152, 153, 371, 154, 512,
+	155, 0, 156, 157, 159, 211, 158, 487, 0, 0,
+	160, 161, 0, 213, 513, 0, 0, 162, 488, 489,
+	462, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	482, 0, 170, 171, 172, 217, 514, 0, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 440, 0, 0,
+	0, 0, 0, 438, 0, 0, 0, 0, 436, 437,
+	468, 456, 457, 458, 455, 444, 445, 1376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 76, 0,
+	77, 0, 0, 0, 0, 450, 0, 0, 0, 78,
+	79, 80, 178, 497, 498, 81, 499, 500, 0, 82,
+	183, 83, 465, 483, 501, 502, 0, 493, 0, 476,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 359, 91, 92, 93, 0, 477, 479, 0, 478,
+	480, 94, 95, 96, 97, 503, 98, 504, 505, 0,
+	0, 99, 0, 0, 0, 0, 496, 101, 0, 0,
+	0, 0, 102, 449, 103, 104, 484, 463, 0, 105,
+	106, 506, 107, 0, 0, 0, 360, 0, 108, 494,
+	0, 194, 0, 109, 490, 492, 361, 110, 0, 111,
+	0, 0, 362, 112, 507, 508, 509, 0, 475, 0,
+	363, 113, 364, 114, 0, 0, 495, 365, 115, 366,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	367, 122, 123, 439, 124, 464, 491, 125, 510, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 368, 129,
+	369, 485, 130, 131, 0, 486, 132, 207, 0, 133,
+	134, 511, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 370, 143, 144, 145, 453, 146, 0, 147,
+	148, 149, 0, 150, 151, 481, 152, 153, 371, 154,
+	512, 155, 0, 156, 157, 159, 211, 158, 487, 0,
+	0, 160, 161, 0, 213, 513, 0, 0, 162, 488,
+	489, 462, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 482, 0, 170, 171, 172, 217, 514, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 440, 0,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 436,
+	437, 468, 456, 457, 458, 455, 444, 445, 1029, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 76,
+	0, 77, 0, 0, 0, 0, 450, 0, 0, 0,
+	78, 79, 80, 178, 497, 498, 81, 499, 500, 0,
+	82, 183, 83, 465, 483, 501, 502, 0, 493, 0,
+	476, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 359, 91, 92, 93, 0, 477, 479, 0,
+	478, 480, 94, 95, 96, 97, 503, 98, 504, 505,
+	0, 0, 99, 0, 0, 0, 0, 496, 101, 0,
+	0, 0, 0, 102, 449, 103, 104, 484, 463, 0,
+	105, 106, 506, 107, 0, 0, 0, 360, 0, 108,
+	494, 0, 194, 0, 109, 490, 492, 361, 110, 0,
+	111, 0, 0, 362, 112, 507, 508, 509, 0, 475,
+	0, 363, 113, 364, 114, 0, 0, 495, 365, 115,
+	366, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 367, 122, 123, 439, 124, 464, 491, 125, 510,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 368,
+	129, 369, 485, 130, 131, 0, 486, 132, 207, 0,
+	133, 134, 511, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 370, 143, 144, 145, 453, 146, 0,
+	147, 148, 149, 0, 150, 151, 481, 152, 153, 371,
+	154, 512, 155, 0, 156, 157, 159, 211, 158, 487,
+	0, 0, 160, 161, 0, 213, 513, 0, 0, 162,
+	488, 489, 462, 163, 164, 165, 166, 167, 0, 0,
+	168, 169, 482, 0, 170, 171, 172, 217, 514, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 440,
+	0, 0, 0, 0, 0, 438, 0, 0, 0, 0,
+	436, 437, 0, 0, 0, 0, 787, 1026, 445, 468,
+	456, 457, 458, 455, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 450, 0, 0, 0, 78, 79,
+	80, 178, 497, 498, 81, 499, 500, 0, 82, 183,
+	83, 465, 483, 501, 502, 0, 493, 0, 476, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	359, 91, 92, 93, 0, 477, 479, 0, 478, 480,
+	94, 95, 96, 97, 503, 98, 504, 505, 0, 0,
+	99, 0, 0, 0, 0, 496, 101, 0, 0, 0,
+	0, 102, 449, 103, 104, 484, 463, 0, 105, 106,
+	506, 107, 0, 0, 0, 360, 0, 108, 494, 0,
+	194, 0, 109, 490, 492, 361, 110, 0, 111, 0,
+	0, 362, 112, 507, 508, 509, 0, 475, 0, 363,
+	113, 364, 114, 0, 0, 495, 365, 115, 366, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 439, 124, 464, 491, 125, 510, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 368, 129, 369,
+	485, 130, 131, 0, 486, 132, 207, 0, 133, 134,
+	511, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 370, 143, 144, 145, 453, 146, 0, 147, 148,
+	149, 0, 150, 151, 481, 152, 153, 371, 154, 512,
+	155, 0, 156, 157, 159, 211, 158, 487, 0, 0,
+	160, 161, 0, 213, 513, 0, 0, 162, 488, 489,
+	462, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	482, 0, 170, 171, 172, 217, 514, 1382, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 440, 0, 0,
+	0, 0, 0, 438, 0, 0, 0, 0, 436, 437,
+	468, 456, 457, 458, 455, 444, 445, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 76, 0,
+	77, 0, 0, 0, 0, 450, 0, 0, 0, 78,
+	79, 80, 178, 497, 498, 81, 499, 500, 0, 82,
+	183, 83, 465, 483, 501, 502, 0, 493, 0, 476,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 359, 91, 92, 93, 0, 477, 479, 0, 478,
+	480, 94, 95, 96, 97, 503, 98, 504, 505, 529,
+	0, 99, 0, 0, 0, 0, 496, 101, 0, 0,
+	0, 0, 102, 449, 103, 104, 484, 463, 0, 105,
+	106, 506, 107, 0, 0, 0, 360, 0, 108, 494,
+	0, 194, 0, 109, 490, 492, 361, 110, 0, 111,
+	0, 0, 362, 112, 507, 508, 509, 0, 475, 0,
+	363, 113, 364, 114, 0, 0, 495, 365, 115, 366,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	367, 122, 123, 439, 124, 464, 491, 125, 510, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 368, 129,
+	369, 485, 130, 131, 0, 486, 132, 207, 0, 133,
+	134, 511, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 370, 143, 144, 145, 453, 146, 0, 147,
+	148, 149, 0, 150, 151, 481, 152, 153, 371, 154,
+	512, 155, 0, 156, 157, 159, 211, 158, 487, 0,
+	0, 160, 161, 0, 213, 513, 0, 0, 162, 488,
+	489, 462, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 482, 0, 170, 171, 172, 217, 514, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 440, 0,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 436,
+	437, 468, 456, 457, 458, 455, 444, 445, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 76,
+	0, 77, 0, 0, 0, 0, 450, 0, 0, 0,
+	78, 79, 80, 178, 497, 498, 81, 499, 500, 0,
+	82, 183, 83, 465, 483, 501, 502, 0, 493, 0,
+	476, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 359, 91, 92, 93, 0, 477, 479, 0,
+	478, 480, 94, 95, 96, 97, 503, 98, 504, 505,
+	0, 0, 99, 0, 0, 0, 0, 496, 101, 0,
+	0, 0, 0, 102, 449, 103, 104, 484, 463, 0,
+	105, 106, 506, 107, 0, 0, 1083, 360, 0, 108,
+	494, 0, 194, 0, 109, 490, 492, 361, 110, 0,
+	111, 0, 0, 362, 112, 507, 508, 509, 0, 475,
+	0, 363, 113, 364, 114, 0, 0, 495, 365, 115,
+	366, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 367, 122, 123, 439, 124, 464, 491, 125, 510,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 368,
+	129, 369, 485, 130, 131, 0, 486, 132, 207, 0,
+	133, 134, 511, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 370, 143, 144, 145, 453, 146, 0,
+	147, 148, 149, 0, 150, 151, 481, 152, 153, 371,
+	154, 512, 155, 0, 156, 157, 159, 211, 158, 487,
+	0, 0, 160, 161, 0, 213, 513, 0, 0, 162,
+	488, 489, 462, 163, 164, 165, 166, 167, 0, 0,
+	168, 169, 482, 0, 170, 171, 172, 217, 514, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 440,
+	0, 0, 0, 0, 0, 438, 0, 0, 0, 0,
+	436, 437, 468, 456, 457, 458, 455, 444, 445, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
+	76, 0, 77, 0, 0, 0, 0, 450, 0, 0,
+	0, 78, 79, 80, 178, 497, 498, 81, 499, 500,
+	0, 82, 183, 83, 465, 483, 501, 502, 0, 493,
+	0, 476, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 0, 359, 91, 92, 93, 0, 477, 479,
+	0, 478, 480, 94, 95, 96, 97, 503, 98, 504,
+	505, 0, 0, 99, 0, 0, 0, 0, 496, 101,
+	0, 0, 0, 0, 102, 449, 103, 104, 484, 463,
+	0, 105, 106, 506, 107, 0, 0, 0, 360, 0,
+	108, 494, 0, 194, 0, 109, 490, 492, 361, 110,
+	0, 111, 0, 0, 362, 112, 507, 508, 509, 0,
+	475, 0, 363, 113, 364, 114, 0, 0, 495, 365,
+	115, 366, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 367, 122, 123, 439, 124, 464, 491, 125,
+	510, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	368, 129, 369, 485, 130, 131, 0, 486, 132, 207,
+	0, 133, 134, 511, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 370, 143, 144, 145, 453, 146,
+	0, 147, 148, 149, 0, 150, 151, 481, 152, 153,
+	371, 154, 512, 155, 0, 156, 157, 159, 211, 158,
+	487, 0, 0, 160, 161, 0, 213, 513, 0, 0,
+	162, 488, 489, 462, 163, 164, 165, 166, 167, 0,
+	0, 168, 169, 482, 0, 170, 171, 172, 217, 514,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	440, 0, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 436, 437, 434, 0, 0, 0, 0, 0, 445,
+	468, 456, 457, 458, 455, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 76, 722,
+	77, 0, 0, 0, 0, 450, 0, 0, 0, 78,
+	79, 80, 178, 497, 498, 81, 499, 500, 0, 82,
+	183, 83, 465, 483, 501, 502, 0, 493, 0, 476,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 359, 91, 92, 93, 0, 477, 479, 0, 478,
+	480, 94, 95, 96, 97, 503, 98, 504, 505, 0,
+	0, 99, 0, 0, 0, 0, 496, 101, 0, 0,
+	0, 0, 102, 449, 103, 104, 484, 463, 0, 105,
+	106, 506, 107, 0, 0, 0, 360, 0, 108, 494,
+	0, 194, 0, 109, 490, 492, 361, 110, 0, 111,
+	0, 0, 362, 112, 507, 508, 509, 0, 475, 0,
+	363, 113, 364, 114, 0, 0, 495, 365, 115, 366,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	367, 122, 123, 439, 124, 464, 491, 125, 510, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 368, 129,
+	369, 485, 130, 131, 0, 486, 132, 207, 0, 133,
+	134, 511, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 370, 143, 144, 145, 453, 146, 0, 147,
+	148, 149, 0, 150, 151, 481, 152, 153, 371, 154,
+	512, 155, 0, 156, 157, 159, 211, 158, 487, 0,
+	0, 160, 161, 0, 213, 513, 0, 0, 162, 488,
+	489, 462, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 482, 0, 170, 171, 172, 217, 514, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 440, 0,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 436,
+	437, 468, 456, 457, 458, 455, 444, 445, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 76,
+	0, 77, 0, 0, 0, 0, 450, 0, 0, 0,
+	78, 79, 80, 178, 497, 498, 81, 499, 500, 0,
+	82, 183, 83, 465, 483, 501, 502, 0, 493, 0,
+	476, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 359, 91, 92, 1714, 0, 477, 479, 0,
+	478, 480, 94, 95, 96, 97, 503, 98, 504, 505,
+	0, 0, 99, 0, 0, 0, 0, 496, 101, 0,
+	0, 0, 0, 102, 449, 103, 104, 484, 463, 0,
+	105, 106, 506, 107, 0, 0, 0, 360, 0, 108,
+	494, 0, 194, 0, 109, 490, 492, 361, 110, 0,
+	111, 0, 0, 362, 112, 507, 508, 509, 0, 475,
+	0, 363, 113, 364, 114, 0, 0, 495, 365, 115,
+	366, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 367, 122, 123, 439, 124, 464, 491, 125, 510,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 368,
+	129, 369, 485, 130, 131, 0, 486, 132, 207, 0,
+	133, 134, 511, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 370, 143, 144, 145, 453, 146, 0,
+	147, 148, 149, 0, 150, 151, 481, 152, 153, 371,
+	154, 512, 155, 0, 156, 157, 159, 211, 158, 487,
+	0, 0, 160, 161, 0, 213, 513, 0, 0, 162,
+	488, 489, 462, 163, 164, 165, 1713, 167, 0, 0,
+	168, 169, 482, 0, 170, 171, 172, 217, 514, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 440,
+	0, 0, 0, 0, 0, 438, 0, 0, 0, 0,
+	436, 437, 468, 456, 457, 458, 455, 444, 445, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
+	76, 0, 77, 0, 0, 0, 0, 450, 0, 0,
+	0, 78, 79, 80, 178, 497, 498, 81, 499, 500,
+	0, 82, 183, 83, 465, 483, 501, 502, 0, 493,
+	0, 476, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 0, 359, 91, 92, 93, 0, 477, 479,
+	0, 478, 480, 94, 95, 96, 97, 503, 98, 504,
+	505, 0, 0, 99, 0, 0, 0, 0, 496, 101,
+	0, 0, 0, 0, 102, 449, 103, 104, 484, 463,
+	0, 105, 106, 506, 107, 0, 0, 0, 360, 0,
+	108, 494, 0, 194, 0, 109, 490, 492, 361, 110,
+	0, 111, 0, 0, 362, 112, 507, 508, 509, 0,
+	475, 0, 363, 113, 364, 114, 0, 0, 495, 365,
+	115, 366, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 367, 122, 123, 439, 124, 464, 491, 125,
+	510, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	368, 129, 369, 485, 130, 131, 0, 486, 132, 207,
+	0, 133, 134, 511, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 370, 143, 144, 145, 453, 146,
+	0, 147, 148, 149, 0, 150, 151, 481, 152, 153,
+	371, 154, 512, 155, 0, 156, 157, 159, 211, 158,
+	487, 0, 0, 160, 161, 0, 213, 513, 0, 0,
+	162, 488, 489, 462, 163, 164, 165, 166, 167, 0,
+	0, 168, 169, 482, 0, 170, 171, 172, 217, 514,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	440, 0, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 436, 437, 468, 456, 457, 458, 455, 444, 445,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	75, 76, 0, 77, 0, 0, 0, 0, 450, 0,
+	0, 0, 78, 79, 80, 1712, 497, 498, 81, 499,
+	500, 0, 82, 183, 83, 465, 483, 501, 502, 0,
+	493, 0, 476, 0, 84, 85, 86, 87, 0, 88,
+	89, 0, 90, 0, 359, 91, 92, 1714, 0, 477,
+	479, 0, 478, 480, 94, 95, 96, 97, 503, 98,
+	504, 505, 0, 0, 99, 0, 0, 0, 0, 496,
+	101, 0, 0, 0, 0, 102, 449, 103, 104, 484,
+	463, 0, 105, 106, 506, 107, 0, 0, 0, 360,
+	0, 108, 494, 0, 194, 0, 109, 490, 492, 361,
+	110, 0, 111, 0, 0, 362, 112, 507, 508, 509,
+	0, 475, 0, 363, 113, 364, 114, 0, 0, 495,
+	365, 115, 366, 0, 116, 0, 0, 0, 117, 118,
+	119, 120, 121, 367, 122, 123, 439, 124, 464, 491,
+	125, 510, 126, 127, 0, 0, 0, 0, 0, 128,
+	204, 368, 129, 369, 485, 130, 131, 0, 486, 132,
+	207, 0, 133, 134, 511, 135, 136, 0, 137, 138,
+	139, 140, 141, 0, 142, 370, 143, 144, 145, 453,
+	146, 0, 147, 148, 149, 0, 150, 151, 481, 152,
+	153, 371, 154, 512, 155, 0, 156, 157, 159, 211,
+	158, 487, 0, 0, 160, 161, 0, 213, 513, 0,
+	0, 162, 488, 489, 462, 163, 164, 165, 1713, 167,
+	0, 0, 168, 169, 482, 0, 170, 171, 172, 217,
+	514, 0, 173, 0, 0, 0, 0, 174, 175, 176,
+	177, 440, 0, 0, 0, 0, 0, 438, 0, 0,
+	0, 0, 436, 437, 468, 456, 457, 458, 455, 444,
+	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 75, 76, 0, 77, 0, 0, 0, 0, 450,
+	0, 0, 0, 78, 79, 80, 178, 497, 498, 81,
+	499, 500, 0, 82, 183, 83, 465, 483, 501, 502,
+	0, 493, 0, 476, 0, 84, 85, 86, 87, 0,
+	88, 89, 0, 90, 0, 359, 91, 92, 93, 0,
+	477, 479, 0, 478, 480, 94, 95, 96, 97, 503,
+	98, 504, 505, 0, 0, 99, 0, 0, 0, 0,
+	496, 101, 0, 0, 0, 0, 102, 449, 103, 104,
+	484, 463, 0, 105, 106, 506, 107, 0, 0, 0,
+	360, 0, 108, 494, 0, 194, 0, 109, 490, 492,
+	361, 110, 0, 111, 0, 0, 362, 112, 507, 508,
+	509, 0, 475, 0, 363, 113, 364, 114, 0, 0,
+	495, 365, 115, 366, 0, 116, 0, 0, 0, 117,
+	118, 119, 120, 121, 367, 122, 123, 0, 124, 464,
+	491, 125, 510, 126, 127, 0, 0, 0, 0, 0,
+	128, 204, 368, 129, 369, 485, 130, 131, 0, 486,
+	132, 207, 0, 133, 134, 511, 135, 136, 0, 137,
+	138, 139, 140, 141, 0, 142, 370, 143, 144, 145,
+	1073, 146, 0, 147, 148, 149, 0, 150, 151, 481,
+	152, 153, 371, 154, 512, 155, 0, 156, 157, 159,
+	211, 158, 487, 0, 0, 160, 161, 0, 213, 513,
+	0, 0, 162, 488, 489, 462, 163, 164, 165, 166,
+	167, 0, 0, 168, 169, 482, 0, 170, 171, 172,
+	217, 514, 0, 173, 0, 0, 0, 0, 174, 175,
+	176, 177, 468, 456, 457, 458, 455, 444, 1071, 0,
+	0, 0, 0, 1069, 1070, 0, 0, 0, 0, 75,
+	76, 1072, 77, 0, 0, 0, 0, 450, 0, 0,
+	0, 78, 79, 80, 0, 497, 498, 81, 499, 500,
+	0, 82, 183, 83, 465, 483, 501, 502, 0, 493,
+	0, 476, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 0, 359, 91, 92, 1714, 0, 477, 479,
+	0, 478, 480, 94, 95, 96, 97, 503, 98, 504,
+	505, 0, 0, 99, 0, 0, 0, 0, 496, 101,
+	0, 0, 0, 0, 102, 449, 103, 104, 484, 463,
+	0, 105, 106, 506, 107, 0, 0, 0, 360, 0,
+	108, 494, 0, 194, 0, 109, 490, 492, 0, 110,
+	0, 111, 0, 0, 362, 112, 507, 508, 509, 0,
+	475, 0, 0, 113, 364, 114, 0, 0, 495, 365,
+	115, 0, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 367, 122, 123, 439, 124, 464, 491, 125,
+	510, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	368, 129, 369, 485, 130, 131, 0, 486, 132, 207,
+	0, 133, 134, 511, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 370, 143, 144, 145, 453, 146,
+	0, 147, 148, 149, 0, 150, 151, 481, 152, 153,
+	0, 154, 512, 155, 0, 156, 157, 159, 211, 158,
+	487, 0, 0, 160, 161, 0, 213, 513, 0, 0,
+	162, 488, 489, 462, 163, 164, 165, 1713, 167, 0,
+	0, 168, 169, 482, 0, 170, 171, 172, 217, 514,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	468, 0, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 436, 437, 0, 0, 0, 0, 75, 76, 445,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+	79, 80, 178, 179, 180, 81, 181, 182, 0, 82,
+	183, 83, 0, 483, 184, 185, 0, 493, 0, 476,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 359, 91, 92, 93, 0, 477, 479, 0, 478,
+	480, 94, 95, 96, 97, 187, 98, 188, 189, 0,
+	0, 99, 0, 0, 0, 0, 100, 101, 0, 0,
+	0, 0, 102, 190, 103, 104, 484, 0, 0, 105,
+	106, 192, 107, 0, 0, 0, 360, 0, 108, 494,
+	0, 194, 0, 109, 490, 492, 361, 110, 0, 111,
+	0, 0, 362, 112, 197, 198, 199, 0, 200, 0,
+	363, 113, 364, 114, 0, 0, 495, 365, 115, 366,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	367, 122, 123, 0, 124, 0, 491, 125, 203, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 368, 129,
+	369, 485, 130, 131, 0, 486, 132, 207, 0, 133,
+	134, 208, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 370, 143, 144, 145, 209, 146, 0, 147,
+	148, 149, 0, 150, 151, 481, 152, 153, 371, 154,
+	210, 155, 0, 156, 157, 159, 211, 158, 487, 0,
+	0, 160, 161, 0, 213, 214, 0, 0, 162, 488,
+	489, 0, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 482, 0, 170, 171, 172, 217, 218, 0, 173,
+	353, 0, 0, 0, 174, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 76, 0,
+	77, 0, 352, 0, 0, 0, 0, 1498, 0, 78,
+	79, 80, 178, 179, 180, 81, 181, 182, 0, 82,
+	183, 83, 0, 0, 184, 185, 0, 186, 0, 358,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 359, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 94, 95, 96, 97, 187, 98, 188, 189, 0,
+	0, 99, 0, 0, 0, 0, 100, 101, 0, 0,
+	0, 0, 102, 190, 103, 104, 191, 0, 0, 105,
+	106, 192, 107, 0, 0, 0, 360, 0, 108, 193,
+	0, 194, 0, 109, 195, 196, 361, 110, 0, 111,
+	0, 0, 362, 112, 197, 198, 199, 0, 200, 0,
+	363, 113, 364, 114, 0, 0, 201, 365, 115, 366,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	367, 122, 123, 0, 124, 0, 202, 125, 203, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 368, 129,
+	369, 205, 130, 131, 0, 206, 132, 207, 0, 133,
+	134, 208, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 370, 143, 144, 145, 209, 146, 0, 147,
+	148, 149, 62, 150, 151, 0, 152, 153, 371, 154,
+	210, 155, 0, 156, 157, 159, 211, 158, 212, 0,
+	64, 160, 161, 0, 213, 214, 0, 0, 162, 215,
+	216, 0, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 0, 0, 170, 171, 172, 357, 218, 0, 173,
+	0, 0, 0, 60, 174, 175, 176, 177, 0, 61,
+	0, 353, 681, 685, 0, 686, 676, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 59, 75, 76,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	78, 79, 80, 178, 179, 180, 81, 181, 182, 0,
+	82, 183, 83, 0, 0, 184, 185, 0, 186, 0,
+	358, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 359, 91, 92, 93, 0, 0, 0, 0,
+	0, 0, 94, 95, 96, 97, 187, 98, 188, 189,
+	689, 0, 99, 0, 0, 0, 0, 100, 101, 0,
+	0, 0, 0, 102, 190, 103, 104, 191, 678, 0,
+	105, 106, 192, 107, 0, 0, 0, 360, 0, 108,
+	193, 0, 194, 0, 109, 195, 196, 361, 110, 0,
+	111, 0, 0, 362, 112, 197, 198, 199, 0, 200,
+	0, 363, 113, 364, 114, 0, 0, 201, 365, 115,
+	366, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 367, 122, 123, 0, 124, 0, 202, 125, 203,
+	126, 127, 0, 679, 0, 0, 0, 128, 204, 368,
+	129, 369, 205, 130, 131, 0, 206, 132, 207, 0,
+	133, 134, 208, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 370, 143, 144, 145, 209, 146, 0,
+	147, 148, 149, 0, 150, 151, 0, 152, 153, 371,
+	154, 210, 155, 0, 156, 157, 159, 211, 158, 212,
+	0, 0, 160, 161, 0, 213, 214, 0, 0, 162,
+	215, 216, 677, 163, 164, 165, 166, 167, 0, 0,
+	168, 169, 0, 0, 170, 171, 172, 217, 218, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 353,
+	681, 685, 0, 686, 676, 0, 0, 0, 0, 0,
+	687, 682, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 358, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	359, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 672, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 678, 0, 105, 106,
+	192, 107, 0, 0, 0, 360, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 361, 110, 0, 111, 0,
+	0, 362, 112, 197, 198, 199, 0, 200, 0, 363,
+	113, 364, 114, 0, 0, 201, 365, 115, 366, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 679, 0, 0, 0, 128, 204, 368, 129, 369,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 370, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 371, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	677, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 353, 681, 685,
+	0, 686, 676, 0, 0, 0, 0, 0, 687, 682,
+	0, 0, 0, 0, 75, 76, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 79, 80, 178,
+	179, 180, 81, 181, 182, 0, 82, 183, 83, 0,
+	0, 184, 185, 0, 186, 0, 358, 0, 84, 85,
+	86, 87, 0, 88, 89, 0, 90, 0, 359, 91,
+	92, 93, 0, 0, 0, 0, 0, 0, 94, 95,
+	96, 97, 187, 98, 188, 189, 0, 0, 99, 0,
+	0, 0, 0, 100, 101, 0, 0, 0, 0, 102,
+	190, 103, 104, 191, 678, 0, 105, 106, 192, 107,
+	0, 0, 0, 360, 0, 108, 193, 0, 194, 0,
+	109, 195, 196, 361, 110, 0, 111, 0, 0, 362,
+	112, 197, 198, 199, 0, 200, 0, 363, 113, 364,
+	114, 0, 0, 201, 365, 115, 366, 0, 116, 0,
+	0, 0, 117, 118, 119, 120, 121, 367, 122, 123,
+	0, 124, 0, 202, 125, 203, 126, 127, 0, 679,
+	0, 0, 0, 128, 204, 368, 129, 369, 205, 130,
+	131, 0, 206, 132, 207, 0, 133, 134, 208, 135,
+	136, 0, 137, 138, 139, 140, 141, 0, 142, 370,
+	143, 144, 145, 209, 146, 0, 147, 148, 149, 0,
+	150, 151, 0, 152, 153, 371, 154, 210, 155, 0,
+	156, 157, 159, 211, 158, 212, 0, 0, 160, 161,
+	0, 213, 214, 0, 0, 162, 215, 216, 677, 163,
+	164, 165, 166, 167, 0, 0, 168, 169, 0, 72,
+	170, 171, 172, 217, 218, 0, 173, 0, 0, 0,
+	0, 174, 175, 176, 177, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 687, 682, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 314, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 62, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 64,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 357, 218, 0, 173, 72,
+	0, 0, 60, 174, 175, 176, 177, 0, 61, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 933, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 62, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 64,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 357, 218, 0, 173, 72,
+	0, 0, 60, 174, 175, 176, 177, 0, 61, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 1184, 59, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 72,
+	0, 0, 0, 174, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 425, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 314, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 72,
+	0, 0, 0, 174, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 933, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 72,
+	0, 0, 0, 174, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 879, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 72,
+	0, 0, 0, 174, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 1400, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	0, 0, 170, 171, 172, 217, 218, 0, 173, 353,
+	0, 0, 0, 174, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 76, 0, 77,
+	0, 352, 0, 0, 0, 0, 525, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 358, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	359, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 360, 0, 108, 193, 0,
+	194, 0, 109, 195, 196, 361, 110, 0, 111, 0,
+	0, 362, 112, 197, 198, 199, 0, 200, 0, 363,
+	113, 364, 114, 0, 0, 201, 365, 115, 366, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 367,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 368, 129, 369,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 370, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 371, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	72, 0, 170, 171, 172, 217, 218, 0, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 75, 76, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+	79, 80, 178, 179, 180, 81, 181, 182, 0, 82,
+	183, 83, 0, 0, 184, 185, 844, 186, 0, 0,
+	0, 84, 85, 86, 87, 0, 88, 89, 842, 90,
+	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 94, 95, 96, 97, 187, 98, 188, 189, 0,
+	0, 99, 0, 0, 0, 0, 100, 101, 0, 0,
+	0, 0, 102, 190, 103, 104, 191, 0, 0, 105,
+	106, 192, 107, 0, 847, 0, 0, 0, 108, 193,
+	0, 194, 0, 109, 195, 196, 0, 110, 0, 111,
+	895, 0, 0, 112, 197, 198, 199, 0, 200, 0,
+	0, 113, 0, 114, 0, 0, 201, 0, 115, 0,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 122, 123, 0, 124, 0, 202, 125, 203, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 0, 129,
+	0, 205, 130, 131, 0, 206, 132, 207, 846, 133,
+	134, 208, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 0, 143, 144, 145, 209, 146, 0, 147,
+	148, 149, 0, 150, 151, 0, 152, 153, 0, 154,
+	210, 155, 0, 156, 157, 159, 211, 158, 212, 0,
+	0, 160, 161, 0, 213, 214, 0, 0, 162, 215,
+	216, 0, 163, 164, 165, 166, 167, 0, 896, 168,
+	169, 72, 0, 170, 171, 172, 217, 218, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 75, 76,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	78, 79, 80, 178, 179, 180, 81, 181, 182, 0,
+	82, 183, 83, 0, 0, 184, 185, 844, 186, 0,
+	0, 839, 84, 85, 86, 87, 0, 88, 89, 842,
+	90, 0, 0, 91, 92, 93, 0, 0, 0, 0,
+	0, 0, 94, 95, 96, 97, 187, 98, 188, 189,
+	0, 0, 99, 0, 0, 0, 0, 100, 101, 0,
+	0, 0, 0, 102, 190, 103, 104, 191, 0, 0,
+	105, 106, 192, 107, 0, 847, 0, 0, 0, 108,
+	193, 0, 194, 0, 109, 838, 196, 0, 110, 0,
+	111, 0, 0, 0, 112, 197, 198, 199, 0, 200,
+	0, 0, 113, 0, 114, 0, 0, 201, 0, 115,
+	0, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 0, 122, 123, 0, 124, 0, 202, 125, 203,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 0,
+	129, 0, 205, 130, 131, 0, 206, 132, 207, 846,
+	133, 134, 208, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 0, 143, 144, 145, 209, 146, 0,
+	147, 148, 149, 0, 150, 151, 0, 152, 153, 0,
+	154, 210, 155, 0, 156, 157, 159, 211, 158, 212,
+	0, 0, 160, 161, 0, 213, 214, 0, 0, 162,
+	215, 216, 0, 163, 164, 165, 166, 167, 0, 845,
+	168, 169, 72, 0, 170, 171, 172, 217, 218, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 75,
+	76, 242, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 78, 79, 80, 178, 179, 180, 81, 181, 182,
+	0, 82, 183, 83, 0, 0, 184, 185, 0, 186,
+	0, 0, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 249, 0, 91, 92, 93, 0, 0, 0,
+	0, 0, 0, 94, 95, 96, 97, 187, 98, 188,
+	189, 0, 0, 245, 0, 0, 0, 0, 100, 246,
+	0, 0, 0, 0, 102, 190, 103, 104, 191, 0,
+	0, 105, 106, 192, 107, 0, 0, 0, 0, 250,
+	108, 193, 0, 194, 0, 109, 195, 196, 0, 110,
+	0, 111, 0, 0, 0, 247, 197, 198, 199, 0,
+	200, 0, 0, 113, 0, 114, 0, 0, 201, 0,
+	115, 0, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 122, 123, 0, 124, 0, 202, 125,
+	203, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	0, 129, 0, 205, 130, 131, 0, 206, 132, 207,
+	0, 133, 134, 208, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 0, 143, 144, 145, 209, 146,
+	0, 147, 148, 149, 251, 150, 151, 0, 152, 153,
+	0, 154, 210, 155, 0, 156, 157, 159, 211, 158,
+	212, 0, 0, 160, 161, 0, 213, 214, 0, 0,
+	162, 215, 216, 0, 163, 164, 165, 166, 167, 0,
+	0, 168, 248, 72, 0, 170, 171, 172, 217, 218,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	75, 76, 0, 77, 0, 0, 0, 0, 0, 1184,
+	0, 0, 78, 79, 80, 178, 179, 180, 81, 181,
+	182, 0, 82, 183, 83, 0, 0, 184, 185, 0,
+	186, 0, 0, 0, 84, 85, 86, 87, 0, 88,
+	89, 0, 90, 0, 0, 91, 92, 93, 0, 0,
+	0, 0, 0, 0, 94, 95, 96, 97, 187, 98,
+	188, 189, 0, 0, 99, 0, 0, 0, 0, 100,
+	101, 0, 0, 0, 0, 102, 190, 103, 104, 191,
+	0, 0, 105, 106, 192, 107, 0, 0, 0, 0,
+	0, 108, 193, 0, 194, 0, 109, 195, 196, 0,
+	110, 0, 111, 0, 0, 0, 112, 197, 198, 199,
+	0, 200, 0, 0, 113, 0, 114, 0, 0, 201,
+	0, 115, 0, 0, 116, 0, 0, 0, 117, 118,
+	119, 120, 121, 0, 122, 123, 0, 124, 0, 202,
+	125, 203, 126, 127, 0, 0, 0, 0, 0, 128,
+	204, 0, 129, 0, 205, 130, 131, 0, 206, 132,
+	207, 0, 133, 134, 208, 135, 136, 0, 137, 138,
+	139, 140, 141, 0, 142, 0, 143, 144, 145, 209,
+	146, 0, 147, 148, 149, 0, 150, 151, 0, 152,
+	153, 0, 154, 210, 155, 0, 156, 157, 159, 211,
+	158, 212, 0, 0, 160, 161, 0, 213, 214, 0,
+	0, 162, 215, 216, 0, 163, 164, 165, 166, 167,
+	0, 0, 168, 169, 72, 0, 170, 171, 172, 217,
+	218, 0, 173, 0, 0, 0, 0, 174, 175, 176,
+	177, 75, 76, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 78, 79, 80, 178, 179, 180, 81,
+	181, 182, 0, 82, 183, 83, 0, 0, 184, 185,
+	0, 186, 0, 0, 0, 84, 85, 86, 87, 0,
+	88, 89, 0, 90, 0, 0, 91, 92, 93, 0,
+	0, 0, 0, 0, 0, 94, 95, 96, 97, 187,
+	98, 188, 189, 0, 0, 99, 0, 0, 0, 0,
+	100, 101, 0, 0, 0, 0, 102, 190, 103, 104,
+	191, 0, 0, 105, 106, 192, 107, 0, 0, 0,
+	0, 0, 108, 193, 0, 194, 0, 109, 195, 196,
+	0, 110, 0, 111, 0, 0, 0, 112, 197, 198,
+	199, 0, 200, 0, 0, 113, 0, 114, 0, 0,
+	201, 0, 115, 0, 0, 116, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 122, 123, 0, 124, 0,
+	202, 125, 203, 126, 127, 0, 0, 314, 0, 0,
+	128, 204, 0, 129, 0, 205, 130, 131, 0, 206,
+	132, 207, 0, 133, 134, 208, 135, 136, 0, 137,
+	138, 139, 140, 141, 0, 142, 0, 143, 144, 145,
+	209, 146, 0, 147, 148, 149, 0, 150, 151, 0,
+	152, 153, 0, 154, 210, 155, 0, 156, 157, 159,
+	211, 158, 212, 0, 0, 160, 161, 0, 213, 214,
+	0, 0, 162, 215, 216, 0, 163, 164, 165, 166,
+	167, 0, 0, 168, 169, 72, 0, 170, 171, 172,
+	217, 218, 0, 173, 0, 0, 0, 0, 174, 175,
+	176, 177, 75, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 78, 79, 80, 178, 179, 180,
+	81, 181, 182, 0, 82, 183, 83, 0, 0, 184,
+	185, 0, 186, 0, 0, 0, 84, 85, 86, 87,
+	0, 88, 89, 0, 90, 0, 0, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 94, 95, 222, 97,
+	187, 98, 188, 189, 0, 0, 99, 0, 0, 0,
+	0, 100, 101, 0, 0, 0, 0, 102, 190, 103,
+	104, 191, 0, 0, 105, 106, 192, 107, 0, 0,
+	0, 0, 0, 108, 193, 0, 194, 0, 109, 195,
+	196, 0, 110, 0, 111, 0, 0, 0, 112, 197,
+	198, 199, 0, 200, 0, 0, 113, 0, 114, 0,
+	0, 201, 0, 115, 0, 0, 116, 0, 0, 0,
+	117, 118, 119, 120, 121, 0, 122, 123, 0, 124,
+	0, 202, 125, 203, 126, 127, 0, 0, 0, 0,
+	0, 128, 204, 0, 129, 0, 205, 130, 131, 0,
+	206, 132, 207, 0, 133, 134, 208, 135, 136, 0,
+	137, 138, 139, 140, 141, 0, 142, 0, 143, 144,
+	145, 209, 146, 0, 147, 148, 149, 0, 150, 151,
+	0, 152, 153, 0, 154, 210, 155, 0, 156, 157,
+	159, 211, 158, 212, 0, 221, 160, 161, 0, 213,
+	214, 0, 0, 162, 215, 216, 0, 163, 164, 165,
+	166, 167, 0, 0, 168, 169, 72, 0, 170, 171,
+	172, 217, 218, 0, 173, 0, 0, 0, 0, 174,
+	175, 176, 177, 75, 76, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 78, 79, 80, 178, 179,
+	180, 81, 181, 182, 0, 82, 183, 83, 0, 0,
+	184, 185, 0, 186, 0, 0, 0, 84, 85, 86,
+	87, 0, 88, 89, 0, 90, 0, 0, 91, 92,
+	93, 0, 0, 0, 0, 0, 0, 94, 95, 96,
+	97, 187, 98, 188, 189, 0, 0, 99, 0, 0,
+	0, 0, 100, 101, 0, 0, 0, 0, 102, 190,
+	103, 104, 191, 0, 0, 105, 106, 192, 107, 0,
+	0, 0, 0, 0, 108, 193, 0, 194, 0, 109,
+	319, 196, 0, 110, 0, 111, 0, 0, 0, 112,
+	197, 198, 199, 0, 200, 0, 0, 113, 0, 114,
+	0, 0, 201, 0, 115, 0, 0, 116, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 122, 123, 0,
+	124, 0, 202, 125, 203, 126, 127, 0, 0, 314,
+	0, 0, 128, 204, 0, 129, 0, 205, 130, 131,
+	0, 206, 132, 207, 0, 133, 134, 208, 135, 136,
+	0, 137, 138, 139, 140, 141, 0, 142, 0, 143,
+	144, 145, 209, 146, 0, 147, 148, 149, 0, 150,
+	151, 0, 152, 153, 0, 154, 210, 155, 0, 156,
+	157, 159, 211, 158, 212, 0, 0, 160, 161, 0,
+	213, 214, 0, 0, 162, 215, 216, 0, 163, 164,
+	165, 166, 167, 0, 0, 168, 169, 72, 0, 170,
+	171, 172, 217, 218, 0, 173, 0, 0, 0, 0,
+	174, 175, 176, 177, 75, 76, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 79, 80, 178,
+	179, 180, 81, 181, 182, 0, 82, 183, 83, 0,
+	0, 184, 185, 0, 186, 0, 0, 0, 84, 85,
+	86, 87, 0, 88, 89, 0, 90, 0, 0, 91,
+	92, 93, 0, 0, 0, 0, 0, 0, 94, 95,
+	96, 97, 187, 98, 188, 189, 0, 0, 99, 0,
+	0, 0, 0, 100, 101, 0, 0, 0, 0, 102,
+	190, 103, 104, 191, 0, 0, 105, 106, 192, 107,
+	0, 0, 0, 0, 0, 108, 193, 0, 194, 0,
+	109, 195, 196, 0, 110, 0, 111, 0, 0, 0,
+	112, 197, 198, 199, 0, 200, 0, 0, 113, 0,
+	114, 0, 0, 201, 0, 115, 0, 0, 116, 0,
+	0, 0, 117, 118, 119, 120, 121, 0, 122, 123,
+	0, 124, 0, 202, 125, 203, 126, 127, 0, 0,
+	0, 0, 0, 128, 204, 0, 129, 0, 205, 130,
+	131, 0, 206, 132, 207, 0, 133, 134, 208, 135,
+	136, 0, 137, 138, 139, 140, 141, 0, 142, 0,
+	143, 144, 145, 209, 146, 0, 147, 148, 149, 0,
+	150, 151, 0, 152, 153, 0, 154, 210, 155, 0,
+	156, 157, 159, 211, 158, 212, 0, 0, 160, 161,
+	0, 213, 214, 0, 0, 162, 215, 216, 0, 163,
+	164, 165, 166, 167, 0, 0, 168, 169, 72, 0,
+	170, 171, 172, 217, 218, 0, 173, 0, 0, 0,
+	0, 174, 175, 176, 177, 75, 76, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 78, 79, 80,
+	178, 179, 180, 81, 181, 182, 0, 82, 183, 83,
+	0, 0, 184, 185, 0, 186, 0, 0, 0, 84,
+	85, 86, 87, 0, 88, 89, 0, 90, 0, 0,
+	91, 92, 93, 0, 0, 0, 0, 0, 0, 94,
+	95, 96, 97, 187, 98, 188, 189, 0, 0, 99,
+	0, 0, 0, 0, 100, 101, 0, 0, 0, 0,
+	102, 190, 103, 104, 191, 0, 0, 105, 106, 192,
+	107, 0, 0, 0, 0, 0, 108, 193, 0, 194,
+	0, 109, 1117, 196, 0, 110, 0, 111, 0, 0,
+	0, 112, 197, 198, 199, 0, 200, 0, 0, 113,
+	0, 114, 0, 0, 201, 0, 115, 0, 0, 116,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 122,
+	123, 0, 124, 0, 202, 125, 203, 126, 127, 0,
+	0, 0, 0, 0, 128, 204, 0, 129, 0, 205,
+	130, 131, 0, 206, 132, 207, 0, 133, 134, 208,
+	135, 136, 0, 137, 138, 139, 140, 141, 0, 142,
+	0, 143, 144, 145, 209, 146, 0, 147, 148, 149,
+	0, 150, 151, 0, 152, 153, 0, 154, 210, 155,
+	0, 156, 157, 159, 211, 158, 212, 0, 0, 160,
+	161, 0, 213, 214, 0, 0, 162, 215, 216, 0,
+	163, 164, 165, 166, 167, 0, 0, 168, 169, 72,
+	0, 170, 171, 172, 217, 218, 0, 173, 0, 0,
+	0, 0, 174, 175, 176, 177, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 1115, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	72, 0, 170, 171, 172, 217, 218, 0, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 75, 76, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+	79, 80, 178, 179, 180, 81, 181, 182, 0, 82,
+	183, 83, 0, 0, 184, 185, 0, 186, 0, 0,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 94, 95, 96, 97, 187, 98, 188, 189, 0,
+	0, 99, 0, 0, 0, 0, 100, 101, 0, 0,
+	0, 0, 102, 190, 103, 104, 191, 0, 0, 105,
+	106, 192, 107, 0, 0, 0, 0, 0, 108, 193,
+	0, 194, 0, 109, 1106, 196, 0, 110, 0, 111,
+	0, 0, 0, 112, 197, 198, 199, 0, 200, 0,
+	0, 113, 0, 114, 0, 0, 201, 0, 115, 0,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 122, 123, 0, 124, 0, 202, 125, 203, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 0, 129,
+	0, 205, 130, 131, 0, 206, 132, 207, 0, 133,
+	134, 208, 135, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 0, 143, 144, 145, 209, 146, 0, 147,
+	148, 149, 0, 150, 151, 0, 152, 153, 0, 154,
+	210, 155, 0, 156, 157, 159, 211, 158, 212, 0,
+	0, 160, 161, 0, 213, 214, 0, 0, 162, 215,
+	216, 0, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 72, 0, 170, 171, 172, 217, 218, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 75, 76,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	78, 79, 80, 178, 179, 180, 81, 181, 182, 0,
+	82, 183, 83, 0, 0, 184, 185, 0, 186, 0,
+	0, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 0, 91, 92, 93, 0, 0, 0, 0,
+	0, 0, 94, 95, 96, 97, 187, 98, 188, 189,
+	0, 0, 99, 0, 0, 0, 0, 100, 101, 0,
+	0, 0, 0, 102, 190, 103, 104, 191, 0, 0,
+	105, 106, 192, 107, 0, 0, 0, 0, 0, 108,
+	193, 0, 194, 0, 109, 714, 196, 0, 110, 0,
+	111, 0, 0, 0, 112, 197, 198, 199, 0, 200,
+	0, 0, 113, 0, 114, 0, 0, 201, 0, 115,
+	0, 0, 116, 0, 0, 0, 117, 118, 119, 120,
+	121, 0, 122, 123, 0, 124, 0, 202, 125, 203,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 0,
+	129, 0, 205, 130, 131, 0, 206, 132, 207, 0,
+	133, 134, 208, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 0, 143, 144, 145, 209, 146, 0,
+	147, 148, 149, 0, 150, 151, 0, 152, 153, 0,
+	154, 210, 155, 0, 156, 157, 159, 211, 158, 212,
+	0, 0, 160, 161, 0, 213, 214, 0, 0, 162,
+	215, 216, 0, 163, 164, 165, 166, 167, 0, 0,
+	168, 169, 72, 0, 170, 171, 172, 217, 218, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 75,
+	76, 0, 77, 0, 0, 0, 0, 0, 646, 0,
+	0, 78, 79, 80, 178, 179, 180, 81, 181, 182,
+	0, 82, 183, 83, 0, 0, 184, 185, 0, 186,
+	0, 0, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 0, 0, 91, 92, 93, 0, 0, 0,
+	0, 0, 0, 94, 95, 96, 97, 187, 98, 188,
+	189, 0, 0, 99, 0, 0, 0, 0, 100, 101,
+	0, 0, 0, 0, 102, 190, 103, 104, 191, 0,
+	0, 105, 106, 192, 107, 0, 0, 0, 0, 0,
+	108, 193, 0, 194, 0, 109, 195, 196, 0, 110,
+	0, 111, 0, 0, 0, 112, 197, 198, 199, 0,
+	200, 0, 0, 113, 0, 114, 0, 0, 201, 0,
+	115, 0, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 122, 123, 0, 124, 0, 202, 125,
+	203, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	0, 129, 0, 205, 130, 131, 0, 206, 132, 207,
+	0, 133, 134, 208, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 0, 143, 144, 145, 209, 146,
+	0, 147, 148, 149, 0, 150, 151, 0, 0, 153,
+	0, 154, 210, 155, 0, 156, 157, 159, 211, 158,
+	212, 0, 0, 160, 161, 0, 213, 214, 0, 0,
+	162, 215, 216, 0, 163, 164, 165, 166, 167, 0,
+	0, 168, 169, 72, 0, 170, 171, 172, 217, 218,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	75, 76, 0, 77, 0, 0, 0, 0, 0, 0,
+	0, 0, 78, 79, 80, 178, 179, 180, 81, 181,
+	182, 0, 82, 183, 83, 0, 0, 184, 185, 0,
+	186, 0, 0, 0, 84, 85, 86, 87, 0, 88,
+	89, 0, 90, 0, 0, 91, 92, 93, 0, 0,
+	0, 0, 0, 0, 94, 95, 96, 97, 187, 98,
+	188, 189, 0, 0, 99, 0, 0, 0, 0, 100,
+	101, 0, 0, 0, 0, 102, 190, 103, 104, 191,
+	0, 0, 105, 106, 192, 107, 0, 0, 0, 0,
+	0, 108, 193, 0, 194, 0, 109, 409, 196, 0,
+	110, 0, 111, 0, 0, 0, 112, 197, 198, 199,
+	0, 200, 0, 0, 113, 0, 114, 0, 0, 201,
+	0, 115, 0, 0, 116, 0, 0, 0, 117, 118,
+	119, 120, 121, 0, 122, 123, 0, 124, 0, 202,
+	125, 203, 126, 127, 0, 0, 0, 0, 0, 128,
+	204, 0, 129, 0, 205, 130, 131, 0, 206, 132,
+	207, 0, 133, 134, 208, 135, 136, 0, 137, 138,
+	139, 140, 141, 0, 142, 0, 143, 144, 145, 209,
+	146, 0, 147, 148, 149, 0, 150, 151, 0, 152,
+	153, 0, 154, 210, 155, 0, 156, 157, 159, 211,
+	158, 212, 0, 0, 160, 161, 0, 213, 214, 0,
+	0, 162, 215, 216, 0, 163, 164, 165, 166, 167,
+	0, 0, 168, 169, 72, 0, 170, 171, 172, 217,
+	218, 0, 173, 0, 0, 0, 0, 174, 175, 176,
+	177, 75, 76, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 78, 79, 80, 178, 179, 180, 81,
+	181, 182, 0, 82, 183, 83, 0, 0, 184, 185,
+	0, 186, 0, 0, 0, 84, 85, 86, 87, 0,
+	88, 89, 0, 90, 0, 0, 91, 92, 93, 0,
+	0, 0, 0, 0, 0, 94, 95, 96, 97, 187,
+	98, 188, 189, 0, 0, 99, 0, 0, 0, 0,
+	100, 101, 0, 0, 0, 0, 102, 190, 103, 104,
+	191, 0, 0, 105, 106, 192, 107, 0, 0, 0,
+	0, 0, 108, 193, 0, 194, 0, 109, 405, 196,
+	0, 110, 0, 111, 0, 0, 0, 112, 197, 198,
+	199, 0, 200, 0, 0, 113, 0, 114, 0, 0,
+	201, 0, 115, 0, 0, 116, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 122, 123, 0, 124, 0,
+	202, 125, 203, 126, 127, 0, 0, 0, 0, 0,
+	128, 204, 0, 129, 0, 205, 130, 131, 0, 206,
+	132, 207, 0, 133, 134, 208, 135, 136, 0, 137,
+	138, 139, 140, 141, 0, 142, 0, 143, 144, 145,
+	209, 146, 0, 147, 148, 149, 0, 150, 151, 0,
+	152, 153, 0, 154, 210, 155, 0, 156, 157, 159,
+	211, 158, 212, 0, 0, 160, 161, 0, 213, 214,
+	0, 0, 162, 215, 216, 0, 163, 164, 165, 166,
+	167, 0, 0, 168, 169, 72, 0, 170, 171, 172,
+	217, 218, 0, 173, 0, 0, 0, 0, 174, 175,
+	176, 177, 75, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 78, 79, 80, 178, 179, 180,
+	81, 181, 182, 0, 82, 183, 83, 0, 0, 184,
+	185, 0, 186, 0, 0, 0, 84, 85, 86, 87,
+	0, 88, 89, 0, 90, 0, 0, 91, 92, 93,
+	0, 0, 0, 0, 0, 0, 94, 95, 96, 97,
+	187, 98, 188, 189, 0, 0, 99, 0, 0, 0,
+	0, 100, 101, 0, 0, 0, 0, 102, 190, 103,
+	104, 191, 0, 0, 105, 106, 192, 107, 0, 0,
+	0, 0, 0, 108, 193, 0, 194, 0, 109, 195,
+	196, 0, 110, 0, 111, 0, 0, 0, 112, 197,
+	198, 199, 0, 200, 0, 0, 113, 0, 114, 0,
+	0, 201, 0, 115, 0, 0, 116, 0, 0, 0,
+	117, 118, 119, 120, 264, 0, 122, 123, 0, 124,
+	0, 202, 125, 203, 126, 127, 0, 0, 0, 0,
+	0, 128, 204, 0, 129, 0, 205, 130, 131, 0,
+	206, 132, 207, 0, 133, 134, 208, 135, 136, 0,
+	137, 138, 139, 140, 141, 0, 142, 0, 143, 144,
+	145, 209, 146, 0, 147, 148, 149, 0, 150, 151,
+	0, 152, 153, 0, 154, 210, 155, 0, 156, 157,
+	159, 211, 158, 212, 0, 0, 160, 161, 0, 263,
+	214, 0, 0, 259, 215, 216, 0, 163, 164, 165,
+	166, 167, 0, 0, 168, 169, 72, 0, 170, 171,
+	172, 217, 218, 0, 173, 0, 0, 0, 0, 174,
+	175, 176, 177, 75, 76, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 78, 79, 80, 178, 179,
+	180, 81, 181, 182, 0, 82, 183, 83, 0, 0,
+	184, 185, 0, 186, 0, 0, 0, 84, 85, 86,
+	87, 0, 88, 89, 0, 90, 0, 0, 91, 92,
+	93, 0, 0, 0, 0, 0, 0, 94, 95, 96,
+	97, 187, 98, 188, 189, 0, 0, 99, 0, 0,
+	0, 0, 100, 101, 0, 0, 0, 0, 102, 190,
+	103, 104, 191, 0, 0, 105, 106, 192, 107, 0,
+	0, 0, 0, 0, 108, 193, 0, 194, 0, 109,
+	345, 196, 0, 110, 0, 111, 0, 0, 0, 112,
+	197, 198, 199, 0, 200, 0, 0, 113, 0, 114,
+	0, 0, 201, 0, 115, 0, 0, 116, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 122, 123, 0,
+	124, 0, 202, 125, 203, 126, 127, 0, 0, 0,
+	0, 0, 128, 204, 0, 129, 0, 205, 130, 131,
+	0, 206, 132, 207, 0, 133, 134, 208, 135, 136,
+	0, 137, 138, 139, 140, 141, 0, 142, 0, 143,
+	144, 145, 209, 146, 0, 147, 148, 149, 0, 150,
+	151, 0, 152, 153, 0, 154, 210, 155, 0, 156,
+	157, 159, 211, 158, 212, 0, 0, 160, 161, 0,
+	213, 214, 0, 0, 162, 215, 216, 0, 163, 164,
+	165, 166, 167, 0, 0, 168, 169, 72, 0, 170,
+	171, 172, 217, 218, 0, 173, 0, 0, 0, 0,
+	174, 175, 176, 177, 75, 76, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 79, 80, 178,
+	179, 180, 81, 181, 182, 0, 82, 183, 83, 0,
+	0, 184, 185, 0, 186, 0, 0, 0, 84, 85,
+	86, 87, 0, 88, 89, 0, 90, 0, 0, 91,
+	92, 93, 0, 0, 0, 0, 0, 0, 94, 95,
+	96, 97, 187, 98, 188, 189, 0, 0, 99, 0,
+	0, 0, 0, 100, 101, 0, 0, 0, 0, 102,
+	190, 103, 104, 191, 0, 0, 105, 106, 192, 107,
+	0, 0, 0, 0, 0, 108, 193, 0, 194, 0,
+	109, 343, 196, 0, 110, 0, 111, 0, 0, 0,
+	112, 197, 198, 199, 0, 200, 0, 0, 113, 0,
+	114, 0, 0, 201, 0, 115, 0, 0, 116, 0,
+	0, 0, 117, 118, 119, 120, 121, 0, 122, 123,
+	0, 124, 0, 202, 125, 203, 126, 127, 0, 0,
+	0, 0, 0, 128, 204, 0, 129, 0, 205, 130,
+	131, 0, 206, 132, 207, 0, 133, 134, 208, 135,
+	136, 0, 137, 138, 139, 140, 141, 0, 142, 0,
+	143, 144, 145, 209, 146, 0, 147, 148, 149, 0,
+	150, 151, 0, 152, 153, 0, 154, 210, 155, 0,
+	156, 157, 159, 211, 158, 212, 0, 0, 160, 161,
+	0, 213, 214, 0, 0, 162, 215, 216, 0, 163,
+	164, 165, 166, 167, 0, 0, 168, 169, 72, 0,
+	170, 171, 172, 217, 218, 0, 173, 0, 0, 0,
+	0, 174, 175, 176, 177, 75, 76, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 78, 79, 80,
+	178, 179, 180, 81, 181, 182, 0, 82, 183, 83,
+	0, 0, 184, 185, 0, 186, 0, 0, 0, 84,
+	85, 86, 87, 0, 88, 89, 0, 90, 0, 0,
+	91, 92, 93, 0, 0, 0, 0, 0, 0, 94,
+	95, 96, 97, 187, 98, 188, 189, 0, 0, 99,
+	0, 0, 0, 0, 100, 101, 0, 0, 0, 0,
+	102, 190, 103, 104, 191, 0, 0, 105, 106, 192,
+	107, 0, 0, 0, 0, 0, 108, 193, 0, 194,
+	0, 109, 340, 196, 0, 110, 0, 111, 0, 0,
+	0, 112, 197, 198, 199, 0, 200, 0, 0, 113,
+	0, 114, 0, 0, 201, 0, 115, 0, 0, 116,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 122,
+	123, 0, 124, 0, 202, 125, 203, 126, 127, 0,
+	0, 0, 0, 0, 128, 204, 0, 129, 0, 205,
+	130, 131, 0, 206, 132, 207, 0, 133, 134, 208,
+	135, 136, 0, 137, 138, 139, 140, 141, 0, 142,
+	0, 143, 144, 145, 209, 146, 0, 147, 148, 149,
+	0, 150, 151, 0, 152, 153, 0, 154, 210, 155,
+	0, 156, 157, 159, 211, 158, 212, 0, 0, 160,
+	161, 0, 213, 214, 0, 0, 162, 215, 216, 0,
+	163, 164, 165, 166, 167, 0, 0, 168, 169, 72,
+	0, 170, 171, 172, 217, 218, 0, 173, 0, 0,
+	0, 0, 174, 175, 176, 177, 75, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 0, 78, 79,
+	80, 178, 179, 180, 81, 181, 182, 0, 82, 183,
+	83, 0, 0, 184, 185, 0, 186, 0, 0, 0,
+	84, 85, 86, 87, 0, 88, 89, 0, 90, 0,
+	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
+	94, 95, 96, 97, 187, 98, 188, 189, 0, 0,
+	99, 0, 0, 0, 0, 100, 101, 0, 0, 0,
+	0, 102, 190, 103, 104, 191, 0, 0, 105, 106,
+	192, 107, 0, 0, 0, 0, 0, 108, 193, 0,
+	194, 0, 109, 322, 196, 0, 110, 0, 111, 0,
+	0, 0, 112, 197, 198, 199, 0, 200, 0, 0,
+	113, 0, 114, 0, 0, 201, 0, 115, 0, 0,
+	116, 0, 0, 0, 117, 118, 119, 120, 121, 0,
+	122, 123, 0, 124, 0, 202, 125, 203, 126, 127,
+	0, 0, 0, 0, 0, 128, 204, 0, 129, 0,
+	205, 130, 131, 0, 206, 132, 207, 0, 133, 134,
+	208, 135, 136, 0, 137, 138, 139, 140, 141, 0,
+	142, 0, 143, 144, 145, 209, 146, 0, 147, 148,
+	149, 0, 150, 151, 0, 152, 153, 0, 154, 210,
+	155, 0, 156, 157, 159, 211, 158, 212, 0, 0,
+	160, 161, 0, 213, 214, 0, 0, 162, 215, 216,
+	0, 163, 164, 165, 166, 167, 0, 0, 168, 169,
+	72, 0, 170, 171, 172, 217, 218, 0, 173, 0,
+	0, 0, 0, 174, 175, 176, 177, 75, 76, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+	79, 80, 178, 179, 180, 81, 181, 182, 0, 82,
+	183, 83, 0, 0, 184, 185, 0, 186, 0, 0,
+	0, 84, 85, 86, 87, 0, 88, 89, 0, 90,
+	0, 0, 91, 92, 93, 0, 0, 0, 0, 0,
+	0, 94, 95, 96, 97, 187, 98, 188, 189, 0,
+	0, 99, 0, 0, 0, 0, 100, 101, 0, 0,
+	0, 0, 102, 190, 103, 104, 191, 0, 0, 105,
+	106, 192, 107, 0, 0, 0, 0, 0, 108, 193,
+	0, 194, 0, 109, 195, 196, 0, 110, 0, 111,
+	0, 0, 0, 112, 197, 198, 199, 0, 200, 0,
+	0, 113, 0, 114, 0, 0, 201, 0, 115, 0,
+	0, 116, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 122, 123, 0, 124, 0, 202, 125, 203, 126,
+	127, 0, 0, 0, 0, 0, 128, 204, 0, 129,
+	0, 205, 130, 131, 0, 206, 132, 207, 0, 133,
+	134, 208, 303, 136, 0, 137, 138, 139, 140, 141,
+	0, 142, 0, 143, 144, 145, 209, 146, 0, 147,
+	148, 149, 0, 150, 151, 0, 152, 153, 0, 154,
+	210, 155, 0, 156, 157, 159, 211, 158, 212, 0,
+	0, 160, 161, 0, 213, 214, 0, 0, 162, 215,
+	216, 0, 163, 164, 165, 166, 167, 0, 0, 168,
+	169, 72, 0, 170, 171, 172, 217, 218, 0, 173,
+	0, 0, 0, 0, 174, 175, 176, 177, 75, 76,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	78, 79, 80, 178, 179, 180, 81, 181, 182, 0,
+	82, 183, 83, 0, 0, 184, 185, 0, 186, 0,
+	0, 0, 84, 85, 86, 87, 0, 88, 89, 0,
+	90, 0, 0, 91, 92, 93, 0, 0, 0, 0,
+	0, 0, 94, 95, 96, 97, 187, 98, 188, 189,
+	0, 0, 99, 0, 0, 0, 0, 100, 101, 0,
+	0, 0, 0, 102, 190, 103, 104, 191, 0, 0,
+	105, 106, 192, 107, 0, 0, 0, 0, 0, 108,
+	193, 0, 194, 0, 109, 195, 196, 0, 110, 0,
+	111, 0, 0, 0, 112, 197, 198, 199, 0, 200,
+	0, 0, 113, 0, 114, 0, 0, 201, 0, 115,
+	0, 0, 257, 0, 0, 0, 117, 118, 119, 120,
+	264, 0, 122, 123, 0, 124, 0, 202, 125, 203,
+	126, 127, 0, 0, 0, 0, 0, 128, 204, 0,
+	129, 0, 205, 130, 131, 0, 206, 132, 207, 0,
+	133, 134, 208, 135, 136, 0, 137, 138, 139, 140,
+	141, 0, 142, 0, 143, 144, 145, 209, 146, 0,
+	147, 148, 149, 0, 150, 258, 0, 152, 153, 0,
+	154, 210, 155, 0, 156, 157, 159, 211, 158, 212,
+	0, 0, 160, 161, 0, 263, 214, 0, 0, 259,
+	215, 216, 0, 163, 164, 165, 166, 167, 0, 0,
+	168, 169, 72, 0, 170, 171, 172, 217, 218, 0,
+	173, 0, 0, 0, 0, 174, 175, 176, 177, 75,
+	76, 0, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 78, 79, 80, 178, 179, 180, 81, 181, 182,
+	0, 82, 183, 83, 0, 0, 184, 185, 0, 186,
+	0, 0, 0, 84, 85, 86, 87, 0, 88, 89,
+	0, 90, 0, 0, 91, 92, 93, 0, 0, 0,
+	0, 0, 0, 94, 95, 96, 97, 187, 98, 188,
+	189, 0, 0, 99, 0, 0, 0, 0, 100, 101,
+	0, 0, 0, 0, 102, 190, 103, 104, 191, 0,
+	0, 105, 106, 192, 107, 0, 0, 0, 0, 0,
+	108, 193, 0, 194, 0, 109, 195, 196, 0, 110,
+	0, 111, 0, 0, 0, 112, 197, 198, 199, 0,
+	200, 0, 0, 113, 0, 114, 0, 0, 201, 0,
+	115, 0, 0, 116, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 122, 123, 0, 124, 0, 202, 125,
+	203, 126, 127, 0, 0, 0, 0, 0, 128, 204,
+	0, 129, 0, 205, 130, 0, 0, 206, 132, 207,
+	0, 0, 134, 208, 135, 136, 0, 137, 138, 139,
+	140, 141, 0, 142, 0, 143, 144, 145, 209, 0,
+	0, 147, 148, 149, 0, 150, 151, 0, 152, 153,
+	0, 154, 210, 155, 0, 156, 157, 159, 211, 158,
+	212, 0, 0, 160, 161, 0, 213, 214, 0, 0,
+	162, 215, 216, 0, 163, 164, 165, 166, 167, 0,
+	0, 168, 169, 0, 0, 170, 171, 172, 217, 218,
+	0, 173, 0, 0, 0, 0, 174, 175, 176, 177,
+	737, 0, 755, 756, 757, 759, 760, 761, 762, 763,
+	0, 0, 0, 0, 0, 0, 0, 764, 0, 0,
+	0, 0, 0, 739, 0, 0, 771, 737, 0, 755,
+	756, 757, 759, 760, 761, 762, 763, 0, 0, 0,
+	0, 0, 738, 0, 764, 0, 0, 0, 0, 752,
+	739, 0, 0, 771, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 738,
+	0, 0, 0, 0, 0, 0, 752, 1250, 0, 1266,
+	1267, 1268, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 264, 991, 992, 0, 0, 0, 746, 0,
-	0, 997, 0, 0, 0, 0, 0, 1002, 1003, 1005,
-	1007, 1008, 0, 1011, 1012, 0, 0, 0, 0, 1145,
-	264, 0, 1019, 0, 674, 0, 0, 0, 264, 0,
-	0, 0, 0, 1140, 0, 672, 0, 799, 0, 0,
-	799, 0, 0, 0, 669, 0, 0, 836, 858, 662,
-	957, 857, 0, 0, 0, 220, 0, 0, 221, 0,
-	19, 623, 222, 85, 264, 0, 1040, 833, 0, 668,
-	32, 0, 0, 0, 0, 1047, 0, 0, 0, 0,
-	1062, 1062, 0, 264, 1141, 646, 0, 0, 0, 0,
-	236, 33, 0, 0, 0, 858, 0, 36, 857, 0,
-	663, 0, 0, 648, 1126, 673, 1142, 1143, 1144, 671,
-	0, 0, 0, 0, 833, 0, 858, 0, 0, 857,
-	0, 647, 24, 957, 0, 0, 0, 661, 25, 0,
-	0, 0, 0, 0, 0, 833, 0, 0, 0, 0,
-	26, 0, 0, 1136, 1137, 1138, 1139, 1135, 1132, 1133,
-	1134, 1127, 1128, 1129, 1130, 1131, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 986, 0, 0, 0, 0,
-	0, 0, 987, 674, 0, 0, 0, 858, 0, 0,
-	857, 236, 0, 0, 236, 236, 0, 0, 0, 0,
-	0, 0, 1146, 669, 0, 0, 833, 0, 662, 0,
-	0, 0, 0, 1145, 0, 0, 0, 0, 683, 27,
-	0, 34, 687, 0, 0, 0, 0, 1140, 43, 0,
-	0, 0, 30, 31, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 45, 0, 0, 0, 0,
-	0, 0, 0, 642, 0, 0, 0, 35, 0, 663,
-	0, 646, 0, 664, 665, 666, 0, 0, 671, 0,
-	46, 0, 0, 667, 0, 264, 0, 41, 1141, 648,
-	0, 673, 0, 42, 0, 0, 1205, 0, 746, 0,
-	623, 0, 0, 1211, 0, 0, 0, 647, 0, 0,
-	0, 40, 0, 661, 264, 0, 0, 264, 0, 0,
-	0, 0, 0, 0, 0, 1226, 670, 0, 1062, 0,
-	29, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 29, 0, 0, 0, 1136, 1137, 1138,
-	0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1268,
-	672, 0, 0, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 0,
+	768, 0, 772, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 770, 0, 1263, 0, 0, 0,
+	0, 0, 0, 766, 0, 0, 0, 768, 753, 772,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	667, 1319, 1320, 746, 0, 663, 648, 0, 673, 642,
-	642, 646, 0, 0, 671, 1344, 0, 1345, 0, 264,
-	1347, 1348, 1349, 0, 647, 0, 0, 0, 0, 648,
-	661, 642, 0, 746, 1360, 0, 0, 0, 0, 0,
-	0, 264, 264, 0, 0, 264, 0, 647, 0, 0,
-	0, 642, 1062, 661, 0, 0, 0, 0, 0, 827,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 0, 0, 1126, 1415, 674, 0, 0, 904,
-	0, 0, 0, 1403, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 0, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 1139, 0, 0, 0,
-	0, 668, 0, 0, 662, 0, 648, 0, 673, 0,
-	0, 0, 0, 0, 0, 0, 746, 0, 1421, 0,
-	85, 0, 0, 0, 647, 0, 0, 264, 0, 0,
-	661, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 1360, 0, 0, 0, 0,
-	642, 244, 0, 0, 0, 663, 0, 0, 0, 0,
-	264, 0, 1462, 0, 0, 0, 0, 0, 0, 0,
-	264, 0, 642, 0, 0, 0, 0, 1140, 0, 0,
-	0, 0, 0, 0, 0, 0, 674, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 29, 669, 0, 0, 0,
-	0, 662, 1166, 0, 1064, 0, 0, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 1141, 0,
-	0, 0, 0, 0, 1494, 1495, 0, 0, 1499, 0,
-	0, 0, 0, 0, 0, 0, 1360, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 0, 0, 642, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 0, 904, 0, 0, 0,
-	0, 0, 0, 642, 642, 264, 0, 85, 0, 0,
-	683, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 1360, 1462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 264, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 0, 0, 0, 0, 0,
-	396, 384, 385, 386, 383, 372, 683, 0, 0, 0,
-	0, 0, 89, 90, 922, 91, 0, 0, 0, 0,
-	378, 0, 0, 0, 92, 93, 174, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 103, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 923, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 827, 116, 422, 827, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 162, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 921, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 0,
-	0, 0, 0, 0, 0, 29, 0, 364, 365, 924,
-	0, 0, 0, 366, 0, 0, 373, 919, 0, 0,
-	0, 0, 827, 827, 0, 0, 827, 0, 0, 0,
+	0, 770, 0, 0, 0, 0, 0, 0, 765, 0,
+	766, 0, 0, 0, 0, 753, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 765, 0, 0, 0, 1270,
+	0, 737, 754, 755, 756, 757, 759, 760, 761, 762,
+	763, 1269, 769, 0, 0, 0, 0, 0, 764, 0,
+	0, 0, 0, 0, 739, 1264, 0, 771, 0, 754,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 769,
+	0, 0, 0, 738, 0, 0, 0, 0, 0, 0,
+	752, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 767, 0, 749, 750, 751, 0, 758, 748,
+	745, 746, 747, 740, 741, 742, 743, 744, 1250, 1265,
+	1266, 1267, 1268, 0, 0, 1552, 0, 0, 0, 767,
+	0, 749, 750, 751, 0, 758, 748, 745, 746, 747,
+	740, 741, 742, 743, 744, 0, 0, 0, 0, 0,
+	0, 768, 1290, 772, 737, 0, 755, 756, 757, 759,
+	760, 761, 762, 763, 0, 770, 0, 1263, 0, 0,
+	0, 764, 0, 0, 766, 0, 0, 739, 0, 753,
+	771, 1260, 1261, 1262, 0, 0, 1259, 1256, 1257, 1258,
+	1251, 1252, 1253, 1254, 1255, 0, 738, 0, 0, 765,
+	0, 0, 0, 752, 0, 737, 0, 755, 756, 757,
+	759, 760, 761, 762, 763, 0, 0, 0, 0, 0,
+	0, 0, 764, 0, 0, 0, 0, 0, 739, 0,
+	0, 771, 0, 754, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 769, 0, 0, 0, 738, 0, 0,
+	0, 0, 0, 0, 752, 0, 1264, 0, 0, 0,
+	0, 0, 0, 0, 768, 0, 772, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 770, 0,
+	0, 0, 0, 0, 0, 0, 0, 766, 0, 0,
+	0, 0, 753, 767, 0, 749, 750, 751, 0, 758,
+	748, 745, 746, 747, 740, 741, 742, 743, 744, 0,
+	1265, 0, 765, 0, 0, 768, 1289, 772, 737, 0,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 770,
+	0, 0, 0, 0, 0, 764, 0, 0, 766, 0,
+	0, 739, 0, 753, 771, 0, 754, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 769, 0, 0, 0,
+	738, 0, 0, 765, 0, 0, 0, 752, 0, 0,
+	0, 0, 1260, 1261, 1262, 0, 0, 1259, 1256, 1257,
+	1258, 1251, 1252, 1253, 1254, 1255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 754, 0, 0,
+	0, 0, 0, 0, 0, 0, 767, 769, 749, 750,
+	751, 0, 758, 748, 745, 746, 747, 740, 741, 742,
+	743, 744, 0, 0, 0, 0, 0, 0, 768, 1288,
+	772, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 770, 0, 0, 0, 0, 0, 0, 0,
+	0, 766, 0, 0, 0, 0, 753, 767, 0, 749,
+	750, 751, 0, 758, 748, 745, 746, 747, 740, 741,
+	742, 743, 744, 0, 0, 0, 765, 1665, 0, 737,
+	0, 755, 756, 757, 759, 760, 761, 762, 763, 0,
+	0, 0, 0, 0, 0, 0, 764, 0, 0, 0,
+	0, 0, 739, 0, 0, 771, 0, 0, 0, 737,
+	754, 755, 756, 757, 759, 760, 761, 762, 763, 0,
+	769, 738, 0, 0, 0, 0, 764, 0, 752, 0,
+	0, 0, 739, 0, 0, 771, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 738, 0, 0, 0, 0, 0, 0, 752, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 0, 0, 0, 768,
+	1651, 772, 737, 0, 755, 756, 757, 759, 760, 761,
+	762, 763, 0, 770, 0, 0, 0, 0, 0, 764,
+	0, 0, 766, 0, 0, 739, 0, 753, 771, 768,
+	0, 772, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 770, 738, 0, 0, 765, 0, 0,
+	0, 752, 766, 0, 0, 0, 0, 753, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 765, 0, 0,
+	0, 754, 0, 0, 0, 0, 0, 0, 0, 0,
+	737, 769, 755, 756, 757, 759, 760, 761, 762, 763,
+	0, 0, 0, 0, 0, 0, 0, 764, 0, 0,
+	0, 754, 768, 739, 772, 0, 771, 0, 0, 0,
+	0, 769, 0, 0, 0, 0, 770, 0, 0, 0,
+	0, 0, 738, 0, 0, 766, 0, 0, 0, 752,
+	753, 767, 0, 749, 750, 751, 0, 758, 748, 745,
+	746, 747, 740, 741, 742, 743, 744, 0, 0, 0,
+	765, 1625, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 767, 0, 749, 750, 751, 0, 758, 748, 745,
+	746, 747, 740, 741, 742, 743, 744, 0, 0, 0,
+	0, 1620, 0, 0, 754, 0, 0, 0, 0, 0,
+	768, 0, 772, 737, 769, 755, 756, 757, 759, 760,
+	761, 762, 763, 0, 770, 0, 0, 0, 0, 0,
+	764, 0, 0, 766, 0, 0, 739, 0, 753, 771,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 738, 0, 0, 765, 0,
+	0, 0, 752, 0, 767, 0, 749, 750, 751, 0,
+	758, 748, 745, 746, 747, 740, 741, 742, 743, 744,
+	0, 0, 0, 0, 1615, 0, 0, 0, 0, 0,
+	0, 0, 754, 0, 0, 0, 0, 0, 0, 0,
+	0, 737, 769, 755, 756, 757, 759, 760, 761, 762,
+	763, 0, 0, 0, 0, 0, 0, 0, 764, 0,
+	0, 0, 0, 768, 739, 772, 0, 771, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 770, 0, 0,
+	0, 0, 0, 738, 0, 0, 766, 0, 0, 0,
+	752, 753, 767, 0, 749, 750, 751, 0, 758, 748,
+	745, 746, 747, 740, 741, 742, 743, 744, 0, 0,
+	0, 765, 1554, 0, 0, 0, 0, 737, 0, 755,
+	756, 757, 759, 760, 761, 762, 763, 0, 0, 0,
+	0, 0, 0, 0, 764, 0, 0, 0, 0, 0,
+	739, 0, 0, 771, 0, 754, 0, 0, 0, 0,
+	0, 768, 0, 772, 0, 769, 0, 0, 0, 738,
+	0, 0, 0, 0, 0, 770, 752, 0, 0, 0,
+	0, 0, 0, 0, 766, 0, 0, 0, 0, 753,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 765,
+	0, 0, 0, 0, 0, 767, 0, 749, 750, 751,
+	0, 758, 748, 745, 746, 747, 740, 741, 742, 743,
+	744, 0, 0, 0, 0, 1553, 0, 768, 0, 772,
+	0, 0, 0, 754, 0, 0, 0, 0, 0, 0,
+	0, 770, 0, 769, 0, 0, 0, 0, 0, 0,
+	766, 0, 0, 0, 0, 753, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 765, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 767, 0, 749, 750, 751, 0, 758,
+	748, 745, 746, 747, 740, 741, 742, 743, 744, 754,
+	0, 0, 0, 1466, 0, 0, 0, 0, 737, 769,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 0,
+	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
+	0, 739, 0, 0, 771, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	738, 0, 0, 0, 0, 0, 0, 752, 0, 767,
+	0, 749, 750, 751, 0, 758, 748, 745, 746, 747,
+	740, 741, 742, 743, 744, 0, 0, 0, 0, 1403,
+	0, 0, 0, 0, 737, 0, 755, 756, 757, 759,
+	760, 761, 762, 763, 0, 0, 0, 0, 0, 0,
+	0, 764, 0, 0, 0, 0, 0, 739, 0, 0,
+	771, 0, 0, 0, 0, 0, 0, 0, 768, 0,
+	772, 0, 0, 0, 0, 0, 738, 0, 0, 0,
+	0, 0, 770, 752, 0, 0, 0, 0, 0, 0,
+	0, 766, 0, 0, 0, 0, 753, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 765, 737, 0, 755,
+	756, 757, 759, 760, 761, 762, 763, 0, 0, 0,
+	0, 0, 0, 0, 764, 0, 0, 0, 0, 0,
+	739, 0, 0, 771, 768, 0, 772, 0, 0, 0,
+	754, 0, 0, 0, 0, 0, 0, 0, 770, 738,
+	769, 0, 0, 0, 0, 0, 752, 766, 0, 0,
+	0, 0, 753, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 765, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1446,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 754, 768, 0, 772,
+	1379, 0, 0, 0, 0, 0, 769, 0, 0, 0,
+	0, 770, 0, 0, 0, 0, 0, 0, 0, 0,
+	766, 0, 0, 0, 0, 753, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 527, 0, 0, 0, 0, 0, 0, 0,
-	0, 827, 0, 0, 89, 90, 532, 91, 533, 534,
-	535, 536, 537, 538, 539, 540, 92, 93, 174, 175,
-	176, 94, 177, 178, 541, 95, 179, 96, 542, 543,
-	180, 181, 544, 182, 545, 293, 546, 97, 98, 99,
-	0, 100, 547, 101, 548, 294, 102, 103, 549, 550,
-	551, 552, 553, 554, 104, 105, 106, 107, 183, 108,
-	184, 185, 555, 556, 109, 557, 558, 559, 110, 111,
-	560, 561, 683, 562, 186, 112, 187, 563, 564, 113,
-	114, 188, 115, 565, 566, 567, 295, 568, 116, 189,
-	569, 190, 570, 117, 191, 192, 571, 572, 573, 296,
-	118, 193, 194, 195, 574, 196, 575, 297, 119, 298,
-	120, 576, 577, 197, 299, 121, 300, 578, 250, 579,
-	580, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	581, 128, 582, 198, 129, 199, 130, 131, 583, 584,
-	585, 586, 587, 132, 200, 302, 133, 303, 201, 134,
-	135, 588, 202, 136, 203, 589, 137, 138, 204, 139,
-	140, 590, 141, 142, 143, 591, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 592, 150, 252, 593, 151,
-	152, 305, 153, 206, 154, 594, 155, 157, 207, 156,
-	208, 595, 596, 158, 159, 597, 254, 209, 598, 599,
-	253, 210, 211, 600, 160, 161, 162, 163, 601, 602,
-	164, 165, 603, 604, 166, 167, 168, 212, 213, 605,
-	169, 606, 607, 608, 609, 170, 171, 172, 173, 0,
-	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 732, 89, 90, 532, 91, 533, 534, 535, 536,
-	537, 538, 539, 540, 92, 93, 174, 175, 176, 94,
-	177, 178, 541, 95, 179, 96, 542, 543, 180, 181,
-	544, 182, 545, 293, 546, 97, 98, 99, 0, 100,
-	547, 101, 548, 294, 102, 103, 549, 550, 551, 552,
-	553, 554, 104, 105, 106, 107, 183, 108, 184, 185,
-	555, 556, 109, 557, 558, 559, 110, 111, 560, 561,
-	0, 562, 186, 112, 187, 563, 564, 113, 114, 188,
-	115, 565, 566, 567, 295, 568, 116, 189, 569, 190,
-	570, 117, 191, 192, 571, 572, 573, 296, 118, 193,
-	194, 195, 574, 196, 575, 297, 119, 298, 120, 576,
-	577, 197, 299, 121, 300, 578, 250, 579, 580, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 581, 128,
-	582, 198, 129, 199, 130, 131, 583, 584, 585, 586,
-	587, 132, 200, 302, 133, 303, 201, 134, 135, 588,
-	202, 136, 203, 589, 137, 138, 204, 139, 140, 590,
-	141, 142, 143, 591, 144, 304, 145, 146, 205, 147,
-	0, 148, 149, 592, 150, 252, 593, 151, 152, 305,
-	153, 206, 154, 594, 155, 157, 207, 156, 208, 595,
-	596, 158, 159, 597, 254, 209, 598, 599, 253, 210,
-	211, 600, 160, 161, 162, 163, 601, 602, 164, 165,
-	603, 604, 166, 167, 168, 212, 213, 605, 169, 606,
-	607, 608, 609, 170, 171, 172, 173, 396, 384, 385,
-	386, 383, 372, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	43, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 45, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 292, 442, 0, 169, 0, 0, 0, 41,
-	170, 171, 172, 173, 368, 42, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 0,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 43,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 45, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 292, 442, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 368, 42, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 967, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 972, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 968, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 969, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 396, 384, 385, 386, 383, 372,
-	0, 0, 364, 365, 0, 0, 89, 90, 366, 91,
-	0, 373, 0, 0, 378, 0, 0, 0, 92, 93,
-	174, 425, 426, 94, 427, 428, 0, 95, 179, 96,
-	393, 411, 429, 430, 0, 421, 0, 404, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 294, 102, 103,
-	0, 405, 407, 0, 406, 408, 104, 105, 106, 107,
-	431, 108, 432, 433, 0, 0, 109, 0, 0, 0,
-	424, 111, 0, 0, 0, 0, 377, 112, 412, 391,
-	0, 113, 114, 434, 115, 0, 0, 0, 295, 0,
-	116, 422, 0, 190, 0, 117, 418, 420, 0, 0,
-	0, 296, 118, 435, 436, 437, 0, 403, 0, 297,
-	119, 298, 120, 0, 0, 423, 299, 121, 300, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 301,
-	126, 127, 367, 128, 392, 419, 129, 438, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 302, 133, 303,
-	413, 134, 135, 0, 414, 136, 203, 0, 137, 138,
-	439, 139, 140, 0, 141, 142, 143, 0, 144, 304,
-	145, 146, 381, 147, 0, 148, 149, 0, 150, 252,
-	409, 151, 152, 305, 153, 440, 154, 0, 155, 157,
-	207, 156, 415, 0, 0, 158, 159, 0, 254, 441,
-	0, 0, 253, 416, 417, 390, 160, 161, 162, 163,
-	0, 0, 164, 165, 410, 0, 166, 167, 168, 212,
-	442, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 368, 0, 396, 384, 385, 386, 383, 372, 0,
-	0, 364, 365, 0, 0, 89, 90, 366, 91, 0,
-	373, 1302, 0, 378, 0, 0, 0, 92, 93, 174,
-	425, 426, 94, 427, 428, 0, 95, 179, 96, 393,
-	411, 429, 430, 0, 421, 0, 404, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 294, 102, 103, 0,
-	405, 407, 0, 406, 408, 104, 105, 106, 107, 431,
-	108, 432, 433, 0, 0, 109, 0, 0, 0, 424,
-	111, 0, 0, 0, 0, 377, 112, 412, 391, 0,
-	113, 114, 434, 115, 0, 0, 0, 295, 0, 116,
-	422, 0, 190, 0, 117, 418, 420, 0, 0, 0,
-	296, 118, 435, 436, 437, 0, 403, 0, 297, 119,
-	298, 120, 0, 0, 423, 299, 121, 300, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 301, 126,
-	127, 367, 128, 392, 419, 129, 438, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 302, 133, 303, 413,
-	134, 135, 0, 414, 136, 203, 0, 137, 138, 439,
-	139, 140, 0, 141, 142, 143, 0, 144, 304, 145,
-	146, 381, 147, 0, 148, 149, 0, 150, 252, 409,
-	151, 152, 305, 153, 440, 154, 0, 155, 157, 207,
-	156, 415, 0, 0, 158, 159, 0, 254, 441, 0,
-	0, 253, 416, 417, 390, 160, 161, 162, 163, 0,
-	0, 164, 165, 410, 0, 166, 167, 168, 212, 442,
-	0, 169, 0, 0, 0, 0, 170, 171, 172, 173,
-	368, 0, 396, 384, 385, 386, 383, 372, 0, 0,
-	364, 365, 0, 0, 89, 90, 366, 91, 0, 373,
-	1245, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 918,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 103, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 162, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 364, 365,
-	0, 0, 0, 0, 366, 689, 914, 373, 396, 384,
-	385, 386, 383, 372, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 367, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 381, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 1251, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 368, 0, 396, 384, 385,
-	386, 383, 372, 0, 0, 364, 365, 0, 0, 89,
-	90, 366, 91, 0, 373, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	0, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 0, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 212, 442, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 368, 0, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 972,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 0,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 0, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 212, 442, 0, 169, 0, 0, 0, 0, 170,
-	171, 172, 173, 368, 0, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 0, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 362, 0, 0, 0, 366, 0,
-	0, 373, 396, 384, 385, 386, 383, 372, 0, 0,
-	0, 0, 0, 0, 89, 90, 630, 91, 0, 0,
-	0, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 0,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 1564, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 1563, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	396, 384, 385, 386, 383, 372, 0, 0, 364, 365,
-	0, 0, 89, 90, 366, 91, 0, 373, 0, 0,
-	378, 0, 0, 0, 92, 93, 1562, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 1564, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 0, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 0, 116, 422, 0, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 1563, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 396,
-	384, 385, 386, 383, 372, 0, 0, 364, 365, 0,
-	0, 89, 90, 366, 91, 0, 373, 0, 0, 378,
-	0, 0, 0, 92, 93, 174, 425, 426, 94, 427,
-	428, 0, 95, 179, 96, 393, 411, 429, 430, 0,
-	421, 0, 404, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 405, 407, 0, 406,
-	408, 104, 105, 106, 107, 431, 108, 432, 433, 0,
-	0, 109, 0, 0, 0, 424, 111, 0, 0, 0,
-	0, 377, 112, 412, 391, 0, 113, 114, 434, 115,
-	0, 0, 0, 295, 0, 116, 422, 0, 190, 0,
-	117, 418, 420, 0, 0, 0, 296, 118, 435, 436,
-	437, 0, 403, 0, 297, 119, 298, 120, 0, 0,
-	423, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 367, 128, 392,
-	419, 129, 438, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 413, 134, 135, 0, 414,
-	136, 203, 0, 137, 138, 439, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 381, 147, 0,
-	148, 149, 0, 150, 252, 409, 151, 152, 305, 153,
-	440, 154, 0, 155, 157, 207, 156, 415, 0, 0,
-	158, 159, 0, 254, 441, 0, 0, 253, 416, 417,
-	390, 160, 161, 162, 163, 0, 0, 164, 165, 410,
-	0, 166, 167, 168, 212, 442, 0, 169, 0, 0,
-	0, 0, 170, 171, 172, 173, 368, 0, 396, 384,
-	385, 386, 383, 372, 0, 0, 364, 365, 0, 0,
-	89, 90, 366, 91, 0, 373, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 962, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 396, 384, 385, 386, 383,
-	372, 0, 0, 0, 0, 958, 959, 89, 90, 0,
-	91, 960, 0, 0, 961, 378, 0, 0, 0, 92,
-	93, 0, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	1564, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	0, 119, 298, 120, 0, 0, 423, 299, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 0, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 1563,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 396, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 89, 90, 0, 91, 366, 0,
-	0, 373, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 411,
-	180, 181, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 412, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 419, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 416, 417, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 213, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 288,
+	0, 0, 0, 0, 0, 765, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 767, 0, 749, 750,
+	751, 0, 758, 748, 745, 746, 747, 740, 741, 742,
+	743, 744, 0, 0, 0, 0, 1022, 0, 737, 754,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 769,
+	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
+	0, 739, 0, 0, 771, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 1362, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 293, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 295, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 296, 118, 193, 194,
-	195, 0, 196, 0, 297, 119, 298, 120, 0, 0,
-	197, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 305, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 0, 42, 288, 507,
-	511, 0, 512, 502, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 40, 0, 0, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 515, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 504, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 505, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 503,
-	160, 161, 162, 163, 0, 0, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 288, 507, 511, 0, 512,
-	502, 0, 0, 0, 0, 513, 508, 89, 90, 0,
-	91, 0, 0, 0, 0, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 293, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 498, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	504, 0, 113, 114, 188, 115, 0, 0, 0, 295,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 296, 118, 193, 194, 195, 0, 196, 0,
-	297, 119, 298, 120, 0, 0, 197, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 505, 0, 0, 0, 132, 200, 302, 133,
-	303, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 305, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 503, 160, 161, 162,
-	163, 0, 0, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 288, 507, 511, 0, 512, 502, 0, 0,
-	0, 0, 513, 508, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 293, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 504, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 197, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 505,
-	0, 0, 0, 132, 200, 302, 133, 303, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 503, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 513,
-	508, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 263, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 86, 42, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 89, 90,
-	0, 91, 0, 0, 0, 829, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 43,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 45, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 0, 164, 165, 0, 0, 166, 167,
-	168, 292, 213, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 86, 42, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 40, 0, 1061, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	353, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 191, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 0, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 86, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 829, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 0, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 89, 90, 0, 91,
-	0, 0, 0, 774, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 191, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 0, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 89, 90, 0, 91, 0, 0, 0,
-	1269, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 191, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 0, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 288, 0,
+	738, 0, 0, 0, 0, 0, 0, 752, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 767,
+	0, 749, 750, 751, 0, 758, 748, 745, 746, 747,
+	740, 741, 742, 743, 744, 0, 0, 1658, 0, 737,
+	0, 755, 756, 757, 759, 760, 761, 762, 763, 0,
+	0, 0, 0, 0, 0, 0, 764, 0, 0, 0,
+	0, 0, 739, 0, 0, 771, 0, 0, 768, 737,
+	772, 755, 756, 757, 759, 760, 761, 762, 763, 0,
+	0, 738, 770, 0, 0, 0, 764, 0, 752, 0,
+	0, 766, 739, 0, 0, 771, 753, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 453, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 749, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 747, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 752, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	807, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 751, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 808, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 86, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 0, 0, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 749, 182, 0, 0, 744, 97, 98, 99,
-	0, 100, 747, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 752, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 743, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 751, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 750,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	1061, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 263, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 493, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 492, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 269, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 1006, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 1004, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	995, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 622, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 479, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 0,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 86,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 0,
-	169, 89, 90, 0, 91, 170, 171, 172, 173, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 338, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 335, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 83, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 82, 209, 0,
-	0, 78, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 283, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 280,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 278, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 272, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 247, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 76, 0, 0, 0, 122, 123, 124, 125, 83,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	77, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 82,
-	209, 0, 0, 78, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	0, 0, 202, 136, 203, 0, 0, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 0, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 646,
-	169, 664, 665, 666, 0, 170, 171, 172, 173, 0,
-	0, 667, 0, 0, 0, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 0, 646, 0, 664, 665, 666, 0, 0, 0,
-	0, 647, 0, 0, 667, 0, 0, 661, 0, 0,
-	648, 646, 673, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 647, 648,
-	0, 673, 0, 0, 661, 0, 0, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 672, 0,
-	0, 0, 0, 661, 0, 0, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 0, 0, 662, 0,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 668, 674,
-	669, 0, 0, 663, 0, 662, 0, 0, 0, 0,
-	672, 0, 671, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 663, 0, 0, 0,
-	670, 0, 658, 659, 660, 671, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 663, 0, 0, 0, 0,
-	0, 0, 0, 1165, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 0, 0, 0, 0, 0, 0, 1164,
-	0, 0, 0, 670, 0, 658, 659, 660, 0, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 670, 1521, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 1520, 0, 0, 0, 0, 0, 0, 667,
-	0, 0, 0, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 646, 0, 664, 665, 666, 0, 0,
-	0, 0, 647, 0, 0, 667, 0, 0, 661, 0,
-	0, 648, 0, 673, 0, 0, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 0, 647,
-	0, 667, 0, 0, 0, 661, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 647, 672, 0, 0, 0,
-	0, 661, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 0, 668,
-	0, 669, 0, 0, 0, 0, 662, 674, 0, 0,
-	0, 663, 0, 0, 0, 0, 0, 0, 672, 0,
-	671, 0, 0, 0, 0, 0, 668, 669, 0, 0,
-	663, 0, 662, 0, 0, 0, 0, 0, 0, 671,
+	0, 738, 0, 0, 0, 0, 765, 0, 752, 0,
+	737, 0, 755, 756, 757, 759, 760, 761, 762, 763,
+	0, 0, 0, 0, 0, 1735, 0, 764, 0, 0,
+	0, 923, 0, 739, 0, 0, 771, 0, 0, 768,
+	754, 772, 0, 0, 0, 0, 0, 0, 0, 0,
+	769, 0, 738, 770, 0, 0, 1280, 0, 1279, 752,
+	0, 0, 766, 0, 0, 0, 0, 753, 0, 768,
+	0, 772, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 770, 0, 0, 924, 765, 0, 0,
+	0, 0, 766, 0, 0, 0, 0, 753, 0, 1734,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 0, 765, 1324, 0,
+	768, 754, 772, 0, 0, 0, 0, 0, 0, 0,
+	0, 769, 0, 0, 770, 0, 0, 0, 0, 0,
+	0, 0, 0, 766, 0, 0, 0, 0, 753, 0,
+	0, 754, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 769, 0, 0, 0, 0, 0, 0, 765, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 668, 0, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 663, 0, 0, 0, 670, 1507, 658,
-	659, 660, 671, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 1484, 0, 0,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 0,
-	0, 0, 0, 0, 1479, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 646, 0, 664, 665, 666,
-	1475, 0, 0, 0, 0, 0, 0, 667, 0, 0,
-	0, 0, 0, 648, 646, 673, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 647, 648, 0, 673, 0, 0, 661, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	647, 0, 0, 667, 0, 0, 661, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 647, 0, 667,
-	0, 0, 0, 661, 0, 648, 0, 673, 0, 0,
-	0, 0, 0, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 647, 672, 0, 0, 0, 0, 661,
-	0, 0, 674, 669, 0, 0, 0, 0, 662, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 0, 669, 0, 0, 0, 0, 662, 668, 674,
+	0, 767, 0, 749, 750, 751, 0, 758, 748, 745,
+	746, 747, 740, 741, 742, 743, 744, 0, 0, 0,
+	0, 0, 754, 0, 0, 0, 0, 0, 0, 0,
+	0, 767, 769, 749, 750, 751, 0, 758, 748, 745,
+	746, 747, 740, 741, 742, 743, 744, 0, 0, 0,
+	0, 774, 0, 0, 0, 0, 0, 737, 0, 755,
+	756, 757, 759, 760, 761, 762, 763, 0, 0, 0,
+	0, 0, 0, 0, 764, 0, 0, 773, 0, 0,
+	739, 0, 767, 771, 749, 750, 751, 0, 758, 748,
+	745, 746, 747, 740, 741, 742, 743, 744, 0, 738,
+	0, 0, 0, 0, 0, 0, 752, 0, 737, 0,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 0,
+	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
+	0, 739, 0, 0, 771, 0, 0, 0, 737, 0,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 0,
+	738, 0, 0, 0, 0, 764, 0, 752, 0, 0,
+	0, 739, 0, 0, 771, 0, 0, 768, 0, 772,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 668, 0, 669,
-	0, 0, 0, 0, 662, 674, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 672, 0, 671, 0,
-	0, 0, 0, 0, 668, 669, 0, 0, 663, 0,
-	662, 0, 0, 0, 0, 0, 0, 671, 0, 0,
+	738, 770, 0, 0, 0, 0, 0, 752, 0, 0,
+	766, 0, 0, 0, 0, 753, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	668, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 663, 0, 0, 0, 670, 1417, 658, 659, 660,
-	671, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 0, 1416, 0, 0, 0, 0,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 1334, 0, 0, 0, 0, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 646, 0, 664, 665, 666, 1272, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	0, 648, 646, 673, 664, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 667, 0, 0, 0, 0, 647,
-	648, 0, 673, 0, 0, 661, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 647, 0,
-	0, 667, 0, 0, 661, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 647, 672, 0, 0, 0, 0, 661, 0, 0,
-	674, 669, 0, 0, 0, 0, 662, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 0, 0,
-	669, 0, 0, 0, 0, 662, 668, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 1581,
-	0, 0, 0, 0, 0, 668, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 672, 0, 671, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 663, 0, 662, 0,
-	0, 0, 0, 0, 0, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 668, 0,
-	0, 0, 0, 663, 0, 0, 0, 0, 0, 0,
-	1580, 0, 671, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 663,
-	0, 0, 0, 670, 1247, 658, 659, 660, 671, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 0, 910, 0, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1318, 0,
-	0, 0, 0, 0, 0, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 0, 648,
-	646, 673, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 0, 0, 818, 647, 648, 0,
-	673, 0, 676, 661, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 647, 0, 0, 0,
-	667, 0, 661, 675, 0, 0, 648, 0, 673, 0,
+	0, 0, 0, 0, 0, 765, 0, 0, 768, 0,
+	772, 737, 0, 755, 756, 757, 759, 760, 761, 762,
+	763, 0, 770, 0, 0, 0, 0, 0, 764, 0,
+	0, 766, 0, 0, 739, 0, 753, 771, 768, 754,
+	772, 0, 0, 0, 0, 0, 0, 0, 0, 769,
+	0, 0, 770, 738, 0, 0, 765, 298, 0, 0,
+	752, 766, 0, 0, 0, 0, 753, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 647, 0, 1156, 819, 1155, 0,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	0, 0, 0, 0, 0, 0, 765, 0, 0, 0,
+	754, 0, 0, 0, 0, 0, 0, 0, 0, 767,
+	769, 749, 750, 751, 0, 758, 748, 745, 746, 747,
+	740, 741, 742, 743, 744, 0, 0, 0, 0, 0,
+	754, 768, 0, 772, 0, 0, 0, 0, 0, 0,
+	769, 0, 0, 0, 0, 770, 0, 0, 0, 0,
+	0, 0, 0, 0, 766, 1397, 0, 0, 0, 753,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 0, 1319, 0, 765,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 0, 674, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
-	0, 0, 0, 662, 668, 0, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 668, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 0, 0,
-	0, 668, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 0, 0, 671, 0, 0, 0, 0, 0, 0,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 0, 0, 0, 0,
+	0, 0, 737, 754, 755, 756, 757, 759, 760, 761,
+	762, 763, 0, 769, 0, 0, 0, 0, 0, 764,
+	0, 0, 0, 0, 0, 739, 0, 0, 771, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 646, 0, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 0, 648, 646, 673, 664, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	647, 648, 0, 673, 0, 0, 661, 0, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 647,
-	0, 0, 0, 667, 0, 661, 0, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 0, 0,
-	0, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	0, 0, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 674, 669, 0, 0, 0, 0, 662, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 1162, 0,
-	0, 669, 0, 0, 0, 0, 662, 668, 242, 674,
+	0, 0, 0, 0, 738, 0, 0, 0, 0, 0,
+	0, 752, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 767, 0, 749, 750, 751, 0, 758,
+	748, 745, 746, 747, 740, 741, 742, 743, 744, 737,
+	0, 755, 756, 757, 759, 760, 761, 762, 763, 0,
+	0, 0, 0, 0, 0, 0, 764, 0, 0, 1281,
+	0, 1286, 739, 0, 0, 771, 0, 0, 0, 0,
+	0, 0, 768, 0, 772, 0, 0, 0, 0, 0,
+	0, 738, 0, 0, 0, 0, 770, 0, 752, 0,
+	0, 0, 0, 0, 0, 766, 0, 0, 0, 0,
+	753, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 668, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
+	765, 0, 0, 0, 737, 0, 755, 756, 757, 759,
+	760, 761, 762, 763, 0, 0, 0, 0, 0, 0,
+	0, 764, 0, 0, 0, 0, 0, 739, 0, 768,
+	771, 772, 0, 0, 754, 0, 0, 0, 0, 0,
+	0, 0, 0, 770, 769, 0, 738, 0, 0, 0,
+	0, 0, 766, 752, 0, 0, 0, 753, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1266, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 0,
-	0, 1385, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 667,
-	0, 1139, 1157, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 0, 646, 0, 664, 665, 666, 0,
-	0, 0, 647, 0, 0, 0, 667, 0, 661, 1119,
-	0, 0, 648, 0, 673, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1145, 0,
-	647, 0, 0, 0, 0, 0, 661, 0, 0, 0,
-	0, 0, 1140, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 672, 0, 0, 0,
-	0, 0, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 0, 674, 1141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 668,
-	0, 0, 669, 0, 0, 0, 0, 662, 0, 1124,
-	0, 663, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 0, 0, 0, 668, 0, 0,
-	663, 0, 0, 0, 0, 0, 0, 0, 0, 671,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 0, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 0, 0, 0, 1242, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	646, 0, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 1139, 0, 0, 0, 648, 646,
-	673, 664, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 667, 0, 0, 0, 0, 647, 648, 0, 673,
-	0, 0, 661, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 647, 0, 0, 0, 0,
-	0, 661, 0, 0, 648, 0, 673, 0, 0, 0,
-	0, 0, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 1145, 647, 0, 1241, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 1140, 0, 0, 674, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 1139, 0, 0, 674, 669, 0,
-	0, 0, 0, 662, 0, 0, 0, 0, 672, 0,
-	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
-	0, 1139, 662, 668, 674, 1126, 1141, 1142, 1143, 1144,
-	0, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 1145, 0, 671, 0, 0, 0, 1139, 0, 0,
-	0, 0, 0, 663, 0, 1140, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 1136, 1137, 1138, 1145, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 0,
-	663, 0, 1140, 0, 0, 0, 0, 0, 0, 671,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 1141, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1140, 0,
-	0, 0, 0, 1141, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1136, 1137, 1138, 0, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 1141,
+	0, 0, 0, 0, 0, 0, 0, 765, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 0, 0,
-	0, 0, 846, 861, 838, 854, 853, 0, 0, 839,
-	0, 0, 0, 863, 862, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1136, 1137,
-	1138, 0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130,
-	1131, 859, 0, 851, 850, 0, 0, 0, 0, 0,
-	0, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 848, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 767, 0, 749, 750, 751, 0,
+	758, 748, 745, 746, 747, 740, 741, 742, 743, 744,
+	0, 754, 0, 0, 768, 0, 772, 0, 0, 0,
+	0, 769, 0, 0, 0, 0, 0, 0, 770, 0,
+	0, 0, 0, 0, 0, 0, 0, 766, 0, 0,
+	0, 0, 753, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 843, 844, 0, 524, 0,
+	0, 0, 765, 0, 0, 0, 0, 0, 0, 0,
+	0, 767, 1248, 749, 750, 751, 0, 758, 748, 745,
+	746, 747, 740, 741, 742, 743, 744, 0, 0, 0,
+	0, 0, 0, 0, 0, 737, 754, 755, 756, 757,
+	759, 760, 761, 762, 763, 0, 769, 0, 0, 0,
+	0, 0, 764, 0, 0, 1243, 0, 0, 739, 0,
+	0, 771, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 738, 0, 0,
+	0, 0, 0, 0, 752, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 767, 0, 749, 750,
+	751, 0, 758, 748, 745, 746, 747, 740, 741, 742,
+	743, 744, 737, 0, 755, 756, 757, 759, 760, 761,
+	762, 763, 0, 0, 0, 0, 0, 0, 0, 764,
+	0, 0, 0, 0, 0, 739, 0, 0, 771, 0,
+	0, 0, 0, 0, 0, 768, 0, 772, 0, 0,
+	0, 0, 0, 0, 738, 0, 0, 0, 0, 770,
+	0, 752, 0, 0, 0, 0, 0, 0, 766, 0,
+	0, 0, 0, 753, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 765, 0, 0, 0, 737, 0, 755,
+	756, 757, 759, 760, 761, 762, 763, 0, 0, 0,
+	0, 0, 0, 0, 764, 0, 0, 0, 0, 0,
+	739, 0, 768, 771, 772, 863, 0, 754, 0, 0,
+	0, 0, 0, 0, 0, 0, 770, 769, 0, 738,
+	0, 0, 0, 0, 0, 766, 752, 0, 0, 0,
+	753, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
+	765, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 767, 0, 749,
+	750, 751, 0, 758, 748, 745, 746, 747, 740, 741,
+	742, 743, 744, 0, 754, 0, 0, 768, 0, 772,
+	0, 0, 0, 0, 769, 0, 0, 0, 0, 0,
+	0, 770, 0, 0, 0, 0, 0, 0, 0, 0,
+	766, 0, 0, 0, 0, 753, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 765, 0, 0, 0, 0,
+	0, 0, 0, 0, 767, 0, 749, 750, 751, 0,
+	758, 748, 745, 746, 747, 740, 741, 742, 743, 744,
+	0, 0, 0, 0, 0, 0, 0, 0, 737, 754,
+	755, 756, 757, 759, 760, 761, 762, 763, 0, 769,
+	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
+	0, 739, 0, 0, 771, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 847, 0, 0, 0, 0, 0, 0, 0, 0,
+	738, 0, 0, 0, 0, 0, 0, 752, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 767,
+	0, 749, 750, 751, 0, 758, 748, 745, 746, 747,
+	740, 741, 742, 743, 744, 737, 0, 755, 756, 757,
+	759, 760, 761, 762, 763, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 739, 0,
+	0, 771, 0, 0, 0, 0, 0, 0, 768, 0,
+	772, 0, 0, 0, 0, 0, 0, 738, 0, 0,
+	0, 0, 770, 0, 752, 0, 0, 0, 0, 0,
+	0, 766, 0, 0, 0, 0, 753, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 845, 0, 0, 0,
-	0, 841, 0, 0, 0, 0, 0, 840, 0, 0,
-	860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 737, 0, 755, 756,
+	757, 759, 760, 761, 762, 763, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 739,
+	0, 0, 771, 0, 0, 768, 0, 772, 0, 0,
+	754, 0, 0, 0, 0, 0, 0, 0, 738, 770,
+	769, 0, 0, 0, 0, 752, 0, 0, 766, 0,
+	0, 0, 0, 753, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 864,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	767, 0, 749, 750, 751, 0, 758, 748, 745, 746,
+	747, 740, 741, 742, 743, 744, 768, 754, 772, 0,
+	0, 0, 0, 0, 0, 0, 0, 769, 0, 0,
+	28, 54, 53, 0, 0, 0, 0, 0, 0, 766,
+	30, 48, 0, 0, 753, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 31, 49, 0, 0, 0, 0, 0, 0,
+	55, 0, 0, 0, 0, 0, 0, 767, 0, 749,
+	750, 751, 0, 758, 748, 745, 746, 747, 740, 741,
+	742, 743, 744, 0, 0, 0, 0, 37, 754, 0,
+	0, 0, 38, 0, 39, 0, 0, 0, 769, 0,
+	0, 0, 0, 0, 737, 0, 0, 40, 0, 759,
+	760, 761, 762, 763, 0, 0, 0, 41, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 739, 0, 0,
+	771, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 738, 0, 767, 0,
+	749, 750, 751, 752, 758, 748, 745, 746, 747, 740,
+	741, 742, 743, 744, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 42, 0,
+	0, 43, 0, 0, 50, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 0, 46, 47, 0, 0,
+	0, 0, 0, 0, 768, 0, 772, 0, 0, 0,
+	64, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 51, 0, 0, 0, 0, 766, 0, 0,
+	0, 0, 753, 0, 52, 0, 65, 0, 0, 0,
+	0, 0, 0, 60, 0, 0, 0, 0, 0, 61,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 59, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 754, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 769, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 767, 0, 0, 0,
+	0, 0, 758, 748, 745, 746, 747, 740, 741, 742,
+	743, 744,
 }
 var sqlPact = [...]int{
 
-	1951, -1000, -12, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 709,
-	-1000, -1000, -1000, 535, 635, 90, 864, 864, -1000, -1000,
-	15081, 1760, 306, 306, 306, 396, 480, 99, -1000, 755,
-	27, 14864, 12043, 1060, -14, 11392, 212, 1951, 11826, 12043,
-	14647, 931, 862, 11392, 14430, 14213, 13996, -1000, 7925, -1000,
-	-1000, -1000, -1000, 682, -1000, -15, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 676, -1000, 13779, 13779, 853, -1000,
-	-1000, 472, 258, 1086, -1000, -7, -1000, -1000, -1000, -1000,
+	20726, -1000, 2, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 638, 13483,
+	13001, 737, -1000, -1000, -1000, -1000, 564, 720, 0, 223,
+	12278, 490, 13001, 12278, -1000, -1000, 16857, 1971, 399, 399,
+	399, 489, 13001, -1000, -1000, 551, 108, -1000, 565, 0,
+	16616, 13483, 1137, -2, 12760, 248, 20726, 13242, 13483, 16375,
+	-1000, -148, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2747,39 +3124,40 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 930, -1000, 675, 928,
-	913, 252, 859, -1000, 853, -1000, -1000, -1000, 11392, -1000,
-	13562, 875, 13345, -1000, 755, -1000, -1000, -1000, 805, 1059,
-	1059, 1059, 1075, 79, 77, 99, -16, 12043, -1000, 216,
-	-1000, -1000, -1000, -1000, -1000, -16, 6001, 6001, -1000, -1000,
-	212, -1000, 232, 10267, -139, -1000, 5523, -1000, 602, 987,
-	511, 506, 983, 11392, 12043, 434, 13128, -1000, 980, 78,
-	979, -1000, -32, 977, -1000, -23, -1000, -1000, -1000, -1000,
-	-1000, -1000, 212, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11609, 910, 11609, -1000,
-	-1000, -1000, 806, 8401, 8164, 1021, 1599, -1000, -1000, -1000,
-	-10, 3356, 12043, 942, 11609, 12043, -1000, 12043, -1000, 800,
-	-1000, -1000, 85, -1000, 210, 765, 12911, -1000, 761, -1000,
-	805, -1000, 631, 788, 6258, 6975, 99, -1000, -1000, 99,
-	99, 6975, -1000, -1000, 12043, -16, 1095, 12043, 911, -18,
-	-1000, 16838, -1000, -1000, 6975, 6975, 6975, 6975, 6975, 578,
-	-1000, -1000, -1000, 3832, -1000, -1000, -139, 202, 222, -1000,
-	-1000, 200, -139, -1000, -1000, -1000, -1000, 197, 1192, 330,
-	-1000, -1000, -1000, 6975, 263, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 939, 196, 194, -1000, -1000, -1000,
-	-1000, 191, 187, 182, 180, 178, 166, 160, 159, 154,
-	152, 150, 146, 145, 569, -1000, 274, -1000, -1000, 274,
-	274, -1000, 131, 131, 132, -1000, -1000, -1000, 131, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 144, 52,
-	-1000, -1000, -1000, 12043, -139, -1000, 3118, 3356, 6975, -24,
-	-1000, 17670, -1000, -71, 562, -1000, 10948, 1036, 1034, 1047,
-	11392, 382, 377, 12043, 268, 55, 1090, 9793, -1000, 12043,
-	12043, -1000, 12043, -1000, -1000, 12043, 12043, 12043, 27, 10504,
-	375, -41, 12043, 12043, -1000, 909, 650, -19, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1172, -1000,
-	-1000, -1000, -1000, 1183, -19, -1000, -1000, -1000, -1000, -1000,
-	1190, -1000, -1000, -1000, -1000, 3356, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 464,
+	-6, 13483, 13483, -1000, 647, 967, 870, 12760, 16134, 13483,
+	15893, 15652, 1074, -1000, -1000, -1000, -1000, -1000, 0, 8786,
+	734, 462, -1000, -5, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 13483, 966, 726, 965, -1000, 15411, 15411, 857,
+	-1000, -1000, 443, 324, 1159, -1000, 7, -1000, -1000, 963,
+	-1000, 703, 960, 958, 322, 861, -1000, 857, -1000, -1000,
+	-1000, 12760, -1000, -1000, 15170, 478, 903, 14929, 13483, -1000,
+	565, -1000, -1000, -1000, 792, 1128, 1128, 1128, 1165, 117,
+	115, 108, -8, 13483, -1000, 249, -8, 6658, 6658, -1000,
+	-1000, 248, -1000, 271, 11305, -1000, 6116, -1000, 1985, 1031,
+	678, 569, 1018, -148, -1000, 3425, 3683, 7478, 7478, 13483,
+	-6, -7, -1000, 13483, 13483, 12760, 13483, 513, 14688, -1000,
+	1016, -1000, 91, 1013, -25, 1008, -1000, 525, -1000, -12,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 248, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 13001, 13483, 1015, 247, 7478, 13001, 13483, -1000,
+	-1000, -1000, 821, 9305, 9047, 1102, 514, -1000, -1000, -1000,
+	4, 3683, 13483, 975, 13001, 13483, -1000, 13483, -1000, 818,
+	-1000, -1000, 106, -1000, 244, 791, 13483, 14447, -1000, 786,
+	-1000, -1000, 792, -1000, 692, 814, 6936, 7478, 108, -1000,
+	-1000, 108, 108, 7478, -1000, -1000, 13483, -8, 1200, 13483,
+	956, -9, -1000, 19397, -1000, -1000, 7478, 7478, 7478, 7478,
+	7478, 643, -1000, -1000, -1000, 4212, -1000, -1000, -148, 236,
+	256, -1000, -1000, 234, -148, -1000, -1000, -1000, -1000, 233,
+	1275, 384, -1000, -1000, -1000, 7478, 330, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 971, 231, 229, -1000,
+	-1000, -1000, -1000, 227, 226, 221, 216, 214, 209, 208,
+	204, 203, 202, 201, 198, 197, 615, -1000, 350, -1000,
+	-1000, 350, 350, -1000, 181, 181, 182, -1000, -1000, -1000,
+	181, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	196, 68, -1000, -1000, -1000, 13483, -14, -1000, 20267, -1000,
+	-59, 1187, -1000, 319, 602, -1000, 12037, 1130, 1129, 1124,
+	390, 388, 12760, 309, 455, 445, 13483, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2787,298 +3165,329 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, -1000, -1000, -1000, -1000, 11392, 10721,
-	976, 673, 756, -1000, 971, -1000, -1000, -1000, -1000, 17670,
-	-1000, 17670, 448, 867, -1000, 867, -20, -1000, 16810, -1000,
-	143, -25, -1000, 268, 9556, 6001, 18001, 12043, 385, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	657, 370, 896, 618, 130, 3356, -1000, 1136, 1136, 1136,
-	17716, 17716, 153, -140, 16492, -21, -139, -1000, -1000, 5027,
-	4788, -139, 2706, -1000, 634, 1181, 271, 17670, 948, 895,
-	142, 72, 70, 6975, 725, 6975, 7214, 6975, 6975, 4071,
-	6975, 6975, 6975, 6975, 6975, 6975, -1000, 138, -1000, -1000,
-	-1000, -1000, 1180, -1000, -1000, 1176, -1000, 1175, 268, 64,
-	-1000, -1000, -1000, -1000, 1826, 5523, -1000, 605, 12043, 12043,
-	12043, -1000, -1000, 742, 12694, -1000, 18001, 12043, -1000, 136,
-	135, 829, 821, 12043, 12043, 12477, 12260, 12043, 528, 12043,
-	12043, 474, -1000, 6975, 659, -1000, 9102, 279, 12043, 50,
-	-1000, -1000, -1000, 243, 12043, -1000, -1000, -1000, 78, -1000,
-	-32, -1000, -1000, 12043, -41, -52, 12043, -1000, 470, 546,
-	-1000, -1000, 8638, -1000, -1000, -1000, 634, -1000, -84, -1000,
-	-1000, 59, -53, -1000, -1000, -1000, -1000, 12043, 209, 12043,
-	12043, 968, 12043, -1000, -1000, -1000, 6975, -1000, -1000, -1000,
-	27, 12043, -1000, 888, -70, 921, 11175, 11175, -1000, 8865,
-	-1000, -1000, 1105, -1000, -1000, -1000, -1000, 61, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 132, 569,
-	131, 131, 131, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 274, 274, 274, -1000, -1000, 249, 383, 383, 1096,
-	1096, 1096, 495, 495, 422, 763, 1985, 1985, 1985, 445,
-	706, 706, 1985, 1985, 1985, 17716, 17689, 2341, 6975, 354,
-	580, 130, 6975, -1000, 975, -1000, -1000, -1000, 908, 129,
-	7214, 7214, -1000, -1000, -1000, 3832, -1000, -1000, 128, 6975,
-	-1000, 6975, -29, -30, -1000, 17670, -1000, -36, -1000, -1000,
-	-38, 6975, 6975, 6975, 51, -1000, 350, -1000, 347, 342,
-	336, -1000, 127, 49, 438, -1000, 6975, 596, 124, 121,
-	6975, -1000, -1000, 17424, 48, 907, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 47, 17396, 46, 2004, -1000, 7214, 7214,
-	7214, 3832, 120, 45, 16791, -116, 17377, 5762, 5762, 5762,
-	44, 17131, 6975, -116, 15545, 15519, 2318, -37, -42, -43,
-	1174, -44, 43, 41, 888, -1000, -1000, 6975, -1000, -1000,
-	-1000, 329, 318, 966, -1000, 734, -1000, 670, 6975, 12043,
-	119, 118, 527, -1000, 964, 694, 962, 694, -1000, -71,
-	551, -1000, -1000, 317, 17670, -1000, 1049, -48, -1000, -1000,
-	268, 9793, 5523, -54, -1000, -84, -84, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, 10721, 117, 12043, 116, 115, 12043,
-	-1000, -1000, 40, -1000, -1000, -1000, -1000, -1000, 883, 1073,
-	9556, 851, 844, 9556, 856, 606, 606, 606, -1000, -1000,
-	-1000, 12043, 113, -1000, 9339, 38, 921, 225, 223, -1000,
-	1173, 6975, 2341, 6975, 7214, 7214, -1000, 2341, -1000, -1000,
-	-1000, -1000, 905, 111, 6975, 18001, 17742, 17642, -55, 4549,
-	-95, 16473, 6975, -1000, -1000, 222, -1000, 37, 5284, -1000,
-	17084, -11, -11, -1000, 778, 746, 492, 440, 1161, 1187,
-	978, -1000, 6975, 17103, -1000, 10030, 269, 620, 16227, 18001,
-	-1000, 6975, -1000, 904, 6975, -1000, 18001, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 802, 7214, 1130, 1130, 1130,
-	-103, 4310, -1000, 938, 904, 6975, 6975, 18001, 35, 34,
-	31, -1000, 6975, -116, 6975, 6975, 6975, -1000, -1000, -1000,
-	29, -1000, 1160, -1000, -1000, 883, 16519, 12043, 12043, 12043,
-	955, 1566, -1000, 16201, -56, 12043, 12043, -1000, 768, 882,
-	299, 12043, -1000, 12043, -1000, 12043, 12043, 12043, 12043, 137,
-	27, -1000, -1000, -1000, 241, -1000, -1000, 12043, 103, 10721,
-	7688, 653, -1000, 266, 6975, 6975, 921, 9556, 9556, 1606,
-	831, 9556, -1000, -1000, -1000, -1000, 101, 12043, 11175, 356,
-	1139, 24, 1093, 2341, 17349, 1810, 6975, 18001, 2448, -60,
-	-1000, 6975, 6975, -1000, -61, -1000, 6975, -1000, 17670, -1000,
-	1186, 6975, 22, 21, 20, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15, -1000, -1000, 17670, 6975, -1000, -1000, 15298,
-	6975, 13, -1000, 11, 17670, 938, 17670, -1000, 553, 553,
-	1130, 1130, 1130, 548, 548, 741, 283, 2414, 2414, 2414,
-	1151, 389, 389, 2414, 2414, 2414, 903, 757, 98, 17769,
-	6975, -62, -1000, -1000, -1000, 17670, 17670, 10, -1000, -1000,
-	-1000, -116, 2161, 16174, 16155, -1000, 9, 266, -1000, -1000,
-	-1000, -1000, 12043, -1000, 12043, -1000, 12043, 697, -1000, -1000,
-	817, 97, 7214, 12043, -1000, 577, -66, -72, 692, -1000,
-	642, 6975, -1000, 18001, 694, 694, -1000, 316, 315, -1000,
-	996, 7688, 1044, -1000, 95, -73, 12043, 5, -74, -1000,
-	65, 1058, 6975, -1000, -1000, 94, 12043, -1000, 12043, 17670,
-	-116, -1000, 1606, -1000, 93, 6975, 9556, -1000, 12043, -80,
-	-1000, -1000, 220, 218, -1000, 6975, 6975, 2448, -82, -1000,
-	18001, 2341, 2341, -1000, 15909, -1000, 17084, -1000, -1000, -1000,
-	-1000, 17670, 557, -1000, 15883, -1000, -1000, -1000, 7214, 901,
-	91, 18001, 15856, -1000, -1000, 6975, -1000, -1000, -1000, -1000,
-	-1000, 1503, -1000, -1000, -1000, 6975, 17769, 62, -1000, 87,
-	-1000, -1000, -1000, 515, -1000, -1000, 17670, 1061, -1000, -1000,
-	12043, 12043, 374, -86, 12043, -1000, -1000, 3593, 577, -87,
-	-1000, 577, 7688, 1057, -139, 12043, 1057, 15837, 2706, 84,
-	-109, -1000, 1089, -1000, 12043, 17670, -1000, -91, -1000, -1000,
-	-1000, 2341, 2341, -1000, -1000, -1000, 4, 620, 1072, -1000,
-	17815, 7214, 18001, -93, -1000, 15591, -1000, 15572, 781, 12043,
-	12043, 12043, 285, 12043, -1000, -1000, 413, -1000, 268, -1000,
-	-1000, 577, -1000, -1000, -1000, -1000, -1000, 1058, -38, 7688,
-	12043, 83, -108, -1000, -1000, 555, 6975, 17815, -120, -1000,
-	-1000, -1000, 624, 684, -126, -128, 62, -1000, 6975, -1000,
-	9793, -1000, -1000, 1057, 3, -133, -1000, -1000, -1000, 1,
-	6736, 6736, -116, -1000, -1000, 643, 641, 497, -1000, -1000,
-	-1000, -1000, -1000, 781, 17670, -104, -1000, -1000, 577, -1000,
-	-1000, -1000, 7451, 630, 453, 16545, -1000, -1000, 1003, -1000,
-	292, 652, 652, 624, -1000, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1119, -1000, -1000, 798, -1000, -1000, 6497,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2603, 20182, -1000, 13483, 867,
+	865, 341, 105, 1198, 10805, -1000, 13483, 13483, -1000, -1000,
+	-1000, 13483, 13483, 13483, -1000, 0, 11555, 444, -60, -1000,
+	-1000, -1000, -1000, -1000, -1000, 11796, -121, 20267, 954, -60,
+	537, -11, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1253, -1000, -1000, -1000, -1000, 1266, -11, -1000,
+	-1000, -1000, -1000, -1000, 1273, -1000, -1000, -1000, -1000, 3683,
+	-1000, -1000, -1000, 13483, -1000, -1000, -1000, -1000, -1000, 12760,
+	11796, 1000, 195, 698, 784, -1000, 999, -1000, -1000, -1000,
+	-1000, 20267, -1000, 20267, 545, 894, -1000, 894, -13, -1000,
+	19170, -1000, 194, -16, 341, 10555, 6658, 2099, 13483, 471,
+	7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478,
+	7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478, 7478,
+	7478, 7478, 7478, 7478, 7478, 7478, 7478, 845, 7478, 437,
+	944, 675, 179, 3683, -1000, 1235, 1235, 1235, 20535, 20535,
+	187, -149, 18734, -21, -148, -1000, -1000, 5567, 5296, -148,
+	2995, -1000, 578, 1265, 348, 20267, 986, 933, 191, 101,
+	100, 7478, 626, 7478, 8020, 7478, 7478, 4483, 7478, 7478,
+	7478, 7478, 7478, 7478, -1000, 189, -1000, -1000, -1000, -1000,
+	1264, -1000, -1000, 1263, -1000, 1262, 341, 99, 6116, -1000,
+	599, 188, 7478, 13483, 13483, 13483, -1000, -1000, 781, 14206,
+	-1000, 2099, 13483, -1000, 186, 184, 840, 839, 13483, 13483,
+	13965, 13724, 13483, 7478, -1000, 1007, 7478, 13483, 13483, 566,
+	-1000, 7478, -1000, 952, -1000, 900, 900, 658, 7478, 697,
+	-1000, 10055, 354, 13483, 53, -1000, -1000, -1000, 291, 13483,
+	-1000, -1000, 91, -1000, -25, -1000, -1000, 13483, 13483, 98,
+	-38, -1000, -1000, -1000, -1000, 13483, 235, 7478, 13483, -1000,
+	574, 556, -1000, -1000, 9563, -1000, -1000, -1000, 578, -1000,
+	-60, -1000, 95, 13483, 11796, 13483, 998, 13483, -1000, -1000,
+	-1000, 7478, -1000, -1000, -1000, 0, -1000, 932, -58, 1215,
+	12519, 12519, -1000, 9805, -1000, -1000, 505, -1000, -1000, -1000,
+	-1000, 62, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 182, 615, 181, 181, 181, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 350, 350, 350, -1000, -1000,
+	299, 412, 412, 1205, 1205, 1205, 860, 860, 1538, 1602,
+	20824, 20824, 20824, 292, 318, 318, 20824, 20824, 20824, 292,
+	292, 292, 292, 292, 292, 20535, 20458, 653, 7478, 7478,
+	431, 671, 179, 653, 7478, -1000, 905, -1000, -1000, -1000,
+	951, 177, 8020, 8020, -1000, -1000, -1000, 4212, -1000, -1000,
+	175, 7478, 262, 7478, -99, -117, -1000, -1000, -20, -1000,
+	-1000, -29, 7478, 7478, 7478, 93, -1000, 421, -1000, 414,
+	411, 410, -1000, 173, 90, 509, -1000, 7478, 627, 170,
+	168, 7478, -1000, -1000, 20105, 89, 949, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 87, 19914, 85, 17427, -1000, 8020,
+	8020, 8020, 4212, 167, 84, 19119, -121, 19829, 6387, 6387,
+	6387, 83, 19752, 7478, -121, 17654, 17541, 17377, -30, -31,
+	-37, 1260, -39, 81, 75, 932, -1000, -1000, -1000, 7478,
+	20267, -1000, 408, 406, 997, -1000, 780, -1000, 691, 7478,
+	13483, 165, 163, 631, -1000, 996, 702, 995, 702, -1000,
+	19561, -59, 575, 20267, -1000, -1000, 405, 19008, 7478, -1000,
+	-1000, -1000, -1000, 814, -1000, 20267, -1000, 1136, -41, -1000,
+	-1000, 341, 10805, 6116, -43, -1000, -60, -1000, 1114, 11796,
+	162, 13483, 20267, -60, -1000, -1000, -1000, -1000, -1000, -1000,
+	161, 72, 160, 13483, -1000, -1000, 70, -1000, -1000, -1000,
+	-1000, 921, 1164, 10555, 855, 854, 10555, 908, 646, 646,
+	646, -1000, -1000, -1000, 13483, 158, -1000, 10305, 63, 1215,
+	-1000, 259, 428, -1000, 1255, 7478, 653, 653, 7478, 8020,
+	8020, -1000, 653, -1000, -1000, -1000, -1000, 947, 154, 7478,
+	2099, 2694, 2537, -47, 5025, -64, 7478, 18658, -1000, -1000,
+	256, -1000, 51, 5845, 19448, -24, -24, -1000, 798, 751,
+	580, 520, 1252, 1272, 1048, -1000, 7478, 19478, -1000, 11055,
+	345, 658, 18457, 2099, -1000, 7478, -1000, 943, 7478, -1000,
+	2099, 8020, 8020, 8020, 8020, 8020, 8020, 8020, 8020, 8020,
+	8020, 8020, 8020, 8020, 8020, 8020, 8020, 8020, 8020, 878,
+	8020, 1227, 1227, 1227, -65, 4754, -1000, 970, 943, 7478,
+	7478, 2099, 49, 42, 41, -1000, 7478, -121, 7478, 7478,
+	7478, -1000, -1000, -1000, 37, -1000, 1246, -1000, -1000, 921,
+	-48, 13483, 13483, 13483, 994, 2233, -1000, 18381, -49, 13483,
+	13483, -1000, 827, 869, 386, 13483, -1000, 13483, -1000, 13483,
+	13483, 13483, 13483, 13483, -1000, -121, -1000, -1000, 152, 0,
+	658, -1000, -1000, 290, 1091, -1000, 13483, 153, 11796, 1110,
+	8536, 695, -1000, 343, 7478, 7478, 1215, 10555, 10555, 973,
+	828, 10555, -1000, -1000, -1000, -1000, 143, 13483, 12519, 1238,
+	-1000, 252, 34, 1189, 653, 2424, 2228, 7478, 2099, 20616,
+	-66, -1000, 7478, 7478, -1000, -67, -1000, 7478, 2255, -1000,
+	-1000, 1270, 7478, 27, 24, 23, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 21, -1000, -1000, 20267, 7478, -1000, -1000,
+	17098, 7478, 18, -1000, 16, 20267, 970, 20267, -1000, 549,
+	549, 1227, 1227, 1227, 340, 340, 936, 846, 495, 495,
+	495, 407, 298, 298, 495, 495, 495, 942, 787, 142,
+	2628, 7478, -68, -1000, -1000, -1000, 20267, 20267, 15, -1000,
+	-1000, -1000, -121, 17350, 18293, 18180, -1000, 13, 343, -1000,
+	-1000, -1000, -1000, 13483, -1000, 13483, -1000, 13483, 752, -1000,
+	-1000, 831, 140, 8020, 374, 13483, -1000, 657, -74, -78,
+	750, -1000, 745, 7478, -1000, 2099, 702, 702, -1000, -1000,
+	404, 403, -1000, 1056, 8536, 1120, -1000, 814, 139, 137,
+	-80, 13483, 12, 1090, -82, -1000, 77, 1138, 7478, -1000,
+	-1000, 131, 13483, -1000, 13483, 20267, -121, -1000, 973, -1000,
+	130, 7478, 10555, -1000, 13483, -84, -1000, 251, -1000, -1000,
+	7478, 7478, 20616, -86, -1000, 2099, 653, 653, -1000, 18092,
+	-1000, 7478, -1000, 19448, -1000, -1000, -1000, -1000, 20267, 603,
+	-1000, 18009, -1000, -1000, -1000, 8020, 941, 129, 2099, 17979,
+	-1000, -1000, 7478, -1000, -1000, -1000, -1000, -1000, 1225, -1000,
+	-1000, -1000, 7478, 2628, 8020, 107, -1000, 123, -1000, -1000,
+	-1000, 590, -1000, -1000, 20267, 1148, -1000, -1000, 13483, 13483,
+	449, -90, 13483, -1000, -1000, -1000, 3941, 7478, 657, -91,
+	-1000, 122, 657, 8536, 1132, -148, 13483, 1132, 17818, 2995,
+	121, -75, -1000, 1196, -1000, 13483, 20267, -1000, -92, -1000,
+	-1000, 653, 653, -1000, -1000, -1000, 18817, 11, 658, 1146,
+	-1000, 17618, 8020, 2099, -100, -1000, 17705, -1000, 2714, 2628,
+	805, 13483, 13483, 13483, 360, 13483, -1000, -1000, 506, -1000,
+	341, -1000, -107, -1000, 657, 7478, -1000, -1000, -1000, -1000,
+	-1000, 1138, -29, 8536, 13483, 119, -109, -1000, -1000, -1000,
+	552, 7478, 17618, -113, -1000, -1000, -1000, 662, 663, -126,
+	-128, 107, -1000, 7478, -1000, 10805, -1000, 333, -1000, -130,
+	1132, 10, -132, -1000, -1000, -1000, 9, 7749, 7749, -121,
+	-1000, -1000, 682, 664, 535, -1000, -1000, -1000, -1000, -1000,
+	805, 20267, -118, -1000, 13483, 333, -1000, -1000, 657, -1000,
+	-1000, -1000, 8278, 704, 533, 19089, -1000, -1000, 1077, -1000,
+	364, 709, 709, 662, -1000, -139, -1000, 287, -1000, -1000,
+	1207, -1000, -1000, -1000, -1000, -1000, -1000, 1214, -1000, -1000,
+	852, -1000, -1000, 13483, 7478, 7207, -1000, -1000, -1000, -1000,
+	20267, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1450, 1449, 1105, 1447, 1445, 1441, 1436, 1434, 83,
-	1432, 1430, 85, 1429, 79, 1428, 1425, 1424, 64, 1423,
-	1421, 1418, 1417, 78, 33, 1754, 114, 102, 1415, 1413,
-	1412, 10, 76, 72, 1409, 37, 1408, 542, 1163, 49,
-	20, 15, 4, 1407, 1397, 1393, 29, 1391, 1390, 1389,
-	11, 40, 25, 1388, 14, 89, 1384, 1379, 74, 1373,
-	127, 42, 96, 97, 1367, 1341, 484, 1340, 12, 46,
-	1339, 24, 1333, 19, 51, 103, 1332, 499, 38, 22,
-	36, 1331, 1329, 1328, 61, 70, 43, 1325, 30, 32,
-	1324, 45, 1322, 95, 98, 1321, 1315, 1314, 1312, 1311,
-	1310, 1094, 1309, 7, 52, 41, 28, 39, 0, 215,
-	551, 1299, 56, 27, 35, 31, 1298, 77, 1293, 1292,
-	1289, 1285, 1283, 54, 1282, 44, 107, 26, 63, 69,
-	23, 101, 57, 88, 112, 84, 1281, 91, 1279, 75,
-	1278, 1277, 633, 73, 1274, 1273, 1272, 617, 614, 474,
-	176, 1270, 1268, 334, 324, 1266, 1263, 65, 1259, 1257,
-	106, 1256, 120, 92, 1254, 87, 1253, 66, 1251, 108,
-	113, 86, 1250, 94, 48, 1248, 1246, 1245, 17, 1,
-	6, 5, 8, 3, 18, 16, 1244, 1238, 82, 67,
-	1236, 511, 1235, 1230, 47, 1228, 1225, 21, 1216, 13,
-	1211, 9, 2, 1210, 100, 1208, 71, 1207, 1136, 1204,
-	110, 1201, 1199, 1144, 62,
+	0, 1549, 1547, 1172, 1543, 1542, 1541, 1539, 1538, 1536,
+	1532, 1531, 1530, 1516, 1514, 98, 1507, 1506, 1502, 101,
+	1501, 1500, 93, 1499, 1495, 1491, 1489, 76, 1488, 1486,
+	1484, 1483, 91, 1481, 35, 1932, 126, 117, 1480, 1479,
+	1478, 13, 89, 86, 1477, 56, 1476, 544, 751, 51,
+	25, 29, 79, 1475, 1474, 1473, 38, 1472, 1471, 1469,
+	12, 57, 22, 1468, 26, 20, 1465, 1464, 97, 1463,
+	90, 64, 88, 107, 209, 1460, 1459, 2, 4, 1453,
+	1450, 123, 1430, 11, 63, 1429, 32, 1426, 34, 60,
+	108, 1425, 128, 52, 28, 46, 1423, 1422, 1420, 74,
+	78, 54, 1419, 48, 50, 1416, 72, 1415, 37, 112,
+	40, 1412, 1410, 1402, 1394, 1393, 1392, 587, 1386, 6,
+	39, 55, 15, 17, 1080, 698, 180, 1385, 67, 42,
+	45, 49, 1383, 96, 1374, 1373, 1372, 1371, 1370, 66,
+	1368, 58, 114, 36, 85, 83, 21, 27, 80, 119,
+	125, 100, 1367, 103, 1363, 170, 1362, 1361, 891, 73,
+	1360, 1355, 1354, 883, 882, 786, 18, 1350, 1347, 732,
+	498, 1346, 1345, 75, 1344, 1336, 115, 1335, 113, 111,
+	1334, 102, 1333, 84, 1332, 0, 197, 166, 1329, 105,
+	59, 1326, 1319, 1317, 19, 3, 10, 7, 8, 5,
+	24, 23, 1315, 1314, 106, 81, 1313, 127, 1312, 1307,
+	33, 1306, 1305, 16, 1301, 14, 1297, 9, 1, 1295,
+	118, 1292, 87, 1291, 1223, 1290, 124, 1289, 1287, 1283,
+	1214, 71,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 33, 33, 32, 32, 32, 32, 32, 32,
-	32, 32, 32, 32, 32, 32, 32, 32, 32, 29,
-	29, 35, 35, 35, 34, 34, 30, 30, 5, 5,
-	5, 9, 10, 10, 10, 10, 10, 10, 62, 62,
-	61, 61, 65, 65, 11, 11, 12, 12, 12, 12,
-	138, 138, 137, 13, 17, 204, 204, 204, 208, 208,
-	209, 209, 210, 210, 210, 210, 210, 210, 210, 206,
-	206, 19, 19, 19, 101, 101, 100, 100, 100, 100,
-	102, 102, 102, 102, 162, 160, 160, 167, 167, 167,
-	44, 44, 44, 44, 44, 159, 159, 159, 159, 168,
-	168, 168, 168, 168, 168, 45, 45, 45, 166, 166,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	161, 161, 205, 205, 207, 207, 8, 8, 46, 46,
-	47, 47, 105, 105, 105, 104, 176, 176, 177, 177,
-	177, 178, 178, 178, 178, 178, 178, 178, 175, 175,
-	173, 173, 174, 174, 174, 174, 211, 211, 103, 103,
-	50, 50, 181, 181, 181, 181, 179, 179, 179, 179,
-	179, 182, 180, 183, 183, 183, 183, 183, 126, 126,
-	126, 22, 7, 7, 90, 90, 54, 54, 130, 130,
-	130, 41, 41, 31, 31, 31, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 91, 91, 92, 92, 21,
-	21, 21, 213, 213, 36, 36, 37, 6, 6, 14,
-	43, 43, 97, 97, 97, 99, 99, 99, 98, 98,
-	98, 23, 68, 68, 69, 69, 136, 70, 70, 18,
-	18, 25, 25, 24, 24, 24, 24, 24, 24, 26,
-	26, 27, 27, 27, 27, 27, 27, 27, 189, 189,
-	189, 191, 191, 188, 15, 15, 15, 15, 190, 190,
-	212, 212, 77, 77, 77, 49, 48, 48, 52, 52,
-	51, 53, 53, 129, 75, 75, 75, 75, 93, 94,
-	94, 95, 95, 96, 96, 74, 74, 113, 113, 28,
-	28, 58, 58, 59, 59, 131, 131, 131, 131, 132,
-	132, 132, 132, 132, 132, 127, 127, 127, 127, 128,
-	128, 80, 80, 80, 80, 78, 78, 79, 79, 133,
-	133, 133, 133, 76, 76, 134, 134, 134, 106, 106,
-	139, 139, 139, 57, 57, 57, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 141, 141, 141, 141,
-	143, 143, 143, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 144, 144, 151, 151,
-	152, 152, 153, 154, 145, 145, 146, 146, 147, 148,
-	155, 155, 155, 157, 157, 149, 149, 150, 85, 85,
-	85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
-	85, 85, 86, 86, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 110, 110, 110, 110, 110, 110, 110,
-	110, 110, 110, 110, 110, 184, 184, 184, 184, 184,
-	184, 184, 186, 186, 187, 187, 185, 185, 185, 185,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	4, 5, 5, 6, 6, 43, 43, 42, 42, 42,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 39, 39, 45, 45, 45, 44,
+	44, 40, 40, 7, 227, 227, 8, 76, 76, 10,
+	10, 10, 10, 15, 16, 16, 16, 16, 16, 16,
+	16, 73, 73, 71, 71, 80, 80, 17, 18, 18,
+	18, 19, 19, 19, 19, 154, 154, 153, 153, 20,
+	20, 25, 21, 78, 78, 79, 79, 77, 26, 26,
+	220, 220, 220, 224, 224, 225, 225, 226, 226, 226,
+	226, 226, 226, 226, 222, 222, 28, 28, 28, 117,
+	117, 116, 116, 116, 116, 118, 118, 118, 118, 178,
+	176, 176, 183, 183, 183, 54, 54, 54, 54, 54,
+	175, 175, 175, 175, 184, 184, 184, 184, 184, 184,
+	55, 55, 55, 182, 182, 33, 29, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 177, 177, 221, 221,
+	223, 223, 14, 14, 14, 56, 56, 57, 57, 121,
+	121, 121, 120, 192, 192, 193, 193, 193, 194, 194,
+	194, 194, 194, 194, 194, 194, 191, 191, 189, 189,
+	190, 190, 190, 190, 228, 228, 119, 119, 60, 60,
+	197, 197, 197, 197, 195, 195, 195, 195, 195, 198,
+	196, 199, 199, 199, 199, 199, 142, 142, 142, 31,
+	13, 13, 105, 105, 64, 64, 146, 146, 146, 51,
+	51, 41, 41, 41, 9, 9, 72, 72, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 106, 106, 107,
+	107, 30, 30, 30, 230, 230, 46, 46, 47, 12,
+	12, 11, 22, 53, 53, 113, 113, 113, 115, 115,
+	115, 114, 114, 114, 32, 83, 83, 84, 84, 152,
+	85, 85, 27, 27, 35, 35, 34, 34, 34, 34,
+	34, 34, 36, 36, 37, 37, 37, 37, 37, 37,
+	37, 205, 205, 205, 207, 207, 204, 23, 23, 23,
+	23, 206, 206, 229, 229, 92, 92, 92, 59, 58,
+	58, 62, 62, 61, 63, 63, 145, 90, 90, 90,
+	90, 108, 110, 110, 109, 109, 111, 111, 112, 112,
+	89, 89, 129, 129, 38, 38, 68, 68, 69, 69,
+	147, 147, 147, 147, 148, 148, 148, 148, 148, 148,
+	143, 143, 143, 143, 144, 144, 95, 95, 95, 95,
+	93, 93, 94, 94, 149, 149, 149, 149, 91, 91,
+	150, 150, 150, 122, 122, 155, 155, 155, 67, 67,
+	67, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 157, 157, 157, 157, 159, 159, 159, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 160, 160, 167, 167, 168, 168, 169, 170, 161,
+	161, 162, 162, 163, 164, 171, 171, 171, 173, 173,
+	165, 165, 166, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 101, 101, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	200, 200, 200, 200, 200, 200, 200, 202, 202, 203,
+	203, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 208, 208, 209, 209,
+	210, 210, 211, 211, 213, 214, 214, 214, 215, 219,
+	219, 212, 212, 216, 216, 216, 217, 217, 218, 218,
+	218, 218, 218, 133, 133, 133, 134, 134, 135, 74,
+	74, 131, 131, 130, 130, 130, 132, 132, 75, 172,
+	172, 172, 172, 172, 172, 172, 96, 96, 102, 97,
+	97, 98, 98, 98, 98, 98, 98, 103, 104, 99,
+	99, 99, 128, 128, 136, 140, 140, 139, 138, 138,
+	137, 137, 123, 123, 123, 123, 123, 86, 86, 231,
+	231, 141, 141, 87, 87, 88, 82, 82, 81, 81,
+	151, 151, 151, 151, 70, 70, 52, 52, 65, 65,
+	66, 66, 50, 50, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 174, 174, 174, 48, 48,
+	48, 49, 49, 180, 180, 180, 181, 181, 181, 181,
+	179, 179, 179, 179, 179, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
 	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
 	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 192, 192, 193, 193, 194, 194, 195, 195, 197,
-	198, 198, 198, 199, 203, 203, 196, 196, 200, 200,
-	200, 201, 201, 202, 202, 202, 202, 202, 117, 117,
-	117, 118, 118, 119, 63, 63, 115, 115, 114, 114,
-	114, 116, 116, 64, 156, 156, 156, 156, 156, 156,
-	156, 81, 81, 87, 82, 82, 83, 83, 83, 83,
-	83, 83, 88, 89, 84, 84, 84, 112, 112, 120,
-	124, 124, 123, 122, 122, 121, 121, 107, 107, 107,
-	107, 107, 71, 71, 214, 214, 125, 125, 72, 72,
-	73, 67, 67, 66, 66, 135, 135, 135, 135, 60,
-	60, 42, 42, 55, 55, 56, 56, 40, 40, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	158, 158, 158, 38, 38, 38, 39, 39, 164, 164,
-	164, 165, 165, 165, 165, 163, 163, 163, 163, 163,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 170, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 186,
+	186, 186, 186, 186, 186, 186, 186, 186, 186, 186,
+	186, 186, 186, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	4, 6, 1, 3, 2, 5, 3, 6, 4, 6,
-	6, 6, 4, 8, 2, 3, 3, 6, 4, 3,
-	2, 1, 1, 0, 2, 0, 2, 0, 1, 1,
-	1, 5, 3, 5, 4, 6, 3, 5, 1, 3,
-	1, 2, 2, 3, 2, 5, 1, 1, 1, 1,
-	1, 3, 1, 6, 6, 1, 2, 2, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 2, 3, 3, 2, 1, 3, 3, 3, 3,
-	1, 3, 3, 2, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 0, 1, 1,
-	2, 2, 4, 2, 4, 4, 3, 3, 4, 2,
-	2, 0, 2, 0, 2, 0, 6, 9, 1, 0,
-	1, 3, 1, 1, 1, 3, 2, 0, 3, 1,
-	2, 2, 1, 1, 2, 4, 2, 5, 6, 7,
-	3, 1, 4, 5, 5, 10, 1, 1, 4, 0,
-	3, 0, 2, 2, 2, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 1, 2,
-	1, 4, 10, 13, 1, 0, 1, 3, 3, 3,
-	5, 2, 0, 1, 1, 0, 6, 6, 8, 6,
-	8, 8, 10, 8, 10, 1, 0, 2, 0, 3,
-	2, 2, 1, 0, 1, 0, 3, 3, 6, 6,
-	1, 3, 1, 4, 2, 8, 5, 0, 4, 3,
-	0, 7, 1, 3, 1, 1, 3, 5, 5, 1,
-	1, 3, 3, 1, 2, 3, 2, 3, 4, 1,
-	1, 8, 8, 1, 2, 4, 4, 4, 2, 2,
-	3, 1, 3, 6, 1, 1, 1, 1, 1, 0,
-	1, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	3, 1, 3, 2, 2, 2, 1, 1, 2, 2,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 2,
-	3, 2, 0, 1, 3, 2, 2, 1, 4, 3,
-	4, 5, 4, 5, 4, 5, 2, 4, 1, 1,
-	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
-	2, 2, 4, 1, 3, 1, 2, 3, 2, 0,
-	2, 5, 2, 3, 4, 0, 1, 1, 1, 1,
-	2, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 5, 0, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 3, 0, 1, 1,
-	1, 1, 5, 2, 1, 1, 1, 1, 4, 1,
-	2, 2, 1, 1, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 0, 1, 4, 1, 3, 3, 5, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 4,
+	6, 8, 4, 6, 6, 1, 3, 2, 5, 3,
+	6, 4, 6, 6, 6, 4, 8, 2, 3, 3,
+	6, 4, 5, 2, 3, 2, 1, 1, 0, 2,
+	0, 2, 0, 2, 1, 1, 5, 3, 0, 1,
+	1, 1, 1, 7, 3, 3, 5, 4, 6, 3,
+	5, 1, 3, 1, 2, 2, 3, 4, 2, 3,
+	5, 1, 1, 1, 1, 1, 3, 1, 1, 6,
+	4, 4, 12, 2, 0, 1, 3, 3, 6, 4,
+	1, 2, 2, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 2, 3, 3, 2,
+	1, 3, 3, 3, 3, 1, 3, 3, 2, 1,
+	1, 3, 1, 1, 1, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 0, 1, 1, 2, 2, 2, 4, 2,
+	4, 4, 3, 3, 4, 2, 2, 0, 2, 0,
+	2, 0, 6, 9, 13, 1, 0, 1, 3, 1,
+	1, 1, 3, 2, 0, 3, 1, 2, 2, 1,
+	1, 2, 4, 2, 3, 5, 6, 7, 3, 1,
+	4, 5, 5, 10, 1, 1, 4, 0, 3, 0,
+	2, 2, 2, 0, 1, 1, 2, 2, 0, 3,
+	3, 2, 1, 1, 2, 2, 1, 2, 1, 4,
+	10, 13, 1, 0, 1, 3, 3, 3, 5, 2,
+	0, 1, 1, 0, 6, 6, 1, 1, 6, 6,
+	8, 6, 8, 8, 10, 8, 10, 1, 0, 2,
+	0, 3, 2, 2, 1, 0, 1, 0, 3, 3,
+	6, 3, 6, 1, 3, 1, 4, 2, 8, 5,
+	0, 4, 3, 0, 9, 1, 3, 1, 1, 3,
+	5, 5, 1, 1, 3, 3, 1, 2, 3, 2,
+	3, 4, 1, 1, 8, 8, 1, 2, 4, 4,
+	4, 2, 2, 3, 1, 3, 6, 1, 1, 1,
+	1, 1, 0, 1, 0, 1, 1, 0, 1, 1,
+	0, 1, 0, 3, 1, 3, 2, 2, 2, 1,
+	1, 2, 1, 0, 2, 3, 1, 1, 1, 1,
+	3, 0, 2, 0, 2, 3, 2, 0, 1, 3,
+	2, 2, 1, 4, 3, 4, 5, 4, 5, 4,
+	5, 2, 4, 1, 1, 0, 2, 2, 2, 1,
+	1, 0, 4, 2, 1, 2, 2, 4, 1, 3,
+	1, 2, 3, 2, 0, 2, 5, 2, 2, 3,
+	0, 1, 1, 1, 1, 2, 4, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 5, 0, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 3, 0, 1, 1, 1, 1, 5, 2, 1,
+	1, 1, 1, 4, 1, 2, 2, 1, 1, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 0, 1, 4, 1,
+	3, 3, 5, 2, 2, 2, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 4, 4, 5, 3, 4, 3,
-	3, 4, 3, 4, 3, 4, 5, 6, 6, 7,
-	6, 7, 6, 7, 3, 4, 1, 3, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 5,
-	6, 6, 7, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 3, 5, 6, 8, 6,
-	6, 4, 4, 1, 1, 1, 5, 1, 3, 1,
-	3, 1, 1, 1, 1, 6, 4, 4, 4, 4,
-	6, 5, 5, 5, 4, 8, 6, 6, 4, 4,
-	4, 5, 0, 5, 0, 2, 0, 1, 3, 3,
-	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
-	0, 1, 4, 2, 2, 2, 2, 2, 4, 3,
-	5, 4, 3, 5, 1, 3, 1, 3, 3, 3,
-	2, 1, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 4, 3, 2, 3, 0, 3, 3, 2, 2,
-	1, 0, 2, 2, 3, 2, 1, 1, 3, 5,
-	1, 2, 4, 2, 0, 1, 0, 2, 2, 2,
-	3, 5, 1, 2, 1, 0, 1, 1, 1, 3,
-	3, 1, 0, 1, 3, 3, 2, 1, 1, 1,
-	3, 1, 2, 1, 3, 3, 0, 1, 2, 1,
-	1, 1, 1, 6, 2, 3, 5, 1, 1, 1,
-	1, 2, 2, 1, 1, 1, 1, 0, 1, 1,
+	3, 3, 2, 2, 3, 4, 3, 4, 4, 5,
+	3, 4, 3, 3, 4, 3, 4, 3, 4, 5,
+	6, 6, 7, 6, 7, 6, 7, 3, 4, 1,
+	3, 2, 2, 2, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 5, 6, 6, 7, 1, 1, 1, 3,
+	6, 8, 1, 1, 1, 2, 2, 2, 1, 1,
+	3, 5, 6, 8, 6, 6, 4, 4, 1, 1,
+	1, 5, 1, 3, 1, 3, 1, 1, 1, 1,
+	6, 4, 4, 4, 4, 6, 5, 5, 5, 4,
+	8, 6, 6, 4, 4, 4, 5, 0, 5, 0,
+	2, 0, 1, 3, 3, 2, 2, 0, 6, 1,
+	0, 3, 0, 2, 2, 0, 1, 4, 2, 2,
+	2, 2, 2, 4, 3, 5, 4, 3, 5, 1,
+	3, 1, 3, 3, 3, 2, 1, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 4, 3, 2, 3,
+	0, 3, 3, 2, 2, 1, 0, 2, 2, 3,
+	2, 1, 1, 3, 5, 1, 2, 4, 2, 0,
+	1, 0, 2, 2, 2, 3, 5, 1, 2, 1,
+	0, 1, 1, 1, 3, 3, 1, 0, 1, 3,
+	3, 2, 1, 1, 1, 3, 1, 2, 1, 3,
+	3, 0, 1, 2, 1, 1, 1, 1, 6, 2,
+	3, 5, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3102,349 +3511,381 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -5, -9, -10, -11, -13,
-	-14, -16, -17, -18, -19, -20, -21, -22, -23, 19,
-	-6, -7, -8, -190, 81, 87, 99, 178, -24, -25,
-	191, 192, 29, 50, 180, 216, 56, -189, -27, -26,
-	260, 236, 242, 187, -28, 204, 229, 263, 204, 68,
-	109, 76, 112, 223, 68, 109, 204, -12, 260, -18,
-	-14, -23, -9, -208, 18, -209, -210, 56, 81, 99,
-	187, 112, 76, 223, -208, -101, 130, 189, 212, -102,
-	-100, -162, 208, 138, -61, -38, 4, -169, -171, 16,
-	17, 19, 28, 29, 33, 37, 39, 49, 50, 51,
-	53, 55, 58, 59, 66, 67, 68, 69, 71, 76,
-	80, 81, 87, 91, 92, 94, 100, 105, 112, 120,
-	122, 127, 134, 135, 136, 137, 140, 141, 143, 146,
-	148, 149, 155, 158, 161, 162, 165, 168, 169, 171,
-	172, 174, 175, 176, 178, 180, 181, 183, 185, 186,
-	188, 191, 192, 194, 196, 198, 201, 199, 205, 206,
-	216, 217, 218, 219, 222, 223, 226, 227, 228, 232,
-	237, 238, 239, 240, 30, 31, 32, 34, 35, 38,
-	42, 43, 45, 70, 72, 73, 86, 88, 93, 101,
-	103, 106, 107, 113, 114, 115, 117, 125, 145, 147,
-	156, 160, 164, 166, 170, 182, 195, 200, 202, 209,
-	213, 214, 229, 230, 4, 68, 49, 69, 100, 109,
-	205, 208, 212, 18, -213, 212, -213, -213, -212, 204,
-	204, -90, 68, 221, -26, -27, -25, -51, -52, 220,
-	116, 85, 154, -24, -25, -189, -191, 171, -188, -38,
-	130, 138, 189, 212, 208, -191, -48, -49, 18, 78,
-	264, -133, -42, 152, -38, -73, 260, -3, -133, 106,
-	-38, -42, 106, 97, 118, -134, -133, -38, 106, -60,
-	106, -42, -62, 106, -61, -138, -137, -165, 4, -169,
-	-171, -170, 229, 47, 57, 98, 111, 119, 121, 126,
-	128, 139, 157, 159, 179, 193, 151, 264, 151, -101,
-	-101, -37, 120, 210, 245, 97, 240, -45, 6, 74,
-	-65, 262, 97, -205, 151, 97, -161, 97, 240, 120,
-	-36, -37, -76, -133, -61, 106, 109, -38, 106, -51,
-	-52, -75, -93, -94, 129, 150, -77, 18, 78, -77,
-	-77, 37, 261, 261, 264, -191, -56, 260, -67, -66,
-	-135, -108, 253, -110, 251, 252, 257, 142, 241, -117,
-	-42, -111, 9, 260, -120, -186, -25, 86, 24, -118,
-	-119, 182, -38, 8, 5, 6, 7, -40, -141, -150,
-	215, 89, 144, 40, -184, -185, 4, -169, -164, -142,
-	-152, -146, -149, 117, 47, 61, 64, 62, 65, 190,
-	224, 41, 88, 160, 164, 202, 213, 214, 106, 145,
-	107, 45, 101, 125, 80, 31, 32, 34, 35, 42,
-	43, 70, 72, 73, 93, 113, 114, 115, 147, 170,
-	195, 209, 230, -170, -153, -154, -147, -148, -155, -66,
-	-73, 253, -42, 260, -71, -107, 262, 265, 258, -72,
-	-125, -108, 74, -33, 174, -32, 17, 19, 81, 227,
-	86, 174, 174, 86, -134, -43, -42, 191, -38, 25,
-	86, -35, 264, 39, 176, 86, 264, 86, 261, 264,
-	-204, -60, 204, 68, -210, -204, 127, -160, 74, -167,
-	-159, -126, 9, 215, 89, 151, -166, 5, 252, -158,
-	-165, 6, 8, 251, -160, 74, 59, -168, 6, 4,
-	-150, -126, 74, 130, 117, 262, -163, 4, -169, -171,
-	-170, -172, 18, 20, 21, 22, 23, 24, 25, 26,
-	27, 36, 40, 41, 44, 46, 48, 54, 56, 60,
-	61, 62, 63, 64, 65, 74, 75, 77, 78, 79,
-	82, 83, 85, 89, 90, 95, 96, 97, 99, 102,
-	104, 108, 109, 110, 116, 118, 123, 124, 129, 131,
-	132, 142, 144, 150, 151, 152, 153, 154, 163, 167,
-	173, 177, 187, 190, 197, 203, 204, 207, 210, 211,
-	215, 220, 221, 224, 225, 231, 233, 234, 235, 236,
-	-162, -207, 95, -204, -162, -162, 127, -35, 264, 260,
-	142, -39, 106, -38, 142, -75, -94, -93, -95, -108,
-	18, -108, -110, -26, -26, -26, -53, -129, -108, -188,
-	25, -55, -38, -58, 97, 264, 10, 46, 28, 251,
-	252, 253, 254, 255, 248, 249, 250, 247, 243, 244,
-	245, 52, 133, 184, 12, 13, 14, 22, 153, 128,
-	241, 193, 119, 30, 108, 25, 4, -108, -108, -108,
-	-108, -108, 159, -25, -108, -63, -71, -25, -114, 258,
-	260, -71, 260, 6, 6, 260, -121, -108, -192, 237,
-	95, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 166, -157, 232, -157,
-	-157, -143, 260, -143, -144, 260, -143, 260, -58, -42,
-	-107, -163, 253, -163, -108, 264, 261, 264, 210, -91,
-	54, 48, -104, 106, 48, -173, -38, 54, -174, 44,
-	221, 167, 96, -91, 54, -91, 54, 54, -133, 210,
-	210, -42, -106, 234, -97, -18, 260, 74, 25, -68,
-	-69, -136, -70, -42, 260, -38, -38, -42, -60, -61,
-	-62, -12, -137, 210, -60, -55, 97, -44, 169, 175,
-	196, 188, 264, 5, 8, 8, 6, -163, -206, -38,
-	-133, -46, -47, -105, -104, -175, -173, 109, 221, 86,
-	151, 142, 86, -96, 182, 183, 264, -31, 26, 77,
-	260, 264, 261, -106, -59, -131, -133, -25, -132, 260,
-	-135, -139, -140, -142, -151, -145, -149, -150, 33, 38,
-	206, 200, 113, 114, 115, 195, 31, 170, 93, 80,
-	73, 72, 147, 35, 34, -153, -154, -147, -148, 70,
-	209, 32, 43, 42, 230, -61, 208, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, 128, 193,
-	30, 108, 210, 144, 142, 215, 89, 222, 78, 148,
-	-214, 203, 27, -112, -25, 260, -163, -117, 182, 260,
-	261, 264, -63, -116, 259, -108, -114, -63, 261, 261,
-	-63, 231, 18, 78, 253, -85, 239, 136, 71, 105,
-	135, -86, 186, 8, -124, -123, 233, -193, 91, 102,
-	260, 261, 261, -108, -64, -156, 4, 239, 136, 71,
-	105, 135, 186, -81, -108, -82, -109, -110, 251, 252,
-	257, 260, 182, -83, -108, -63, -108, 36, 124, 211,
-	-84, -108, 97, -63, -108, -108, -108, -63, -63, -63,
-	260, 8, 8, 8, -106, 261, 259, 266, -125, -32,
-	-42, -38, -38, 142, -104, 106, -139, -38, 260, 260,
-	122, 122, -38, -38, 106, -38, 106, -38, -38, -33,
-	174, -38, -38, 174, -108, -99, 151, -60, 229, -38,
-	-58, 264, 245, -60, -35, -206, -206, 219, 51, 169,
-	-167, -85, 264, 261, 264, -39, 109, -61, -42, 86,
-	-38, -129, -15, -18, -14, -23, -9, -38, -74, 102,
-	264, 57, -80, 121, 139, 98, 126, 179, 111, -128,
-	-127, 25, -38, -128, -25, -132, -131, -57, 24, -85,
-	260, 240, -108, 210, -214, 203, -112, -108, 144, 215,
-	89, 222, 78, 148, 97, 260, -109, -109, -63, 260,
-	-63, -108, 264, 259, 259, 264, 261, -52, 264, -51,
-	-108, -63, -63, 261, 210, 210, 210, 210, 260, 261,
-	-122, -123, 82, -108, -198, 158, 260, 260, -108, 25,
-	261, 97, 261, -87, 163, 261, 10, 251, 252, 253,
-	254, 255, 248, 249, 250, 247, 243, 244, 245, 52,
-	133, 184, 12, 13, 14, 119, 108, -109, -109, -109,
-	-63, 260, 261, -88, -89, 97, 95, 25, -84, -84,
-	-84, 261, 97, -63, 264, 264, 264, 261, 261, 261,
-	8, 261, 264, 261, 261, -74, -108, 210, 210, 86,
-	142, -176, -174, -108, -55, 260, 260, -29, 81, 191,
-	-92, 86, -35, 86, -35, 210, -91, 54, 210, 53,
-	261, -106, -69, -125, 261, -38, -105, 260, -39, 260,
-	260, -38, 261, -113, 104, 37, -131, 121, 121, -131,
-	-80, 121, -78, 157, -78, -78, -38, 260, 261, 258,
-	258, 8, -108, -108, -109, -109, 97, 260, -108, -115,
-	-139, 22, 22, 261, -63, 261, 264, 261, -108, -114,
-	261, 231, -52, -52, -52, 136, 105, 135, -86, 135,
-	-86, -86, 8, 6, 83, -108, 207, -199, -38, 260,
-	234, -51, 261, -139, -108, -88, -108, -139, -109, -109,
-	-109, -109, -109, -109, -109, -109, -109, -109, -109, -109,
-	-109, -109, -109, -109, -109, -109, 78, 142, 148, -109,
-	264, -63, 261, -89, -88, -108, -108, -139, 261, 261,
-	261, -63, -108, -108, -108, 261, 8, -113, 259, -38,
-	-38, -104, 86, -177, 54, -178, 46, 142, 144, 221,
-	167, 44, 74, 173, 261, 261, -55, -55, 142, 74,
-	142, 74, 67, 217, -38, -38, -42, -38, -38, -38,
-	-98, 260, 151, -18, 245, -55, 260, -46, -54, -130,
-	-38, -187, 260, -184, -185, -40, 151, -194, 235, -108,
-	-63, -131, -131, -79, 225, 151, 121, -131, 260, -55,
-	-127, 259, 8, 8, 261, 22, 22, -108, -115, 261,
-	264, -108, -108, 261, -108, 6, -108, 261, 261, 261,
-	261, -108, -203, -38, -108, 261, 261, -89, 97, 78,
-	148, 260, -108, 261, 261, 264, 261, 261, 261, -194,
-	-104, -38, -61, 144, 122, 260, -109, -42, -103, -211,
-	55, 201, 261, 261, 144, 144, -108, -139, -35, -35,
-	210, 210, 79, -54, 54, -73, -25, 260, 261, -55,
-	261, 261, 264, -41, -71, 46, -41, -108, 260, -42,
-	-195, -197, -38, -79, 260, -108, -131, -55, 261, 259,
-	259, -108, -108, 261, -139, 261, -52, -196, 162, 261,
-	-109, 97, 260, -115, 261, -108, -178, -108, -50, 260,
-	260, 173, -34, 46, -38, -38, 223, 143, 261, -38,
-	-103, 261, -103, -130, -31, -61, -31, 261, -63, 260,
-	264, 25, -55, 261, 261, -52, 37, -109, -115, 261,
-	261, 261, -181, 134, -55, -55, -42, -30, 225, -61,
-	191, -106, -103, -41, -52, -54, -197, -199, 261, -200,
-	168, 183, -63, 261, -179, -182, -180, 151, 98, 161,
-	194, 261, 261, -50, -108, -68, -31, 261, 261, 261,
-	-201, -202, 30, 218, 59, -108, -201, -180, 151, -182,
-	151, 223, 76, -181, -106, -103, -202, 165, 94, 182,
-	165, 94, -183, 141, 176, 39, 191, -183, -179, 22,
-	16, 144, 74, -202,
+	-1000, -1, -2, -3, -4, -5, -6, -7, -8, -9,
+	-10, -15, -16, -17, -18, -20, -21, -22, -24, -25,
+	-26, -27, -28, -29, -30, -31, -32, -33, 24, -227,
+	34, 56, -12, -13, -11, -14, -206, 91, 96, 98,
+	111, 121, 192, 195, -34, -35, 210, 211, 35, 57,
+	198, 236, 248, 26, 25, 64, -205, -37, -36, 281,
+	257, 263, 206, -38, 224, 250, 284, 224, 77, 124,
+	-52, -48, 4, -185, -187, 21, 22, 24, 33, 34,
+	35, 39, 43, 45, 55, 56, 57, 58, 60, 61,
+	63, 66, 67, 68, 75, 76, 77, 78, 80, 85,
+	90, 91, 96, 98, 99, 103, 104, 106, 112, 117,
+	121, 123, 127, 135, 137, 142, 145, 149, 150, 151,
+	152, 153, 155, 156, 158, 161, 163, 164, 170, 173,
+	176, 177, 180, 183, 184, 186, 187, 189, 190, 191,
+	192, 193, 195, 197, 198, 199, 201, 203, 204, 205,
+	207, 208, 210, 211, 213, 215, 217, 218, 221, 219,
+	225, 226, 232, 236, 237, 238, 239, 240, 243, 244,
+	247, 248, 249, 253, 258, 259, 260, 261, 36, 37,
+	38, 40, 41, 44, 48, 49, 51, 79, 81, 82,
+	97, 100, 105, 113, 115, 118, 119, 128, 129, 130,
+	132, 140, 160, 162, 171, 175, 179, 181, 185, 200,
+	214, 220, 222, 228, 229, 233, 234, 250, 251, -220,
+	-70, 224, 77, -52, 166, 85, 127, 244, 77, 197,
+	124, 224, -19, -27, -22, -32, -15, -19, 26, 281,
+	-224, -48, 23, -225, -226, 85, 91, 127, 244, 64,
+	111, 206, 224, -220, -224, -48, -117, 145, 208, 232,
+	-118, -116, -178, 228, 153, -71, -48, 4, 77, 55,
+	78, 112, 124, 225, 228, 232, 23, -230, 232, -230,
+	-230, -229, 224, -220, 224, 99, -105, 77, 197, 242,
+	-36, -37, -35, -61, -62, 241, 131, 95, 169, -34,
+	-35, -205, -207, 186, -204, -48, -207, -58, -59, 23,
+	88, 285, -149, -52, 167, -88, 281, -3, -149, 118,
+	-48, -52, 118, -86, -123, 283, 287, 279, 230, 285,
+	-70, -65, -48, 224, 54, 109, 133, -150, -149, -48,
+	118, -48, -70, 118, -73, 118, -71, 87, -19, -154,
+	-153, -181, 26, 4, -185, -187, -186, 250, 53, 65,
+	110, 120, 126, 134, 136, 141, 143, 154, 172, 174,
+	196, 212, 166, 230, 285, -71, 109, 166, 109, -117,
+	-117, -47, 135, 230, 266, 109, 261, -55, 6, 83,
+	-80, 283, 109, -221, 166, 109, -177, 109, 261, 135,
+	-46, -47, -91, -149, -71, 118, 224, 124, -48, 118,
+	-48, -61, -62, -90, -108, -109, 144, 165, -92, 23,
+	88, -92, -92, 43, 282, 282, 285, -207, -66, 281,
+	-82, -81, -151, -124, 275, -126, 273, 274, 268, 157,
+	262, -133, -52, -127, 9, 281, -136, -202, -35, 97,
+	29, -134, -135, 200, -48, 8, 5, 6, 7, -50,
+	-157, -166, 235, 101, 159, 46, -200, -201, 4, -185,
+	-180, -158, -168, -162, -165, 132, 53, 70, 73, 71,
+	74, 209, 245, 47, 100, 175, 179, 222, 233, 234,
+	118, 160, 119, 51, 113, 140, 90, 37, 38, 40,
+	41, 48, 49, 79, 81, 82, 105, 128, 129, 130,
+	162, 185, 214, 229, 251, -186, -169, -170, -163, -164,
+	-171, -81, -88, 275, -52, 281, -87, -141, -124, 83,
+	-43, 217, 203, 60, 189, -42, 22, 24, 91, 248,
+	210, 191, 97, 60, 189, 189, 97, -123, -179, 275,
+	4, -185, -187, -186, -188, 23, 25, 26, 27, 28,
+	29, 30, 31, 32, 42, 46, 47, 50, 52, 54,
+	62, 64, 69, 70, 71, 72, 73, 74, 83, 84,
+	86, 87, 88, 89, 92, 93, 95, 101, 102, 107,
+	108, 109, 111, 114, 116, 122, 124, 125, 131, 133,
+	138, 139, 144, 146, 147, 157, 159, 165, 166, 167,
+	168, 169, 178, 182, 188, 194, 206, 209, 216, 223,
+	224, 227, 230, 231, 235, 241, 242, 245, 246, 252,
+	254, 255, 256, 257, -179, -124, -124, -52, 285, -52,
+	-52, -150, -53, -52, 210, -48, 30, 97, -45, 45,
+	193, 97, 285, 97, 201, 282, 285, -220, -222, -48,
+	-226, 91, 127, 85, 244, 281, -74, -124, -220, -222,
+	142, -176, 83, -183, -175, -142, 9, 235, 101, 166,
+	-182, 5, 274, -174, -181, 6, 8, 273, -176, 83,
+	68, -184, 6, 4, -166, -142, 83, 145, 132, 283,
+	-179, -178, -223, 107, -220, -178, -178, 142, -45, 285,
+	281, 157, -71, -49, 118, -48, 157, -90, -109, -108,
+	-111, -124, 23, -124, -126, -36, -36, -36, -63, -145,
+	-124, -204, 30, -65, -68, 109, 285, 10, 52, 33,
+	273, 274, 275, 276, 277, 270, 271, 272, 269, 264,
+	265, 266, 59, 148, 202, 12, 13, 14, 268, 15,
+	16, 17, 18, 19, 27, 168, 143, 262, 120, 212,
+	134, 36, 122, 30, 4, -124, -124, -124, -124, -124,
+	174, -35, -124, -74, -86, -35, -130, 279, 281, -86,
+	281, 6, 6, 281, -137, -124, -208, 258, 107, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 181, -173, 253, -173, -173, -159,
+	281, -159, -160, 281, -159, 281, -68, -52, 285, 282,
+	285, 33, 261, 230, -106, 62, 54, -120, 118, 54,
+	-189, -48, 62, -190, 50, 242, 182, 108, -106, 62,
+	-106, 62, 62, 237, 237, -149, 261, 230, 230, -52,
+	280, 286, -76, 123, -48, 134, 134, -122, 255, -113,
+	-27, 281, 83, 30, -83, -84, -152, -85, -52, 281,
+	-48, -48, -70, -71, -73, -19, -153, 230, 285, -56,
+	-57, -121, -120, -191, -189, 124, 242, 285, 109, -54,
+	184, 190, 215, 207, 285, 5, 8, 8, 6, -179,
+	-222, -149, -56, 97, 281, 166, 157, 97, -112, 200,
+	201, 285, -41, 31, 86, 281, 282, -122, -69, -147,
+	-149, -35, -148, 281, -151, -155, -156, -158, -167, -161,
+	-165, -166, 39, 44, 226, 220, 128, 129, 130, 214,
+	37, 185, 105, 90, 82, 81, 162, 41, 40, -169,
+	-170, -163, -164, 79, 229, 38, 49, 48, 251, -71,
+	228, -124, -124, -124, -124, -124, -124, -124, -124, -124,
+	-124, -124, -124, -124, -124, -124, -124, -124, -124, -124,
+	-124, -124, -124, -124, -124, -124, -124, -124, 143, 120,
+	212, 36, 122, -124, 230, 159, 157, 235, 101, 243,
+	88, 163, -231, 223, 32, -128, -35, 281, -179, -133,
+	200, 281, 282, 285, -74, -132, 280, -130, -74, 282,
+	282, -74, 252, 23, 88, 275, -100, 260, 151, 80,
+	117, 150, -101, 205, 8, -140, -139, 254, -209, 103,
+	114, 281, 282, 282, -124, -75, -172, 4, 260, 151,
+	80, 117, 150, 205, -96, -124, -97, -125, -126, 273,
+	274, 268, 281, 200, -98, -124, -74, -124, 42, 139,
+	231, -99, -124, 109, -74, -124, -124, -124, -74, -74,
+	-74, 281, 8, 8, 8, -122, 282, -141, -42, 281,
+	-124, -52, -48, -48, 157, -120, 118, -155, -48, 281,
+	281, 137, 137, -48, -48, 118, -48, 118, -48, -48,
+	-124, -43, 189, -124, -48, -48, 189, -124, 109, -72,
+	6, 159, -72, -62, -61, -124, -115, 166, -70, 250,
+	-48, -68, 285, 266, -70, -45, -222, -48, 282, 285,
+	-49, 124, -124, -222, 240, 58, 184, -183, -100, 282,
+	-71, -56, -52, 97, -48, -145, -23, -27, -22, -32,
+	-15, -89, 114, 285, 65, -95, 136, 154, 110, 141,
+	196, 126, -144, -143, 30, -48, -144, -35, -148, -147,
+	-67, 29, 279, -100, 281, 261, -124, -124, 230, -231,
+	223, -128, -124, 159, 235, 101, 243, 88, 163, 109,
+	281, -125, -125, -74, 281, -74, 279, -124, 280, 280,
+	285, 282, -62, 285, -124, -74, -74, 282, 230, 230,
+	230, 230, 281, 282, -138, -139, 92, -124, -214, 173,
+	281, 281, -124, 30, 282, 109, 282, -102, 178, 282,
+	10, 273, 274, 275, 276, 277, 270, 271, 272, 269,
+	264, 265, 266, 59, 148, 202, 12, 13, 14, 134,
+	122, -125, -125, -125, -74, 281, 282, -103, -104, 109,
+	107, 30, -99, -99, -99, 282, 109, -74, 285, 285,
+	285, 282, 282, 282, 8, 282, 285, 282, 282, -89,
+	-74, 230, 230, 97, 157, -192, -190, -124, -65, 281,
+	281, -39, 91, 210, -107, 97, -45, 97, -45, 166,
+	230, -106, 62, 230, 280, -74, -110, -108, 61, 282,
+	-122, -84, -141, 282, 66, -121, 281, -49, 281, 282,
+	281, -48, 282, -129, 116, 43, -147, 136, 136, -147,
+	-95, 136, -93, 172, -93, -93, -48, 281, 282, 279,
+	280, 8, 8, -124, -124, -125, -125, 109, 281, -124,
+	-131, -155, 27, 27, 282, -74, 282, 285, -124, 282,
+	-130, 282, 252, -62, -62, -62, 151, 117, 150, -101,
+	150, -101, -101, 8, 6, 93, -124, 227, -215, -48,
+	281, 255, -61, 282, -155, -124, -103, -124, -155, -125,
+	-125, -125, -125, -125, -125, -125, -125, -125, -125, -125,
+	-125, -125, -125, -125, -125, -125, -125, 88, 157, 163,
+	-125, 285, -74, 282, -104, -103, -124, -124, -155, 282,
+	282, 282, -74, -124, -124, -124, 282, 8, -129, 282,
+	-48, -48, -120, 97, -193, 62, -194, 52, 157, 159,
+	242, 182, 50, 83, 166, 188, 282, 282, -65, -65,
+	157, 83, 157, 83, 76, 238, -48, -48, -48, -52,
+	-48, -48, -48, -114, 281, 166, -27, -62, 266, 76,
+	-65, 281, -56, 66, -64, -146, -48, -203, 281, -200,
+	-201, -50, 166, -210, 256, -124, -74, -147, -147, -94,
+	246, 166, 136, -147, 281, -65, -143, 8, 280, 282,
+	27, 27, -124, -131, 282, 285, -124, -124, 282, -124,
+	280, 286, 6, -124, 282, 282, 282, 282, -124, -219,
+	-48, -124, 282, 282, -104, 109, 88, 163, 281, -124,
+	282, 282, 285, 282, 282, 282, -210, -120, -48, -71,
+	159, 137, 281, -125, 244, -52, -119, -228, 63, 221,
+	282, 282, 159, 159, -124, -155, -45, -45, 230, 230,
+	89, -64, 62, -110, -88, -35, 281, 281, 282, -65,
+	282, 76, 282, 285, -51, -86, 52, -51, -124, 281,
+	-52, -211, -213, -48, -94, 281, -124, -147, -65, 282,
+	280, -124, -124, 282, -155, 282, -124, -62, -212, 177,
+	282, -125, 109, 281, -131, 282, -124, -194, -124, -125,
+	-60, 281, 281, 188, -44, 52, -48, -48, 244, 158,
+	282, -48, -74, -119, 282, 281, -119, -146, -41, -71,
+	-41, 282, -74, 281, 285, 30, -65, 282, 280, 282,
+	-62, 43, -125, -131, 282, 282, 282, -197, 149, -65,
+	-65, -52, -40, 246, -71, 210, -122, 282, -119, -74,
+	-51, -62, -64, -213, -215, 282, -216, 183, 201, -74,
+	282, -195, -198, -196, 166, 110, 176, 213, 282, 282,
+	-60, -124, -83, -78, 257, 282, -41, 282, 282, 282,
+	-217, -218, 36, 239, 68, -124, -217, -196, 166, -198,
+	166, 244, 85, -197, -122, -79, -77, -48, -78, -119,
+	-218, 180, 106, 200, 180, 106, -199, 156, 193, 45,
+	210, -199, -195, 285, 266, 27, 21, 159, 83, -77,
+	-124, -218,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	48, 49, 50, 0, 0, 289, 0, 0, 259, -2,
-	0, 0, 233, 233, 233, 291, 205, 288, -2, 299,
-	0, 0, 0, 297, 273, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 66,
-	67, 68, 69, 0, 78, 79, 80, 82, 83, 84,
-	85, 86, 87, 88, 0, 91, 733, 764, 774, 95,
-	100, 0, 824, -2, 104, 60, 683, 684, 685, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 734, 735, 736, 737, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
-	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
-	763, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	775, 776, 777, 778, 779, 780, 781, 782, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 818, 819, 820, 821, 822, 823, 825,
-	826, 827, 828, 829, 130, 131, 0, 133, 143, 0,
-	141, 0, 0, 139, 235, 232, 230, 231, 0, 290,
-	0, 0, 0, 204, -2, 269, 270, -2, 0, 294,
-	294, 294, 0, 0, 270, 0, 278, 752, 281, 666,
-	733, 738, 764, 774, 824, 279, 652, 0, 296, 295,
-	0, 274, 349, 0, 661, 319, 0, 2, 0, 806,
-	0, 0, 806, 0, 0, 0, 355, 52, 806, 43,
-	806, 659, 56, 806, 58, 0, 70, 72, 691, 692,
-	693, 694, 828, 830, 831, 832, 833, 834, 835, 836,
-	837, 838, 839, 840, 841, 842, 0, 0, 0, 92,
-	93, 94, 0, 0, 0, 0, 0, 103, 125, 126,
-	61, 0, 0, 145, 0, 0, 136, 0, 137, 0,
-	229, 234, 43, 353, 0, 806, 687, 237, 806, -2,
-	0, 265, 306, 307, 0, 0, 0, 292, 293, 0,
-	0, 0, 261, 262, 0, 280, 0, 0, 322, 651,
-	653, 657, 658, 434, 0, 0, 0, 0, 0, 0,
-	513, 514, 515, 0, 517, 518, 519, 801, 0, 523,
-	524, 820, 661, 669, 670, 671, 672, 0, 0, 0,
-	677, 678, 679, 636, 562, 533, -2, -2, 667, 376,
-	377, 378, 379, -2, 830, 537, 539, 541, 542, 543,
-	544, 0, 802, 816, 817, 823, 826, 827, 806, 813,
-	807, 797, 804, 812, 721, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 690, 400, 401, 406, 407, 409, 322,
-	320, 350, 351, 0, 662, 642, 0, 0, 0, 0,
-	648, 646, 647, 20, 226, 22, 0, 226, 226, 0,
-	0, 0, 0, 0, 359, 0, 240, 0, 356, 0,
-	0, 54, 0, 41, 42, 0, 0, 0, 289, 0,
-	0, 75, 0, 717, 81, 0, 0, 96, 98, 105,
-	107, 108, 109, 115, 116, 117, 118, 198, 0, 200,
-	128, 129, 680, 0, 97, 99, 101, 102, 119, 120,
-	0, 122, 123, 124, 417, 0, 62, 695, 696, 697,
-	698, 699, 843, 844, 845, 846, 847, 848, 849, 850,
-	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
-	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
-	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
-	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
-	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27, 0, 0,
+	0, 0, 69, 70, 71, 72, 0, 0, 332, 332,
+	0, 0, 0, 0, 302, -2, 0, 0, 275, 275,
+	275, 334, 0, 64, 65, 243, 331, -2, 342, 0,
+	0, 0, 340, 316, 0, 0, -2, 0, 0, 0,
+	63, 716, 738, 739, 740, 755, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 771, 772, 773, 774, 775, 776, 777, 778, 779,
+	780, 781, 782, 783, 784, 785, 786, 787, 788, 789,
+	790, 791, 792, 793, 794, 795, 796, 797, 798, 799,
+	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
+	810, 811, 812, 813, 814, 815, 816, 817, 818, 819,
+	820, 821, 822, 823, 824, 825, 826, 827, 828, 829,
+	830, 831, 832, 833, 834, 835, 836, 837, 838, 839,
+	840, 841, 842, 843, 844, 845, 846, 847, 848, 849,
+	850, 851, 852, 853, 854, 855, 856, 857, 858, 859,
+	860, 861, 862, 863, 864, 865, 866, 867, 868, 869,
+	870, 871, 872, 873, 874, 875, 876, 877, 878, 879,
+	880, 881, 882, 883, 884, 885, 886, 887, 888, 889,
+	890, 891, 892, 893, 894, 895, 896, 897, 898, 0,
+	110, 0, 776, 714, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 92, 93, 94, 88, 332, 0,
+	0, 0, 113, 114, 115, -2, -2, -2, -2, 117,
+	119, 120, 0, 0, 0, 0, 126, 796, 831, 842,
+	130, 135, 0, 893, -2, 139, 83, 166, 167, 0,
+	169, 179, 0, 177, 0, 0, 175, 277, 274, 272,
+	273, 0, 333, 165, 0, 0, 0, 0, 0, 242,
+	-2, 312, 313, -2, 0, 337, 337, 337, 0, 0,
+	313, 0, 321, 815, 324, 721, 322, 707, 0, 339,
+	338, 0, 317, 394, 0, 364, 0, 2, 0, 875,
+	0, 0, 875, 717, 697, 0, 0, 0, 0, 0,
+	111, 112, 718, 0, 0, 0, 0, 0, 400, 74,
+	875, 75, 58, 875, 79, 875, 81, 0, 89, 0,
+	95, 97, 98, 746, 747, 748, 749, 897, 899, 900,
 	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
-	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
-	132, 134, 0, 142, 135, 140, 138, 201, 0, 149,
-	0, 0, 806, 686, 0, 268, 304, 305, 308, 311,
-	312, 309, 434, 275, 276, 277, 300, 301, 215, 282,
-	0, 0, 663, 359, 0, 0, 0, 0, 0, 0,
+	911, 912, 0, 0, 0, 0, 0, 0, 0, 127,
+	128, 129, 0, 0, 0, 0, 0, 138, 160, 161,
+	84, 0, 0, 181, 0, 0, 172, 0, 173, 0,
+	271, 276, 58, 398, 0, 875, 0, 742, 279, 875,
+	281, -2, 0, 308, 349, 350, 0, 0, 0, 335,
+	336, 0, 0, 0, 304, 305, 0, 323, 0, 0,
+	367, 706, 708, 712, 713, 479, 0, 0, 0, 0,
+	0, 0, 566, 567, 568, 0, 572, 573, 574, 870,
+	0, 578, 579, 889, 716, 724, 725, 726, 727, 0,
+	0, 0, 732, 733, 734, 691, 617, 588, -2, -2,
+	722, 421, 422, 423, 424, -2, 899, 592, 594, 596,
+	597, 598, 599, 0, 871, 885, 886, 892, 895, 896,
+	875, 882, 876, 866, 873, 881, 780, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, 745, 445, 446, 451, 452,
+	454, 367, 365, 395, 396, 0, 0, 703, 701, 702,
+	29, 0, 32, 0, 268, 35, 0, 268, 268, 0,
+	0, 0, 0, 0, 0, 0, 0, 698, 692, 693,
+	750, 751, 752, 753, 754, 913, 914, 915, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 955, 956, 957,
+	958, 959, 960, 961, 962, 963, 964, 965, 966, 967,
+	968, 969, 970, 971, 972, 973, 974, 975, 976, 977,
+	978, 979, 980, 981, 982, 983, 984, 985, 986, 987,
+	988, 989, 990, 991, 694, 0, 68, 715, 0, 0,
+	0, 404, 0, 283, 0, 401, 0, 0, 77, 56,
+	57, 0, 0, 0, 87, 332, 0, 0, 100, 124,
+	116, 118, 121, 122, 123, 186, 101, 649, 0, 109,
+	0, 131, 133, 140, 142, 143, 144, 150, 151, 152,
+	153, 236, 0, 238, 163, 164, 735, 0, 132, 134,
+	136, 137, 154, 155, 0, 157, 158, 159, 462, 0,
+	85, 168, 170, 0, 178, 171, 176, 174, 239, 0,
+	186, 0, 0, 0, 875, 741, 0, 311, 347, 348,
+	351, 356, 357, 354, 479, 318, 319, 320, 343, 344,
+	253, 325, 0, 0, 404, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 0, 0, 656, 438, 439, 440,
-	461, 462, 0, -2, 594, 0, 520, 521, 522, 0,
-	0, -2, 0, 674, 431, 0, 0, 635, 564, 0,
-	0, 0, 0, 0, 0, 0, 615, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 390, 403, 413, 411,
-	410, 392, 0, 391, 389, 0, 393, 0, 359, 0,
-	643, 637, 638, 639, 0, 0, 650, 0, 0, 0,
-	0, 225, 24, 806, 0, 34, 0, 0, 171, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 51, 0, 247, 242, 0, 0, 0, 322,
-	252, 254, 255, 0, 0, 357, 53, 660, 43, 59,
-	57, 65, 71, 0, 76, 77, 0, 236, 0, 0,
-	113, 114, 0, 199, 682, 681, 431, 63, 144, 89,
-	354, 0, 148, 150, 152, 153, 154, 687, 0, 0,
-	0, 0, 0, 310, 313, 314, 0, 303, 213, 214,
-	289, 0, 665, 316, 321, 323, 340, 340, 327, 0,
-	654, 435, 365, 366, 367, 368, 369, 431, 372, 373,
-	374, 375, 383, 384, 385, 386, 387, 388, 397, 0,
-	382, 382, 382, 394, 395, 398, 399, 404, 405, 415,
-	416, 414, 414, 414, 412, 436, 0, 441, 442, 443,
-	444, 445, 446, 447, 448, 449, -2, -2, -2, 453,
-	454, 455, -2, -2, -2, 459, 460, -2, 0, 0,
-	645, 0, 0, 467, 0, 470, 472, 474, 0, 0,
-	0, 0, 644, 484, 627, 0, 655, 469, 0, 0,
-	516, 0, 0, 0, 600, 594, 601, 0, -2, 525,
-	299, 0, 0, 0, 0, 675, 418, 419, 420, 421,
-	422, 423, 432, 0, 634, 630, 0, 572, 0, 0,
-	0, 538, 540, 0, 0, 0, 604, 605, 606, 607,
-	608, 609, 610, 0, 0, 0, 0, 486, 0, 0,
-	0, 0, 820, 0, 594, 620, 0, 0, 0, 0,
-	0, 594, 0, 626, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 352, 640, 0, 649, 23,
-	217, 0, 0, 0, 26, 806, 157, 0, 0, 0,
-	0, 0, 228, 35, 806, 43, 806, 43, 36, 21,
-	226, 216, 219, 0, 358, 239, 0, 0, 244, 241,
-	359, 0, 0, 0, 55, 73, 74, 110, 111, 112,
-	106, 121, 0, 146, 0, 0, 687, 0, 0, 0,
-	238, 302, 0, 284, 285, 286, 287, 664, 318, 0,
-	0, 0, 0, 0, 0, 346, 346, 346, 344, 325,
-	339, 0, 338, 326, -2, 327, 0, 360, 362, 370,
-	0, 0, -2, 0, 0, 0, 485, -2, 468, 471,
-	473, 475, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 595, 0, 598, 599, 0, -2, 0, 0, 298,
-	299, 299, 299, 531, 0, 0, 0, 0, 0, 0,
-	0, 631, 0, 0, 532, 0, 0, 0, 0, 0,
-	546, 0, 547, 0, 0, 548, 0, 0, 0, 0,
+	0, 700, 0, 0, 711, 483, 484, 485, 512, 513,
+	0, -2, 649, 0, 575, 576, 577, 0, 0, -2,
+	0, 729, 476, 0, 0, 690, 619, 0, 0, 0,
+	0, 0, 0, 0, 670, 676, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 448, 458, 456, 455, 437,
+	0, 436, 434, 0, 438, 0, 404, 0, 0, 705,
+	0, 0, 0, 0, 0, 0, 267, 37, 875, 0,
+	47, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 53, 0, 0, 0, 0, 0,
+	695, 0, 66, 0, 719, 0, 0, 342, 0, 290,
+	285, 0, 0, 0, 367, 295, 297, 298, 0, 0,
+	402, 76, 58, 82, 80, 90, 96, 0, 0, 0,
+	185, 187, 189, 190, 191, 742, 0, 0, 0, 278,
+	0, 0, 148, 149, 0, 237, 737, 736, 476, 86,
+	180, 399, 0, 0, 186, 0, 0, 0, 355, 358,
+	359, 0, 346, 251, 252, 332, 720, 361, 366, 368,
+	385, 385, 372, 0, 709, 480, 410, 411, 412, 413,
+	414, 476, 417, 418, 419, 420, 428, 429, 430, 431,
+	432, 433, 442, 0, 427, 427, 427, 439, 440, 443,
+	444, 449, 450, 460, 461, 459, 459, 459, 457, 481,
+	0, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	-2, -2, -2, 498, 499, 500, -2, -2, -2, 504,
+	505, 506, 507, 508, 509, 510, 511, -2, 0, 0,
+	0, 700, 0, -2, 0, 520, 0, 523, 525, 527,
+	0, 0, 0, 0, 699, 537, 682, 0, 710, 522,
+	0, 0, 569, 0, 0, 0, 655, 656, 0, -2,
+	580, 342, 0, 0, 0, 0, 730, 463, 464, 465,
+	466, 467, 468, 477, 0, 689, 685, 0, 627, 0,
+	0, 0, 593, 595, 0, 0, 0, 659, 660, 661,
+	662, 663, 664, 665, 0, 0, 0, 0, 539, 0,
+	0, 0, 0, 889, 0, 649, 675, 0, 0, 0,
+	0, 0, 649, 0, 681, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 361, 397, 704, 36, 0,
+	34, 259, 0, 0, 0, 39, 875, 194, 0, 0,
+	0, 0, 0, 270, 48, 875, 58, 875, 58, 49,
+	0, 30, 268, 33, 258, 261, 0, 0, 0, 254,
+	256, 257, 255, 353, 341, 403, 282, 0, 0, 287,
+	284, 404, 0, 0, 0, 78, 99, 125, 0, 0,
+	0, 742, 650, 108, 145, 146, 147, 141, 156, 182,
+	0, 0, 0, 0, 280, 345, 0, 327, 328, 329,
+	330, 363, 0, 0, 0, 0, 0, 0, 391, 391,
+	391, 389, 370, 384, 0, 383, 371, -2, 372, 0,
+	405, 407, 0, 415, 0, 0, -2, -2, 0, 0,
+	0, 538, -2, 521, 524, 526, 528, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 650, 653, 654,
+	0, -2, 0, 0, 342, 342, 342, 586, 0, 0,
+	0, 0, 0, 0, 0, 686, 0, 0, 587, 0,
+	0, 0, 0, 0, 601, 0, 602, 0, 0, 603,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 488, 489, 490,
-	0, 0, 549, 618, 619, 0, 0, 0, 0, 0,
-	0, 554, 0, 625, 0, 0, 0, 558, 559, 560,
-	0, 380, 0, 396, 408, 318, 0, 0, 0, 0,
-	0, 155, 170, 0, 0, 0, 0, 28, 0, 0,
-	0, 0, 32, 0, 38, 0, 0, 0, 0, 250,
-	0, 251, 253, 256, 0, 90, 151, 0, 0, 149,
-	0, 0, 283, 566, 0, 0, 324, 0, 0, 0,
-	0, 0, 341, 345, 342, 343, 336, 0, 329, 0,
-	0, 0, 437, -2, 0, 0, 0, 0, -2, 0,
-	596, 0, 0, 628, 0, 589, 0, -2, 595, 602,
-	526, 0, 0, 0, 0, 424, 425, 426, 427, 428,
-	429, 430, 0, 676, 629, 633, 0, 570, 571, 575,
-	0, 0, 536, 0, 603, 612, 613, 487, 491, 492,
-	493, 494, 495, 496, 497, 498, 499, -2, -2, -2,
-	503, 504, 505, -2, -2, -2, 0, 0, 0, 614,
-	0, 0, 592, 616, 617, 622, 623, 0, 551, 552,
-	553, 624, 0, 0, 0, 402, 0, 566, 641, 221,
-	223, 25, 0, 156, 0, 159, 0, 0, 162, 163,
-	0, 0, 0, 0, 172, 179, 0, 0, 0, 40,
-	0, 0, 227, 0, 43, 43, 218, 0, 0, 220,
-	0, 0, 0, 243, 0, 0, 0, 0, 0, 206,
-	212, 212, 0, 534, 535, 0, 0, 271, 0, 317,
-	315, 330, 0, 332, 0, 0, 0, 334, 0, 0,
-	328, 363, 0, 0, 371, 0, 0, -2, 0, 478,
-	0, -2, -2, 588, 595, 673, 299, 527, 529, 530,
-	433, 632, 577, 574, 0, 561, 545, 611, 0, 0,
-	0, 0, 595, 591, 550, 0, 556, 557, 381, 272,
-	27, 0, 160, 161, 164, 0, 166, 181, 173, 0,
-	176, 177, 174, 0, 29, 30, 39, 45, 31, 37,
-	0, 0, 0, 0, 0, 257, 258, 0, 179, 0,
-	147, 179, 0, 215, 668, 0, 215, 0, 0, 0,
-	565, 567, 0, 331, 0, 348, 333, 0, 337, 364,
-	361, -2, -2, 479, 597, 590, 0, 299, 0, 563,
-	-2, 0, 0, 0, 593, 0, 158, 0, 185, 0,
-	0, 0, 47, 0, 222, 224, 0, 246, 359, 249,
-	168, 179, 202, 207, 208, 211, 209, 212, 299, 0,
-	0, 0, 0, 335, 528, 580, 0, -2, 0, 511,
-	555, 165, 190, 0, 0, 0, 181, 33, 0, 44,
-	0, 248, 169, 215, 0, 0, 568, 569, 347, 0,
-	0, 0, 576, 512, 167, 186, 187, 0, 182, 183,
-	184, 180, 178, 185, 46, 359, 210, 526, 179, 573,
-	578, 581, -2, 777, 714, 0, 579, 188, 0, 189,
-	0, 0, 0, 190, 245, 203, 0, 583, 584, 585,
-	586, 587, 191, 0, 194, 195, 0, 192, 175, 0,
-	193, 196, 197, 582,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 541, 542, 543, 0, 0, 604, 673, 674, 0,
+	0, 0, 0, 0, 0, 609, 0, 680, 0, 0,
+	0, 613, 614, 615, 0, 425, 0, 441, 453, 363,
+	0, 0, 0, 0, 0, 192, 208, 0, 0, 0,
+	0, 41, 0, 0, 0, 0, 45, 0, 51, 0,
+	0, 0, 0, 0, 696, 67, 73, 352, 293, 0,
+	342, 296, 299, 0, 0, 188, 0, 0, 186, 0,
+	0, 0, 326, 621, 0, 0, 369, 0, 0, 0,
+	0, 0, 386, 390, 387, 388, 381, 0, 374, 0,
+	408, 0, 0, 482, -2, 0, 0, 0, 0, -2,
+	0, 651, 0, 0, 683, 0, 644, 0, 0, -2,
+	657, 581, 0, 0, 0, 0, 469, 470, 471, 472,
+	473, 474, 475, 0, 731, 684, 688, 0, 625, 626,
+	630, 0, 0, 591, 0, 658, 667, 668, 540, 544,
+	545, 546, 547, 548, 549, 550, 551, 552, -2, -2,
+	-2, 556, 557, 558, -2, -2, -2, 0, 0, 0,
+	669, 0, 0, 647, 671, 672, 677, 678, 0, 606,
+	607, 608, 679, 0, 0, 0, 447, 0, 621, 31,
+	263, 265, 38, 0, 193, 0, 196, 0, 0, 199,
+	200, 0, 0, 0, 0, 0, 210, 217, 0, 0,
+	0, 55, 0, 0, 269, 0, 58, 58, 52, 260,
+	0, 0, 262, 0, 0, 0, 286, 353, 0, 0,
+	0, 0, 0, 0, 0, 244, 250, 250, 0, 589,
+	590, 0, 0, 314, 0, 362, 360, 375, 0, 377,
+	0, 0, 0, 379, 0, 0, 373, 0, 409, 416,
+	0, 0, -2, 0, 531, 0, -2, -2, 643, 650,
+	570, 0, 728, 342, 582, 584, 585, 478, 687, 632,
+	629, 0, 616, 600, 666, 0, 0, 0, 0, 650,
+	646, 605, 0, 611, 612, 426, 315, 40, 0, 197,
+	198, 201, 0, 203, 0, 219, 211, 0, 214, 215,
+	212, 0, 42, 43, 54, 60, 44, 50, 0, 0,
+	0, 0, 0, 294, 300, 301, 0, 0, 217, 0,
+	183, 0, 217, 0, 253, 723, 0, 253, 0, 0,
+	0, 620, 622, 0, 376, 0, 393, 378, 0, 382,
+	406, -2, -2, 532, 652, 645, 0, 0, 342, 0,
+	618, -2, 0, 0, 0, 648, 0, 195, 0, 204,
+	223, 0, 0, 0, 62, 0, 264, 266, 0, 289,
+	404, 292, 0, 206, 217, 0, 240, 245, 246, 249,
+	247, 250, 342, 0, 0, 0, 0, 380, 571, 583,
+	635, 0, -2, 0, 564, 610, 202, 228, 0, 0,
+	0, 219, 46, 0, 59, 0, 291, 104, 207, 0,
+	253, 0, 0, 623, 624, 392, 0, 0, 0, 631,
+	565, 205, 224, 225, 0, 220, 221, 222, 218, 216,
+	223, 61, 404, 102, 0, 104, 248, 581, 217, 628,
+	633, 636, -2, 846, 773, 0, 634, 226, 0, 227,
+	0, 0, 0, 228, 288, 103, 105, 0, 184, 241,
+	0, 638, 639, 640, 641, 642, 229, 0, 232, 233,
+	0, 230, 213, 0, 0, 0, 231, 234, 235, 106,
+	107, 637,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 249, 3, 255, 250, 3,
-	260, 261, 253, 251, 264, 252, 262, 254, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 266, 263,
-	243, 245, 244, 3, 265, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 271, 3, 277, 272, 3,
+	281, 282, 275, 273, 285, 274, 283, 276, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 286, 284,
+	264, 266, 265, 3, 287, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 258, 3, 259, 248, 3, 3, 3, 3, 3,
+	3, 279, 3, 280, 270, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 247, 3, 257,
+	3, 3, 3, 3, 269, 3, 268,
 }
 var sqlTok2 = [...]int{
 
@@ -3472,10 +3913,12 @@ var sqlTok2 = [...]int{
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
 	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
 	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 246, 256,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257,
 }
 var sqlTok3 = [...]int{
-	0,
+	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
+	57605, 263, 57606, 267, 57607, 278, 0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -3817,13 +4260,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:439
+		//line sql.y:460
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:445
+		//line sql.y:466
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -3831,7 +4274,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:451
+		//line sql.y:472
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -3839,549 +4282,681 @@ sqldefault:
 				sqlVAL.stmts = nil
 			}
 		}
-	case 13:
+	case 21:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:470
+		//line sql.y:499
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 19:
+	case 28:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:479
+		//line sql.y:509
 		{
 			sqlVAL.stmt = nil
 		}
-	case 20:
+	case 29:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:485
+		//line sql.y:515
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
-	case 21:
+	case 30:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:489
+		//line sql.y:519
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
 		}
-	case 22:
+	case 31:
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+		//line sql.y:527
+		{
+			sqlVAL.stmt = &Split{Table: sqlDollar[3].qname, Exprs: sqlDollar[7].exprs}
+		}
+	case 32:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:531
+		{
+			sqlVAL.stmt = &Scatter{Table: sqlDollar[3].qname}
+		}
+	case 33:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:537
+		{
+			sqlVAL.stmt = &SetZoneConfig{Database: Name(sqlDollar[3].str), YAMLConfig: sqlDollar[6].expr}
+		}
+	case 34:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:541
+		{
+			sqlVAL.stmt = &SetZoneConfig{Table: sqlDollar[3].qname, YAMLConfig: sqlDollar[6].expr}
+		}
+	case 35:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:495
+		//line sql.y:547
 		{
 			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
 		}
-	case 23:
+	case 36:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:499
+		//line sql.y:551
 		{
 			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
-	case 24:
+	case 37:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:506
+		//line sql.y:558
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: false, ColumnDef: sqlDollar[2].colDef}
 		}
-	case 25:
+	case 38:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:511
+		//line sql.y:563
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: true, ColumnDef: sqlDollar[5].colDef}
 		}
-	case 26:
+	case 39:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:516
+		//line sql.y:568
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: false, ColumnDef: sqlDollar[3].colDef}
 		}
-	case 27:
+	case 40:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:521
+		//line sql.y:573
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: sqlDollar[6].colDef}
 		}
-	case 28:
+	case 41:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:525
+		//line sql.y:577
 		{
 			unimplemented()
 		}
-	case 29:
+	case 42:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:527
+		//line sql.y:579
 		{
 			unimplemented()
 		}
-	case 30:
+	case 43:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:581
 		{
 			unimplemented()
 		}
-	case 31:
+	case 44:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:532
+		//line sql.y:584
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: sqlDollar[5].str}
 		}
-	case 32:
+	case 45:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:537
+		//line sql.y:589
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: sqlDollar[3].str}
 		}
-	case 33:
+	case 46:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:542
+		//line sql.y:594
 		{
 		}
-	case 34:
+	case 47:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:545
+		//line sql.y:597
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddConstraint{ConstraintDef: sqlDollar[2].constraintDef}
 		}
-	case 35:
+	case 48:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:549
+		//line sql.y:601
 		{
 			unimplemented()
 		}
-	case 36:
+	case 49:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:551
+		//line sql.y:603
 		{
 			unimplemented()
 		}
-	case 37:
+	case 50:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:554
+		//line sql.y:606
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: true, Constraint: sqlDollar[5].str}
 		}
-	case 38:
+	case 51:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:559
+		//line sql.y:611
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: false, Constraint: sqlDollar[3].str}
 		}
-	case 39:
+	case 52:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:616
+		{
+			sqlVAL.alterTableCmd = &AlterTableSetTTL{TTL: sqlDollar[3].expr, Column: sqlDollar[5].str}
+		}
+	case 53:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:621
+		{
+			sqlVAL.alterTableCmd = &AlterTableSetTTL{}
+		}
+	case 54:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:564
+		//line sql.y:626
 		{
 			unimplemented()
 		}
-	case 40:
+	case 55:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:565
+		//line sql.y:627
 		{
 			unimplemented()
 		}
-	case 41:
+	case 56:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:568
+		//line sql.y:630
 		{
 			unimplemented()
 		}
-	case 42:
+	case 57:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:569
+		//line sql.y:631
 		{
 			unimplemented()
 		}
-	case 43:
+	case 58:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:570
+		//line sql.y:632
 		{
 		}
-	case 44:
+	case 59:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:573
+		//line sql.y:635
 		{
 			unimplemented()
 		}
-	case 45:
+	case 60:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:574
+		//line sql.y:636
 		{
 		}
-	case 46:
+	case 61:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:577
+		//line sql.y:639
 		{
 			unimplemented()
 		}
-	case 47:
+	case 62:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:578
+		//line sql.y:640
 		{
 		}
-	case 51:
+	case 63:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:645
+		{
+			sqlVAL.stmt = &Analyze{Table: sqlDollar[2].qname}
+		}
+	case 66:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:589
+		//line sql.y:656
 		{
-			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
+			sqlVAL.stmt = &Backup{Targets: sqlDollar[2].targetList, To: sqlDollar[4].expr, IncrementalFrom: sqlDollar[5].exprs}
 		}
-	case 52:
+	case 67:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:662
+		{
+			sqlVAL.exprs = sqlDollar[3].exprs
+		}
+	case 68:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:666
+		{
+			sqlVAL.exprs = nil
+		}
+	case 73:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:680
+		{
+			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr), OrderBy: sqlDollar[6].orderBy, Limit: sqlDollar[7].limit}
+		}
+	case 74:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:596
+		//line sql.y:687
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 53:
+	case 75:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:691
+		{
+			sqlVAL.stmt = &DropRole{Name: Name(sqlDollar[3].str)}
+		}
+	case 76:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:600
+		//line sql.y:695
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 54:
+	case 77:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:604
+		//line sql.y:699
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 55:
+	case 78:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:608
+		//line sql.y:703
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 56:
+	case 79:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:612
+		//line sql.y:707
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 57:
+	case 80:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:711
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 58:
+	case 81:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:622
+		//line sql.y:717
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 59:
+	case 82:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:626
+		//line sql.y:721
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 60:
+	case 83:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:632
+		//line sql.y:727
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 61:
+	case 84:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:636
+		//line sql.y:731
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 62:
+	case 85:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:642
+		//line sql.y:737
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 63:
+	case 86:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:646
+		//line sql.y:741
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 64:
+	case 87:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:748
+		{
+			sqlVAL.stmt = &Execute{Statement: sqlDollar[2].stmt, DiscardRows: true}
+		}
+	case 88:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:653
+		//line sql.y:756
 		{
 			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 65:
+	case 89:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:760
+		{
+			sqlVAL.stmt = &Explain{Options: []string{"ANALYZE"}, Statement: sqlDollar[3].stmt}
+		}
+	case 90:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:657
+		//line sql.y:764
 		{
 			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 66:
+	case 91:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:663
+		//line sql.y:770
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 70:
+	case 95:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:672
+		//line sql.y:779
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 71:
+	case 96:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:676
+		//line sql.y:783
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 73:
+	case 99:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:686
+		//line sql.y:795
 		{
 			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 74:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:693
+	case 100:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:799
 		{
-			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
+			sqlVAL.stmt = &GrantRole{Role: Name(sqlDollar[2].str), Members: NameList(sqlDollar[4].strs)}
 		}
-	case 75:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:700
+	case 101:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:806
 		{
-			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
+			sqlVAL.stmt = &Restore{Targets: sqlDollar[2].targetList, From: sqlDollar[4].exprs}
 		}
-	case 76:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:704
+	case 102:
+		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
+		//line sql.y:814
 		{
-			// TODO(marc): this is postgres' grammar, but do we really need
-			// both "x" and "TABLE X"?
-			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
+			sqlVAL.stmt = &Import{Table: sqlDollar[3].qname, Defs: sqlDollar[5].tblDefs, Files: sqlDollar[10].exprs, Options: sqlDollar[12].kvOptions}
 		}
-	case 77:
+	case 103:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:710
+		//line sql.y:820
 		{
-			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
+			sqlVAL.kvOptions = sqlDollar[2].kvOptions
 		}
-	case 78:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:717
+	case 104:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:824
 		{
-			sqlVAL.privilegeList = privilege.List{privilege.ALL}
+			sqlVAL.kvOptions = nil
 		}
-	case 79:
+	case 105:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:720
+		//line sql.y:830
 		{
+			sqlVAL.kvOptions = KVOptions{sqlDollar[1].kvOption}
 		}
-	case 80:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:724
+	case 106:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:834
 		{
-			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
+			sqlVAL.kvOptions = append(sqlDollar[1].kvOptions, sqlDollar[3].kvOption)
 		}
-	case 81:
+	case 107:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:728
+		//line sql.y:840
 		{
-			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
+			sqlVAL.kvOption = KVOption{Key: Name(sqlDollar[1].str), Value: sqlDollar[3].expr}
 		}
-	case 82:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:735
+	case 108:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:848
 		{
-			sqlVAL.privilegeType = privilege.CREATE
+			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 83:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:739
+	case 109:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:852
+		{
+			sqlVAL.stmt = &RevokeRole{Role: Name(sqlDollar[2].str), Members: NameList(sqlDollar[4].strs)}
+		}
+	case 110:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:859
+		{
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
+		}
+	case 111:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:863
+		{
+			// TODO(marc): this is postgres' grammar, but do we really need
+			// both "x" and "TABLE X"?
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
+		}
+	case 112:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:869
+		{
+			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
+		}
+	case 113:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:876
+		{
+			sqlVAL.privilegeList = privilege.List{privilege.ALL}
+		}
+	case 114:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:879
+		{
+		}
+	case 115:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:883
+		{
+			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
+		}
+	case 116:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:887
+		{
+			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
+		}
+	case 117:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:894
+		{
+			sqlVAL.privilegeType = privilege.CREATE
+		}
+	case 118:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:898
 		{
 			sqlVAL.privilegeType = privilege.DROP
 		}
-	case 84:
+	case 119:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:743
+		//line sql.y:902
 		{
 			sqlVAL.privilegeType = privilege.GRANT
 		}
-	case 85:
+	case 120:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:747
+		//line sql.y:906
 		{
 			sqlVAL.privilegeType = privilege.SELECT
 		}
-	case 86:
+	case 121:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:751
+		//line sql.y:910
 		{
 			sqlVAL.privilegeType = privilege.INSERT
 		}
-	case 87:
+	case 122:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:755
+		//line sql.y:914
 		{
 			sqlVAL.privilegeType = privilege.DELETE
 		}
-	case 88:
+	case 123:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:759
+		//line sql.y:918
 		{
 			sqlVAL.privilegeType = privilege.UPDATE
 		}
-	case 89:
+	case 124:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:767
+		//line sql.y:926
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 90:
+	case 125:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:771
+		//line sql.y:930
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 91:
+	case 126:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:779
+		//line sql.y:938
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 92:
+	case 127:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:783
+		//line sql.y:942
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 93:
+	case 128:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:787
+		//line sql.y:946
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 94:
+	case 129:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:793
+		//line sql.y:952
 		{
 			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 96:
+	case 131:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:800
+		//line sql.y:959
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 97:
+	case 132:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:804
+		//line sql.y:963
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 98:
+	case 133:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:808
+		//line sql.y:967
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 99:
+	case 134:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:812
+		//line sql.y:971
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 101:
+	case 136:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:819
+		//line sql.y:978
 		{
 			unimplemented()
 		}
-	case 102:
+	case 137:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:822
+		//line sql.y:981
 		{
 			sqlVAL.stmt = &SetTimeZone{Value: sqlDollar[3].expr}
 		}
-	case 103:
+	case 138:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:825
+		//line sql.y:984
 		{
 			unimplemented()
 		}
-	case 105:
+	case 140:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:832
+		//line sql.y:991
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 106:
+	case 141:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:836
+		//line sql.y:995
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 109:
+	case 144:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:844
+		//line sql.y:1003
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 110:
+	case 145:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:850
+		//line sql.y:1009
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 111:
+	case 146:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:855
+		//line sql.y:1014
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 112:
+	case 147:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:860
+		//line sql.y:1019
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 113:
+	case 148:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:865
+		//line sql.y:1024
 		{
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 114:
+	case 149:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:1028
 		{
 			sqlVAL.isoLevel = SerializableIsolation
 		}
-	case 115:
+	case 150:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:875
+		//line sql.y:1034
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 116:
+	case 151:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:879
+		//line sql.y:1038
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 117:
+	case 152:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:883
+		//line sql.y:1042
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 119:
+	case 154:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:898
+		//line sql.y:1057
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 120:
+	case 155:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:902
+		//line sql.y:1061
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 121:
+	case 156:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:906
+		//line sql.y:1065
 		{
 			// TODO(pmattis): support opt_interval?
 			expr := &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
@@ -4397,250 +4972,262 @@ sqldefault:
 			}
 			sqlVAL.expr = d
 		}
-	case 123:
+	case 158:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:923
+		//line sql.y:1082
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 124:
+	case 159:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:927
+		//line sql.y:1086
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 125:
+	case 160:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:932
+		//line sql.y:1091
 		{
 			unimplemented()
 		}
-	case 126:
+	case 161:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:933
+		//line sql.y:1092
 		{
 			unimplemented()
 		}
-	case 127:
+	case 162:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:1093
 		{
 		}
-	case 128:
+	case 163:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:938
+		//line sql.y:1097
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 129:
+	case 164:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:942
+		//line sql.y:1101
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 130:
+	case 165:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1108
+		{
+			sqlVAL.stmt = &Validate{Targets: sqlDollar[2].targetList}
+		}
+	case 166:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:948
+		//line sql.y:1114
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 131:
+	case 167:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:952
+		//line sql.y:1118
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 132:
+	case 168:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:1122
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 133:
+	case 169:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:960
+		//line sql.y:1126
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 134:
+	case 170:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:964
+		//line sql.y:1130
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 135:
+	case 171:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:968
+		//line sql.y:1134
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 136:
+	case 172:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:972
+		//line sql.y:1138
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 137:
+	case 173:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:976
+		//line sql.y:1142
 		{
 			sqlVAL.stmt = &Show{Name: "TIME ZONE"}
 		}
-	case 138:
+	case 174:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:980
+		//line sql.y:1146
 		{
 			sqlVAL.stmt = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
 		}
-	case 139:
+	case 175:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:984
+		//line sql.y:1150
 		{
 			sqlVAL.stmt = nil
 		}
-	case 140:
+	case 176:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:990
+		//line sql.y:1156
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 141:
+	case 177:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:994
+		//line sql.y:1160
 		{
 			sqlVAL.qname = nil
 		}
-	case 142:
+	case 178:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1000
+		//line sql.y:1166
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 143:
+	case 179:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1005
+		//line sql.y:1171
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 144:
+	case 180:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1177
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 145:
+	case 181:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1181
 		{
 			sqlVAL.strs = nil
 		}
-	case 146:
+	case 182:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1022
+		//line sql.y:1188
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs}
 		}
-	case 147:
+	case 183:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1026
+		//line sql.y:1192
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs}
 		}
-	case 149:
+	case 184:
+		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
+		//line sql.y:1196
+		{
+			sqlVAL.stmt = &CreateExternalTable{Table: sqlDollar[4].qname, Defs: sqlDollar[6].tblDefs, Files: sqlDollar[11].exprs, Options: sqlDollar[13].kvOptions}
+		}
+	case 186:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1033
+		//line sql.y:1203
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 150:
+	case 187:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1039
+		//line sql.y:1209
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 151:
+	case 188:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1043
+		//line sql.y:1213
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 152:
+	case 189:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1049
+		//line sql.y:1219
 		{
 			sqlVAL.tblDef = sqlDollar[1].colDef
 		}
-	case 154:
+	case 191:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1054
+		//line sql.y:1224
 		{
 			sqlVAL.tblDef = sqlDollar[1].constraintDef
 		}
-	case 155:
+	case 192:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1060
+		//line sql.y:1230
 		{
 			sqlVAL.colDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colQuals)
 		}
-	case 156:
+	case 193:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1066
+		//line sql.y:1236
 		{
 			sqlVAL.colQuals = append(sqlDollar[1].colQuals, sqlDollar[2].colQual)
 		}
-	case 157:
+	case 194:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1070
+		//line sql.y:1240
 		{
 			sqlVAL.colQuals = nil
 		}
-	case 158:
+	case 195:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1076
+		//line sql.y:1246
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colQual = sqlDollar[3].colQual
 		}
-	case 160:
+	case 197:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1081
+		//line sql.y:1251
 		{
 			unimplemented()
 		}
-	case 161:
+	case 198:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1097
+		//line sql.y:1267
 		{
 			sqlVAL.colQual = NotNullConstraint{}
 		}
-	case 162:
+	case 199:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1101
+		//line sql.y:1271
 		{
 			sqlVAL.colQual = NullConstraint{}
 		}
-	case 163:
+	case 200:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1105
+		//line sql.y:1275
 		{
 			sqlVAL.colQual = UniqueConstraint{}
 		}
-	case 164:
+	case 201:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1109
+		//line sql.y:1279
 		{
 			sqlVAL.colQual = PrimaryKeyConstraint{}
 		}
-	case 165:
+	case 202:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1112
+		//line sql.y:1282
 		{
 			unimplemented()
 		}
-	case 166:
+	case 203:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1114
+		//line sql.y:1284
 		{
 			if ContainsVars(sqlDollar[2].expr) {
 				sqllex.Error("default expression contains a variable")
@@ -4652,15 +5239,29 @@ sqldefault:
 			}
 			sqlVAL.colQual = &ColumnDefault{Expr: sqlDollar[2].expr}
 		}
-	case 167:
+	case 204:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1296
+		{
+			if ContainsVars(sqlDollar[3].expr) {
+				sqllex.Error("on update expression contains a variable")
+				return 1
+			}
+			if containsSubquery(sqlDollar[3].expr) {
+				sqllex.Error("on update expression contains a subquery")
+				return 1
+			}
+			sqlVAL.colQual = &ColumnOnUpdate{Expr: sqlDollar[3].expr}
+		}
+	case 205:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1125
+		//line sql.y:1307
 		{
 			unimplemented()
 		}
-	case 168:
+	case 206:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1129
+		//line sql.y:1311
 		{
 			sqlVAL.tblDef = &IndexTableDef{
 				Name:    Name(sqlDollar[2].str),
@@ -4668,9 +5269,9 @@ sqldefault:
 				Storing: sqlDollar[6].strs,
 			}
 		}
-	case 169:
+	case 207:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1137
+		//line sql.y:1319
 		{
 			sqlVAL.tblDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4680,28 +5281,28 @@ sqldefault:
 				},
 			}
 		}
-	case 170:
+	case 208:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1152
+		//line sql.y:1334
 		{
 			sqlVAL.constraintDef = sqlDollar[3].constraintDef
 			sqlVAL.constraintDef.setName(Name(sqlDollar[2].str))
 		}
-	case 171:
+	case 209:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1157
+		//line sql.y:1339
 		{
 			sqlVAL.constraintDef = sqlDollar[1].constraintDef
 		}
-	case 172:
+	case 210:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1162
+		//line sql.y:1344
 		{
 			unimplemented()
 		}
-	case 173:
+	case 211:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1164
+		//line sql.y:1346
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4710,9 +5311,9 @@ sqldefault:
 				},
 			}
 		}
-	case 174:
+	case 212:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1173
+		//line sql.y:1355
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4721,498 +5322,528 @@ sqldefault:
 				PrimaryKey: true,
 			}
 		}
-	case 175:
+	case 213:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1182
+		//line sql.y:1364
 		{
 			unimplemented()
 		}
-	case 178:
+	case 216:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1199
+		//line sql.y:1381
 		{
 			sqlVAL.strs = sqlDollar[3].strs
 		}
-	case 179:
+	case 217:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1203
+		//line sql.y:1385
 		{
 			sqlVAL.strs = nil
 		}
-	case 180:
+	case 218:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1209
+		//line sql.y:1391
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 181:
+	case 219:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1213
+		//line sql.y:1395
 		{
 			sqlVAL.strs = nil
 		}
-	case 182:
+	case 220:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1218
+		//line sql.y:1400
 		{
 			unimplemented()
 		}
-	case 183:
+	case 221:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1401
 		{
 			unimplemented()
 		}
-	case 184:
+	case 222:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1220
+		//line sql.y:1402
 		{
 			unimplemented()
 		}
-	case 185:
+	case 223:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1221
+		//line sql.y:1403
 		{
 		}
-	case 186:
+	case 224:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1228
+		//line sql.y:1412
 		{
 			unimplemented()
 		}
-	case 187:
+	case 225:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1229
+		//line sql.y:1413
 		{
 			unimplemented()
 		}
-	case 188:
+	case 226:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1230
+		//line sql.y:1414
 		{
 			unimplemented()
 		}
-	case 189:
+	case 227:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1231
+		//line sql.y:1415
 		{
 			unimplemented()
 		}
-	case 190:
+	case 228:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1232
+		//line sql.y:1416
 		{
 		}
-	case 191:
+	case 229:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1419
 		{
 			unimplemented()
 		}
-	case 192:
+	case 230:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1238
+		//line sql.y:1422
 		{
 			unimplemented()
 		}
-	case 193:
+	case 231:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1241
+		//line sql.y:1425
 		{
 			unimplemented()
 		}
-	case 194:
+	case 232:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1426
 		{
 			unimplemented()
 		}
-	case 195:
+	case 233:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1243
+		//line sql.y:1427
 		{
 			unimplemented()
 		}
-	case 196:
+	case 234:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1244
+		//line sql.y:1428
 		{
 			unimplemented()
 		}
-	case 197:
+	case 235:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1429
 		{
 			unimplemented()
 		}
-	case 198:
+	case 236:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1433
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 199:
+	case 237:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1253
+		//line sql.y:1437
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 200:
+	case 238:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1257
+		//line sql.y:1441
 		{
 			sqlVAL.expr = DInt(sqlDollar[1].ival)
 		}
-	case 201:
+	case 239:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1264
+		//line sql.y:1448
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 202:
+	case 240:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1271
+		//line sql.y:1455
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:    Name(sqlDollar[4].str),
 				Table:   sqlDollar[6].qname,
 				Unique:  sqlDollar[2].boolVal,
-				Columns: sqlDollar[8].strs,
+				Columns: sqlDollar[8].idxElems,
 				Storing: sqlDollar[10].strs,
 			}
 		}
-	case 203:
+	case 241:
 		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1281
+		//line sql.y:1465
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:        Name(sqlDollar[7].str),
 				Table:       sqlDollar[9].qname,
 				Unique:      sqlDollar[2].boolVal,
 				IfNotExists: true,
-				Columns:     sqlDollar[11].strs,
+				Columns:     sqlDollar[11].idxElems,
 				Storing:     sqlDollar[13].strs,
 			}
 		}
-	case 204:
+	case 242:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1294
+		//line sql.y:1478
 		{
 			sqlVAL.boolVal = true
 		}
-	case 205:
+	case 243:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1298
+		//line sql.y:1482
 		{
 			sqlVAL.boolVal = false
 		}
-	case 206:
+	case 244:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1304
+		//line sql.y:1488
 		{
-			sqlVAL.strs = []string{sqlDollar[1].str}
+			sqlVAL.idxElems = IndexElemList{sqlDollar[1].idxElem}
 		}
-	case 207:
+	case 245:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1308
+		//line sql.y:1492
 		{
-			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
+			sqlVAL.idxElems = append(sqlDollar[1].idxElems, sqlDollar[3].idxElem)
 		}
-	case 208:
+	case 246:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1317
+		//line sql.y:1501
 		{
-			// TODO(pmattis): Support opt_asc_desc.
-			sqlVAL.str = sqlDollar[1].str
+			sqlVAL.idxElem = IndexElem{Column: Name(sqlDollar[1].str), Direction: sqlDollar[3].dir}
 		}
-	case 209:
+	case 247:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1321
+		//line sql.y:1504
 		{
 			unimplemented()
 		}
-	case 210:
+	case 248:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1322
+		//line sql.y:1505
 		{
 			unimplemented()
 		}
-	case 211:
+	case 249:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1325
+		//line sql.y:1508
 		{
 			unimplemented()
 		}
-	case 212:
+	case 250:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1326
+		//line sql.y:1509
 		{
 		}
-	case 213:
+	case 251:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1330
+		//line sql.y:1513
 		{
 			sqlVAL.dir = Ascending
 		}
-	case 214:
+	case 252:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1334
+		//line sql.y:1517
 		{
 			sqlVAL.dir = Descending
 		}
-	case 215:
+	case 253:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1338
+		//line sql.y:1521
 		{
 			sqlVAL.dir = DefaultDirection
 		}
-	case 216:
+	case 254:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1528
+		{
+			sqlVAL.stmt = &CommentOnTable{Table: sqlDollar[4].qname, Comment: sqlDollar[6].strPtr}
+		}
+	case 255:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1532
+		{
+			sqlVAL.stmt = &CommentOnColumn{Column: sqlDollar[4].qname, Comment: sqlDollar[6].strPtr}
+		}
+	case 256:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1538
+		{
+			s := sqlDollar[1].str
+			sqlVAL.strPtr = &s
+		}
+	case 257:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1543
+		{
+			sqlVAL.strPtr = nil
+		}
+	case 258:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1345
+		//line sql.y:1550
 		{
 			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 217:
+	case 259:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1554
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[3].qname, NewName: sqlDollar[6].qname, IfExists: false}
 		}
-	case 218:
+	case 260:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1558
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[5].qname, NewName: sqlDollar[8].qname, IfExists: true}
 		}
-	case 219:
+	case 261:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1357
+		//line sql.y:1562
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[3].qname, NewName: Name(sqlDollar[6].str), IfExists: false}
 		}
-	case 220:
+	case 262:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1361
+		//line sql.y:1566
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[5].qname, NewName: Name(sqlDollar[8].str), IfExists: true}
 		}
-	case 221:
+	case 263:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1365
+		//line sql.y:1570
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 222:
+	case 264:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1369
+		//line sql.y:1574
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 223:
+	case 265:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1373
+		//line sql.y:1578
 		{
 			sqlVAL.stmt = nil
 		}
-	case 224:
+	case 266:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1377
+		//line sql.y:1582
 		{
 			sqlVAL.stmt = nil
 		}
-	case 225:
+	case 267:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1383
+		//line sql.y:1588
 		{
 			sqlVAL.boolVal = true
 		}
-	case 226:
+	case 268:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1387
+		//line sql.y:1592
 		{
 			sqlVAL.boolVal = false
 		}
-	case 227:
+	case 269:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1392
+		//line sql.y:1597
 		{
 		}
-	case 228:
+	case 270:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1393
+		//line sql.y:1598
 		{
 		}
-	case 229:
+	case 271:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1398
+		//line sql.y:1603
 		{
 			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 230:
+	case 272:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1402
+		//line sql.y:1607
 		{
 			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 231:
+	case 273:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1406
+		//line sql.y:1611
 		{
 			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 232:
+	case 274:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1411
+		//line sql.y:1616
 		{
 		}
-	case 233:
+	case 275:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1412
+		//line sql.y:1617
 		{
 		}
-	case 235:
+	case 277:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1417
+		//line sql.y:1622
 		{
 			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 236:
+	case 278:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1423
+		//line sql.y:1628
 		{
 			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 237:
+	case 279:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1429
+		//line sql.y:1634
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 238:
+	case 280:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1433
+		//line sql.y:1638
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 239:
+	case 281:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1645
+		{
+			sqlVAL.stmt = &CreateRole{Name: Name(sqlDollar[3].str)}
+		}
+	case 282:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1439
+		//line sql.y:1651
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 242:
+	case 285:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1455
+		//line sql.y:1667
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].selectStmt}
 		}
-	case 243:
+	case 286:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1459
+		//line sql.y:1671
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].selectStmt}
 		}
-	case 244:
+	case 287:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1675
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 245:
+	case 288:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1468
+		//line sql.y:1680
 		{
 			unimplemented()
 		}
-	case 246:
+	case 289:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1469
+		//line sql.y:1681
 		{
 			unimplemented()
 		}
-	case 247:
+	case 290:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1470
+		//line sql.y:1682
 		{
 		}
-	case 248:
+	case 291:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1473
+		//line sql.y:1685
 		{
 			unimplemented()
 		}
-	case 249:
+	case 292:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1474
+		//line sql.y:1686
 		{
 			unimplemented()
 		}
-	case 250:
+	case 293:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1475
+		//line sql.y:1687
 		{
 		}
-	case 251:
-		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1480
+	case 294:
+		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
+		//line sql.y:1692
 		{
-			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
+			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr), OrderBy: sqlDollar[8].orderBy, Limit: sqlDollar[9].limit}
 		}
-	case 252:
+	case 295:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1486
+		//line sql.y:1698
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 253:
+	case 296:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1490
+		//line sql.y:1702
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 256:
+	case 299:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1500
+		//line sql.y:1712
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Names: QualifiedNames{sqlDollar[1].qname}, Expr: sqlDollar[3].expr}
 		}
-	case 257:
+	case 300:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1512
+		//line sql.y:1724
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: Tuple(sqlDollar[5].exprs)}
 		}
-	case 258:
+	case 301:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1516
+		//line sql.y:1728
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: &Subquery{Select: sqlDollar[5].selectStmt}}
 		}
-	case 261:
+	case 304:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1563
+		//line sql.y:1775
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 262:
+	case 305:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1567
+		//line sql.y:1779
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 264:
+	case 307:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1583
+		//line sql.y:1795
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[2].orderBy
 			}
 		}
-	case 265:
+	case 308:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1590
+		//line sql.y:1802
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5220,24 +5851,24 @@ sqldefault:
 				s.Limit = sqlDollar[3].limit
 			}
 		}
-	case 266:
+	case 309:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1598
+		//line sql.y:1810
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 		}
-	case 267:
+	case 310:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1602
+		//line sql.y:1814
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[3].orderBy
 			}
 		}
-	case 268:
+	case 311:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1609
+		//line sql.y:1821
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5245,9 +5876,9 @@ sqldefault:
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 271:
+	case 314:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1647
+		//line sql.y:1859
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:   sqlDollar[3].selExprs,
@@ -5257,9 +5888,9 @@ sqldefault:
 				Having:  newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 272:
+	case 315:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1659
+		//line sql.y:1871
 		{
 			sqlVAL.selectStmt = &Select{
 				Distinct: sqlDollar[2].boolVal,
@@ -5270,9 +5901,9 @@ sqldefault:
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 274:
+	case 317:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1671
+		//line sql.y:1883
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:       SelectExprs{StarSelectExpr()},
@@ -5280,9 +5911,9 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 275:
+	case 318:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1679
+		//line sql.y:1891
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astUnion,
@@ -5291,9 +5922,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 276:
+	case 319:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1688
+		//line sql.y:1900
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astIntersect,
@@ -5302,9 +5933,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 277:
+	case 320:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1697
+		//line sql.y:1909
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astExcept,
@@ -5313,142 +5944,142 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 278:
+	case 321:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1715
+		//line sql.y:1927
 		{
 			unimplemented()
 		}
-	case 279:
+	case 322:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1716
+		//line sql.y:1928
 		{
 			unimplemented()
 		}
-	case 280:
+	case 323:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1717
+		//line sql.y:1929
 		{
 			unimplemented()
 		}
-	case 281:
+	case 324:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1720
+		//line sql.y:1932
 		{
 			unimplemented()
 		}
-	case 282:
+	case 325:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1721
+		//line sql.y:1933
 		{
 			unimplemented()
 		}
-	case 283:
+	case 326:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1724
+		//line sql.y:1936
 		{
 			unimplemented()
 		}
-	case 284:
+	case 327:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1728
+		//line sql.y:1940
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 288:
+	case 331:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1736
+		//line sql.y:1948
 		{
 			unimplemented()
 		}
-	case 289:
+	case 332:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1737
+		//line sql.y:1949
 		{
 		}
-	case 290:
+	case 333:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1740
+		//line sql.y:1952
 		{
 		}
-	case 291:
+	case 334:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1741
+		//line sql.y:1953
 		{
 		}
-	case 292:
+	case 335:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1745
+		//line sql.y:1957
 		{
 			sqlVAL.boolVal = true
 		}
-	case 293:
+	case 336:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1749
+		//line sql.y:1961
 		{
 			sqlVAL.boolVal = false
 		}
-	case 294:
+	case 337:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1753
+		//line sql.y:1965
 		{
 			sqlVAL.boolVal = false
 		}
-	case 295:
+	case 338:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1759
+		//line sql.y:1971
 		{
 			sqlVAL.boolVal = true
 		}
-	case 296:
+	case 339:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1764
+		//line sql.y:1976
 		{
 		}
-	case 297:
+	case 340:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1765
+		//line sql.y:1977
 		{
 		}
-	case 298:
+	case 341:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1769
+		//line sql.y:1981
 		{
 			sqlVAL.orderBy = sqlDollar[1].orderBy
 		}
-	case 299:
+	case 342:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1773
+		//line sql.y:1985
 		{
 			sqlVAL.orderBy = nil
 		}
-	case 300:
+	case 343:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1779
+		//line sql.y:1991
 		{
 			sqlVAL.orderBy = OrderBy(sqlDollar[3].orders)
 		}
-	case 301:
+	case 344:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1785
+		//line sql.y:1997
 		{
 			sqlVAL.orders = []*Order{sqlDollar[1].order}
 		}
-	case 302:
+	case 345:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1789
+		//line sql.y:2001
 		{
 			sqlVAL.orders = append(sqlDollar[1].orders, sqlDollar[3].order)
 		}
-	case 303:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1795
+		//line sql.y:2007
 		{
 			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 304:
+	case 347:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1803
+		//line sql.y:2015
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -5457,18 +6088,18 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 305:
+	case 348:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1812
+		//line sql.y:2024
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 308:
+	case 351:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1823
+		//line sql.y:2035
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -5476,2030 +6107,2101 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 309:
+	case 353:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:2050
+		{
+			sqlVAL.limit = nil
+		}
+	case 354:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1836
+		//line sql.y:2056
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 310:
+	case 355:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1843
+		//line sql.y:2063
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 312:
+	case 357:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1850
+		//line sql.y:2070
 		{
 			sqlVAL.expr = nil
 		}
-	case 313:
+	case 358:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1864
+		//line sql.y:2084
 		{
 		}
-	case 314:
+	case 359:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1865
+		//line sql.y:2085
 		{
 		}
-	case 315:
+	case 360:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1891
+		//line sql.y:2111
 		{
 			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 316:
+	case 361:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1895
+		//line sql.y:2115
 		{
 			sqlVAL.groupBy = nil
 		}
-	case 317:
+	case 362:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1901
+		//line sql.y:2121
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 318:
+	case 363:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1905
+		//line sql.y:2125
 		{
 			sqlVAL.expr = nil
 		}
-	case 319:
+	case 364:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1911
+		//line sql.y:2131
 		{
 			sqlVAL.selectStmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 320:
+	case 365:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1915
+		//line sql.y:2135
 		{
 			sqlVAL.selectStmt = append(sqlDollar[1].selectStmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 321:
+	case 366:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1925
+		//line sql.y:2145
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 322:
+	case 367:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1929
+		//line sql.y:2149
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 323:
+	case 368:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1935
+		//line sql.y:2155
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 324:
+	case 369:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1939
+		//line sql.y:2159
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 325:
+	case 370:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1946
+		//line sql.y:2166
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 326:
+	case 371:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1950
+		//line sql.y:2170
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].selectStmt}, As: Name(sqlDollar[2].str)}
 		}
-	case 328:
+	case 373:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1954
+		//line sql.y:2174
 		{
 			unimplemented()
 		}
-	case 329:
+	case 374:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1972
+		//line sql.y:2192
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 330:
+	case 375:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1976
+		//line sql.y:2196
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 331:
+	case 376:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1980
+		//line sql.y:2200
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 332:
+	case 377:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1984
+		//line sql.y:2204
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 333:
+	case 378:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1988
+		//line sql.y:2208
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 334:
+	case 379:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1992
+		//line sql.y:2212
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 335:
+	case 380:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1997
+		//line sql.y:2217
 		{
 			unimplemented()
 		}
-	case 336:
+	case 381:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1999
+		//line sql.y:2219
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 337:
+	case 382:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2002
+		//line sql.y:2222
 		{
 			unimplemented()
 		}
-	case 338:
+	case 383:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2004
+		//line sql.y:2224
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 340:
+	case 385:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2011
+		//line sql.y:2231
 		{
 			sqlVAL.str = ""
 		}
-	case 341:
+	case 386:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2017
+		//line sql.y:2237
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 342:
+	case 387:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2021
+		//line sql.y:2241
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 343:
+	case 388:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2025
+		//line sql.y:2245
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 344:
+	case 389:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2029
+		//line sql.y:2249
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 345:
+	case 390:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2035
+		//line sql.y:2255
 		{
 		}
-	case 346:
+	case 391:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2036
+		//line sql.y:2256
 		{
 		}
-	case 347:
+	case 392:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2047
+		//line sql.y:2267
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 348:
+	case 393:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2051
+		//line sql.y:2271
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 349:
+	case 394:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2057
+		//line sql.y:2277
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 350:
+	case 395:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2281
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 351:
+	case 396:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2066
+		//line sql.y:2286
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 352:
+	case 397:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2071
+		//line sql.y:2291
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 353:
+	case 398:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2078
+		//line sql.y:2298
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 354:
+	case 399:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2082
+		//line sql.y:2302
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 355:
+	case 400:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2095
+		//line sql.y:2315
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 356:
+	case 401:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2319
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 357:
+	case 402:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2323
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 358:
+	case 403:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2109
+		//line sql.y:2329
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 359:
+	case 404:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2333
 		{
 			sqlVAL.expr = nil
 		}
-	case 360:
+	case 405:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2125
+		//line sql.y:2345
 		{
-			sqlVAL.colType = sqlDollar[1].colType
+			if sqlDollar[2].boolVal {
+				sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
+			} else {
+				sqlVAL.colType = sqlDollar[1].colType
+			}
 		}
-	case 361:
+	case 406:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2129
+		//line sql.y:2354
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
 		}
-	case 362:
+	case 407:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2130
+		//line sql.y:2358
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
 		}
-	case 363:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2133
+	case 408:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2366
 		{
-			unimplemented()
+			sqlVAL.boolVal = true
 		}
-	case 364:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2134
+	case 409:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2370
 		{
-			unimplemented()
+			sqlVAL.boolVal = true
 		}
-	case 365:
+	case 410:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2135
+		//line sql.y:2374
 		{
+			sqlVAL.boolVal = false
 		}
-	case 371:
+	case 416:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2143
+		//line sql.y:2384
 		{
 			unimplemented()
 		}
-	case 372:
+	case 417:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2145
+		//line sql.y:2386
 		{
 			sqlVAL.colType = &BytesType{Name: "BLOB"}
 		}
-	case 373:
+	case 418:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2149
+		//line sql.y:2390
 		{
 			sqlVAL.colType = &BytesType{Name: "BYTES"}
 		}
-	case 374:
+	case 419:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2153
+		//line sql.y:2394
 		{
 			sqlVAL.colType = &StringType{Name: "TEXT"}
 		}
-	case 375:
+	case 420:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2157
+		//line sql.y:2398
 		{
 			sqlVAL.colType = &StringType{Name: "STRING"}
 		}
-	case 380:
+	case 425:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2178
+		//line sql.y:2419
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival)}
 		}
-	case 381:
+	case 426:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2182
+		//line sql.y:2423
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival), Scale: int(sqlDollar[4].ival)}
 		}
-	case 382:
+	case 427:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2186
+		//line sql.y:2427
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 383:
+	case 428:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2193
+		//line sql.y:2434
 		{
 			sqlVAL.colType = &IntType{Name: "INT"}
 		}
-	case 384:
+	case 429:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2197
+		//line sql.y:2438
 		{
 			sqlVAL.colType = &IntType{Name: "INT64"}
 		}
-	case 385:
+	case 430:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2201
+		//line sql.y:2442
 		{
 			sqlVAL.colType = &IntType{Name: "INTEGER"}
 		}
-	case 386:
+	case 431:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2205
+		//line sql.y:2446
 		{
 			sqlVAL.colType = &IntType{Name: "SMALLINT"}
 		}
-	case 387:
+	case 432:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2209
+		//line sql.y:2450
 		{
 			sqlVAL.colType = &IntType{Name: "BIGINT"}
 		}
-	case 388:
+	case 433:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2213
+		//line sql.y:2454
 		{
 			sqlVAL.colType = &FloatType{Name: "REAL"}
 		}
-	case 389:
+	case 434:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2217
+		//line sql.y:2458
 		{
 			sqlVAL.colType = &FloatType{Name: "FLOAT", Prec: int(sqlDollar[2].ival)}
 		}
-	case 390:
+	case 435:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2221
+		//line sql.y:2462
 		{
 			sqlVAL.colType = &FloatType{Name: "DOUBLE PRECISION"}
 		}
-	case 391:
+	case 436:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2225
+		//line sql.y:2466
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DECIMAL"
 		}
-	case 392:
+	case 437:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2230
+		//line sql.y:2471
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DEC"
 		}
-	case 393:
+	case 438:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2235
+		//line sql.y:2476
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "NUMERIC"
 		}
-	case 394:
+	case 439:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2240
+		//line sql.y:2481
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOLEAN"}
 		}
-	case 395:
+	case 440:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2244
+		//line sql.y:2485
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOL"}
 		}
-	case 396:
+	case 441:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2250
+		//line sql.y:2491
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 397:
+	case 442:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2495
 		{
 			sqlVAL.ival = 0
 		}
-	case 402:
+	case 447:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2272
+		//line sql.y:2513
 		{
 			sqlVAL.colType = &IntType{Name: "BIT", N: int(sqlDollar[4].ival)}
 		}
-	case 403:
+	case 448:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2278
+		//line sql.y:2519
 		{
 			sqlVAL.colType = &IntType{Name: "BIT"}
 		}
-	case 408:
+	case 453:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2294
+		//line sql.y:2535
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*StringType).N = int(sqlDollar[3].ival)
 		}
-	case 409:
+	case 454:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2301
+		//line sql.y:2542
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 410:
+	case 455:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2307
+		//line sql.y:2548
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 411:
+	case 456:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2311
+		//line sql.y:2552
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 412:
+	case 457:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2315
+		//line sql.y:2556
 		{
 			sqlVAL.colType = &StringType{Name: "VARCHAR"}
 		}
-	case 413:
+	case 458:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2320
+		//line sql.y:2561
 		{
 		}
-	case 414:
+	case 459:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2321
+		//line sql.y:2562
 		{
 		}
-	case 415:
+	case 460:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2326
+		//line sql.y:2567
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 416:
+	case 461:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2330
+		//line sql.y:2571
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 417:
+	case 462:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2335
+		//line sql.y:2576
 		{
 			sqlVAL.colType = &IntervalType{}
 		}
-	case 418:
+	case 463:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2340
+		//line sql.y:2581
 		{
 			unimplemented()
 		}
-	case 419:
+	case 464:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2341
+		//line sql.y:2582
 		{
 			unimplemented()
 		}
-	case 420:
+	case 465:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2342
+		//line sql.y:2583
 		{
 			unimplemented()
 		}
-	case 421:
+	case 466:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2343
+		//line sql.y:2584
 		{
 			unimplemented()
 		}
-	case 422:
+	case 467:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2344
+		//line sql.y:2585
 		{
 			unimplemented()
 		}
-	case 423:
+	case 468:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2345
+		//line sql.y:2586
 		{
 			unimplemented()
 		}
-	case 424:
+	case 469:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2346
+		//line sql.y:2587
 		{
 			unimplemented()
 		}
-	case 425:
+	case 470:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2588
 		{
 			unimplemented()
 		}
-	case 426:
+	case 471:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2348
+		//line sql.y:2589
 		{
 			unimplemented()
 		}
-	case 427:
+	case 472:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2349
+		//line sql.y:2590
 		{
 			unimplemented()
 		}
-	case 428:
+	case 473:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2350
+		//line sql.y:2591
 		{
 			unimplemented()
 		}
-	case 429:
+	case 474:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2351
+		//line sql.y:2592
 		{
 			unimplemented()
 		}
-	case 430:
+	case 475:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2352
+		//line sql.y:2593
 		{
 			unimplemented()
 		}
-	case 431:
+	case 476:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2353
+		//line sql.y:2594
 		{
 		}
-	case 432:
+	case 477:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2356
+		//line sql.y:2597
 		{
 			unimplemented()
 		}
-	case 433:
+	case 478:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2357
+		//line sql.y:2598
 		{
 			unimplemented()
 		}
-	case 435:
+	case 480:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2381
+		//line sql.y:2622
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 436:
+	case 481:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2384
+		//line sql.y:2625
 		{
 			unimplemented()
 		}
-	case 437:
+	case 482:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2385
+		//line sql.y:2626
 		{
 			unimplemented()
 		}
-	case 438:
+	case 483:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2394
+		//line sql.y:2635
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 439:
+	case 484:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2398
+		//line sql.y:2639
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 440:
+	case 485:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2643
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 441:
+	case 486:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2406
+		//line sql.y:2647
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 442:
+	case 487:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2651
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 443:
+	case 488:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2414
+		//line sql.y:2655
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 444:
+	case 489:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2659
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 445:
+	case 490:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2422
+		//line sql.y:2663
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 446:
+	case 491:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2426
+		//line sql.y:2667
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 447:
+	case 492:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2430
+		//line sql.y:2671
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 448:
+	case 493:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2434
+		//line sql.y:2675
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 449:
+	case 494:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2438
+		//line sql.y:2679
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 450:
+	case 495:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2442
+		//line sql.y:2683
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 451:
+	case 496:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2446
+		//line sql.y:2687
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 452:
+	case 497:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2450
+		//line sql.y:2691
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 453:
+	case 498:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2454
+		//line sql.y:2695
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 454:
+	case 499:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2458
+		//line sql.y:2699
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 455:
+	case 500:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2462
+		//line sql.y:2703
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 456:
+	case 501:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2466
+		//line sql.y:2707
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 457:
+	case 502:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2470
+		//line sql.y:2711
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 458:
+	case 503:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2474
+		//line sql.y:2715
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 459:
+	case 504:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2719
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: RegMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 505:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2723
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotRegMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 506:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2727
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: RegIMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 507:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2731
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotRegIMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 508:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2735
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Contains, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 509:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2739
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ContainedBy, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 510:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2478
+		//line sql.y:2743
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 460:
+	case 511:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2482
+		//line sql.y:2747
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 461:
+	case 512:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2486
+		//line sql.y:2751
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 462:
+	case 513:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2490
+		//line sql.y:2755
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 463:
+	case 514:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2494
+		//line sql.y:2759
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 464:
+	case 515:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2763
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 465:
+	case 516:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2767
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ILike, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 517:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:2771
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotILike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
+		}
+	case 518:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2502
+		//line sql.y:2775
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 466:
+	case 519:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2506
+		//line sql.y:2779
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 467:
+	case 520:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2510
+		//line sql.y:2783
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 468:
+	case 521:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2514
+		//line sql.y:2787
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 469:
+	case 522:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2517
+		//line sql.y:2790
 		{
 			unimplemented()
 		}
-	case 470:
+	case 523:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2519
+		//line sql.y:2792
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 471:
+	case 524:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2523
+		//line sql.y:2796
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 472:
+	case 525:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2527
+		//line sql.y:2800
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 473:
+	case 526:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2804
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 474:
+	case 527:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2535
+		//line sql.y:2808
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 475:
+	case 528:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2539
+		//line sql.y:2812
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 476:
+	case 529:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2543
+		//line sql.y:2816
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 477:
+	case 530:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2547
+		//line sql.y:2820
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 478:
+	case 531:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2551
+		//line sql.y:2824
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 479:
+	case 532:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2555
+		//line sql.y:2828
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 480:
+	case 533:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2559
+		//line sql.y:2832
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 481:
+	case 534:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2563
+		//line sql.y:2836
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 482:
+	case 535:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2567
+		//line sql.y:2840
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 483:
+	case 536:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2571
+		//line sql.y:2844
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 484:
+	case 537:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2575
+		//line sql.y:2848
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 485:
+	case 538:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2579
+		//line sql.y:2852
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 487:
+	case 540:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2596
+		//line sql.y:2869
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 488:
+	case 541:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2600
+		//line sql.y:2873
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 489:
+	case 542:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2604
+		//line sql.y:2877
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 490:
+	case 543:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2608
+		//line sql.y:2881
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 491:
+	case 544:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2612
+		//line sql.y:2885
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 492:
+	case 545:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2616
+		//line sql.y:2889
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 493:
+	case 546:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2620
+		//line sql.y:2893
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 494:
+	case 547:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2624
+		//line sql.y:2897
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 495:
+	case 548:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2901
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 496:
+	case 549:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2632
+		//line sql.y:2905
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 497:
+	case 550:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2636
+		//line sql.y:2909
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 498:
+	case 551:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2640
+		//line sql.y:2913
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 499:
+	case 552:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2644
+		//line sql.y:2917
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 500:
+	case 553:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2648
+		//line sql.y:2921
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 501:
+	case 554:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2652
+		//line sql.y:2925
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 502:
+	case 555:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2656
+		//line sql.y:2929
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 503:
+	case 556:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2660
+		//line sql.y:2933
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 504:
+	case 557:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2664
+		//line sql.y:2937
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 505:
+	case 558:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2668
+		//line sql.y:2941
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 506:
+	case 559:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:2945
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 507:
+	case 560:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:2949
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 508:
+	case 561:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2680
+		//line sql.y:2953
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 509:
+	case 562:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2684
+		//line sql.y:2957
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 510:
+	case 563:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2688
+		//line sql.y:2961
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 511:
+	case 564:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2692
+		//line sql.y:2965
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 512:
+	case 565:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2696
+		//line sql.y:2969
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 513:
+	case 566:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2708
+		//line sql.y:2981
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 515:
+	case 568:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2713
+		//line sql.y:2986
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 516:
+	case 569:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2717
+		//line sql.y:2990
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 519:
+	case 570:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:2994
+		{
+			sqlVAL.expr = &SubscriptExpr{Expr: &ParenExpr{Expr: sqlDollar[2].expr}, Index: &ArrayIndirection{Begin: sqlDollar[5].expr}}
+		}
+	case 571:
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+		//line sql.y:2998
+		{
+			sqlVAL.expr = &SubscriptExpr{Expr: &ParenExpr{Expr: sqlDollar[2].expr}, Index: &ArrayIndirection{Begin: sqlDollar[5].expr, End: sqlDollar[7].expr}}
+		}
+	case 574:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2723
+		//line sql.y:3004
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 520:
+	case 575:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2727
+		//line sql.y:3008
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 521:
+	case 576:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3012
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].selectStmt}}
 		}
-	case 522:
+	case 577:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2737
+		//line sql.y:3018
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 523:
+	case 578:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2741
+		//line sql.y:3022
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 524:
+	case 579:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2745
+		//line sql.y:3026
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 525:
+	case 580:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2753
+		//line sql.y:3034
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 526:
+	case 581:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2757
+		//line sql.y:3038
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 527:
+	case 582:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2761
+		//line sql.y:3042
 		{
 			unimplemented()
 		}
-	case 528:
+	case 583:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2762
+		//line sql.y:3043
 		{
 			unimplemented()
 		}
-	case 529:
+	case 584:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2763
+		//line sql.y:3044
 		{
 			unimplemented()
 		}
-	case 530:
+	case 585:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2765
+		//line sql.y:3046
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 531:
+	case 586:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2770
+		//line sql.y:3051
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{StarExpr()}}
 		}
-	case 532:
+	case 587:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2783
+		//line sql.y:3064
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 533:
+	case 588:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2789
+		//line sql.y:3070
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 534:
+	case 589:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2798
+		//line sql.y:3079
 		{
 			unimplemented()
 		}
-	case 535:
+	case 590:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2799
+		//line sql.y:3080
 		{
 			unimplemented()
 		}
-	case 536:
+	case 591:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2803
+		//line sql.y:3084
 		{
 			unimplemented()
 		}
-	case 537:
+	case 592:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2805
+		//line sql.y:3086
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 538:
+	case 593:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2809
+		//line sql.y:3090
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 539:
+	case 594:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2813
+		//line sql.y:3094
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 540:
+	case 595:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2817
+		//line sql.y:3098
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 541:
+	case 596:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2820
+		//line sql.y:3101
 		{
 			unimplemented()
 		}
-	case 542:
+	case 597:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2821
+		//line sql.y:3102
 		{
 			unimplemented()
 		}
-	case 543:
+	case 598:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2822
+		//line sql.y:3103
 		{
 			unimplemented()
 		}
-	case 544:
+	case 599:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2823
+		//line sql.y:3104
 		{
 			unimplemented()
 		}
-	case 545:
+	case 600:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2825
+		//line sql.y:3106
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 546:
+	case 601:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3110
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 547:
+	case 602:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2832
+		//line sql.y:3113
 		{
 			unimplemented()
 		}
-	case 548:
+	case 603:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2833
+		//line sql.y:3114
 		{
 			unimplemented()
 		}
-	case 549:
+	case 604:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2834
+		//line sql.y:3115
 		{
 			unimplemented()
 		}
-	case 550:
+	case 605:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2835
+		//line sql.y:3116
 		{
 			unimplemented()
 		}
-	case 551:
+	case 606:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2836
+		//line sql.y:3117
 		{
 			unimplemented()
 		}
-	case 552:
+	case 607:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2837
+		//line sql.y:3118
 		{
 			unimplemented()
 		}
-	case 553:
+	case 608:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2838
+		//line sql.y:3119
 		{
 			unimplemented()
 		}
-	case 554:
+	case 609:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2839
+		//line sql.y:3120
 		{
 			unimplemented()
 		}
-	case 555:
+	case 610:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2841
+		//line sql.y:3122
 		{
 			sqlVAL.expr = &IfExpr{Cond: sqlDollar[3].expr, True: sqlDollar[5].expr, Else: sqlDollar[7].expr}
 		}
-	case 556:
+	case 611:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2845
+		//line sql.y:3126
 		{
 			sqlVAL.expr = &NullIfExpr{Expr1: sqlDollar[3].expr, Expr2: sqlDollar[5].expr}
 		}
-	case 557:
+	case 612:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2849
+		//line sql.y:3130
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "IFNULL", Exprs: Exprs{sqlDollar[3].expr, sqlDollar[5].expr}}
 		}
-	case 558:
+	case 613:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2853
+		//line sql.y:3134
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "COALESCE", Exprs: sqlDollar[3].exprs}
 		}
-	case 559:
+	case 614:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2857
+		//line sql.y:3138
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 560:
+	case 615:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2861
+		//line sql.y:3142
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 561:
+	case 616:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2867
+		//line sql.y:3148
 		{
 			unimplemented()
 		}
-	case 562:
+	case 617:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2868
+		//line sql.y:3149
 		{
 		}
-	case 563:
+	case 618:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2871
+		//line sql.y:3152
 		{
 			unimplemented()
 		}
-	case 564:
+	case 619:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2872
+		//line sql.y:3153
 		{
 		}
-	case 565:
+	case 620:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2876
+		//line sql.y:3157
 		{
 			unimplemented()
 		}
-	case 566:
+	case 621:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2877
+		//line sql.y:3158
 		{
 		}
-	case 567:
+	case 622:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2880
+		//line sql.y:3161
 		{
 			unimplemented()
 		}
-	case 568:
+	case 623:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2881
+		//line sql.y:3162
 		{
 			unimplemented()
 		}
-	case 569:
+	case 624:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2884
+		//line sql.y:3165
 		{
 			unimplemented()
 		}
-	case 570:
+	case 625:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2887
+		//line sql.y:3168
 		{
 			unimplemented()
 		}
-	case 571:
+	case 626:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2888
+		//line sql.y:3169
 		{
 			unimplemented()
 		}
-	case 572:
+	case 627:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2889
+		//line sql.y:3170
 		{
 		}
-	case 573:
+	case 628:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2893
+		//line sql.y:3174
 		{
 			unimplemented()
 		}
-	case 574:
+	case 629:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2904
+		//line sql.y:3185
 		{
 			unimplemented()
 		}
-	case 575:
+	case 630:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2905
+		//line sql.y:3186
 		{
 		}
-	case 576:
+	case 631:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2908
+		//line sql.y:3189
 		{
 			unimplemented()
 		}
-	case 577:
+	case 632:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2909
+		//line sql.y:3190
 		{
 		}
-	case 578:
+	case 633:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2917
+		//line sql.y:3198
 		{
 			unimplemented()
 		}
-	case 579:
+	case 634:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3199
 		{
 			unimplemented()
 		}
-	case 580:
+	case 635:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2919
+		//line sql.y:3200
 		{
 		}
-	case 581:
+	case 636:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2922
+		//line sql.y:3203
 		{
 			unimplemented()
 		}
-	case 582:
+	case 637:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3204
 		{
 			unimplemented()
 		}
-	case 583:
+	case 638:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2929
+		//line sql.y:3210
 		{
 			unimplemented()
 		}
-	case 584:
+	case 639:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2930
+		//line sql.y:3211
 		{
 			unimplemented()
 		}
-	case 585:
+	case 640:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2931
+		//line sql.y:3212
 		{
 			unimplemented()
 		}
-	case 586:
+	case 641:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2932
+		//line sql.y:3213
 		{
 			unimplemented()
 		}
-	case 587:
+	case 642:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2933
+		//line sql.y:3214
 		{
 			unimplemented()
 		}
-	case 588:
+	case 643:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2944
+		//line sql.y:3225
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 589:
+	case 644:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2948
+		//line sql.y:3229
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 590:
+	case 645:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2952
+		//line sql.y:3233
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 591:
+	case 646:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2958
+		//line sql.y:3239
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 592:
+	case 647:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2962
+		//line sql.y:3243
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 593:
+	case 648:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2968
+		//line sql.y:3249
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 594:
+	case 649:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3009
+		//line sql.y:3290
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 595:
+	case 650:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3013
+		//line sql.y:3294
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 596:
+	case 651:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3019
+		//line sql.y:3300
 		{
 			sqlVAL.colTypes = []ColumnType{sqlDollar[1].colType}
 		}
-	case 597:
+	case 652:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3023
+		//line sql.y:3304
 		{
 			sqlVAL.colTypes = append(sqlDollar[1].colTypes, sqlDollar[3].colType)
 		}
-	case 598:
+	case 653:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3029
+		//line sql.y:3310
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 599:
+	case 654:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3033
+		//line sql.y:3314
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 600:
+	case 655:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3037
+		//line sql.y:3318
 		{
 			sqlVAL.expr = Array(nil)
 		}
-	case 601:
+	case 656:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3043
+		//line sql.y:3324
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 602:
+	case 657:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3328
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 603:
+	case 658:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3053
+		//line sql.y:3334
 		{
 			sqlVAL.exprs = Exprs{DString(sqlDollar[1].str), sqlDollar[3].expr}
 		}
-	case 611:
+	case 666:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3074
+		//line sql.y:3355
 		{
 			unimplemented()
 		}
-	case 612:
+	case 667:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3075
+		//line sql.y:3356
 		{
 			unimplemented()
 		}
-	case 613:
+	case 668:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3078
+		//line sql.y:3359
 		{
 			unimplemented()
 		}
-	case 614:
+	case 669:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3082
+		//line sql.y:3363
 		{
 			unimplemented()
 		}
-	case 615:
+	case 670:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3083
+		//line sql.y:3364
 		{
 		}
-	case 616:
+	case 671:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3097
+		//line sql.y:3378
 		{
 			unimplemented()
 		}
-	case 617:
+	case 672:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3098
+		//line sql.y:3379
 		{
 			unimplemented()
 		}
-	case 618:
+	case 673:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3099
+		//line sql.y:3380
 		{
 			unimplemented()
 		}
-	case 619:
+	case 674:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3100
+		//line sql.y:3381
 		{
 			unimplemented()
 		}
-	case 620:
+	case 675:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3101
+		//line sql.y:3382
 		{
 			unimplemented()
 		}
-	case 621:
+	case 676:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3102
+		//line sql.y:3383
 		{
 		}
-	case 622:
+	case 677:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3105
+		//line sql.y:3386
 		{
 			unimplemented()
 		}
-	case 623:
+	case 678:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3108
+		//line sql.y:3389
 		{
 			unimplemented()
 		}
-	case 624:
+	case 679:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3111
+		//line sql.y:3392
 		{
 			unimplemented()
 		}
-	case 625:
+	case 680:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3112
+		//line sql.y:3393
 		{
 			unimplemented()
 		}
-	case 626:
+	case 681:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3113
+		//line sql.y:3394
 		{
 			unimplemented()
 		}
-	case 627:
+	case 682:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3117
+		//line sql.y:3398
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 628:
+	case 683:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3121
+		//line sql.y:3402
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 629:
+	case 684:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3132
+		//line sql.y:3413
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 630:
+	case 685:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3139
+		//line sql.y:3420
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 631:
+	case 686:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3143
+		//line sql.y:3424
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 632:
+	case 687:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3149
+		//line sql.y:3430
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 633:
+	case 688:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3155
+		//line sql.y:3436
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 634:
+	case 689:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3159
+		//line sql.y:3440
 		{
 			sqlVAL.expr = nil
 		}
-	case 636:
+	case 691:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3166
+		//line sql.y:3447
 		{
 			sqlVAL.expr = nil
 		}
-	case 637:
+	case 692:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3172
+		//line sql.y:3453
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
-	case 638:
+	case 693:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3176
+		//line sql.y:3457
 		{
 			sqlVAL.indirectElem = qualifiedStar
 		}
-	case 639:
+	case 694:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3180
+		//line sql.y:3461
 		{
 			sqlVAL.indirectElem = IndexIndirection(sqlDollar[2].str)
 		}
-	case 640:
+	case 695:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3465
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
-	case 641:
+	case 696:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3188
+		//line sql.y:3469
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
-	case 642:
+	case 697:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3194
+		//line sql.y:3475
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
-	case 643:
+	case 698:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3198
+		//line sql.y:3479
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 644:
+	case 699:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3203
+		//line sql.y:3484
 		{
 		}
-	case 645:
+	case 700:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3204
+		//line sql.y:3485
 		{
 		}
-	case 647:
+	case 702:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3213
+		//line sql.y:3494
 		{
 			sqlVAL.expr = DefaultVal{}
 		}
-	case 648:
+	case 703:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3219
+		//line sql.y:3500
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 649:
+	case 704:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3223
+		//line sql.y:3504
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 650:
+	case 705:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3232
+		//line sql.y:3513
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 652:
+	case 707:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3240
+		//line sql.y:3521
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 653:
+	case 708:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3246
+		//line sql.y:3527
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 654:
+	case 709:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3250
+		//line sql.y:3531
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 655:
+	case 710:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3256
+		//line sql.y:3537
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 656:
+	case 711:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3265
+		//line sql.y:3546
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 657:
+	case 712:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3269
+		//line sql.y:3550
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr}
 		}
-	case 658:
+	case 713:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3273
+		//line sql.y:3554
 		{
 			sqlVAL.selExpr = StarSelectExpr()
 		}
-	case 659:
+	case 714:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3281
+		//line sql.y:3562
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 660:
+	case 715:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3285
+		//line sql.y:3566
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 661:
+	case 716:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3296
+		//line sql.y:3577
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 662:
+	case 717:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3300
+		//line sql.y:3581
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 663:
+	case 718:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3306
+		//line sql.y:3587
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 664:
+	case 719:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3310
+		//line sql.y:3591
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 665:
+	case 720:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3316
+		//line sql.y:3597
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 666:
+	case 721:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3319
+		//line sql.y:3600
 		{
 		}
-	case 667:
+	case 722:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3329
+		//line sql.y:3610
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 668:
+	case 723:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3333
+		//line sql.y:3614
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 669:
+	case 724:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3340
+		//line sql.y:3621
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 670:
+	case 725:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3344
+		//line sql.y:3625
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 671:
+	case 726:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3348
+		//line sql.y:3629
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 672:
+	case 727:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3352
+		//line sql.y:3633
 		{
 			sqlVAL.expr = DBytes(sqlDollar[1].str)
 		}
-	case 673:
+	case 728:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3355
+		//line sql.y:3636
 		{
 			unimplemented()
 		}
-	case 674:
+	case 729:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3357
+		//line sql.y:3638
 		{
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 675:
+	case 730:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3361
+		//line sql.y:3642
 		{
 			// TODO(pmattis): support opt_interval?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 676:
+	case 731:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3366
+		//line sql.y:3647
 		{
 			// TODO(pmattis): Support the precision specification?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
-	case 677:
+	case 732:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3371
+		//line sql.y:3652
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 678:
+	case 733:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3375
+		//line sql.y:3656
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 679:
+	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3379
+		//line sql.y:3660
 		{
 			sqlVAL.expr = DNull
 		}
-	case 681:
+	case 736:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3386
+		//line sql.y:3667
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
-	case 682:
+	case 737:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3390
+		//line sql.y:3671
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
-	case 687:
+	case 742:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3412
+		//line sql.y:3693
 		{
 			sqlVAL.str = ""
 		}
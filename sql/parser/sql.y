@@ -71,9 +71,14 @@ func unimplemented() {
   order          *Order
   groupBy        GroupBy
   dir            Direction
+  idxElem        IndexElem
+  idxElems       IndexElemList
   alterTableCmd  AlterTableCmd
   alterTableCmds AlterTableCmds
   isoLevel       IsolationLevel
+  kvOption       KVOption
+  kvOptions      KVOptions
+  strPtr         *string
 }
 
 %type <stmts> stmt_block
@@ -81,18 +86,27 @@ func unimplemented() {
 %type <stmt> stmt
 
 %type <stmt> alter_table_stmt
+%type <stmt> alter_split_stmt
+%type <stmt> alter_zone_stmt
+%type <stmt> analyze_stmt
+%type <stmt> backup_stmt
+%type <stmt> comment_stmt
 %type <stmt> create_stmt
+%type <stmt> create_role_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
 %type <stmt> create_table_stmt
 %type <stmt> delete_stmt
 %type <stmt> drop_stmt
+%type <stmt> execute_stmt
 %type <stmt> explain_stmt
 %type <stmt> explainable_stmt
 %type <stmt> grant_stmt
+%type <stmt> import_stmt
 %type <stmt> insert_stmt
 %type <stmt> preparable_stmt
 %type <stmt> rename_stmt
+%type <stmt> restore_stmt
 %type <stmt> revoke_stmt
 %type <selectStmt> select_stmt
 %type <stmt> set_stmt
@@ -100,6 +114,7 @@ func unimplemented() {
 %type <stmt> transaction_stmt
 %type <stmt> truncate_stmt
 %type <stmt> update_stmt
+%type <stmt> validate_stmt
 
 %type <selectStmt> select_no_parens select_with_parens select_clause
 %type <selectStmt> simple_select values_clause
@@ -136,14 +151,18 @@ func unimplemented() {
 %type <strs> opt_column_list
 %type <orderBy> sort_clause opt_sort_clause
 %type <orders> sortby_list
-%type <strs> index_params
+%type <idxElems> index_params
 %type <strs> name_list opt_name_list
-%type <empty> opt_array_bounds
+%type <boolVal> opt_array_bounds
 %type <tblExprs> from_clause from_list
 %type <qnames> qualified_name_list
 %type <qname> any_name
+%type <strPtr> comment_text
 %type <qnames> any_name_list
 %type <exprs> expr_list extract_list
+%type <exprs> opt_incremental
+%type <kvOption> kv_option
+%type <kvOptions> opt_with_options kv_option_list
 %type <indirect> attrs
 %type <selExprs> target_list opt_target_list
 %type <updateExprs> set_clause_list
@@ -168,7 +187,7 @@ func unimplemented() {
 
 %type <empty> opt_set_data
 
-%type <limit> limit_clause offset_clause
+%type <limit> limit_clause offset_clause opt_limit_clause
 %type <expr>  select_limit_value
 // %type <empty> opt_select_fetch_first_value
 %type <empty> row_or_rows
@@ -200,7 +219,7 @@ func unimplemented() {
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <order> sortby
-%type <str> index_elem
+%type <idxElem> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
 %type <qname> relation_expr
@@ -272,6 +291,8 @@ func unimplemented() {
 %token <str>   PARAM
 %token <str>   TYPECAST DOT_DOT
 %token <str>   LESS_EQUALS GREATER_EQUALS NOT_EQUALS
+%token <str>   NOT_REGMATCH REGIMATCH NOT_REGIMATCH
+%token <str>   CONTAINS CONTAINED_BY
 %token <str>   ERROR
 
 // If you want to make any keyword changes, update the keyword table in
@@ -284,24 +305,24 @@ func unimplemented() {
 %token <str>   ALL ALTER ANALYSE ANALYZE AND ANY ARRAY AS ASC
 %token <str>   ASYMMETRIC AT
 
-%token <str>   BEGIN BETWEEN BIGINT BIT
+%token <str>   BACKUP BEGIN BETWEEN BIGINT BIT
 %token <str>   BLOB BOOL BOOLEAN BOTH BY BYTES
 
 %token <str>   CASCADE CASE CAST CHAR
 %token <str>   CHARACTER CHECK
-%token <str>   COALESCE COLLATE COLLATION COLUMN COLUMNS COMMIT
-%token <str>   COMMITTED CONCAT CONFLICT CONSTRAINT
+%token <str>   COALESCE COLLATE COLLATION COLUMN COLUMNS COMMENT COMMIT
+%token <str>   COMMITTED CONCAT CONFIGURE CONFLICT CONSTRAINT
 %token <str>   COVERING CREATE
-%token <str>   CROSS CUBE CURRENT CURRENT_CATALOG CURRENT_DATE
+%token <str>   CROSS CSV CUBE CURRENT CURRENT_CATALOG CURRENT_DATE
 %token <str>   CURRENT_ROLE CURRENT_TIME CURRENT_TIMESTAMP
 %token <str>   CURRENT_USER CYCLE
 
 %token <str>   DATA DATABASE DATABASES DATE DAY DEC DECIMAL DEFAULT
 %token <str>   DEFERRABLE DELETE DESC
-%token <str>   DISTINCT DO DOUBLE DROP
+%token <str>   DISCARD DISTINCT DO DOUBLE DROP
 
 %token <str>   ELSE END ESCAPE EXCEPT
-%token <str>   EXISTS EXPLAIN EXTRACT
+%token <str>   EXECUTE EXISTS EXPLAIN EXTERNAL EXTRACT
 
 %token <str>   FALSE FETCH FILTER FIRST FLOAT FOLLOWING FOR
 %token <str>   FOREIGN FROM FULL
@@ -310,8 +331,8 @@ func unimplemented() {
 
 %token <str>   HAVING HOUR
 
-%token <str>   IF IFNULL IN
-%token <str>   INDEX INITIALLY
+%token <str>   IF IFNULL ILIKE IMPORT IN
+%token <str>   INCREMENTAL INDEX INITIALLY
 %token <str>   INNER INSERT INT INT64 INTEGER
 %token <str>   INTERSECT INTERVAL INTO IS ISOLATION
 
@@ -336,19 +357,19 @@ func unimplemented() {
 %token <str>   PRECEDING PRECISION PRIMARY
 
 %token <str>   RANGE READ REAL RECURSIVE REF REFERENCES
-%token <str>   RENAME REPEATABLE
-%token <str>   RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
+%token <str>   RENAME REPEATABLE RESET
+%token <str>   RESTORE RESTRICT RETURNING REVOKE RIGHT ROLE ROLLBACK ROLLUP
 %token <str>   ROW ROWS RSHIFT
 
-%token <str>   SEARCH SECOND SELECT
+%token <str>   SCATTER SEARCH SECOND SELECT
 %token <str>   SERIALIZABLE SESSION SESSION_USER SET SHOW
-%token <str>   SIMILAR SIMPLE SMALLINT SNAPSHOT SOME SQL
+%token <str>   SIMILAR SIMPLE SMALLINT SNAPSHOT SOME SPLIT SQL
 %token <str>   STRICT STRING STORING SUBSTRING
 %token <str>   SYMMETRIC
 
 %token <str>   TABLE TABLES TEXT THEN
 %token <str>   TIME TIMESTAMP TO TRAILING TRANSACTION TREAT TRIM TRUE
-%token <str>   TRUNCATE TYPE
+%token <str>   TRUNCATE TTL TYPE
 
 %token <str>   UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN
 %token <str>   UPDATE USER USING
@@ -373,6 +394,7 @@ func unimplemented() {
 
 // Precedence: lowest to highest
 %nonassoc  SET                 // see relation_expr_opt_alias
+%nonassoc  ON                  // see key_actions
 %left      UNION EXCEPT
 %left      INTERSECT
 %left      OR
@@ -380,7 +402,7 @@ func unimplemented() {
 %right     NOT
 %nonassoc  IS                  // IS sets precedence for IS NULL, etc
 %nonassoc  '<' '>' '=' LESS_EQUALS GREATER_EQUALS NOT_EQUALS
-%nonassoc  BETWEEN IN LIKE SIMILAR NOT_LA
+%nonassoc  BETWEEN IN LIKE ILIKE SIMILAR NOT_LA
 %nonassoc  ESCAPE              // ESCAPE must be just above LIKE/SIMILAR
 %nonassoc  OVERLAPS
 %left      POSTFIXOP           // dummy for postfix OP rules
@@ -409,7 +431,7 @@ func unimplemented() {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT       // multi-character ops
+%left      CONCAT '~' NOT_REGMATCH REGIMATCH NOT_REGIMATCH CONTAINS CONTAINED_BY // multi-character ops
 %left      '|'
 %left      '^' '#'
 %left      '&'
@@ -420,7 +442,6 @@ func unimplemented() {
 %left      AT                // sets precedence for AT TIME ZONE
 %left      COLLATE
 %right     UMINUS
-%left      '~'
 %left      '[' ']'
 %left      '(' ')'
 %left      TYPECAST
@@ -458,13 +479,21 @@ stmt_list:
 
 stmt:
   alter_table_stmt
+| alter_split_stmt
+| alter_zone_stmt
+| analyze_stmt
+| backup_stmt
+| comment_stmt
 | create_stmt
 | delete_stmt
 | drop_stmt
+| execute_stmt
 | explain_stmt
 | grant_stmt
+| import_stmt
 | insert_stmt
 | rename_stmt
+| restore_stmt
 | revoke_stmt
 | select_stmt
   {
@@ -475,6 +504,7 @@ stmt:
 | transaction_stmt
 | truncate_stmt
 | update_stmt
+| validate_stmt
 | /* EMPTY */
   {
     $$ = nil
@@ -490,6 +520,28 @@ alter_table_stmt:
     $$ = &AlterTable{Table: $5, IfExists: true, Cmds: $6}
   }
 
+// ALTER TABLE <name> SPLIT AT (<primary key values>)
+// ALTER TABLE <name> SCATTER
+alter_split_stmt:
+  ALTER TABLE relation_expr SPLIT AT '(' expr_list ')'
+  {
+    $$ = &Split{Table: $3, Exprs: $7}
+  }
+| ALTER TABLE relation_expr SCATTER
+  {
+    $$ = &Scatter{Table: $3}
+  }
+
+alter_zone_stmt:
+  ALTER DATABASE name CONFIGURE ZONE a_expr
+  {
+    $$ = &SetZoneConfig{Database: Name($3), YAMLConfig: $6}
+  }
+| ALTER TABLE relation_expr CONFIGURE ZONE a_expr
+  {
+    $$ = &SetZoneConfig{Table: $3, YAMLConfig: $6}
+  }
+
 alter_table_cmds:
   alter_table_cmd
   {
@@ -559,6 +611,16 @@ alter_table_cmd:
   {
     $$ = &AlterTableDropConstraint{IfExists: false, Constraint: $3}
   }
+  // ALTER TABLE <name> SET TTL <interval> ON <colname>
+| SET TTL a_expr ON name
+  {
+    $$ = &AlterTableSetTTL{TTL: $3, Column: $5}
+  }
+  // ALTER TABLE <name> RESET TTL
+| RESET TTL
+  {
+    $$ = &AlterTableSetTTL{}
+  }
 
 alter_column_default:
   SET DEFAULT a_expr { unimplemented() }
@@ -577,17 +639,46 @@ alter_using:
   USING a_expr { unimplemented() }
 | /* EMPTY */ {}
 
+// ANALYZE qualified_name
+analyze_stmt:
+  analyze_keyword qualified_name
+  {
+    $$ = &Analyze{Table: $2}
+  }
+
+analyze_keyword:
+  ANALYZE
+| ANALYSE
+
+// BACKUP privilege_target TO a_expr [INCREMENTAL FROM expr_list]
+backup_stmt:
+  BACKUP privilege_target TO a_expr opt_incremental
+  {
+    $$ = &Backup{Targets: $2, To: $4, IncrementalFrom: $5}
+  }
+
+opt_incremental:
+  INCREMENTAL FROM expr_list
+  {
+    $$ = $3
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
 // CREATE [DATABASE|INDEX|TABLE|TABLE AS]
 create_stmt:
   create_database_stmt
 | create_index_stmt
+| create_role_stmt
 | create_table_stmt
 
 // DELETE FROM query
 delete_stmt:
-  opt_with_clause DELETE FROM relation_expr_opt_alias where_clause
+  opt_with_clause DELETE FROM relation_expr_opt_alias where_clause opt_sort_clause opt_limit_clause
   {
-    $$ = &Delete{Table: $4, Where: newWhere(astWhere, $5)}
+    $$ = &Delete{Table: $4, Where: newWhere(astWhere, $5), OrderBy: $6, Limit: $7}
   }
 
 // DROP itemtype [ IF EXISTS ] itemname [, itemname ...] [ RESTRICT | CASCADE ]
@@ -596,6 +687,10 @@ drop_stmt:
   {
     $$ = &DropDatabase{Name: Name($3), IfExists: false}
   }
+| DROP ROLE name
+  {
+    $$ = &DropRole{Name: Name($3)}
+  }
 | DROP DATABASE IF EXISTS name
   {
     $$ = &DropDatabase{Name: Name($5), IfExists: true}
@@ -647,12 +742,24 @@ attrs:
     $$ = append($1, NameIndirection($3))
   }
 
+// EXECUTE query DISCARD ROWS
+execute_stmt:
+  EXECUTE explainable_stmt DISCARD ROWS
+  {
+    $$ = &Execute{Statement: $2, DiscardRows: true}
+  }
+
 // EXPLAIN (options) query
+// EXPLAIN ANALYZE query
 explain_stmt:
   EXPLAIN explainable_stmt
   {
     $$ = &Explain{Statement: $2}
   }
+| EXPLAIN ANALYZE explainable_stmt
+  {
+    $$ = &Explain{Options: []string{"ANALYZE"}, Statement: $3}
+  }
 | EXPLAIN '(' explain_option_list ')' explainable_stmt
   {
     $$ = &Explain{Options: $3, Statement: $5}
@@ -679,20 +786,72 @@ explain_option_list:
 
 explain_option_name:
   non_reserved_word
+| ANALYZE
 
 // GRANT privileges ON privilege_target TO grantee_list
+// GRANT role TO grantee_list
 grant_stmt:
   GRANT privileges ON privilege_target TO grantee_list
   {
     $$ = &Grant{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| GRANT name TO grantee_list
+  {
+    $$ = &GrantRole{Role: Name($2), Members: NameList($4)}
+  }
+
+// RESTORE privilege_target FROM expr_list
+restore_stmt:
+  RESTORE privilege_target FROM expr_list
+  {
+    $$ = &Restore{Targets: $2, From: $4}
+  }
+
+// IMPORT TABLE qualified_name (table_elem_list) CSV DATA (expr_list)
+//   [WITH kv_option_list]
+import_stmt:
+  IMPORT TABLE any_name '(' opt_table_elem_list ')' CSV DATA '(' expr_list ')' opt_with_options
+  {
+    $$ = &Import{Table: $3, Defs: $5, Files: $10, Options: $12}
+  }
+
+opt_with_options:
+  WITH kv_option_list
+  {
+    $$ = $2
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+kv_option_list:
+  kv_option
+  {
+    $$ = KVOptions{$1}
+  }
+| kv_option_list ',' kv_option
+  {
+    $$ = append($1, $3)
+  }
+
+kv_option:
+  name '=' a_expr
+  {
+    $$ = KVOption{Key: Name($1), Value: $3}
+  }
 
 // REVOKE privileges ON privilege_target FROM grantee_list
+// REVOKE role FROM grantee_list
 revoke_stmt:
   REVOKE privileges ON privilege_target FROM grantee_list
   {
     $$ = &Revoke{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| REVOKE name FROM grantee_list
+  {
+    $$ = &RevokeRole{Role: Name($2), Members: NameList($4)}
+  }
 
 
 privilege_target:
@@ -943,6 +1102,13 @@ non_reserved_word_or_sconst:
     $$ = DString($1)
   }
 
+// VALIDATE privilege_target
+validate_stmt:
+  VALIDATE privilege_target
+  {
+    $$ = &Validate{Targets: $2}
+  }
+
 show_stmt:
   SHOW IDENT
   {
@@ -1026,6 +1192,10 @@ create_table_stmt:
   {
     $$ = &CreateTable{Table: $6, IfNotExists: true, Defs: $8}
   }
+| CREATE EXTERNAL TABLE any_name '(' opt_table_elem_list ')' CSV DATA '(' expr_list ')' opt_with_options
+  {
+    $$ = &CreateExternalTable{Table: $4, Defs: $6, Files: $11, Options: $13}
+  }
 
 opt_table_elem_list:
   table_elem_list
@@ -1122,6 +1292,18 @@ col_qualification_elem:
     }
     $$ = &ColumnDefault{Expr: $2}
   }
+| ON UPDATE b_expr
+  {
+    if ContainsVars($3) {
+      sqllex.Error("on update expression contains a variable")
+      return 1
+    }
+    if containsSubquery($3) {
+      sqllex.Error("on update expression contains a subquery")
+      return 1
+    }
+    $$ = &ColumnOnUpdate{Expr: $3}
+  }
 | REFERENCES qualified_name opt_column_list key_match key_actions { unimplemented() }
 
 index_def:
@@ -1225,11 +1407,13 @@ key_match:
 // production. update is in the left 8 bits, delete in the right. Note that
 // NOACTION is the default.
 key_actions:
-  key_update { unimplemented() }
-| key_delete { unimplemented() }
+  // Prefer shifting ON, which means that an ON UPDATE column qualification
+  // directly following a REFERENCES qualification is parsed as a key action.
+  key_update %prec SET { unimplemented() }
+| key_delete %prec SET { unimplemented() }
 | key_update key_delete { unimplemented() }
 | key_delete key_update { unimplemented() }
-| /* EMPTY */ {}
+| /* EMPTY */ %prec SET {}
 
 key_update:
   ON UPDATE key_action { unimplemented() }
@@ -1302,7 +1486,7 @@ opt_unique:
 index_params:
   index_elem
   {
-    $$ = []string{$1}
+    $$ = IndexElemList{$1}
   }
 | index_params ',' index_elem
   {
@@ -1315,8 +1499,7 @@ index_params:
 index_elem:
   name opt_collate opt_asc_desc
   {
-    // TODO(pmattis): Support opt_asc_desc.
-    $$ = $1
+    $$ = IndexElem{Column: Name($1), Direction: $3}
   }
 | func_expr_windowless opt_collate opt_asc_desc { unimplemented() }
 | '(' a_expr ')' opt_collate opt_asc_desc { unimplemented() }
@@ -1339,6 +1522,28 @@ opt_asc_desc:
     $$ = DefaultDirection
   }
 
+// COMMENT ON { TABLE qualified_name | COLUMN qualified_name } IS comment_text
+comment_stmt:
+  COMMENT ON TABLE qualified_name IS comment_text
+  {
+    $$ = &CommentOnTable{Table: $4, Comment: $6}
+  }
+| COMMENT ON COLUMN qualified_name IS comment_text
+  {
+    $$ = &CommentOnColumn{Column: $4, Comment: $6}
+  }
+
+comment_text:
+  SCONST
+  {
+    s := $1
+    $$ = &s
+  }
+| NULL
+  {
+    $$ = nil
+  }
+
 // ALTER THING name RENAME TO newname
 rename_stmt:
   ALTER DATABASE name RENAME TO name
@@ -1434,6 +1639,13 @@ create_database_stmt:
     $$ = &CreateDatabase{IfNotExists: true, Name: Name($6)}
   }
 
+// CREATE ROLE name
+create_role_stmt:
+  CREATE ROLE name
+  {
+    $$ = &CreateRole{Name: Name($3)}
+  }
+
 insert_stmt:
   opt_with_clause INSERT INTO insert_target insert_rest opt_on_conflict
   {
@@ -1476,9 +1688,9 @@ opt_conf_expr:
 
 update_stmt:
   opt_with_clause UPDATE relation_expr_opt_alias
-    SET set_clause_list from_clause where_clause
+    SET set_clause_list from_clause where_clause opt_sort_clause opt_limit_clause
   {
-    $$ = &Update{Table: $3, Exprs: $5, Where: newWhere(astWhere, $7)}
+    $$ = &Update{Table: $3, Exprs: $5, Where: newWhere(astWhere, $7), OrderBy: $8, Limit: $9}
   }
 
 set_clause_list:
@@ -1831,6 +2043,14 @@ limit_clause:
 // TODO(pmattis): Should we support this?
 // | FETCH first_or_next opt_select_fetch_first_value row_or_rows ONLY { unimplemented() }
 
+// The LIMIT of UPDATE and DELETE, which unlike that of SELECT has no OFFSET.
+opt_limit_clause:
+  limit_clause
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
 offset_clause:
   OFFSET a_expr
   {
@@ -2123,16 +2343,37 @@ where_clause:
 typename:
   simple_typename opt_array_bounds
   {
-    $$ = $1
+    if $2 {
+      $$ = &ArrayType{ElemType: $1}
+    } else {
+      $$ = $1
+    }
   }
   // SQL standard syntax, currently only one-dimensional
-| simple_typename ARRAY '[' ICONST ']' { unimplemented() }
-| simple_typename ARRAY { unimplemented() }
+| simple_typename ARRAY '[' ICONST ']'
+  {
+    $$ = &ArrayType{ElemType: $1}
+  }
+| simple_typename ARRAY
+  {
+    $$ = &ArrayType{ElemType: $1}
+  }
 
+// Only one-dimensional arrays are supported. As in PostgreSQL, the declared
+// size of the array is ignored.
 opt_array_bounds:
-  opt_array_bounds '[' ']' { unimplemented() }
-| opt_array_bounds '[' ICONST ']' { unimplemented() }
-| /* EMPTY */ {}
+  '[' ']'
+  {
+    $$ = true
+  }
+| '[' ICONST ']'
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 simple_typename:
   numeric
@@ -2474,6 +2715,30 @@ a_expr:
   {
     $$ = &ComparisonExpr{Operator: NE, Left: $1, Right: $3}
   }
+| a_expr '~' a_expr
+  {
+    $$ = &ComparisonExpr{Operator: RegMatch, Left: $1, Right: $3}
+  }
+| a_expr NOT_REGMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: NotRegMatch, Left: $1, Right: $3}
+  }
+| a_expr REGIMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: RegIMatch, Left: $1, Right: $3}
+  }
+| a_expr NOT_REGIMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: NotRegIMatch, Left: $1, Right: $3}
+  }
+| a_expr CONTAINS a_expr
+  {
+    $$ = &ComparisonExpr{Operator: Contains, Left: $1, Right: $3}
+  }
+| a_expr CONTAINED_BY a_expr
+  {
+    $$ = &ComparisonExpr{Operator: ContainedBy, Left: $1, Right: $3}
+  }
 | a_expr AND a_expr
   {
     $$ = &AndExpr{Left: $1, Right: $3}
@@ -2498,6 +2763,14 @@ a_expr:
   {
     $$ = &ComparisonExpr{Operator: NotLike, Left: $1, Right: $4}
   }
+| a_expr ILIKE a_expr
+  {
+    $$ = &ComparisonExpr{Operator: ILike, Left: $1, Right: $3}
+  }
+| a_expr NOT_LA ILIKE a_expr %prec NOT_LA
+  {
+    $$ = &ComparisonExpr{Operator: NotILike, Left: $1, Right: $4}
+  }
 | a_expr SIMILAR TO a_expr %prec SIMILAR
   {
     $$ = &ComparisonExpr{Operator: SimilarTo, Left: $1, Right: $4}
@@ -2717,6 +2990,14 @@ c_expr:
   {
     $$ = &ParenExpr{Expr: $2}
   }
+| '(' a_expr ')' '[' a_expr ']'
+  {
+    $$ = &SubscriptExpr{Expr: &ParenExpr{Expr: $2}, Index: &ArrayIndirection{Begin: $5}}
+  }
+| '(' a_expr ')' '[' a_expr ':' a_expr ']'
+  {
+    $$ = &SubscriptExpr{Expr: &ParenExpr{Expr: $2}, Index: &ArrayIndirection{Begin: $5, End: $7}}
+  }
 | case_expr
 | func_expr
 | select_with_parens %prec UMINUS
@@ -3452,15 +3733,19 @@ unreserved_keyword:
 | ADD
 | ALTER
 | AT
+| BACKUP
 | BEGIN
 | BLOB
 | BY
 | CASCADE
 | COLUMNS
+| COMMENT
 | COMMIT
 | COMMITTED
+| CONFIGURE
 | CONFLICT
 | COVERING
+| CSV
 | CUBE
 | CURRENT
 | CYCLE
@@ -3471,12 +3756,16 @@ unreserved_keyword:
 | DELETE
 | DOUBLE
 | DROP
+| EXECUTE
 | EXPLAIN
+| EXTERNAL
 | FILTER
 | FIRST
 | FOLLOWING
 | GRANTS
 | HOUR
+| IMPORT
+| INCREMENTAL
 | INSERT
 | ISOLATION
 | KEY
@@ -3504,11 +3793,15 @@ unreserved_keyword:
 | REF
 | RENAME
 | REPEATABLE
+| RESET
+| RESTORE
 | RESTRICT
 | REVOKE
+| ROLE
 | ROLLBACK
 | ROLLUP
 | ROWS
+| SCATTER
 | SEARCH
 | SECOND
 | SERIALIZABLE
@@ -3517,6 +3810,7 @@ unreserved_keyword:
 | SHOW
 | SIMPLE
 | SNAPSHOT
+| SPLIT
 | SQL
 | STORING
 | STRICT
@@ -3524,6 +3818,7 @@ unreserved_keyword:
 | TEXT
 | TRANSACTION
 | TRUNCATE
+| TTL
 | TYPE
 | UNBOUNDED
 | UNCOMMITTED
@@ -3603,6 +3898,7 @@ type_func_name_keyword:
   COLLATION
 | CROSS
 | FULL
+| ILIKE
 | INNER
 | IS
 | JOIN
@@ -3645,6 +3941,7 @@ reserved_keyword:
 | DEFAULT
 | DEFERRABLE
 | DESC
+| DISCARD
 | DISTINCT
 | DO
 | ELSE
@@ -61,18 +61,36 @@ type Statement interface {
 // StatementType implements the Statement interface.
 func (*AlterTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Analyze) StatementType() StatementType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Backup) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*BeginTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*CommentOnColumn) StatementType() StatementType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CommentOnTable) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*CommitTransaction) StatementType() StatementType { return Ack }
 
 // StatementType implements the Statement interface.
 func (*CreateDatabase) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*CreateExternalTable) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*CreateIndex) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*CreateRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*CreateTable) StatementType() StatementType { return DDL }
 
@@ -85,15 +103,27 @@ func (*DropDatabase) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*DropIndex) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*DropRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*DropTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Execute) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*Explain) StatementType() StatementType { return Rows }
 
 // StatementType implements the Statement interface.
 func (*Grant) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*GrantRole) StatementType() StatementType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*Import) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Insert) StatementType() StatementType { return RowsAffected }
 
@@ -112,12 +142,21 @@ func (*RenameIndex) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*RenameTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Restore) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Revoke) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*RevokeRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*RollbackTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*Scatter) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*Select) StatementType() StatementType { return Rows }
 
@@ -130,6 +169,9 @@ func (*SetTransaction) StatementType() StatementType { return Ack }
 // StatementType implements the Statement interface.
 func (*SetTimeZone) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*SetZoneConfig) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Show) StatementType() StatementType { return Rows }
 
@@ -148,6 +190,9 @@ func (*ShowIndex) StatementType() StatementType { return Rows }
 // StatementType implements the Statement interface.
 func (*ShowTables) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*Split) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*Truncate) StatementType() StatementType { return Ack }
 
@@ -157,5 +202,8 @@ func (*Update) StatementType() StatementType { return RowsAffected }
 // StatementType implements the Statement interface.
 func (*Union) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*Validate) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (Values) StatementType() StatementType { return Rows }
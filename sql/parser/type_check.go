@@ -128,7 +128,7 @@ func (expr *CastExpr) TypeCheck() (Datum, error) {
 		return nil, err
 	}
 
-	switch expr.Type.(type) {
+	switch t := expr.Type.(type) {
 	case *BoolType:
 		switch dummyExpr {
 		case DummyBool, DummyInt, DummyFloat, DummyString:
@@ -177,6 +177,22 @@ func (expr *CastExpr) TypeCheck() (Datum, error) {
 			return DummyInterval, nil
 		}
 
+	case *ArrayType:
+		switch d := dummyExpr.(type) {
+		case *DArray:
+			elem := d.elemType()
+			if elem == DNull {
+				return dummyArray, nil
+			}
+			elem, err := (&CastExpr{Expr: elem, Type: t.ElemType}).TypeCheck()
+			if err != nil {
+				return nil, err
+			}
+			return arrayPlaceholder(elem)
+		case dNull:
+			return DNull, nil
+		}
+
 	// TODO(pmattis): unimplemented.
 	case *DecimalType:
 	}
@@ -407,7 +423,50 @@ func (expr *UnaryExpr) TypeCheck() (Datum, error) {
 
 // TypeCheck implements the Expr interface.
 func (expr Array) TypeCheck() (Datum, error) {
-	return nil, util.Errorf("unhandled type %T", expr)
+	elems := make(DTuple, 0, len(expr))
+	for _, e := range expr {
+		d, err := e.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, d)
+	}
+	elem, err := arrayElemType(elems)
+	if err != nil {
+		return nil, err
+	}
+	return arrayPlaceholder(elem)
+}
+
+// TypeCheck implements the Expr interface.
+func (expr *SubscriptExpr) TypeCheck() (Datum, error) {
+	dummyExpr, err := expr.Expr.TypeCheck()
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range []Expr{expr.Index.Begin, expr.Index.End} {
+		if e == nil {
+			continue
+		}
+		dummyIndex, err := e.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		if dummyIndex != DummyInt && dummyIndex != DNull {
+			return nil, fmt.Errorf("array subscript must be type int, not %s", dummyIndex.Type())
+		}
+	}
+	if dummyExpr == DNull {
+		return DNull, nil
+	}
+	array, ok := dummyExpr.(*DArray)
+	if !ok {
+		return nil, fmt.Errorf("cannot subscript type %s because it is not an array", dummyExpr.Type())
+	}
+	if expr.Index.End != nil {
+		return array, nil
+	}
+	return array.elemType(), nil
 }
 
 // TypeCheck implements the Expr interface.
@@ -506,6 +565,28 @@ func (expr DTuple) TypeCheck() (Datum, error) {
 	return tuple, nil
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *DArray) TypeCheck() (Datum, error) {
+	return arrayPlaceholder(expr.elemType())
+}
+
+// arrayElemType returns the type of the non-NULL elements (or DNull if there
+// are none), returning an error if the elements do not all have the same type.
+func arrayElemType(elems DTuple) (Datum, error) {
+	var elem Datum = DNull
+	for _, d := range elems {
+		if d == DNull {
+			continue
+		}
+		if elem == DNull {
+			elem = d
+		} else if reflect.TypeOf(d) != reflect.TypeOf(elem) {
+			return nil, fmt.Errorf("ARRAY types %s and %s cannot be matched", elem.Type(), d.Type())
+		}
+	}
+	return elem, nil
+}
+
 func typeCheckBooleanExprs(exprs ...Expr) (Datum, error) {
 	for _, expr := range exprs {
 		dummyExpr, err := expr.TypeCheck()
@@ -543,6 +624,13 @@ func typeCheckComparisonOp(op ComparisonOp, dummyLeft, dummyRight Datum) (Datum,
 			if err := typeCheckTupleEQ(dummyLeft, dummyRight); err != nil {
 				return nil, cmpOp{}, err
 			}
+		} else if lType == arrayType && rType == arrayType {
+			lElem := dummyLeft.(*DArray).elemType()
+			rElem := dummyRight.(*DArray).elemType()
+			if lElem != DNull && rElem != DNull && lElem != rElem {
+				return nil, cmpOp{}, fmt.Errorf("unsupported comparison operator: <%s> %s <%s>",
+					dummyLeft.Type(), op, dummyRight.Type())
+			}
 		} else if op == In && rType == tupleType {
 			if err := typeCheckTupleIN(dummyLeft, dummyRight); err != nil {
 				return nil, cmpOp{}, err
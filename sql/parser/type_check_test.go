@@ -55,6 +55,10 @@ func TestTypeCheck(t *testing.T) {
 		`true IS NOT TRUE`,
 		`true IS FALSE`,
 		`true IS NOT FALSE`,
+		`ARRAY[1, NULL, 2]`,
+		`ARRAY[NULL] = ARRAY[1]`,
+		`(ARRAY[1])[NULL]`,
+		`COALESCE(ARRAY[1], ARRAY[2])`,
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d)
@@ -103,6 +107,14 @@ func TestTypeCheckError(t *testing.T) {
 		{`NULLIF(1, 2.0)`, `incompatible NULLIF expressions int, float`},
 		{`COALESCE(1, 2.0)`, `incompatible COALESCE expressions int, float`},
 		{`COALESCE(1, 2, 3, 4, '5')`, `incompatible COALESCE expressions int, string`},
+		{`ARRAY[1, 'a']`, `ARRAY types int and string cannot be matched`},
+		{`ARRAY[ARRAY[1]]`, `arrays of int[] are not supported`},
+		{`ARRAY[1] = ARRAY['a']`, `unsupported comparison operator: <int[]> = <string[]>`},
+		{`ARRAY[1] @> ARRAY[1.0]`, `unsupported comparison operator: <int[]> @> <float[]>`},
+		{`ARRAY[1] @> 1`, `unsupported comparison operator:`},
+		{`(ARRAY[1])['a']`, `array subscript must be type int, not string`},
+		{`(1)[1]`, `cannot subscript type int because it is not an array`},
+		{`ARRAY[1.5]::DATE[]`, `invalid cast: float -> DATE`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
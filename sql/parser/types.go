@@ -37,6 +37,7 @@ func (*TimestampType) columnType() {}
 func (*IntervalType) columnType()  {}
 func (*StringType) columnType()    {}
 func (*BytesType) columnType()     {}
+func (*ArrayType) columnType()     {}
 
 // BoolType represents a BOOLEAN type.
 type BoolType struct {
@@ -144,3 +145,12 @@ type BytesType struct {
 func (node *BytesType) String() string {
 	return node.Name
 }
+
+// ArrayType represents a one-dimensional ARRAY type (e.g. INT[]).
+type ArrayType struct {
+	ElemType ColumnType
+}
+
+func (node *ArrayType) String() string {
+	return fmt.Sprintf("%s[]", node.ElemType)
+}
@@ -30,14 +30,16 @@ import (
 
 // Update represents an UPDATE statement.
 type Update struct {
-	Table TableExpr
-	Exprs UpdateExprs
-	Where *Where
+	Table   TableExpr
+	Exprs   UpdateExprs
+	Where   *Where
+	OrderBy OrderBy
+	Limit   *Limit
 }
 
 func (node *Update) String() string {
-	return fmt.Sprintf("UPDATE %s SET %s%s",
-		node.Table, node.Exprs, node.Where)
+	return fmt.Sprintf("UPDATE %s SET %s%s%s%s",
+		node.Table, node.Exprs, node.Where, node.OrderBy, node.Limit)
 }
 
 // UpdateExprs represents a list of update expressions.
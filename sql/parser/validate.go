@@ -0,0 +1,29 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// Validate represents a VALIDATE statement, which checks the descriptors of
+// the targeted tables, or of the targeted databases and their tables, for
+// corruption.
+type Validate struct {
+	Targets TargetList
+}
+
+func (node *Validate) String() string {
+	return fmt.Sprintf("VALIDATE %s", node.Targets)
+}
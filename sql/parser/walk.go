@@ -144,6 +144,15 @@ func (expr Array) Walk(v Visitor) {
 	}
 }
 
+// Walk implements the Expr interface.
+func (expr *SubscriptExpr) Walk(v Visitor) {
+	expr.Expr = WalkExpr(v, expr.Expr)
+	expr.Index.Begin = WalkExpr(v, expr.Index.Begin)
+	if expr.Index.End != nil {
+		expr.Index.End = WalkExpr(v, expr.Index.End)
+	}
+}
+
 // Walk implements the Expr interface.
 func (DefaultVal) Walk(_ Visitor) {}
 
@@ -200,6 +209,9 @@ func (DTimestamp) Walk(_ Visitor) {}
 // Walk implements the Expr interface.
 func (DTuple) Walk(_ Visitor) {}
 
+// Walk implements the Expr interface.
+func (*DArray) Walk(_ Visitor) {}
+
 // WalkExpr traverses the nodes in an expression.
 func WalkExpr(v Visitor, expr Expr) Expr {
 	v, expr = v.Visit(expr, true)
@@ -250,21 +262,80 @@ func FillArgs(stmt Statement, args Args) error {
 	return v.err
 }
 
+type fingerprintVisitor struct{}
+
+var _ Visitor = fingerprintVisitor{}
+
+func (v fingerprintVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
+	if !pre {
+		return nil, expr
+	}
+	switch expr.(type) {
+	case IntVal, NumVal:
+		return nil, ValArg{name: "_"}
+	case Datum:
+		if expr != DNull {
+			return nil, ValArg{name: "_"}
+		}
+	}
+	return v, expr
+}
+
+// Fingerprint returns the statement with its constants replaced by the
+// placeholder $_, so that statements which differ only in their constants
+// share a fingerprint.
+func Fingerprint(stmt Statement) string {
+	// Walking a statement modifies it, so walk a copy of the statement
+	// obtained by parsing it again.
+	stmts, err := ParseTraditional(stmt.String())
+	if err != nil || len(stmts) != 1 {
+		return stmt.String()
+	}
+	WalkStmt(fingerprintVisitor{}, stmts[0])
+	return stmts[0].String()
+}
+
 // WalkStmt walks the entire parsed stmt calling WalkExpr on each
 // expression, and replacing each expression with the one returned
 // by WalkExpr.
 func WalkStmt(v Visitor, stmt Statement) {
 	switch stmt := stmt.(type) {
+	case *Backup:
+		stmt.To = WalkExpr(v, stmt.To)
+		for i, expr := range stmt.IncrementalFrom {
+			stmt.IncrementalFrom[i] = WalkExpr(v, expr)
+		}
+	case *CreateExternalTable:
+		for i, expr := range stmt.Files {
+			stmt.Files[i] = WalkExpr(v, expr)
+		}
+		for i, opt := range stmt.Options {
+			stmt.Options[i].Value = WalkExpr(v, opt.Value)
+		}
 	case *Delete:
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
+		walkOrderByAndLimit(v, stmt.OrderBy, stmt.Limit)
+	case *Execute:
+		WalkStmt(v, stmt.Statement)
 	case *Explain:
 		WalkStmt(v, stmt.Statement)
+	case *Import:
+		for i, expr := range stmt.Files {
+			stmt.Files[i] = WalkExpr(v, expr)
+		}
+		for i, opt := range stmt.Options {
+			stmt.Options[i].Value = WalkExpr(v, opt.Value)
+		}
 	case *Insert:
 		WalkStmt(v, stmt.Rows)
 	case *ParenSelect:
 		WalkStmt(v, stmt.Select)
+	case *Restore:
+		for i, expr := range stmt.From {
+			stmt.From[i] = WalkExpr(v, expr)
+		}
 	case *Select:
 		for i := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, stmt.Exprs[i].Expr)
@@ -278,21 +349,17 @@ func WalkStmt(v Visitor, stmt Statement) {
 		if stmt.Having != nil {
 			stmt.Having.Expr = WalkExpr(v, stmt.Having.Expr)
 		}
-		for i, expr := range stmt.OrderBy {
-			stmt.OrderBy[i].Expr = WalkExpr(v, expr.Expr)
-		}
-		if stmt.Limit != nil {
-			if stmt.Limit.Offset != nil {
-				stmt.Limit.Offset = WalkExpr(v, stmt.Limit.Offset)
-			}
-			if stmt.Limit.Count != nil {
-				stmt.Limit.Count = WalkExpr(v, stmt.Limit.Count)
-			}
-		}
+		walkOrderByAndLimit(v, stmt.OrderBy, stmt.Limit)
 	case *Set:
 		for i, expr := range stmt.Values {
 			stmt.Values[i] = WalkExpr(v, expr)
 		}
+	case *SetZoneConfig:
+		stmt.YAMLConfig = WalkExpr(v, stmt.YAMLConfig)
+	case *Split:
+		for i, expr := range stmt.Exprs {
+			stmt.Exprs[i] = WalkExpr(v, expr)
+		}
 	case *Update:
 		for i, expr := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, expr.Expr)
@@ -300,6 +367,7 @@ func WalkStmt(v Visitor, stmt Statement) {
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
+		walkOrderByAndLimit(v, stmt.OrderBy, stmt.Limit)
 	case Values:
 		for i, tuple := range stmt {
 			stmt[i] = WalkExpr(v, tuple).(Tuple)
@@ -307,6 +375,20 @@ func WalkStmt(v Visitor, stmt Statement) {
 	}
 }
 
+func walkOrderByAndLimit(v Visitor, orderBy OrderBy, limit *Limit) {
+	for i, expr := range orderBy {
+		orderBy[i].Expr = WalkExpr(v, expr.Expr)
+	}
+	if limit != nil {
+		if limit.Offset != nil {
+			limit.Offset = WalkExpr(v, limit.Offset)
+		}
+		if limit.Count != nil {
+			limit.Count = WalkExpr(v, limit.Count)
+		}
+	}
+}
+
 type containsSubqueryVisitor struct {
 	containsSubquery bool
 }
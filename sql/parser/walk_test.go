@@ -160,3 +160,30 @@ func TestWalkStmt(t *testing.T) {
 		}
 	}
 }
+
+func TestFingerprint(t *testing.T) {
+	testData := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT a FROM t WHERE b = 1 AND c = 'x'`, `SELECT a FROM t WHERE b = $_ AND c = $_`},
+		{`SELECT a FROM t WHERE b IS NULL LIMIT 10`, `SELECT a FROM t WHERE b IS NULL LIMIT $_`},
+		{`INSERT INTO t VALUES (1, 2.5, 'a')`, `INSERT INTO t VALUES ($_, $_, $_)`},
+		{`UPDATE t SET a = a + 1 WHERE b = $1`, `UPDATE t SET a = a + $_ WHERE b = $1`},
+		{`DELETE FROM t WHERE a IN (1, 2)`, `DELETE FROM t WHERE a IN ($_, $_)`},
+		{`CREATE TABLE t (a INT)`, `CREATE TABLE t (a INT)`},
+	}
+	for _, d := range testData {
+		stmts, err := ParseTraditional(d.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if s := Fingerprint(stmts[0]); s != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.sql, d.expected, s)
+		}
+		// The statement itself is left untouched.
+		if s := stmts[0].String(); s == d.expected && d.sql != d.expected {
+			t.Errorf("%s: statement was modified", d.sql)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// SetZoneConfig represents an ALTER DATABASE ... CONFIGURE ZONE or ALTER
+// TABLE ... CONFIGURE ZONE statement. Exactly one of Database and Table is
+// set. The YAMLConfig expression evaluates to the YAML representation of the
+// zone config, or to NULL to remove the zone config.
+type SetZoneConfig struct {
+	Database   Name
+	Table      *QualifiedName
+	YAMLConfig Expr
+}
+
+func (node *SetZoneConfig) String() string {
+	if node.Table != nil {
+		return fmt.Sprintf("ALTER TABLE %s CONFIGURE ZONE %s", node.Table, node.YAMLConfig)
+	}
+	return fmt.Sprintf("ALTER DATABASE %s CONFIGURE ZONE %s", node.Database, node.YAMLConfig)
+}
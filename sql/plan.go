@@ -35,6 +35,7 @@ type schemaInfo struct {
 // planner is the centerpiece of SQL statement execution combining session
 // state and database state with the logic for SQL execution.
 type planner struct {
+	db           *client.DB
 	txn          *client.Txn
 	session      Session
 	user         string
@@ -42,6 +43,17 @@ type planner struct {
 	leases       map[ID]*LeaseState
 	leaseMgr     *LeaseManager
 	systemConfig *config.SystemConfig
+	flows        *flowContext
+	stores       *storeCache
+	planCache    *planCache
+	tableStats   *tableStatsCache
+	roles        []string         // the roles of the user, resolved on first use
+	sessions     *sessionRegistry // may be nil
+	mem          *sessionMemory   // the memory used by the request; may be nil
+	stmtMemory   memoryAccount    // the memory used by the plan of the current statement
+	// sessionHandedOff is set once a session token has been issued for the
+	// session, which may then be resumed on another node.
+	sessionHandedOff bool
 
 	// TODO(pmattis): This is a hack to force updating to the latest version of a
 	// lease after a schema change operation such as CREATE INDEX.
@@ -73,14 +85,26 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	switch n := stmt.(type) {
 	case *parser.AlterTable:
 		return p.AlterTable(n)
+	case *parser.Analyze:
+		return p.Analyze(n)
+	case *parser.Backup:
+		return p.Backup(n)
 	case *parser.BeginTransaction:
 		return p.BeginTransaction(n)
+	case *parser.CommentOnColumn:
+		return p.CommentOnColumn(n)
+	case *parser.CommentOnTable:
+		return p.CommentOnTable(n)
 	case *parser.CommitTransaction:
 		return p.CommitTransaction(n)
 	case *parser.CreateDatabase:
 		return p.CreateDatabase(n)
+	case *parser.CreateExternalTable:
+		return p.CreateExternalTable(n)
 	case *parser.CreateIndex:
 		return p.CreateIndex(n)
+	case *parser.CreateRole:
+		return p.CreateRole(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
 	case *parser.Delete:
@@ -89,12 +113,20 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.DropDatabase(n)
 	case *parser.DropIndex:
 		return p.DropIndex(n)
+	case *parser.DropRole:
+		return p.DropRole(n)
 	case *parser.DropTable:
 		return p.DropTable(n)
+	case *parser.Execute:
+		return p.Execute(n)
 	case *parser.Explain:
 		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
+	case *parser.GrantRole:
+		return p.GrantRole(n)
+	case *parser.Import:
+		return p.Import(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
@@ -107,10 +139,16 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.RenameIndex(n)
 	case *parser.RenameTable:
 		return p.RenameTable(n)
+	case *parser.Restore:
+		return p.Restore(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
+	case *parser.RevokeRole:
+		return p.RevokeRole(n)
 	case *parser.RollbackTransaction:
 		return p.RollbackTransaction(n)
+	case *parser.Scatter:
+		return p.Scatter(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
@@ -119,6 +157,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.SetTimeZone(n)
 	case *parser.SetTransaction:
 		return p.SetTransaction(n)
+	case *parser.SetZoneConfig:
+		return p.SetZoneConfig(n)
 	case *parser.Show:
 		return p.Show(n)
 	case *parser.ShowColumns:
@@ -131,10 +171,14 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowIndex(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.Split:
+		return p.Split(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
 	case *parser.Update:
 		return p.Update(n)
+	case *parser.Validate:
+		return p.Validate(n)
 	case parser.Values:
 		return p.Values(n)
 	default:
@@ -271,9 +315,11 @@ type planNode interface {
 }
 
 var _ planNode = &distinctNode{}
+var _ planNode = &flowNode{}
 var _ planNode = &groupNode{}
 var _ planNode = &indexJoinNode{}
 var _ planNode = &limitNode{}
 var _ planNode = &scanNode{}
 var _ planNode = &sortNode{}
+var _ planNode = &unnestNode{}
 var _ planNode = &valuesNode{}
@@ -0,0 +1,117 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/util/cache"
+)
+
+// planCacheSize is the number of index selections remembered by an
+// Executor.
+const planCacheSize = 1024
+
+// planCacheKey identifies the index selection of a scan: the table and the
+// version of its descriptor and of its statistics the selection was made
+// against, along with the filter and the ordering the index was selected
+// for. A schema change bumps the version of the descriptor and ANALYZE
+// replaces the statistics, so the selections made against the previous
+// versions are no longer found and are eventually evicted.
+type planCacheKey struct {
+	tableID  ID
+	version  uint32
+	stats    int64   // the creation time of the statistics, if any
+	indexID  IndexID // the explicitly requested index, if any
+	filter   string
+	ordering string
+}
+
+func makePlanCacheKey(s *scanNode, ordering []int, stats *tableStatistics) planCacheKey {
+	key := planCacheKey{
+		tableID:  s.desc.ID,
+		version:  s.desc.Version,
+		ordering: fmt.Sprint(ordering),
+	}
+	if stats != nil {
+		key.stats = stats.createdAt.UnixNano()
+	}
+	if s.isSecondaryIndex {
+		key.indexID = s.index.ID
+	}
+	if s.filter != nil {
+		key.filter = s.filter.String()
+	}
+	return key
+}
+
+// A planCache remembers the index selected for scans, so that planning an
+// identical statement again only needs to analyze the selected index
+// instead of every index of the table. The cache is defensive: a cached
+// selection only narrows down the indexes considered, and is ignored if the
+// index no longer exists. The cache is safe for concurrent use and can be
+// used through a nil reference, in which case it caches nothing.
+type planCache struct {
+	mu     sync.Mutex
+	cache  *cache.UnorderedCache
+	hits   int64
+	misses int64
+}
+
+func newPlanCache(size int) *planCache {
+	return &planCache{
+		cache: cache.NewUnorderedCache(cache.Config{
+			Policy: cache.CacheLRU,
+			ShouldEvict: func(s int, key, value interface{}) bool {
+				return s > size
+			},
+		}),
+	}
+}
+
+// lookup returns the index previously selected for the given key.
+func (pc *planCache) lookup(key planCacheKey) (IndexID, bool) {
+	if pc == nil {
+		return 0, false
+	}
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	v, ok := pc.cache.Get(key)
+	if !ok {
+		pc.misses++
+		return 0, false
+	}
+	pc.hits++
+	return v.(IndexID), true
+}
+
+// add records the index selected for the given key.
+func (pc *planCache) add(key planCacheKey, indexID IndexID) {
+	if pc == nil {
+		return
+	}
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.cache.Add(key, indexID)
+}
+
+// stats returns the number of cache hits and misses.
+func (pc *planCache) stats() (hits, misses int64) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	return pc.hits, pc.misses
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPlanCache(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := testTableDesc()
+	expr, _ := parseAndNormalizeExpr(t, `a = 1`)
+	s := &scanNode{desc: desc, index: &desc.PrimaryIndex, filter: expr}
+	key := makePlanCacheKey(s, nil, nil)
+
+	pc := newPlanCache(2)
+	if _, ok := pc.lookup(key); ok {
+		t.Fatalf("unexpected cache hit for %+v", key)
+	}
+	pc.add(key, 2)
+	if id, ok := pc.lookup(key); !ok || id != 2 {
+		t.Fatalf("expected index 2 to be cached for %+v, got %d (%t)", key, id, ok)
+	}
+
+	// A different filter, ordering, statistics or descriptor version is a
+	// different scan.
+	otherExpr, _ := parseAndNormalizeExpr(t, `a = 2`)
+	s.filter = otherExpr
+	otherFilter := makePlanCacheKey(s, nil, nil)
+	s.filter = expr
+	otherOrdering := makePlanCacheKey(s, []int{1}, nil)
+	otherStats := makePlanCacheKey(s, nil, &tableStatistics{createdAt: time.Unix(1, 0)})
+	desc.Version++
+	otherVersion := makePlanCacheKey(s, nil, nil)
+	for _, k := range []planCacheKey{otherFilter, otherOrdering, otherStats, otherVersion} {
+		if _, ok := pc.lookup(k); ok {
+			t.Errorf("unexpected cache hit for %+v", k)
+		}
+	}
+	if hits, misses := pc.stats(); hits != 1 || misses != 5 {
+		t.Errorf("expected 1 hit and 5 misses, got %d and %d", hits, misses)
+	}
+
+	// The least recently used selections are evicted.
+	pc.add(otherFilter, 1)
+	pc.add(otherOrdering, 1)
+	if _, ok := pc.lookup(key); ok {
+		t.Errorf("expected %+v to be evicted", key)
+	}
+
+	// A nil cache caches nothing.
+	var nilCache *planCache
+	nilCache.add(key, 2)
+	if _, ok := nilCache.lookup(key); ok {
+		t.Errorf("unexpected cache hit in nil cache")
+	}
+}
@@ -2,25 +2,6 @@
 // source: cockroach/sql/privilege.proto
 // DO NOT EDIT!
 
-/*
-	Package sql is a generated protocol buffer package.
-
-	It is generated from these files:
-		cockroach/sql/privilege.proto
-		cockroach/sql/session.proto
-		cockroach/sql/structured.proto
-
-	It has these top-level messages:
-		UserPrivileges
-		PrivilegeDescriptor
-		Session
-		ColumnType
-		ColumnDescriptor
-		IndexDescriptor
-		TableDescriptor
-		DatabaseDescriptor
-		Descriptor
-*/
 package sql
 
 import proto "github.com/gogo/protobuf/proto"
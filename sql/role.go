@@ -0,0 +1,212 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Roles share the namespace of users in the system.users table: a role is a
+// user without a password. Any user or role can be granted membership in a
+// role, which gives it the privileges of the role and of the roles the role
+// is a member of. Memberships are stored in the system.role_members table.
+
+// CreateRole creates a role.
+// Privileges: "root" user.
+//   Notes: postgres requires the CREATEROLE attribute.
+//          mysql requires the CREATE ROLE privilege.
+func (p *planner) CreateRole(n *parser.CreateRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to create roles", security.RootUser)
+	}
+	if n.Name == "" {
+		return nil, errEmptyRoleName
+	}
+	exists, err := p.userExists(string(n.Name))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("user or role %q already exists", string(n.Name))
+	}
+	const insertRole = `INSERT INTO system.users (username) VALUES (%s)`
+	jp := planner{txn: p.txn, user: security.RootUser}
+	if _, err := jp.exec(fmt.Sprintf(insertRole, parser.DString(n.Name))); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropRole drops a role and all memberships in it. Privileges granted to the
+// role on databases and tables are not revoked.
+// Privileges: "root" user.
+//   Notes: postgres requires the CREATEROLE attribute.
+//          mysql requires the DROP ROLE privilege.
+func (p *planner) DropRole(n *parser.DropRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to drop roles", security.RootUser)
+	}
+	if string(n.Name) == security.RootUser {
+		return nil, fmt.Errorf("%s cannot be dropped", security.RootUser)
+	}
+	exists, err := p.userExists(string(n.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("role %q does not exist", string(n.Name))
+	}
+	name := parser.DString(n.Name)
+	jp := planner{txn: p.txn, user: security.RootUser}
+	for _, sql := range []string{
+		fmt.Sprintf(`DELETE FROM system.users WHERE username = %s`, name),
+		fmt.Sprintf(`DELETE FROM system.role_members WHERE member = %s OR role = %s`, name, name),
+	} {
+		if _, err := jp.exec(sql); err != nil {
+			return nil, err
+		}
+	}
+	p.roles = nil
+	return &valuesNode{}, nil
+}
+
+// GrantRole makes users or roles members of a role.
+// Privileges: "root" user.
+//   Notes: postgres requires the ADMIN OPTION on the role.
+//          mysql requires the ROLE_ADMIN privilege.
+func (p *planner) GrantRole(n *parser.GrantRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to grant roles", security.RootUser)
+	}
+	exists, err := p.userExists(string(n.Role))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("role %q does not exist", string(n.Role))
+	}
+	// Membership cycles are rejected, as they would make every role of the
+	// cycle a member of itself.
+	roles, err := p.memberOf(string(n.Role))
+	if err != nil {
+		return nil, err
+	}
+	isRole := map[string]struct{}{string(n.Role): {}}
+	for _, role := range roles {
+		isRole[role] = struct{}{}
+	}
+
+	jp := planner{txn: p.txn, user: security.RootUser}
+	for _, member := range n.Members {
+		if _, ok := isRole[member]; ok {
+			return nil, fmt.Errorf("cannot grant role %q to %q: this would create a membership cycle",
+				string(n.Role), member)
+		}
+		row, err := jp.queryRow(fmt.Sprintf(
+			`SELECT member FROM system.role_members WHERE member = %s AND role = %s`,
+			parser.DString(member), parser.DString(n.Role)))
+		if err != nil {
+			return nil, err
+		}
+		if row != nil {
+			// Already a member.
+			continue
+		}
+		if _, err := jp.exec(fmt.Sprintf(`INSERT INTO system.role_members VALUES (%s, %s)`,
+			parser.DString(member), parser.DString(n.Role))); err != nil {
+			return nil, err
+		}
+	}
+	p.roles = nil
+	return &valuesNode{}, nil
+}
+
+// RevokeRole removes the membership of users or roles in a role.
+// Privileges: "root" user.
+//   Notes: postgres requires the ADMIN OPTION on the role.
+//          mysql requires the ROLE_ADMIN privilege.
+func (p *planner) RevokeRole(n *parser.RevokeRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to revoke roles", security.RootUser)
+	}
+	jp := planner{txn: p.txn, user: security.RootUser}
+	for _, member := range n.Members {
+		if _, err := jp.exec(fmt.Sprintf(
+			`DELETE FROM system.role_members WHERE member = %s AND role = %s`,
+			parser.DString(member), parser.DString(n.Role))); err != nil {
+			return nil, err
+		}
+	}
+	p.roles = nil
+	return &valuesNode{}, nil
+}
+
+// userExists returns whether a user or role with the given name exists.
+func (p *planner) userExists(name string) (bool, error) {
+	jp := planner{txn: p.txn, user: security.RootUser}
+	row, err := jp.queryRow(fmt.Sprintf(`SELECT username FROM system.users WHERE username = %s`,
+		parser.DString(name)))
+	if err != nil {
+		return false, err
+	}
+	return row != nil, nil
+}
+
+// getRoles returns the roles p.user is a member of. They are resolved once
+// per planner and cached for the statements executed by it.
+func (p *planner) getRoles() ([]string, error) {
+	if p.roles == nil {
+		roles, err := p.memberOf(p.user)
+		if err != nil {
+			return nil, err
+		}
+		p.roles = append([]string{}, roles...)
+	}
+	return p.roles, nil
+}
+
+// memberOf returns the roles the given user or role is a direct or indirect
+// member of.
+func (p *planner) memberOf(name string) ([]string, error) {
+	if p.txn == nil {
+		return nil, nil
+	}
+	const selectRoles = `SELECT role FROM system.role_members WHERE member = %s`
+	jp := planner{txn: p.txn, user: security.RootUser}
+	var roles []string
+	seen := map[string]struct{}{name: {}}
+	for queue := []string{name}; len(queue) > 0; queue = queue[1:] {
+		plan, err := jp.query(fmt.Sprintf(selectRoles, parser.DString(queue[0])))
+		if err != nil {
+			return nil, err
+		}
+		for plan.Next() {
+			role := string(plan.Values()[0].(parser.DString))
+			if _, ok := seen[role]; !ok {
+				seen[role] = struct{}{}
+				roles = append(roles, role)
+				queue = append(queue, role)
+			}
+		}
+		if err := plan.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return roles, nil
+}
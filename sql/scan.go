@@ -63,7 +63,7 @@ func (q *qvalue) Eval(ctx parser.EvalContext) (parser.Datum, error) {
 }
 
 type qvalMap map[ColumnID]*qvalue
-type colKindMap map[ColumnID]ColumnType_Kind
+type colTypeMap map[ColumnID]ColumnType
 
 type span struct {
 	start roachpb.Key
@@ -154,6 +154,7 @@ type scanNode struct {
 	index            *IndexDescriptor
 	spans            []span
 	visibleCols      []ColumnDescriptor
+	database         string // the database of the table, unless it is aliased
 	isSecondaryIndex bool
 	reverse          bool
 	columns          []string
@@ -171,12 +172,14 @@ type scanNode struct {
 	implicitValTypes []parser.Datum    // the implicit value types for unique indexes
 	implicitVals     []parser.Datum    // the implicit values for unique indexes
 	qvals            qvalMap           // the values in the current row
-	colKind          colKindMap        // map of column kinds for decoding column values
+	colTypes         colTypeMap        // map of column types for decoding column values
 	row              parser.DTuple     // the rendered row
 	filter           parser.Expr       // filtering expression for rows
 	render           []parser.Expr     // rendering expressions for rows
 	explain          explainMode
 	explainValue     parser.Datum
+	external         *externalScan // the rows of an external table
+	stats            *execStats    // execution statistics for EXPLAIN ANALYZE
 }
 
 func (n *scanNode) Columns() []string {
@@ -192,10 +195,24 @@ func (n *scanNode) Values() parser.DTuple {
 }
 
 func (n *scanNode) Next() bool {
+	if n.stats == nil {
+		return n.next()
+	}
+	start := time.Now()
+	ok := n.next()
+	n.stats.recordNext(start, ok)
+	return ok
+}
+
+func (n *scanNode) next() bool {
 	if n.err != nil {
 		return false
 	}
 
+	if n.desc != nil && n.desc.External != nil {
+		return n.nextExternal()
+	}
+
 	if n.kvs == nil {
 		if !n.initScan() {
 			return false
@@ -234,6 +251,9 @@ func (n *scanNode) ExplainPlan() (name, description string, children []planNode)
 	}
 	if n.desc == nil {
 		description = "-"
+	} else if n.desc.External != nil {
+		name = "external"
+		description = fmt.Sprintf("%s %s", n.desc.Name, strings.Join(n.desc.External.URLs, " "))
 	} else {
 		description = fmt.Sprintf("%s@%s %s", n.desc.Name, n.index.Name,
 			prettySpans(n.spans, 2))
@@ -258,6 +278,9 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 
 		// This is only kosher because we know that getAliasedDesc() succeeded.
 		qname := from[0].(*parser.AliasedTableExpr).Expr.(*parser.QualifiedName)
+		if from[0].(*parser.AliasedTableExpr).As == "" {
+			n.database = qname.Database()
+		}
 		indexName := qname.Index()
 		if indexName != "" && !equalName(n.desc.PrimaryIndex.Name, indexName) {
 			for i := range n.desc.Indexes {
@@ -277,25 +300,9 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 			if from[0].(*parser.AliasedTableExpr).As == "" {
 				n.desc.Alias = n.index.Name
 			}
-			// Strip out any columns from the table that are not present in the
-			// index.
-			indexColIDs := map[ColumnID]struct{}{}
-			for _, colID := range n.index.ColumnIDs {
-				indexColIDs[colID] = struct{}{}
-			}
-			for _, colID := range n.index.ImplicitColumnIDs {
-				indexColIDs[colID] = struct{}{}
-			}
-			for _, col := range n.desc.Columns {
-				if _, ok := indexColIDs[col.ID]; !ok {
-					continue
-				}
-				n.visibleCols = append(n.visibleCols, col)
-			}
-			n.isSecondaryIndex = true
+			n.initIndex(n.index)
 		} else {
-			n.index = &n.desc.PrimaryIndex
-			n.visibleCols = n.desc.Columns
+			n.initIndex(&n.desc.PrimaryIndex)
 		}
 
 		return nil
@@ -306,6 +313,31 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 	}
 }
 
+// initIndex sets the index to scan, which must belong to n.desc. For a
+// secondary index, any columns of the table that are not present in the index
+// are stripped out of the visible columns.
+func (n *scanNode) initIndex(index *IndexDescriptor) {
+	n.index = index
+	if index == &n.desc.PrimaryIndex {
+		n.visibleCols = n.desc.Columns
+		return
+	}
+	indexColIDs := map[ColumnID]struct{}{}
+	for _, colID := range index.ColumnIDs {
+		indexColIDs[colID] = struct{}{}
+	}
+	for _, colID := range index.ImplicitColumnIDs {
+		indexColIDs[colID] = struct{}{}
+	}
+	for _, col := range n.desc.Columns {
+		if _, ok := indexColIDs[col.ID]; !ok {
+			continue
+		}
+		n.visibleCols = append(n.visibleCols, col)
+	}
+	n.isSecondaryIndex = true
+}
+
 // initScan initializes (and performs) the key-value scan.
 //
 // TODO(pmattis): The key-value scan currently reads all of the key-value
@@ -345,6 +377,14 @@ func (n *scanNode) initScan() bool {
 	if n.err = n.txn.Run(b); n.err != nil {
 		return false
 	}
+	if n.stats != nil {
+		n.stats.batches++
+		for _, result := range b.Results {
+			for i := range result.Rows {
+				n.stats.bytes += int64(len(result.Rows[i].Key) + len(result.Rows[i].ValueBytes()))
+			}
+		}
+	}
 
 	for _, result := range b.Results {
 		if n.kvs == nil {
@@ -370,10 +410,10 @@ func (n *scanNode) initScan() bool {
 			n.implicitVals = make([]parser.Datum, len(n.implicitValTypes))
 		}
 
-		// Prepare a map from column ID to column kind used for unmarshalling values.
-		n.colKind = make(colKindMap, len(n.desc.Columns))
+		// Prepare a map from column ID to column type used for unmarshalling values.
+		n.colTypes = make(colTypeMap, len(n.desc.Columns))
 		for _, col := range n.desc.Columns {
-			n.colKind[col.ID] = col.Type.Kind
+			n.colTypes[col.ID] = col.Type
 		}
 	}
 	return true
@@ -419,12 +459,12 @@ func (n *scanNode) initTargets(targets parser.SelectExprs) error {
 // initOrdering initializes the ordering info using the selected index. This
 // must be called after index selection is performed.
 func (n *scanNode) initOrdering(exactPrefix int) {
-	if n.index == nil {
+	if n.index == nil || n.desc.External != nil {
 		return
 	}
 	n.exactPrefix = exactPrefix
 	n.columnIDs = n.index.fullColumnIDs()
-	n.ordering = n.computeOrdering(n.columnIDs)
+	n.ordering = n.computeOrdering(n.columnIDs, n.index.ColumnDirections)
 	if n.reverse {
 		for i := range n.ordering {
 			n.ordering[i] = -n.ordering[i]
@@ -441,8 +481,10 @@ func (n *scanNode) initOrdering(exactPrefix int) {
 //
 // If there is an index on (k, v) and we're asking for the ordering for those
 // columns, computeOrdering will return (0, 1). This indicates that column k is
-// not part of the output column set and column v is in ascending order.
-func (n *scanNode) computeOrdering(columnIDs []ColumnID) []int {
+// not part of the output column set and column v is in ascending order. The
+// directions parameter holds the directions of the columns (see
+// columnDirection); a descending column has a negative ordering.
+func (n *scanNode) computeOrdering(columnIDs []ColumnID, directions []IndexDescriptor_Direction) []int {
 	// Loop over the column IDs and determine if they are used for any of the
 	// render targets.
 	ordering := make([]int, len(columnIDs))
@@ -450,6 +492,9 @@ func (n *scanNode) computeOrdering(columnIDs []ColumnID) []int {
 		for i, r := range n.render {
 			if qval, ok := r.(*qvalue); ok && qval.col.ID == colID {
 				ordering[j] = i + 1
+				if columnDirection(directions, j) == IndexDescriptor_DESC {
+					ordering[j] = -ordering[j]
+				}
 				break
 			}
 		}
@@ -483,7 +528,7 @@ func (n *scanNode) addRender(target parser.SelectExpr) error {
 				return fmt.Errorf("\"%s\" cannot be aliased", qname)
 			}
 			tableName := qname.Table()
-			if tableName != "" && !equalName(n.desc.Alias, tableName) {
+			if tableName != "" && (!equalName(n.desc.Alias, tableName) || !n.matchesDatabase(qname)) {
 				return fmt.Errorf("table \"%s\" not found", tableName)
 			}
 
@@ -590,7 +635,7 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 		}
 	} else {
 		if n.implicitVals != nil {
-			if _, n.err = decodeKeyVals(n.implicitValTypes, n.implicitVals, kv.ValueBytes()); n.err != nil {
+			if _, n.err = decodeKeyVals(n.implicitValTypes, n.implicitVals, nil, kv.ValueBytes()); n.err != nil {
 				return false
 			}
 			for i, id := range n.index.ImplicitColumnIDs {
@@ -768,13 +813,13 @@ func prettyKeyVals(vals []parser.Datum) string {
 }
 
 func (n *scanNode) unmarshalValue(kv client.KeyValue) (parser.Datum, bool) {
-	kind, ok := n.colKind[n.colID]
+	typ, ok := n.colTypes[n.colID]
 	if !ok {
 		n.err = fmt.Errorf("column-id \"%d\" does not exist", n.colID)
 		return nil, false
 	}
 	var d parser.Datum
-	d, n.err = unmarshalColumnValue(kind, kv.Value)
+	d, n.err = unmarshalColumnValue(typ, kv.Value)
 	return d, n.err == nil
 }
 
@@ -808,6 +853,11 @@ func (n *scanNode) getQVal(col ColumnDescriptor) *qvalue {
 			qval.datum = parser.DummyTimestamp
 		case ColumnType_INTERVAL:
 			qval.datum = parser.DummyInterval
+		case ColumnType_ARRAY:
+			var err error
+			if qval.datum, err = makeArrayPlaceholder(*col.Type.ArrayContents); err != nil {
+				panic(err)
+			}
 		default:
 			panic(fmt.Sprintf("unsupported column type: %s", col.Type.Kind))
 		}
@@ -858,7 +908,15 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 				if !equalName(name, col.Name) {
 					continue
 				}
-				return v, v.getQVal(col)
+				qval := v.getQVal(col)
+				if len(qname.Indirect) == 2 {
+					// table.column[array-indirection]
+					return v, &parser.SubscriptExpr{
+						Expr:  qval,
+						Index: qname.Indirect[1].(*parser.ArrayIndirection),
+					}
+				}
+				return v, qval
 			}
 		}
 
@@ -922,12 +980,20 @@ func (v *qnameVisitor) getDesc(qname *parser.QualifiedName) *TableDescriptor {
 		qname.Base = parser.Name(v.desc.Alias)
 		return v.desc
 	}
-	if equalName(v.desc.Alias, string(qname.Base)) {
+	if equalName(v.desc.Alias, string(qname.Base)) && v.matchesDatabase(qname) {
 		return v.desc
 	}
 	return nil
 }
 
+// matchesDatabase returns whether the database qualifying the normalized
+// column name, if any, is the database of the scanned table. A column of an
+// aliased table can't be qualified by a database.
+func (n *scanNode) matchesDatabase(qname *parser.QualifiedName) bool {
+	database := qname.Database()
+	return database == "" || (n.database != "" && equalName(n.database, database))
+}
+
 func (n *scanNode) resolveQNames(expr parser.Expr) (parser.Expr, error) {
 	if expr == nil {
 		return expr, nil
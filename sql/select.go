@@ -47,13 +47,17 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err := scan.initWhere(n.Where); err != nil {
 		return nil, err
 	}
-	if err := scan.initTargets(n.Exprs); err != nil {
+	unnest, err := p.unnest(n, scan)
+	if err != nil {
 		return nil, err
 	}
 	group, err := p.groupBy(n, scan)
 	if err != nil {
 		return nil, err
 	}
+	if group != nil && unnest != nil {
+		return nil, util.Errorf("unnest() cannot be used with GROUP BY/aggregation")
+	}
 	if group != nil && n.OrderBy != nil {
 		// TODO(pmattis): orderBy currently uses deep knowledge of the
 		// scanNode. Need to lift that out or make orderBy compatible with
@@ -69,8 +73,11 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if plan, err = p.distribute(scan, group, plan); err != nil {
+		return nil, err
+	}
 
-	limit, err := p.limit(n, p.distinct(n, sort.wrap(group.wrap(plan))))
+	limit, err := p.limit(n, p.distinct(n, sort.wrap(group.wrap(unnest.wrap(plan)))))
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +101,9 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		ordering, _ = sort.Ordering()
 	}
 
-	if s.desc == nil || (s.filter == nil && ordering == nil) {
-		// No table or no where-clause and no ordering.
+	if s.desc == nil || s.desc.External != nil || (s.filter == nil && ordering == nil) {
+		// No table, an external table which has no indexes to select from, or
+		// no where-clause and no ordering.
 		s.initOrdering(0)
 		return s, nil
 	}
@@ -121,6 +129,25 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		}
 	}
 
+	// The statistics of the table, if any, are only used to estimate the
+	// rows matching the filter.
+	var stats *tableStatistics
+	if s.filter != nil {
+		stats = p.getTableStatistics(s.desc)
+	}
+
+	// Only consider the index previously selected for the same scan, if any.
+	cacheKey := makePlanCacheKey(s, ordering, stats)
+	cachedIndexID, cached := p.planCache.lookup(cacheKey)
+	if cached {
+		for _, c := range candidates {
+			if c.index.ID == cachedIndexID {
+				candidates = []*indexInfo{c}
+				break
+			}
+		}
+	}
+
 	for _, c := range candidates {
 		c.init(s)
 	}
@@ -173,7 +200,7 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		// to scan. An examples of this is "a IN (1, 2, 3)".
 
 		for _, c := range candidates {
-			c.analyzeExprs(exprs)
+			c.analyzeExprs(exprs, stats)
 		}
 	}
 
@@ -195,6 +222,9 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 	// After sorting, candidates[0] contains the best index. Copy its info into
 	// the scanNode.
 	c := candidates[0]
+	if !cached {
+		p.planCache.add(cacheKey, c.index.ID)
+	}
 	s.index = c.index
 	s.isSecondaryIndex = (c.index != &s.desc.PrimaryIndex)
 	s.spans = makeSpans(c.constraints, c.desc.ID, c.index.ID)
@@ -251,6 +281,10 @@ type indexConstraint struct {
 	// of the tuple. For example, if the index was only on (a), then the tupleMap
 	// would be {1}.
 	tupleMap []int
+	// dir is the direction of the (first) constrained column. The start and
+	// end of the spans of a descending column are reversed with respect to
+	// the values: "a >= 1" constrains the end of the spans.
+	dir IndexDescriptor_Direction
 }
 
 func (c indexConstraint) String() string {
@@ -312,10 +346,17 @@ func (v *indexInfo) init(s *scanNode) {
 }
 
 // analyzeExprs examines the range map to determine the cost of using the
-// index.
-func (v *indexInfo) analyzeExprs(exprs []parser.Exprs) {
+// index. If statistics have been collected on the table, the cost is
+// weighted by the estimated number of rows matching the constraints of the
+// index instead of by how many of the index columns are constrained.
+func (v *indexInfo) analyzeExprs(exprs []parser.Exprs, stats *tableStatistics) {
 	v.makeConstraints(exprs)
 
+	if stats != nil {
+		v.cost *= stats.estimateRows(v.constraints)
+		return
+	}
+
 	// Count the number of elements used to limit the start and end keys. We then
 	// boost the cost by what fraction of the index keys are being used. The
 	// higher the fraction, the lower the cost.
@@ -337,7 +378,7 @@ func (v *indexInfo) analyzeOrdering(scan *scanNode, ordering []int) {
 	v.exactPrefix = exactPrefix(v.constraints)
 
 	// Compute the ordering provided by the index.
-	indexOrdering := scan.computeOrdering(v.index.fullColumnIDs())
+	indexOrdering := scan.computeOrdering(v.index.fullColumnIDs(), v.index.ColumnDirections)
 
 	// Compute how much of the index ordering matches the requested ordering for
 	// both forward and reverse scans.
@@ -380,7 +421,8 @@ func (v *indexInfo) analyzeOrdering(scan *scanNode, ordering []int) {
 //
 // Start constraints look for comparison expressions with the operators >, >=,
 // = or IN. End constraints look for comparison expressions with the operators
-// <, <=, = or IN.
+// <, <=, = or IN. For a descending column the roles are swapped: start
+// constraints look for <, <=, = or IN and end constraints for >, >=, = or IN.
 func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 	if len(exprs) != 1 {
 		return
@@ -392,7 +434,8 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 
 	for i := 0; i < len(v.index.ColumnIDs); i++ {
 		colID := v.index.ColumnIDs[i]
-		var constraint indexConstraint
+		constraint := indexConstraint{dir: columnDirection(v.index.ColumnDirections, i)}
+		desc := constraint.dir == IndexDescriptor_DESC
 
 		for _, e := range andExprs {
 			if c, ok := e.(*parser.ComparisonExpr); ok {
@@ -412,8 +455,12 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 					// comparison "(b, a) = (1, 2)" would be rewritten as "(a, b) = (2,
 					// 1)". Note that we don't actually need to rewrite the comparison,
 					// but simply provide a mapping from the order in the tuple to the
-					// order in the index.
-					for _, colID := range v.index.ColumnIDs[i:] {
+					// order in the index. Tuples are only matched against ascending
+					// columns, whose values sort in the order of their keys.
+					for j, colID := range v.index.ColumnIDs[i:] {
+						if columnDirection(v.index.ColumnDirections, i+j) == IndexDescriptor_DESC {
+							break
+						}
 						idx := findColumnInTuple(t, colID)
 						if idx == -1 {
 							break
@@ -447,7 +494,11 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 					// Note that makeSpans treats "a != x" the same as "a IS NOT
 					// NULL". We don't simplify "a != x" to "a IS NOT NULL" in
 					// simplifyExpr because doing so affects other simplifications.
-					if !startDone {
+					if desc {
+						if !endDone {
+							constraint.end = c
+						}
+					} else if !startDone {
 						constraint.start = c
 					}
 				case parser.In:
@@ -475,26 +526,50 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 						constraint.tupleMap = tupleMap
 					}
 				case parser.GT, parser.GE:
-					if !startDone && constraint.start == nil {
+					if desc {
+						if !endDone && constraint.end == nil {
+							constraint.end = c
+						}
+					} else if !startDone && constraint.start == nil {
 						constraint.start = c
 					}
 				case parser.LT, parser.LE:
-					if !endDone && constraint.end == nil {
+					if desc {
+						if !startDone && constraint.start == nil {
+							constraint.start = c
+						}
+					} else if !endDone && constraint.end == nil {
 						constraint.end = c
 					}
 				case parser.Is:
-					if c.Right == parser.DNull && !endDone {
+					// NULL sorts before all values of an ascending column and after
+					// all values of a descending column.
+					if c.Right != parser.DNull {
+						break
+					}
+					if desc {
+						if !startDone {
+							constraint.start = c
+						}
+					} else if !endDone {
 						constraint.end = c
 					}
 				case parser.IsNot:
-					if c.Right == parser.DNull && !startDone {
+					if c.Right != parser.DNull {
+						break
+					}
+					if desc {
+						if !endDone {
+							constraint.end = c
+						}
+					} else if !startDone {
 						constraint.start = c
 					}
 				}
 			}
 		}
 
-		if constraint.start != nil && constraint.start.Operator == parser.GT {
+		if !desc && constraint.start != nil && constraint.start.Operator == parser.GT {
 			// Transform a > constraint into a >= constraint so that we play
 			// nicer with the inclusive nature of the scan start key.
 			//
@@ -514,6 +589,28 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 		if constraint.end != nil && constraint.end.Operator == parser.LT {
 			endDone = true
 		}
+		if desc {
+			// The spans of "a < x" start after all keys with the value x, and
+			// those of "a > x" end before them, so no further columns can be
+			// constrained on that side.
+			if constraint.start != nil && constraint.start.Operator == parser.LT {
+				startDone = true
+			}
+			if constraint.end != nil && constraint.end.Operator == parser.GT {
+				endDone = true
+			}
+			// NULL sorts last in a descending column. Exclude it from the spans
+			// of "a < x" and "a <= x", just as the spans of an ascending column
+			// start after NULL when only their end is constrained.
+			if constraint.start != nil && constraint.end == nil && !endDone &&
+				(constraint.start.Operator == parser.LT || constraint.start.Operator == parser.LE) {
+				constraint.end = &parser.ComparisonExpr{
+					Operator: parser.IsNot,
+					Left:     constraint.start.Left,
+					Right:    parser.DNull,
+				}
+			}
+		}
 
 		if constraint.start != nil || constraint.end != nil {
 			v.constraints = append(v.constraints, constraint)
@@ -568,6 +665,9 @@ func (v indexInfoByCost) Sort() {
 }
 
 // makeSpans constructs the spans for an index given a set of constraints.
+// The values of descending columns are encoded complemented; an inclusive
+// bound after all keys with a given value of such a column is the prefix end
+// of the key instead of the key of the next value.
 func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span {
 	prefix := roachpb.Key(MakeIndexKeyPrefix(tableID, indexID))
 	spans := []span{{
@@ -582,6 +682,14 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 		// key.
 		lastEnd := c.end != nil &&
 			(i+1 == len(constraints) || constraints[i+1].end == nil)
+		desc := c.dir == IndexDescriptor_DESC
+		encode := func(b []byte, datum parser.Datum) []byte {
+			key, err := encodeTableKeyWithDirection(b, datum, c.dir)
+			if err != nil {
+				panic(err)
+			}
+			return key
+		}
 
 		if (c.start != nil && c.start.Operator == parser.In) ||
 			(c.end != nil && c.end.Operator == parser.In) {
@@ -601,10 +709,15 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 			}
 
 			// For each of the existing spans and for each value in the tuple, create
-			// a new span.
+			// a new span. The values of a descending column are visited in reverse
+			// so that the spans remain in key order.
 			existingSpans := spans
 			spans = make([]span, 0, len(existingSpans)*len(tuple))
-			for _, datum := range tuple {
+			for j := range tuple {
+				datum := tuple[j]
+				if desc {
+					datum = tuple[len(tuple)-1-j]
+				}
 				var start, end []byte
 
 				switch t := datum.(type) {
@@ -633,17 +746,10 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					}
 
 				default:
-					var err error
-					if start, err = encodeTableKey(buf[:0], datum); err != nil {
-						panic(err)
-					}
-
+					start = encode(buf[:0], datum)
 					end = start
-					if lastEnd {
-						var err error
-						if end, err = encodeTableKey(nil, datum.Next()); err != nil {
-							panic(err)
-						}
+					if lastEnd && !desc {
+						end = encode(nil, datum.Next())
 					}
 				}
 
@@ -653,6 +759,9 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					}
 					if c.end != nil {
 						s.end = append(append(roachpb.Key(nil), s.end...), end...)
+						if lastEnd && desc {
+							s.end = s.end.PrefixEnd()
+						}
 					}
 					spans = append(spans, s)
 				}
@@ -671,14 +780,18 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					spans[i].start = encoding.EncodeNotNull(spans[i].start)
 				}
 			default:
+				// This includes IS NULL for a descending column, which constrains
+				// the start of the range to NULL.
 				if datum, ok := c.start.Right.(parser.Datum); ok {
-					key, err := encodeTableKey(buf[:0], datum)
-					if err != nil {
-						panic(err)
-					}
+					key := encode(buf[:0], datum)
 					// Append the constraint to all of the existing spans.
 					for i := range spans {
 						spans[i].start = append(spans[i].start, key...)
+						if c.start.Operator == parser.LT {
+							// The range of "a < x" on a descending column starts after
+							// all keys with the value x.
+							spans[i].start = spans[i].start.PrefixEnd()
+						}
 					}
 				}
 			}
@@ -693,22 +806,30 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 				for i := range spans {
 					spans[i].end = encoding.EncodeNotNull(spans[i].end)
 				}
+			case parser.NE, parser.IsNot:
+				// A != or IS NOT NULL expression on a descending column allows us to
+				// constrain the end of the range to stop before NULL.
+				for i := range spans {
+					n := len(spans[i].end)
+					spans[i].end = encoding.EncodeNotNull(spans[i].end)
+					onesComplement(spans[i].end[n:])
+				}
 			default:
 				if datum, ok := c.end.Right.(parser.Datum); ok {
-					if lastEnd && c.end.Operator != parser.LT {
+					if lastEnd && !desc && c.end.Operator != parser.LT {
 						datum = datum.Next()
 					}
-					key, err := encodeTableKey(buf[:0], datum)
-					if err != nil {
-						panic(err)
-					}
+					key := encode(buf[:0], datum)
 					// Append the constraint to all of the existing spans.
 					for i := range spans {
 						spans[i].end = append(spans[i].end, key...)
+						if lastEnd && desc && c.end.Operator != parser.GT {
+							spans[i].end = spans[i].end.PrefixEnd()
+						}
 					}
 				}
 
-				if c.start == nil && (i == 0 || constraints[i-1].start != nil) {
+				if !desc && c.start == nil && (i == 0 || constraints[i-1].start != nil) {
 					// This is the first constraint for which we don't have a start
 					// constraint. Add a not-NULL endpoint.
 					for i := range spans {
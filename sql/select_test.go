@@ -18,7 +18,10 @@
 package sql
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
@@ -47,7 +50,7 @@ func makeConstraints(t *testing.T, sql string, desc *TableDescriptor,
 		index:    index,
 		covering: true,
 	}
-	c.analyzeExprs(exprs)
+	c.analyzeExprs(exprs, nil)
 	if equiv && len(exprs) == 1 {
 		expr = joinAndExprs(exprs[0])
 	}
@@ -245,6 +248,98 @@ func TestMakeSpans(t *testing.T) {
 	}
 }
 
+type keyedRow struct {
+	key  []byte
+	name string
+}
+
+type keyedRows []keyedRow
+
+func (r keyedRows) Len() int           { return len(r) }
+func (r keyedRows) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r keyedRows) Less(i, j int) bool { return bytes.Compare(r[i].key, r[j].key) < 0 }
+
+func TestMakeSpansDescending(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	// The expected rows are listed in index order for every combination of the
+	// values NULL, 1, 2 and 3 in the indexed columns.
+	testData := []struct {
+		expr       string
+		columns    []string
+		directions []IndexDescriptor_Direction
+		expected   string
+	}{
+		{`a = 2`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `2`},
+		{`a != 2`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `3 2 1`},
+		{`a > 1`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `3 2`},
+		{`a >= 2`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `3 2`},
+		{`a < 3`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `2 1`},
+		{`a <= 2`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `2 1`},
+		{`a > 1 AND a < 3`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `2`},
+		{`a IS NULL`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `NULL`},
+		{`a IS NOT NULL`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `3 2 1`},
+		{`a IN (1, 3)`, []string{"a"}, []IndexDescriptor_Direction{IndexDescriptor_DESC}, `3 1`},
+
+		{`a = 2 AND b > 1`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_DESC, IndexDescriptor_ASC}, `2/2 2/3`},
+		{`a IN (1, 3) AND b = 2`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_DESC, IndexDescriptor_ASC}, `3/2 1/2`},
+		{`a = 1 AND b < 3`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC}, `1/2 1/1`},
+		{`a = 1 AND b >= 2`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC}, `1/3 1/2`},
+		{`a = 1 AND b IS NULL`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC}, `1/NULL`},
+		{`a = 1 AND b IS NOT NULL`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC}, `1/3 1/2 1/1`},
+		{`a >= 2 AND b = 2`, []string{"a", "b"},
+			[]IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC},
+			`2/2 2/1 2/NULL 3/3 3/2 3/1 3/NULL`},
+	}
+	values := []parser.Datum{parser.DNull, parser.DInt(1), parser.DInt(2), parser.DInt(3)}
+	for _, d := range testData {
+		desc, index := makeTestIndex(t, d.columns)
+		index.ColumnDirections = d.directions
+		constraints, _ := makeConstraints(t, d.expr, desc, index)
+		spans := makeSpans(constraints, desc.ID, index.ID)
+
+		// Encode every combination of values and collect the ones which fall
+		// within the spans, sorted by key.
+		rows := keyedRows{{key: MakeIndexKeyPrefix(desc.ID, index.ID)}}
+		for i, dir := range d.directions {
+			var next keyedRows
+			for _, r := range rows {
+				for _, v := range values {
+					key, err := encodeTableKeyWithDirection(append([]byte(nil), r.key...), v, dir)
+					if err != nil {
+						t.Fatal(err)
+					}
+					name := v.String()
+					if i > 0 {
+						name = r.name + "/" + name
+					}
+					next = append(next, keyedRow{key: key, name: name})
+				}
+			}
+			rows = next
+		}
+		sort.Sort(rows)
+		var found []string
+		for _, r := range rows {
+			for _, s := range spans {
+				if bytes.Compare(r.key, s.start) >= 0 && bytes.Compare(r.key, s.end) < 0 {
+					found = append(found, r.name)
+					break
+				}
+			}
+		}
+		if s := strings.Join(found, " "); d.expected != s {
+			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
+		}
+	}
+}
+
 func TestExactPrefix(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
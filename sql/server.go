@@ -44,8 +44,8 @@ type Server struct {
 }
 
 // MakeServer creates a Server.
-func MakeServer(ctx *base.Context, db client.DB, gossip *gossip.Gossip, clock *hlc.Clock) Server {
-	return Server{context: ctx, Executor: newExecutor(db, gossip, clock)}
+func MakeServer(ctx *base.Context, db client.DB, gossip *gossip.Gossip, clock *hlc.Clock, rpcContext *rpc.Context) Server {
+	return Server{context: ctx, Executor: newExecutor(db, gossip, clock, rpcContext)}
 }
 
 // ServeHTTP serves the SQL API by treating the request URL path
@@ -113,9 +113,15 @@ func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RegisterRPC registers the SQL RPC endpoint.
+// RegisterRPC registers the SQL RPC endpoints.
 func (s Server) RegisterRPC(rpcServer *rpc.Server) error {
-	return rpcServer.RegisterPublic(driver.RPCMethod, s.executeCmd, &driver.Request{})
+	if err := rpcServer.RegisterPublic(driver.RPCMethod, s.executeCmd, &driver.Request{}); err != nil {
+		return err
+	}
+	if err := rpcServer.Register(flowMethod, s.executeFlowCmd, &FlowRequest{}); err != nil {
+		return err
+	}
+	return rpcServer.Register(importMethod, s.executeImportCmd, &ImportRequest{})
 }
 
 func (s Server) executeCmd(argsI proto.Message) (proto.Message, error) {
@@ -123,3 +129,13 @@ func (s Server) executeCmd(argsI proto.Message) (proto.Message, error) {
 	reply, _, err := s.Execute(*args)
 	return &reply, err
 }
+
+func (s Server) executeFlowCmd(argsI proto.Message) (proto.Message, error) {
+	args := argsI.(*FlowRequest)
+	return s.executeFlow(args), nil
+}
+
+func (s Server) executeImportCmd(argsI proto.Message) (proto.Message, error) {
+	args := argsI.(*ImportRequest)
+	return s.executeImport(args), nil
+}
@@ -20,7 +20,10 @@ package sql
 import (
 	"time"
 
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/gogo/protobuf/proto"
 )
 
 func (s Session) getLocation() (*time.Location, error) {
@@ -36,3 +39,34 @@ func (s Session) getLocation() (*time.Location, error) {
 		return nil, util.Errorf("unhandled timezone variant type %T", t)
 	}
 }
+
+// sessionState returns the state of the session to be sent back to the
+// client, including its open transaction, if any.
+func (p *planner) sessionState() Session {
+	s := p.session
+	if p.txn != nil {
+		s.Txn = &Session_Transaction{Txn: p.txn.Proto, Timestamp: driver.Timestamp(p.evalCtx.TxnTimestamp.Time)}
+		s.MutatesSystemDB = p.txn.SystemDBTrigger()
+	} else {
+		s.Txn = nil
+		s.MutatesSystemDB = false
+	}
+	return s
+}
+
+// showSessionToken returns a token serializing the state of the session,
+// including its open transaction, which the driver accepts to resume the
+// session on another node. This node stops tracking the session's
+// transaction, so that it isn't aborted here once the session moves on.
+// Privileges: None.
+func (p *planner) showSessionToken() (planNode, error) {
+	s := p.sessionState()
+	data, err := proto.Marshal(&s)
+	if err != nil {
+		return nil, err
+	}
+	p.sessionHandedOff = true
+	v := &valuesNode{columns: []string{"SESSION_TOKEN"}}
+	v.rows = append(v.rows, []parser.Datum{parser.DString(driver.EncodeSessionToken(data))})
+	return v, nil
+}
@@ -31,6 +31,8 @@ type Session struct {
 	//	*Session_Location
 	//	*Session_Offset
 	Timezone isSession_Timezone `protobuf_oneof:"timezone"`
+	// Indicates that simple queries are executed using distributed flows.
+	DistSQL bool `protobuf:"varint,7,opt,name=distsql" json:"distsql"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -179,6 +181,14 @@ func (m *Session) MarshalTo(data []byte) (int, error) {
 		}
 		i += nn2
 	}
+	data[i] = 0x38
+	i++
+	if m.DistSQL {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -272,6 +282,7 @@ func (m *Session) Size() (n int) {
 	if m.Timezone != nil {
 		n += m.Timezone.Size()
 	}
+	n += 2
 	return n
 }
 
@@ -490,6 +501,26 @@ func (m *Session) Unmarshal(data []byte) error {
 				}
 			}
 			m.Timezone = &Session_Offset{v}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistSQL", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistSQL = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
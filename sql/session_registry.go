@@ -0,0 +1,202 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+var errTooManySessions = errors.New("too many open sessions, try again later")
+
+// sessionRegistry tracks the SQL sessions which are executing a request or
+// which hold an open transaction on this node. It enforces a limit on the
+// number of sessions executing a request and expires the sessions which have
+// been idle for too long. Session state is held by the client, so a session
+// with an open transaction is identified by the ID of its transaction. As the
+// client may continue the session through another node at any time, an idle
+// session doesn't count against the limit.
+type sessionRegistry struct {
+	sync.Mutex
+	maxSessions int           // Zero for no limit
+	idleTimeout time.Duration // Zero for no timeout
+	active      int           // Requests in flight
+	open        map[string]*openSession
+	expired     map[string]time.Time // IDs of expired transactions
+	running     map[*sessionRequest]struct{}
+}
+
+// openSession is a session with an open transaction.
+type openSession struct {
+	user       string
+	txn        roachpb.Transaction
+	lastActive time.Time
+	busy       bool // True while a request for the session is in flight
+}
+
+// sessionRequest is a request of a session which is being executed.
+type sessionRequest struct {
+	user  string
+	txnID []byte // the ID of the session's transaction, if any
+	start time.Time
+	mem   *sessionMemory
+}
+
+// sessionInfo describes a session for SHOW SESSIONS.
+type sessionInfo struct {
+	user   string
+	txnID  []byte
+	active bool          // Whether a request of the session is in flight
+	age    time.Duration // The time since the request started or the session became idle
+	memory int64         // The memory used by the request in flight
+}
+
+// begin registers a request for the session with the supplied transaction,
+// which may be nil. If the session is open, the ID of its transaction is
+// returned and must be passed to end. expired is true if the transaction was
+// aborted because the session had been idle for too long. errTooManySessions
+// is returned if the request would exceed the session limit, in which case end
+// must not be called.
+func (r *sessionRegistry) begin(txn *roachpb.Transaction) (openID string, expired bool, err error) {
+	r.Lock()
+	defer r.Unlock()
+	if txn != nil && len(txn.ID) > 0 {
+		id := string(txn.ID)
+		if s, ok := r.open[id]; ok && !s.busy {
+			openID = id
+		} else {
+			_, expired = r.expired[id]
+		}
+	}
+	if r.maxSessions > 0 && r.active >= r.maxSessions {
+		return "", false, errTooManySessions
+	}
+	if openID != "" {
+		r.open[openID].busy = true
+	}
+	r.active++
+	return openID, expired, nil
+}
+
+// end is called when a request registered with begin completes, supplying
+// the session's user and resulting transaction, which may be nil.
+func (r *sessionRegistry) end(openID, user string, txn *roachpb.Transaction) {
+	r.Lock()
+	defer r.Unlock()
+	r.active--
+	if openID != "" {
+		delete(r.open, openID)
+	}
+	// A transaction without an ID has not yet sent any requests and holds no
+	// state which would need to be cleaned up, so it isn't tracked.
+	if txn == nil || len(txn.ID) == 0 || txn.Status != roachpb.PENDING {
+		return
+	}
+	if r.open == nil {
+		r.open = map[string]*openSession{}
+	}
+	r.open[string(txn.ID)] = &openSession{user: user, txn: *txn, lastActive: time.Now()}
+}
+
+// track registers a request which is being executed, until it is passed to
+// untrack.
+func (r *sessionRegistry) track(req *sessionRequest) {
+	r.Lock()
+	defer r.Unlock()
+	if r.running == nil {
+		r.running = map[*sessionRequest]struct{}{}
+	}
+	r.running[req] = struct{}{}
+}
+
+// untrack unregisters a request registered with track.
+func (r *sessionRegistry) untrack(req *sessionRequest) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.running, req)
+}
+
+// sessions describes the sessions executing a request and the idle sessions
+// holding an open transaction as of now.
+func (r *sessionRegistry) sessions(now time.Time) []sessionInfo {
+	r.Lock()
+	defer r.Unlock()
+	infos := make([]sessionInfo, 0, len(r.running)+len(r.open))
+	for req := range r.running {
+		infos = append(infos, sessionInfo{
+			user:   req.user,
+			txnID:  req.txnID,
+			active: true,
+			age:    now.Sub(req.start),
+			memory: req.mem.Used(),
+		})
+	}
+	for _, s := range r.open {
+		if s.busy {
+			// Described by the running request.
+			continue
+		}
+		infos = append(infos, sessionInfo{
+			user:  s.user,
+			txnID: s.txn.ID,
+			age:   now.Sub(s.lastActive),
+		})
+	}
+	return infos
+}
+
+// expire removes the open sessions which have been idle for longer than the
+// idle timeout as of now and returns their transactions. The caller is
+// responsible for aborting the transactions which haven't been continued
+// through another node and for passing them to markExpired.
+func (r *sessionRegistry) expire(now time.Time) []roachpb.Transaction {
+	r.Lock()
+	defer r.Unlock()
+	if r.idleTimeout == 0 {
+		return nil
+	}
+	for id, t := range r.expired {
+		// Clients are informed of the expiration of their session for one
+		// further idle timeout; afterwards they'll find the transaction
+		// aborted when they next use it.
+		if now.Sub(t) > r.idleTimeout {
+			delete(r.expired, id)
+		}
+	}
+	var txns []roachpb.Transaction
+	for id, s := range r.open {
+		if s.busy || now.Sub(s.lastActive) <= r.idleTimeout {
+			continue
+		}
+		delete(r.open, id)
+		txns = append(txns, s.txn)
+	}
+	return txns
+}
+
+// markExpired records that the transaction of an expired session was
+// aborted as of now, so that the client is told when it next uses it.
+func (r *sessionRegistry) markExpired(txnID []byte, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	if r.expired == nil {
+		r.expired = map[string]time.Time{}
+	}
+	r.expired[string(txnID)] = now
+}
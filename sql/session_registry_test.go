@@ -0,0 +1,166 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestSessionRegistryLimit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	r := sessionRegistry{maxSessions: 2}
+	txn := &roachpb.Transaction{ID: []byte("a"), Status: roachpb.PENDING}
+
+	// A request which opens a transaction keeps its session open.
+	openID, _, err := r.begin(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.end(openID, "", txn)
+
+	// The idle open session doesn't count against the limit, as its client
+	// may continue it through another node: two other sessions can execute
+	// a request, but a third can't, nor can the open session.
+	for i := 0; i < 2; i++ {
+		if _, _, err := r.begin(nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := r.begin(nil); err != errTooManySessions {
+		t.Fatalf("expected %s, got %v", errTooManySessions, err)
+	}
+	if _, _, err := r.begin(txn); err != errTooManySessions {
+		t.Fatalf("expected %s, got %v", errTooManySessions, err)
+	}
+	r.end("", "", nil)
+
+	openID, _, err = r.begin(txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if openID != "a" {
+		t.Fatalf("expected open session, got %q", openID)
+	}
+	committed := *txn
+	committed.Status = roachpb.COMMITTED
+	r.end(openID, "", &committed)
+	r.end("", "", nil)
+
+	if r.active != 0 || len(r.open) != 0 {
+		t.Fatalf("expected no sessions, found %d active and %d open", r.active, len(r.open))
+	}
+}
+
+func TestSessionRegistryExpire(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	r := sessionRegistry{idleTimeout: time.Minute}
+	txnA := &roachpb.Transaction{ID: []byte("a"), Status: roachpb.PENDING}
+	txnB := &roachpb.Transaction{ID: []byte("b"), Status: roachpb.PENDING}
+	r.end(r.beginOrFatal(t, nil), "", txnA)
+	r.end(r.beginOrFatal(t, nil), "", txnB)
+
+	// A session with a request in flight is never expired.
+	openID := r.beginOrFatal(t, txnB)
+	now := time.Now().Add(2 * time.Minute)
+	txns := r.expire(now)
+	if len(txns) != 1 || string(txns[0].ID) != "a" {
+		t.Fatalf("expected transaction a to expire, got %+v", txns)
+	}
+	r.markExpired(txns[0].ID, now)
+	r.end(openID, "", txnB)
+
+	// The client is told about the expiration of its session.
+	openID, expired, err := r.begin(txnA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if openID != "" || !expired {
+		t.Fatalf("expected expired session, got %q, %t", openID, expired)
+	}
+	r.end(openID, "", nil)
+
+	// The transaction of an expired session which wasn't aborted, because
+	// the client continued it through another node, isn't reported.
+	if txns := r.expire(time.Now().Add(4 * time.Minute)); len(txns) != 1 || string(txns[0].ID) != "b" {
+		t.Fatalf("expected transaction b to expire, got %+v", txns)
+	}
+	if len(r.expired) != 0 {
+		t.Fatalf("expected expiration of transaction a to be forgotten, found %d", len(r.expired))
+	}
+	openID, expired, err = r.begin(txnB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if openID != "" || expired {
+		t.Fatalf("expected unknown session, got %q, %t", openID, expired)
+	}
+	r.end(openID, "", nil)
+}
+
+func TestSessionRegistrySessions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	r := sessionRegistry{}
+	now := time.Now()
+	txn := &roachpb.Transaction{ID: []byte("a"), Status: roachpb.PENDING}
+	r.end(r.beginOrFatal(t, nil), "alice", txn)
+
+	mem := &sessionMemory{}
+	if err := mem.grow(100, "test"); err != nil {
+		t.Fatal(err)
+	}
+	req := &sessionRequest{user: "bob", start: now.Add(-time.Second), mem: mem}
+	r.track(req)
+
+	infos := r.sessions(now)
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 sessions; got %+v", infos)
+	}
+	for _, info := range infos {
+		switch info.user {
+		case "alice":
+			if info.active || string(info.txnID) != "a" || info.memory != 0 {
+				t.Errorf("unexpected idle session %+v", info)
+			}
+		case "bob":
+			if !info.active || info.age != time.Second || info.memory != 100 {
+				t.Errorf("unexpected active session %+v", info)
+			}
+		default:
+			t.Errorf("unexpected session %+v", info)
+		}
+	}
+
+	// While a request of an open session is in flight, the session is
+	// described by the tracked request only.
+	openID := r.beginOrFatal(t, txn)
+	r.untrack(req)
+	if infos := r.sessions(now); len(infos) != 0 {
+		t.Errorf("expected no sessions; got %+v", infos)
+	}
+	r.end(openID, "alice", txn)
+}
+
+func (r *sessionRegistry) beginOrFatal(t *testing.T, txn *roachpb.Transaction) string {
+	openID, _, err := r.begin(txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return openID
+}
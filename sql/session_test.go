@@ -0,0 +1,102 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSessionToken verifies that a session with an open transaction can be
+// resumed on another connection from its session token, and that the node
+// which issued the token stops tracking the session.
+func TestSessionToken(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES (1, 1)`); err != nil {
+		t.Fatal(err)
+	}
+	var token string
+	if err := tx.QueryRow(`SHOW SESSION_TOKEN`).Scan(&token); err != nil {
+		t.Fatal(err)
+	}
+
+	// The session is no longer tracked as idle with an open transaction.
+	rows, err := sqlDB.Query(`SHOW SESSIONS`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sessions int
+	for rows.Next() {
+		sessions++
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if sessions != 1 {
+		t.Errorf("expected only the session running SHOW SESSIONS; got %d sessions", sessions)
+	}
+
+	// Resume the session and commit its transaction on another connection.
+	resumedDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs&session=%s",
+		security.RootUser, s.ServingAddr(), url.QueryEscape(token)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resumedDB.Close()
+	resumedDB.SetMaxOpenConns(1)
+	if _, err := resumedDB.Exec(`INSERT INTO t.kv VALUES (2, 2); COMMIT`); err != nil {
+		t.Fatal(err)
+	}
+	// The transaction was committed on the other connection.
+	_ = tx.Rollback()
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Errorf("expected both rows of the resumed transaction; got %d", count)
+	}
+
+	// Malformed tokens are rejected.
+	badDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs&session=%%21",
+		security.RootUser, s.ServingAddr()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer badDB.Close()
+	if err := badDB.Ping(); err == nil {
+		t.Error("expected an invalid session token to be rejected")
+	}
+}
@@ -60,6 +60,20 @@ func (p *planner) Set(n *parser.Set) (planNode, error) {
 			return nil, fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, parser.Modern, parser.Traditional)
 		}
 
+	case `DISTSQL`:
+		s, err := p.getStringVal(name, n.Values)
+		if err != nil {
+			return nil, err
+		}
+		switch normalizeName(s) {
+		case "on":
+			p.session.DistSQL = true
+		case "off":
+			p.session.DistSQL = false
+		default:
+			return nil, fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, "on", "off")
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown variable: %q", name)
 	}
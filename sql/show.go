@@ -21,11 +21,14 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 // Show a session-local variable name.
@@ -45,8 +48,14 @@ func (p *planner) Show(n *parser.Show) (planNode, error) {
 		v.rows = append(v.rows, []parser.Datum{parser.DString(loc.String())})
 	case `SYNTAX`:
 		v.rows = append(v.rows, []parser.Datum{parser.DString(parser.Syntax(p.session.Syntax).String())})
+	case `DISTSQL`:
+		v.rows = append(v.rows, []parser.Datum{parser.DBool(p.session.DistSQL)})
 	case `TRANSACTION ISOLATION LEVEL`:
 		v.rows = append(v.rows, []parser.Datum{parser.DString(p.txn.Proto.Isolation.String())})
+	case `SESSIONS`:
+		return p.showSessions(), nil
+	case `SESSION_TOKEN`:
+		return p.showSessionToken()
 	default:
 		return nil, fmt.Errorf("unknown variable: %q", name)
 	}
@@ -54,6 +63,35 @@ func (p *planner) Show(n *parser.Show) (planNode, error) {
 	return v, nil
 }
 
+// showSessions returns the sessions executing a statement or holding an open
+// transaction on this node, along with the memory used by the statements in
+// flight. The idle time of sessions is reported as their age.
+// Privileges: None.
+//   Notes: users other than root only see their own sessions.
+func (p *planner) showSessions() planNode {
+	v := &valuesNode{columns: []string{"User", "Transaction", "Active", "Age", "Memory"}}
+	if p.sessions == nil {
+		return v
+	}
+	for _, s := range p.sessions.sessions(time.Now()) {
+		if p.user != security.RootUser && s.user != p.user {
+			continue
+		}
+		txn := parser.Datum(parser.DNull)
+		if len(s.txnID) > 0 {
+			txn = parser.DString(uuid.UUID(s.txnID).Short())
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(s.user),
+			txn,
+			parser.DBool(s.active),
+			parser.DInterval{Duration: s.age},
+			parser.DInt(s.memory),
+		})
+	}
+	return v
+}
+
 // ShowColumns of a table.
 // Privileges: None.
 //   Notes: postgres does not have a SHOW COLUMNS statement.
@@ -63,7 +101,7 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	v := &valuesNode{columns: []string{"Field", "Type", "Null", "Default"}}
+	v := &valuesNode{columns: []string{"Field", "Type", "Null", "Default", "Comment"}}
 	for i, col := range desc.Columns {
 		defaultExpr := parser.Datum(parser.DNull)
 		if e := desc.Columns[i].DefaultExpr; e != nil {
@@ -74,12 +112,14 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 			parser.DString(col.Type.SQLString()),
 			parser.DBool(desc.Columns[i].Nullable),
 			defaultExpr,
+			commentDatum(col.Comment),
 		})
 	}
 	return v, nil
 }
 
-// ShowDatabases returns all the databases.
+// ShowDatabases returns the databases the user has privileges on, either on
+// the database itself or on one of its tables.
 // Privileges: None.
 //   Notes: postgres does not have a "show databases"
 //          mysql has a "SHOW DATABASES" permission, but we have no system-level permissions.
@@ -99,11 +139,42 @@ func (p *planner) ShowDatabases(n *parser.ShowDatabases) (planNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		if visible, err := p.databaseVisible(name); err != nil {
+			return nil, err
+		} else if !visible {
+			continue
+		}
 		v.rows = append(v.rows, []parser.Datum{parser.DString(name)})
 	}
 	return v, nil
 }
 
+// databaseVisible returns whether the user has privileges on the named
+// database or on one of its tables.
+func (p *planner) databaseVisible(name string) (bool, error) {
+	dbDesc, err := p.getDatabaseDesc(name)
+	if err != nil {
+		return false, err
+	}
+	if visible, err := p.anyPrivilege(dbDesc); err != nil || visible {
+		return visible, err
+	}
+	tableNames, err := p.getTableNames(dbDesc)
+	if err != nil {
+		return false, err
+	}
+	for _, tableName := range tableNames {
+		desc, err := p.getTableDesc(tableName)
+		if err != nil {
+			return false, err
+		}
+		if visible, err := p.anyPrivilege(desc); err != nil || visible {
+			return visible, err
+		}
+	}
+	return false, nil
+}
+
 // ShowGrants returns grant details for the specified objects and users.
 // TODO(marc): implement multiple targets, or no targets (meaning full scan).
 // Privileges: None.
@@ -183,7 +254,7 @@ func (p *planner) ShowIndex(n *parser.ShowIndex) (planNode, error) {
 	return v, nil
 }
 
-// ShowTables returns all the tables.
+// ShowTables returns the tables of a database the user has privileges on.
 // Privileges: None.
 //   Notes: postgres does not have a SHOW TABLES statement.
 //          mysql only returns tables you have privileges on.
@@ -209,9 +280,21 @@ func (p *planner) ShowTables(n *parser.ShowTables) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	v := &valuesNode{columns: []string{"Table"}}
+	v := &valuesNode{columns: []string{"Table", "Comment"}}
 	for _, name := range tableNames {
-		v.rows = append(v.rows, []parser.Datum{parser.DString(name.Table())})
+		desc, err := p.getTableDesc(name)
+		if err != nil {
+			return nil, err
+		}
+		if visible, err := p.anyPrivilege(desc); err != nil {
+			return nil, err
+		} else if !visible {
+			continue
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(name.Table()),
+			commentDatum(desc.Comment),
+		})
 	}
 
 	return v, nil
@@ -0,0 +1,89 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// stmtStats are the statistics of the execution of a statement which are
+// recorded in the slow query log.
+type stmtStats struct {
+	planning  time.Duration // making the plans, summed over all attempts
+	execution time.Duration // running the plans, summed over all attempts
+	attempts  int           // more than one if the statement was retried
+	rows      int64         // rows returned or affected by the last attempt
+	kv        client.TxnStats
+}
+
+// A slowQueryLog records the statements whose execution takes longer than a
+// threshold.
+type slowQueryLog struct {
+	threshold time.Duration // zero disables the log
+	mu        sync.Mutex    // serializes writes to w
+	w         io.Writer     // if nil, entries go to the server log
+}
+
+// SetSlowQueryLog makes the Executor record the statements taking longer
+// than threshold to execute, along with their latency, the number of KV
+// batches, rows and retries they took, to w. Statements are identified by
+// their fingerprint, with constants replaced by placeholders. If w is nil,
+// slow statements are logged as warnings. A zero threshold disables the
+// log. This method must be called before actually using the Executor.
+func (e *Executor) SetSlowQueryLog(threshold time.Duration, w io.Writer) {
+	e.slowQueries.threshold = threshold
+	e.slowQueries.w = w
+}
+
+// maybeLogSlowQuery records the statement in the slow query log if its
+// execution took longer than the log's threshold.
+func (e *Executor) maybeLogSlowQuery(stmt parser.Statement, latency time.Duration,
+	stats *stmtStats, err error) {
+	l := &e.slowQueries
+	if l.threshold <= 0 || latency < l.threshold {
+		return
+	}
+	if e.metrics != nil {
+		e.metrics.Counter("sql.statements.slow").Inc(1)
+	}
+	retries := 0
+	if stats.attempts > 1 {
+		retries = stats.attempts - 1
+	}
+	entry := fmt.Sprintf("latency=%s planning=%s execution=%s kv_batches=%d kv_reads=%d kv_writes=%d rows=%d retries=%d",
+		latency, stats.planning, stats.execution, stats.kv.Batches, stats.kv.Reads, stats.kv.Writes,
+		stats.rows, retries)
+	if err != nil {
+		entry += fmt.Sprintf(" error=%q", err)
+	}
+	entry += fmt.Sprintf(" stmt=%q", parser.Fingerprint(stmt))
+	if l.w == nil {
+		log.Warningf("slow query: %s", entry)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := fmt.Fprintf(l.w, "%s %s\n", time.Now().UTC().Format(time.RFC3339Nano), entry); err != nil {
+		log.Warningf("unable to write to the slow query log: %s", err)
+	}
+}
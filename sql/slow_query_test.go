@@ -0,0 +1,95 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSlowQueryLog verifies that statements exceeding the slow query
+// threshold are recorded in the slow query log by fingerprint, along with
+// their statistics.
+func TestSlowQueryLog(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	f, err := ioutil.TempFile("", "slow-query-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := server.NewTestContext()
+	ctx.SQLSlowQueryThreshold = time.Nanosecond
+	ctx.SQLSlowQueryLog = f.Name()
+	s, sqlDB, _ := setupWithContext(t, ctx)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2);
+`); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv WHERE v > 0`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var insert, selectEntry string
+	for _, line := range strings.Split(string(data), "\n") {
+		switch {
+		case strings.Contains(line, `stmt="INSERT INTO t.kv VALUES ($_, $_), ($_, $_)"`):
+			insert = line
+		case strings.Contains(line, `stmt="SELECT COUNT(*) FROM t.kv WHERE v > $_"`):
+			selectEntry = line
+		}
+	}
+	if insert == "" || selectEntry == "" {
+		t.Fatalf("expected the INSERT and SELECT statements to be logged; got:\n%s", data)
+	}
+	for _, field := range []string{"rows=2", "retries=0"} {
+		if !strings.Contains(insert, field) {
+			t.Errorf("expected %s in %q", field, insert)
+		}
+	}
+	if strings.Contains(insert, "kv_writes=0 ") {
+		t.Errorf("expected KV writes in %q", insert)
+	}
+	for _, field := range []string{"rows=1", "kv_writes=0"} {
+		if !strings.Contains(selectEntry, field) {
+			t.Errorf("expected %s in %q", field, selectEntry)
+		}
+	}
+	if strings.Contains(selectEntry, "kv_reads=0 ") {
+		t.Errorf("expected KV reads in %q", selectEntry)
+	}
+}
@@ -72,7 +72,7 @@ func (p *planner) orderBy(n *parser.Select, s *scanNode) (*sortNode, error) {
 				if err := qname.NormalizeColumnName(); err != nil {
 					return nil, err
 				}
-				if qname.Table() == "" || equalName(s.desc.Alias, qname.Table()) {
+				if qname.Table() == "" || (equalName(s.desc.Alias, qname.Table()) && s.matchesDatabase(qname)) {
 					for j, r := range s.render {
 						if qval, ok := r.(*qvalue); ok {
 							if equalName(qval.col.Name, qname.Column()) {
@@ -122,10 +122,11 @@ func (p *planner) orderBy(n *parser.Select, s *scanNode) (*sortNode, error) {
 		ordering = append(ordering, index)
 	}
 
-	return &sortNode{columns: columns, ordering: ordering}, nil
+	return &sortNode{planner: p, columns: columns, ordering: ordering}, nil
 }
 
 type sortNode struct {
+	planner  *planner
 	plan     planNode
 	columns  []string
 	ordering []int
@@ -225,6 +226,9 @@ func (n *sortNode) initValues() bool {
 		values := n.plan.Values()
 		valuesCopy := make(parser.DTuple, len(values))
 		copy(valuesCopy, values)
+		if n.err = n.planner.stmtMemory.grow(tupleSize(valuesCopy), "sort"); n.err != nil {
+			return false
+		}
 		v.rows = append(v.rows, valuesCopy)
 	}
 	n.err = n.plan.Err()
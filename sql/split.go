@@ -0,0 +1,131 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Split splits the range containing the given primary key values of a
+// table, so that a new range starts with them. Fewer values than primary
+// key columns may be given, in which case the split happens at the first
+// row with those leading values. The split takes effect immediately and
+// is not undone if the enclosing transaction aborts.
+// Privileges: "root" user.
+//   Notes: postgres and mysql do not have ranges.
+func (p *planner) Split(n *parser.Split) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to split tables", security.RootUser)
+	}
+	tableDesc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
+	index := &tableDesc.PrimaryIndex
+	if len(n.Exprs) > len(index.ColumnIDs) {
+		return nil, fmt.Errorf("too many values: table %q has %d primary key columns",
+			tableDesc.Name, len(index.ColumnIDs))
+	}
+
+	key := MakeIndexKeyPrefix(tableDesc.ID, index.ID)
+	for i, expr := range n.Exprs {
+		if parser.ContainsVars(expr) {
+			return nil, fmt.Errorf("split values must not contain variables")
+		}
+		normalized, err := p.evalCtx.NormalizeExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		val, err := normalized.Eval(p.evalCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == parser.DNull {
+			return nil, fmt.Errorf("split values must not be NULL")
+		}
+		col, err := tableDesc.FindColumnByID(index.ColumnIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		// Reject values which could not be stored in the column.
+		if _, err := marshalColumnValue(*col, val); err != nil {
+			return nil, err
+		}
+		if key, err = encodeTableKey(key, val); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.db.AdminSplit(roachpb.Key(key)); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// Scatter adds a replica of each range of a table on a randomly chosen
+// store, relying on the replicate queue to remove the excess replicas, so
+// that the ranges of a freshly split table are spread across the cluster.
+// Privileges: "root" user.
+//   Notes: postgres and mysql do not have ranges.
+func (p *planner) Scatter(n *parser.Scatter) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to scatter tables", security.RootUser)
+	}
+	tableDesc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
+
+	start := roachpb.Key(keys.MakeTablePrefix(uint32(tableDesc.ID)))
+	end := start.PrefixEnd()
+	for key := start; bytes.Compare(key, end) < 0; {
+		descs, err := p.db.ScanRangeDescriptors(key, rangeLookupBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(descs) == 0 {
+			break
+		}
+		for _, desc := range descs {
+			if bytes.Compare(desc.StartKey, end) >= 0 {
+				return &valuesNode{}, nil
+			}
+			// The first range may start before the table, in which case it
+			// is addressed by the start of the table.
+			rangeKey := desc.StartKey.AsRawKey()
+			if bytes.Compare(rangeKey, start) < 0 {
+				rangeKey = start
+			}
+			if err := p.db.AdminScatter(rangeKey); err != nil {
+				return nil, err
+			}
+			key = desc.EndKey.AsRawKey()
+		}
+	}
+	return &valuesNode{}, nil
+}
@@ -26,7 +26,10 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -139,3 +142,54 @@ func TestSplitOnTableBoundaries(t *testing.T) {
 		t.Fatalf("Found ranges: %v\nexpected: %v", ranges, splits)
 	}
 }
+
+// TestSplitAtAndScatter verifies that ALTER TABLE ... SPLIT AT splits the
+// table's ranges at the given primary key and that ALTER TABLE ... SCATTER
+// succeeds even when there are no other stores to scatter to.
+func TestSplitAtAndScatter(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE test;
+CREATE TABLE test.t (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`ALTER TABLE test.t SPLIT AT (5)`); err != nil {
+		t.Fatal(err)
+	}
+
+	tableID := sql.ID(keys.MaxReservedDescID + 2)
+	splitKey := encoding.EncodeVarint(sql.MakeIndexKeyPrefix(tableID, 1), 5)
+	ranges, err := getRangeKeys(kvDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, key := range ranges {
+		if key.Equal(splitKey) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("no range ends at %s: %v", roachpb.Key(splitKey), ranges)
+	}
+
+	for _, test := range []struct {
+		stmt, expErr string
+	}{
+		{`ALTER TABLE test.t SPLIT AT (1, 2)`, "too many values"},
+		{`ALTER TABLE test.t SPLIT AT ('a')`, "doesn't match type"},
+		{`ALTER TABLE test.t SPLIT AT (NULL)`, "must not be NULL"},
+	} {
+		if _, err := sqlDB.Exec(test.stmt); !testutils.IsError(err, test.expErr) {
+			t.Errorf("%s: expected error %q; got %v", test.stmt, test.expErr, err)
+		}
+	}
+
+	if _, err := sqlDB.Exec(`ALTER TABLE test.t SCATTER`); err != nil {
+		t.Fatal(err)
+	}
+}
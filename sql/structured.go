@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/util"
@@ -252,6 +253,7 @@ func (desc *TableDescriptor) Validate() error {
 
 	columnNames := map[string]ColumnID{}
 	columnIDs := map[ColumnID]string{}
+	arrayColumnIDs := map[ColumnID]struct{}{}
 	for _, column := range desc.Columns {
 		if err := validateName(column.Name, "column"); err != nil {
 			return err
@@ -275,6 +277,13 @@ func (desc *TableDescriptor) Validate() error {
 			return fmt.Errorf("column \"%s\" invalid ID (%d) > next column ID (%d)",
 				column.Name, column.ID, desc.NextColumnID)
 		}
+
+		if column.Type.Kind == ColumnType_ARRAY {
+			if c := column.Type.ArrayContents; c == nil || *c == ColumnType_ARRAY {
+				return fmt.Errorf("column \"%s\" has invalid array element type", column.Name)
+			}
+			arrayColumnIDs[column.ID] = struct{}{}
+		}
 	}
 
 	// TODO(pmattis): Check that the indexes are unique. That is, no 2 indexes
@@ -282,6 +291,11 @@ func (desc *TableDescriptor) Validate() error {
 	if len(desc.PrimaryIndex.ColumnIDs) == 0 {
 		return errMissingPrimaryKey
 	}
+	for _, column := range desc.Columns {
+		if column.OnUpdateExpr != nil && desc.PrimaryIndex.containsColumnID(column.ID) {
+			return fmt.Errorf("primary key column \"%s\" cannot have an on update expression", column.Name)
+		}
+	}
 
 	indexNames := map[string]struct{}{}
 	indexIDs := map[IndexID]string{}
@@ -318,21 +332,97 @@ func (desc *TableDescriptor) Validate() error {
 			return fmt.Errorf("index \"%s\" must contain at least 1 column", index.Name)
 		}
 
+		if len(index.ColumnDirections) > len(index.ColumnNames) {
+			return fmt.Errorf("index \"%s\" has more column directions (%d) than columns (%d)",
+				index.Name, len(index.ColumnDirections), len(index.ColumnNames))
+		}
+
+		indexColumnIDs := map[ColumnID]struct{}{}
 		for i, name := range index.ColumnNames {
 			colID, ok := columnNames[normalizeName(name)]
 			if !ok {
 				return fmt.Errorf("index \"%s\" contains unknown column \"%s\"", index.Name, name)
 			}
+			if _, ok := indexColumnIDs[colID]; ok {
+				return fmt.Errorf("index \"%s\" contains duplicate column \"%s\"", index.Name, name)
+			}
+			indexColumnIDs[colID] = struct{}{}
 			if colID != index.ColumnIDs[i] {
 				return fmt.Errorf("index \"%s\" column \"%s\" should have ID %d, but found ID %d",
 					index.Name, name, colID, index.ColumnIDs[i])
 			}
+			if _, ok := arrayColumnIDs[colID]; ok {
+				return fmt.Errorf("index \"%s\" contains array column \"%s\": arrays cannot be indexed",
+					index.Name, name)
+			}
+		}
+		for _, colID := range index.ImplicitColumnIDs {
+			if _, ok := columnIDs[colID]; !ok {
+				return fmt.Errorf("index \"%s\" contains unknown implicit column ID %d", index.Name, colID)
+			}
 		}
 	}
+
+	if ttl := desc.RowTTL; ttl != nil {
+		if ttl.Duration <= 0 {
+			return fmt.Errorf("row TTL must be positive: %s", time.Duration(ttl.Duration))
+		}
+		col, err := desc.FindColumnByID(ttl.ColumnID)
+		if err != nil {
+			return fmt.Errorf("row TTL refers to unknown column ID %d", ttl.ColumnID)
+		}
+		if col.Type.Kind != ColumnType_TIMESTAMP {
+			return fmt.Errorf("row TTL column \"%s\" must be of type TIMESTAMP, not %s",
+				col.Name, col.Type.SQLString())
+		}
+	}
+
 	// Validate the privilege descriptor.
 	return desc.Privileges.Validate(desc.GetID())
 }
 
+// ValidateUpdate validates that the table descriptor is well formed and
+// that it is a valid new version of prev: the IDs of the columns and indexes
+// already present in prev are left unchanged and new columns and indexes do
+// not reuse the IDs of columns and indexes that have been dropped.
+func (desc *TableDescriptor) ValidateUpdate(prev *TableDescriptor) error {
+	if err := desc.Validate(); err != nil {
+		return err
+	}
+	if desc.ID != prev.ID {
+		return fmt.Errorf("table \"%s\" ID changed from %d to %d", desc.Name, prev.ID, desc.ID)
+	}
+	if desc.NextColumnID < prev.NextColumnID {
+		return fmt.Errorf("table \"%s\" next column ID decreased from %d to %d",
+			desc.Name, prev.NextColumnID, desc.NextColumnID)
+	}
+	if desc.NextIndexID < prev.NextIndexID {
+		return fmt.Errorf("table \"%s\" next index ID decreased from %d to %d",
+			desc.Name, prev.NextIndexID, desc.NextIndexID)
+	}
+
+	prevColumnIDs := map[ColumnID]struct{}{}
+	for _, column := range prev.Columns {
+		prevColumnIDs[column.ID] = struct{}{}
+	}
+	for _, column := range desc.Columns {
+		if _, ok := prevColumnIDs[column.ID]; !ok && column.ID < prev.NextColumnID {
+			return fmt.Errorf("column \"%s\" reuses ID %d of a dropped column", column.Name, column.ID)
+		}
+	}
+
+	prevIndexIDs := map[IndexID]struct{}{}
+	for _, index := range append([]IndexDescriptor{prev.PrimaryIndex}, prev.Indexes...) {
+		prevIndexIDs[index.ID] = struct{}{}
+	}
+	for _, index := range append([]IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		if _, ok := prevIndexIDs[index.ID]; !ok && index.ID < prev.NextIndexID {
+			return fmt.Errorf("index \"%s\" reuses ID %d of a dropped index", index.Name, index.ID)
+		}
+	}
+	return nil
+}
+
 // AddColumn adds a column to the table.
 func (desc *TableDescriptor) AddColumn(col ColumnDescriptor) {
 	desc.Columns = append(desc.Columns, col)
@@ -417,6 +507,10 @@ func (c *ColumnType) SQLString() string {
 			}
 			return fmt.Sprintf("%s(%d)", c.Kind.String(), c.Precision)
 		}
+	case ColumnType_ARRAY:
+		if c.ArrayContents != nil {
+			return fmt.Sprintf("%s[]", c.ArrayContents.String())
+		}
 	}
 	return c.Kind.String()
 }
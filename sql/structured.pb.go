@@ -32,6 +32,7 @@ const (
 	ColumnType_INTERVAL  ColumnType_Kind = 6
 	ColumnType_STRING    ColumnType_Kind = 7
 	ColumnType_BYTES     ColumnType_Kind = 8
+	ColumnType_ARRAY     ColumnType_Kind = 9
 )
 
 var ColumnType_Kind_name = map[int32]string{
@@ -44,6 +45,7 @@ var ColumnType_Kind_name = map[int32]string{
 	6: "INTERVAL",
 	7: "STRING",
 	8: "BYTES",
+	9: "ARRAY",
 }
 var ColumnType_Kind_value = map[string]int32{
 	"BOOL":      0,
@@ -55,6 +57,7 @@ var ColumnType_Kind_value = map[string]int32{
 	"INTERVAL":  6,
 	"STRING":    7,
 	"BYTES":     8,
+	"ARRAY":     9,
 }
 
 func (x ColumnType_Kind) Enum() *ColumnType_Kind {
@@ -74,12 +77,85 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// The direction of a column in the index.
+type IndexDescriptor_Direction int32
+
+const (
+	IndexDescriptor_ASC  IndexDescriptor_Direction = 0
+	IndexDescriptor_DESC IndexDescriptor_Direction = 1
+)
+
+var IndexDescriptor_Direction_name = map[int32]string{
+	0: "ASC",
+	1: "DESC",
+}
+var IndexDescriptor_Direction_value = map[string]int32{
+	"ASC":  0,
+	"DESC": 1,
+}
+
+func (x IndexDescriptor_Direction) Enum() *IndexDescriptor_Direction {
+	p := new(IndexDescriptor_Direction)
+	*p = x
+	return p
+}
+func (x IndexDescriptor_Direction) String() string {
+	return proto.EnumName(IndexDescriptor_Direction_name, int32(x))
+}
+func (x *IndexDescriptor_Direction) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(IndexDescriptor_Direction_value, data, "IndexDescriptor_Direction")
+	if err != nil {
+		return err
+	}
+	*x = IndexDescriptor_Direction(value)
+	return nil
+}
+
+// The state of a table. Only public tables can be read or written by
+// statements; a table being added is still being filled (e.g. by IMPORT)
+// and is invisible to everybody else.
+type TableDescriptor_State int32
+
+const (
+	TableDescriptor_PUBLIC TableDescriptor_State = 0
+	TableDescriptor_ADD    TableDescriptor_State = 1
+)
+
+var TableDescriptor_State_name = map[int32]string{
+	0: "PUBLIC",
+	1: "ADD",
+}
+var TableDescriptor_State_value = map[string]int32{
+	"PUBLIC": 0,
+	"ADD":    1,
+}
+
+func (x TableDescriptor_State) Enum() *TableDescriptor_State {
+	p := new(TableDescriptor_State)
+	*p = x
+	return p
+}
+func (x TableDescriptor_State) String() string {
+	return proto.EnumName(TableDescriptor_State_name, int32(x))
+}
+func (x *TableDescriptor_State) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TableDescriptor_State_value, data, "TableDescriptor_State")
+	if err != nil {
+		return err
+	}
+	*x = TableDescriptor_State(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.sql.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
 	Width int32 `protobuf:"varint,2,opt,name=width" json:"width"`
 	// FLOAT and DECIMAL.
 	Precision int32 `protobuf:"varint,3,opt,name=precision" json:"precision"`
+	// ARRAY: the kind of the array elements. Only one-dimensional arrays of
+	// non-array kinds are supported.
+	ArrayContents *ColumnType_Kind `protobuf:"varint,4,opt,name=array_contents,enum=cockroach.sql.ColumnType_Kind" json:"array_contents,omitempty"`
 }
 
 func (m *ColumnType) Reset()         { *m = ColumnType{} }
@@ -94,6 +170,11 @@ type ColumnDescriptor struct {
 	// Default expression to use to populate the column on insert if no
 	// value is provided.
 	DefaultExpr *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	// Expression to use to populate the column on update if no value is
+	// provided.
+	OnUpdateExpr *string `protobuf:"bytes,6,opt,name=on_update_expr" json:"on_update_expr,omitempty"`
+	// The comment of the column set by COMMENT ON COLUMN, if any.
+	Comment string `protobuf:"bytes,7,opt,name=comment" json:"comment"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
@@ -121,6 +202,10 @@ type IndexDescriptor struct {
 	// computed as PrimaryIndex.column_ids - column_ids. For the primary index
 	// the list will be empty.
 	ImplicitColumnIDs []ColumnID `protobuf:"varint,7,rep,name=implicit_column_ids,casttype=ColumnID" json:"implicit_column_ids,omitempty"`
+	// An ordered list of the directions of the columns of the index. This list
+	// parallels the column_names list. It may be shorter than column_names, in
+	// which case the remaining columns are ascending.
+	ColumnDirections []IndexDescriptor_Direction `protobuf:"varint,8,rep,name=column_directions,enum=cockroach.sql.IndexDescriptor_Direction" json:"column_directions,omitempty"`
 }
 
 func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
@@ -151,6 +236,16 @@ type TableDescriptor struct {
 	// next_index_id is used to ensure that deleted index ids are not reused.
 	NextIndexID IndexID              `protobuf:"varint,11,opt,name=next_index_id,casttype=IndexID" json:"next_index_id"`
 	Privileges  *PrivilegeDescriptor `protobuf:"bytes,12,opt,name=privileges" json:"privileges,omitempty"`
+	// The source of the rows of an external table, which is nil for tables
+	// stored in the cluster.
+	External *ExternalSource `protobuf:"bytes,13,opt,name=external" json:"external,omitempty"`
+	// The comment of the table set by COMMENT ON TABLE, if any.
+	Comment string `protobuf:"bytes,14,opt,name=comment" json:"comment"`
+	// The row TTL of the table, which is nil for tables whose rows don't
+	// expire.
+	RowTTL *RowTTL `protobuf:"bytes,15,opt,name=row_ttl" json:"row_ttl,omitempty"`
+	// The state of the table, which is nil for public tables.
+	State *TableDescriptor_State `protobuf:"varint,16,opt,name=state,enum=cockroach.sql.TableDescriptor_State" json:"state,omitempty"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -241,6 +336,62 @@ func (m *TableDescriptor) GetPrivileges() *PrivilegeDescriptor {
 	return nil
 }
 
+func (m *TableDescriptor) GetExternal() *ExternalSource {
+	if m != nil {
+		return m.External
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *TableDescriptor) GetRowTTL() *RowTTL {
+	if m != nil {
+		return m.RowTTL
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetState() TableDescriptor_State {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return TableDescriptor_PUBLIC
+}
+
+// ExternalSource describes the data of an external table, which is read
+// from CSV files on every scan instead of being stored in the cluster.
+type ExternalSource struct {
+	// The URLs of the CSV files, which are read in order.
+	URLs []string `protobuf:"bytes,1,rep,name=urls" json:"urls,omitempty"`
+	// The field delimiter, which defaults to a comma.
+	Delimiter string `protobuf:"bytes,2,opt,name=delimiter" json:"delimiter"`
+	// The comment character, if any. Lines starting with it are skipped.
+	Comment string `protobuf:"bytes,3,opt,name=comment" json:"comment"`
+}
+
+func (m *ExternalSource) Reset()         { *m = ExternalSource{} }
+func (m *ExternalSource) String() string { return proto.CompactTextString(m) }
+func (*ExternalSource) ProtoMessage()    {}
+
+// RowTTL describes the expiration of the rows of a table: rows whose
+// timestamp column holds a time older than the TTL are deleted.
+type RowTTL struct {
+	// The ID of the TIMESTAMP column holding the time the TTL applies to.
+	ColumnID ColumnID `protobuf:"varint,1,opt,name=column_id,casttype=ColumnID" json:"column_id"`
+	// The TTL in nanoseconds.
+	Duration int64 `protobuf:"varint,2,opt,name=duration" json:"duration"`
+}
+
+func (m *RowTTL) Reset()         { *m = RowTTL{} }
+func (m *RowTTL) String() string { return proto.CompactTextString(m) }
+func (*RowTTL) ProtoMessage()    {}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -380,6 +531,8 @@ func _Descriptor_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buf
 
 func init() {
 	proto.RegisterEnum("cockroach.sql.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.sql.IndexDescriptor_Direction", IndexDescriptor_Direction_name, IndexDescriptor_Direction_value)
+	proto.RegisterEnum("cockroach.sql.TableDescriptor_State", TableDescriptor_State_name, TableDescriptor_State_value)
 }
 func (m *ColumnType) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -405,6 +558,11 @@ func (m *ColumnType) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Precision))
+	if m.ArrayContents != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintStructured(data, i, uint64(*m.ArrayContents))
+	}
 	return i, nil
 }
 
@@ -452,6 +610,16 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
 		i += copy(data[i:], *m.DefaultExpr)
 	}
+	if m.OnUpdateExpr != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.OnUpdateExpr)))
+		i += copy(data[i:], *m.OnUpdateExpr)
+	}
+	data[i] = 0x3a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Comment)))
+	i += copy(data[i:], m.Comment)
 	return i, nil
 }
 
@@ -529,6 +697,13 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (int, error) {
 			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	if len(m.ColumnDirections) > 0 {
+		for _, num := range m.ColumnDirections {
+			data[i] = 0x40
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
 	return i, nil
 }
 
@@ -620,6 +795,102 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.External != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.External.Size()))
+		n9, err := m.External.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	data[i] = 0x72
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Comment)))
+	i += copy(data[i:], m.Comment)
+	if m.RowTTL != nil {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.RowTTL.Size()))
+		n10, err := m.RowTTL.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.State != nil {
+		data[i] = 0x80
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintStructured(data, i, uint64(*m.State))
+	}
+	return i, nil
+}
+
+func (m *ExternalSource) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ExternalSource) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URLs) > 0 {
+		for _, s := range m.URLs {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Delimiter)))
+	i += copy(data[i:], m.Delimiter)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Comment)))
+	i += copy(data[i:], m.Comment)
+	return i, nil
+}
+
+func (m *RowTTL) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RowTTL) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ColumnID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Duration))
 	return i, nil
 }
 
@@ -744,6 +1015,9 @@ func (m *ColumnType) Size() (n int) {
 	n += 1 + sovStructured(uint64(m.Kind))
 	n += 1 + sovStructured(uint64(m.Width))
 	n += 1 + sovStructured(uint64(m.Precision))
+	if m.ArrayContents != nil {
+		n += 1 + sovStructured(uint64(*m.ArrayContents))
+	}
 	return n
 }
 
@@ -760,6 +1034,12 @@ func (m *ColumnDescriptor) Size() (n int) {
 		l = len(*m.DefaultExpr)
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	if m.OnUpdateExpr != nil {
+		l = len(*m.OnUpdateExpr)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	l = len(m.Comment)
+	n += 1 + l + sovStructured(uint64(l))
 	return n
 }
 
@@ -792,6 +1072,11 @@ func (m *IndexDescriptor) Size() (n int) {
 			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	if len(m.ColumnDirections) > 0 {
+		for _, e := range m.ColumnDirections {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
 	return n
 }
 
@@ -827,6 +1112,43 @@ func (m *TableDescriptor) Size() (n int) {
 		l = m.Privileges.Size()
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	if m.External != nil {
+		l = m.External.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	l = len(m.Comment)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.RowTTL != nil {
+		l = m.RowTTL.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.State != nil {
+		n += 2 + sovStructured(uint64(*m.State))
+	}
+	return n
+}
+
+func (m *ExternalSource) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.URLs) > 0 {
+		for _, s := range m.URLs {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	l = len(m.Delimiter)
+	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Comment)
+	n += 1 + l + sovStructured(uint64(l))
+	return n
+}
+
+func (m *RowTTL) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.ColumnID))
+	n += 1 + sovStructured(uint64(m.Duration))
 	return n
 }
 
@@ -970,6 +1292,26 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayContents", wireType)
+			}
+			var v ColumnType_Kind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnType_Kind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArrayContents = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1148,6 +1490,65 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.DefaultExpr = &s
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdateExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.OnUpdateExpr = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1364,6 +1765,26 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.ImplicitColumnIDs = append(m.ImplicitColumnIDs, v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnDirections", wireType)
+			}
+			var v IndexDescriptor_Direction
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (IndexDescriptor_Direction(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnDirections = append(m.ColumnDirections, v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1722,13 +2143,265 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructured(data[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.External == nil {
+				m.External = &ExternalSource{}
+			}
+			if err := m.External.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowTTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowTTL == nil {
+				m.RowTTL = &RowTTL{}
+			}
+			if err := m.RowTTL.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var v TableDescriptor_State
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (TableDescriptor_State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.State = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalSource) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLs = append(m.URLs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delimiter = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthStructured
 			}
 			if (iNdEx + skippy) > l {
@@ -1743,6 +2416,95 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *RowTTL) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnID", wireType)
+			}
+			m.ColumnID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ColumnID |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *DatabaseDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
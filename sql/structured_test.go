@@ -21,10 +21,12 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/gogo/protobuf/proto"
 )
 
 func TestAllocateIDs(t *testing.T) {
@@ -271,6 +273,111 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`column "bar" has invalid array element type`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", Type: sql.ColumnType{Kind: sql.ColumnType_ARRAY}},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`index "bar" contains array column "bar": arrays cannot be indexed`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", Type: sql.ColumnType{
+						Kind: sql.ColumnType_ARRAY, ArrayContents: sql.ColumnType_INT.Enum()}},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`primary key column "bar" cannot have an on update expression`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", OnUpdateExpr: proto.String("now()")},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`index "bar" contains duplicate column "bar"`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar",
+					ColumnIDs: []sql.ColumnID{1, 1}, ColumnNames: []string{"bar", "bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`index "blah" contains unknown implicit column ID 2`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				Indexes: []sql.IndexDescriptor{
+					{ID: 2, Name: "blah", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"},
+						ImplicitColumnIDs: []sql.ColumnID{2}},
+				},
+				NextColumnID: 3,
+				NextIndexID:  3,
+			}},
+		{`row TTL must be positive: -1s`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", Type: sql.ColumnType{Kind: sql.ColumnType_TIMESTAMP}},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				RowTTL:       &sql.RowTTL{ColumnID: 1, Duration: -int64(time.Second)},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`row TTL refers to unknown column ID 2`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", Type: sql.ColumnType{Kind: sql.ColumnType_TIMESTAMP}},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				RowTTL:       &sql.RowTTL{ColumnID: 2, Duration: int64(time.Hour)},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`row TTL column "bar" must be of type TIMESTAMP, not INT`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar", Type: sql.ColumnType{Kind: sql.ColumnType_INT}},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				RowTTL:       &sql.RowTTL{ColumnID: 1, Duration: int64(time.Hour)},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {
@@ -281,6 +388,84 @@ func TestValidateTableDesc(t *testing.T) {
 	}
 }
 
+func TestValidateTableDescUpdate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	prev := sql.TableDescriptor{
+		ID:       keys.MaxReservedDescID + 2,
+		ParentID: keys.MaxReservedDescID + 1,
+		Name:     "foo",
+		Columns: []sql.ColumnDescriptor{
+			{ID: 1, Name: "a"},
+			{ID: 3, Name: "c"},
+		},
+		PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "primary", Unique: true,
+			ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"a"}},
+		Indexes: []sql.IndexDescriptor{
+			{ID: 3, Name: "c", ColumnIDs: []sql.ColumnID{3}, ColumnNames: []string{"c"},
+				ImplicitColumnIDs: []sql.ColumnID{1}},
+		},
+		NextColumnID: 4,
+		NextIndexID:  4,
+		Privileges:   sql.NewDefaultPrivilegeDescriptor(),
+	}
+	if err := prev.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	testData := []struct {
+		err    string
+		update func(desc *sql.TableDescriptor)
+	}{
+		{``, func(desc *sql.TableDescriptor) {}},
+		{``, func(desc *sql.TableDescriptor) {
+			desc.Columns = append(desc.Columns, sql.ColumnDescriptor{ID: 4, Name: "d"})
+			desc.Indexes = append(desc.Indexes, sql.IndexDescriptor{ID: 4, Name: "d",
+				ColumnIDs: []sql.ColumnID{4}, ColumnNames: []string{"d"}})
+			desc.NextColumnID = 5
+			desc.NextIndexID = 5
+		}},
+		{``, func(desc *sql.TableDescriptor) {
+			desc.Columns[1].Name = "renamed"
+			desc.Indexes[0].ColumnNames[0] = "renamed"
+		}},
+		{`table "foo" ID changed from 1001 to 1002`, func(desc *sql.TableDescriptor) {
+			desc.ID++
+		}},
+		{`table "foo" next column ID decreased from 4 to 3`, func(desc *sql.TableDescriptor) {
+			desc.Columns = desc.Columns[:1]
+			desc.Indexes = nil
+			desc.NextColumnID = 3
+		}},
+		{`table "foo" next index ID decreased from 4 to 3`, func(desc *sql.TableDescriptor) {
+			desc.Columns = desc.Columns[:1]
+			desc.Indexes = nil
+			desc.NextIndexID = 3
+		}},
+		{`column "b" reuses ID 2 of a dropped column`, func(desc *sql.TableDescriptor) {
+			desc.Columns = append(desc.Columns, sql.ColumnDescriptor{ID: 2, Name: "b"})
+		}},
+		{`index "b" reuses ID 2 of a dropped index`, func(desc *sql.TableDescriptor) {
+			desc.Indexes = append(desc.Indexes, sql.IndexDescriptor{ID: 2, Name: "b",
+				ColumnIDs: []sql.ColumnID{3}, ColumnNames: []string{"c"}})
+		}},
+	}
+	for i, d := range testData {
+		desc := *proto.Clone(&prev).(*sql.TableDescriptor)
+		d.update(&desc)
+		err := desc.ValidateUpdate(&prev)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+		} else if err == nil {
+			t.Errorf("%d: expected \"%s\", but found success", i, d.err)
+		} else if d.err != err.Error() {
+			t.Errorf("%d: expected \"%s\", but found \"%s\"", i, d.err, err.Error())
+		}
+	}
+}
+
 func TestColumnTypeSQLString(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
@@ -301,6 +486,7 @@ func TestColumnTypeSQLString(t *testing.T) {
 		{sql.ColumnType{Kind: sql.ColumnType_STRING}, "STRING"},
 		{sql.ColumnType{Kind: sql.ColumnType_STRING, Width: 10}, "STRING(10)"},
 		{sql.ColumnType{Kind: sql.ColumnType_BYTES}, "BYTES"},
+		{sql.ColumnType{Kind: sql.ColumnType_ARRAY, ArrayContents: sql.ColumnType_INT.Enum()}, "INT[]"},
 	}
 	for i, d := range testData {
 		sql := d.colType.SQLString()
@@ -18,6 +18,8 @@
 package sql
 
 import (
+	"time"
+
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
@@ -26,6 +28,17 @@ import (
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
+const (
+	// eventLogRetention is the row TTL of the event log table.
+	eventLogRetention = 90 * 24 * time.Hour
+	// jobsRetention is the row TTL of the jobs table, which applies to the
+	// time a job was last modified.
+	jobsRetention = 14 * 24 * time.Hour
+	// nodesRetention is the row TTL of the nodes table, which applies to the
+	// time a row was last written; see nodeRowRefreshInterval.
+	nodesRetention = 24 * time.Hour
+)
+
 const (
 	// sql CREATE commands and full schema for each system table.
 	namespaceTableSchema = `
@@ -63,6 +76,66 @@ CREATE TABLE system.zones (
   id     INT PRIMARY KEY,
   config BYTES
 );`
+
+	// Long running operations, such as BACKUP and RESTORE.
+	jobsTableSchema = `
+CREATE TABLE system.jobs (
+  id          INT PRIMARY KEY,
+  type        STRING,
+  description STRING,
+  status      STRING,
+  created     TIMESTAMP,
+  modified    TIMESTAMP,
+  fraction    FLOAT
+);`
+
+	// Memberships of users and roles in roles.
+	roleMembersTableSchema = `
+CREATE TABLE system.role_members (
+  member STRING,
+  role   STRING,
+  PRIMARY KEY (member, role)
+);`
+
+	// Topology of the cluster: one row per store, maintained by the node
+	// holding the store from the descriptors it gossips.
+	nodesTableSchema = `
+CREATE TABLE system.nodes (
+  nodeID     INT,
+  storeID    INT,
+  address    STRING,
+  nodeAttrs  STRING,
+  storeAttrs STRING,
+  updated    TIMESTAMP,
+  PRIMARY KEY (nodeID, storeID)
+);`
+
+	// Operator-significant events, such as nodes joining the cluster and
+	// schema changes. The info column holds event-specific details as JSON.
+	eventLogTableSchema = `
+CREATE TABLE system.eventlog (
+  eventTime   TIMESTAMP,
+  eventType   STRING,
+  targetID    INT,
+  reportingID INT,
+  actor       STRING,
+  info        STRING,
+  PRIMARY KEY (eventTime, eventType, targetID, reportingID)
+);`
+
+	// The statistics collected on the columns of tables by ANALYZE. The
+	// histogram column holds the encoded buckets of an equi-depth histogram.
+	tableStatisticsTableSchema = `
+CREATE TABLE system.table_statistics (
+  tableID       INT,
+  columnID      INT,
+  createdAt     TIMESTAMP,
+  rowCount      INT,
+  distinctCount INT,
+  nullCount     INT,
+  histogram     BYTES,
+  PRIMARY KEY (tableID, columnID)
+);`
 )
 
 var (
@@ -90,17 +163,43 @@ var (
 	// ZonesTable is the descriptor for the zones table.
 	ZonesTable = createSystemTable(keys.ZonesTableID, zonesTableSchema)
 
+	// JobsTable is the descriptor for the jobs table. Jobs are deleted once
+	// they haven't been modified for jobsRetention.
+	JobsTable = withRowTTL(createSystemTable(keys.JobsTableID, jobsTableSchema),
+		"modified", jobsRetention)
+
+	// RoleMembersTable is the descriptor for the role members table.
+	RoleMembersTable = createSystemTable(keys.RoleMembersTableID, roleMembersTableSchema)
+
+	// NodesTable is the descriptor for the nodes table. The rows of stores
+	// which haven't been refreshed for nodesRetention are deleted.
+	NodesTable = withRowTTL(createSystemTable(keys.NodesTableID, nodesTableSchema),
+		"updated", nodesRetention)
+
+	// EventLogTable is the descriptor for the event log table. Events are
+	// deleted once they are older than eventLogRetention.
+	EventLogTable = withRowTTL(createSystemTable(keys.EventLogTableID, eventLogTableSchema),
+		"eventTime", eventLogRetention)
+
+	// TableStatisticsTable is the descriptor for the table statistics table.
+	TableStatisticsTable = createSystemTable(keys.TableStatisticsTableID, tableStatisticsTableSchema)
+
 	// SystemAllowedPrivileges describes the privileges allowed for each
 	// system object. No user may have more than those privileges, and
 	// the root user must have exactly those privileges.
 	// CREATE|DROP|ALL should always be denied.
 	SystemAllowedPrivileges = map[ID]privilege.List{
-		keys.SystemDatabaseID:  privilege.ReadData,
-		keys.NamespaceTableID:  privilege.ReadData,
-		keys.DescriptorTableID: privilege.ReadData,
-		keys.LeaseTableID:      privilege.ReadWriteData,
-		keys.UsersTableID:      privilege.ReadWriteData,
-		keys.ZonesTableID:      privilege.ReadWriteData,
+		keys.SystemDatabaseID:       privilege.ReadData,
+		keys.NamespaceTableID:       privilege.ReadData,
+		keys.DescriptorTableID:      privilege.ReadData,
+		keys.LeaseTableID:           privilege.ReadWriteData,
+		keys.UsersTableID:           privilege.ReadWriteData,
+		keys.ZonesTableID:           privilege.ReadWriteData,
+		keys.JobsTableID:            privilege.ReadWriteData,
+		keys.RoleMembersTableID:     privilege.ReadWriteData,
+		keys.NodesTableID:           privilege.ReadWriteData,
+		keys.EventLogTableID:        privilege.ReadWriteData,
+		keys.TableStatisticsTableID: privilege.ReadWriteData,
 	}
 
 	// NumUsedSystemIDs is only used in tests that need to know the
@@ -134,6 +233,17 @@ func createSystemTable(id ID, cmd string) TableDescriptor {
 	return desc
 }
 
+// withRowTTL gives the system table a row TTL on the named column, so that
+// its rows are deleted by the row TTL deleter once they are older than ttl.
+func withRowTTL(desc TableDescriptor, column string, ttl time.Duration) TableDescriptor {
+	i, err := desc.FindColumnByName(column)
+	if err != nil {
+		log.Fatal(err)
+	}
+	desc.RowTTL = &RowTTL{ColumnID: desc.Columns[i].ID, Duration: int64(ttl)}
+	return desc
+}
+
 // GetInitialSystemValues returns a list of key/value pairs.
 // They are written at cluster bootstrap time (see storage/node.go:BootstrapCLuster).
 func GetInitialSystemValues() []roachpb.KeyValue {
@@ -147,6 +257,11 @@ func GetInitialSystemValues() []roachpb.KeyValue {
 		{SystemDB.ID, &LeaseTable},
 		{SystemDB.ID, &UsersTable},
 		{SystemDB.ID, &ZonesTable},
+		{SystemDB.ID, &JobsTable},
+		{SystemDB.ID, &RoleMembersTable},
+		{SystemDB.ID, &NodesTable},
+		{SystemDB.ID, &EventLogTable},
+		{SystemDB.ID, &TableStatisticsTable},
 	}
 
 	// Initial kv pairs:
@@ -194,3 +309,9 @@ func GetInitialSystemValues() []roachpb.KeyValue {
 func IsSystemID(id ID) bool {
 	return id > 0 && id <= keys.MaxReservedDescID
 }
+
+// IsSystemConfigID returns true if this ID is for a system object whose
+// data is part of the gossiped system config.
+func IsSystemConfigID(id ID) bool {
+	return id > 0 && id <= keys.MaxSystemConfigDescID
+}
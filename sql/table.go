@@ -130,6 +130,16 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 	case *parser.BytesType:
 		col.Type.Kind = ColumnType_BYTES
 		colDatumType = parser.DummyBytes
+	case *parser.ArrayType:
+		elem, _, err := makeColumnDefDescs(&parser.ColumnTableDef{Name: d.Name, Type: t.ElemType})
+		if err != nil {
+			return nil, nil, err
+		}
+		col.Type.Kind = ColumnType_ARRAY
+		col.Type.ArrayContents = elem.Type.Kind.Enum()
+		if colDatumType, err = makeArrayPlaceholder(elem.Type.Kind); err != nil {
+			return nil, nil, err
+		}
 	default:
 		return nil, nil, util.Errorf("unexpected type %T", t)
 	}
@@ -149,6 +159,21 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 		col.DefaultExpr = &s
 	}
 
+	if d.OnUpdateExpr != nil {
+		// Verify the on update expression type is compatible with the column type.
+		onUpdateType, err := d.OnUpdateExpr.TypeCheck()
+		if err != nil {
+			return nil, nil, err
+		}
+		if colDatumType != onUpdateType {
+			return nil, nil, fmt.Errorf("incompatible column type and on update expression: %s vs %s",
+				col.Type.Kind, onUpdateType.Type())
+		}
+
+		s := d.OnUpdateExpr.String()
+		col.OnUpdateExpr = &s
+	}
+
 	var idx *IndexDescriptor
 	if d.PrimaryKey || d.Unique {
 		idx = &IndexDescriptor{
@@ -210,6 +235,12 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 		p.leases[tableID] = lease
 	}
 
+	// Tables which are still being added (e.g. filled by IMPORT) are
+	// invisible to statements until they become public.
+	if lease.GetState() != TableDescriptor_PUBLIC {
+		return nil, fmt.Errorf("table %q does not exist", qname.Table())
+	}
+
 	return proto.Clone(&lease.TableDescriptor).(*TableDescriptor), nil
 }
 
@@ -276,13 +307,13 @@ func (p *planner) getTableNames(dbDesc *DatabaseDescriptor) (parser.QualifiedNam
 	return qualifiedNames, nil
 }
 
-func encodeIndexKey(columnIDs []ColumnID, colMap map[ColumnID]int,
-	values []parser.Datum, indexKey []byte) ([]byte, bool, error) {
+func encodeIndexKey(columnIDs []ColumnID, directions []IndexDescriptor_Direction,
+	colMap map[ColumnID]int, values []parser.Datum, indexKey []byte) ([]byte, bool, error) {
 	var key []byte
 	var containsNull bool
 	key = append(key, indexKey...)
 
-	for _, id := range columnIDs {
+	for j, id := range columnIDs {
 		var val parser.Datum
 		if i, ok := colMap[id]; ok {
 			// TODO(pmattis): Need to convert the values[i] value to the type
@@ -297,13 +328,44 @@ func encodeIndexKey(columnIDs []ColumnID, colMap map[ColumnID]int,
 		}
 
 		var err error
-		if key, err = encodeTableKey(key, val); err != nil {
+		if key, err = encodeTableKeyWithDirection(key, val, columnDirection(directions, j)); err != nil {
 			return nil, containsNull, err
 		}
 	}
 	return key, containsNull, nil
 }
 
+// columnDirection returns the direction of the i-th column of an index given
+// the index's column directions. Columns without a direction are ascending.
+func columnDirection(directions []IndexDescriptor_Direction, i int) IndexDescriptor_Direction {
+	if i < len(directions) {
+		return directions[i]
+	}
+	return IndexDescriptor_ASC
+}
+
+// encodeTableKeyWithDirection encodes val as encodeTableKey does. The
+// encoding of a descending column is the ones complement of the ascending
+// encoding. Because the ascending encodings are prefix-free, this reverses
+// the order in which the encoded values sort, NULL included.
+func encodeTableKeyWithDirection(b []byte, val parser.Datum, dir IndexDescriptor_Direction) ([]byte, error) {
+	n := len(b)
+	b, err := encodeTableKey(b, val)
+	if err != nil {
+		return nil, err
+	}
+	if dir == IndexDescriptor_DESC {
+		onesComplement(b[n:])
+	}
+	return b, nil
+}
+
+func onesComplement(b []byte) {
+	for i := range b {
+		b[i] = ^b[i]
+	}
+}
+
 func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 	if val == parser.DNull {
 		return encoding.EncodeNull(b), nil
@@ -401,7 +463,7 @@ func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals
 		return nil, util.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
 	}
 
-	return decodeKeyVals(valTypes, vals, remaining)
+	return decodeKeyVals(valTypes, vals, index.ColumnDirections, remaining)
 }
 
 // decodeKeyVals decodes the values that are part of the key. ValTypes is a
@@ -409,12 +471,14 @@ func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals
 // parameter while the valTypes parameter is unmodified. Note that len(vals) >=
 // len(valTypes). The types of the decoded values will match the corresponding
 // entry in the valTypes parameter with the exception that a value might also
-// be parser.DNull. The remaining bytes in the key after decoding the values
-// are returned.
-func decodeKeyVals(valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
+// be parser.DNull. The directions parameter holds the directions of the
+// columns (see columnDirection). The remaining bytes in the key after
+// decoding the values are returned.
+func decodeKeyVals(valTypes, vals []parser.Datum, directions []IndexDescriptor_Direction,
+	key []byte) ([]byte, error) {
 	for j := range valTypes {
 		var err error
-		vals[j], key, err = decodeTableKey(valTypes[j], key)
+		vals[j], key, err = decodeTableKeyWithDirection(valTypes[j], key, columnDirection(directions, j))
 		if err != nil {
 			return nil, err
 		}
@@ -422,6 +486,26 @@ func decodeKeyVals(valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
 	return key, nil
 }
 
+// decodeTableKeyWithDirection decodes a value encoded by
+// encodeTableKeyWithDirection.
+func decodeTableKeyWithDirection(valType parser.Datum, key []byte,
+	dir IndexDescriptor_Direction) (parser.Datum, []byte, error) {
+	if dir != IndexDescriptor_DESC {
+		return decodeTableKey(valType, key)
+	}
+	n, err := encoding.PeekLength(key, true /* complemented */)
+	if err != nil {
+		return nil, nil, err
+	}
+	inverted := append([]byte(nil), key[:n]...)
+	onesComplement(inverted)
+	val, _, err := decodeTableKey(valType, inverted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return val, key[n:], nil
+}
+
 func decodeTableKey(valType parser.Datum, key []byte) (parser.Datum, []byte, error) {
 	var isNull bool
 	if key, isNull = encoding.DecodeIfNull(key); isNull {
@@ -467,13 +551,13 @@ func encodeSecondaryIndexes(tableID ID, indexes []IndexDescriptor,
 	var secondaryIndexEntries []indexEntry
 	for _, secondaryIndex := range indexes {
 		secondaryIndexKeyPrefix := MakeIndexKeyPrefix(tableID, secondaryIndex.ID)
-		secondaryIndexKey, containsNull, err := encodeIndexKey(
-			secondaryIndex.ColumnIDs, colMap, values, secondaryIndexKeyPrefix)
+		secondaryIndexKey, containsNull, err := encodeIndexKey(secondaryIndex.ColumnIDs,
+			secondaryIndex.ColumnDirections, colMap, values, secondaryIndexKeyPrefix)
 		if err != nil {
 			return nil, err
 		}
 
-		extraKey, _, err := encodeIndexKey(secondaryIndex.ImplicitColumnIDs, colMap, values, nil)
+		extraKey, _, err := encodeIndexKey(secondaryIndex.ImplicitColumnIDs, nil, colMap, values, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -541,6 +625,10 @@ func marshalColumnValue(col ColumnDescriptor, val parser.Datum) (interface{}, er
 		if v, ok := val.(parser.DInterval); ok {
 			return v.Duration, nil
 		}
+	case ColumnType_ARRAY:
+		if v, ok := val.(*parser.DArray); ok {
+			return marshalArrayValue(col, v)
+		}
 	default:
 		return nil, util.Errorf("unsupported column type: %s", col.Type.Kind)
 	}
@@ -548,15 +636,81 @@ func marshalColumnValue(col ColumnDescriptor, val parser.Datum) (interface{}, er
 		val.Type(), col.Type.Kind, col.Name)
 }
 
+// marshalArrayValue encodes the elements of the array as a single
+// roachpb.Value. NULL elements are encoded as values without a tag.
+func marshalArrayValue(col ColumnDescriptor, array *parser.DArray) (roachpb.Value, error) {
+	var r roachpb.Value
+	elemCol := ColumnDescriptor{Name: col.Name, Type: ColumnType{Kind: *col.Type.ArrayContents}}
+	elems := make([]roachpb.Value, len(array.Elements))
+	for i, e := range array.Elements {
+		// Verify the element type before encoding.
+		if _, err := marshalColumnValue(elemCol, e); err != nil {
+			return r, fmt.Errorf("array value type %s doesn't match type %s of column %q",
+				array.Type(), col.Type.SQLString(), col.Name)
+		}
+		switch t := e.(type) {
+		case parser.DBool:
+			if t {
+				elems[i].SetInt(1)
+			} else {
+				elems[i].SetInt(0)
+			}
+		case parser.DInt:
+			elems[i].SetInt(int64(t))
+		case parser.DFloat:
+			elems[i].SetFloat(float64(t))
+		case parser.DString:
+			elems[i].SetBytes([]byte(t))
+		case parser.DBytes:
+			elems[i].SetBytes([]byte(t))
+		case parser.DDate:
+			elems[i].SetInt(int64(t))
+		case parser.DTimestamp:
+			elems[i].SetTime(t.Time)
+		case parser.DInterval:
+			elems[i].SetInt(int64(t.Duration))
+		}
+	}
+	r.SetArray(elems)
+	return r, nil
+}
+
+// makeArrayPlaceholder returns the datum used for type checking arrays with
+// elements of the specified kind.
+func makeArrayPlaceholder(kind ColumnType_Kind) (parser.Datum, error) {
+	var elem parser.Datum
+	switch kind {
+	case ColumnType_BOOL:
+		elem = parser.DummyBool
+	case ColumnType_INT:
+		elem = parser.DummyInt
+	case ColumnType_FLOAT:
+		elem = parser.DummyFloat
+	case ColumnType_STRING:
+		elem = parser.DummyString
+	case ColumnType_BYTES:
+		elem = parser.DummyBytes
+	case ColumnType_DATE:
+		elem = parser.DummyDate
+	case ColumnType_TIMESTAMP:
+		elem = parser.DummyTimestamp
+	case ColumnType_INTERVAL:
+		elem = parser.DummyInterval
+	default:
+		return nil, fmt.Errorf("arrays of %s are not supported", kind)
+	}
+	return (&parser.DArray{Elements: parser.DTuple{elem}}).TypeCheck()
+}
+
 // unmarshalColumnValue decodes the value from a key-value pair using the type
 // expected by the column. An error is returned if the value's type does not
 // match the column's type.
-func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Datum, error) {
+func unmarshalColumnValue(typ ColumnType, value *roachpb.Value) (parser.Datum, error) {
 	if value == nil {
 		return parser.DNull, nil
 	}
 
-	switch kind {
+	switch kind := typ.Kind; kind {
 	case ColumnType_BOOL:
 		v, err := value.GetInt()
 		if err != nil {
@@ -605,6 +759,23 @@ func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Da
 			return nil, err
 		}
 		return parser.DInterval{Duration: time.Duration(v)}, nil
+	case ColumnType_ARRAY:
+		v, err := value.GetArray()
+		if err != nil {
+			return nil, err
+		}
+		elemType := ColumnType{Kind: *typ.ArrayContents}
+		array := &parser.DArray{Elements: make(parser.DTuple, len(v))}
+		for i := range v {
+			if v[i].Tag == roachpb.ValueType_UNKNOWN {
+				array.Elements[i] = parser.DNull
+				continue
+			}
+			if array.Elements[i], err = unmarshalColumnValue(elemType, &v[i]); err != nil {
+				return nil, err
+			}
+		}
+		return array, nil
 	default:
 		return nil, util.Errorf("unsupported column type: %s", kind)
 	}
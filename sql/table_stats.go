@@ -0,0 +1,514 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// statsSampleSize is the number of rows sampled by ANALYZE to compute the
+	// distinct counts, NULL counts and histograms of the columns of a table.
+	statsSampleSize = 10000
+	// statsHistogramBuckets is the maximum number of buckets of the histogram
+	// of a column.
+	statsHistogramBuckets = 100
+	// tableStatsCacheTTL is the time for which the statistics of a table are
+	// cached before being read again from the table statistics table.
+	tableStatsCacheTTL = time.Minute
+
+	// defaultEqualitySelectivity and defaultRangeSelectivity are the
+	// estimated fractions of the rows of a table matching a constraint on a
+	// column without statistics.
+	defaultEqualitySelectivity = 0.1
+	defaultRangeSelectivity    = 1.0 / 3
+)
+
+// tableStatistics holds the statistics collected on the columns of a table.
+type tableStatistics struct {
+	createdAt time.Time
+	rowCount  int64
+	columns   map[ColumnID]*columnStatistics
+}
+
+// columnStatistics holds the statistics collected on a column. The distinct
+// count and histogram only account for the non-NULL values of the column.
+type columnStatistics struct {
+	distinctCount int64
+	nullCount     int64
+	histogram     []histogramBucket
+}
+
+// A histogramBucket holds the estimated number of values of a column which
+// are greater than the upper bound of the previous bucket and less than or
+// equal to its own upper bound.
+type histogramBucket struct {
+	upperBound parser.Datum
+	count      int64
+}
+
+// Analyze collects statistics on the columns of a table: the number of rows
+// of the table, and the number of distinct values, the number of NULLs and
+// an equi-depth histogram of each column. The row count is exact while the
+// other statistics are estimated from a sample of statsSampleSize rows. The
+// statistics replace the previous statistics of the table in the table
+// statistics table, where index selection finds them.
+// Privileges: SELECT on table.
+func (p *planner) Analyze(n *parser.Analyze) (planNode, error) {
+	tableDesc, err := p.getTableLease(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(tableDesc, privilege.SELECT); err != nil {
+		return nil, err
+	}
+	if p.db == nil {
+		return nil, util.Errorf("unable to write the statistics of table %q", tableDesc.Name)
+	}
+
+	stats, err := p.collectTableStatistics(n.Table, tableDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The statistics are written outside of the transaction of the statement
+	// so that they don't conflict with the statistics read by index
+	// selection for the statements of the transaction.
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		sp := planner{txn: txn, user: security.RootUser}
+		return sp.writeTableStatistics(tableDesc, stats)
+	}); err != nil {
+		return nil, err
+	}
+	p.tableStats.invalidate(tableDesc.ID)
+	return &valuesNode{}, nil
+}
+
+// collectTableStatistics scans the table, counting its rows and keeping a
+// uniform sample of them from which the statistics of the columns are
+// computed.
+func (p *planner) collectTableStatistics(table *parser.QualifiedName, desc *TableDescriptor) (*tableStatistics, error) {
+	var buf bytes.Buffer
+	for i, col := range desc.Columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(parser.Name(col.Name).String())
+	}
+	plan, err := p.query(fmt.Sprintf("SELECT %s FROM %s", buf.String(), table))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &tableStatistics{
+		createdAt: time.Now(),
+		columns:   map[ColumnID]*columnStatistics{},
+	}
+	// Reservoir sampling: the n-th row replaces a random row of the sample
+	// with probability statsSampleSize/n.
+	var sample []parser.DTuple
+	for plan.Next() {
+		stats.rowCount++
+		row := plan.Values()
+		if len(sample) < statsSampleSize {
+			sample = append(sample, append(parser.DTuple(nil), row...))
+		} else if i := rand.Int63n(stats.rowCount); i < statsSampleSize {
+			sample[i] = append(sample[i][:0], row...)
+		}
+	}
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, col := range desc.Columns {
+		values := make(parser.DTuple, len(sample))
+		for j, row := range sample {
+			values[j] = row[i]
+		}
+		stats.columns[col.ID] = makeColumnStatistics(values, stats.rowCount)
+	}
+	return stats, nil
+}
+
+// makeColumnStatistics computes the statistics of a column from a sample of
+// its values, scaling them to the row count of the table.
+func makeColumnStatistics(sample parser.DTuple, rowCount int64) *columnStatistics {
+	stats := &columnStatistics{}
+	if len(sample) == 0 {
+		return stats
+	}
+	scale := float64(rowCount) / float64(len(sample))
+
+	values := make(parser.DTuple, 0, len(sample))
+	for _, d := range sample {
+		if d != parser.DNull {
+			values = append(values, d)
+		}
+	}
+	stats.nullCount = int64(float64(len(sample)-len(values))*scale + 0.5)
+	if len(values) == 0 {
+		return stats
+	}
+	sort.Sort(values)
+
+	// Count the distinct values of the sample, and the values occurring only
+	// once in it.
+	var distinct, singletons int
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j].Compare(values[i]) == 0 {
+			j++
+		}
+		distinct++
+		if j == i+1 {
+			singletons++
+		}
+		i = j
+	}
+	stats.distinctCount = estimateDistinctCount(distinct, singletons, len(values), rowCount-stats.nullCount)
+	stats.histogram = makeHistogram(values, scale)
+	return stats
+}
+
+// estimateDistinctCount estimates the number of distinct values among the
+// given number of values from the distinct and singleton values of a sample
+// of them, using the Duj1 estimator of Haas et al. The estimate is exact
+// when the sample holds all the values.
+func estimateDistinctCount(distinct, singletons, sampleSize int, total int64) int64 {
+	n, d, f1, N := float64(sampleSize), float64(distinct), float64(singletons), float64(total)
+	if N <= n {
+		return int64(distinct)
+	}
+	estimate := n * d / (n - f1 + f1*n/N)
+	if estimate < d {
+		estimate = d
+	}
+	return int64(math.Min(estimate, N) + 0.5)
+}
+
+// makeHistogram builds an equi-depth histogram of at most
+// statsHistogramBuckets buckets from the sorted non-NULL values of a sample,
+// scaling the counts of the buckets by the given factor. Equal values are
+// never split over several buckets.
+func makeHistogram(values parser.DTuple, scale float64) []histogramBucket {
+	depth := (len(values) + statsHistogramBuckets - 1) / statsHistogramBuckets
+	var histogram []histogramBucket
+	count := 0
+	for i, d := range values {
+		count++
+		if i == len(values)-1 || (count >= depth && values[i+1].Compare(d) != 0) {
+			histogram = append(histogram, histogramBucket{
+				upperBound: d,
+				count:      int64(float64(count)*scale + 0.5),
+			})
+			count = 0
+		}
+	}
+	return histogram
+}
+
+// encodeHistogram encodes the buckets of a histogram as the key encoding of
+// their upper bound followed by their count.
+func encodeHistogram(histogram []histogramBucket) ([]byte, error) {
+	var b []byte
+	for _, bucket := range histogram {
+		var err error
+		if b, err = encodeTableKey(b, bucket.upperBound); err != nil {
+			return nil, err
+		}
+		b = encoding.EncodeUvarint(b, uint64(bucket.count))
+	}
+	return b, nil
+}
+
+// decodeHistogram decodes a histogram encoded by encodeHistogram, whose upper
+// bounds are of the type of valType.
+func decodeHistogram(valType parser.Datum, b []byte) ([]histogramBucket, error) {
+	var histogram []histogramBucket
+	for len(b) > 0 {
+		var bucket histogramBucket
+		var err error
+		if bucket.upperBound, b, err = decodeTableKey(valType, b); err != nil {
+			return nil, err
+		}
+		var count uint64
+		if b, count, err = encoding.DecodeUvarint(b); err != nil {
+			return nil, err
+		}
+		bucket.count = int64(count)
+		histogram = append(histogram, bucket)
+	}
+	return histogram, nil
+}
+
+// writeTableStatistics replaces the statistics of the table in the table
+// statistics table. The histograms of columns whose type has no key
+// encoding are omitted.
+func (p *planner) writeTableStatistics(desc *TableDescriptor, stats *tableStatistics) error {
+	const deleteStats = `DELETE FROM system.table_statistics WHERE tableID = %d`
+	if _, err := p.exec(fmt.Sprintf(deleteStats, desc.ID)); err != nil {
+		return err
+	}
+	const insertStats = `INSERT INTO system.table_statistics VALUES ` +
+		`(%d, %d, '%s'::timestamp, %d, %d, %d, %s)`
+	createdAt := parser.DTimestamp{Time: stats.createdAt}
+	for _, col := range desc.Columns {
+		colStats := stats.columns[col.ID]
+		histogram := parser.Datum(parser.DNull)
+		if b, err := encodeHistogram(colStats.histogram); err == nil && b != nil {
+			histogram = parser.DBytes(b)
+		}
+		sql := fmt.Sprintf(insertStats, desc.ID, col.ID, createdAt, stats.rowCount,
+			colStats.distinctCount, colStats.nullCount, histogram)
+		if _, err := p.exec(sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTableStatistics reads the statistics of the table from the table
+// statistics table, returning nil if none have been collected. The
+// statistics of dropped columns are skipped.
+func (p *planner) readTableStatistics(desc *TableDescriptor) (*tableStatistics, error) {
+	const selectStats = `SELECT columnID, createdAt, rowCount, distinctCount, nullCount, histogram ` +
+		`FROM system.table_statistics WHERE tableID = %d`
+	var stats *tableStatistics
+	err := p.db.Txn(func(txn *client.Txn) error {
+		sp := planner{txn: txn, user: security.RootUser}
+		plan, err := sp.query(fmt.Sprintf(selectStats, desc.ID))
+		if err != nil {
+			return err
+		}
+		stats = nil
+		for plan.Next() {
+			row := plan.Values()
+			if stats == nil {
+				stats = &tableStatistics{
+					createdAt: row[1].(parser.DTimestamp).Time,
+					rowCount:  int64(row[2].(parser.DInt)),
+					columns:   map[ColumnID]*columnStatistics{},
+				}
+			}
+			colID := ColumnID(row[0].(parser.DInt))
+			if _, err := desc.FindColumnByID(colID); err != nil {
+				continue
+			}
+			colStats := &columnStatistics{
+				distinctCount: int64(row[3].(parser.DInt)),
+				nullCount:     int64(row[4].(parser.DInt)),
+			}
+			if b, ok := row[5].(parser.DBytes); ok {
+				valTypes, err := makeKeyVals(desc, []ColumnID{colID})
+				if err != nil {
+					return err
+				}
+				if colStats.histogram, err = decodeHistogram(valTypes[0], []byte(b)); err != nil {
+					return err
+				}
+			}
+			stats.columns[colID] = colStats
+		}
+		return plan.Err()
+	})
+	return stats, err
+}
+
+// getTableStatistics returns the statistics of the table, or nil if none
+// have been collected. Failing to read the statistics is not an error:
+// index selection then falls back to its heuristics.
+func (p *planner) getTableStatistics(desc *TableDescriptor) *tableStatistics {
+	if p.tableStats == nil || p.db == nil {
+		return nil
+	}
+	now := time.Now()
+	if stats, ok := p.tableStats.lookup(desc.ID, now); ok {
+		return stats
+	}
+	stats, err := p.readTableStatistics(desc)
+	if err != nil {
+		log.Warningf("unable to read the statistics of table %q: %s", desc.Name, err)
+		return nil
+	}
+	p.tableStats.add(desc.ID, stats, now)
+	return stats
+}
+
+// estimateRows estimates the number of rows of the table satisfying the
+// constraints of an index, assuming that the values of the constrained
+// columns are independent.
+func (s *tableStatistics) estimateRows(constraints indexConstraints) float64 {
+	rows := float64(s.rowCount)
+	if s.rowCount > 0 {
+		for _, c := range constraints {
+			rows *= s.selectivity(c)
+		}
+	}
+	if rows < 1 {
+		return 1
+	}
+	return rows
+}
+
+// selectivity estimates the fraction of the rows of the table satisfying an
+// index constraint.
+func (s *tableStatistics) selectivity(c indexConstraint) float64 {
+	var col *columnStatistics
+	left := c.start
+	if left == nil {
+		left = c.end
+	}
+	if q, ok := left.Left.(*qvalue); ok {
+		col = s.columns[q.col.ID]
+	}
+
+	if c.start != nil && c.start == c.end {
+		// An equality or IN constraint.
+		values := 1.0
+		if t, ok := c.start.Right.(parser.DTuple); ok && c.start.Operator == parser.In {
+			values = float64(len(t))
+		}
+		if col == nil || col.distinctCount == 0 {
+			return math.Min(values*defaultEqualitySelectivity, 1)
+		}
+		return math.Min(values/float64(col.distinctCount), 1)
+	}
+	if col == nil {
+		return defaultRangeSelectivity
+	}
+
+	nullFraction := math.Min(float64(col.nullCount)/float64(s.rowCount), 1)
+	if c.end != nil && c.end.Operator == parser.Is {
+		return nullFraction
+	}
+	return (1 - nullFraction) * col.rangeSelectivity(rangeBound(c.start), rangeBound(c.end))
+}
+
+// rangeBound returns the value bounding a range constraint, or nil if the
+// constraint doesn't bound the values of the column.
+func rangeBound(c *parser.ComparisonExpr) parser.Datum {
+	if c == nil {
+		return nil
+	}
+	switch c.Operator {
+	case parser.GT, parser.GE, parser.LT, parser.LE:
+		if d, ok := c.Right.(parser.Datum); ok && d != parser.DNull {
+			return d
+		}
+	}
+	return nil
+}
+
+// rangeSelectivity estimates the fraction of the non-NULL values of the
+// column between the lower and upper bounds, either of which may be nil,
+// from the buckets of the histogram overlapping the range. Bounds of a type
+// different from the values of the histogram are ignored.
+func (s *columnStatistics) rangeSelectivity(lower, upper parser.Datum) float64 {
+	if len(s.histogram) == 0 {
+		return defaultRangeSelectivity
+	}
+	typ := s.histogram[0].upperBound.Type()
+	if lower != nil && lower.Type() != typ {
+		lower = nil
+	}
+	if upper != nil && upper.Type() != typ {
+		upper = nil
+	}
+
+	var total, overlapping int64
+	var prev parser.Datum
+	for _, bucket := range s.histogram {
+		total += bucket.count
+		if (lower == nil || bucket.upperBound.Compare(lower) >= 0) &&
+			(upper == nil || prev == nil || prev.Compare(upper) < 0) {
+			overlapping += bucket.count
+		}
+		prev = bucket.upperBound
+	}
+	if total == 0 {
+		return defaultRangeSelectivity
+	}
+	return float64(overlapping) / float64(total)
+}
+
+// A tableStatsCache caches the statistics of tables read by index
+// selection. The statistics are read again once they have been cached for
+// tableStatsCacheTTL, so that the statistics collected on other nodes are
+// eventually used. The cache is safe for concurrent use and can be used
+// through a nil reference, in which case it caches nothing.
+type tableStatsCache struct {
+	mu      sync.Mutex
+	entries map[ID]tableStatsCacheEntry
+}
+
+type tableStatsCacheEntry struct {
+	stats    *tableStatistics // nil if the table has no statistics
+	loadedAt time.Time
+}
+
+// lookup returns the cached statistics of the table, if any.
+func (c *tableStatsCache) lookup(id ID, now time.Time) (*tableStatistics, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[id]
+	if !ok || now.Sub(e.loadedAt) >= tableStatsCacheTTL {
+		return nil, false
+	}
+	return e.stats, true
+}
+
+// add caches the statistics of the table read at the given time.
+func (c *tableStatsCache) add(id ID, stats *tableStatistics, now time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[ID]tableStatsCacheEntry{}
+	}
+	c.entries[id] = tableStatsCacheEntry{stats: stats, loadedAt: now}
+}
+
+// invalidate removes the cached statistics of the table.
+func (c *tableStatsCache) invalidate(id ID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, id)
+}
@@ -0,0 +1,126 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestMakeColumnStatistics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	// The sample holds half of the rows of the table.
+	sample := parser.DTuple{
+		parser.DInt(3), parser.DInt(1), parser.DInt(2), parser.DInt(2),
+		parser.DNull, parser.DInt(3), parser.DInt(3),
+	}
+	stats := makeColumnStatistics(sample, 14)
+	if stats.nullCount != 2 {
+		t.Errorf("expected 2 NULLs, got %d", stats.nullCount)
+	}
+	if stats.distinctCount != 3 {
+		t.Errorf("expected 3 distinct values, got %d", stats.distinctCount)
+	}
+	expected := []histogramBucket{
+		{upperBound: parser.DInt(1), count: 2},
+		{upperBound: parser.DInt(2), count: 4},
+		{upperBound: parser.DInt(3), count: 6},
+	}
+	if !reflect.DeepEqual(expected, stats.histogram) {
+		t.Errorf("expected histogram %v, got %v", expected, stats.histogram)
+	}
+
+	b, err := encodeHistogram(stats.histogram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	histogram, err := decodeHistogram(parser.DummyInt, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, histogram) {
+		t.Errorf("expected decoded histogram %v, got %v", expected, histogram)
+	}
+}
+
+func TestEstimateDistinctCount(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		distinct, singletons, sampleSize int
+		total                            int64
+		expected                         int64
+	}{
+		// The sample holds all the values.
+		{10, 5, 100, 100, 10},
+		// Every value of the sample is unique.
+		{100, 100, 100, 1000, 1000},
+		// Every value of the sample occurs several times.
+		{10, 0, 100, 1000, 10},
+		{30, 10, 100, 1000, 33},
+	}
+	for i, d := range testData {
+		if count := estimateDistinctCount(d.distinct, d.singletons, d.sampleSize, d.total); count != d.expected {
+			t.Errorf("%d: expected %d distinct values, got %d", i, d.expected, count)
+		}
+	}
+}
+
+func TestTableStatisticsEstimateRows(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc, index := makeTestIndex(t, []string{"a", "b"})
+	stats := &tableStatistics{
+		rowCount: 1000,
+		columns: map[ColumnID]*columnStatistics{
+			1: {distinctCount: 100},
+			2: {
+				distinctCount: 10,
+				nullCount:     100,
+				histogram: []histogramBucket{
+					{upperBound: parser.DInt(2), count: 300},
+					{upperBound: parser.DInt(5), count: 300},
+					{upperBound: parser.DInt(9), count: 300},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		expr     string
+		expected float64
+	}{
+		{`c`, 1000},
+		{`a = 1`, 10},
+		{`a IN (1, 2)`, 20},
+		{`a = 1 AND b = 1`, 1},
+		{`a = 1 AND b > 5`, 3},
+		{`a = 1 AND b >= 3 AND b < 5`, 3},
+		{`a = 1 AND b IS NULL`, 1},
+		{`a > 0`, 1000.0 / 3},
+	}
+	for _, d := range testData {
+		constraints, _ := makeConstraints(t, d.expr, desc, index)
+		if rows := stats.estimateRows(constraints); math.Abs(rows-d.expected) > 1e-6 {
+			t.Errorf("%s: expected %.2f rows, got %.2f", d.expr, d.expected, rows)
+		}
+	}
+}
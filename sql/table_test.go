@@ -18,6 +18,7 @@
 package sql
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -230,3 +231,44 @@ func TestPrimaryKeyUnspecified(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestEncodeTableKeyWithDirection(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	// The values are in ascending order.
+	testCases := [][]parser.Datum{
+		{parser.DNull, parser.DInt(-10), parser.DInt(0), parser.DInt(1), parser.DInt(300)},
+		{parser.DNull, parser.DFloat(-1.5), parser.DFloat(0), parser.DFloat(0.25), parser.DFloat(1e10)},
+		{parser.DNull, parser.DString(""), parser.DString("a"), parser.DString("a\x00"), parser.DString("b")},
+	}
+	for _, dir := range []IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_DESC} {
+		for i, vals := range testCases {
+			var prev []byte
+			for j, val := range vals {
+				key, err := encodeTableKeyWithDirection(nil, val, dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j > 0 {
+					c := bytes.Compare(prev, key)
+					if (dir == IndexDescriptor_ASC && c >= 0) || (dir == IndexDescriptor_DESC && c <= 0) {
+						t.Errorf("%d: %s: %s and %s encode out of order", i, dir, vals[j-1], val)
+					}
+				}
+				prev = key
+
+				// Append a suffix to check that exactly the encoded value is consumed.
+				key = append(key, 'x')
+				decoded, rest, err := decodeTableKeyWithDirection(vals[len(vals)-1], key, dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if decoded.Compare(val) != 0 {
+					t.Errorf("%d: %s: expected %s, but found %s", i, dir, val, decoded)
+				}
+				if !bytes.Equal(rest, []byte{'x'}) {
+					t.Errorf("%d: %s: expected remaining key \"x\", but found %q", i, dir, rest)
+				}
+			}
+		}
+	}
+}
@@ -37,6 +37,9 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err := checkNotExternal(tableDesc); err != nil {
+			return nil, err
+		}
 
 		if err := p.checkPrivilege(tableDesc, privilege.DROP); err != nil {
 			return nil, err
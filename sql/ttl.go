@@ -0,0 +1,295 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// rowTTLChunkSize is the maximum number of rows examined, and thus deleted,
+// by a single transaction.
+const rowTTLChunkSize = 1000
+
+// rowTTLLeaseIntervals is the number of deletion intervals a node holds the
+// row TTL lease for after acquiring or renewing it. The lease holder renews
+// the lease every interval; other nodes take over once it has missed a few
+// renewals.
+const rowTTLLeaseIntervals = 3
+
+// rowTTLTable is a table with a row TTL, as found in the system config.
+type rowTTLTable struct {
+	name     *parser.QualifiedName
+	columnID ColumnID // The TTL column.
+	ttl      time.Duration
+}
+
+// StartRowTTLDeleter starts a worker which deletes the expired rows of the
+// tables with a row TTL every interval. A row expires once the value of the
+// table's TTL column is older than the TTL. A zero interval disables the
+// deletion of expired rows.
+//
+// Expired rows are deleted like any other rows: the deletions leave MVCC
+// tombstones, and the space of the deleted rows is reclaimed by the GC
+// queue once the GC TTL of the table's zone has passed. Every node runs the
+// worker, but only the node holding the row TTL lease deletes rows, so that
+// the nodes don't scan the same tables and conflict on the same rows.
+func (e *Executor) StartRowTTLDeleter(interval time.Duration, stopper *stop.Stopper) {
+	if interval == 0 {
+		return
+	}
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				cfg := e.getSystemConfig()
+				if cfg == nil {
+					continue
+				}
+				if ok, err := e.acquireRowTTLLease(time.Now(), rowTTLLeaseIntervals*interval); err != nil {
+					log.Warningf("unable to acquire the row TTL lease: %s", err)
+					continue
+				} else if !ok {
+					continue
+				}
+				tables, err := rowTTLTables(cfg)
+				if err != nil {
+					log.Warningf("unable to find tables with a row TTL: %s", err)
+					continue
+				}
+				for _, table := range tables {
+					if err := e.deleteExpiredRows(table, stopper); err != nil {
+						log.Warningf("unable to delete expired rows of %s: %s", table.name, err)
+					}
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// acquireRowTTLLease acquires or renews the row TTL lease for this node until
+// now+duration and returns whether it succeeded, which it doesn't while
+// another node holds an unexpired lease. The lease is a key holding the ID of
+// the holder and the expiration; transactions serialize its acquisition.
+// Since the expiration is compared to the local clock, the duration must be
+// much larger than the clock offset between nodes.
+func (e *Executor) acquireRowTTLLease(now time.Time, duration time.Duration) (bool, error) {
+	var acquired bool
+	err := e.db.Txn(func(txn *client.Txn) error {
+		acquired = false
+		kv, err := txn.Get(keys.RowTTLLeaseKey)
+		if err != nil {
+			return err
+		}
+		if kv.Exists() {
+			nodeID, expiration, err := decodeRowTTLLease(kv.ValueBytes())
+			if err != nil {
+				return err
+			}
+			if nodeID != e.nodeID && now.Before(expiration) {
+				return nil
+			}
+		}
+		acquired = true
+		return txn.Put(keys.RowTTLLeaseKey, encodeRowTTLLease(e.nodeID, now.Add(duration)))
+	})
+	return acquired, err
+}
+
+// encodeRowTTLLease encodes the value of the row TTL lease key.
+func encodeRowTTLLease(nodeID uint32, expiration time.Time) []byte {
+	b := encoding.EncodeUvarint(nil, uint64(nodeID))
+	return encoding.EncodeVarint(b, expiration.UnixNano())
+}
+
+// decodeRowTTLLease decodes the value of the row TTL lease key.
+func decodeRowTTLLease(b []byte) (uint32, time.Time, error) {
+	b, nodeID, err := encoding.DecodeUvarint(b)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	_, expiration, err := encoding.DecodeVarint(b)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return uint32(nodeID), time.Unix(0, expiration), nil
+}
+
+// rowTTLTables returns the tables of the system config which have a row TTL.
+func rowTTLTables(cfg *config.SystemConfig) ([]rowTTLTable, error) {
+	prefix := MakeIndexKeyPrefix(DescriptorTable.ID, DescriptorTable.PrimaryIndex.ID)
+	dbNames := map[ID]string{}
+	var tableDescs []*TableDescriptor
+	for _, kv := range cfg.Values {
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			continue
+		}
+		// Skip the row sentinels: only the descriptor column holds a value.
+		_, id, err := encoding.DecodeUvarint(kv.Key[len(prefix):])
+		if err != nil {
+			return nil, err
+		}
+		if !kv.Key.Equal(MakeDescMetadataKey(ID(id))) {
+			continue
+		}
+		desc := &Descriptor{}
+		if err := kv.Value.GetProto(desc); err != nil {
+			return nil, err
+		}
+		if db := desc.GetDatabase(); db != nil {
+			dbNames[db.ID] = db.Name
+		} else if table := desc.GetTable(); table != nil && table.RowTTL != nil {
+			tableDescs = append(tableDescs, table)
+		}
+	}
+
+	var tables []rowTTLTable
+	for _, desc := range tableDescs {
+		dbName, ok := dbNames[desc.ParentID]
+		if !ok {
+			continue
+		}
+		tables = append(tables, rowTTLTable{
+			name: &parser.QualifiedName{
+				Base:     parser.Name(dbName),
+				Indirect: parser.Indirection{parser.NameIndirection(desc.Name)},
+			},
+			columnID: desc.RowTTL.ColumnID,
+			ttl:      time.Duration(desc.RowTTL.Duration),
+		})
+	}
+	return tables, nil
+}
+
+// deleteExpiredRows deletes the rows of the table which expired before the
+// start of the deletion. The primary index is scanned in chunks of
+// rowTTLChunkSize rows, one transaction per chunk, each chunk resuming at the
+// key where the previous one stopped, so that neither the transactions nor
+// the scans grow with the size of the table. Rows expiring while the deletion
+// runs are left to the next deletion. The deletion stops early when the
+// stopper is draining.
+func (e *Executor) deleteExpiredRows(table rowTTLTable, stopper *stop.Stopper) error {
+	cutoff := time.Now().Add(-table.ttl)
+	var resume roachpb.Key
+	for {
+		var deleted int
+		var err error
+		if !stopper.RunTask(func() {
+			p := planner{
+				user:         security.RootUser,
+				leaseMgr:     e.leaseMgr,
+				systemConfig: e.getSystemConfig(),
+			}
+			err = e.db.Txn(func(txn *client.Txn) error {
+				p.txn = txn
+				var err error
+				deleted, resume, err = p.deleteExpiredChunk(table, cutoff, resume)
+				return err
+			})
+			p.releaseLeases(e.db)
+		}) {
+			// The server is draining.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if e.metrics != nil {
+			e.metrics.Counter("sql.ttl.deleted").Inc(int64(deleted))
+		}
+		if resume == nil {
+			return nil
+		}
+	}
+}
+
+// deleteExpiredChunk deletes the rows expired before cutoff among the
+// rowTTLChunkSize rows of the table's primary index starting at the given key,
+// or at the start of the index if the key is nil. Returns the number of rows
+// deleted and the key at which the next chunk starts, which is nil once the
+// end of the index has been reached.
+func (p *planner) deleteExpiredChunk(table rowTTLTable, cutoff time.Time,
+	start roachpb.Key) (int, roachpb.Key, error) {
+	desc, err := p.getTableLease(table.name)
+	if err != nil {
+		return 0, nil, err
+	}
+	prefix := roachpb.Key(MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+	if start == nil {
+		start = prefix
+	}
+	scan := &scanNode{planner: p, txn: p.txn, desc: desc}
+	scan.initIndex(&desc.PrimaryIndex)
+	scan.spans = []span{{start: start, end: prefix.PrefixEnd()}}
+	if err := scan.initTargets(parser.SelectExprs{parser.StarSelectExpr()}); err != nil {
+		return 0, nil, err
+	}
+	scan.initOrdering(0)
+	rows, resume, err := scanBatch(scan, rowTTLChunkSize)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	colIDtoRowIndex, err := makeColIDtoRowIndex(scan, desc)
+	if err != nil {
+		return 0, nil, err
+	}
+	ttlIndex, ok := colIDtoRowIndex[table.columnID]
+	if !ok {
+		return 0, nil, util.Errorf("%s: row TTL column %d not found", table.name, table.columnID)
+	}
+	b := client.Batch{}
+	deleted := 0
+	for _, row := range rows {
+		ts, ok := row[ttlIndex].(parser.DTimestamp)
+		if !ok || !ts.Before(cutoff) {
+			// The row isn't expired, or has no expiration.
+			continue
+		}
+		if err := deleteRow(&b, desc, colIDtoRowIndex, row); err != nil {
+			return 0, nil, err
+		}
+		deleted++
+	}
+	if deleted > 0 {
+		if IsSystemConfigID(desc.GetID()) {
+			p.txn.SetSystemDBTrigger()
+		}
+		if err := p.txn.Run(&b); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if len(resume) == 0 {
+		return deleted, nil, nil
+	}
+	return deleted, resume[0].Key, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestRowTTLLeaseEncoding(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	expiration := time.Unix(1445000000, 123456789)
+	nodeID, decoded, err := decodeRowTTLLease(encodeRowTTLLease(7, expiration))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeID != 7 || !decoded.Equal(expiration) {
+		t.Errorf("expected node 7 until %s, got node %d until %s", expiration, nodeID, decoded)
+	}
+	if _, _, err := decodeRowTTLLease(nil); err == nil {
+		t.Error("expected an error decoding an empty lease")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// unnest initializes the render targets of the scanNode, replacing each
+// top-level "unnest(<array>)" target with its array argument. An unnestNode
+// which expands the arrays into one row per element is returned if any such
+// targets were found.
+func (p *planner) unnest(n *parser.Select, s *scanNode) (*unnestNode, error) {
+	var u *unnestNode
+	for _, target := range n.Exprs {
+		// Note that target is a copy and modifying it does not modify the AST.
+		if f, ok := target.Expr.(*parser.FuncExpr); ok && len(f.Name.Indirect) == 0 &&
+			strings.EqualFold(string(f.Name.Base), "unnest") {
+			if len(f.Exprs) != 1 {
+				return nil, fmt.Errorf("unnest() requires exactly 1 argument, found %d", len(f.Exprs))
+			}
+			if target.As == "" {
+				target.As = "unnest"
+			}
+			target.Expr = f.Exprs[0]
+			if u == nil {
+				u = &unnestNode{}
+			}
+			u.columns = append(u.columns, len(s.render))
+		}
+		if s.err = s.addRender(target); s.err != nil {
+			return nil, s.err
+		}
+	}
+	return u, nil
+}
+
+// unnestNode expands the array values in the unnested columns of the rows of
+// its source, producing one row per array element. When several columns are
+// unnested, the arrays are expanded in parallel and shorter arrays are padded
+// with NULLs. Rows where all of the unnested values are NULL or empty arrays
+// produce no output.
+type unnestNode struct {
+	plan    planNode
+	columns []int            // the indexes of the unnested columns
+	arrays  []*parser.DArray // the arrays of the current source row
+	index   int              // the index of the next element to output
+	length  int              // the length of the longest array of the current source row
+	row     parser.DTuple
+	err     error
+}
+
+func (n *unnestNode) wrap(plan planNode) planNode {
+	if n == nil {
+		return plan
+	}
+	n.plan = plan
+	n.arrays = make([]*parser.DArray, len(n.columns))
+	return n
+}
+
+func (n *unnestNode) Columns() []string {
+	return n.plan.Columns()
+}
+
+func (n *unnestNode) Ordering() ([]int, int) {
+	// Expanding the arrays destroys any ordering of the unnested columns.
+	return nil, 0
+}
+
+func (n *unnestNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *unnestNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	for n.index >= n.length {
+		if !n.plan.Next() {
+			n.err = n.plan.Err()
+			return false
+		}
+		values := n.plan.Values()
+		n.index, n.length = 0, 0
+		for i, c := range n.columns {
+			switch t := values[c].(type) {
+			case *parser.DArray:
+				n.arrays[i] = t
+				if len(t.Elements) > n.length {
+					n.length = len(t.Elements)
+				}
+			default:
+				if t != parser.DNull {
+					n.err = fmt.Errorf("unnest() argument must be an array, not %s", t.Type())
+					return false
+				}
+				n.arrays[i] = nil
+			}
+		}
+		n.row = append(n.row[:0], values...)
+	}
+	for i, c := range n.columns {
+		if a := n.arrays[i]; a != nil && n.index < len(a.Elements) {
+			n.row[c] = a.Elements[n.index]
+		} else {
+			n.row[c] = parser.DNull
+		}
+	}
+	n.index++
+	return true
+}
+
+func (n *unnestNode) Err() error {
+	return n.err
+}
+
+func (n *unnestNode) ExplainPlan() (name, description string, children []planNode) {
+	columns := n.plan.Columns()
+	names := make([]string, len(n.columns))
+	for i, c := range n.columns {
+		names[i] = columns[c]
+	}
+	return "unnest", strings.Join(names, ", "), []planNode{n.plan}
+}
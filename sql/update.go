@@ -37,6 +37,9 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNotExternal(tableDesc); err != nil {
+		return nil, err
+	}
 
 	if err := p.checkPrivilege(tableDesc, privilege.UPDATE); err != nil {
 		return nil, err
@@ -93,6 +96,17 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, err
 	}
 
+	// Columns with ON UPDATE expressions which aren't explicitly assigned are
+	// set to the value of their ON UPDATE expression.
+	onUpdateCols, onUpdateExprs, err := p.makeOnUpdateExprs(tableDesc, colIDSet)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range onUpdateCols {
+		colIDSet[c.ID] = struct{}{}
+	}
+	cols = append(cols, onUpdateCols...)
+
 	// Generate the list of select targets. We need to select all of the columns
 	// plus we select all of the update expressions in case those expressions
 	// reference columns (e.g. "UPDATE t SET v = v + 1"). Note that we flatten
@@ -125,12 +139,17 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 			targets = append(targets, parser.SelectExpr{Expr: e})
 		}
 	}
+	for _, e := range onUpdateExprs {
+		targets = append(targets, parser.SelectExpr{Expr: e})
+	}
 
 	// Query the rows that need updating.
 	rows, err := p.Select(&parser.Select{
-		Exprs: targets,
-		From:  parser.TableExprs{n.Table},
-		Where: n.Where,
+		Exprs:   targets,
+		From:    parser.TableExprs{n.Table},
+		Where:   n.Where,
+		OrderBy: n.OrderBy,
+		Limit:   n.Limit,
 	})
 	if err != nil {
 		return nil, err
@@ -161,12 +180,14 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 
 	b := client.Batch{}
 	result := &valuesNode{}
+	// Writes to the zones table are recorded in the event log.
+	var zoneIDs []ID
 	for rows.Next() {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
 		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+			primaryIndex.ColumnIDs, primaryIndex.ColumnDirections, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
 		if err != nil {
 			return nil, err
 		}
@@ -246,6 +267,12 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 				b.Del(key)
 			}
 		}
+
+		if tableDesc.ID == ZonesTable.ID {
+			if id, ok := zoneConfigTarget(colIDtoRowIndex, rowVals); ok {
+				zoneIDs = append(zoneIDs, id)
+			}
+		}
 	}
 
 	if err := rows.Err(); err != nil {
@@ -255,9 +282,43 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, convertBatchError(tableDesc, b, err)
 	}
 
+	if err := p.logZoneConfigEvents(EventLogSetZoneConfig, zoneIDs); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// makeOnUpdateExprs returns the columns of the table which have an ON UPDATE
+// expression and aren't in the set of updated columns, along with their
+// parsed ON UPDATE expressions.
+func (p *planner) makeOnUpdateExprs(tableDesc *TableDescriptor,
+	updated map[ColumnID]struct{}) ([]ColumnDescriptor, []parser.Expr, error) {
+	var cols []ColumnDescriptor
+	var exprs []parser.Expr
+	for _, col := range tableDesc.Columns {
+		if col.OnUpdateExpr == nil {
+			continue
+		}
+		if _, ok := updated[col.ID]; ok {
+			continue
+		}
+		expr, err := parser.ParseExpr(*col.OnUpdateExpr, parser.Traditional)
+		if err != nil {
+			return nil, nil, err
+		}
+		expr, err = p.evalCtx.NormalizeExpr(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if parser.ContainsVars(expr) {
+			return nil, nil, util.Errorf("on update expression contains variables")
+		}
+		cols = append(cols, col)
+		exprs = append(exprs, expr)
+	}
+	return cols, exprs, nil
+}
+
 func fillDefault(expr parser.Expr, index int, defaultExprs []parser.Expr) (parser.Expr, error) {
 	switch expr.(type) {
 	case parser.DefaultVal:
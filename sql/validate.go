@@ -0,0 +1,91 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Validate checks the descriptors of the targeted tables, or of the targeted
+// databases and all of their tables, returning a row per descriptor with
+// the error found validating it, if any. The descriptors are read without
+// being validated so that corrupt descriptors can be reported.
+// Privileges: root user.
+func (p *planner) Validate(n *parser.Validate) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to validate descriptors", security.RootUser)
+	}
+
+	v := &valuesNode{columns: []string{"Descriptor", "Error"}}
+	addRow := func(name string, desc descriptorProto) {
+		result := parser.Datum(parser.DNull)
+		if err := desc.Validate(); err != nil {
+			result = parser.DString(err.Error())
+		}
+		v.rows = append(v.rows, []parser.Datum{parser.DString(name), result})
+	}
+	addTable := func(tableName *parser.QualifiedName, dbDesc *DatabaseDescriptor) error {
+		desc := &TableDescriptor{}
+		if err := p.readDescriptor(tableKey{dbDesc.ID, tableName.Table()}, desc); err != nil {
+			return err
+		}
+		addRow(tableName.String(), desc)
+		return nil
+	}
+
+	if n.Targets.Databases != nil {
+		if len(n.Targets.Databases) == 0 {
+			return nil, errNoDatabase
+		}
+		for _, dbName := range n.Targets.Databases {
+			dbDesc := &DatabaseDescriptor{}
+			if err := p.readDescriptor(databaseKey{dbName}, dbDesc); err != nil {
+				return nil, err
+			}
+			addRow(dbName, dbDesc)
+			tableNames, err := p.getTableNames(dbDesc)
+			if err != nil {
+				return nil, err
+			}
+			for _, tableName := range tableNames {
+				if err := addTable(tableName, dbDesc); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return v, nil
+	}
+
+	if len(n.Targets.Tables) == 0 {
+		return nil, errNoTable
+	}
+	for _, tableName := range n.Targets.Tables {
+		if err := tableName.NormalizeTableName(p.session.Database); err != nil {
+			return nil, err
+		}
+		dbDesc, err := p.getDatabaseDesc(tableName.Database())
+		if err != nil {
+			return nil, err
+		}
+		if err := addTable(tableName, dbDesc); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
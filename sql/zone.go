@@ -0,0 +1,180 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+	yaml "gopkg.in/yaml.v1"
+)
+
+// SetZoneConfig sets or removes the zone config of a database or table. The
+// YAML config is applied on top of the zone config currently in effect for
+// the object, so that only the fields which are specified are changed.
+// Privileges: "root" user.
+//   Notes: postgres and mysql do not have zone configs.
+func (p *planner) SetZoneConfig(n *parser.SetZoneConfig) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to configure zones", security.RootUser)
+	}
+
+	var id ID
+	if n.Table != nil {
+		tableDesc, err := p.getTableDesc(n.Table)
+		if err != nil {
+			return nil, err
+		}
+		id = tableDesc.ID
+	} else {
+		if n.Database == "" {
+			return nil, errEmptyDatabaseName
+		}
+		dbDesc, err := p.getDatabaseDesc(string(n.Database))
+		if err != nil {
+			return nil, err
+		}
+		id = dbDesc.ID
+	}
+
+	d, err := n.YAMLConfig.Eval(p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	b := &client.Batch{}
+	eventType := EventLogSetZoneConfig
+	switch t := d.(type) {
+	case parser.DString:
+		zone, err := p.makeZoneConfig(id, string(t))
+		if err != nil {
+			return nil, err
+		}
+		b.Put(MakeZoneKey(id), zone)
+	default:
+		if d != parser.DNull {
+			return nil, fmt.Errorf("zone config must be a string or NULL, not type %s", d.Type())
+		}
+		b.Del(MakeZoneKey(id))
+		eventType = EventLogRemoveZoneConfig
+	}
+	if err := p.txn.Run(b); err != nil {
+		return nil, err
+	}
+	if err := p.logEvent(eventType, id, zoneConfigEventInfo{Statement: n.String()}); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// makeZoneConfig applies the given YAML representation of a zone config on
+// top of the zone config in effect for the object with the given ID and
+// validates the result.
+func (p *planner) makeZoneConfig(id ID, yamlConfig string) (*config.ZoneConfig, error) {
+	base := config.DefaultZoneConfig
+	if p.systemConfig != nil {
+		var err error
+		if base, err = GetZoneConfig(*p.systemConfig, uint32(id)); err != nil {
+			return nil, err
+		}
+	}
+	// The config in effect may be shared, so it must not be modified.
+	zone := proto.Clone(base).(*config.ZoneConfig)
+	if err := yaml.Unmarshal([]byte(yamlConfig), zone); err != nil {
+		return nil, fmt.Errorf("could not parse zone config: %s", err)
+	}
+	if err := zone.Validate(); err != nil {
+		return nil, err
+	}
+	if p.stores != nil {
+		if err := validateZoneReplicas(zone, p.stores.descriptors()); err != nil {
+			return nil, err
+		}
+	}
+	return zone, nil
+}
+
+// validateZoneReplicas verifies that each replica of the zone config can be
+// placed on one of the given stores, i.e. that some store has all of the
+// attributes required by the replica, and that each lease preference is
+// satisfied by one of them. If no stores are known, no validation is
+// performed.
+func validateZoneReplicas(zone *config.ZoneConfig, stores []roachpb.StoreDescriptor) error {
+	if len(stores) == 0 {
+		return nil
+	}
+	for _, attrs := range zone.ReplicaAttrs {
+		if !anyStoreMatches(attrs, stores) {
+			return util.Errorf("no store matches replica attributes %s", attrs)
+		}
+	}
+	for _, attrs := range zone.LeasePreferences {
+		if !anyStoreMatches(attrs, stores) {
+			return util.Errorf("no store matches lease preference %s", attrs)
+		}
+	}
+	return nil
+}
+
+// anyStoreMatches returns whether one of the stores has all of the given
+// attributes.
+func anyStoreMatches(attrs roachpb.Attributes, stores []roachpb.StoreDescriptor) bool {
+	for _, s := range stores {
+		if attrs.IsSubset(*s.CombinedAttrs()) {
+			return true
+		}
+	}
+	return false
+}
+
+// storeCache holds the most recently gossiped descriptor of each store.
+type storeCache struct {
+	mu     sync.Mutex
+	stores map[roachpb.StoreID]roachpb.StoreDescriptor
+}
+
+// storeGossipUpdate is the gossip callback used to keep the cache up to date.
+func (c *storeCache) storeGossipUpdate(_ string, content []byte) {
+	var desc roachpb.StoreDescriptor
+	if err := proto.Unmarshal(content, &desc); err != nil {
+		log.Error(err)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stores == nil {
+		c.stores = map[roachpb.StoreID]roachpb.StoreDescriptor{}
+	}
+	c.stores[desc.StoreID] = desc
+}
+
+// descriptors returns the cached store descriptors.
+func (c *storeCache) descriptors() []roachpb.StoreDescriptor {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	descs := make([]roachpb.StoreDescriptor, 0, len(c.stores))
+	for _, desc := range c.stores {
+		descs = append(descs, desc)
+	}
+	return descs
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSetZoneConfig verifies that zone configs are written, merged with the
+// config in effect, validated and removed by ALTER ... CONFIGURE ZONE.
+func TestSetZoneConfig(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer config.TestingDisableTableSplits()()
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	dbID := sql.ID(keys.MaxReservedDescID + 1)
+	if _, err := sqlDB.Exec(`CREATE DATABASE test`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE 'range_max_bytes: 134217728'`); err != nil {
+		t.Fatal(err)
+	}
+	zone := &config.ZoneConfig{}
+	if err := kvDB.GetProto(sql.MakeZoneKey(dbID), zone); err != nil {
+		t.Fatal(err)
+	}
+	if zone.RangeMaxBytes != 134217728 {
+		t.Errorf("expected range_max_bytes to be set; got %+v", zone)
+	}
+	// Unspecified fields are taken from the config in effect.
+	if zone.RangeMinBytes != config.DefaultZoneConfig.RangeMinBytes ||
+		len(zone.ReplicaAttrs) != len(config.DefaultZoneConfig.ReplicaAttrs) {
+		t.Errorf("expected unspecified fields to be inherited; got %+v", zone)
+	}
+
+	// Invalid configs are rejected.
+	if _, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE 'range_min_bytes: 268435456'`); !testutils.IsError(err, "RangeMaxBytes") {
+		t.Errorf("expected range size validation error; got %v", err)
+	}
+
+	// Replicas have to match the attributes of some store. Wait for the
+	// store descriptor to be gossiped before verifying this.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		_, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE 'replicas: [{attrs: [nonexistent]}]'`)
+		if !testutils.IsError(err, "no store matches replica attributes") {
+			return util.Errorf("expected replica validation error; got %v", err)
+		}
+		return nil
+	})
+
+	// So do lease preferences, which can't be empty.
+	if _, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE 'lease_preferences: [{attrs: [nonexistent]}]'`); !testutils.IsError(err, "no store matches lease preference nonexistent") {
+		t.Errorf("expected lease preference validation error; got %v", err)
+	}
+	if _, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE 'lease_preferences: [{attrs: []}]'`); !testutils.IsError(err, "lease preferences must specify at least one attribute") {
+		t.Errorf("expected lease preference validation error; got %v", err)
+	}
+
+	if _, err := sqlDB.Exec(`ALTER DATABASE test CONFIGURE ZONE NULL`); err != nil {
+		t.Fatal(err)
+	}
+	if kv, err := kvDB.Get(sql.MakeZoneKey(dbID)); err != nil {
+		t.Fatal(err)
+	} else if kv.Exists() {
+		t.Errorf("expected zone config to be removed; got %s", kv)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/gogo/protobuf/proto"
 )
 
 type metaAction func(*client.Batch, roachpb.Key, *roachpb.RangeDescriptor)
@@ -101,3 +102,112 @@ func rangeAddressing(b *client.Batch, desc *roachpb.RangeDescriptor, action meta
 	}
 	return nil
 }
+
+// A RangeAddressingReport describes the inconsistencies found between the
+// meta1 and meta2 range addressing records and the range descriptors.
+type RangeAddressingReport struct {
+	// RangeCount is the number of range descriptors visited.
+	RangeCount int
+	// Missing holds the keys of addressing records which are absent or do
+	// not match the descriptor of the range they address.
+	Missing []roachpb.Key
+	// Orphaned holds the keys of addressing records which don't belong to
+	// any range.
+	Orphaned []roachpb.Key
+	// Repaired is set if the inconsistencies were repaired.
+	Repaired bool
+}
+
+// Consistent returns whether no inconsistencies were found.
+func (r RangeAddressingReport) Consistent() bool {
+	return len(r.Missing) == 0 && len(r.Orphaned) == 0
+}
+
+// verifyAddressingBatchSize is the number of addressing records read at a
+// time while looking for orphaned records.
+const verifyAddressingBatchSize = 1000
+
+// VerifyRangeAddressing cross-checks the meta1 and meta2 range addressing
+// records against the range descriptors. The descriptors are walked from
+// KeyMin to KeyMax; a gap in the chain of descriptors is returned as an
+// error. The addressing records of each descriptor are read by their keys
+// from the meta ranges holding them, after which all the records are
+// scanned in batches to find those which don't belong to any range. If
+// repair is true, missing or outdated addressing records are rewritten from
+// the descriptors and orphaned records are deleted, in the same transaction
+// which performed the verification.
+func VerifyRangeAddressing(db *client.DB, repair bool) (RangeAddressingReport, error) {
+	var report RangeAddressingReport
+	err := db.Txn(func(txn *client.Txn) error {
+		report = RangeAddressingReport{}
+
+		b := txn.NewBatch()
+		expected := map[string]struct{}{}
+		for startKey := roachpb.RKeyMin; !startKey.Equal(roachpb.RKeyMax); {
+			desc := &roachpb.RangeDescriptor{}
+			if err := txn.GetProto(keys.RangeDescriptorKey(startKey), desc); err != nil {
+				return err
+			}
+			if desc.RangeID == 0 {
+				return util.Errorf("no range descriptor found for key %s", startKey)
+			}
+			if !startKey.Less(desc.EndKey) {
+				return util.Errorf("range descriptor for key %s does not advance: %s", startKey, desc)
+			}
+			report.RangeCount++
+
+			var metaKeys []roachpb.Key
+			if err := rangeAddressing(nil, desc, func(_ *client.Batch, key roachpb.Key, _ *roachpb.RangeDescriptor) {
+				metaKeys = append(metaKeys, key)
+			}); err != nil {
+				return err
+			}
+			gb := txn.NewBatch()
+			for _, key := range metaKeys {
+				gb.Get(key)
+			}
+			if err := txn.Run(gb); err != nil {
+				return err
+			}
+			for i, key := range metaKeys {
+				expected[string(key)] = struct{}{}
+				row := gb.Results[i].Rows[0]
+				record := &roachpb.RangeDescriptor{}
+				if row.Exists() {
+					if err := row.ValueProto(record); err != nil {
+						return err
+					}
+				}
+				if !row.Exists() || !proto.Equal(record, desc) {
+					report.Missing = append(report.Missing, key)
+					b.Put(key, desc)
+				}
+			}
+			startKey = desc.EndKey
+		}
+
+		for start := roachpb.Key(keys.Meta1Prefix); ; {
+			rows, err := txn.Scan(start, keys.MetaMax, verifyAddressingBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				if _, ok := expected[string(row.Key)]; !ok {
+					report.Orphaned = append(report.Orphaned, row.Key)
+					b.Del(row.Key)
+				}
+			}
+			if len(rows) < verifyAddressingBatchSize {
+				break
+			}
+			start = roachpb.Key(rows[len(rows)-1].Key).Next()
+		}
+
+		if !repair || report.Consistent() {
+			return nil
+		}
+		report.Repaired = true
+		return txn.CommitInBatch(b)
+	})
+	return report, err
+}
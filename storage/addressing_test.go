@@ -213,3 +213,106 @@ func TestUpdateRangeAddressingSplitMeta1(t *testing.T) {
 		t.Error("expected failure trying to update addressing records for meta1 split")
 	}
 }
+
+// TestVerifyRangeAddressing verifies that missing and orphaned range
+// addressing records are reported and repaired.
+func TestVerifyRangeAddressing(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	report, err := VerifyRangeAddressing(store.DB(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Consistent() || report.RangeCount != 1 {
+		t.Fatalf("expected consistent addressing of one range, got %+v", report)
+	}
+
+	// Remove the meta2 record of the only range and add an orphaned one.
+	missing := roachpb.Key(meta2Key(roachpb.RKeyMax))
+	orphaned := roachpb.Key(meta2Key(roachpb.RKey("a")))
+	if err := store.DB().Del(missing); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DB().Put(orphaned, &roachpb.RangeDescriptor{RangeID: 99}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, repair := range []bool{false, true} {
+		report, err := VerifyRangeAddressing(store.DB(), repair)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(report.Missing, []roachpb.Key{missing}) ||
+			!reflect.DeepEqual(report.Orphaned, []roachpb.Key{orphaned}) ||
+			report.Repaired != repair {
+			t.Errorf("repair=%t: unexpected report %+v", repair, report)
+		}
+	}
+
+	report, err = VerifyRangeAddressing(store.DB(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Consistent() {
+		t.Errorf("expected consistent addressing after repair, got %+v", report)
+	}
+}
+
+// TestVerifyRangeAddressingSplit verifies the range addressing records of a
+// store whose ranges, including the meta2 range, have been split.
+func TestVerifyRangeAddressingSplit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	db := store.DB()
+
+	for _, key := range []roachpb.Key{
+		roachpb.Key(meta2Key(roachpb.RKey("m"))), roachpb.Key("b"), roachpb.Key("c"), roachpb.Key("n"),
+	} {
+		if err := db.AdminSplit(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Look up the ranges, so that they can be addressed once their
+	// records are modified below.
+	for _, key := range []string{"a", "b", "c", "n"} {
+		if _, err := db.Get(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := VerifyRangeAddressing(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Consistent() || report.RangeCount != 5 {
+		t.Fatalf("expected consistent addressing of five ranges, got %+v", report)
+	}
+
+	// Remove the meta2 record of [b, c), which lives in the first meta2
+	// range, and add an orphaned record to the second meta2 range.
+	missing := roachpb.Key(meta2Key(roachpb.RKey("c")))
+	orphaned := roachpb.Key(meta2Key(roachpb.RKey("x")))
+	if err := db.Del(missing); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put(orphaned, &roachpb.RangeDescriptor{RangeID: 99}); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = VerifyRangeAddressing(db, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Missing, []roachpb.Key{missing}) ||
+		!reflect.DeepEqual(report.Orphaned, []roachpb.Key{orphaned}) || !report.Repaired {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if report, err = VerifyRangeAddressing(db, false); err != nil {
+		t.Fatal(err)
+	} else if !report.Consistent() {
+		t.Errorf("expected consistent addressing after repair, got %+v", report)
+	}
+}
@@ -255,6 +255,28 @@ func (a Allocator) RebalanceTarget(storeID roachpb.StoreID, required roachpb.Att
 	return nil
 }
 
+// ScatterTarget returns a store chosen at random among the stores with
+// the required attributes on nodes holding none of the existing
+// replicas, or nil if there is no such store. Unlike AllocateTarget, it
+// disregards the load of the stores, so that repeatedly scattering a set
+// of ranges spreads their replicas across the cluster.
+func (a Allocator) ScatterTarget(required roachpb.Attributes, existing []roachpb.ReplicaDescriptor) *roachpb.StoreDescriptor {
+	existingNodes := make(nodeIDSet, len(existing))
+	for _, repl := range existing {
+		existingNodes[repl.NodeID] = struct{}{}
+	}
+	var candidates []*roachpb.StoreDescriptor
+	for _, s := range a.storePool.getStoreList(required, a.options.Deterministic).stores {
+		if _, ok := existingNodes[s.Node.NodeID]; !ok {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[a.randGen.Intn(len(candidates))]
+}
+
 // ShouldRebalance returns whether the specified store should attempt to
 // rebalance a replica to another store.
 func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
@@ -280,6 +302,12 @@ func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
 
 	sl := a.storePool.getStoreList(*storeDesc.CombinedAttrs(), a.options.Deterministic)
 
+	// A store which refuses new replicas sheds them to any store which
+	// accepts them.
+	if storeDesc.Capacity.RefusesReplicas() {
+		return a.balancer.selectGood(sl, makeNodeIDSet(storeDesc.Node.NodeID)) != nil
+	}
+
 	// ShouldRebalance is true if a suitable replacement can be found.
 	return a.balancer.improve(storeDesc, sl, makeNodeIDSet(storeDesc.Node.NodeID)) != nil
 }
@@ -305,6 +305,54 @@ func TestAllocatorExistingReplica(t *testing.T) {
 	}
 }
 
+// TestAllocatorScatterTarget verifies that scatter targets have the
+// required attributes and are on nodes without a replica of the range.
+func TestAllocatorScatterTarget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+	gossiputil.NewStoreGossiper(g).GossipStores(sameDCStores, t)
+
+	existing := []roachpb.ReplicaDescriptor{{NodeID: 2, StoreID: 2}}
+	seen := map[roachpb.StoreID]bool{}
+	for i := 0; i < 100; i++ {
+		result := a.ScatterTarget(multiDisksConfig.ReplicaAttrs[1], existing)
+		if result == nil {
+			t.Fatal("expected a scatter target")
+		}
+		// Store 3 has the required attributes but shares node 2 with the
+		// existing replica.
+		if result.StoreID != 4 {
+			t.Fatalf("expected store 4; got %+v", result)
+		}
+		seen[result.StoreID] = true
+	}
+
+	for i := 0; i < 100; i++ {
+		result := a.ScatterTarget(roachpb.Attributes{}, existing)
+		if result == nil {
+			t.Fatal("expected a scatter target")
+		}
+		if result.Node.NodeID == 2 {
+			t.Fatalf("expected a store on a node other than 2; got %+v", result)
+		}
+		seen[result.StoreID] = true
+	}
+	// All stores on other nodes are chosen eventually.
+	for _, storeID := range []roachpb.StoreID{1, 4, 5} {
+		if !seen[storeID] {
+			t.Errorf("store %d was never chosen", storeID)
+		}
+	}
+
+	// With replicas on both nodes which have stores with the required
+	// attributes, there is no target left.
+	existing = []roachpb.ReplicaDescriptor{{NodeID: 2, StoreID: 3}, {NodeID: 3, StoreID: 4}}
+	if result := a.ScatterTarget(multiDisksConfig.ReplicaAttrs[1], existing); result != nil {
+		t.Errorf("expected no scatter target; got %+v", result)
+	}
+}
+
 // TestAllocatorRelaxConstraints verifies that attribute constraints
 // will be relaxed in order to match nodes lacking required attributes,
 // if necessary to find an allocation target.
@@ -551,6 +599,43 @@ func TestAllocatorRebalanceByCount(t *testing.T) {
 	}
 }
 
+// TestAllocatorMaxRangeCount verifies that stores which refuse new replicas
+// are never chosen as allocation targets and want to shed their replicas.
+func TestAllocatorMaxRangeCount(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID:  1,
+			Node:     roachpb.NodeDescriptor{NodeID: 1},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, RangeCount: 10, MaxRangeCount: 10},
+		},
+		{
+			StoreID:  2,
+			Node:     roachpb.NodeDescriptor{NodeID: 2},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 20},
+		},
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	for i := 0; i < 10; i++ {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, []roachpb.ReplicaDescriptor{}, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != 2 {
+			t.Errorf("expected store 2; got %d", result.StoreID)
+		}
+	}
+
+	a.options.Deterministic = true
+	if !a.ShouldRebalance(1) {
+		t.Errorf("expected store 1 to rebalance")
+	}
+}
+
 // TestAllocatorRemoveTarget verifies that the replica chosen by RemoveTarget is
 // the one with the lowest capacity.
 func TestAllocatorRemoveTarget(t *testing.T) {
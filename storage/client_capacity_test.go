@@ -0,0 +1,110 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestStoreNearlyFull verifies that a store whose disk usage exceeds the
+// capacity alert threshold reports so in its descriptor and refuses writes
+// of new data to non-system ranges only.
+func TestStoreNearlyFull(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	sCtx := storage.TestStoreContext
+	// Any disk usage at all exceeds the threshold.
+	sCtx.CapacityAlertThreshold = 1e-9
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	store := createTestStoreWithEngine(t,
+		engine.NewInMem(roachpb.Attributes{}, 10<<20, stopper),
+		hlc.NewClock(hlc.NewManualClock(0).UnixNano),
+		true, &sCtx, stopper)
+
+	userKey := append(keys.MakeTablePrefix(keys.MaxReservedDescID+1), 'a')
+	if err := store.DB().AdminSplit(keys.UserTableDataMin); err != nil {
+		t.Fatal(err)
+	}
+
+	desc, err := store.Descriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !desc.Capacity.NearlyFull {
+		t.Fatalf("expected store to be nearly full: %+v", desc.Capacity)
+	}
+
+	// Writes to system ranges still succeed.
+	if err := store.DB().Put("a", "value"); err != nil {
+		t.Fatal(err)
+	}
+	// Writes of new user data are refused.
+	err = store.DB().Put(userKey, "value")
+	if _, ok := err.(*roachpb.StoreNearlyFullError); !ok {
+		t.Fatalf("expected StoreNearlyFullError; got %v", err)
+	}
+	// Deletions are let through.
+	if err := store.DB().Del(userKey); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestStoreNearlyFullReplica verifies that writes of new data are refused
+// when the store of another replica of the range has gossiped that it is
+// nearly full.
+func TestStoreNearlyFullReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 2)
+	defer mtc.Stop()
+
+	userKey := append(keys.MakeTablePrefix(keys.MaxReservedDescID+1), 'a')
+	if err := mtc.db.AdminSplit(keys.UserTableDataMin); err != nil {
+		t.Fatal(err)
+	}
+	rangeID := mtc.stores[0].LookupReplica(roachpb.RKey(userKey), nil).Desc().RangeID
+	mtc.replicateRange(rangeID, 0, 1)
+	if err := mtc.db.Put(userKey, "value"); err != nil {
+		t.Fatal(err)
+	}
+
+	desc, err := mtc.stores[1].Descriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc.Capacity.NearlyFull = true
+	util.SucceedsWithin(t, time.Second, func() error {
+		// Gossip again in case the store has gossiped its own descriptor.
+		if err := mtc.gossip.AddInfoProto(gossip.MakeStoreKey(desc.StoreID), desc, 0); err != nil {
+			t.Fatal(err)
+		}
+		err := mtc.db.Put(userKey, "value")
+		if e, ok := err.(*roachpb.StoreNearlyFullError); !ok || e.StoreID != desc.StoreID {
+			return util.Errorf("expected StoreNearlyFullError for store %d; got %v", desc.StoreID, err)
+		}
+		return nil
+	})
+}
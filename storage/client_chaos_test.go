@@ -0,0 +1,205 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// chaosTestContext is a multiTestContext whose stores communicate through a
+// ChaosTransport and have their own, skewed clocks. It is used to verify
+// that the invariants of the replicated state hold in the presence of
+// partitions, lossy links and clock offsets.
+type chaosTestContext struct {
+	*multiTestContext
+	transport *multiraft.ChaosTransport
+	rng       *rand.Rand
+	manuals   []*hlc.ManualClock
+	base      int64 // the time around which the clocks are skewed
+	maxSkew   time.Duration
+}
+
+// startChaosTestContext starts a chaosTestContext with the given number of
+// stores, whose clocks are at most maxSkew apart.
+func startChaosTestContext(t *testing.T, numStores int, maxSkew time.Duration) *chaosTestContext {
+	rng, seed := randutil.NewPseudoRand()
+	log.Infof("chaos test seed: %d", seed)
+	ctc := &chaosTestContext{
+		multiTestContext: &multiTestContext{},
+		rng:              rng,
+		base:             1,
+		maxSkew:          maxSkew,
+	}
+	for i := 0; i < numStores; i++ {
+		manual := hlc.NewManualClock(ctc.base)
+		clock := hlc.NewClock(manual.UnixNano)
+		clock.SetMaxOffset(2 * maxSkew)
+		ctc.manuals = append(ctc.manuals, manual)
+		ctc.clocks = append(ctc.clocks, clock)
+	}
+	ctc.manualClock = ctc.manuals[0]
+	ctc.clock = ctc.clocks[0]
+	ctc.clientStopper = stop.NewStopper()
+	ctc.transport = multiraft.NewChaosTransport(
+		multiraft.NewLocalRPCTransport(ctc.clientStopper), ctc.clientStopper, rng.Int63())
+	ctc.multiTestContext.transport = ctc.transport
+	ctc.Start(t, numStores)
+	return ctc
+}
+
+// advanceClocks moves all clocks forward by the given duration, skewing
+// each of them by a random offset of at most maxSkew.
+func (ctc *chaosTestContext) advanceClocks(d time.Duration) {
+	ctc.base += int64(d)
+	for _, manual := range ctc.manuals {
+		manual.Set(ctc.base + ctc.rng.Int63n(int64(ctc.maxSkew)))
+	}
+}
+
+// isolate partitions the given store away from all other stores.
+func (ctc *chaosTestContext) isolate(idx int) {
+	ctc.transport.Partition([]roachpb.StoreID{ctc.stores[idx].StoreID()})
+}
+
+// chaosWriter increments a key until stopped, counting the increments it
+// attempted and those which were acknowledged.
+type chaosWriter struct {
+	attempted, acked int64 // accessed atomically
+	stop, done       chan struct{}
+}
+
+// startWriter starts a chaosWriter incrementing the given key.
+func (ctc *chaosTestContext) startWriter(key roachpb.Key) *chaosWriter {
+	w := &chaosWriter{stop: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		defer close(w.done)
+		for {
+			select {
+			case <-w.stop:
+				return
+			default:
+			}
+			atomic.AddInt64(&w.attempted, 1)
+			if _, err := ctc.db.Inc(key, 1); err == nil {
+				atomic.AddInt64(&w.acked, 1)
+			}
+		}
+	}()
+	return w
+}
+
+// stopWriter stops the writer, waiting for its pending increment, which
+// requires the cluster to be available.
+func (ctc *chaosTestContext) stopWriter(w *chaosWriter) {
+	close(w.stop)
+	select {
+	case <-w.done:
+	case <-time.After(10 * time.Second):
+		ctc.t.Fatal("writer did not finish its pending increment")
+	}
+}
+
+// checkNoLostWrites verifies that the value of the key reflects all the
+// acknowledged increments, and no more increments than were attempted.
+func (ctc *chaosTestContext) checkNoLostWrites(key roachpb.Key, w *chaosWriter) int64 {
+	kv, err := ctc.db.Get(key)
+	if err != nil {
+		ctc.t.Fatal(err)
+	}
+	value := kv.ValueInt()
+	if value < w.acked || value > w.attempted {
+		ctc.t.Fatalf("value %d of %s is outside of [%d acked, %d attempted] increments",
+			value, key, w.acked, w.attempted)
+	}
+	return value
+}
+
+// checkReplicasConverge verifies that all replicas eventually apply the
+// same value for the key. Replicas applying diverging commands, as happens
+// if two of them believe they're in charge at the same time, would never
+// converge.
+func (ctc *chaosTestContext) checkReplicasConverge(key roachpb.Key, expected int64) {
+	util.SucceedsWithin(ctc.t, 5*time.Second, func() error {
+		for i, eng := range ctc.engines {
+			val, _, err := engine.MVCCGet(eng, key, roachpb.MaxTimestamp, true, nil)
+			if err != nil {
+				return err
+			}
+			var value int64
+			if val != nil {
+				if value, err = val.GetInt(); err != nil {
+					return err
+				}
+			}
+			if value != expected {
+				return util.Errorf("store %d: expected %s=%d; got %d", i, key, expected, value)
+			}
+		}
+		return nil
+	})
+}
+
+// TestChaosPartitionAndClockSkew writes to a range while its replicas are
+// repeatedly partitioned, their links made lossy and slow and their clocks
+// skewed, and verifies that no acknowledged write is lost and that the
+// replicas converge once the network heals.
+func TestChaosPartitionAndClockSkew(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const numStores = 3
+	ctc := startChaosTestContext(t, numStores, 50*time.Millisecond)
+	defer ctc.Stop()
+	ctc.replicateRange(1, 0, 1, 2)
+
+	key := roachpb.Key("chaos")
+	w := ctc.startWriter(key)
+	for round := 0; round < 5; round++ {
+		// Isolate a random store and expire the leases, so that the majority
+		// moves on without it if it held the lease.
+		ctc.isolate(ctc.rng.Intn(numStores))
+		ctc.advanceClocks(storage.DefaultLeaderLeaseDuration + 1)
+		time.Sleep(100 * time.Millisecond)
+
+		// Make all links lossy and slow.
+		ctc.transport.Heal()
+		ctc.transport.SetDefaultRule(multiraft.ChaosRule{DropRate: 0.1, Delay: 5 * time.Millisecond})
+		ctc.advanceClocks(ctc.maxSkew)
+		time.Sleep(100 * time.Millisecond)
+
+		ctc.transport.Heal()
+	}
+	ctc.advanceClocks(storage.DefaultLeaderLeaseDuration + 1)
+	ctc.stopWriter(w)
+
+	value := ctc.checkNoLostWrites(key, w)
+	ctc.checkReplicasConverge(key, value)
+	dropped, delayed := ctc.transport.Stats()
+	log.Infof("%d increments acked out of %d; %d messages dropped, %d delayed",
+		w.acked, w.attempted, dropped, delayed)
+}
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
@@ -30,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 )
@@ -300,8 +302,9 @@ func TestStoreRangeMergeLastRange(t *testing.T) {
 	}
 }
 
-// TestStoreRangeMergeNonCollocated attempts to merge two ranges
-// that are not on the same stores.
+// TestStoreRangeMergeNonCollocated merges two ranges that are not on
+// the same stores and verifies that the right-hand range is moved to the
+// stores of the left-hand range first.
 func TestStoreRangeMergeNonCollocated(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	mtc := startMultiTestContext(t, 4)
@@ -339,10 +342,25 @@ func TestStoreRangeMergeNonCollocated(t *testing.T) {
 	mtc.replicateRange(rangeB.Desc().RangeID, 0, 1, 3)
 	mtc.replicateRange(rangeC.Desc().RangeID, 0, 1, 2)
 
-	// Attempt to merge.
+	// Merge B into A, which requires moving B's replica from store 3 to
+	// store 2.
 	desc := rangeA.Desc()
 	argsMerge := adminMergeArgs(roachpb.Key(desc.StartKey))
-	if _, err := rangeA.AdminMerge(argsMerge, desc); !testutils.IsError(err, "ranges not collocated") {
-		t.Fatalf("did not got expected error; got %s", err)
+	if _, err := rangeA.AdminMerge(argsMerge, desc); err != nil {
+		t.Fatal(err)
+	}
+
+	mergedDesc := rangeA.Desc()
+	if !mergedDesc.EndKey.Equal(roachpb.RKey("d")) {
+		t.Errorf("expected merged range to end at \"d\"; got %s", mergedDesc.EndKey)
 	}
+	// Store 2 received B's data before the merge and now holds the merged
+	// range.
+	util.SucceedsWithin(t, time.Second, func() error {
+		rng := mtc.stores[2].LookupReplica(roachpb.RKey("c"), nil)
+		if rng == nil || !rng.Desc().EndKey.Equal(roachpb.RKey("d")) {
+			return util.Errorf("store 2 does not hold the merged range yet")
+		}
+		return nil
+	})
 }
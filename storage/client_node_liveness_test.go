@@ -0,0 +1,117 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestNodeLivenessHeartbeatAndIncrementEpoch verifies that heartbeats
+// create and extend a node's liveness record, that its epoch can only be
+// incremented once the record has expired, and that the next heartbeat
+// adopts the new epoch.
+func TestNodeLivenessHeartbeatAndIncrementEpoch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	store := createTestStoreWithEngine(t,
+		engine.NewInMem(roachpb.Attributes{}, 10<<20, stopper), clock, true, nil, stopper)
+
+	const threshold = 10 * time.Second
+	nl := storage.NewNodeLiveness(clock, store.DB(), store.Gossip(), threshold, time.Second)
+	nodeID := roachpb.NodeID(2)
+
+	if _, err := nl.GetLiveness(nodeID); !testutils.IsError(err, "not found") {
+		t.Fatalf("expected missing liveness record; got %v", err)
+	}
+
+	// The first heartbeat creates the record at epoch one.
+	if err := nl.Heartbeat(nodeID); err != nil {
+		t.Fatal(err)
+	}
+	liveness, err := nl.GetLiveness(nodeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if liveness.Epoch != 1 || !liveness.IsLive(clock.Now()) {
+		t.Fatalf("expected live record at epoch 1; got %+v", liveness)
+	}
+	var stored roachpb.Liveness
+	if err := store.DB().GetProto(keys.NodeLivenessKey(nodeID), &stored); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stored, liveness) {
+		t.Fatalf("expected stored record %+v; got %+v", liveness, stored)
+	}
+
+	// A later heartbeat extends the expiration at the same epoch.
+	manual.Increment(int64(time.Second))
+	if err := nl.Heartbeat(nodeID); err != nil {
+		t.Fatal(err)
+	}
+	extended, err := nl.GetLiveness(nodeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if extended.Epoch != 1 || !liveness.Expiration.Less(extended.Expiration) {
+		t.Fatalf("expected record %+v to be extended; got %+v", liveness, extended)
+	}
+
+	// The epoch of a live record cannot be incremented.
+	if err := nl.IncrementEpoch(extended); !testutils.IsError(err, "liveness record is live") {
+		t.Fatalf("expected refusal to increment live epoch; got %v", err)
+	}
+
+	// Once expired, it can, and incrementing it again is a no-op.
+	manual.Increment(int64(threshold) + 1)
+	if err := nl.IncrementEpoch(extended); err != nil {
+		t.Fatal(err)
+	}
+	if err := nl.IncrementEpoch(extended); err != nil {
+		t.Fatal(err)
+	}
+	incremented, err := nl.GetLiveness(nodeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incremented.Epoch != 2 || incremented.IsLive(clock.Now()) {
+		t.Fatalf("expected expired record at epoch 2; got %+v", incremented)
+	}
+
+	// The node's next heartbeat adopts the new epoch.
+	if err := nl.Heartbeat(nodeID); err != nil {
+		t.Fatal(err)
+	}
+	if liveness, err = nl.GetLiveness(nodeID); err != nil {
+		t.Fatal(err)
+	}
+	if liveness.Epoch != 2 || !liveness.IsLive(clock.Now()) {
+		t.Fatalf("expected live record at epoch 2; got %+v", liveness)
+	}
+}
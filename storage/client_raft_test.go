@@ -153,6 +153,62 @@ func TestStoreRecoverFromEngine(t *testing.T) {
 	validate(store)
 }
 
+// TestStoreRecoverWithGroupCommit verifies that raft state written with
+// group commit enabled is recovered by a store restarted without it.
+func TestStoreRecoverWithGroupCommit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	splitKey := roachpb.Key("m")
+	testKeys := []roachpb.Key{roachpb.Key("a"), roachpb.Key("z")}
+
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	engineStopper := stop.NewStopper()
+	defer engineStopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, engineStopper)
+
+	increment := func(store *storage.Store, key roachpb.Key, value int64) (*roachpb.IncrementResponse, error) {
+		args := incrementArgs(key, value)
+		resp, err := client.SendWrappedWith(rg1(store), nil, roachpb.Header{
+			RangeID: store.LookupReplica(roachpb.RKey(key), nil).Desc().RangeID,
+		}, &args)
+		incResp, _ := resp.(*roachpb.IncrementResponse)
+		return incResp, err
+	}
+
+	func() {
+		stopper := stop.NewStopper()
+		defer stopper.Stop()
+		sCtx := storage.TestStoreContext
+		sCtx.RaftGroupCommit = true
+		store := createTestStoreWithEngine(t, eng, clock, true, &sCtx, stopper)
+
+		splitArgs := adminSplitArgs(roachpb.KeyMin, splitKey)
+		if _, err := client.SendWrapped(rg1(store), nil, &splitArgs); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 10; i++ {
+			for _, key := range testKeys {
+				if _, err := increment(store, key, 1); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}()
+
+	store := createTestStoreWithEngine(t, eng, clock, false, nil, engineStopper)
+	for _, key := range testKeys {
+		// A no-op increment initializes raft processing for the range
+		// and returns the current value.
+		resp, err := increment(store, key, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val := resp.NewValue; val != 10 {
+			t.Errorf("key %q: expected 10 but got %d", key, val)
+		}
+	}
+}
+
 // TestStoreRecoverWithErrors verifies that even commands that fail are marked as
 // applied so they are not retried after recovery.
 func TestStoreRecoverWithErrors(t *testing.T) {
@@ -233,7 +289,7 @@ func TestReplicateRange(t *testing.T) {
 		roachpb.ReplicaDescriptor{
 			NodeID:  mtc.stores[1].Ident.NodeID,
 			StoreID: mtc.stores[1].Ident.StoreID,
-		}, rng.Desc()); err != nil {
+		}, rng.Desc(), storage.REASON_MANUAL); err != nil {
 		t.Fatal(err)
 	}
 	// Verify no intent remains on range descriptor key.
@@ -296,7 +352,7 @@ func TestRestoreReplicas(t *testing.T) {
 		roachpb.ReplicaDescriptor{
 			NodeID:  mtc.stores[1].Ident.NodeID,
 			StoreID: mtc.stores[1].Ident.StoreID,
-		}, firstRng.Desc()); err != nil {
+		}, firstRng.Desc(), storage.REASON_MANUAL); err != nil {
 		t.Fatal(err)
 	}
 
@@ -363,6 +419,72 @@ func TestRestoreReplicas(t *testing.T) {
 	}
 }
 
+// TestWitnessOnlyUpToDateReplica verifies that a witness which is the only
+// up-to-date replica of a range available doesn't take over leadership of
+// the range and bring the lagging full replica up to date without its user
+// data. The range only becomes available again once the up-to-date full
+// replica returns, and the lagging replica then catches up with all the
+// data.
+func TestWitnessOnlyUpToDateReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 3)
+	defer mtc.Stop()
+
+	rng, err := mtc.stores[0].GetReplica(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtc.replicateRange(1, 0, 1)
+	if err := rng.ChangeReplicas(roachpb.ADD_REPLICA,
+		roachpb.ReplicaDescriptor{
+			NodeID:  mtc.stores[2].Ident.NodeID,
+			StoreID: mtc.stores[2].Ident.StoreID,
+			Witness: true,
+		}, rng.Desc(), storage.REASON_MANUAL); err != nil {
+		t.Fatal(err)
+	}
+
+	key := roachpb.Key("a")
+	incArgs := incrementArgs(key, 5)
+	if _, err := client.SendWrapped(rg1(mtc.stores[0]), nil, &incArgs); err != nil {
+		t.Fatal(err)
+	}
+	mtc.waitForValues(key, 3*time.Second, []int64{5, 5, 0})
+
+	// Let the second store fall behind; the increment is committed by the
+	// first store and the witness.
+	mtc.stopStore(1)
+	incArgs = incrementArgs(key, 11)
+	if _, err := client.SendWrapped(rg1(mtc.stores[0]), nil, &incArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Leave the witness as the only up-to-date replica. The lagging store
+	// can't win an election without the witness's vote, and the witness
+	// must not start one.
+	mtc.stopStore(0)
+	mtc.restartStore(1)
+	time.Sleep(10 * storage.TestStoreContext.RaftTickInterval *
+		time.Duration(storage.TestStoreContext.RaftElectionTimeoutTicks))
+	for _, i := range []int{1, 2} {
+		if status := mtc.stores[i].RaftStatus(1); status != nil && status.SoftState.RaftState == raft.StateLeader {
+			t.Fatalf("store %d unexpectedly leads the range", i)
+		}
+	}
+	if values := mtc.readIntFromEngines(key); values[1] != 5 || values[2] != 0 {
+		t.Fatalf("unexpected values %v", values)
+	}
+
+	// Once the first store returns, it brings the lagging store up to date.
+	mtc.restartStore(0)
+	mtc.waitForValues(key, 5*time.Second, []int64{16, 16, 0})
+	incArgs = incrementArgs(key, 1)
+	if _, err := client.SendWrapped(rg1(mtc.stores[0]), nil, &incArgs); err != nil {
+		t.Fatal(err)
+	}
+	mtc.waitForValues(key, 3*time.Second, []int64{17, 17, 0})
+}
+
 func TestFailedReplicaChange(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	defer func() { storage.TestingCommandFilter = nil }()
@@ -395,7 +517,7 @@ func TestFailedReplicaChange(t *testing.T) {
 		roachpb.ReplicaDescriptor{
 			NodeID:  mtc.stores[1].Ident.NodeID,
 			StoreID: mtc.stores[1].Ident.StoreID,
-		}, rng.Desc())
+		}, rng.Desc(), storage.REASON_MANUAL)
 	if err == nil || !strings.Contains(err.Error(), "boom") {
 		t.Fatalf("did not get expected error: %s", err)
 	}
@@ -418,7 +540,7 @@ func TestFailedReplicaChange(t *testing.T) {
 		roachpb.ReplicaDescriptor{
 			NodeID:  mtc.stores[1].Ident.NodeID,
 			StoreID: mtc.stores[1].Ident.StoreID,
-		}, rng.Desc())
+		}, rng.Desc(), storage.REASON_MANUAL)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -483,7 +605,7 @@ func TestReplicateAfterTruncation(t *testing.T) {
 		roachpb.ReplicaDescriptor{
 			NodeID:  mtc.stores[1].Ident.NodeID,
 			StoreID: mtc.stores[1].Ident.StoreID,
-		}, rng.Desc()); err != nil {
+		}, rng.Desc(), storage.REASON_MANUAL); err != nil {
 		t.Fatal(err)
 	}
 
@@ -742,7 +864,7 @@ func TestChangeReplicasDescriptorInvariant(t *testing.T) {
 				NodeID:  mtc.stores[storeNum].Ident.NodeID,
 				StoreID: mtc.stores[storeNum].Ident.StoreID,
 			},
-			desc)
+			desc, storage.REASON_MANUAL)
 	}
 
 	// Retain the descriptor for the range at this point.
@@ -1403,3 +1525,113 @@ func TestReplicateReAddAfterDown(t *testing.T) {
 	// The range should be synced back up.
 	mtc.waitForValues(roachpb.Key("a"), 3*time.Second, []int64{16, 16, 16})
 }
+
+// TestReplicaIDsAcrossRestarts verifies that replica IDs keep increasing
+// when stores restart between membership changes, so that the ID of a
+// removed replica is never handed out again.
+func TestReplicaIDsAcrossRestarts(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	mtc := startMultiTestContext(t, 3)
+	defer mtc.Stop()
+
+	rangeID := roachpb.RangeID(1)
+	mtc.replicateRange(rangeID, 0, 1, 2)
+	mtc.restart()
+
+	mtc.unreplicateRange(rangeID, 0, 2)
+	mtc.restart()
+
+	// Re-adding the replica to the same store allocates a fresh ID.
+	mtc.replicateRange(rangeID, 0, 2)
+
+	expected := map[roachpb.StoreID]roachpb.ReplicaID{1: 1, 2: 2, 3: 4}
+	util.SucceedsWithin(t, 3*time.Second, func() error {
+		for i, store := range mtc.stores {
+			rng := store.LookupReplica(roachpb.RKeyMin, nil)
+			if rng == nil {
+				return util.Errorf("range not found on store %d", i)
+			}
+			desc := rng.Desc()
+			replicaIDsByStore := map[roachpb.StoreID]roachpb.ReplicaID{}
+			for _, rep := range desc.Replicas {
+				replicaIDsByStore[rep.StoreID] = rep.ReplicaID
+			}
+			if !reflect.DeepEqual(expected, replicaIDsByStore) {
+				return util.Errorf("store %d: expected replica IDs %v; got %v", i, expected, replicaIDsByStore)
+			}
+			if desc.NextReplicaID != 5 {
+				return util.Errorf("store %d: expected NextReplicaID 5; got %d", i, desc.NextReplicaID)
+			}
+		}
+		return nil
+	})
+}
+
+// TestStoreSnapshotTestingKnobs verifies that the snapshot knobs of the
+// store's testing knobs can pause the application of a snapshot.
+func TestStoreSnapshotTestingKnobs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	paused := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	var applied int32
+	mtc := &multiTestContext{
+		storeContext: &storage.StoreContext{},
+	}
+	*mtc.storeContext = storage.TestStoreContext
+	mtc.storeContext.TestingKnobs.BeforeSnapshotApply = func(storeID roachpb.StoreID, _ roachpb.RangeID) {
+		if storeID == 2 {
+			once.Do(func() {
+				close(paused)
+				<-release
+			})
+		}
+	}
+	mtc.storeContext.TestingKnobs.AfterSnapshotApply = func(storeID roachpb.StoreID, _ roachpb.RangeID) {
+		if storeID == 2 {
+			atomic.AddInt32(&applied, 1)
+		}
+	}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+	releaseOnce := sync.Once{}
+	defer releaseOnce.Do(func() { close(release) })
+
+	rng, err := mtc.stores[0].GetReplica(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.ChangeReplicas(roachpb.ADD_REPLICA,
+		roachpb.ReplicaDescriptor{
+			NodeID:  mtc.stores[1].Ident.NodeID,
+			StoreID: mtc.stores[1].Ident.StoreID,
+		}, rng.Desc(), storage.REASON_MANUAL); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-paused:
+	case <-time.After(3 * time.Second):
+		t.Fatal("snapshot was not applied")
+	}
+	// The replica remains uninitialized while the snapshot is paused.
+	if mtc.stores[1].LookupReplica(roachpb.RKeyMin, nil) != nil {
+		t.Error("expected replica to be uninitialized before the snapshot is applied")
+	}
+	if atomic.LoadInt32(&applied) != 0 {
+		t.Error("expected snapshot not to be applied yet")
+	}
+	releaseOnce.Do(func() { close(release) })
+
+	util.SucceedsWithin(t, 3*time.Second, func() error {
+		if mtc.stores[1].LookupReplica(roachpb.RKeyMin, nil) == nil {
+			return util.Errorf("range not found on store 2")
+		}
+		if atomic.LoadInt32(&applied) == 0 {
+			return util.Errorf("snapshot not applied")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestRangeLog verifies that splits and merges are recorded in the range
+// event log.
+func TestRangeLog(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	if err := store.DB().AdminSplit("b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DB().AdminMerge("a"); err != nil {
+		t.Fatal(err)
+	}
+
+	events, resume, err := storage.ReadRangeLog(store.DB(), 0, nil, storage.DefaultRangeLogReadLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || resume != nil {
+		t.Fatalf("expected 2 events and no resume key; got %+v, %q", events, resume)
+	}
+	for i, expType := range []storage.RangeLogEventType{storage.RANGE_SPLIT, storage.RANGE_MERGE} {
+		event := events[i]
+		if event.EventType != expType || event.RangeID != 1 || event.OtherRangeID != 2 ||
+			event.StoreID != store.StoreID() {
+			t.Errorf("%d: unexpected event %+v", i, event)
+		}
+	}
+	if !events[0].UpdatedDesc.EndKey.Equal(roachpb.RKey("b")) {
+		t.Errorf("expected split at \"b\"; got %s", events[0].UpdatedDesc.EndKey)
+	}
+	if !events[1].UpdatedDesc.EndKey.Equal(roachpb.RKeyMax) {
+		t.Errorf("expected merged range to end at %s; got %s", roachpb.RKeyMax, events[1].UpdatedDesc.EndKey)
+	}
+
+	// Events can be filtered by either range involved.
+	if events, _, err := storage.ReadRangeLog(store.DB(), 2, nil, storage.DefaultRangeLogReadLimit); err != nil {
+		t.Fatal(err)
+	} else if len(events) != 2 {
+		t.Errorf("expected 2 events for range 2; got %+v", events)
+	}
+	if events, _, err := storage.ReadRangeLog(store.DB(), 3, nil, storage.DefaultRangeLogReadLimit); err != nil {
+		t.Fatal(err)
+	} else if len(events) != 0 {
+		t.Errorf("expected no events for range 3; got %+v", events)
+	}
+
+	// Reads are paged: a limited read reports where to resume.
+	first, resume, err := storage.ReadRangeLog(store.DB(), 2, nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 1 || first[0].EventType != storage.RANGE_SPLIT || resume == nil {
+		t.Fatalf("expected the split and a resume key; got %+v, %q", first, resume)
+	}
+	rest, resume, err := storage.ReadRangeLog(store.DB(), 2, resume, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 1 || rest[0].EventType != storage.RANGE_MERGE {
+		t.Fatalf("expected the merge; got %+v", rest)
+	}
+	if rest, _, err := storage.ReadRangeLog(store.DB(), 2, resume, 1); err != nil {
+		t.Fatal(err)
+	} else if len(rest) != 0 {
+		t.Errorf("expected no further events; got %+v", rest)
+	}
+}
+
+// TestRangeChangeHistory verifies that replica changes are recorded in the
+// change history of the range.
+func TestRangeChangeHistory(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 3)
+	defer mtc.Stop()
+
+	mtc.replicateRange(1, 0, 1, 2)
+	mtc.unreplicateRange(1, 0, 2)
+
+	events, err := storage.ReadRangeChangeHistory(mtc.db, roachpb.RKeyMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		eventType storage.RangeLogEventType
+		store     int
+	}{
+		{storage.RANGE_ADD_REPLICA, 1},
+		{storage.RANGE_ADD_REPLICA, 2},
+		{storage.RANGE_REMOVE_REPLICA, 2},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events; got %+v", len(expected), events)
+	}
+	for i, exp := range expected {
+		event := events[i]
+		if event.EventType != exp.eventType || event.RangeID != 1 ||
+			event.Replica.StoreID != mtc.stores[exp.store].StoreID() ||
+			event.Reason != storage.REASON_MANUAL {
+			t.Errorf("%d: unexpected event %+v", i, event)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/testutils"
@@ -87,3 +88,77 @@ func TestReplicaGCQueueDropReplicaGCOnScan(t *testing.T) {
 		return nil
 	})
 }
+
+// TestReplicaGCQueueDelayedGC verifies that the data of a removed replica
+// is kept, and can be recovered, until the replica GC delay has passed.
+func TestReplicaGCQueueDelayedGC(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	mtc := &multiTestContext{
+		storeContext: &storage.StoreContext{},
+	}
+	*mtc.storeContext = storage.TestStoreContext
+	mtc.storeContext.ReplicaGCDelay = time.Hour
+	mtc.Start(t, 3)
+	defer mtc.Stop()
+
+	rangeID := roachpb.RangeID(1)
+	mtc.replicateRange(rangeID, 0, 1, 2)
+	incArgs := incrementArgs([]byte("a"), 5)
+	if _, err := client.SendWrapped(rg1(mtc.stores[0]), nil, &incArgs); err != nil {
+		t.Fatal(err)
+	}
+	mtc.waitForValues(roachpb.Key("a"), time.Second, []int64{5, 5, 5})
+	mtc.unreplicateRange(rangeID, 0, 1)
+
+	// The replica is removed from the store, but its data is kept.
+	util.SucceedsWithin(t, time.Second, func() error {
+		if _, err := mtc.stores[1].GetReplica(rangeID); !testutils.IsError(err, "range .* was not found") {
+			return util.Errorf("expected range removal")
+		}
+		if infos := mtc.stores[1].PendingGCReplicas(); len(infos) != 1 || infos[0].Desc.RangeID != rangeID {
+			return util.Errorf("expected range %d to be pending GC, got %+v", rangeID, infos)
+		}
+		return nil
+	})
+	mtc.waitForValues(roachpb.Key("a"), time.Second, []int64{5, 5, 5})
+
+	// The pending GC survives a restart.
+	mtc.stopStore(1)
+	mtc.restartStore(1)
+	if infos := mtc.stores[1].PendingGCReplicas(); len(infos) != 1 {
+		t.Fatalf("expected range %d to be pending GC after restart, got %+v", rangeID, infos)
+	}
+
+	// The replica can be recovered.
+	if err := mtc.stores[1].RecoverPendingGCReplica(rangeID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mtc.stores[1].GetReplica(rangeID); err != nil {
+		t.Fatal(err)
+	}
+	if infos := mtc.stores[1].PendingGCReplicas(); len(infos) != 0 {
+		t.Fatalf("expected no replicas pending GC, got %+v", infos)
+	}
+
+	// Once inactive, the recovered replica is considered for GC again and
+	// its data is destroyed after the delay.
+	mtc.manualClock.Increment(int64(storage.ReplicaGCQueueInactivityThreshold+
+		storage.DefaultLeaderLeaseDuration) + 1)
+	util.SucceedsWithin(t, time.Second, func() error {
+		store := mtc.stores[1]
+		store.ForceReplicaGCScan(t)
+		if infos := store.PendingGCReplicas(); len(infos) != 1 {
+			return util.Errorf("expected range %d to be pending GC, got %+v", rangeID, infos)
+		}
+		return nil
+	})
+	mtc.stores[1].ForcePendingGCSweep(t)
+	mtc.waitForValues(roachpb.Key("a"), time.Second, []int64{5, 5, 5})
+	mtc.manualClock.Increment(int64(time.Hour) + 1)
+	mtc.stores[1].ForcePendingGCSweep(t)
+	mtc.waitForValues(roachpb.Key("a"), time.Second, []int64{5, 0, 5})
+	if infos := mtc.stores[1].PendingGCReplicas(); len(infos) != 0 {
+		t.Fatalf("expected no replicas pending GC, got %+v", infos)
+	}
+}
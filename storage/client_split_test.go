@@ -84,6 +84,39 @@ func TestStoreRangeSplitAtIllegalKeys(t *testing.T) {
 	}
 }
 
+// TestStoreRangeSplitQueueExclusions verifies that the queue exclusions
+// of a range carry over to both sides of a split.
+func TestStoreRangeSplitQueueExclusions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	if err := store.DB().AdminSetQueueExclusion("a", "gc", true); err != nil {
+		t.Fatal(err)
+	}
+	args := adminSplitArgs(roachpb.KeyMin, roachpb.Key("b"))
+	if _, err := client.SendWrapped(rg1(store), nil, &args); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []roachpb.RKey{roachpb.RKey("a"), roachpb.RKey("b")} {
+		rng := store.LookupReplica(key, nil)
+		if queues := rng.QueueExclusions(); !reflect.DeepEqual(queues, []string{"gc"}) {
+			t.Errorf("range %d: expected exclusion from the gc queue; got %v", rng.Desc().RangeID, queues)
+		}
+	}
+
+	// Lifting the exclusion from one side leaves the other excluded.
+	if err := store.DB().AdminSetQueueExclusion("b", "gc", false); err != nil {
+		t.Fatal(err)
+	}
+	if queues := store.LookupReplica(roachpb.RKey("b"), nil).QueueExclusions(); len(queues) != 0 {
+		t.Errorf("expected exclusion of the new range to be lifted; got %v", queues)
+	}
+	if queues := store.LookupReplica(roachpb.RKey("a"), nil).QueueExclusions(); len(queues) != 1 {
+		t.Errorf("expected original range to remain excluded; got %v", queues)
+	}
+}
+
 // TestStoreRangeSplitAtTablePrefix verifies a range can be split
 // at TableDataPrefix and still gossip the SystemConfig properly.
 func TestStoreRangeSplitAtTablePrefix(t *testing.T) {
@@ -156,6 +189,31 @@ func TestStoreRangeSplitAtRangeBounds(t *testing.T) {
 	}
 }
 
+// TestStoreRangeSplitGossipsFirstRange verifies that splitting the
+// first range gossips its updated descriptor right away instead of
+// waiting for the periodic gossip.
+func TestStoreRangeSplitGossipsFirstRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	splitKey := roachpb.Key("m")
+	args := adminSplitArgs(roachpb.KeyMin, splitKey)
+	if _, err := client.SendWrapped(rg1(store), nil, &args); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		var desc roachpb.RangeDescriptor
+		if err := store.Gossip().GetInfoProto(gossip.KeyFirstRangeDescriptor, &desc); err != nil {
+			return err
+		}
+		if !bytes.Equal(desc.EndKey, splitKey) {
+			return util.Errorf("expected gossiped first range to end at %q, got %q", splitKey, desc.EndKey)
+		}
+		return nil
+	})
+}
+
 // TestStoreRangeSplitConcurrent verifies that concurrent range splits
 // of the same range are executed serially, and all but the first fail
 // because the split key is invalid after the first split succeeds.
@@ -120,7 +120,7 @@ func createTestStoreWithEngine(t *testing.T, eng engine.Engine, clock *hlc.Clock
 	}
 	localSender.AddStore(store)
 	if bootstrap {
-		if err := store.BootstrapRange(sql.GetInitialSystemValues()); err != nil {
+		if err := store.BootstrapRange(sql.GetInitialSystemValues(), nil); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -396,7 +396,7 @@ func (m *multiTestContext) addStore() {
 
 		// Bootstrap the initial range on the first store
 		if idx == 0 {
-			if err := store.BootstrapRange(sql.GetInitialSystemValues()); err != nil {
+			if err := store.BootstrapRange(sql.GetInitialSystemValues(), nil); err != nil {
 				m.t.Fatal(err)
 			}
 		}
@@ -485,7 +485,7 @@ func (m *multiTestContext) replicateRange(rangeID roachpb.RangeID, sourceStoreIn
 			roachpb.ReplicaDescriptor{
 				NodeID:  m.stores[dest].Ident.NodeID,
 				StoreID: m.stores[dest].Ident.StoreID,
-			}, rng.Desc())
+			}, rng.Desc(), storage.REASON_MANUAL)
 		if err != nil {
 			m.t.Fatal(err)
 		}
@@ -518,7 +518,7 @@ func (m *multiTestContext) unreplicateRange(rangeID roachpb.RangeID, source, des
 		roachpb.ReplicaDescriptor{
 			NodeID:  m.idents[dest].NodeID,
 			StoreID: m.idents[dest].StoreID,
-		}, rng.Desc())
+		}, rng.Desc(), storage.REASON_MANUAL)
 	if err != nil {
 		m.t.Fatal(err)
 	}
@@ -112,12 +112,27 @@ type Engine interface {
 	// with timestamps less than the associated value will be GC'd
 	// during compaction.
 	SetGCTimeouts(minTxnTS, minRCacheTS int64)
+	// CompactRange compacts the specified key range, removing the
+	// tombstones of deleted keys and applying the GC timeouts to the
+	// transaction records and response cache entries within it. Nil start
+	// and end keys stand for the start and end of the engine's keys.
+	CompactRange(start, end roachpb.EncodedKey)
 	// ApproximateSize returns the approximate number of bytes the engine is
 	// using to store data for the given range of keys.
 	ApproximateSize(start, end roachpb.EncodedKey) (uint64, error)
+	// TimeBounds returns the smallest and largest timestamps of the MVCC
+	// versioned values in the given range of keys, as recorded in the
+	// metadata of the files holding them and including the data which
+	// has not been flushed yet. ok is false if the bounds are unknown,
+	// e.g. because the range holds no such values.
+	TimeBounds(start, end roachpb.EncodedKey) (min, max roachpb.Timestamp, ok bool, err error)
 	// Flush causes the engine to write all in-memory data to disk
 	// immediately.
 	Flush() error
+	// VerifyChecksums reads all of the engine's data, verifying the
+	// checksums of the files holding it. It returns an error describing
+	// the first corruption found.
+	VerifyChecksums() error
 	// NewIterator returns a new instance of an Iterator over this
 	// engine. The caller must invoke Iterator.Close() when finished with
 	// the iterator to free resources.
@@ -130,10 +145,13 @@ type Engine interface {
 	NewSnapshot() Engine
 	// NewBatch returns a new instance of a batched engine which wraps
 	// this engine. Batched engines accumulate all mutations and apply
-	// them atomically on a call to Commit().
+	// them atomically on a call to Commit(). A batch created from a
+	// batch is nested within it: it reads the outer batch's mutations
+	// and its Commit() applies to the outer batch.
 	NewBatch() Engine
 	// Commit atomically applies any batched updates to the underlying
-	// engine. This is a noop unless the engine was created via NewBatch().
+	// engine (or, for a nested batch, to the outer batch). This is a
+	// noop unless the engine was created via NewBatch().
 	Commit() error
 	// Defer adds a callback to be run after the batch commits
 	// successfully.  If Commit() fails (or if this engine was not
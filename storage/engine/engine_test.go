@@ -251,6 +251,80 @@ func TestEngineBatch(t *testing.T) {
 	}, t)
 }
 
+// TestEngineNestedBatch verifies that a batch created from a batch
+// observes the outer batch's writes, applies its own writes to the
+// outer batch on commit and runs its deferred functions only once the
+// outer batch commits.
+func TestEngineNestedBatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	runWithAllEngines(func(e Engine, t *testing.T) {
+		outer := e.NewBatch()
+		defer outer.Close()
+		if err := outer.Put(roachpb.EncodedKey("a"), []byte("a")); err != nil {
+			t.Fatal(err)
+		}
+
+		inner := outer.NewBatch()
+		defer inner.Close()
+		if val, err := inner.Get(roachpb.EncodedKey("a")); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(val, []byte("a")) {
+			t.Fatalf("expected nested batch to read %q; got %q", "a", val)
+		}
+		if err := inner.Put(roachpb.EncodedKey("b"), []byte("b")); err != nil {
+			t.Fatal(err)
+		}
+		if err := inner.Clear(roachpb.EncodedKey("a")); err != nil {
+			t.Fatal(err)
+		}
+		kvs, err := Scan(inner, roachpb.EncodedKey("a"), roachpb.EncodedKey("z"), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 1 || !bytes.Equal(kvs[0].Key, roachpb.EncodedKey("b")) {
+			t.Fatalf("expected nested batch to scan only %q; got %v", "b", kvs)
+		}
+		var deferred bool
+		inner.Defer(func() { deferred = true })
+
+		if err := inner.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if deferred {
+			t.Fatal("deferred function ran before outer batch committed")
+		}
+		// The nested batch's writes are visible in the outer batch, but not
+		// in the engine.
+		if val, err := outer.Get(roachpb.EncodedKey("b")); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(val, []byte("b")) {
+			t.Fatalf("expected outer batch to read %q; got %q", "b", val)
+		}
+		if val, err := e.Get(roachpb.EncodedKey("b")); err != nil {
+			t.Fatal(err)
+		} else if val != nil {
+			t.Fatalf("expected engine not to contain %q; got %q", "b", val)
+		}
+
+		if err := outer.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if !deferred {
+			t.Fatal("deferred function did not run after outer batch committed")
+		}
+		if val, err := e.Get(roachpb.EncodedKey("a")); err != nil {
+			t.Fatal(err)
+		} else if val != nil {
+			t.Fatalf("expected %q to be deleted; got %q", "a", val)
+		}
+		if val, err := e.Get(roachpb.EncodedKey("b")); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(val, []byte("b")) {
+			t.Fatalf("expected engine to read %q; got %q", "b", val)
+		}
+	}, t)
+}
+
 func TestEnginePutGetDelete(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	runWithAllEngines(func(engine Engine, t *testing.T) {
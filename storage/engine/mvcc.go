@@ -77,6 +77,13 @@ func (meta MVCCMetadata) IsIntentOf(txn *roachpb.Transaction) bool {
 	return meta.Txn != nil && txn != nil && bytes.Equal(meta.Txn.ID, txn.ID)
 }
 
+// visibleAt returns whether the intent described by meta is visible to
+// reads of its own transaction at the given read sequence, zero meaning
+// that all of the transaction's writes are visible.
+func (meta MVCCMetadata) visibleAt(readSequence uint32) bool {
+	return readSequence == 0 || meta.Txn.Sequence <= readSequence
+}
+
 // Delta returns the difference between two MVCCStats structures.
 func (ms *MVCCStats) Delta(oms *MVCCStats) MVCCStats {
 	result := *ms
@@ -554,6 +561,17 @@ func mvccGetInternal(engine Engine, key roachpb.Key, metaKey roachpb.EncodedKey,
 					txn.Epoch, meta.Txn.Epoch)
 			}
 			valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+		} else if ownIntent && !meta.visibleAt(txn.ReadSequence) {
+			// The intent was written past the transaction's read sequence,
+			// so we read what preceded it instead: the transaction's
+			// earlier write, if there was one, or the latest committed
+			// value.
+			if meta.PrevIntent != nil {
+				*value = *meta.PrevIntent
+				valueKey = latestKey
+			} else {
+				valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+			}
 		} else {
 			var ok bool
 			ok, _, _, err = engine.GetProto(latestKey, value)
@@ -749,6 +767,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 	}
 
 	var meta *MVCCMetadata
+	var prevIntent *MVCCValue
 	var origAgeSeconds int64
 	if ok {
 		// There is existing metadata for this key; ensure our write is permitted.
@@ -767,6 +786,20 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 					txn.Epoch, meta.Txn.Epoch, txn.ID)
 			}
 
+			// Keep the value which reads at the transaction's read sequence
+			// observe: the intent we're replacing if it precedes the read
+			// sequence, or else whatever the intent kept itself.
+			if txn.ReadSequence != 0 && txn.Epoch == meta.Txn.Epoch {
+				if meta.visibleAt(txn.ReadSequence) {
+					prevIntent = &MVCCValue{}
+					if _, _, _, err := engine.GetProto(mvccEncodeTimestamp(metaKey, meta.Timestamp), prevIntent); err != nil {
+						return err
+					}
+				} else {
+					prevIntent = meta.PrevIntent
+				}
+			}
+
 			// We are replacing our own older write intent. If we are
 			// writing at the same timestamp we can simply overwrite it;
 			// otherwise we must explicitly delete the obsolete intent.
@@ -790,7 +823,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 			return nil
 		}
 	}
-	buf.newMeta = MVCCMetadata{Txn: txn, Timestamp: timestamp}
+	buf.newMeta = MVCCMetadata{Txn: txn, Timestamp: timestamp, PrevIntent: prevIntent}
 	newMeta := &buf.newMeta
 
 	// Make sure to zero the redundant timestamp (timestamp is encoded
@@ -835,7 +868,7 @@ func MVCCIncrement(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp roac
 	//   happens, returns an error with an appropriate message.
 	// - Otherwise, either a WriteTooOldError or WriteIntentError is returned,
 	//   depending on whether the newer write is an intent.
-	value, _, err := MVCCGet(engine, key, timestamp, true /* consistent */, txn)
+	value, _, err := MVCCGet(engine, key, timestamp, true /* consistent */, latestReadTxn(txn))
 	if err != nil {
 		return 0, err
 	}
@@ -865,6 +898,18 @@ func MVCCIncrement(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp roac
 	return r, MVCCPut(engine, ms, key, timestamp, newValue, txn)
 }
 
+// latestReadTxn returns the transaction to read the existing value of a key
+// with on behalf of a write. Unlike plain reads, such writes observe all of
+// the transaction's earlier writes, regardless of its read sequence.
+func latestReadTxn(txn *roachpb.Transaction) *roachpb.Transaction {
+	if txn == nil || txn.ReadSequence == 0 {
+		return txn
+	}
+	cpy := *txn
+	cpy.ReadSequence = 0
+	return &cpy
+}
+
 // MVCCConditionalPut sets the value for a specified key only if the
 // expected value matches. If not, the return a ConditionFailedError
 // containing the actual value.
@@ -879,7 +924,7 @@ func MVCCConditionalPut(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp
 	// - If the conditional check succeeds, either a WriteTooOldError or WriteIntentError
 	//   is returned, depending on whether the newer write is an intent.
 	// - If the conditional check fails, a ConditionFailedError is returned.
-	existVal, _, err := MVCCGet(engine, key, timestamp, true /* consistent */, txn)
+	existVal, _, err := MVCCGet(engine, key, timestamp, true /* consistent */, latestReadTxn(txn))
 	if err != nil {
 		return err
 	}
@@ -998,12 +1043,16 @@ func getReverseScanMetaKey(iter Iterator, encEndKey roachpb.EncodedKey) (roachpb
 
 // mvccScanInternal scans the key range [start,end) up to some maximum number
 // of results. Specify max=0 for unbounded scans. Specify reverse=true to scan
-// in descending instead of ascending order.
+// in descending instead of ascending order. Specify keyOnly=true to omit the
+// values of the returned rows, retaining only their timestamps.
 func mvccScanInternal(engine Engine, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp,
-	consistent bool, txn *roachpb.Transaction, reverse bool) ([]roachpb.KeyValue, []roachpb.Intent, error) {
+	consistent bool, txn *roachpb.Transaction, reverse, keyOnly bool) ([]roachpb.KeyValue, []roachpb.Intent, error) {
 	res := []roachpb.KeyValue{}
 	intents, err := MVCCIterate(engine, key, endKey, timestamp, consistent, txn, reverse,
 		func(kv roachpb.KeyValue) (bool, error) {
+			if keyOnly {
+				kv.Value = roachpb.Value{Timestamp: kv.Value.Timestamp}
+			}
 			res = append(res, kv)
 			if max != 0 && max == int64(len(res)) {
 				return true, nil
@@ -1022,7 +1071,15 @@ func mvccScanInternal(engine Engine, key, endKey roachpb.Key, max int64, timesta
 func MVCCScan(engine Engine, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp,
 	consistent bool, txn *roachpb.Transaction) ([]roachpb.KeyValue, []roachpb.Intent, error) {
 	return mvccScanInternal(engine, key, endKey, max, timestamp,
-		consistent, txn, false /* !reverse */)
+		consistent, txn, false /* !reverse */, false /* !keyOnly */)
+}
+
+// MVCCScanKeys is like MVCCScan, but omits the values of the returned
+// rows, retaining only their timestamps.
+func MVCCScanKeys(engine Engine, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp,
+	consistent bool, txn *roachpb.Transaction) ([]roachpb.KeyValue, []roachpb.Intent, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp,
+		consistent, txn, false /* !reverse */, true /* keyOnly */)
 }
 
 // MVCCReverseScan scans the key range [start,end) key up to some maximum number of
@@ -1030,7 +1087,15 @@ func MVCCScan(engine Engine, key, endKey roachpb.Key, max int64, timestamp roach
 func MVCCReverseScan(engine Engine, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp,
 	consistent bool, txn *roachpb.Transaction) ([]roachpb.KeyValue, []roachpb.Intent, error) {
 	return mvccScanInternal(engine, key, endKey, max, timestamp,
-		consistent, txn, true /* reverse */)
+		consistent, txn, true /* reverse */, false /* !keyOnly */)
+}
+
+// MVCCReverseScanKeys is like MVCCReverseScan, but omits the values of
+// the returned rows, retaining only their timestamps.
+func MVCCReverseScanKeys(engine Engine, key, endKey roachpb.Key, max int64, timestamp roachpb.Timestamp,
+	consistent bool, txn *roachpb.Transaction) ([]roachpb.KeyValue, []roachpb.Intent, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp,
+		consistent, txn, true /* reverse */, true /* keyOnly */)
 }
 
 // MVCCIterate iterates over the key range [start,end). At each step of the
@@ -1114,6 +1179,9 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 	// Gathers up all the intents from WriteIntentErrors. We only get those if
 	// the scan is consistent.
 	var wiErr error
+	// Scratch space for computing key.Next() when advancing the iterator,
+	// reused across iterations to avoid an allocation per row.
+	var nextBuf []byte
 
 	for {
 		key, metaKey, err := getMetaKey(iter, encEndKey)
@@ -1173,7 +1241,8 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 			}
 
 		} else {
-			iter.Seek(mvccEncodeKey(keyBuf, key.Next()))
+			nextBuf = key.NextInto(nextBuf)
+			iter.Seek(mvccEncodeKey(keyBuf, nextBuf))
 			if !iter.Valid() {
 				if err := iter.Error(); err != nil {
 					return nil, err
@@ -1186,6 +1255,89 @@ func MVCCIterate(engine Engine, startKey, endKey roachpb.Key, timestamp roachpb.
 	return intents, wiErr
 }
 
+// MVCCIterateChanges iterates over the keys in [startKey, endKey) whose
+// latest version as of endTime was written after startTime. At each step of
+// the iteration, f() is invoked with the key and its value as of endTime,
+// which is nil if the key was deleted. Deletions are only reported if
+// startTime is not zero, as there is nothing to delete for a full
+// iteration. If f returns true (done) or an error, the iteration stops and
+// the error is propagated. Inline values are skipped. Intents at or below
+// endTime are returned as a WriteIntentError, as the values of their keys
+// are not known until their transactions have finished.
+func MVCCIterateChanges(engine Engine, startKey, endKey roachpb.Key, startTime, endTime roachpb.Timestamp,
+	f func(roachpb.Key, *roachpb.Value) (bool, error)) error {
+	if len(endKey) == 0 {
+		return emptyKeyError()
+	}
+
+	iter := engine.NewIterator()
+	defer iter.Close()
+
+	encEndKey := MVCCEncodeKey(endKey)
+	var meta MVCCMetadata
+	var value MVCCValue
+	var wiErr *roachpb.WriteIntentError
+	for iter.Seek(MVCCEncodeKey(startKey)); iter.Valid(); {
+		key, metaKey, err := getScanMetaKey(iter, encEndKey)
+		if err != nil {
+			return err
+		}
+		// Exceeding the boundary.
+		if key == nil && metaKey == nil {
+			break
+		}
+		if err := iter.ValueProto(&meta); err != nil {
+			return err
+		}
+
+		if meta.Txn != nil && !endTime.Less(meta.Timestamp) {
+			// Accumulate the intents, but continue the iteration.
+			if wiErr == nil {
+				wiErr = &roachpb.WriteIntentError{}
+			}
+			wiErr.Intents = append(wiErr.Intents, roachpb.Intent{Key: key, Txn: *meta.Txn})
+		} else if !meta.IsInline() && startTime.Less(meta.Timestamp) {
+			// The latest version at or below endTime decides whether the key
+			// changed since startTime.
+			iter.Seek(MVCCEncodeVersionKey(key, endTime))
+			if !iter.Valid() {
+				break
+			}
+			versionKey, ts, isValue, err := MVCCDecodeKey(iter.Key())
+			if err != nil {
+				return err
+			}
+			if isValue && versionKey.Equal(key) && startTime.Less(ts) {
+				if err := iter.ValueProto(&value); err != nil {
+					return err
+				}
+				var v *roachpb.Value
+				if !value.Deleted {
+					v = value.Value
+					v.Timestamp = &ts
+					if err := v.Verify(key); err != nil {
+						return err
+					}
+				}
+				if v != nil || !startTime.Equal(roachpb.ZeroTimestamp) {
+					if done, err := f(key, v); err != nil || done {
+						return err
+					}
+				}
+			}
+		}
+
+		iter.Seek(MVCCEncodeKey(key.Next()))
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if wiErr != nil {
+		return wiErr
+	}
+	return nil
+}
+
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
 // extant write intent for a given txn according to commit parameter.
 // ResolveWriteIntent will skip write intents of other txns.
@@ -1245,9 +1397,14 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 		newMeta := *meta
 		newMeta.Timestamp = txn.Timestamp
 		if pushed { // keep intent if we're pushing timestamp
-			newMeta.Txn = txn
+			// The sequence the intent was written at determines whether
+			// the transaction's own reads observe it; keep it.
+			pushedTxn := *txn
+			pushedTxn.Sequence = meta.Txn.Sequence
+			newMeta.Txn = &pushedTxn
 		} else {
 			newMeta.Txn = nil
+			newMeta.PrevIntent = nil
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
 		if err != nil {
@@ -1358,6 +1515,9 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey roach
 	encKey := MVCCEncodeKey(key)
 	encEndKey := MVCCEncodeKey(endKey)
 	nextKey := encKey
+	// Buffers reused across iterations for computing and encoding the
+	// successor of each resolved key.
+	var nextBuf, encBuf []byte
 
 	num := int64(0)
 	for {
@@ -1389,7 +1549,9 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey roach
 
 		// In order to efficiently skip the possibly long list of
 		// old versions for this key, we make a non-version MVCC key.
-		nextKey = MVCCEncodeKey(currentKey.Next())
+		nextBuf = currentKey.NextInto(nextBuf)
+		encBuf = mvccEncodeKey(encBuf[:0], nextBuf)
+		nextKey = encBuf
 	}
 
 	return num, nil
@@ -60,6 +60,12 @@ type MVCCMetadata struct {
 	// is only a single MVCC metadata row with value inlined, and with
 	// empty timestamp, key_bytes, and val_bytes.
 	Value *cockroach_roachpb1.Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	// For an intent overwriting an earlier intent of the same transaction,
+	// the value of the transaction's latest write preceding its current read
+	// sequence, which reads at that sequence observe instead of the intent.
+	// Nil if there was no such write, in which case those reads observe the
+	// latest committed value.
+	PrevIntent *MVCCValue `protobuf:"bytes,7,opt,name=prev_intent" json:"prev_intent,omitempty"`
 }
 
 func (m *MVCCMetadata) Reset()         { *m = MVCCMetadata{} }
@@ -189,6 +195,16 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.PrevIntent != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintMvcc(data, i, uint64(m.PrevIntent.Size()))
+		n5, err := m.PrevIntent.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -305,6 +321,10 @@ func (m *MVCCMetadata) Size() (n int) {
 		l = m.Value.Size()
 		n += 1 + l + sovMvcc(uint64(l))
 	}
+	if m.PrevIntent != nil {
+		l = m.PrevIntent.Size()
+		n += 1 + l + sovMvcc(uint64(l))
+	}
 	return n
 }
 
@@ -626,6 +646,39 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMvcc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMvcc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevIntent == nil {
+				m.PrevIntent = &MVCCValue{}
+			}
+			if err := m.PrevIntent.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMvcc(data[iNdEx:])
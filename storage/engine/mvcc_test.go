@@ -1329,6 +1329,51 @@ func TestMVCCReverseScan(t *testing.T) {
 	}
 }
 
+// TestMVCCScanKeys verifies that key-only scans return the same keys and
+// timestamps as regular scans, but omit values.
+func TestMVCCScanKeys(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	for i, key := range []roachpb.Key{testKey1, testKey2, testKey3} {
+		if err := MVCCPut(engine, nil, key, makeTS(int64(i+1), 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, reverse := range []bool{false, true} {
+		scan, scanKeys := MVCCScan, MVCCScanKeys
+		if reverse {
+			scan, scanKeys = MVCCReverseScan, MVCCReverseScanKeys
+		}
+		expected, _, err := scan(engine, testKey1, keyMax, 2, makeTS(5, 0), true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kvs, _, err := scanKeys(engine, testKey1, keyMax, 2, makeTS(5, 0), true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != len(expected) || len(kvs) != 2 {
+			t.Fatalf("reverse=%t: expected %d rows, got %d", reverse, len(expected), len(kvs))
+		}
+		for i, kv := range kvs {
+			if !bytes.Equal(kv.Key, expected[i].Key) {
+				t.Errorf("reverse=%t: %d: expected key %q, got %q", reverse, i, expected[i].Key, kv.Key)
+			}
+			if !kv.Value.Timestamp.Equal(*expected[i].Value.Timestamp) {
+				t.Errorf("reverse=%t: %d: expected timestamp %s, got %s",
+					reverse, i, expected[i].Value.Timestamp, kv.Value.Timestamp)
+			}
+			if kv.Value.RawBytes != nil {
+				t.Errorf("reverse=%t: %d: expected no value, got %q", reverse, i, kv.Value.RawBytes)
+			}
+		}
+	}
+}
+
 func TestMVCCResolveTxn(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
@@ -1615,6 +1660,81 @@ func TestMVCCReadWithPushedTimestamp(t *testing.T) {
 	}
 }
 
+// TestMVCCReadSequence verifies that reads of a transaction observe only
+// its own writes which precede its read sequence, while writes reading the
+// existing value observe all of them.
+func TestMVCCReadSequence(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	txnAt := func(seq, readSeq uint32) *roachpb.Transaction {
+		txn := makeTxn(txn1, makeTS(2, 0))
+		txn.Sequence = seq
+		txn.ReadSequence = readSeq
+		return txn
+	}
+	expectValue := func(txn *roachpb.Transaction, expected roachpb.Value) {
+		value, _, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value == nil || !bytes.Equal(value.RawBytes, expected.RawBytes) {
+			t.Errorf("expected value %q; got %+v", expected.RawBytes, value)
+		}
+		kvs, _, err := MVCCScan(engine, testKey1, testKey2, 0, makeTS(2, 0), true, txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 1 || !bytes.Equal(kvs[0].Value.RawBytes, expected.RawBytes) {
+			t.Errorf("expected scan to return %q; got %+v", expected.RawBytes, kvs)
+		}
+	}
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// First statement: the transaction's write isn't visible at the read
+	// sequence preceding it, unless the read sequence isn't used.
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value2, txnAt(2, 1)); err != nil {
+		t.Fatal(err)
+	}
+	expectValue(txnAt(2, 1), value1)
+	expectValue(txnAt(2, 0), value2)
+
+	// Second statement: the write of the first statement is visible, but
+	// neither of the overwrites of the second statement is.
+	expectValue(txnAt(4, 3), value2)
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value3, txnAt(4, 3)); err != nil {
+		t.Fatal(err)
+	}
+	expectValue(txnAt(4, 3), value2)
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value4, txnAt(5, 3)); err != nil {
+		t.Fatal(err)
+	}
+	expectValue(txnAt(5, 3), value2)
+
+	// Conditional puts observe the latest write.
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(2, 0), value3, &value4, txnAt(6, 3)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Committing drops the value kept for reads at earlier sequences.
+	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(2, 0), makeTxn(txn1Commit, makeTS(2, 0))); err != nil {
+		t.Fatal(err)
+	}
+	meta := &MVCCMetadata{}
+	if _, _, _, err := engine.GetProto(MVCCEncodeKey(testKey1), meta); err != nil {
+		t.Fatal(err)
+	}
+	if meta.Txn != nil || meta.PrevIntent != nil {
+		t.Errorf("expected resolved metadata; got %+v", meta)
+	}
+	expectValue(nil, value3)
+}
+
 func TestMVCCResolveWithDiffEpochs(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
@@ -2672,3 +2792,86 @@ func BenchmarkMVCCStats(b *testing.B) {
 
 	b.StopTimer()
 }
+
+// TestMVCCIterateChanges verifies that only the keys changed after the
+// start time are visited, with their values as of the end time, and that
+// deletions are only reported for incremental iterations.
+func TestMVCCIterateChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(3, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(3, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Written after the end time of all iterations below.
+	if err := MVCCPut(engine, nil, testKey3, makeTS(5, 0), value4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		startTime, endTime roachpb.Timestamp
+		expKeys            []roachpb.Key
+		expValues          []*roachpb.Value
+	}{
+		// A full iteration as of before the later writes.
+		{roachpb.ZeroTimestamp, makeTS(2, 0), []roachpb.Key{testKey1, testKey2},
+			[]*roachpb.Value{&value1, &value2}},
+		// A full iteration skips the deleted key.
+		{roachpb.ZeroTimestamp, makeTS(4, 0), []roachpb.Key{testKey1, testKey3},
+			[]*roachpb.Value{&value3, &value3}},
+		// An incremental iteration reports the deletion.
+		{makeTS(2, 0), makeTS(4, 0), []roachpb.Key{testKey1, testKey2, testKey3},
+			[]*roachpb.Value{&value3, nil, &value3}},
+		// Nothing changed in (1, 2].
+		{makeTS(1, 0), makeTS(2, 0), nil, nil},
+	}
+	for i, test := range testCases {
+		var keys []roachpb.Key
+		var values []*roachpb.Value
+		if err := MVCCIterateChanges(engine, testKey1, testKey4, test.startTime, test.endTime,
+			func(key roachpb.Key, value *roachpb.Value) (bool, error) {
+				keys = append(keys, key)
+				values = append(values, value)
+				return false, nil
+			}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if len(keys) != len(test.expKeys) {
+			t.Fatalf("%d: expected keys %s; got %s", i, test.expKeys, keys)
+		}
+		for j := range keys {
+			if !keys[j].Equal(test.expKeys[j]) {
+				t.Errorf("%d: expected key %s; got %s", i, test.expKeys[j], keys[j])
+			}
+			if (values[j] == nil) != (test.expValues[j] == nil) {
+				t.Errorf("%d: expected value %v for %s; got %v", i, test.expValues[j], keys[j], values[j])
+			} else if values[j] != nil && !bytes.Equal(values[j].RawBytes, test.expValues[j].RawBytes) {
+				t.Errorf("%d: expected value %q for %s; got %q", i, test.expValues[j].RawBytes, keys[j], values[j].RawBytes)
+			}
+		}
+	}
+
+	// An intent below the end time is reported.
+	if err := MVCCPut(engine, nil, testKey2, makeTS(4, 0), value1, txn1); err != nil {
+		t.Fatal(err)
+	}
+	err := MVCCIterateChanges(engine, testKey1, testKey4, roachpb.ZeroTimestamp, makeTS(4, 0),
+		func(roachpb.Key, *roachpb.Value) (bool, error) { return false, nil })
+	if wiErr, ok := err.(*roachpb.WriteIntentError); !ok || len(wiErr.Intents) != 1 {
+		t.Fatalf("expected a WriteIntentError for one intent; got %v", err)
+	}
+}
@@ -330,11 +330,36 @@ func (r *RocksDB) ApproximateSize(start, end roachpb.EncodedKey) (uint64, error)
 	return uint64(C.DBApproximateSize(r.rdb, goToCSlice(start), goToCSlice(end))), nil
 }
 
+// TimeBounds returns the smallest and largest timestamps of the MVCC
+// versioned values in the given range of keys. It consults the table
+// properties of the overlapping sstables and the memtables only, and so
+// is cheap regardless of the amount of flushed data in the range.
+func (r *RocksDB) TimeBounds(start, end roachpb.EncodedKey) (min, max roachpb.Timestamp, ok bool, err error) {
+	var cMin, cMax C.DBTimestamp
+	var found C.bool
+	if err := statusToError(C.DBGetTimeBounds(r.rdb, goToCSlice(start), goToCSlice(end),
+		&cMin, &cMax, &found)); err != nil {
+		return roachpb.Timestamp{}, roachpb.Timestamp{}, false, err
+	}
+	if !found {
+		return roachpb.Timestamp{}, roachpb.Timestamp{}, false, nil
+	}
+	min = roachpb.Timestamp{WallTime: int64(cMin.wall_time), Logical: int32(cMin.logical)}
+	max = roachpb.Timestamp{WallTime: int64(cMax.wall_time), Logical: int32(cMax.logical)}
+	return min, max, true, nil
+}
+
 // Flush causes RocksDB to write all in-memory data to disk immediately.
 func (r *RocksDB) Flush() error {
 	return statusToError(C.DBFlush(r.rdb))
 }
 
+// VerifyChecksums reads all of the data in RocksDB, verifying the
+// checksums of the sstable blocks holding it.
+func (r *RocksDB) VerifyChecksums() error {
+	return statusToError(C.DBVerifyChecksums(r.rdb))
+}
+
 // goToCSlice converts a go byte slice to a DBSlice. Note that this is
 // potentially dangerous as the DBSlice holds a reference to the go
 // byte slice memory that the Go GC does not know about. This method
@@ -504,17 +529,32 @@ func (r *rocksDBSnapshot) Capacity() (roachpb.StoreCapacity, error) {
 func (r *rocksDBSnapshot) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 }
 
+// CompactRange is a noop for a snapshot.
+func (r *rocksDBSnapshot) CompactRange(start, end roachpb.EncodedKey) {
+}
+
 // ApproximateSize returns the approximate number of bytes the engine is
 // using to store data for the given range of keys.
 func (r *rocksDBSnapshot) ApproximateSize(start, end roachpb.EncodedKey) (uint64, error) {
 	return r.parent.ApproximateSize(start, end)
 }
 
+// TimeBounds returns the time bounds of the parent engine, which may
+// include data written after the snapshot was taken.
+func (r *rocksDBSnapshot) TimeBounds(start, end roachpb.EncodedKey) (roachpb.Timestamp, roachpb.Timestamp, bool, error) {
+	return r.parent.TimeBounds(start, end)
+}
+
 // Flush is a no-op for snapshots.
 func (r *rocksDBSnapshot) Flush() error {
 	return nil
 }
 
+// VerifyChecksums verifies the checksums of the parent engine.
+func (r *rocksDBSnapshot) VerifyChecksums() error {
+	return r.parent.VerifyChecksums()
+}
+
 // NewIterator returns a new instance of an Iterator over the
 // engine using the snapshot handle.
 func (r *rocksDBSnapshot) NewIterator() Iterator {
@@ -543,6 +583,8 @@ func (r *rocksDBSnapshot) Defer(func()) {
 
 type rocksDBBatch struct {
 	parent *RocksDB
+	// outer is the batch this batch is nested within, if any.
+	outer  *rocksDBBatch
 	batch  *C.DBBatch
 	defers []func()
 }
@@ -554,6 +596,17 @@ func newRocksDBBatch(r *RocksDB) *rocksDBBatch {
 	}
 }
 
+// newRocksDBNestedBatch returns a batch nested within the supplied
+// batch. Reads observe the updates of the outer batch, and Commit
+// applies the nested batch's updates to the outer batch.
+func newRocksDBNestedBatch(outer *rocksDBBatch) *rocksDBBatch {
+	return &rocksDBBatch{
+		parent: outer.parent,
+		outer:  outer,
+		batch:  C.DBNewNestedBatch(outer.batch),
+	}
+}
+
 func (r *rocksDBBatch) Open() error {
 	return util.Errorf("cannot open a batch")
 }
@@ -663,14 +716,26 @@ func (r *rocksDBBatch) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 	// no-op
 }
 
+func (r *rocksDBBatch) CompactRange(start, end roachpb.EncodedKey) {
+	// no-op
+}
+
 func (r *rocksDBBatch) ApproximateSize(start, end roachpb.EncodedKey) (uint64, error) {
 	return r.parent.ApproximateSize(start, end)
 }
 
+func (r *rocksDBBatch) TimeBounds(start, end roachpb.EncodedKey) (roachpb.Timestamp, roachpb.Timestamp, bool, error) {
+	return r.parent.TimeBounds(start, end)
+}
+
 func (r *rocksDBBatch) Flush() error {
 	return util.Errorf("cannot flush a batch")
 }
 
+func (r *rocksDBBatch) VerifyChecksums() error {
+	return r.parent.VerifyChecksums()
+}
+
 func (r *rocksDBBatch) NewIterator() Iterator {
 	return &rocksDBIterator{
 		iter: C.DBBatchNewIter(r.parent.rdb, r.batch),
@@ -681,8 +746,10 @@ func (r *rocksDBBatch) NewSnapshot() Engine {
 	panic("cannot create a NewSnapshot from a batch")
 }
 
+// NewBatch returns a batch nested within this batch. See
+// newRocksDBNestedBatch.
 func (r *rocksDBBatch) NewBatch() Engine {
-	return newRocksDBBatch(r.parent)
+	return newRocksDBNestedBatch(r)
 }
 
 func (r *rocksDBBatch) Commit() error {
@@ -695,6 +762,14 @@ func (r *rocksDBBatch) Commit() error {
 	C.DBBatchDestroy(r.batch)
 	r.batch = nil
 
+	// The deferred functions of a nested batch are run when the outer
+	// batch commits.
+	if r.outer != nil {
+		r.outer.defers = append(r.outer.defers, r.defers...)
+		r.defers = nil
+		return nil
+	}
+
 	// On success, run the deferred functions in reverse order.
 	for i := len(r.defers) - 1; i >= 0; i-- {
 		r.defers[i]()
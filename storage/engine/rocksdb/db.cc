@@ -26,6 +26,7 @@
 #include "rocksdb/merge_operator.h"
 #include "rocksdb/options.h"
 #include "rocksdb/table.h"
+#include "rocksdb/table_properties.h"
 #include "rocksdb/utilities/write_batch_with_index.h"
 #include "cockroach/roachpb/api.pb.h"
 #include "cockroach/roachpb/data.pb.h"
@@ -39,10 +40,14 @@ extern "C" {
 
 struct DBBatch {
   int updates;
+  // The batch this batch is nested within, or NULL if the batch is
+  // written directly to the engine.
+  DBBatch* parent;
   rocksdb::WriteBatchWithIndex rep;
 
   DBBatch()
-      : updates(0) {
+      : updates(0),
+        parent(NULL) {
   }
 };
 
@@ -263,6 +268,123 @@ class DBCompactionFilter : public rocksdb::CompactionFilter {
   const int64_t min_rcache_ts_;
 };
 
+// The names of the table properties recorded by
+// TimeBoundTblPropCollector.
+const char kFirstKeyProp[] = "crdb.key.first";
+const char kLastKeyProp[] = "crdb.key.last";
+const char kMinTimestampProp[] = "crdb.ts.min";
+const char kMaxTimestampProp[] = "crdb.ts.max";
+
+// The size of the timestamp suffix of MVCC versioned keys: a
+// decreasing encoding of the 8 byte wall time and 4 byte logical
+// component.
+const int kMVCCVersionTimestampSize = 12;
+
+// DecodeTimestamp decodes the timestamp suffix of an MVCC versioned
+// key. The suffix must be kMVCCVersionTimestampSize bytes long.
+DBTimestamp DecodeTimestamp(const rocksdb::Slice& s) {
+  const uint8_t* p = reinterpret_cast<const uint8_t*>(s.data());
+  uint64_t wall_time = 0;
+  for (int i = 0; i < 8; i++) {
+    wall_time = (wall_time << 8) | p[i];
+  }
+  uint32_t logical = 0;
+  for (int i = 8; i < kMVCCVersionTimestampSize; i++) {
+    logical = (logical << 8) | p[i];
+  }
+  DBTimestamp ts;
+  ts.wall_time = static_cast<int64_t>(~wall_time);
+  ts.logical = static_cast<int32_t>(~logical);
+  return ts;
+}
+
+// UpdateTimeBounds widens the encoded time bounds *ts_min and *ts_max,
+// which are empty if not known yet, to include the encoded timestamps min
+// and max. Encoded timestamps sort in decreasing order.
+void UpdateTimeBounds(const rocksdb::Slice& min, const rocksdb::Slice& max,
+                      std::string* ts_min, std::string* ts_max) {
+  if (ts_min->empty() || min.compare(*ts_min) > 0) {
+    *ts_min = min.ToString();
+  }
+  if (ts_max->empty() || max.compare(*ts_max) < 0) {
+    *ts_max = max.ToString();
+  }
+}
+
+// TimeBoundTblPropCollector records the first and last key of each
+// sstable along with the smallest and largest timestamps of the MVCC
+// versioned values it contains. This allows cheaply determining whether
+// a key range holds any data older than a given timestamp (see
+// DBGetTimeBounds). The timestamps are stored in their encoded form;
+// since the encoding is decreasing, the smallest encoded suffix belongs
+// to the largest timestamp.
+class TimeBoundTblPropCollector : public rocksdb::TablePropertiesCollector {
+ public:
+  TimeBoundTblPropCollector()
+      : has_keys_(false) {
+  }
+
+  virtual const char* Name() const {
+    return "TimeBoundTblPropCollector";
+  }
+
+  virtual rocksdb::Status AddUserKey(const rocksdb::Slice& user_key,
+                                     const rocksdb::Slice& value,
+                                     rocksdb::EntryType type,
+                                     rocksdb::SequenceNumber seq,
+                                     uint64_t file_size) {
+    if (!has_keys_) {
+      first_key_ = user_key.ToString();
+      has_keys_ = true;
+    }
+    last_key_ = user_key.ToString();
+
+    rocksdb::Slice key(user_key);
+    std::string decoded;
+    if (!DecodeBytes(&key, &decoded) || key.size() != kMVCCVersionTimestampSize) {
+      // Not a versioned value.
+      return rocksdb::Status::OK();
+    }
+    UpdateTimeBounds(key, key, &ts_min_, &ts_max_);
+    return rocksdb::Status::OK();
+  }
+
+  virtual rocksdb::Status Finish(rocksdb::UserCollectedProperties* properties) {
+    if (!has_keys_) {
+      return rocksdb::Status::OK();
+    }
+    (*properties)[kFirstKeyProp] = first_key_;
+    (*properties)[kLastKeyProp] = last_key_;
+    if (!ts_min_.empty()) {
+      (*properties)[kMinTimestampProp] = ts_min_;
+      (*properties)[kMaxTimestampProp] = ts_max_;
+    }
+    return rocksdb::Status::OK();
+  }
+
+  virtual rocksdb::UserCollectedProperties GetReadableProperties() const {
+    return rocksdb::UserCollectedProperties{};
+  }
+
+ private:
+  bool has_keys_;
+  std::string first_key_;
+  std::string last_key_;
+  std::string ts_min_;
+  std::string ts_max_;
+};
+
+class TimeBoundTblPropCollectorFactory : public rocksdb::TablePropertiesCollectorFactory {
+ public:
+  virtual rocksdb::TablePropertiesCollector* CreateTablePropertiesCollector() {
+    return new TimeBoundTblPropCollector();
+  }
+
+  virtual const char* Name() const {
+    return "TimeBoundTblPropCollectorFactory";
+  }
+};
+
 class DBCompactionFilterFactory : public rocksdb::CompactionFilterFactory {
  public:
   DBCompactionFilterFactory() {}
@@ -723,6 +845,49 @@ struct EngineGetter : public Getter {
   DBSlice const key_;
 };
 
+// BatchGetter is an implementation of the Getter interface which
+// retrieves the value for the supplied key from a batch, taking into
+// account the updates contained in the batch and its ancestors.
+struct BatchGetter : public Getter {
+  BatchGetter(DBEngine* db, DBBatch* batch, DBSlice key)
+      : db_(db),
+        batch_(batch),
+        key_(key) {
+  }
+
+  virtual DBStatus Get(DBString* value) {
+    value->data = NULL;
+    value->len = 0;
+    return DBBatchGet(db_, batch_, key_, value);
+  }
+
+  DBEngine* const db_;
+  DBBatch* const batch_;
+  DBSlice const key_;
+};
+
+// BatchInserter is a WriteBatch handler which replays the updates of
+// a nested batch into its parent batch.
+class BatchInserter : public rocksdb::WriteBatch::Handler {
+ public:
+  BatchInserter(DBBatch* batch)
+      : batch_(batch) {
+  }
+
+  virtual void Put(const rocksdb::Slice& key, const rocksdb::Slice& value) {
+    DBBatchPut(batch_, ToDBSlice(key), ToDBSlice(value));
+  }
+  virtual void Merge(const rocksdb::Slice& key, const rocksdb::Slice& value) {
+    DBBatchMerge(batch_, ToDBSlice(key), ToDBSlice(value));
+  }
+  virtual void Delete(const rocksdb::Slice& key) {
+    DBBatchDelete(batch_, ToDBSlice(key));
+  }
+
+ private:
+  DBBatch* const batch_;
+};
+
 // ProcessDeltaKey performs the heavy lifting of processing the deltas
 // for "key" contained in a batch and determining what the resulting
 // value is. "delta" should have been seeked to "key", but may not be
@@ -1040,6 +1205,8 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   options.info_log.reset(new DBLogger(db_opts.logging_enabled));
   options.merge_operator.reset(new DBMergeOperator);
   options.table_factory.reset(rocksdb::NewBlockBasedTableFactory(table_options));
+  options.table_properties_collector_factories.emplace_back(
+      new TimeBoundTblPropCollectorFactory);
   options.write_buffer_size = 64 << 20;           // 64 MB
   options.target_file_size_base = 64 << 20;       // 64 MB
   options.max_bytes_for_level_base = 512 << 20;   // 512 MB
@@ -1078,6 +1245,16 @@ DBStatus DBFlush(DBEngine* db) {
   return ToDBStatus(db->rep->Flush(options));
 }
 
+DBStatus DBVerifyChecksums(DBEngine* db) {
+  rocksdb::ReadOptions options;
+  options.verify_checksums = true;
+  options.fill_cache = false;
+  std::unique_ptr<rocksdb::Iterator> iter(db->rep->NewIterator(options));
+  for (iter->SeekToFirst(); iter->Valid(); iter->Next()) {
+  }
+  return ToDBStatus(iter->status());
+}
+
 void DBSetGCTimeouts(DBEngine * db, int64_t min_txn_ts, int64_t min_rcache_ts) {
   DBCompactionFilterFactory *db_cff =
       (DBCompactionFilterFactory*)db->rep->GetOptions().compaction_filter_factory.get();
@@ -1107,6 +1284,66 @@ uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end) {
   return result;
 }
 
+DBStatus DBGetTimeBounds(DBEngine* db, DBSlice start, DBSlice end,
+                         DBTimestamp* min, DBTimestamp* max, bool* found) {
+  *found = false;
+  const rocksdb::Slice start_key = ToSlice(start);
+  const rocksdb::Slice end_key = ToSlice(end);
+
+  // Only the properties of the sstables overlapping the range are read.
+  rocksdb::TablePropertiesCollection props;
+  const rocksdb::Range range(start_key, end_key);
+  rocksdb::Status status = db->rep->GetPropertiesOfTablesInRange(
+      db->rep->DefaultColumnFamily(), &range, 1, &props);
+  if (!status.ok()) {
+    return ToDBStatus(status);
+  }
+
+  std::string ts_min;
+  std::string ts_max;
+  for (const auto& p : props) {
+    const rocksdb::UserCollectedProperties& user_props =
+        p.second->user_collected_properties;
+    if (user_props.find(kFirstKeyProp) == user_props.end()) {
+      // The table was written before the properties were collected,
+      // so nothing is known about its timestamps.
+      return kSuccess;
+    }
+    auto tmin = user_props.find(kMinTimestampProp);
+    auto tmax = user_props.find(kMaxTimestampProp);
+    if (tmin == user_props.end() || tmax == user_props.end()) {
+      continue;
+    }
+    UpdateTimeBounds(tmin->second, tmax->second, &ts_min, &ts_max);
+  }
+
+  // The data which has not been flushed yet is read from the memtables,
+  // which hold a small amount of data compared to the sstables.
+  rocksdb::ReadOptions read_opts;
+  read_opts.read_tier = rocksdb::kMemtableTier;
+  std::unique_ptr<rocksdb::Iterator> iter(db->rep->NewIterator(read_opts));
+  for (iter->Seek(start_key); iter->Valid() && iter->key().compare(end_key) < 0; iter->Next()) {
+    rocksdb::Slice key(iter->key());
+    std::string decoded;
+    if (!DecodeBytes(&key, &decoded) || key.size() != kMVCCVersionTimestampSize) {
+      // Not a versioned value.
+      continue;
+    }
+    UpdateTimeBounds(key, key, &ts_min, &ts_max);
+  }
+  if (!iter->status().ok()) {
+    return ToDBStatus(iter->status());
+  }
+
+  if (ts_min.empty()) {
+    return kSuccess;
+  }
+  *min = DecodeTimestamp(ts_min);
+  *max = DecodeTimestamp(ts_max);
+  *found = true;
+  return kSuccess;
+}
+
 DBStatus DBPut(DBEngine* db, DBSlice key, DBSlice value) {
   rocksdb::WriteOptions options;
   return ToDBStatus(db->rep->Put(options, ToSlice(key), ToSlice(value)));
@@ -1143,6 +1380,12 @@ DBStatus DBWrite(DBEngine* db, DBBatch *batch) {
   if (batch->updates == 0) {
     return kSuccess;
   }
+  if (batch->parent != NULL) {
+    // A nested batch is written to its parent, which is in turn
+    // written to the engine when it is committed.
+    BatchInserter inserter(batch->parent);
+    return ToDBStatus(batch->rep.GetWriteBatch()->Iterate(&inserter));
+  }
   rocksdb::WriteOptions options;
   return ToDBStatus(db->rep->Write(options, batch->rep.GetWriteBatch()));
 }
@@ -1210,6 +1453,12 @@ DBBatch* DBNewBatch() {
   return new DBBatch;
 }
 
+DBBatch* DBNewNestedBatch(DBBatch* parent) {
+  DBBatch* batch = new DBBatch;
+  batch->parent = parent;
+  return batch;
+}
+
 void DBBatchDestroy(DBBatch* batch) {
   delete batch;
 }
@@ -1226,12 +1475,19 @@ void DBBatchMerge(DBBatch* batch, DBSlice key, DBSlice value) {
 
 DBStatus DBBatchGet(DBEngine* db, DBBatch* batch, DBSlice key, DBString* value) {
   if (batch->updates == 0) {
+    if (batch->parent != NULL) {
+      return DBBatchGet(db, batch->parent, key, value);
+    }
     return DBGet(db, NULL, key, value);
   }
 
   std::unique_ptr<rocksdb::WBWIIterator> iter(batch->rep.NewIterator());
   rocksdb::Slice rkey(ToSlice(key));
   iter->Seek(rkey);
+  if (batch->parent != NULL) {
+    BatchGetter base(db, batch->parent, key);
+    return ProcessDeltaKey(&base, iter.get(), rkey, value);
+  }
   EngineGetter base(db, key);
   return ProcessDeltaKey(&base, iter.get(), rkey, value);
 }
@@ -1245,11 +1501,23 @@ DBIterator* DBBatchNewIter(DBEngine* db, DBBatch* batch) {
   if (batch->updates == 0) {
     // Don't bother to create a batch iterator if the batch contains
     // no updates.
+    if (batch->parent != NULL) {
+      return DBBatchNewIter(db, batch->parent);
+    }
     return DBNewIter(db, NULL);
   }
 
   DBIterator* iter = new DBIterator;
-  rocksdb::Iterator* base = db->rep->NewIterator(MakeReadOptions(NULL));
+  rocksdb::Iterator* base;
+  if (batch->parent != NULL) {
+    // Iterate over the parent batch (and, transitively, the engine),
+    // taking ownership of the parent's underlying iterator.
+    DBIterator* parent_iter = DBBatchNewIter(db, batch->parent);
+    base = parent_iter->rep;
+    delete parent_iter;
+  } else {
+    base = db->rep->NewIterator(MakeReadOptions(NULL));
+  }
   rocksdb::WBWIIterator *delta = batch->rep.NewIterator();
   iter->rep = new BaseDeltaIterator(base, delta);
   return iter;
@@ -48,6 +48,13 @@ typedef struct DBEngine DBEngine;
 typedef struct DBIterator DBIterator;
 typedef struct DBSnapshot DBSnapshot;
 
+// A DBTimestamp is the wall time and logical component of an MVCC
+// timestamp.
+typedef struct {
+  int64_t wall_time;
+  int32_t logical;
+} DBTimestamp;
+
 // DBOptions contains local database options.
 typedef struct {
   int64_t cache_size;
@@ -70,6 +77,10 @@ void DBClose(DBEngine* db);
 // complete.
 DBStatus DBFlush(DBEngine* db);
 
+// Reads all of the data in the database, verifying the checksums of
+// the sstable blocks holding it. Returns the first corruption found.
+DBStatus DBVerifyChecksums(DBEngine* db);
+
 // Sets GC timeouts.
 void DBSetGCTimeouts(DBEngine * db, int64_t min_txn_ts, int64_t min_rcache_ts);
 
@@ -84,6 +95,15 @@ DBStatus DBCompactRange(DBEngine* db, DBSlice* start, DBSlice* end);
 // range [start,end].
 uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end);
 
+// Returns in *min and *max the smallest and largest timestamps of the
+// MVCC versioned values in the range [start,end), as recorded in the
+// table properties of the overlapping sstables and as read from the
+// memtables. *found is set to false if the range holds no versioned
+// values or if any overlapping sstable lacks the properties, in which
+// case *min and *max are unchanged.
+DBStatus DBGetTimeBounds(DBEngine* db, DBSlice start, DBSlice end,
+                         DBTimestamp* min, DBTimestamp* max, bool* found);
+
 // Sets the database entry for "key" to "value".
 DBStatus DBPut(DBEngine* db, DBSlice key, DBSlice value);
 
@@ -157,6 +177,11 @@ DBStatus DBIterError(DBIterator* iter);
 // atomically. Use DBWrite() to apply the batch to a database.
 DBBatch* DBNewBatch();
 
+// Creates a new batch nested within parent. Reads from the nested
+// batch observe the updates of parent, and DBWrite() applies the
+// nested batch to parent instead of a database.
+DBBatch* DBNewNestedBatch(DBBatch* parent);
+
 // Destroys a batch, freeing any associated memory.
 void DBBatchDestroy(DBBatch* batch);
 
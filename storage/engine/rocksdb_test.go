@@ -493,3 +493,68 @@ func BenchmarkMVCCMergeTimeSeries(b *testing.B) {
 	}
 	runMVCCMerge(&value, 1024, b)
 }
+
+// TestRocksDBTimeBounds verifies that the time bounds of MVCC versioned
+// values are reported for overlapping key ranges, both for flushed values
+// and for values which are only in the memtable.
+func TestRocksDBTimeBounds(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	rocksdb := newMemRocksDB(roachpb.Attributes{}, testCacheSize, stopper)
+	if err := rocksdb.Open(); err != nil {
+		t.Fatalf("could not create new in-memory rocksdb db instance: %v", err)
+	}
+
+	for i, ts := range []roachpb.Timestamp{makeTS(5, 1), makeTS(7, 0), makeTS(10, 2)} {
+		key := roachpb.Key(fmt.Sprintf("b%d", i))
+		if err := MVCCPut(rocksdb, nil, key, ts, roachpb.MakeValueFromString("value"), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Inline values carry no timestamp and must not affect the bounds.
+	if err := MVCCPut(rocksdb, nil, roachpb.Key("c"), roachpb.ZeroTimestamp,
+		roachpb.MakeValueFromString("value"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing has been flushed yet; the bounds come from the memtable.
+	if min, max, ok, err := rocksdb.TimeBounds(MVCCEncodeKey(keyMin), MVCCEncodeKey(keyMax)); err != nil {
+		t.Fatal(err)
+	} else if !ok || !min.Equal(makeTS(5, 1)) || !max.Equal(makeTS(10, 2)) {
+		t.Errorf("expected [%s, %s] before flush; got [%s, %s] (ok=%t)",
+			makeTS(5, 1), makeTS(10, 2), min, max, ok)
+	}
+
+	if err := rocksdb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// An older value which is only in the memtable widens the bounds of
+	// the flushed values.
+	if err := MVCCPut(rocksdb, nil, roachpb.Key("d"), makeTS(2, 0), roachpb.MakeValueFromString("value"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		start, end roachpb.Key
+		ok         bool
+		min, max   roachpb.Timestamp
+	}{
+		{keyMin, keyMax, true, makeTS(2, 0), makeTS(10, 2)},
+		{roachpb.Key("b"), roachpb.Key("c"), true, makeTS(5, 1), makeTS(10, 2)},
+		{roachpb.Key("d"), roachpb.Key("e"), true, makeTS(2, 0), makeTS(2, 0)},
+		// Ranges without versioned values are unknown.
+		{roachpb.Key("a"), roachpb.Key("b"), false, roachpb.ZeroTimestamp, roachpb.ZeroTimestamp},
+		{roachpb.Key("e"), roachpb.Key("f"), false, roachpb.ZeroTimestamp, roachpb.ZeroTimestamp},
+	}
+	for i, c := range testCases {
+		min, max, ok, err := rocksdb.TimeBounds(MVCCEncodeKey(c.start), MVCCEncodeKey(c.end))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != c.ok || !min.Equal(c.min) || !max.Equal(c.max) {
+			t.Errorf("%d: expected [%s, %s] (ok=%t); got [%s, %s] (ok=%t)",
+				i, c.min, c.max, c.ok, min, max, ok)
+		}
+	}
+}
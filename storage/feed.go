@@ -21,6 +21,7 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/metric"
 )
 
 // RegisterRangeEvent occurs in two scenarios. Firstly, while a store
@@ -75,10 +76,12 @@ type MergeRangeEvent struct {
 	Removed RemoveRangeEvent
 }
 
-// StartStoreEvent occurs whenever a store is initially started.
+// StartStoreEvent occurs whenever a store is initially started. The event
+// carries the store's metrics registry so that subscribers can sample it.
 type StartStoreEvent struct {
 	StoreID   roachpb.StoreID
 	StartedAt int64
+	Metrics   *metric.Registry
 }
 
 // StoreStatusEvent contains the current descriptor for the given store.
@@ -108,6 +111,20 @@ type ReplicationStatusEvent struct {
 	AvailableRangeCount  int32
 }
 
+// VerificationStatusEvent summarizes the checksum verifications of the
+// ranges in the store. Like ReplicationStatusEvent, it is periodically
+// broadcast by the store.
+type VerificationStatusEvent struct {
+	StoreID roachpb.StoreID
+
+	// OldestVerifiedAt is the wall time of the least recent verification of
+	// any of the store's ranges.
+	OldestVerifiedAt int64
+	// FailedVerificationCount is the number of ranges whose most recent
+	// verification failed.
+	FailedVerificationCount int32
+}
+
 // BeginScanRangesEvent occurs when the store is about to scan over all ranges.
 // During such a scan, each existing range will be published to the feed as a
 // RegisterRangeEvent with the Scan flag set. This is used because downstream
@@ -172,10 +189,11 @@ func (sef StoreEventFeed) mergeRange(rngMerged, rngRemoved *Replica) {
 }
 
 // startStore publishes a StartStoreEvent to this feed.
-func (sef StoreEventFeed) startStore(startedAt int64) {
+func (sef StoreEventFeed) startStore(startedAt int64, metrics *metric.Registry) {
 	sef.f.Publish(&StartStoreEvent{
 		StoreID:   sef.id,
 		StartedAt: startedAt,
+		Metrics:   metrics,
 	})
 }
 
@@ -196,6 +214,15 @@ func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int32
 	})
 }
 
+// verificationStatus publishes a VerificationStatusEvent to this feed.
+func (sef StoreEventFeed) verificationStatus(oldestVerifiedAt int64, failed int32) {
+	sef.f.Publish(&VerificationStatusEvent{
+		StoreID:                 sef.id,
+		OldestVerifiedAt:        oldestVerifiedAt,
+		FailedVerificationCount: failed,
+	})
+}
+
 // beginScanRanges publishes a BeginScanRangesEvent to this feed.
 func (sef StoreEventFeed) beginScanRanges() {
 	sef.f.Publish(&BeginScanRangesEvent{sef.id})
@@ -219,6 +246,7 @@ type StoreEventListener interface {
 	OnEndScanRanges(event *EndScanRangesEvent)
 	OnStoreStatus(event *StoreStatusEvent)
 	OnReplicationStatus(event *ReplicationStatusEvent)
+	OnVerificationStatus(event *VerificationStatusEvent)
 }
 
 // ProcessStoreEvent dispatches an event on the StoreEventListener.
@@ -244,6 +272,8 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnStoreStatus(specificEvent)
 	case *ReplicationStatusEvent:
 		l.OnReplicationStatus(specificEvent)
+	case *VerificationStatusEvent:
+		l.OnVerificationStatus(specificEvent)
 	}
 }
 
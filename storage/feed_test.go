@@ -264,10 +264,21 @@ func TestStoreEventFeed(t *testing.T) {
 				AvailableRangeCount:  1,
 			},
 		},
+		{
+			"VerificationStatus",
+			func(feed StoreEventFeed) {
+				feed.verificationStatus(100, 2)
+			},
+			&VerificationStatusEvent{
+				StoreID:                 roachpb.StoreID(1),
+				OldestVerifiedAt:        100,
+				FailedVerificationCount: 2,
+			},
+		},
 		{
 			"StartStore",
 			func(feed StoreEventFeed) {
-				feed.startStore(100)
+				feed.startStore(100, nil)
 			},
 			&StartStoreEvent{
 				StoreID:   roachpb.StoreID(1),
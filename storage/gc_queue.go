@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sync"
@@ -26,8 +27,10 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/gogo/protobuf/proto"
 )
@@ -46,6 +49,17 @@ const (
 	// intentAgeThreshold is the threshold after which an extant intent
 	// will be resolved.
 	intentAgeThreshold = 2 * time.Hour // 2 hour
+	// txnAbandonedThreshold is the duration since the last heartbeat
+	// after which a pending transaction record is considered abandoned
+	// by its coordinator. This matches the expiration used by PushTxn.
+	txnAbandonedThreshold = 2 * DefaultHeartbeatInterval
+	// gcLocalCompactionThreshold is the number of bytes of range-local
+	// data, such as expired response cache entries and GC'd versions of
+	// transaction records, which a GC of a replica must clear for the
+	// replica's range-local key spans to be compacted. Until the spans are
+	// compacted, the engine carries the tombstones of the cleared data,
+	// slowing down scans of the range-local data.
+	gcLocalCompactionThreshold = 1 << 20 // 1 MB
 )
 
 // gcQueue manages a queue of replicas slated to be scanned in their
@@ -56,16 +70,30 @@ const (
 //    as implemented going forward).
 //  - Resolve extant write intents and determine oldest non-resolvable
 //    intent.
+//  - Abort pending transactions whose records haven't been heartbeat
+//    by their coordinator and resolve their intents on this range.
 //
 // The shouldQueue function combines the need for both tasks into a
 // single priority. If any task is overdue, shouldQueue returns true.
+//
+// The scan of a replica's user data is skipped if the timestamps
+// recorded in the engine's table properties show that it holds no
+// values old enough for either task.
+//
+// Once a GC has cleared enough range-local data, the replica's
+// range-local key spans are compacted to rid the engine of the
+// tombstones left behind.
 type gcQueue struct {
 	baseQueue
+	// localCompactionThreshold is the number of bytes of range-local data
+	// a GC must clear to trigger the compaction of the replica's
+	// range-local key spans.
+	localCompactionThreshold int64
 }
 
 // newGCQueue returns a new instance of gcQueue.
 func newGCQueue(gossip *gossip.Gossip) *gcQueue {
-	gcq := &gcQueue{}
+	gcq := &gcQueue{localCompactionThreshold: gcLocalCompactionThreshold}
 	gcq.baseQueue = makeBaseQueue("gc", gcq, gossip, gcQueueMaxSize)
 	return gcq
 }
@@ -120,11 +148,7 @@ func (*gcQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
 func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	sysCfg *config.SystemConfig) error {
 
-	snap := repl.store.Engine().NewSnapshot()
 	desc := repl.Desc()
-	iter := newReplicaDataIterator(desc, snap)
-	defer iter.Close()
-	defer snap.Close()
 
 	// Lookup the GC policy for the zone containing this key range.
 	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
@@ -140,6 +164,36 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	intentExp := now
 	intentExp.WallTime -= intentAgeThreshold.Nanoseconds()
 
+	// Compute GC expiration (the age beyond which old versions are GC'd).
+	gcExp := now
+	gcExp.WallTime -= int64(policy.TTLSeconds) * 1E9
+
+	// If the timestamps recorded for the range's user data show that none
+	// of it is older than both expirations, nothing in it can be GC'd or
+	// needs resolving, and only the range-local data (which includes the
+	// transaction records) is scanned.
+	ranges := makeReplicaKeyRanges(desc)
+	userData := ranges[len(ranges)-1]
+	if minTS, _, ok, err := repl.store.Engine().TimeBounds(userData.start, userData.end); err != nil {
+		log.Warningf("unable to determine time bounds of range %s: %s", repl, err)
+	} else if ok && gcExp.Less(minTS) && intentExp.Less(minTS) {
+		if log.V(1) {
+			log.Infof("skipping scan of user data of range %s; oldest value at %s", repl, minTS)
+		}
+		repl.store.metrics.Counter("gc.scans.skipped").Inc(1)
+		ranges = ranges[:len(ranges)-1]
+	}
+
+	snap := repl.store.Engine().NewSnapshot()
+	iter := newKeyRangesIterator(ranges, snap)
+	defer iter.Close()
+	defer snap.Close()
+
+	// Compute transaction expiration (last heartbeat before which a
+	// pending transaction is considered abandoned).
+	txnExp := now
+	txnExp.WallTime -= txnAbandonedThreshold.Nanoseconds()
+
 	// TODO(tschottdorf): execution will use a leader-assigned local
 	// timestamp to compute intent age. While this should be fine, could
 	// consider adding a Now timestamp to GCRequest which would be used
@@ -151,7 +205,23 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 	var keys []roachpb.EncodedKey
 	var vals [][]byte
 
-	// Maps from txn ID to txn and intent key slice.
+	// localClearedBytes counts the bytes of range-local data cleared by
+	// this GC: the response cache entries which are removed when the
+	// engine compacts them and the versions of range-local keys which
+	// are GC'd.
+	var localClearedBytes int64
+	minRCacheTS := now.WallTime - GCResponseCacheExpiration.Nanoseconds()
+
+	// Sequence cache entries are kept for as long as response cache
+	// entries, after which replays of the batches they guard against are
+	// no longer expected.
+	seqCacheExp := now
+	seqCacheExp.WallTime = minRCacheTS
+	var seqCacheKeys []roachpb.GCRequest_GCKey
+
+	// Maps from txn ID to txn and intent key slice. Only transactions
+	// in txnMap are pushed; intentMap holds all intents encountered so
+	// that an aborted transaction has all of its local intents resolved.
 	txnMap := map[string]*roachpb.Transaction{}
 	intentMap := map[string][]roachpb.Intent{}
 
@@ -164,11 +234,83 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 		}
 	}
 
+	// processTxnRecord is invoked with the inline value of a transaction
+	// record. Pending transactions which haven't been heartbeat within
+	// txnAbandonedThreshold are added to the set of txns to push.
+	processTxnRecord := func() {
+		meta := &engine.MVCCMetadata{}
+		if err := proto.Unmarshal(vals[0], meta); err != nil {
+			log.Errorf("unable to unmarshal MVCC metadata for key %q: %s", keys[0], err)
+			return
+		}
+		if meta.Value == nil {
+			return
+		}
+		txn := &roachpb.Transaction{}
+		if err := meta.Value.GetProto(txn); err != nil {
+			log.Errorf("unable to unmarshal transaction record %q: %s", expBaseKey, err)
+			return
+		}
+		if txn.Status != roachpb.PENDING {
+			return
+		}
+		heartbeat := txn.OrigTimestamp
+		if txn.LastHeartbeat != nil {
+			heartbeat = *txn.LastHeartbeat
+		}
+		if heartbeat.Less(txnExp) {
+			if log.V(1) {
+				log.Infof("found abandoned txn %s; last heartbeat %s", txn, heartbeat)
+			}
+			repl.store.metrics.Counter("gc.txns.abandoned").Inc(1)
+			txnMap[string(txn.ID)] = txn
+		}
+	}
+
+	// processSequenceCacheEntry is invoked with the inline value of a
+	// sequence cache entry, which is sent for GC if it has expired.
+	processSequenceCacheEntry := func(txnID []byte) {
+		meta := &engine.MVCCMetadata{}
+		if err := proto.Unmarshal(vals[0], meta); err != nil {
+			log.Errorf("unable to unmarshal MVCC metadata for key %q: %s", keys[0], err)
+			return
+		}
+		if meta.Value == nil {
+			return
+		}
+		var entry roachpb.SequenceCacheEntry
+		if err := meta.Value.GetProto(&entry); err != nil {
+			log.Errorf("unable to unmarshal sequence cache entry %q: %s", expBaseKey, err)
+			return
+		}
+		if entry.Timestamp.Less(seqCacheExp) {
+			seqCacheKeys = append(seqCacheKeys, roachpb.GCRequest_GCKey{Key: expBaseKey, Timestamp: entry.Timestamp})
+			localClearedBytes += int64(len(keys[0]) + len(vals[0]))
+		}
+	}
+
 	// processKeysAndValues is invoked with each key and its set of
 	// values. Intents older than the intent age threshold are sent for
 	// resolution and values after the MVCC metadata, and possible
-	// intent, are sent for garbage collection.
+	// intent, are sent for garbage collection. Transaction records of
+	// abandoned transactions are queued to be pushed.
 	processKeysAndValues := func() {
+		if isTransactionKey(expBaseKey) {
+			processTxnRecord()
+			return
+		}
+		if txnID, ok := repl.seqCache.decodeSequenceCacheKey(expBaseKey); ok {
+			processSequenceCacheEntry(txnID)
+			return
+		}
+		if isResponseCacheKey(expBaseKey) {
+			if cmdID, err := repl.respCache.decodeResponseCacheKey(keys[0]); err != nil {
+				log.Errorf("unable to decode response cache key %q: %s", keys[0], err)
+			} else if cmdID.WallTime < minRCacheTS {
+				localClearedBytes += int64(len(keys[0]) + len(vals[0]))
+			}
+			return
+		}
 		// If there's more than a single value for the key, possibly send for GC.
 		if len(keys) > 1 {
 			meta := &engine.MVCCMetadata{}
@@ -181,10 +323,12 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 				if meta.Txn != nil {
 					// Keep track of intent to resolve if older than the intent
 					// expiration threshold.
+					id := string(meta.Txn.ID)
+					intentMap[id] = append(intentMap[id], roachpb.Intent{Key: expBaseKey})
 					if meta.Timestamp.Less(intentExp) {
-						id := string(meta.Txn.ID)
-						txnMap[id] = meta.Txn
-						intentMap[id] = append(intentMap[id], roachpb.Intent{Key: expBaseKey})
+						if _, ok := txnMap[id]; !ok {
+							txnMap[id] = meta.Txn
+						}
 					} else {
 						updateOldestIntent(meta.Txn.OrigTimestamp.WallTime)
 					}
@@ -197,6 +341,9 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 					// multiple requests in the event that more than X keys
 					// are added to the request.
 					gcArgs.Keys = append(gcArgs.Keys, roachpb.GCRequest_GCKey{Key: expBaseKey, Timestamp: gcTS})
+					if isLocalKey(expBaseKey) {
+						localClearedBytes += gcBytes(keys[startIdx:], vals[startIdx:], gcTS)
+					}
 				}
 			}
 		}
@@ -255,14 +402,22 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 		repl.resolveIntents(repl.context(), intents)
 	}
 
-	// Set start and end keys.
+	// Set start and end keys. Sequence cache entries are range-ID local
+	// and thus not addressable, so they don't contribute to the span; if
+	// they are all there is to GC, the span of the range is used.
 	if len(gcArgs.Keys) > 0 {
 		done = false
 		gcArgs.Key = gcArgs.Keys[0].Key
 		gcArgs.EndKey = gcArgs.Keys[len(gcArgs.Keys)-1].Key.Next()
+	} else if len(seqCacheKeys) > 0 {
+		done = false
+		gcArgs.Key = desc.StartKey.AsRawKey()
+		gcArgs.EndKey = desc.EndKey.AsRawKey()
 	}
+	gcArgs.Keys = append(gcArgs.Keys, seqCacheKeys...)
 
 	if done {
+		gcq.maybeCompactLocal(repl, ranges, localClearedBytes)
 		return nil
 	}
 
@@ -285,9 +440,82 @@ func (gcq *gcQueue) process(now roachpb.Timestamp, repl *Replica,
 		log.Errorf("failed to set last verification timestamp for replica %s: %s", repl, err)
 	}
 
+	gcq.maybeCompactLocal(repl, ranges, localClearedBytes)
 	return nil
 }
 
+// gcBytes returns the number of bytes of the versions of a key which
+// are GC'd given the GC timestamp for the key: those at or below it.
+func gcBytes(keys []roachpb.EncodedKey, vals [][]byte, gcTS roachpb.Timestamp) int64 {
+	var n int64
+	for i, key := range keys {
+		_, ts, _, err := engine.MVCCDecodeKey(key)
+		if err != nil || gcTS.Less(ts) {
+			continue
+		}
+		n += int64(len(key) + len(vals[i]))
+	}
+	return n
+}
+
+// maybeCompactLocal compacts the range-local key spans of the replica
+// if the GC cleared at least localCompactionThreshold bytes of
+// range-local data. ranges are the key ranges of the replica, the
+// first two of which are its range-local key spans.
+func (gcq *gcQueue) maybeCompactLocal(repl *Replica, ranges []keyRange, clearedBytes int64) {
+	if clearedBytes < gcq.localCompactionThreshold {
+		return
+	}
+	if log.V(1) {
+		log.Infof("compacting range-local data of range %s; %d bytes cleared", repl, clearedBytes)
+	}
+	for _, r := range ranges[:2] {
+		repl.store.Engine().CompactRange(r.start, r.end)
+	}
+	repl.store.metrics.Counter("gc.compactions.local").Inc(1)
+}
+
+// describe returns an estimate of the data process would garbage collect
+// from the replica's range. The estimate is taken from the range's MVCC
+// stats and is an upper bound: non-live data which is younger than the
+// zone's GC TTL is not collected.
+func (gcq *gcQueue) describe(now roachpb.Timestamp, repl *Replica,
+	sysCfg *config.SystemConfig) (string, error) {
+
+	if shouldQ, _ := gcq.shouldQueue(now, repl, sysCfg); !shouldQ {
+		return "", nil
+	}
+	ms := repl.stats.GetMVCC()
+	return fmt.Sprintf("would GC up to %d bytes of non-live data and examine %d intents",
+		ms.KeyBytes+ms.ValBytes-ms.LiveBytes, ms.IntentCount), nil
+}
+
+// isTransactionKey returns whether the key addresses a transaction
+// record.
+func isTransactionKey(key roachpb.Key) bool {
+	if !bytes.HasPrefix(key, keys.LocalRangePrefix) {
+		return false
+	}
+	_, suffix, _, err := keys.DecodeRangeKey(key)
+	return err == nil && bytes.Equal(suffix, keys.LocalTransactionSuffix)
+}
+
+// isLocalKey returns whether the key is a local key, as opposed to
+// a key addressing user data.
+func isLocalKey(key roachpb.Key) bool {
+	return bytes.Compare(key, keys.LocalMax) < 0
+}
+
+// isResponseCacheKey returns whether the key addresses a response
+// cache entry.
+func isResponseCacheKey(key roachpb.Key) bool {
+	if !bytes.HasPrefix(key, keys.LocalRangeIDPrefix) {
+		return false
+	}
+	b, _, err := encoding.DecodeUvarint(key[len(keys.LocalRangeIDPrefix):])
+	return err == nil && bytes.HasPrefix(b, keys.LocalResponseCacheSuffix)
+}
+
 // timer returns a constant duration to space out GC processing
 // for successive queued replicas.
 func (*gcQueue) timer() time.Duration {
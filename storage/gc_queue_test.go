@@ -379,3 +379,106 @@ func TestGCQueueIntentResolution(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestGCQueueAbandonedTxn verifies that a pending transaction whose
+// record hasn't been heartbeat is aborted by the GC queue and that its
+// intents are resolved, even if they're younger than the intent age
+// threshold.
+func TestGCQueueAbandonedTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	txn := newTransaction("txn", roachpb.Key("a"), 1, roachpb.SERIALIZABLE, tc.clock)
+	heartbeat := makeTS(now-2*txnAbandonedThreshold.Nanoseconds(), 0)
+	txn.LastHeartbeat = &heartbeat
+	txnKey := keys.TransactionKey(txn.Key, txn.ID)
+	if err := engine.MVCCPutProto(tc.store.Engine(), nil, txnKey, roachpb.ZeroTimestamp, nil, txn); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		pArgs := putArgs(roachpb.Key(key), []byte("value"))
+		if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+			Txn: txn,
+		}, &pArgs); err != nil {
+			t.Fatalf("could not put %q: %s", key, err)
+		}
+	}
+
+	cfg := tc.gossip.GetSystemConfig()
+	if cfg == nil {
+		t.Fatal("nil config")
+	}
+	gcQ := newGCQueue(tc.gossip)
+	if err := gcQ.process(tc.clock.Now(), tc.rng, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	var txnRecord roachpb.Transaction
+	if ok, err := engine.MVCCGetProto(tc.store.Engine(), txnKey, roachpb.ZeroTimestamp, true, nil, &txnRecord); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected transaction record")
+	}
+	if txnRecord.Status != roachpb.ABORTED {
+		t.Errorf("expected txn to be aborted; got %s", txnRecord.Status)
+	}
+
+	meta := &engine.MVCCMetadata{}
+	for _, key := range []string{"a", "b", "c"} {
+		if ok, _, _, err := tc.store.Engine().GetProto(engine.MVCCEncodeKey(roachpb.Key(key)), meta); err != nil {
+			t.Fatal(err)
+		} else if ok && meta.Txn != nil {
+			t.Errorf("expected intent on %q to be resolved", key)
+		}
+	}
+}
+
+// TestGCQueueLocalCompaction verifies that the range-local key spans of
+// a replica are compacted once a GC clears enough range-local data,
+// here in the form of expired response cache entries.
+func TestGCQueueLocalCompaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	for i, key := range []string{"a", "b", "c"} {
+		pArgs := putArgs(roachpb.Key(key), []byte("value"))
+		if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+			CmdID: roachpb.ClientCmdID{WallTime: 1, Random: int64(i + 1)},
+		}, &pArgs); err != nil {
+			t.Fatalf("could not put %q: %s", key, err)
+		}
+	}
+
+	cfg := tc.gossip.GetSystemConfig()
+	if cfg == nil {
+		t.Fatal("nil config")
+	}
+	compactions := tc.store.metrics.Counter("gc.compactions.local")
+
+	// The expired entries fall short of the default threshold.
+	gcQ := newGCQueue(tc.gossip)
+	if err := gcQ.process(tc.clock.Now(), tc.rng, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if c := compactions.Count(); c != 0 {
+		t.Errorf("expected no compaction; got %d", c)
+	}
+
+	gcQ.localCompactionThreshold = 1
+	if err := gcQ.process(tc.clock.Now(), tc.rng, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if c := compactions.Count(); c != 1 {
+		t.Errorf("expected 1 compaction; got %d", c)
+	}
+}
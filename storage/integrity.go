@@ -0,0 +1,81 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// verifyRaftState checks that the replica's descriptor, applied index,
+// raft log bounds and HardState are mutually consistent, as they are
+// after any sequence of successful writes.
+func (r *Replica) verifyRaftState() error {
+	desc := r.Desc()
+	if idx, _ := desc.FindReplica(r.store.StoreID()); idx < 0 {
+		return util.Errorf("range descriptor %s does not contain store %d", desc, r.store.StoreID())
+	}
+	appliedIndex, err := r.loadAppliedIndex(r.store.Engine())
+	if err != nil {
+		return err
+	}
+	truncState, err := r.raftTruncatedState()
+	if err != nil {
+		return err
+	}
+	lastIndex, err := r.loadLastIndex()
+	if err != nil {
+		return err
+	}
+	if appliedIndex < truncState.Index {
+		return util.Errorf("applied index %d precedes truncated index %d", appliedIndex, truncState.Index)
+	}
+	if appliedIndex > lastIndex {
+		return util.Errorf("applied index %d exceeds last index %d", appliedIndex, lastIndex)
+	}
+	var hs raftpb.HardState
+	ok, err := engine.MVCCGetProto(r.store.Engine(), keys.RaftHardStateKey(desc.RangeID),
+		roachpb.ZeroTimestamp, true, nil, &hs)
+	if err != nil {
+		return err
+	}
+	// A replica created by a split may have a HardState without a commit
+	// index, written while it was still uninitialized.
+	if ok && hs.Commit != 0 {
+		if appliedIndex > hs.Commit {
+			return util.Errorf("applied index %d exceeds committed index %d", appliedIndex, hs.Commit)
+		}
+		if hs.Commit > lastIndex {
+			return util.Errorf("committed index %d exceeds last index %d", hs.Commit, lastIndex)
+		}
+	}
+	return nil
+}
+
+// QuarantinedReplicas returns the ranges whose replicas failed the
+// integrity check when the store started, along with the reason.
+func (s *Store) QuarantinedReplicas() map[roachpb.RangeID]error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	quarantined := make(map[roachpb.RangeID]error, len(s.quarantined))
+	for rangeID, err := range s.quarantined {
+		quarantined[rangeID] = err
+	}
+	return quarantined
+}
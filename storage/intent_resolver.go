@@ -0,0 +1,222 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// intentResolverTaskLimit is the maximum number of asynchronous intent
+	// resolution tasks which may run concurrently on a store.
+	intentResolverTaskLimit = 100
+	// intentResolverBytesLimit is the maximum total size of the intents
+	// queued for asynchronous resolution on a store. Intents beyond the
+	// budget are not resolved eagerly; they will be cleaned up by the next
+	// reader which encounters them, or by the GC queue.
+	intentResolverBytesLimit = 16 << 20 // 16 MB
+	// defaultIntentPushLimit is the default maximum number of conflicting
+	// intents whose transactions are pushed when resolving a single write
+	// intent error.
+	defaultIntentPushLimit = 100
+)
+
+// An intentResolver resolves intents which were skipped (by inconsistent
+// reads) or left behind outside of the range (by EndTransaction)
+// asynchronously, so that the client receives its response without
+// waiting for the cleanup. The number of concurrently running tasks and
+// the size of the pending work are bounded; tasks beyond the concurrency
+// limit are queued until a worker becomes available.
+type intentResolver struct {
+	store *Store
+	sem   chan struct{} // Bounds the number of concurrent workers
+
+	mu    sync.Mutex
+	done  *sync.Cond   // Signaled when a task completes; see flush()
+	tasks int          // Number of outstanding tasks
+	bytes int64        // Size of intents currently queued or being resolved
+	queue []intentTask // Tasks waiting for a worker
+}
+
+// An intentTask is a unit of asynchronous intent resolution work.
+type intentTask struct {
+	size int64 // Budget reserved by beginTask
+	fn   func()
+}
+
+func newIntentResolver(store *Store) *intentResolver {
+	ir := &intentResolver{
+		store: store,
+		sem:   make(chan struct{}, intentResolverTaskLimit),
+	}
+	ir.done = sync.NewCond(&ir.mu)
+	return ir
+}
+
+// intentsSize returns the approximate in-memory size of the intents.
+func intentsSize(intents []roachpb.Intent) int64 {
+	var size int64
+	for _, intent := range intents {
+		size += int64(len(intent.Key) + len(intent.EndKey) + intent.Txn.Size())
+	}
+	return size
+}
+
+// beginTask reserves n bytes of the resolver's budget for a new task,
+// returning false if the budget would be exceeded.
+func (ir *intentResolver) beginTask(n int64) bool {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	if ir.bytes > 0 && ir.bytes+n > intentResolverBytesLimit {
+		return false
+	}
+	ir.bytes += n
+	ir.tasks++
+	return true
+}
+
+// endTask releases the budget reserved by beginTask.
+func (ir *intentResolver) endTask(n int64) {
+	ir.mu.Lock()
+	ir.bytes -= n
+	ir.tasks--
+	ir.mu.Unlock()
+	ir.done.Broadcast()
+}
+
+// runTask hands the task to a new worker if fewer than
+// intentResolverTaskLimit workers are running and queues it otherwise.
+// The task must have been admitted by beginTask.
+func (ir *intentResolver) runTask(stopper *stop.Stopper, t intentTask) {
+	ir.mu.Lock()
+	select {
+	case ir.sem <- struct{}{}:
+		ir.mu.Unlock()
+	default:
+		ir.queue = append(ir.queue, t)
+		ir.mu.Unlock()
+		return
+	}
+	if !stopper.RunAsyncTask(func() { ir.work(t) }) {
+		// The stopper is draining, so no worker will start again; abandon
+		// the task along with any queued ones.
+		ir.mu.Lock()
+		<-ir.sem
+		dropped := append(ir.queue, t)
+		ir.queue = nil
+		ir.mu.Unlock()
+		for _, t := range dropped {
+			ir.endTask(t.size)
+		}
+	}
+}
+
+// work runs the task and then any queued tasks until the queue is empty,
+// at which point the worker releases its semaphore slot. The slot is
+// released with the lock held so that runTask never queues a task
+// without a worker left to run it.
+func (ir *intentResolver) work(t intentTask) {
+	for {
+		t.fn()
+
+		ir.mu.Lock()
+		if len(ir.queue) == 0 {
+			<-ir.sem
+			ir.mu.Unlock()
+			ir.endTask(t.size)
+			return
+		}
+		next := ir.queue[0]
+		ir.queue[0] = intentTask{}
+		ir.queue = ir.queue[1:]
+		ir.mu.Unlock()
+		ir.endTask(t.size)
+		t = next
+	}
+}
+
+// processIntentsAsync asynchronously resolves the supplied intents, which
+// were encountered or left behind by commands on the given replica.
+func (ir *intentResolver) processIntentsAsync(r *Replica, intents []intentsWithArg) {
+	if len(intents) == 0 {
+		return
+	}
+	now := r.store.Clock().Now()
+	ctx := r.context()
+	stopper := r.store.Stopper()
+	metrics := r.store.metrics
+
+	for _, item := range intents {
+		size := intentsSize(item.intents)
+		if !ir.beginTask(size) {
+			metrics.Counter("intents.resolve.dropped").Inc(int64(len(item.intents)))
+			if log.V(1) {
+				log.Infoc(ctx, "intent resolution budget exhausted; skipping %d intents", len(item.intents))
+			}
+			continue
+		}
+		// TODO(tschottdorf): avoid data race related to batch unrolling in ExecuteCmd;
+		// can probably go again when that provisional code there is gone. Should
+		// still be careful though, a retry could happen and race with args.
+		args := proto.Clone(item.args).(roachpb.Request)
+		item := item // avoids a race in `for _, item := range ...`
+		ir.runTask(stopper, intentTask{size: size, fn: func() {
+			metrics.Counter("intents.resolve.started").Inc(int64(len(item.intents)))
+			h := roachpb.Header{Timestamp: now}
+			err := r.store.resolveWriteIntentError(ctx, &roachpb.WriteIntentError{
+				Intents: item.intents,
+			}, r, args, h, roachpb.CLEANUP_TXN)
+			if wiErr, ok := err.(*roachpb.WriteIntentError); !ok || wiErr == nil || !wiErr.Resolved {
+				metrics.Counter("intents.resolve.failed").Inc(int64(len(item.intents)))
+				log.Warningc(ctx, "failed to resolve intents: %s", err)
+			}
+		}})
+	}
+}
+
+// flush blocks until all outstanding asynchronous intent resolution tasks
+// have completed.
+func (ir *intentResolver) flush() {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	for ir.tasks > 0 {
+		ir.done.Wait()
+	}
+}
+
+// partitionIntents splits the intents of a write intent error into those
+// whose transactions need to be pushed, those which can be resolved right
+// away and those which exceed the push limit and are left for a later
+// attempt. A limit of zero or less pushes all intents.
+func partitionIntents(intents []roachpb.Intent, limit int) (push, resolve, deferred []roachpb.Intent) {
+	for _, intent := range intents {
+		switch {
+		case intent.Txn.Status != roachpb.PENDING:
+			// The current intent does not need conflict resolution.
+			resolve = append(resolve, intent)
+		case limit > 0 && len(push) >= limit:
+			deferred = append(deferred, intent)
+		default:
+			push = append(push, intent)
+		}
+	}
+	return push, resolve, deferred
+}
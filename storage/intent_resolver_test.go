@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestIntentResolverBudget verifies that the intent resolver refuses work
+// beyond its byte budget and that flush waits for outstanding tasks.
+func TestIntentResolverBudget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ir := newIntentResolver(nil)
+
+	// A single oversized task is always admitted so that large intent sets
+	// make progress, but nothing else is admitted alongside it.
+	if !ir.beginTask(intentResolverBytesLimit + 1) {
+		t.Fatal("expected first task to be admitted")
+	}
+	if ir.beginTask(1) {
+		t.Fatal("expected task beyond budget to be refused")
+	}
+
+	flushed := make(chan struct{})
+	go func() {
+		ir.flush()
+		close(flushed)
+	}()
+	select {
+	case <-flushed:
+		t.Fatal("flush returned with outstanding task")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	ir.endTask(intentResolverBytesLimit + 1)
+	<-flushed
+	if !ir.beginTask(1) {
+		t.Fatal("expected task to be admitted after budget was released")
+	}
+	ir.endTask(1)
+}
+
+// TestIntentResolverQueue verifies that tasks beyond the concurrency limit
+// are queued rather than dropped and run once a worker becomes available.
+func TestIntentResolverQueue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	ir := newIntentResolver(nil)
+
+	const extra = 10
+	var ran int32
+	block := make(chan struct{})
+	started := make(chan struct{}, intentResolverTaskLimit)
+	for i := 0; i < intentResolverTaskLimit+extra; i++ {
+		if !ir.beginTask(1) {
+			t.Fatal("expected task to be admitted")
+		}
+		ir.runTask(stopper, intentTask{size: 1, fn: func() {
+			if atomic.AddInt32(&ran, 1) <= intentResolverTaskLimit {
+				started <- struct{}{}
+			}
+			<-block
+		}})
+	}
+	for i := 0; i < intentResolverTaskLimit; i++ {
+		<-started
+	}
+	ir.mu.Lock()
+	queued := len(ir.queue)
+	ir.mu.Unlock()
+	if queued != extra {
+		t.Fatalf("expected %d queued tasks; got %d", extra, queued)
+	}
+	if n := atomic.LoadInt32(&ran); n != intentResolverTaskLimit {
+		t.Fatalf("expected %d running tasks; got %d", intentResolverTaskLimit, n)
+	}
+
+	close(block)
+	ir.flush()
+	if n := atomic.LoadInt32(&ran); n != intentResolverTaskLimit+extra {
+		t.Fatalf("expected all %d tasks to run; got %d", intentResolverTaskLimit+extra, n)
+	}
+	if l := len(ir.sem); l != 0 {
+		t.Fatalf("expected all workers to have exited; %d remain", l)
+	}
+}
+
+// TestPartitionIntents verifies that intents of pending transactions beyond
+// the push limit are deferred, while other intents are resolved right away.
+func TestPartitionIntents(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var intents []roachpb.Intent
+	for i, status := range []roachpb.TransactionStatus{
+		roachpb.PENDING, roachpb.COMMITTED, roachpb.PENDING, roachpb.ABORTED, roachpb.PENDING,
+	} {
+		intents = append(intents, roachpb.Intent{
+			Key: roachpb.Key([]byte{byte('a' + i)}),
+			Txn: roachpb.Transaction{Status: status},
+		})
+	}
+
+	for i, test := range []struct {
+		limit                            int
+		expPush, expResolve, expDeferred string
+	}{
+		{0, "ace", "bd", ""},
+		{5, "ace", "bd", ""},
+		{2, "ac", "bd", "e"},
+		{1, "a", "bd", "ce"},
+	} {
+		push, resolve, deferred := partitionIntents(intents, test.limit)
+		keys := func(intents []roachpb.Intent) string {
+			var s string
+			for _, intent := range intents {
+				s += string(intent.Key)
+			}
+			return s
+		}
+		if a, e := keys(push), test.expPush; a != e {
+			t.Errorf("%d: expected pushed intents %q; got %q", i, e, a)
+		}
+		if a, e := keys(resolve), test.expResolve; a != e {
+			t.Errorf("%d: expected resolved intents %q; got %q", i, e, a)
+		}
+		if a, e := keys(deferred), test.expDeferred; a != e {
+			t.Errorf("%d: expected deferred intents %q; got %q", i, e, a)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// leaseHistorySize is the number of lease holders remembered by each
+// replica.
+const leaseHistorySize = 16
+
+// A LeaseChangeReason describes how a leader lease came to replace the
+// previous one.
+type LeaseChangeReason int
+
+const (
+	// LeaseInitial is the first lease of the range.
+	LeaseInitial LeaseChangeReason = iota
+	// LeaseExtension is a renewal of the previous lease by its holder. It
+	// only starts an entry of the history when the replica didn't see the
+	// holder acquire the lease, for example after a restart.
+	LeaseExtension
+	// LeaseAcquisition is a lease acquired after the previous holder's
+	// lease expired.
+	LeaseAcquisition
+	// LeaseTransfer is a lease acquired after the previous holder handed
+	// it off by shortening its lease.
+	LeaseTransfer
+	// LeaseEpochTakeover is a lease taken over from a holder whose
+	// liveness epoch was incremented.
+	LeaseEpochTakeover
+)
+
+var leaseChangeReasonNames = [...]string{
+	LeaseInitial:       "initial",
+	LeaseExtension:     "extension",
+	LeaseAcquisition:   "acquisition",
+	LeaseTransfer:      "transfer",
+	LeaseEpochTakeover: "epoch-takeover",
+}
+
+func (r LeaseChangeReason) String() string {
+	return leaseChangeReasonNames[r]
+}
+
+// A LeaseHistoryEntry describes the tenure of a lease holder. Extensions
+// of the lease by the same holder update the entry rather than adding a
+// new one.
+type LeaseHistoryEntry struct {
+	Lease      roachpb.Lease     // The most recent lease of the holder
+	Reason     LeaseChangeReason // How the holder obtained the lease
+	Applied    time.Time         // When the replica applied the first lease of the holder
+	Extensions int               // The number of times the holder extended its lease
+	HandedOff  bool              // Whether the holder shortened its lease to hand it off
+}
+
+// A leaseHistory is a ring buffer of the most recent lease holders of a
+// replica.
+type leaseHistory struct {
+	sync.Mutex
+	entries [leaseHistorySize]LeaseHistoryEntry
+	next    int // The index of the next entry to write
+	count   int // The number of valid entries
+}
+
+// record adds the given lease, which replaced prev, to the history.
+func (h *leaseHistory) record(prev, lease roachpb.Lease, now time.Time) {
+	h.Lock()
+	defer h.Unlock()
+	var last *LeaseHistoryEntry
+	if h.count > 0 {
+		last = &h.entries[(h.next+leaseHistorySize-1)%leaseHistorySize]
+	}
+	if last != nil && last.Lease.Replica.StoreID == lease.Replica.StoreID &&
+		prev.Replica.StoreID == lease.Replica.StoreID {
+		if lease.Epoch == 0 && lease.Expiration.Less(last.Lease.Expiration) {
+			last.HandedOff = true
+		}
+		last.Lease = lease
+		last.Extensions++
+		return
+	}
+
+	var reason LeaseChangeReason
+	switch {
+	case prev.Replica.StoreID == 0:
+		reason = LeaseInitial
+	case prev.Replica.StoreID == lease.Replica.StoreID:
+		reason = LeaseExtension
+	case prev.Epoch != 0:
+		reason = LeaseEpochTakeover
+	case last != nil && last.HandedOff && last.Lease.Replica.StoreID == prev.Replica.StoreID:
+		reason = LeaseTransfer
+	default:
+		reason = LeaseAcquisition
+	}
+	h.entries[h.next] = LeaseHistoryEntry{
+		Lease:   lease,
+		Reason:  reason,
+		Applied: now,
+	}
+	h.next = (h.next + 1) % leaseHistorySize
+	if h.count < leaseHistorySize {
+		h.count++
+	}
+}
+
+// get returns the entries of the history, oldest first.
+func (h *leaseHistory) get() []LeaseHistoryEntry {
+	h.Lock()
+	defer h.Unlock()
+	entries := make([]LeaseHistoryEntry, 0, h.count)
+	for i := h.count; i > 0; i-- {
+		entries = append(entries, h.entries[(h.next+leaseHistorySize-i)%leaseHistorySize])
+	}
+	return entries
+}
+
+// LeaseHistory returns the most recent lease holders of the range as seen
+// by this replica, oldest first. The history is kept in memory only and
+// starts over when the replica is recreated.
+func (r *Replica) LeaseHistory() []LeaseHistoryEntry {
+	return r.leaseHistory.get()
+}
@@ -0,0 +1,88 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+func makeTestLease(storeID roachpb.StoreID, expiration int64) roachpb.Lease {
+	return roachpb.Lease{
+		Expiration: roachpb.Timestamp{WallTime: expiration},
+		Replica:    roachpb.ReplicaDescriptor{StoreID: storeID},
+	}
+}
+
+// TestLeaseHistoryReasons verifies that extensions are coalesced into the
+// entry of their holder and that lease changes are attributed correctly.
+func TestLeaseHistoryReasons(t *testing.T) {
+	var h leaseHistory
+	var prev roachpb.Lease
+	record := func(lease roachpb.Lease) {
+		h.record(prev, lease, time.Unix(0, lease.Expiration.WallTime))
+		prev = lease
+	}
+	record(makeTestLease(1, 10))
+	record(makeTestLease(1, 20))
+	record(makeTestLease(1, 30))
+	record(makeTestLease(2, 40))
+	record(makeTestLease(2, 50))
+	// Store 2 hands off its lease by shortening it.
+	record(makeTestLease(2, 45))
+	record(makeTestLease(3, 60))
+
+	var reasons []LeaseChangeReason
+	var extensions []int
+	for _, e := range h.get() {
+		reasons = append(reasons, e.Reason)
+		extensions = append(extensions, e.Extensions)
+	}
+	if expected := []LeaseChangeReason{LeaseInitial, LeaseAcquisition, LeaseTransfer}; !reflect.DeepEqual(reasons, expected) {
+		t.Errorf("expected reasons %v; got %v", expected, reasons)
+	}
+	if expected := []int{2, 2, 0}; !reflect.DeepEqual(extensions, expected) {
+		t.Errorf("expected extensions %v; got %v", expected, extensions)
+	}
+	if entries := h.get(); entries[1].Lease != makeTestLease(2, 45) || !entries[1].HandedOff {
+		t.Errorf("expected the latest lease of store 2 to be handed off; got %+v", entries[1])
+	}
+}
+
+// TestLeaseHistoryWrapAround verifies that only the most recent holders
+// are kept, oldest first.
+func TestLeaseHistoryWrapAround(t *testing.T) {
+	var h leaseHistory
+	var prev roachpb.Lease
+	const count = leaseHistorySize + 5
+	for i := 1; i <= count; i++ {
+		lease := makeTestLease(roachpb.StoreID(i), int64(i))
+		h.record(prev, lease, time.Unix(0, int64(i)))
+		prev = lease
+	}
+	entries := h.get()
+	if len(entries) != leaseHistorySize {
+		t.Fatalf("expected %d entries; got %d", leaseHistorySize, len(entries))
+	}
+	for i, e := range entries {
+		if expected := roachpb.StoreID(count - leaseHistorySize + i + 1); e.Lease.Replica.StoreID != expected {
+			t.Errorf("%d: expected store %d; got %d", i, expected, e.Lease.Replica.StoreID)
+		}
+	}
+}
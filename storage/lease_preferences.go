@@ -0,0 +1,82 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// preferredLeaseholders returns the replicas satisfying the first of the
+// lease preferences which is satisfied by any of the given replicas, in the
+// order of the replicas. It returns nil if there are no preferences or none
+// of them is satisfied, in which case any replica may hold the lease. attrs
+// returns the attributes of the store of a replica, and whether the replica
+// may hold the lease at all.
+func preferredLeaseholders(preferences []roachpb.Attributes, replicas []roachpb.ReplicaDescriptor,
+	attrs func(roachpb.ReplicaDescriptor) (roachpb.Attributes, bool)) []roachpb.ReplicaDescriptor {
+	for _, preference := range preferences {
+		var preferred []roachpb.ReplicaDescriptor
+		for _, rep := range replicas {
+			if storeAttrs, ok := attrs(rep); ok && preference.IsSubset(storeAttrs) {
+				preferred = append(preferred, rep)
+			}
+		}
+		if len(preferred) > 0 {
+			return preferred
+		}
+	}
+	return nil
+}
+
+// leaseholderAttrs returns the attributes of the store of the given replica,
+// as gossiped, and whether the replica may hold the leader lease. Witnesses
+// may not, and neither may the replicas on dead stores or on stores whose
+// descriptor hasn't been gossiped yet.
+func (s *Store) leaseholderAttrs(rep roachpb.ReplicaDescriptor) (roachpb.Attributes, bool) {
+	if rep.Witness || s.ctx.StorePool == nil {
+		return roachpb.Attributes{}, false
+	}
+	desc := s.ctx.StorePool.getStoreDescriptor(rep.StoreID)
+	if desc == nil || s.ctx.StorePool.getStoreDetail(rep.StoreID).dead {
+		return roachpb.Attributes{}, false
+	}
+	return *desc.CombinedAttrs(), true
+}
+
+// leasePreferenceTarget returns the replica the leader lease held by this
+// replica should move to in order to honor the lease preferences of the
+// zone, or nil if this replica satisfies them or no replica does.
+//
+// Any replica may still acquire the lease of the range, for instance when
+// the preferred replicas are unavailable; the replicate queue of the new
+// holder then hands the lease back to a preferred replica.
+func (r *Replica) leasePreferenceTarget(zone config.ZoneConfig) *roachpb.ReplicaDescriptor {
+	if len(zone.LeasePreferences) == 0 || !r.holdsActiveLease() {
+		return nil
+	}
+	preferred := preferredLeaseholders(zone.LeasePreferences, r.Desc().Replicas,
+		r.store.leaseholderAttrs)
+	if len(preferred) == 0 {
+		return nil
+	}
+	for _, rep := range preferred {
+		if rep.StoreID == r.store.StoreID() {
+			return nil
+		}
+	}
+	return &preferred[0]
+}
@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestPreferredLeaseholders verifies that the replicas satisfying the first
+// satisfiable lease preference are preferred, ignoring the replicas which
+// may not hold the lease.
+func TestPreferredLeaseholders(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	replicas := []roachpb.ReplicaDescriptor{
+		{NodeID: 1, StoreID: 1, ReplicaID: 1},
+		{NodeID: 2, StoreID: 2, ReplicaID: 2},
+		{NodeID: 3, StoreID: 3, ReplicaID: 3},
+		{NodeID: 4, StoreID: 4, ReplicaID: 4},
+	}
+	storeAttrs := map[roachpb.StoreID][]string{
+		1: {"us-west", "ssd"},
+		2: {"us-east", "hdd"},
+		3: {"us-east", "ssd"},
+		// Store 4 is dead.
+		4: {"eu", "ssd"},
+	}
+	attrs := func(rep roachpb.ReplicaDescriptor) (roachpb.Attributes, bool) {
+		return roachpb.Attributes{Attrs: storeAttrs[rep.StoreID]}, rep.StoreID != 4
+	}
+	preference := func(attrs ...string) roachpb.Attributes {
+		return roachpb.Attributes{Attrs: attrs}
+	}
+
+	testCases := []struct {
+		preferences []roachpb.Attributes
+		expected    []roachpb.StoreID
+	}{
+		// Without preferences, any replica may hold the lease.
+		{nil, nil},
+		{[]roachpb.Attributes{preference("us-east")}, []roachpb.StoreID{2, 3}},
+		{[]roachpb.Attributes{preference("us-east", "ssd")}, []roachpb.StoreID{3}},
+		// Unsatisfiable preferences are skipped.
+		{[]roachpb.Attributes{preference("asia"), preference("us-west")}, []roachpb.StoreID{1}},
+		{[]roachpb.Attributes{preference("eu"), preference("hdd")}, []roachpb.StoreID{2}},
+		{[]roachpb.Attributes{preference("ssd"), preference("us-east")}, []roachpb.StoreID{1, 3}},
+		{[]roachpb.Attributes{preference("asia")}, nil},
+	}
+	for i, c := range testCases {
+		var storeIDs []roachpb.StoreID
+		for _, rep := range preferredLeaseholders(c.preferences, replicas, attrs) {
+			storeIDs = append(storeIDs, rep.StoreID)
+		}
+		if !reflect.DeepEqual(storeIDs, c.expected) {
+			t.Errorf("%d: expected stores %v to be preferred; got %v", i, c.expected, storeIDs)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// DefaultLivenessThreshold is the duration for which a heartbeat keeps
+	// a node's liveness record live.
+	DefaultLivenessThreshold = 9 * time.Second
+	// DefaultLivenessHeartbeatInterval is the interval at which nodes
+	// heartbeat their liveness record. It must be comfortably smaller than
+	// the liveness threshold.
+	DefaultLivenessHeartbeatInterval = 3 * time.Second
+)
+
+// NodeLiveness keeps track of the liveness records of all nodes in the
+// cluster, which back epoch-based leader leases. Each node periodically
+// heartbeats its own record, extending its expiration, and gossips the
+// result. An epoch-based lease is valid for as long as the holder's record
+// carries the lease's epoch and is live; once a record has expired, any
+// other node may increment its epoch, invalidating all of the holder's
+// epoch-based leases at once.
+type NodeLiveness struct {
+	clock             *hlc.Clock
+	db                *client.DB
+	gossip            *gossip.Gossip
+	livenessThreshold time.Duration
+	heartbeatInterval time.Duration
+
+	mu    sync.Mutex // Protects nodes.
+	nodes map[roachpb.NodeID]roachpb.Liveness
+}
+
+// NewNodeLiveness creates a NodeLiveness and registers the liveness
+// updating callback with gossip.
+func NewNodeLiveness(clock *hlc.Clock, db *client.DB, g *gossip.Gossip,
+	livenessThreshold, heartbeatInterval time.Duration) *NodeLiveness {
+	nl := &NodeLiveness{
+		clock:             clock,
+		db:                db,
+		gossip:            g,
+		livenessThreshold: livenessThreshold,
+		heartbeatInterval: heartbeatInterval,
+		nodes:             map[roachpb.NodeID]roachpb.Liveness{},
+	}
+	livenessRegex := gossip.MakePrefixPattern(gossip.KeyNodeLivenessPrefix)
+	g.RegisterCallback(livenessRegex, nl.livenessGossipUpdate)
+	return nl
+}
+
+// livenessGossipUpdate is the gossip callback used to keep the liveness
+// records up to date.
+func (nl *NodeLiveness) livenessGossipUpdate(_ string, content []byte) {
+	var liveness roachpb.Liveness
+	if err := proto.Unmarshal(content, &liveness); err != nil {
+		log.Error(err)
+		return
+	}
+	nl.maybeUpdate(liveness)
+}
+
+// maybeUpdate stores the given liveness record unless a newer one is
+// already known.
+func (nl *NodeLiveness) maybeUpdate(liveness roachpb.Liveness) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+	if old, ok := nl.nodes[liveness.NodeID]; ok {
+		if liveness.Epoch < old.Epoch ||
+			(liveness.Epoch == old.Epoch && liveness.Expiration.Less(old.Expiration)) {
+			return
+		}
+	}
+	nl.nodes[liveness.NodeID] = liveness
+}
+
+// GetLiveness returns the most recent liveness record known for the given
+// node.
+func (nl *NodeLiveness) GetLiveness(nodeID roachpb.NodeID) (roachpb.Liveness, error) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+	liveness, ok := nl.nodes[nodeID]
+	if !ok {
+		return roachpb.Liveness{}, util.Errorf("liveness record for node %d not found", nodeID)
+	}
+	return liveness, nil
+}
+
+// StartHeartbeat starts a worker which periodically heartbeats the
+// liveness record of the given node.
+func (nl *NodeLiveness) StartHeartbeat(stopper *stop.Stopper, nodeID roachpb.NodeID) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(nl.heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			if err := nl.Heartbeat(nodeID); err != nil {
+				log.Warningf("failed node liveness heartbeat for node %d: %s", nodeID, err)
+			}
+			select {
+			case <-ticker.C:
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// Heartbeat extends the expiration of the given node's liveness record,
+// creating it at epoch one if it doesn't exist yet, and gossips the
+// result. If the epoch was incremented by another node in the meantime, the
+// new epoch is adopted; leases held at the previous epoch remain invalid.
+func (nl *NodeLiveness) Heartbeat(nodeID roachpb.NodeID) error {
+	key := keys.NodeLivenessKey(nodeID)
+	var liveness roachpb.Liveness
+	if err := nl.db.Txn(func(txn *client.Txn) error {
+		liveness = roachpb.Liveness{}
+		if err := txn.GetProto(key, &liveness); err != nil {
+			return err
+		}
+		if liveness.Epoch == 0 {
+			liveness = roachpb.Liveness{NodeID: nodeID, Epoch: 1}
+		}
+		liveness.Expiration = nl.clock.Now().Add(nl.livenessThreshold.Nanoseconds(), 0)
+		b := txn.NewBatch()
+		b.Put(key, &liveness)
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		return err
+	}
+	nl.maybeUpdate(liveness)
+	return nl.gossip.AddInfoProto(gossip.MakeNodeLivenessKey(nodeID), &liveness, 0)
+}
+
+// IncrementEpoch increments the epoch of the given liveness record,
+// invalidating all epoch-based leases held by the node at that epoch. The
+// record must have expired, taking into account the maximum clock offset.
+// If the epoch was already incremented, this is a no-op.
+func (nl *NodeLiveness) IncrementEpoch(liveness roachpb.Liveness) error {
+	key := keys.NodeLivenessKey(liveness.NodeID)
+	var newLiveness roachpb.Liveness
+	if err := nl.db.Txn(func(txn *client.Txn) error {
+		newLiveness = roachpb.Liveness{}
+		if err := txn.GetProto(key, &newLiveness); err != nil {
+			return err
+		}
+		if newLiveness.Epoch > liveness.Epoch {
+			return nil
+		} else if newLiveness.Epoch < liveness.Epoch {
+			return util.Errorf("unexpected liveness epoch %d for node %d; expected >= %d",
+				newLiveness.Epoch, liveness.NodeID, liveness.Epoch)
+		}
+		now := nl.clock.Now()
+		if newLiveness.IsLive(now.Add(-int64(nl.clock.MaxOffset()), 0)) {
+			return util.Errorf("cannot increment epoch of node %d: liveness record is live", liveness.NodeID)
+		}
+		newLiveness.Epoch++
+		b := txn.NewBatch()
+		b.Put(key, &newLiveness)
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		return err
+	}
+	nl.maybeUpdate(newLiveness)
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestNodeLivenessLeaseValidity verifies that epoch-based leases are valid
+// exactly while the holder's liveness record carries the lease's epoch and
+// is live, and that the replica GC queue judges their inactivity by the
+// liveness record instead of the (zero) lease expiration.
+func TestNodeLivenessLeaseValidity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	nl := NewNodeLiveness(tc.clock, tc.store.DB(), tc.gossip, time.Second, time.Second)
+	tc.store.ctx.NodeLiveness = nl
+
+	// Acquire an epoch-based lease once the current one has expired.
+	tc.manualClock.Increment(int64(DefaultLeaderLeaseDuration + 1))
+	now := tc.clock.Now()
+	_, replica := tc.rng.Desc().FindReplica(tc.store.StoreID())
+	ba := roachpb.BatchRequest{}
+	ba.CmdID = ba.GetOrCreateCmdID(0)
+	ba.Add(&roachpb.LeaderLeaseRequest{Lease: roachpb.Lease{
+		Start:   now,
+		Epoch:   2,
+		Replica: *replica,
+	}})
+	errChan, pendingCmd := tc.rng.proposeRaftCommand(tc.rng.context(), ba)
+	err := <-errChan
+	if err == nil {
+		err = (<-pendingCmd.done).Err
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease := tc.rng.getLease()
+
+	if tc.rng.leaseCovers(lease, now) {
+		t.Error("expected lease without a liveness record to be invalid")
+	}
+	if ts := tc.rng.leaseLastActive(lease); !ts.Equal(lease.Start) {
+		t.Errorf("expected lease without a liveness record to be last active at %s; got %s", lease.Start, ts)
+	}
+
+	expiration := now.Add(100, 0)
+	nl.maybeUpdate(roachpb.Liveness{NodeID: replica.NodeID, Epoch: 2, Expiration: expiration})
+	testCases := []struct {
+		ts       roachpb.Timestamp
+		expValid bool
+	}{
+		{now, true},
+		{now.Add(99, 0), true},
+		{expiration, false},
+		{now.Add(200, 0), false},
+	}
+	for i, test := range testCases {
+		if valid := tc.rng.leaseCovers(lease, test.ts); valid != test.expValid {
+			t.Errorf("%d: expected lease validity %t at %s; got %t", i, test.expValid, test.ts, valid)
+		}
+	}
+	if ts := tc.rng.leaseLastActive(lease); !ts.Equal(expiration) {
+		t.Errorf("expected lease to be last active at the liveness expiration; got %s", ts)
+	}
+
+	// The replica GC queue only considers the replica once the liveness
+	// record has been expired for the inactivity threshold.
+	inactive := expiration.Add(ReplicaGCQueueInactivityThreshold.Nanoseconds(), 0)
+	if should, _ := tc.store.replicaGCQueue.shouldQueue(inactive, tc.rng, nil); should {
+		t.Error("expected replica not to be queued at the inactivity threshold")
+	}
+	if should, _ := tc.store.replicaGCQueue.shouldQueue(inactive.Next(), tc.rng, nil); !should {
+		t.Error("expected replica to be queued after the inactivity threshold")
+	}
+
+	// Incrementing the epoch invalidates the lease, even though the new
+	// liveness record is live; its activity falls back to its start.
+	nl.maybeUpdate(roachpb.Liveness{NodeID: replica.NodeID, Epoch: 3, Expiration: now.Add(300, 0)})
+	if tc.rng.leaseCovers(lease, now.Add(200, 0)) {
+		t.Error("expected lease of a previous epoch to be invalid")
+	}
+	if ts := tc.rng.leaseLastActive(lease); !ts.Equal(lease.Start) {
+		t.Errorf("expected lease of a previous epoch to be last active at %s; got %s", lease.Start, ts)
+	}
+}
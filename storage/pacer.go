@@ -0,0 +1,192 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBackgroundLatencyThreshold is the mean foreground request
+	// latency above which background work is slowed down.
+	DefaultBackgroundLatencyThreshold = 500 * time.Millisecond
+	// DefaultBackgroundIOThreshold is the mean latency of committing applied
+	// raft commands to disk above which background work is slowed down.
+	DefaultBackgroundIOThreshold = 100 * time.Millisecond
+
+	// pacerWindow is the duration of the windows over which latencies are
+	// averaged. The current and the previous window are taken into account.
+	pacerWindow = 10 * time.Second
+	// maxBackgroundSlowdown bounds the factor by which background work is
+	// slowed down.
+	maxBackgroundSlowdown = 10
+)
+
+// A latencyWindow accumulates the latencies recorded during a window.
+type latencyWindow struct {
+	sum   time.Duration
+	count int64
+}
+
+// A backgroundPacer paces the replica scanner and queues according to the
+// load on the store. While the mean foreground request latency or the mean
+// latency of disk writes exceeds its threshold, background work is slowed
+// down proportionally. Background work may also be paused for a limited
+// duration, for example during bulk loads; it resumes automatically. All
+// methods may be called on a nil pacer, which never slows down or pauses.
+type backgroundPacer struct {
+	latencyThreshold time.Duration // Zero to ignore request latency
+	ioThreshold      time.Duration // Zero to ignore disk write latency
+
+	mu          sync.Mutex // Protects the fields below.
+	windowStart time.Time
+	latency, io [2]latencyWindow // The current and the previous window
+	pausedUntil time.Time
+	resumed     chan struct{} // Closed when a pause is lifted or shortened
+}
+
+// newBackgroundPacer creates a backgroundPacer with the given thresholds.
+func newBackgroundPacer(latencyThreshold, ioThreshold time.Duration) *backgroundPacer {
+	return &backgroundPacer{
+		latencyThreshold: latencyThreshold,
+		ioThreshold:      ioThreshold,
+	}
+}
+
+// rotateLocked starts a new window if the current one has ended.
+func (bp *backgroundPacer) rotateLocked(now time.Time) {
+	elapsed := now.Sub(bp.windowStart)
+	if elapsed < pacerWindow {
+		return
+	}
+	if elapsed >= 2*pacerWindow {
+		bp.latency[1], bp.io[1] = latencyWindow{}, latencyWindow{}
+	} else {
+		bp.latency[1], bp.io[1] = bp.latency[0], bp.io[0]
+	}
+	bp.latency[0], bp.io[0] = latencyWindow{}, latencyWindow{}
+	bp.windowStart = now
+}
+
+// recordRequestLatency records the latency of a foreground request.
+func (bp *backgroundPacer) recordRequestLatency(now time.Time, d time.Duration) {
+	if bp == nil {
+		return
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.rotateLocked(now)
+	bp.latency[0].sum += d
+	bp.latency[0].count++
+}
+
+// recordIOLatency records the latency of a disk write.
+func (bp *backgroundPacer) recordIOLatency(now time.Time, d time.Duration) {
+	if bp == nil {
+		return
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.rotateLocked(now)
+	bp.io[0].sum += d
+	bp.io[0].count++
+}
+
+// slowdownFactor returns the ratio of the mean latency recorded in the
+// given windows to the threshold, if it exceeds one.
+func slowdownFactor(windows [2]latencyWindow, threshold time.Duration) float64 {
+	count := windows[0].count + windows[1].count
+	if threshold <= 0 || count == 0 {
+		return 1
+	}
+	mean := (windows[0].sum + windows[1].sum) / time.Duration(count)
+	if mean <= threshold {
+		return 1
+	}
+	return float64(mean) / float64(threshold)
+}
+
+// slowdown returns the factor by which background work is currently slowed
+// down.
+func (bp *backgroundPacer) slowdown(now time.Time) float64 {
+	if bp == nil {
+		return 1
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.rotateLocked(now)
+	factor := slowdownFactor(bp.latency, bp.latencyThreshold)
+	if f := slowdownFactor(bp.io, bp.ioThreshold); f > factor {
+		factor = f
+	}
+	if factor > maxBackgroundSlowdown {
+		factor = maxBackgroundSlowdown
+	}
+	return factor
+}
+
+// pause pauses background work for the given duration. A non-positive
+// duration resumes background work immediately. Shortening a pause wakes
+// up all waiters on resumeCh so that they can recompute their timers.
+func (bp *backgroundPacer) pause(now time.Time, d time.Duration) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	pausedUntil := now.Add(d)
+	if pausedUntil.Before(bp.pausedUntil) && bp.resumed != nil {
+		close(bp.resumed)
+		bp.resumed = nil
+	}
+	bp.pausedUntil = pausedUntil
+}
+
+// resumeCh returns a channel which is closed the next time a pause is
+// lifted or shortened. A nil pacer returns a nil channel, which never
+// becomes ready.
+func (bp *backgroundPacer) resumeCh() <-chan struct{} {
+	if bp == nil {
+		return nil
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	if bp.resumed == nil {
+		bp.resumed = make(chan struct{})
+	}
+	return bp.resumed
+}
+
+// pauseRemaining returns the remaining duration for which background work
+// is paused.
+func (bp *backgroundPacer) pauseRemaining(now time.Time) time.Duration {
+	if bp == nil {
+		return 0
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	if remaining := bp.pausedUntil.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// pace returns the duration to wait before the next unit of background
+// work, given the interval which would be used on an idle store.
+func (bp *backgroundPacer) pace(now time.Time, interval time.Duration) time.Duration {
+	if bp == nil {
+		return interval
+	}
+	return time.Duration(float64(interval)*bp.slowdown(now)) + bp.pauseRemaining(now)
+}
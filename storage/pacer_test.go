@@ -0,0 +1,123 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestBackgroundPacerSlowdown verifies that background work is slowed down
+// in proportion to latencies above the thresholds, and that the slowdown
+// subsides once the latencies have aged out.
+func TestBackgroundPacerSlowdown(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	bp := newBackgroundPacer(100*time.Millisecond, 10*time.Millisecond)
+	now := time.Unix(0, 0)
+
+	if f := bp.slowdown(now); f != 1 {
+		t.Fatalf("expected no slowdown without samples, got %f", f)
+	}
+	bp.recordRequestLatency(now, 50*time.Millisecond)
+	bp.recordRequestLatency(now, 350*time.Millisecond)
+	if f := bp.slowdown(now); f != 2 {
+		t.Errorf("expected slowdown 2 from request latency, got %f", f)
+	}
+	bp.recordIOLatency(now, 40*time.Millisecond)
+	if f := bp.slowdown(now); f != 4 {
+		t.Errorf("expected slowdown 4 from disk write latency, got %f", f)
+	}
+	bp.recordIOLatency(now, time.Second)
+	if f := bp.slowdown(now); f != maxBackgroundSlowdown {
+		t.Errorf("expected slowdown to be capped at %d, got %f", maxBackgroundSlowdown, f)
+	}
+	if d := bp.pace(now, time.Second); d != maxBackgroundSlowdown*time.Second {
+		t.Errorf("expected paced interval of %s, got %s", maxBackgroundSlowdown*time.Second, d)
+	}
+
+	// Latencies are still taken into account during the next window...
+	now = now.Add(pacerWindow)
+	if f := bp.slowdown(now); f != maxBackgroundSlowdown {
+		t.Errorf("expected slowdown %d in the following window, got %f", maxBackgroundSlowdown, f)
+	}
+	// ...but not after that.
+	now = now.Add(pacerWindow)
+	if f := bp.slowdown(now); f != 1 {
+		t.Errorf("expected no slowdown after two windows, got %f", f)
+	}
+
+	// A nil pacer never slows down.
+	var nilPacer *backgroundPacer
+	if d := nilPacer.pace(now, time.Second); d != time.Second {
+		t.Errorf("expected nil pacer not to change interval, got %s", d)
+	}
+}
+
+// TestBackgroundPacerPause verifies that pausing background work extends
+// the paced interval until the pause has elapsed.
+func TestBackgroundPacerPause(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	bp := newBackgroundPacer(0, 0)
+	now := time.Unix(0, 0)
+
+	bp.pause(now, time.Minute)
+	if d := bp.pace(now.Add(20*time.Second), time.Second); d != 41*time.Second {
+		t.Errorf("expected paced interval of 41s while paused, got %s", d)
+	}
+	if d := bp.pauseRemaining(now.Add(time.Minute)); d != 0 {
+		t.Errorf("expected pause to have elapsed, got %s remaining", d)
+	}
+
+	bp.pause(now, time.Minute)
+	bp.pause(now, 0)
+	if d := bp.pauseRemaining(now); d != 0 {
+		t.Errorf("expected pause to be lifted, got %s remaining", d)
+	}
+}
+
+// TestBackgroundPacerResumeCh verifies that the channel returned by
+// resumeCh is closed when a pause is lifted, but not when it is extended.
+func TestBackgroundPacerResumeCh(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	bp := newBackgroundPacer(0, 0)
+	now := time.Unix(0, 0)
+
+	bp.pause(now, time.Minute)
+	ch := bp.resumeCh()
+	bp.pause(now, time.Hour)
+	select {
+	case <-ch:
+		t.Fatal("expected extending the pause not to close the resume channel")
+	default:
+	}
+
+	bp.pause(now, 0)
+	select {
+	case <-ch:
+	default:
+		t.Fatal("expected lifting the pause to close the resume channel")
+	}
+	if bp.resumeCh() == ch {
+		t.Error("expected a fresh resume channel after lifting the pause")
+	}
+
+	var nilPacer *backgroundPacer
+	if ch := nilPacer.resumeCh(); ch != nil {
+		t.Errorf("expected nil pacer to return a nil resume channel")
+	}
+}
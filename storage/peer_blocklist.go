@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+const (
+	// defaultPeerBlocklistWindow is the default window within which the
+	// rejected raft messages of a store are counted against the threshold.
+	defaultPeerBlocklistWindow = 10 * time.Second
+	// defaultPeerBlocklistDuration is the default time for which the raft
+	// messages of a blocklisted store are dropped.
+	defaultPeerBlocklistDuration = 30 * time.Second
+)
+
+// A peerBlocklist counts the raft messages from other stores which are
+// rejected because they are badly addressed or carry invalid snapshots. A
+// store whose rejected messages reach the threshold within the window is
+// blocklisted: its messages are dropped without being processed until the
+// blocklisting expires, so that a buggy or misconfigured node cannot
+// consume a disproportionate share of the raft processing of healthy
+// stores.
+type peerBlocklist struct {
+	threshold int
+	window    time.Duration
+	duration  time.Duration
+	metrics   *metric.Registry
+
+	mu    sync.Mutex
+	peers map[roachpb.StoreID]*peerRejections
+}
+
+// peerRejections holds the rejected messages of a store.
+type peerRejections struct {
+	windowStart  time.Time
+	count        int
+	blockedUntil time.Time
+}
+
+func newPeerBlocklist(threshold int, window, duration time.Duration, metrics *metric.Registry) *peerBlocklist {
+	return &peerBlocklist{
+		threshold: threshold,
+		window:    window,
+		duration:  duration,
+		metrics:   metrics,
+		peers:     map[roachpb.StoreID]*peerRejections{},
+	}
+}
+
+// blocked returns whether the messages of the given store are to be
+// dropped at the given time, counting them in the
+// "raft.messages.blocklisted" metric.
+func (bl *peerBlocklist) blocked(storeID roachpb.StoreID, now time.Time) bool {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	p, ok := bl.peers[storeID]
+	if !ok {
+		return false
+	}
+	if now.Before(p.blockedUntil) {
+		bl.metrics.Counter("raft.messages.blocklisted").Inc(1)
+		return true
+	}
+	if now.Sub(p.windowStart) >= bl.window {
+		// Both the blocklisting and the window have expired.
+		delete(bl.peers, storeID)
+	}
+	return false
+}
+
+// reject records a rejected message of the given store at the given time,
+// blocklisting the store if its rejected messages reach the threshold.
+func (bl *peerBlocklist) reject(storeID roachpb.StoreID, reason string, now time.Time) {
+	bl.metrics.Counter("raft.messages.rejected").Inc(1)
+	if bl.threshold <= 0 {
+		return
+	}
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	p, ok := bl.peers[storeID]
+	if !ok {
+		p = &peerRejections{windowStart: now}
+		bl.peers[storeID] = p
+	} else if now.Sub(p.windowStart) >= bl.window {
+		p.windowStart, p.count = now, 0
+	}
+	p.count++
+	if p.count == bl.threshold {
+		p.blockedUntil = now.Add(bl.duration)
+		bl.metrics.Counter("raft.peers.blocklisted").Inc(1)
+		log.Warningf("blocklisting store %d for %s after %d rejected raft messages (last: %s)",
+			storeID, bl.duration, p.count, reason)
+	}
+}
+
+// blockedPeers returns the stores which are blocklisted at the given time,
+// sorted by store ID.
+func (bl *peerBlocklist) blockedPeers(now time.Time) []roachpb.StoreID {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	var storeIDs []roachpb.StoreID
+	for storeID, p := range bl.peers {
+		if now.Before(p.blockedUntil) {
+			storeIDs = append(storeIDs, storeID)
+		}
+	}
+	sort.Sort(roachpb.StoreIDSlice(storeIDs))
+	return storeIDs
+}
+
+var _ multiraft.PeerFilter = &Store{}
+
+// AcceptMessageFrom implements the multiraft.PeerFilter interface. It
+// returns false for the stores which are blocklisted.
+func (s *Store) AcceptMessageFrom(storeID roachpb.StoreID) bool {
+	return !s.peerBlocklist.blocked(storeID, s.ctx.Clock.PhysicalTime())
+}
+
+// MessageRejected implements the multiraft.PeerFilter interface. It counts
+// the rejected message against the sending store's blocklist threshold.
+func (s *Store) MessageRejected(storeID roachpb.StoreID, reason string) {
+	s.peerBlocklist.reject(storeID, reason, s.ctx.Clock.PhysicalTime())
+}
+
+// BlocklistedPeers returns the IDs of the stores whose raft messages are
+// currently dropped by this store, sorted by store ID.
+func (s *Store) BlocklistedPeers() []roachpb.StoreID {
+	return s.peerBlocklist.blockedPeers(s.ctx.Clock.PhysicalTime())
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// TestPeerBlocklist verifies that a store is blocklisted once its rejected
+// messages reach the threshold within the window, and that the
+// blocklisting expires.
+func TestPeerBlocklist(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	registry := metric.NewRegistry()
+	bl := newPeerBlocklist(3, 10*time.Second, time.Minute, registry)
+	now := time.Unix(0, 0)
+
+	// Rejections spread over more than the window don't blocklist the store.
+	bl.reject(2, "malformed", now)
+	bl.reject(2, "malformed", now.Add(time.Second))
+	now = now.Add(11 * time.Second)
+	bl.reject(2, "malformed", now)
+	if bl.blocked(2, now) {
+		t.Fatal("expected store 2 not to be blocklisted")
+	}
+
+	// Rejections within the window do.
+	bl.reject(2, "malformed", now.Add(time.Second))
+	now = now.Add(2 * time.Second)
+	bl.reject(2, "malformed", now)
+	if !bl.blocked(2, now) {
+		t.Fatal("expected store 2 to be blocklisted")
+	}
+	if bl.blocked(3, now) {
+		t.Fatal("expected store 3 not to be blocklisted")
+	}
+	if peers := bl.blockedPeers(now); !reflect.DeepEqual(peers, []roachpb.StoreID{2}) {
+		t.Errorf("expected blocklisted stores [2]; got %v", peers)
+	}
+	if c := registry.Counter("raft.peers.blocklisted").Count(); c != 1 {
+		t.Errorf("expected 1 blocklisting; got %d", c)
+	}
+	if c := registry.Counter("raft.messages.rejected").Count(); c != 5 {
+		t.Errorf("expected 5 rejected messages; got %d", c)
+	}
+	if c := registry.Counter("raft.messages.blocklisted").Count(); c != 1 {
+		t.Errorf("expected 1 blocklisted message; got %d", c)
+	}
+
+	// The blocklisting expires.
+	now = now.Add(time.Minute)
+	if bl.blocked(2, now) {
+		t.Fatal("expected blocklisting of store 2 to have expired")
+	}
+	if peers := bl.blockedPeers(now); len(peers) != 0 {
+		t.Errorf("expected no blocklisted stores; got %v", peers)
+	}
+}
+
+// TestPeerBlocklistDisabled verifies that a zero (the default) or negative
+// threshold disables the blocklist.
+func TestPeerBlocklistDisabled(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	for _, threshold := range []int{0, -1} {
+		bl := newPeerBlocklist(threshold, 10*time.Second, time.Minute, metric.NewRegistry())
+		now := time.Unix(0, 0)
+		for i := 0; i < 10; i++ {
+			bl.reject(2, "malformed", now)
+		}
+		if bl.blocked(2, now) {
+			t.Errorf("%d: expected store 2 not to be blocklisted", threshold)
+		}
+	}
+}
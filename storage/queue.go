@@ -20,6 +20,7 @@ package storage
 import (
 	"container/heap"
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -80,6 +81,7 @@ func (pq *priorityQueue) update(item *replicaItem, priority float64) {
 var (
 	errQueueDisabled     = errors.New("queue disabled")
 	errReplicaNotAddable = errors.New("replica shouldn't be added to queue")
+	errReplicaExcluded   = errors.New("replica's range is excluded from queue")
 )
 
 type queueImpl interface {
@@ -105,6 +107,15 @@ type queueImpl interface {
 	timer() time.Duration
 }
 
+// A queueDescriber is a queueImpl which can describe the action process
+// would take on a replica without taking it. Queues in dry-run mode call
+// describe instead of process and log the result.
+type queueDescriber interface {
+	// describe returns a description of the action process would take
+	// on the replica, or an empty string if it would take none.
+	describe(roachpb.Timestamp, *Replica, *config.SystemConfig) (string, error)
+}
+
 // baseQueue is the base implementation of the replicaQueue interface.
 // Queue implementations should embed a baseQueue and implement queueImpl.
 //
@@ -127,6 +138,11 @@ type baseQueue struct {
 	replicas    map[roachpb.RangeID]*replicaItem // Map from RangeID to replicaItem (for updating priority)
 	// Some tests in this package disable queues.
 	disabled int32 // updated atomically
+	// In dry-run mode, the queue logs the actions it would take on the
+	// replicas it processes instead of taking them.
+	dryRun int32 // updated atomically
+	// pacer slows down or pauses processing under load. It may be nil.
+	pacer *backgroundPacer
 }
 
 // makeBaseQueue returns a new instance of baseQueue with the
@@ -154,6 +170,14 @@ func (bq *baseQueue) Length() int {
 	return bq.priorityQ.Len()
 }
 
+// contains returns whether the replica of the given range is queued.
+func (bq *baseQueue) contains(rangeID roachpb.RangeID) bool {
+	bq.Lock()
+	defer bq.Unlock()
+	_, ok := bq.replicas[rangeID]
+	return ok
+}
+
 // SetDisabled turns queue processing off or on as directed.
 func (bq *baseQueue) SetDisabled(disabled bool) {
 	if disabled {
@@ -163,6 +187,17 @@ func (bq *baseQueue) SetDisabled(disabled bool) {
 	}
 }
 
+// SetDryRun turns dry-run mode on or off as directed. In dry-run mode, the
+// queue evaluates the replicas it processes and logs its decisions
+// without acting on them.
+func (bq *baseQueue) SetDryRun(dryRun bool) {
+	if dryRun {
+		atomic.StoreInt32(&bq.dryRun, 1)
+	} else {
+		atomic.StoreInt32(&bq.dryRun, 0)
+	}
+}
+
 // Start launches a goroutine to process entries in the queue. The
 // provided stopper is used to finish processing.
 func (bq *baseQueue) Start(clock *hlc.Clock, stopper *stop.Stopper) {
@@ -173,8 +208,12 @@ func (bq *baseQueue) Start(clock *hlc.Clock, stopper *stop.Stopper) {
 // value of bq.shouldQueue. The replica is added with specified
 // priority. If the queue is too full, the replica may not be added, as
 // the replica with the lowest priority will be dropped. Returns an
-// error if the replica was not added.
+// error if the replica was not added, e.g. because its range is
+// excluded from the queue.
 func (bq *baseQueue) Add(repl *Replica, priority float64) error {
+	if repl.isQueueExcluded(bq.name) {
+		return errReplicaExcluded
+	}
 	bq.Lock()
 	defer bq.Unlock()
 	return bq.addInternal(repl, true, priority)
@@ -184,8 +223,15 @@ func (bq *baseQueue) Add(repl *Replica, priority float64) error {
 // should be queued. Replicas are added to the queue using the priority
 // returned by bq.shouldQueue. If the queue is too full, the replica may
 // not be added, as the replica with the lowest priority will be
-// dropped.
+// dropped. Replicas of ranges excluded from the queue are not added.
 func (bq *baseQueue) MaybeAdd(repl *Replica, now roachpb.Timestamp) {
+	if repl.isQueueExcluded(bq.name) {
+		if log.V(3) {
+			log.Infof("range %s is excluded from %s queue; not adding", repl, bq.name)
+		}
+		return
+	}
+
 	// Load the system config.
 	cfg := bq.gossip.GetSystemConfig()
 	if cfg == nil {
@@ -283,6 +329,11 @@ func (bq *baseQueue) processLoop(clock *hlc.Clock, stopper *stop.Stopper) {
 		immediately := make(chan time.Time)
 		close(immediately)
 
+		// resumed is closed when a pause of background work is lifted. It
+		// is refreshed before the pause is consulted so that a resume
+		// racing with the check isn't missed.
+		resumed := bq.pacer.resumeCh()
+
 		for {
 			select {
 			// Incoming signal sets the next time to process if there were previously
@@ -298,13 +349,26 @@ func (bq *baseQueue) processLoop(clock *hlc.Clock, stopper *stop.Stopper) {
 				}
 			// Process replicas as the timer expires.
 			case <-nextTime:
+				resumed = bq.pacer.resumeCh()
+				if d := bq.pacer.pauseRemaining(time.Now()); d > 0 {
+					nextTime = time.After(d)
+					continue
+				}
 				stopper.RunTask(func() {
 					bq.processOne(clock)
 				})
 				if bq.Length() == 0 {
 					nextTime = nil
 				} else {
-					nextTime = time.After(bq.impl.timer())
+					nextTime = time.After(bq.pacer.pace(time.Now(), bq.impl.timer()))
+				}
+
+			// A lifted pause processes the next replica right away instead
+			// of sleeping out the remainder of the pause.
+			case <-resumed:
+				resumed = bq.pacer.resumeCh()
+				if nextTime != nil {
+					nextTime = immediately
 				}
 
 			// Exit on stopper.
@@ -328,6 +392,14 @@ func (bq *baseQueue) processOne(clock *hlc.Clock) {
 	if repl == nil {
 		return
 	}
+	// The range may have been excluded from the queue since the replica
+	// was added.
+	if repl.isQueueExcluded(bq.name) {
+		if log.V(3) {
+			log.Infof("range %s is excluded from %s queue; skipping processing", repl, bq.name)
+		}
+		return
+	}
 
 	now := clock.Now()
 
@@ -348,6 +420,11 @@ func (bq *baseQueue) processOne(clock *hlc.Clock) {
 		return
 	}
 
+	if atomic.LoadInt32(&bq.dryRun) == 1 {
+		bq.describeOne(now, repl, cfg)
+		return
+	}
+
 	if log.V(3) {
 		log.Infof("processing replica %s from %s queue...", repl, bq.name)
 	}
@@ -371,6 +448,37 @@ func (bq *baseQueue) processOne(clock *hlc.Clock) {
 	}
 }
 
+// describeOne logs the action the queue would take on the replica. Unlike
+// processOne, it acquires no leader lease: replicas of queues which need
+// the lease are only described if they already hold it.
+func (bq *baseQueue) describeOne(now roachpb.Timestamp, repl *Replica, cfg *config.SystemConfig) {
+	if bq.impl.needsLeaderLease() {
+		if lease := repl.getLease(); !lease.OwnedBy(repl.store.StoreID()) || !repl.leaseCovers(lease, now) {
+			if log.V(3) {
+				log.Infof("dry run: replica %s does not hold the leader lease; skipping...", repl)
+			}
+			return
+		}
+	}
+	var action string
+	if d, ok := bq.impl.(queueDescriber); ok {
+		var err error
+		if action, err = d.describe(now, repl, cfg); err != nil {
+			log.Errorf("dry run: failure evaluating replica %s from %s queue: %s", repl, bq.name, err)
+			return
+		}
+	} else if shouldQ, priority := bq.impl.shouldQueue(now, repl, cfg); shouldQ {
+		action = fmt.Sprintf("would process (priority=%.2f)", priority)
+	}
+	if action == "" {
+		if log.V(2) {
+			log.Infof("dry run: %s queue would take no action on replica %s", bq.name, repl)
+		}
+		return
+	}
+	log.Infof("dry run: %s queue: replica %s: %s", bq.name, repl, action)
+}
+
 // pop dequeues the highest priority replica in the queue. Returns the
 // replica if not empty; otherwise, returns nil. Expects mutex to be
 // locked.
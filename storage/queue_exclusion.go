@@ -0,0 +1,128 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+)
+
+// A range may be excluded from individual replica queues, e.g. to keep
+// the GC queue off a range whose history is under investigation. The
+// exclusions are persisted in range-ID-local keys (one per excluded
+// queue, see keys.RangeQueueExclusionKey) which are written through Raft,
+// so that they apply to every replica of the range and survive restarts,
+// and which are copied to the new range on a split. Each replica caches
+// the names of the queues its range is excluded from, reloading them
+// whenever an exclusion is set or lifted.
+
+// queues returns the replica queues of the store.
+func (s *Store) queues() []*baseQueue {
+	return []*baseQueue{
+		&s.gcQueue.baseQueue,
+		&s.splitQueue.baseQueue,
+		&s.verifyQueue.baseQueue,
+		&s.replicateQueue.baseQueue,
+		&s.replicaGCQueue.baseQueue,
+		&s.raftLogQueue.baseQueue,
+	}
+}
+
+// isQueueExcluded returns whether the range is excluded from the named
+// queue.
+func (r *Replica) isQueueExcluded(queue string) bool {
+	r.exclusionMu.Lock()
+	defer r.exclusionMu.Unlock()
+	_, ok := r.excludedQueues[queue]
+	return ok
+}
+
+// QueueExclusions returns the names of the queues the range is excluded
+// from, sorted.
+func (r *Replica) QueueExclusions() []string {
+	r.exclusionMu.Lock()
+	defer r.exclusionMu.Unlock()
+	var queues []string
+	for queue := range r.excludedQueues {
+		queues = append(queues, queue)
+	}
+	sort.Strings(queues)
+	return queues
+}
+
+// readQueueExclusions reads the queue exclusions of the given range from
+// the engine.
+func readQueueExclusions(eng engine.Engine, rangeID roachpb.RangeID) (map[string]struct{}, error) {
+	prefix := keys.RangeQueueExclusionPrefix(rangeID)
+	kvs, _, err := engine.MVCCScan(eng, prefix, prefix.PrefixEnd(), 0, roachpb.MaxTimestamp,
+		true /* consistent */, nil)
+	if err != nil || len(kvs) == 0 {
+		return nil, err
+	}
+	excluded := make(map[string]struct{}, len(kvs))
+	for _, kv := range kvs {
+		excluded[string(kv.Key[len(prefix):])] = struct{}{}
+	}
+	return excluded, nil
+}
+
+// loadQueueExclusions reads the queue exclusions of the range from the
+// engine and caches them. Uninitialized replicas have no exclusions.
+func (r *Replica) loadQueueExclusions(eng engine.Engine) error {
+	if !r.isInitialized() {
+		return nil
+	}
+	excluded, err := readQueueExclusions(eng, r.Desc().RangeID)
+	if err != nil {
+		return err
+	}
+	r.exclusionMu.Lock()
+	r.excludedQueues = excluded
+	r.exclusionMu.Unlock()
+	return nil
+}
+
+// copyQueueExclusions copies the queue exclusions of the range to the
+// range created by a split of it, both in the batch and in the cache of
+// the new range's replica.
+func (r *Replica) copyQueueExclusions(batch engine.Engine, newRng *Replica) error {
+	prefix := keys.RangeQueueExclusionPrefix(r.Desc().RangeID)
+	kvs, _, err := engine.MVCCScan(batch, prefix, prefix.PrefixEnd(), 0, roachpb.MaxTimestamp,
+		true /* consistent */, nil)
+	if err != nil || len(kvs) == 0 {
+		return err
+	}
+	excluded := make(map[string]struct{}, len(kvs))
+	for _, kv := range kvs {
+		queue := string(kv.Key[len(prefix):])
+		var ts roachpb.Timestamp
+		if err := kv.Value.GetProto(&ts); err != nil {
+			return err
+		}
+		key := keys.RangeQueueExclusionKey(newRng.Desc().RangeID, queue)
+		if err := engine.MVCCPutProto(batch, nil, key, roachpb.ZeroTimestamp, nil, &ts); err != nil {
+			return err
+		}
+		excluded[queue] = struct{}{}
+	}
+	newRng.exclusionMu.Lock()
+	newRng.excludedQueues = excluded
+	newRng.exclusionMu.Unlock()
+	return nil
+}
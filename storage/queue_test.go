@@ -296,6 +296,107 @@ func TestBaseQueueProcess(t *testing.T) {
 	}
 }
 
+// testDescribingQueueImpl is a testQueueImpl which also implements
+// queueDescriber.
+type testDescribingQueueImpl struct {
+	testQueueImpl
+	described int32
+}
+
+func (tq *testDescribingQueueImpl) describe(now roachpb.Timestamp, r *Replica, _ *config.SystemConfig) (string, error) {
+	atomic.AddInt32(&tq.described, 1)
+	return "would do nothing in particular", nil
+}
+
+// TestBaseQueueDryRun verifies that a queue in dry-run mode describes the
+// replicas it processes instead of processing them, and resumes processing
+// once dry-run mode is turned off.
+func TestBaseQueueDryRun(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, stopper := gossipForTest(t)
+	defer stopper.Stop()
+
+	r1 := &Replica{}
+	if err := r1.setDesc(&roachpb.RangeDescriptor{RangeID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	r2 := &Replica{}
+	if err := r2.setDesc(&roachpb.RangeDescriptor{RangeID: 2}); err != nil {
+		t.Fatal(err)
+	}
+	testQueue := &testDescribingQueueImpl{
+		testQueueImpl: testQueueImpl{
+			shouldQueueFn: func(now roachpb.Timestamp, r *Replica) (shouldQueue bool, priority float64) {
+				return true, 1.0
+			},
+		},
+	}
+	bq := makeBaseQueue("test", testQueue, g, 2)
+	bq.SetDryRun(true)
+	mc := hlc.NewManualClock(0)
+	clock := hlc.NewClock(mc.UnixNano)
+	bq.Start(clock, stopper)
+
+	bq.MaybeAdd(r1, roachpb.ZeroTimestamp)
+	if err := util.IsTrueWithin(func() bool {
+		return atomic.LoadInt32(&testQueue.described) == 1
+	}, 250*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if pc := atomic.LoadInt32(&testQueue.processed); pc != 0 {
+		t.Errorf("expected no processed ranges in dry-run mode; got %d", pc)
+	}
+
+	bq.SetDryRun(false)
+	bq.MaybeAdd(r2, roachpb.ZeroTimestamp)
+	if err := util.IsTrueWithin(func() bool {
+		return atomic.LoadInt32(&testQueue.processed) == 1
+	}, 250*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if dc := atomic.LoadInt32(&testQueue.described); dc != 1 {
+		t.Errorf("expected 1 described range; got %d", dc)
+	}
+}
+
+// TestBaseQueuePauseResume verifies that a queue doesn't process replicas
+// while background work is paused, and that lifting the pause wakes it up
+// without waiting for the pause to elapse.
+func TestBaseQueuePauseResume(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, stopper := gossipForTest(t)
+	defer stopper.Stop()
+
+	r := &Replica{}
+	if err := r.setDesc(&roachpb.RangeDescriptor{RangeID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	testQueue := &testQueueImpl{
+		shouldQueueFn: func(now roachpb.Timestamp, r *Replica) (bool, float64) {
+			return true, 1.0
+		},
+	}
+	bq := makeBaseQueue("test", testQueue, g, 2)
+	bq.pacer = newBackgroundPacer(0, 0)
+	bq.pacer.pause(time.Now(), time.Hour)
+	mc := hlc.NewManualClock(0)
+	clock := hlc.NewClock(mc.UnixNano)
+	bq.Start(clock, stopper)
+
+	bq.MaybeAdd(r, roachpb.ZeroTimestamp)
+	time.Sleep(10 * time.Millisecond)
+	if pc := atomic.LoadInt32(&testQueue.processed); pc != 0 {
+		t.Fatalf("expected no processed ranges while paused; got %d", pc)
+	}
+
+	bq.pacer.pause(time.Now(), 0)
+	if err := util.IsTrueWithin(func() bool {
+		return atomic.LoadInt32(&testQueue.processed) == 1
+	}, 250*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestBaseQueueAddRemove adds then removes a range; ensure range is
 // not processed.
 func TestBaseQueueAddRemove(t *testing.T) {
@@ -337,6 +438,51 @@ func TestBaseQueueAddRemove(t *testing.T) {
 	}
 }
 
+// TestBaseQueueExcludedRange verifies that replicas of ranges excluded
+// from a queue are neither added to nor processed by the queue.
+func TestBaseQueueExcludedRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, stopper := gossipForTest(t)
+	defer stopper.Stop()
+
+	r1 := &Replica{excludedQueues: map[string]struct{}{"test": {}}}
+	if err := r1.setDesc(&roachpb.RangeDescriptor{RangeID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	r2 := &Replica{}
+	if err := r2.setDesc(&roachpb.RangeDescriptor{RangeID: 2}); err != nil {
+		t.Fatal(err)
+	}
+	testQueue := &testQueueImpl{
+		shouldQueueFn: func(now roachpb.Timestamp, r *Replica) (shouldQueue bool, priority float64) {
+			return true, 1.0
+		},
+	}
+	bq := makeBaseQueue("test", testQueue, g, 2)
+	bq.MaybeAdd(r1, roachpb.ZeroTimestamp)
+	if err := bq.Add(r1, 1.0); err != errReplicaExcluded {
+		t.Errorf("expected %s; got %v", errReplicaExcluded, err)
+	}
+	if bq.Length() != 0 {
+		t.Fatalf("expected length 0; got %d", bq.Length())
+	}
+
+	// Exclude r2 after it has been queued.
+	bq.MaybeAdd(r2, roachpb.ZeroTimestamp)
+	if bq.Length() != 1 {
+		t.Fatalf("expected length 1; got %d", bq.Length())
+	}
+	r2.excludedQueues = map[string]struct{}{"test": {}}
+	mc := hlc.NewManualClock(0)
+	bq.processOne(hlc.NewClock(mc.UnixNano))
+	if pc := atomic.LoadInt32(&testQueue.processed); pc != 0 {
+		t.Errorf("expected processed count of 0; got %d", pc)
+	}
+	if bq.Length() != 0 {
+		t.Errorf("expected length 0; got %d", bq.Length())
+	}
+}
+
 // TestAcceptsUnsplitRanges verifies that ranges that need to split are properly
 // rejected when the queue has 'acceptsUnsplitRanges = false'.
 func TestAcceptsUnsplitRanges(t *testing.T) {
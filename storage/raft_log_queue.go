@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -39,6 +40,11 @@ const (
 	// entries. A stale entry is one which all replicas of the range have
 	// progressed past and thus is no longer needed and can be pruned.
 	RaftLogQueueStaleThreshold = 1
+	// raftLogCatchUpEntries is the number of log entries by which a
+	// follower may lag behind the snapshot it is sent for the snapshot to be
+	// considered avoidable: such a follower would have caught up from the
+	// log cheaply had the log not been truncated past its progress.
+	raftLogCatchUpEntries = 100
 )
 
 // raftLogQueue manages a queue of replicas slated to have their raft logs
@@ -79,9 +85,15 @@ func getTruncatableIndexes(r *Replica) (uint64, uint64, error) {
 		return 0, 0, nil
 	}
 
-	// Find the oldest index still in use by the range.
+	// Find the oldest index still in use by the range. While a follower is
+	// being caught up by a snapshot, the log isn't truncated: the entries
+	// following the snapshot are needed to catch it up the rest of the way,
+	// and truncating them would cause another snapshot to be sent.
 	oldestIndex := raftStatus.Applied
 	for _, progress := range raftStatus.Progress {
+		if progress.State == raft.ProgressStateSnapshot {
+			return 0, 0, nil
+		}
 		if progress.Match < oldestIndex {
 			oldestIndex = progress.Match
 		}
@@ -137,6 +149,19 @@ func (rlq *raftLogQueue) process(now roachpb.Timestamp, r *Replica, _ *config.Sy
 	return nil
 }
 
+// describe returns the truncation process would perform on the replica's
+// raft log, if any.
+func (*raftLogQueue) describe(now roachpb.Timestamp, r *Replica, _ *config.SystemConfig) (string, error) {
+	truncatableIndexes, oldestIndex, err := getTruncatableIndexes(r)
+	if err != nil {
+		return "", err
+	}
+	if truncatableIndexes > RaftLogQueueStaleThreshold {
+		return fmt.Sprintf("would truncate %d raft log entries up to index %d", truncatableIndexes, oldestIndex), nil
+	}
+	return "", nil
+}
+
 // timer returns interval between processing successive queued truncations.
 func (*raftLogQueue) timer() time.Duration {
 	return RaftLogQueueTimerDuration
@@ -0,0 +1,236 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// orphanedRaftStateGCInterval is the interval at which the store sweeps for
+// orphaned raft state.
+const orphanedRaftStateGCInterval = 10 * time.Minute
+
+// findOrphanedRangeIDs returns the IDs of the ranges which have Range ID
+// local data (such as a raft log or HardState) other than a raft tombstone
+// on the store, but which have
+// neither a replica on the store nor an entry in the replica descriptor
+// index. Such data is left behind when a store crashes while holding an
+// uninitialized replica, or while removing a replica.
+func (s *Store) findOrphanedRangeIDs() ([]roachpb.RangeID, error) {
+	iter := s.engine.NewIterator()
+	defer iter.Close()
+
+	var rangeIDs []roachpb.RangeID
+	end := engine.MVCCEncodeKey(roachpb.Key(keys.LocalRangeIDPrefix).PrefixEnd())
+	for iter.Seek(engine.MVCCEncodeKey(roachpb.Key(keys.LocalRangeIDPrefix))); iter.Valid(); {
+		if bytes.Compare(iter.Key(), end) >= 0 {
+			break
+		}
+		key, _, _, err := engine.MVCCDecodeKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		rangeID, err := keys.DecodeRangeIDPrefix(key)
+		if err != nil {
+			return nil, err
+		}
+		if key.Equal(keys.RaftTombstoneKey(rangeID)) {
+			iter.Next()
+			continue
+		}
+		// Skip the remaining keys of the range.
+		iter.Seek(engine.MVCCEncodeKey(keys.MakeRangeIDPrefix(rangeID).PrefixEnd()))
+
+		orphaned, err := s.isOrphanedRangeID(rangeID)
+		if err != nil {
+			return nil, err
+		}
+		if orphaned {
+			rangeIDs = append(rangeIDs, rangeID)
+		}
+	}
+	return rangeIDs, iter.Error()
+}
+
+// isOrphanedRangeID returns true if the store has neither a replica nor an
+// entry in the replica descriptor index for the given range.
+func (s *Store) isOrphanedRangeID(rangeID roachpb.RangeID) (bool, error) {
+	if _, ok := s.replicas.get(rangeID); ok {
+		return false, nil
+	}
+	var desc roachpb.RangeDescriptor
+	ok, err := engine.MVCCGetProto(s.engine, keys.StoreReplicaDescriptorKey(rangeID),
+		roachpb.ZeroTimestamp, true, nil, &desc)
+	return !ok, err
+}
+
+// gcOrphanedRaftState purges the Range ID local data of the given orphaned
+// ranges, except for their raft tombstones, after verifying against the meta
+// records that the store isn't supposed to hold a replica of them. If the
+// range still exists, a tombstone is left behind so that stale raft messages
+// cannot recreate the replica. Returns the number of ranges purged.
+func (s *Store) gcOrphanedRaftState(rangeIDs []roachpb.RangeID) (int, error) {
+	if len(rangeIDs) == 0 {
+		return 0, nil
+	}
+	metaDescs, err := s.lookupMetaDescriptors(rangeIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, rangeID := range rangeIDs {
+		desc, inMeta := metaDescs[rangeID]
+		if inMeta {
+			if idx, _ := desc.FindReplica(s.StoreID()); idx >= 0 {
+				// The store is a member of the range, but hasn't received a
+				// snapshot yet. Keep the raft state.
+				continue
+			}
+		}
+		ok, err := s.purgeOrphanedRaftState(rangeID, desc.NextReplicaID)
+		if err != nil {
+			return purged, err
+		}
+		if ok {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// lookupMetaDescriptors scans the meta records for the descriptors of the
+// given ranges.
+func (s *Store) lookupMetaDescriptors(rangeIDs []roachpb.RangeID) (map[roachpb.RangeID]roachpb.RangeDescriptor, error) {
+	wanted := map[roachpb.RangeID]struct{}{}
+	for _, rangeID := range rangeIDs {
+		wanted[rangeID] = struct{}{}
+	}
+	rows, err := s.db.Scan(keys.Meta2Prefix, keys.MetaMax, 0)
+	if err != nil {
+		return nil, err
+	}
+	descs := map[roachpb.RangeID]roachpb.RangeDescriptor{}
+	for _, row := range rows {
+		var desc roachpb.RangeDescriptor
+		if err := row.ValueProto(&desc); err != nil {
+			return nil, err
+		}
+		if _, ok := wanted[desc.RangeID]; ok {
+			descs[desc.RangeID] = desc
+		}
+	}
+	return descs, nil
+}
+
+// purgeOrphanedRaftState clears the Range ID local data of the given range,
+// leaving a tombstone with at least the supplied next replica ID (if
+// non-zero). The store's lock is held so that no replica of the range can be
+// created concurrently. Returns false if the range is no longer orphaned.
+func (s *Store) purgeOrphanedRaftState(rangeID roachpb.RangeID, nextReplicaID roachpb.ReplicaID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if orphaned, err := s.isOrphanedRangeID(rangeID); err != nil || !orphaned {
+		return false, err
+	}
+
+	batch := s.engine.NewBatch()
+	defer batch.Close()
+	prefix := keys.MakeRangeIDPrefix(rangeID)
+	tombstoneKey := keys.RaftTombstoneKey(rangeID)
+	encTombstoneKey := engine.MVCCEncodeKey(tombstoneKey)
+	if err := s.engine.Iterate(engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()),
+		func(kv roachpb.RawKeyValue) (bool, error) {
+			if !bytes.Equal(kv.Key, encTombstoneKey) {
+				_ = batch.Clear(kv.Key)
+			}
+			return false, nil
+		}); err != nil {
+		return false, err
+	}
+
+	if nextReplicaID != 0 {
+		var tombstone roachpb.RaftTombstone
+		if _, err := engine.MVCCGetProto(s.engine, tombstoneKey, roachpb.ZeroTimestamp, true, nil, &tombstone); err != nil {
+			return false, err
+		}
+		if tombstone.NextReplicaID < nextReplicaID {
+			tombstone.NextReplicaID = nextReplicaID
+			if err := engine.MVCCPutProto(batch, nil, tombstoneKey, roachpb.ZeroTimestamp, nil, &tombstone); err != nil {
+				return false, err
+			}
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		return false, err
+	}
+	log.Infof("store %s: purged orphaned raft state of range %d", s, rangeID)
+	return true, nil
+}
+
+// startOrphanedRaftStateGC starts a worker which periodically sweeps the
+// store for orphaned raft state. Only ranges found orphaned by two
+// consecutive sweeps (the first of which may be the one performed on
+// startup) are purged, which guards against transient states such as a
+// replica whose data has been written but which hasn't yet been added to
+// the store.
+func (s *Store) startOrphanedRaftStateGC(candidates []roachpb.RangeID) {
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(orphanedRaftStateGCInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.stopper.RunTask(func() {
+					candidates = s.sweepOrphanedRaftState(candidates)
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// sweepOrphanedRaftState purges the raft state of the ranges which are
+// still orphaned among the candidates found by the previous sweep and
+// returns the candidates for the next sweep.
+func (s *Store) sweepOrphanedRaftState(candidates []roachpb.RangeID) []roachpb.RangeID {
+	orphans, err := s.findOrphanedRangeIDs()
+	if err != nil {
+		log.Warningf("store %s: unable to find orphaned raft state: %s", s, err)
+		return nil
+	}
+	previous := map[roachpb.RangeID]struct{}{}
+	for _, rangeID := range candidates {
+		previous[rangeID] = struct{}{}
+	}
+	var confirmed []roachpb.RangeID
+	for _, rangeID := range orphans {
+		if _, ok := previous[rangeID]; ok {
+			confirmed = append(confirmed, rangeID)
+		}
+	}
+	if _, err := s.gcOrphanedRaftState(confirmed); err != nil {
+		log.Warningf("store %s: unable to purge orphaned raft state: %s", s, err)
+	}
+	return orphans
+}
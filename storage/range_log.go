@@ -0,0 +1,158 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// DefaultRangeLogTTL is the default duration for which range event log
+	// entries are retained.
+	DefaultRangeLogTTL = 30 * 24 * time.Hour
+
+	// rangeLogGCInterval is the interval at which expired range event log
+	// entries are removed.
+	rangeLogGCInterval = time.Hour
+
+	// DefaultRangeLogReadLimit is the default number of events returned
+	// by a single read of the range event log.
+	DefaultRangeLogReadLimit = 1000
+
+	// maxRangeChangeHistory is the number of replica changes retained in
+	// the change history of each range.
+	maxRangeChangeHistory = 32
+)
+
+// logRangeEvent adds a put of the given event to the range event log to the
+// batch. Range lifecycle events are logged in the transaction which effects
+// them, so that the log never records events which did not happen.
+func logRangeEvent(b *client.Batch, event *RangeLogEvent) {
+	b.Put(keys.RangeLogKey(event.Timestamp, event.RangeID), event)
+}
+
+// ReadRangeLog returns up to limit events from the range event log, oldest
+// first, starting at the given key, or at the beginning of the log if start
+// is nil. If rangeID is nonzero, only events which involve that range are
+// returned. The log is scanned in chunks of limit entries, so that a read
+// never holds more than a bounded number of entries in memory. If more
+// events may follow, the returned key is the one from which to continue;
+// otherwise it is nil.
+func ReadRangeLog(db *client.DB, rangeID roachpb.RangeID, start roachpb.Key, limit int) ([]RangeLogEvent, roachpb.Key, error) {
+	if limit <= 0 {
+		return nil, nil, util.Errorf("invalid range event log limit %d", limit)
+	}
+	if start == nil {
+		start = keys.RangeLogPrefix
+	}
+	var events []RangeLogEvent
+	for {
+		rows, err := db.Scan(start, keys.RangeLogKeyMax, int64(limit))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			var event RangeLogEvent
+			if err := row.ValueProto(&event); err != nil {
+				return nil, nil, err
+			}
+			if rangeID != 0 && event.RangeID != rangeID && event.OtherRangeID != rangeID {
+				continue
+			}
+			events = append(events, event)
+			if len(events) == limit {
+				return events, roachpb.Key(row.Key).Next(), nil
+			}
+		}
+		if len(rows) < limit {
+			return events, nil, nil
+		}
+		start = roachpb.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// logReplicaChange adds the given replica change event to the batch, both
+// to the range event log and to the change history kept alongside the
+// range, dropping the oldest entries of the history beyond
+// maxRangeChangeHistory. Unlike the event log, which is pruned after a
+// while, the history of a range stays around for as long as the range does.
+func logReplicaChange(txn *client.Txn, b *client.Batch, event *RangeLogEvent) error {
+	startKey := event.UpdatedDesc.StartKey
+	prefix := keys.RangeChangeHistoryPrefix(startKey)
+	rows, err := txn.ScanKeys(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return err
+	}
+	for i := 0; i <= len(rows)-maxRangeChangeHistory; i++ {
+		b.Del(rows[i].Key)
+	}
+	logRangeEvent(b, event)
+	b.Put(keys.RangeChangeHistoryKey(startKey, event.Timestamp), event)
+	return nil
+}
+
+// ReadRangeChangeHistory returns the recent replica changes of the range
+// with the given start key, oldest first.
+func ReadRangeChangeHistory(db *client.DB, startKey roachpb.RKey) ([]RangeLogEvent, error) {
+	prefix := keys.RangeChangeHistoryPrefix(startKey)
+	rows, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]RangeLogEvent, len(rows))
+	for i, row := range rows {
+		if err := row.ValueProto(&events[i]); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// pruneRangeLog removes all range event log entries for events which
+// happened before the given timestamp.
+func pruneRangeLog(db *client.DB, before roachpb.Timestamp) error {
+	return db.DelRange(keys.RangeLogPrefix, keys.RangeLogKey(before, 0))
+}
+
+// startRangeLogGC starts a worker which periodically removes range event
+// log entries older than the configured retention period. Since removal is
+// idempotent, every store may run it.
+func (s *Store) startRangeLogGC() {
+	if s.ctx.RangeLogTTL <= 0 {
+		return
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(rangeLogGCInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				before := s.ctx.Clock.Now().Add(-s.ctx.RangeLogTTL.Nanoseconds(), 0)
+				if err := pruneRangeLog(s.db, before); err != nil {
+					log.Warningf("unable to prune range event log: %s", err)
+				}
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
@@ -40,21 +40,48 @@ type treeContext struct {
 	nodes map[string]cachedNode
 }
 
-// SetupRangeTree creates a new RangeTree. This should only be called as part
-// of store.BootstrapRange.
-func SetupRangeTree(batch engine.Engine, ms *engine.MVCCStats, timestamp roachpb.Timestamp, startKey roachpb.RKey) error {
-	tree := &roachpb.RangeTree{
-		RootKey: startKey,
+// SetupRangeTree creates a new RangeTree holding the ranges starting at the
+// given sorted start keys. This should only be called as part of
+// store.BootstrapRange.
+func SetupRangeTree(batch engine.Engine, ms *engine.MVCCStats, timestamp roachpb.Timestamp, startKeys []roachpb.RKey) error {
+	if len(startKeys) == 0 {
+		return util.Errorf("cannot set up a range tree without ranges")
+	}
+	// Build a balanced tree from the sorted keys. All levels of such a tree
+	// but the deepest one are full, so it is a valid red-black tree if the
+	// nodes of the deepest level (other than the root) are red and all
+	// others are black.
+	maxDepth := 0
+	for n := len(startKeys); n > 1; n >>= 1 {
+		maxDepth++
+	}
+	var nodes []*roachpb.RangeTreeNode
+	var build func(ks []roachpb.RKey, parentKey roachpb.RKey, depth int) roachpb.RKey
+	build = func(ks []roachpb.RKey, parentKey roachpb.RKey, depth int) roachpb.RKey {
+		if len(ks) == 0 {
+			return nil
+		}
+		mid := len(ks) / 2
+		node := &roachpb.RangeTreeNode{
+			Key:       ks[mid],
+			Black:     depth == 0 || depth < maxDepth,
+			ParentKey: parentKey,
+		}
+		nodes = append(nodes, node)
+		node.LeftKey = build(ks[:mid], node.Key, depth+1)
+		node.RightKey = build(ks[mid+1:], node.Key, depth+1)
+		return node.Key
 	}
-	node := &roachpb.RangeTreeNode{
-		Key:   startKey,
-		Black: true,
+	tree := &roachpb.RangeTree{
+		RootKey: build(startKeys, nil, 0),
 	}
 	if err := engine.MVCCPutProto(batch, ms, keys.RangeTreeRoot, timestamp, nil, tree); err != nil {
 		return err
 	}
-	if err := engine.MVCCPutProto(batch, ms, keys.RangeTreeNodeKey(startKey), timestamp, nil, node); err != nil {
-		return err
+	for _, node := range nodes {
+		if err := engine.MVCCPutProto(batch, ms, keys.RangeTreeNodeKey(node.Key), timestamp, nil, node); err != nil {
+			return err
+		}
 	}
 	return nil
 }
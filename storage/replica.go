@@ -43,7 +43,6 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/tracer"
-	"github.com/gogo/protobuf/proto"
 )
 
 const (
@@ -80,7 +79,8 @@ const (
 // returned error. Note that in a multi-replica test this filter will
 // be run once for each replica and must produce consistent results
 // each time. Should only be used in tests in the storage and
-// storage_test packages.
+// storage_test packages. New tests should prefer the
+// TestingCommandFilter of StoreTestingKnobs, which is scoped to a store.
 var TestingCommandFilter func(roachpb.Request, roachpb.Header) error
 
 // This flag controls whether Transaction entries are automatically gc'ed
@@ -102,6 +102,10 @@ const (
 
 	// DefaultLeaderLeaseDuration is the default duration of the leader lease.
 	DefaultLeaderLeaseDuration = time.Second
+
+	// raftApplyBatchMaxCommands is the maximum number of committed raft
+	// commands which are applied in a single engine batch.
+	raftApplyBatchMaxCommands = 64
 )
 
 // tsCacheMethods specifies the set of methods which affect the
@@ -135,8 +139,10 @@ func usesTimestampCache(r roachpb.Request) bool {
 // committed to the Raft log, the command is executed and the result returned
 // via the done channel.
 type pendingCmd struct {
-	ctx  context.Context
-	done chan roachpb.ResponseWithError // Used to signal waiting RPC handler
+	ctx      context.Context
+	idKey    cmdIDKey
+	proposed time.Time                      // When the command was proposed
+	done     chan roachpb.ResponseWithError // Used to signal waiting RPC handler
 }
 
 // A Replica is a contiguous keyspace with writes managed via an
@@ -155,10 +161,18 @@ type Replica struct {
 	lastIndex uint64
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
-	systemDBHash []byte         // sha1 hash of the system config @ last gossip
-	lease        unsafe.Pointer // Information for leader lease, updated atomically
-	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
-	respCache    *ResponseCache // Provides idempotence for retries
+	systemDBHash []byte                     // sha1 hash of the system config @ last gossip
+	lease        unsafe.Pointer             // Information for leader lease, updated atomically
+	llMu         sync.Mutex                 // Synchronizes readers' requests for leader lease
+	leaseHandoff roachpb.Timestamp          // Expiration of a lease being handed off; protected by llMu
+	handoffTo    *roachpb.ReplicaDescriptor // The replica a lease is handed off to; protected by llMu
+	pendingLease *pendingLeaseRequest       // The lease acquisition in flight, if any; protected by llMu
+	leaseHistory leaseHistory               // The most recent lease holders, for debugging
+	locality     requestLocality            // Samples the nodes requests are issued at
+	breaker      replicaBreaker             // Fails commands fast while the range is unavailable
+	load         replicaLoad                // Measures the rate of requests served
+	respCache    *ResponseCache             // Provides idempotence for retries
+	seqCache     *SequenceCache             // Provides replay protection for txn batches
 
 	// proposeRaftCommandFn can be set to mock out the propose operation.
 	proposeRaftCommandFn func(cmdIDKey, roachpb.RaftCommand) <-chan error
@@ -181,6 +195,19 @@ type Replica struct {
 		value roachpb.ReplicaDescriptor
 	}
 	truncatedState unsafe.Pointer // *roachpb.RaftTruncatedState
+
+	pauseMu     sync.Mutex                  // Protects the following fields:
+	pausedUntil time.Time                   // Deadline of a pause of this replica
+	pausedPeers map[roachpb.ReplicaID]int64 // Followers reported paused, with report time
+
+	rebalanceMu      sync.Mutex                      // Protects the following field:
+	rebalanceTargets map[roachpb.ReplicaID]time.Time // Replicas added to rebalance, with time added
+
+	verifyMu      sync.Mutex // Protects the following field:
+	lastVerifyErr error      // Error of the most recent verification, if it failed
+
+	exclusionMu    sync.Mutex          // Protects the following field:
+	excludedQueues map[string]struct{} // Names of the queues the range is excluded from
 }
 
 var _ client.Sender = &Replica{}
@@ -192,6 +219,7 @@ func NewReplica(desc *roachpb.RangeDescriptor, rm *Store) (*Replica, error) {
 		cmdQ:        NewCommandQueue(),
 		tsCache:     NewTimestampCache(rm.Clock()),
 		respCache:   NewResponseCache(desc.RangeID),
+		seqCache:    NewSequenceCache(desc.RangeID),
 		pendingCmds: map[cmdIDKey]*pendingCmd{},
 	}
 	r.pendingReplica.Cond = sync.NewCond(r)
@@ -215,6 +243,10 @@ func NewReplica(desc *roachpb.RangeDescriptor, rm *Store) (*Replica, error) {
 	}
 	atomic.StorePointer(&r.lease, unsafe.Pointer(lease))
 
+	if err := r.loadQueueExclusions(r.store.Engine()); err != nil {
+		return nil, err
+	}
+
 	if r.ContainsKey(keys.SystemDBSpan.Key) {
 		r.maybeGossipSystemConfig()
 	}
@@ -234,26 +266,7 @@ func (r *Replica) String() string {
 
 // Destroy cleans up all data associated with this range, leaving a tombstone.
 func (r *Replica) Destroy() error {
-	desc := r.Desc()
-	iter := newReplicaDataIterator(desc, r.store.Engine())
-	defer iter.Close()
-	batch := r.store.Engine().NewBatch()
-	defer batch.Close()
-	for ; iter.Valid(); iter.Next() {
-		_ = batch.Clear(iter.Key())
-	}
-
-	// Save a tombstone. The range cannot be re-replicated onto this
-	// node without having a replica ID of at least desc.NextReplicaID.
-	tombstoneKey := keys.RaftTombstoneKey(desc.RangeID)
-	tombstone := &roachpb.RaftTombstone{
-		NextReplicaID: desc.NextReplicaID,
-	}
-	if err := engine.MVCCPutProto(batch, nil, tombstoneKey, roachpb.ZeroTimestamp, nil, tombstone); err != nil {
-		return err
-	}
-
-	return batch.Commit()
+	return destroyReplicaData(r.store.Engine(), r.Desc())
 }
 
 // context returns a context which is initialized with information about
@@ -293,6 +306,43 @@ func (r *Replica) getLease() *roachpb.Lease {
 	return (*roachpb.Lease)(atomic.LoadPointer(&r.lease))
 }
 
+// leaseCovers returns whether the given lease authorizes its holder to
+// serve requests at the given timestamp. Expiration-based leases are valid
+// until their expiration; epoch-based leases for as long as the holder's
+// liveness record carries the lease's epoch and is live.
+func (r *Replica) leaseCovers(l *roachpb.Lease, timestamp roachpb.Timestamp) bool {
+	if l.Epoch == 0 {
+		return l.Covers(timestamp)
+	}
+	nl := r.store.ctx.NodeLiveness
+	if nl == nil {
+		return false
+	}
+	liveness, err := nl.GetLiveness(l.Replica.NodeID)
+	if err != nil {
+		return false
+	}
+	return liveness.Epoch == l.Epoch && liveness.IsLive(timestamp)
+}
+
+// leaseLastActive returns the latest timestamp up to which the given lease
+// is known to be valid. For expiration-based leases this is the
+// expiration; for epoch-based leases it is the expiration of the holder's
+// liveness record while that record still carries the lease's epoch, and
+// the lease's start otherwise, since the lease was invalidated at some
+// unknown time after it.
+func (r *Replica) leaseLastActive(l *roachpb.Lease) roachpb.Timestamp {
+	if l.Epoch == 0 {
+		return l.Expiration
+	}
+	if nl := r.store.ctx.NodeLiveness; nl != nil {
+		if liveness, err := nl.GetLiveness(l.Replica.NodeID); err == nil && liveness.Epoch == l.Epoch {
+			return liveness.Expiration
+		}
+	}
+	return l.Start
+}
+
 // newNotLeaderError returns a NotLeaderError initialized with the
 // replica for the holder (if any) of the given lease.
 func (r *Replica) newNotLeaderError(l *roachpb.Lease, originStoreID roachpb.StoreID) error {
@@ -322,15 +372,39 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 	if replica == nil {
 		return roachpb.NewRangeNotFoundError(desc.RangeID)
 	}
+	lease := roachpb.Lease{
+		Start:      timestamp,
+		Expiration: expiration,
+		Replica:    *replica,
+	}
+	var prevLease *roachpb.Lease
+	if l := r.getLease(); l.Epoch != 0 {
+		prevLease = l
+		if !prevLease.OwnedBy(r.store.StoreID()) {
+			// The previous holder's epoch has been incremented, which was only
+			// possible after its liveness record had expired on our clock.
+			// Starting the new lease no earlier than our clock reading makes
+			// sure it doesn't overlap with any reads the holder served.
+			lease.Start.Forward(r.store.Clock().Now())
+		}
+	}
+	if epoch := r.leaseEpoch(lease.Start); epoch != 0 {
+		lease.Expiration = roachpb.ZeroTimestamp
+		lease.Epoch = epoch
+	}
+	return r.proposeLease(lease, prevLease, duration)
+}
+
+// proposeLease proposes the given leader lease to raft and waits for it to
+// be applied, for at most the given duration.
+func (r *Replica) proposeLease(lease roachpb.Lease, prevLease *roachpb.Lease, duration time.Duration) error {
+	desc := r.Desc()
 	args := &roachpb.LeaderLeaseRequest{
 		Span: roachpb.Span{
 			Key: desc.StartKey.AsRawKey(),
 		},
-		Lease: roachpb.Lease{
-			Start:      timestamp,
-			Expiration: expiration,
-			Replica:    *replica,
-		},
+		Lease:     lease,
+		PrevLease: prevLease,
 	}
 	ba := roachpb.BatchRequest{}
 	ba.RangeID = desc.RangeID
@@ -357,6 +431,7 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 	select {
 	case err := <-errChan:
 		if err != nil {
+			r.removePendingCmd(pendingCmd)
 			return err
 		}
 	case <-ctx.Done():
@@ -373,12 +448,42 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 	}
 }
 
+// leaseEpoch returns the liveness epoch to which a leader lease acquired
+// by this replica at the given timestamp should be tied, or zero if an
+// expiration-based lease should be used instead. The latter is the case
+// when node liveness is disabled, when this node's own liveness record is
+// not live, and always for the range holding the liveness records.
+func (r *Replica) leaseEpoch(timestamp roachpb.Timestamp) int64 {
+	nl := r.store.ctx.NodeLiveness
+	if nl == nil {
+		return 0
+	}
+	desc := r.Desc()
+	if desc.StartKey.Less(roachpb.RKey(keys.NodeLivenessKeyMax)) &&
+		roachpb.RKey(keys.NodeLivenessPrefix).Less(desc.EndKey) {
+		return 0
+	}
+	liveness, err := nl.GetLiveness(r.store.Ident.NodeID)
+	if err != nil || !liveness.IsLive(timestamp) {
+		return 0
+	}
+	return liveness.Epoch
+}
+
+// A pendingLeaseRequest is a leader lease acquisition in flight. Requests
+// needing the lease while it is being acquired wait for its result instead
+// of acquiring the lease themselves.
+type pendingLeaseRequest struct {
+	done chan struct{} // Closed once the acquisition has finished
+	err  error         // The result of the acquisition, once done is closed
+}
+
 // redirectOnOrAcquireLeaderLease checks whether this replica has the
 // leader lease at the specified timestamp. If it does, returns
 // success. If another replica currently holds the lease, redirects by
 // returning NotLeaderError. If the lease is expired, a renewal is
-// synchronously requested. This method uses the leader lease mutex
-// to guarantee only one request to grant the lease is pending.
+// synchronously requested. Only one request to grant the lease is pending
+// at a time: concurrent callers wait for it and share its result.
 //
 // TODO(spencer): implement threshold regrants to avoid latency in
 //  the presence of read or write pressure sufficiently close to the
@@ -390,16 +495,98 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 //  will not incur latency waiting for the command to complete.
 //  Reads, however, must wait.
 func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp roachpb.Timestamp) error {
-	r.llMu.Lock()
-	defer r.llMu.Unlock()
+	for {
+		r.llMu.Lock()
+		if acquire, err := r.needLeaderLease(timestamp); !acquire {
+			r.llMu.Unlock()
+			return err
+		}
+		if p := r.pendingLease; p != nil {
+			r.llMu.Unlock()
+			r.store.metrics.Counter("leases.requests.coalesced").Inc(1)
+			done := trace.Epoch("wait for leader lease")
+			<-p.done
+			done()
+			if p.err != nil {
+				return p.err
+			}
+			// The acquired lease may not cover the timestamp of this request,
+			// which is checked again.
+			continue
+		}
+		p := &pendingLeaseRequest{done: make(chan struct{})}
+		r.pendingLease = p
+		r.llMu.Unlock()
+
+		p.err = r.acquireLeaderLease(trace, timestamp)
+
+		r.llMu.Lock()
+		r.pendingLease = nil
+		r.llMu.Unlock()
+		close(p.done)
+		return p.err
+	}
+}
 
-	if lease := r.getLease(); lease.Covers(timestamp) {
+// needLeaderLease returns whether this replica must acquire the leader
+// lease to serve a request at the given timestamp. If it need not, the
+// returned error redirects the request to another replica, or is nil if
+// this replica holds the lease. The caller must hold llMu.
+func (r *Replica) needLeaderLease(timestamp roachpb.Timestamp) (bool, error) {
+	lease := r.getLease()
+	if r.leaseHandedOff(timestamp) {
+		// The lease is being handed off; redirect the request to the replica
+		// it is handed off to, which picks it up.
+		var target *roachpb.Lease
+		if r.handoffTo != nil {
+			target = &roachpb.Lease{Replica: *r.handoffTo}
+		}
+		return false, r.newNotLeaderError(target, r.store.StoreID())
+	}
+	if r.leaseCovers(lease, timestamp) {
 		if lease.OwnedBy(r.store.StoreID()) {
 			// Happy path: We have an active lease, nothing to do.
-			return nil
+			return false, nil
 		}
 		// If lease is currently held by another, redirect to holder.
-		return r.newNotLeaderError(lease, r.store.StoreID())
+		return false, r.newNotLeaderError(lease, r.store.StoreID())
+	}
+	if r.store.IsDraining() {
+		// A draining store does not acquire new leases; send the client
+		// to another replica instead.
+		return false, r.newNotLeaderError(nil, r.store.StoreID())
+	}
+	if r.isWitness() {
+		// Neither does a witness, which holds no user data.
+		return false, r.newNotLeaderError(nil, r.store.StoreID())
+	}
+	return true, nil
+}
+
+// acquireLeaderLease requests the leader lease for a request at the given
+// timestamp, taking it over from a previous holder whose epoch-based lease
+// is still in place if necessary. It is called by the single pending lease
+// request of the replica.
+func (r *Replica) acquireLeaderLease(trace *tracer.Trace, timestamp roachpb.Timestamp) error {
+	lease := r.getLease()
+	if lease.Epoch != 0 && !lease.OwnedBy(r.store.StoreID()) {
+		// The holder's epoch-based lease can only be taken over once its
+		// liveness epoch has been incremented, which fails while the holder
+		// is still live.
+		nl := r.store.ctx.NodeLiveness
+		if nl == nil {
+			return r.newNotLeaderError(lease, r.store.StoreID())
+		}
+		if err := nl.IncrementEpoch(roachpb.Liveness{
+			NodeID: lease.Replica.NodeID,
+			Epoch:  lease.Epoch,
+		}); err != nil {
+			if log.V(1) {
+				log.Infof("range %d: unable to increment liveness epoch of node %d: %s",
+					r.Desc().RangeID, lease.Replica.NodeID, err)
+			}
+			return r.newNotLeaderError(lease, r.store.StoreID())
+		}
 	}
 	defer trace.Epoch("request leader lease")()
 	// Otherwise, no active lease: Request renewal.
@@ -409,12 +596,12 @@ func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp
 	// the lease request was somehow invalid due to a concurrent change.
 	//
 	// In the case where another machine obtained the lease, we are certain that
-	// it can't be this replica because we're holding a lock.
+	// it can't be this replica because there is only one pending lease request.
 	//
 	// In all cases, the error is converted to a NotLeaderError.
 	if _, ok := err.(*roachpb.LeaseRejectedError); ok {
 		lease := r.getLease()
-		if !lease.Covers(timestamp) {
+		if !r.leaseCovers(lease, timestamp) {
 			// The lease was rejected even though it was not obtained by another
 			// replica.
 			if log.V(1) {
@@ -477,6 +664,14 @@ func (r *Replica) GetReplica() *roachpb.ReplicaDescriptor {
 	return replica
 }
 
+// isWitness returns true if this replica is a witness, i.e. it takes
+// part in raft elections and stores the raft log, but holds no user
+// data.
+func (r *Replica) isWitness() bool {
+	replica := r.GetReplica()
+	return replica != nil && replica.Witness
+}
+
 // ReplicaDescriptor returns information about the given member of this replica's range.
 func (r *Replica) ReplicaDescriptor(replicaID roachpb.ReplicaID) (roachpb.ReplicaDescriptor, error) {
 	r.RLock()
@@ -566,6 +761,7 @@ func (r *Replica) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.B
 	// TODO(tschottdorf) Some (internal) requests go here directly, so they
 	// won't be traced.
 	trace := tracer.FromCtx(ctx)
+	r.locality.record(ba.GatewayNodeID)
 	// Differentiate between admin, read-only and write.
 	if ba.IsAdmin() {
 		defer trace.Epoch("admin path")()
@@ -584,11 +780,12 @@ func (r *Replica) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.B
 	} else {
 		panic(fmt.Sprintf("don't know how to handle command %s", ba))
 	}
-	if err == multiraft.ErrGroupDeleted {
-		// This error needs to be converted appropriately so that
-		// clients will retry.
-		err = roachpb.NewRangeNotFoundError(r.Desc().RangeID)
+	err = r.convertRaftError(err)
+	loadBytes := int64(ba.Size())
+	if br != nil {
+		loadBytes += int64(br.Size())
 	}
+	r.load.record(r.store.Clock().PhysicalNow(), loadBytes)
 	// TODO(tschottdorf): assert nil reply on error.
 	if err != nil {
 		trace.SetError()
@@ -598,6 +795,38 @@ func (r *Replica) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.B
 	return br, nil
 }
 
+// convertRaftError converts the errors with which multiraft fails proposals
+// into errors instructing clients to look up the range elsewhere, to try
+// another replica or to back off and retry. Other errors are returned
+// unchanged.
+func (r *Replica) convertRaftError(err error) error {
+	switch tErr := err.(type) {
+	case *multiraft.ErrNotLeader:
+		// Redirect to the raft leader, if known.
+		desc := r.Desc()
+		nlErr := &roachpb.NotLeaderError{RangeID: desc.RangeID}
+		_, nlErr.Replica = desc.FindReplica(r.store.StoreID())
+		if tErr.Leader.ReplicaID != 0 {
+			_, nlErr.Leader = desc.FindReplica(tErr.Leader.StoreID)
+		}
+		return nlErr
+	}
+	switch err {
+	case multiraft.ErrGroupDeleted, multiraft.ErrGroupNotFound:
+		// The replica is gone or not (yet) a member of the range; clients
+		// look the range up again and retry.
+		return roachpb.NewRangeNotFoundError(r.Desc().RangeID)
+	case multiraft.ErrProposalThrottled, multiraft.ErrProposalDropped:
+		// The command was not proposed; clients back off and retry.
+		return &roachpb.ServerOverloadedError{Message: err.Error()}
+	case multiraft.ErrStopped:
+		// The store is shutting down; clients try another replica
+		// rather than wait for it.
+		return &roachpb.NodeUnavailableError{}
+	}
+	return err
+}
+
 // TODO(tschottdorf): almost obsolete.
 func (r *Replica) checkCmdHeader(header *roachpb.Span) error {
 	if !r.ContainsKeyRange(header.Key, header.EndKey) {
@@ -622,6 +851,9 @@ func (r *Replica) checkBatchRequest(ba roachpb.BatchRequest) error {
 		if ba.ReadConsistency == roachpb.CONSENSUS {
 			return util.Errorf("consensus reads not implemented")
 		}
+		if ba.ReadConsistency == roachpb.INCONSISTENT && !ba.IsFollowerReadable() {
+			return util.Errorf("inconsistent mode is only available to reads which may be served by followers")
+		}
 	} else if ba.ReadConsistency == roachpb.INCONSISTENT {
 		return util.Errorf("inconsistent mode is only available to reads")
 	}
@@ -647,6 +879,9 @@ func (r *Replica) beginCmds(ba *roachpb.BatchRequest) ([]interface{}, error) {
 			h := union.GetInner().Header()
 			spans = append(spans, roachpb.Span{Key: h.Key, EndKey: h.EndKey})
 		}
+		// All of the spans are added with the same access, so requests of
+		// the batch addressing the same keys need only one entry.
+		spans = roachpb.MergeSpans(spans)
 		r.cmdQ.GetWait(readOnly, &wg, spans...)
 		cmdKeys = append(cmdKeys, r.cmdQ.Add(readOnly, spans...)...)
 		r.Unlock()
@@ -723,6 +958,10 @@ func (r *Replica) addAdminCmd(ctx context.Context, ba roachpb.BatchRequest) (*ro
 		var reply roachpb.AdminMergeResponse
 		reply, err = r.AdminMerge(*tArgs, r.Desc())
 		resp = &reply
+	case *roachpb.AdminScatterRequest:
+		var reply roachpb.AdminScatterResponse
+		reply, err = r.AdminScatter(*tArgs, r.Desc())
+		resp = &reply
 	default:
 		return nil, util.Errorf("unrecognized admin command: %T", args)
 	}
@@ -744,6 +983,12 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, ba roachpb.BatchRequest) (
 	header := ba.Header
 	trace := tracer.FromCtx(ctx)
 
+	// A witness has no user data to read from, not even for
+	// inconsistent reads.
+	if r.isWitness() {
+		return nil, r.newNotLeaderError(r.getLease(), r.store.StoreID())
+	}
+
 	// Add the read to the command queue to gate subsequent
 	// overlapping commands until this command completes.
 	qDone := trace.Epoch("command queue")
@@ -753,9 +998,9 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, ba roachpb.BatchRequest) (
 		return nil, err
 	}
 
-	// If there are command keys (there might not be if reads are
-	// inconsistent), the read requires the leader lease.
-	if len(cmdKeys) > 0 {
+	// Unless the read is inconsistent and may be served by a follower,
+	// it requires the leader lease.
+	if ba.ReadConsistency != roachpb.INCONSISTENT || !ba.IsFollowerReadable() {
 		if err := r.redirectOnOrAcquireLeaderLease(trace, header.Timestamp); err != nil {
 			r.endCmds(cmdKeys, ba, err)
 			return nil, err
@@ -798,6 +1043,11 @@ func (r *Replica) addWriteCmd(ctx context.Context, ba roachpb.BatchRequest, wg *
 
 	trace := tracer.FromCtx(ctx)
 
+	// Fail fast if the range recently failed to commit commands.
+	if err := r.checkAvailable(); err != nil {
+		return nil, err
+	}
+
 	// Add the write to the command queue to gate subsequent overlapping
 	// commands until this command completes. Note that this must be
 	// done before getting the max timestamp for the key(s), as
@@ -867,7 +1117,27 @@ func (r *Replica) addWriteCmd(ctx context.Context, ba roachpb.BatchRequest, wg *
 
 	// First wait for raft to commit or abort the command.
 	var br *roachpb.BatchResponse
-	if err = <-errChan; err == nil {
+	replicationStart := time.Now()
+	replicationDone := trace.Epoch("raft replication")
+	var timeoutC <-chan time.Time
+	timeout := r.store.ctx.RangeUnavailableTimeout
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+	select {
+	case err = <-errChan:
+	case <-timeoutC:
+		replicationDone()
+		return nil, r.abandonCmd(cmdKeys, ba, timeout, errChan, pendingCmd)
+	}
+	replicationDone()
+	if err != nil {
+		r.removePendingCmd(pendingCmd)
+	} else {
+		r.markAvailable()
+		r.store.metrics.Histogram("raft.latency.replication").RecordValue(time.Since(replicationStart).Nanoseconds())
 		// Next if the command was committed, wait for the range to apply it.
 		respWithErr := <-pendingCmd.done
 		br, err = respWithErr.Reply, respWithErr.Err
@@ -883,8 +1153,9 @@ func (r *Replica) addWriteCmd(ctx context.Context, ba roachpb.BatchRequest, wg *
 // pending command struct for receiving.
 func (r *Replica) proposeRaftCommand(ctx context.Context, ba roachpb.BatchRequest) (<-chan error, *pendingCmd) {
 	pendingCmd := &pendingCmd{
-		ctx:  ctx,
-		done: make(chan roachpb.ResponseWithError, 1),
+		ctx:      ctx,
+		proposed: time.Now(),
+		done:     make(chan roachpb.ResponseWithError, 1),
 	}
 	desc := r.Desc()
 	_, replica := desc.FindReplica(r.store.StoreID())
@@ -900,6 +1171,7 @@ func (r *Replica) proposeRaftCommand(ctx context.Context, ba roachpb.BatchReques
 	}
 	cmdID := ba.GetOrCreateCmdID(r.store.Clock().PhysicalNow())
 	idKey := makeCmdIDKey(cmdID)
+	pendingCmd.idKey = idKey
 
 	var errChan <-chan error
 	r.Lock()
@@ -918,118 +1190,247 @@ func (r *Replica) proposeRaftCommand(ctx context.Context, ba roachpb.BatchReques
 	} else if r.proposeRaftCommandFn != nil {
 		errChan = r.proposeRaftCommandFn(idKey, raftCmd)
 	} else {
+		queueDone := tracer.FromCtx(ctx).Epoch("raft queue")
 		errChan = r.store.ProposeRaftCommand(idKey, raftCmd)
+		queueDone()
 	}
 
 	return errChan, pendingCmd
 }
 
+// removePendingCmd removes a command which raft refused to propose from
+// the pending commands.
+func (r *Replica) removePendingCmd(cmd *pendingCmd) {
+	r.Lock()
+	defer r.Unlock()
+	if r.pendingCmds[cmd.idKey] == cmd {
+		delete(r.pendingCmds, cmd.idKey)
+	}
+}
+
+// A committedCommand is a raft command which has been committed to the
+// replica's log and is awaiting application.
+type committedCommand struct {
+	idKey cmdIDKey
+	index uint64
+	cmd   roachpb.RaftCommand
+}
+
 // processRaftCommand processes a raft command by unpacking the command
 // struct to get args and reply and then applying the command to the
-// state machine via applyRaftCommand(). The error result is sent on
+// state machine via applyRaftCommands(). The error result is sent on
 // the command's done channel, if available.
 func (r *Replica) processRaftCommand(idKey cmdIDKey, index uint64, raftCmd roachpb.RaftCommand) error {
-	if index == 0 {
-		log.Fatalc(r.context(), "processRaftCommand requires a non-zero index")
+	return r.processRaftCommands([]committedCommand{{idKey: idKey, index: index, cmd: raftCmd}})[0]
+}
+
+// processRaftCommands processes a run of consecutive committed raft
+// commands. Commands are applied in groups of up to
+// raftApplyBatchMaxCommands sharing a single engine batch, which
+// reduces write amplification when a replica catches up on its log.
+// Commands which may alter in-memory replica state as part of their
+// application are applied on their own. The error result of each
+// command is sent on its done channel, if available, and returned.
+func (r *Replica) processRaftCommands(cmds []committedCommand) []error {
+	errs := make([]error, 0, len(cmds))
+	for len(cmds) > 0 {
+		n := 1
+		if !isolatedRaftCommand(cmds[0].cmd.Cmd) {
+			for n < len(cmds) && n < raftApplyBatchMaxCommands && !isolatedRaftCommand(cmds[n].cmd.Cmd) {
+				n++
+			}
+		}
+		errs = append(errs, r.processRaftCommandGroup(cmds[:n])...)
+		cmds = cmds[n:]
 	}
+	return errs
+}
 
+// processRaftCommandGroup applies the given commands in a single engine
+// batch and signals their results.
+func (r *Replica) processRaftCommandGroup(cmds []committedCommand) []error {
+	pendingCmds := make([]*pendingCmd, len(cmds))
+	ctxs := make([]context.Context, len(cmds))
 	r.Lock()
-	cmd := r.pendingCmds[idKey]
-	delete(r.pendingCmds, idKey)
+	for i, c := range cmds {
+		if c.index == 0 {
+			log.Fatalc(r.context(), "processRaftCommand requires a non-zero index")
+		}
+		pendingCmds[i] = r.pendingCmds[c.idKey]
+		delete(r.pendingCmds, c.idKey)
+	}
 	r.Unlock()
 
-	var ctx context.Context
-	if cmd != nil {
-		// We initiated this command, so use the caller-supplied context.
-		ctx = cmd.ctx
-	} else {
-		// TODO(tschottdorf): consider the Trace situation here.
-		ctx = r.context()
+	for i, cmd := range pendingCmds {
+		if cmd != nil {
+			// We initiated this command, so use the caller-supplied context.
+			ctxs[i] = cmd.ctx
+		} else {
+			// TODO(tschottdorf): consider the Trace situation here.
+			ctxs[i] = r.context()
+		}
 	}
 
-	trace := tracer.FromCtx(ctx)
-	execDone := trace.Epoch("applying batch")
-	// applyRaftCommand will return "expected" errors, but may also indicate
+	// applyRaftCommands will return "expected" errors, but may also indicate
 	// replica corruption (as of now, signaled by a replicaCorruptionError).
 	// We feed its return through maybeSetCorrupt to act when that happens.
-	br, err := r.applyRaftCommand(ctx, index, raftCmd.OriginReplica, raftCmd.Cmd)
-	err = r.maybeSetCorrupt(err)
-	execDone()
-
-	if cmd != nil {
-		cmd.done <- roachpb.ResponseWithError{Reply: br, Err: err}
-	} else if err != nil && log.V(1) {
-		log.Errorc(r.context(), "error executing raft command: %s", err)
+	brs, errs := r.applyRaftCommands(ctxs, cmds)
+	for i, cmd := range pendingCmds {
+		errs[i] = r.maybeSetCorrupt(errs[i])
+		if cmd != nil {
+			cmd.done <- roachpb.ResponseWithError{Reply: brs[i], Err: errs[i]}
+		} else if errs[i] != nil && log.V(1) {
+			log.Errorc(r.context(), "error executing raft command: %s", errs[i])
+		}
 	}
-
-	return err
+	return errs
 }
 
-// applyRaftCommand applies a raft command from the replicated log to the
-// underlying state machine (i.e. the engine).
-// When certain critical operations fail, a replicaCorruptionError may be
-// returned and must be handled by the caller.
-func (r *Replica) applyRaftCommand(ctx context.Context, index uint64, originReplica roachpb.ReplicaDescriptor,
-	ba roachpb.BatchRequest) (*roachpb.BatchResponse, error) {
-	if index <= 0 {
-		log.Fatalc(ctx, "raft command index is <= 0")
+// isolatedRaftCommand returns whether the batch must be applied in an
+// engine batch of its own. This is the case for commands whose
+// application alters in-memory replica state read by the execution of
+// subsequent commands, such as leader leases, log truncation and
+// commit triggers.
+func isolatedRaftCommand(ba roachpb.BatchRequest) bool {
+	for _, union := range ba.Requests {
+		switch t := union.GetInner().(type) {
+		case *roachpb.LeaderLeaseRequest, *roachpb.TruncateLogRequest:
+			return true
+		case *roachpb.EndTransactionRequest:
+			if t.InternalCommitTrigger != nil {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	// If we have an out of order index, there's corruption. No sense in trying
-	// to update anything or run the command. Simply return a corruption error.
-	if oldIndex := atomic.LoadUint64(&r.appliedIndex); oldIndex >= index {
-		return nil, newReplicaCorruptionError(util.Errorf("applied index moved backwards: %d >= %d", oldIndex, index))
-	}
+// applyRaftCommands applies raft commands from the replicated log to the
+// underlying state machine (i.e. the engine). Each command is executed
+// in a batch nested within a batch shared by all commands, which is
+// committed once along with the applied index of the last command.
+// The response and error of each command are returned. When certain
+// critical operations fail, a replicaCorruptionError may be returned
+// and must be handled by the caller.
+func (r *Replica) applyRaftCommands(ctxs []context.Context, cmds []committedCommand) (
+	[]*roachpb.BatchResponse, []error) {
+	brs := make([]*roachpb.BatchResponse, len(cmds))
+	errs := make([]error, len(cmds))
+	mss := make([]engine.MVCCStats, len(cmds))
+	intents := make([][]intentsWithArg, len(cmds))
 
-	// Call the helper, which returns a batch containing data written
-	// during command execution and any associated error.
-	ms := engine.MVCCStats{}
-	batch, br, intents, rErr := r.applyRaftCommandInBatch(ctx, index, originReplica, ba, &ms)
+	batch := r.store.Engine().NewBatch()
 	defer batch.Close()
 
+	// Witnesses skip the commands touching user data, and discard all
+	// writes to user data of those they evaluate.
+	witness := r.isWitness()
+	var eng engine.Engine = batch
+	if witness {
+		eng = newWitnessEngine(batch)
+	}
+
+	appliedIndex := atomic.LoadUint64(&r.appliedIndex)
+	var applied bool
+	for i, c := range cmds {
+		trace := tracer.FromCtx(ctxs[i])
+		execDone := trace.Epoch("applying batch")
+		// If we have an out of order index, there's corruption. No sense in
+		// trying to update anything or run the command. Simply return a
+		// corruption error.
+		if appliedIndex >= c.index {
+			errs[i] = newReplicaCorruptionError(util.Errorf("applied index moved backwards: %d >= %d", appliedIndex, c.index))
+			execDone()
+			continue
+		}
+		appliedIndex = c.index
+		applied = true
+		if witness && !witnessEvaluates(c.cmd.Cmd) {
+			execDone()
+			continue
+		}
+
+		// Call the helper, which returns a batch containing data written
+		// during command execution and any associated error.
+		applyStart := time.Now()
+		cmdBatch, br, cmdIntents, rErr := r.applyRaftCommandInBatch(ctxs[i], eng, c.index, c.cmd.OriginReplica, c.cmd.Cmd, &mss[i])
+		if err := cmdBatch.Commit(); err != nil {
+			rErr = newReplicaCorruptionError(util.Errorf("could not commit batch"), err, rErr)
+		}
+		cmdBatch.Close()
+		r.store.applyLatency.RecordValue(time.Since(applyStart).Nanoseconds())
+		brs[i], intents[i], errs[i] = br, cmdIntents, rErr
+		execDone()
+	}
+	if !applied {
+		return brs, errs
+	}
+
 	// Advance the last applied index and commit the batch.
-	if err := setAppliedIndex(batch, r.Desc().RangeID, index); err != nil {
-		log.Fatalc(ctx, "setting applied index in a batch should never fail: %s", err)
+	if err := setAppliedIndex(batch, r.Desc().RangeID, appliedIndex); err != nil {
+		log.Fatalc(ctxs[len(ctxs)-1], "setting applied index in a batch should never fail: %s", err)
 	}
-	if err := batch.Commit(); err != nil {
-		rErr = newReplicaCorruptionError(util.Errorf("could not commit batch"), err, rErr)
+	persistDones := make([]func(), len(ctxs))
+	for i, ctx := range ctxs {
+		persistDones[i] = tracer.FromCtx(ctx).Epoch("persisting batch")
+	}
+	persistStart := time.Now()
+	err := batch.Commit()
+	persistLatency := time.Since(persistStart)
+	r.store.metrics.Histogram("raft.latency.persist").RecordValue(persistLatency.Nanoseconds())
+	r.store.pacer.recordIOLatency(time.Now(), persistLatency)
+	for _, persistDone := range persistDones {
+		persistDone()
+	}
+	if err != nil {
+		for i := range errs {
+			errs[i] = newReplicaCorruptionError(util.Errorf("could not commit batch"), err, errs[i])
+		}
 	} else {
 		// Update cached appliedIndex if we were able to set the applied index on disk.
-		atomic.StoreUint64(&r.appliedIndex, index)
+		atomic.StoreUint64(&r.appliedIndex, appliedIndex)
+		if len(cmds) > 1 {
+			r.store.metrics.Counter("raft.apply.batched").Inc(int64(len(cmds)))
+		}
+	}
+
+	for i, c := range cmds {
+		ba := c.cmd.Cmd
 		// Invalidate the cache and let raftTruncatedState() read the value the next
 		// time it's required.
 		if _, ok := ba.GetArg(roachpb.TruncateLog); ok {
 			r.setCachedTruncatedState(nil)
 		}
-	}
 
-	// On successful write commands, flush to event feed, and handle other
-	// write-related triggers including splitting and config gossip updates.
-	if rErr == nil && ba.IsWrite() {
-		// Publish update to event feed.
-		// TODO(spencer): we should be sending feed updates for each part
-		// of the batch. In particular, stats should be reported per-command.
-		r.store.EventFeed().updateRange(r, roachpb.Batch, &ms)
-		// If the commit succeeded, potentially add range to split queue.
-		r.maybeAddToSplitQueue()
-	}
+		// On successful write commands, flush to event feed, and handle other
+		// write-related triggers including splitting and config gossip updates.
+		if errs[i] == nil && ba.IsWrite() {
+			// Publish update to event feed.
+			// TODO(spencer): we should be sending feed updates for each part
+			// of the batch. In particular, stats should be reported per-command.
+			r.store.EventFeed().updateRange(r, roachpb.Batch, &mss[i])
+			// If the commit succeeded, potentially add range to split queue.
+			r.maybeAddToSplitQueue()
+		}
 
-	// On the replica on which this command originated, resolve skipped intents
-	// asynchronously - even on failure.
-	if originReplica.StoreID == r.store.StoreID() {
-		r.handleSkippedIntents(intents)
+		// On the replica on which this command originated, resolve skipped intents
+		// asynchronously - even on failure.
+		if c.cmd.OriginReplica.StoreID == r.store.StoreID() {
+			r.handleSkippedIntents(intents[i])
+		}
 	}
 
-	return br, rErr
+	return brs, errs
 }
 
-// applyRaftCommandInBatch executes the command in a batch engine and
-// returns the batch containing the results. The caller is responsible
-// for committing the batch, even on error.
-func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, originReplica roachpb.ReplicaDescriptor,
-	ba roachpb.BatchRequest, ms *engine.MVCCStats) (engine.Engine, *roachpb.BatchResponse, []intentsWithArg, error) {
+// applyRaftCommandInBatch executes the command in a batch created from
+// the supplied engine and returns the batch containing the results.
+// The caller is responsible for committing the batch, even on error.
+func (r *Replica) applyRaftCommandInBatch(ctx context.Context, eng engine.Engine, index uint64,
+	originReplica roachpb.ReplicaDescriptor, ba roachpb.BatchRequest, ms *engine.MVCCStats) (
+	engine.Engine, *roachpb.BatchResponse, []intentsWithArg, error) {
 	// Create a new batch for the command to ensure all or nothing semantics.
-	btch := r.store.Engine().NewBatch()
+	btch := eng.NewBatch()
 
 	// Check the response cache for this batch to ensure idempotency.
 	if ba.IsWrite() {
@@ -1072,6 +1473,23 @@ func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, ori
 				log.Warningf("TODO(tschottdorf): #2297: %s hit cache for: <%s,%T>", ba, replyWithErr.Reply, replyWithErr.Err)
 			}
 		}
+
+		// A transactional batch which was not found in the response cache
+		// must carry a sequence number higher than that of any batch
+		// previously applied for its transaction. Otherwise, it is a replay
+		// of a batch which was reissued under a different command ID.
+		if ba.Txn != nil && ba.Txn.Sequence > 0 {
+			seq, readErr := r.seqCache.Get(btch, ba.Txn.ID)
+			if readErr != nil {
+				return btch, nil, nil, newReplicaCorruptionError(util.Errorf("could not read from sequence cache"), readErr)
+			}
+			if ba.Txn.Sequence <= seq {
+				if log.V(1) {
+					log.Infoc(ctx, "rejecting replayed batch with sequence %d <= %d", ba.Txn.Sequence, seq)
+				}
+				return btch, nil, nil, roachpb.NewTransactionRetryError(ba.Txn)
+			}
+		}
 	}
 
 	for _, union := range ba.Requests {
@@ -1080,7 +1498,7 @@ func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, ori
 		// TODO(tschottdorf): shouldn't be in the loop. Currently is because
 		// we haven't cleaned up the timestamp handling fully.
 		if lease := r.getLease(); args.Method() != roachpb.LeaderLease &&
-			(!lease.OwnedBy(originReplica.StoreID) || !lease.Covers(ba.Timestamp)) {
+			(!lease.OwnedBy(originReplica.StoreID) || (lease.Epoch == 0 && !lease.Covers(ba.Timestamp))) {
 			// Verify the leader lease is held, unless this command is trying to
 			// obtain it. Any other Raft command has had the leader lease held
 			// by the replica at proposal time, but this may no longer be the case.
@@ -1097,6 +1515,11 @@ func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, ori
 			// same ClientCmdID and would get the distributed sender stuck in an
 			// infinite loop, retrieving a stale NotLeaderError over and over
 			// again, even when proposing at the correct replica.
+			//
+			// The validity of an epoch-based lease depends on the holder's
+			// liveness record, which can't be consulted deterministically here;
+			// such leases are replaced only after the holder's epoch has been
+			// incremented, so only ownership is verified for them.
 			return btch, nil, nil, r.newNotLeaderError(lease, originReplica.StoreID)
 		}
 	}
@@ -1110,7 +1533,16 @@ func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, ori
 	// to continue request idempotence, even if leadership changes.
 	if ba.IsWrite() {
 		if err == nil {
-			// If command was successful, flush the MVCC stats to the batch.
+			// If command was successful, record its sequence number and
+			// flush the MVCC stats to the batch. The sequence number is
+			// kept after the transaction has been finalized so that late
+			// replays of its batches are still rejected; the GC queue
+			// removes it once it has expired.
+			if ba.Txn != nil {
+				if err := r.seqCache.Put(btch, ba.Txn.ID, ba.Txn.Sequence, ba.Timestamp); err != nil {
+					log.Fatalc(ctx, "updating a sequence cache entry in a batch should never fail: %s", err)
+				}
+			}
 			if err := r.stats.MergeMVCCStats(btch, ms, ba.Timestamp.WallTime); err != nil {
 				// TODO(tschottdorf): ReplicaCorruptionError.
 				log.Fatalc(ctx, "setting mvcc stats in a batch should never fail: %s", err)
@@ -1122,7 +1554,7 @@ func (r *Replica) applyRaftCommandInBatch(ctx context.Context, index uint64, ori
 			// Otherwise, reset the batch to clear out partial execution and
 			// prepare for the failed response cache entry.
 			btch.Close()
-			btch = r.store.Engine().NewBatch()
+			btch = eng.NewBatch()
 		}
 		if err := r.respCache.PutResponse(btch, ba.CmdID,
 			roachpb.ResponseWithError{Reply: br, Err: err}); err != nil {
@@ -1330,7 +1762,7 @@ func (r *Replica) maybeGossipSystemConfigLocked() {
 		return
 	}
 
-	if lease := r.getLease(); !lease.OwnedBy(r.store.StoreID()) || !lease.Covers(r.store.Clock().Now()) {
+	if lease := r.getLease(); !lease.OwnedBy(r.store.StoreID()) || !r.leaseCovers(lease, r.store.Clock().Now()) {
 		// Do not gossip when a leader lease is not held.
 		return
 	}
@@ -1360,29 +1792,10 @@ func (r *Replica) maybeGossipSystemConfigLocked() {
 	r.systemDBHash = hash
 }
 
+// handleSkippedIntents hands the supplied intents to the store's intent
+// resolver, which resolves them asynchronously.
 func (r *Replica) handleSkippedIntents(intents []intentsWithArg) {
-	if len(intents) == 0 {
-		return
-	}
-	now := r.store.Clock().Now()
-
-	for _, item := range intents {
-		ctx := r.context()
-		stopper := r.store.Stopper()
-		// TODO(tschottdorf): avoid data race related to batch unrolling in ExecuteCmd;
-		// can probably go again when that provisional code there is gone. Should
-		// still be careful though, a retry could happen and race with args.
-		args := proto.Clone(item.args).(roachpb.Request)
-		stopper.RunAsyncTask(func() {
-			h := roachpb.Header{Timestamp: now}
-			err := r.store.resolveWriteIntentError(ctx, &roachpb.WriteIntentError{
-				Intents: item.intents,
-			}, r, args, h, roachpb.CLEANUP_TXN)
-			if wiErr, ok := err.(*roachpb.WriteIntentError); !ok || wiErr == nil || !wiErr.Resolved {
-				log.Warningc(ctx, "failed to resolve on inconsistent read: %s", err)
-			}
-		})
-	}
+	r.store.intentResolver.processIntentsAsync(r, intents)
 }
 
 // TODO(spencerkimball): move to util.
@@ -1528,15 +1941,15 @@ func (r *Replica) resolveIntents(ctx context.Context, intents []roachpb.Intent)
 				// Therefore, we inspect the returned error to detect cases
 				// where the command was rejected, and can safely ignore those
 				// errors.
-				if err != multiraft.ErrGroupDeleted {
-					switch err.(type) {
-					case *roachpb.RangeKeyMismatchError:
-					case *roachpb.NotLeaderError:
-					case *roachpb.RangeNotFoundError:
-					default:
-						// TODO(tschottdorf): Does this need to be a panic?
-						panic(fmt.Sprintf("intent resolution failed with unexpected error: %s", err))
-					}
+				switch r.convertRaftError(err).(type) {
+				case *roachpb.RangeKeyMismatchError:
+				case *roachpb.NotLeaderError:
+				case *roachpb.RangeNotFoundError:
+				case *roachpb.ServerOverloadedError:
+				case *roachpb.NodeUnavailableError:
+				default:
+					// TODO(tschottdorf): Does this need to be a panic?
+					panic(fmt.Sprintf("intent resolution failed with unexpected error: %s", err))
 				}
 			}
 		}
@@ -1574,13 +1987,14 @@ func (r *Replica) resolveIntents(ctx context.Context, intents []roachpb.Intent)
 
 var errSystemDBIntent = errors.New("must retry later due to intent on SystemDB")
 
-// loadSystemDBSpan scans the entire SystemDB span and returns the full list of
-// key/value pairs along with the sha1 checksum of the contents (key and value).
+// loadSystemDBSpan scans the system config span of the SystemDB span and
+// returns the full list of key/value pairs along with the sha1 checksum of
+// the contents (key and value).
 func (r *Replica) loadSystemDBSpan() ([]roachpb.KeyValue, []byte, error) {
 	ba := roachpb.BatchRequest{}
 	ba.ReadConsistency = roachpb.INCONSISTENT
 	ba.Timestamp = r.store.Clock().Now()
-	ba.Add(&roachpb.ScanRequest{Span: keys.SystemDBSpan})
+	ba.Add(&roachpb.ScanRequest{Span: keys.SystemConfigSpan})
 	br, intents, err := r.executeBatch(r.store.Engine(), nil, ba)
 	if err != nil {
 		return nil, nil, err
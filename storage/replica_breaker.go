@@ -0,0 +1,155 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// replicaBreaker is a circuit breaker failing the commands of a replica
+// fast while its range appears to have lost its quorum. Commands fail when
+// they don't commit within the store's RangeUnavailableTimeout; the breaker
+// trips once commands have kept failing, without any command committing,
+// for another such timeout, so that a single slow command doesn't trip it.
+// While it is tripped, commands fail without being proposed, except for one
+// command per probe interval which is let through to probe the range. The
+// breaker is reset once a command commits.
+type replicaBreaker struct {
+	mu           sync.Mutex
+	failingSince time.Time                      // when the first command failed since the last commit
+	err          *roachpb.RangeUnavailableError // set while the breaker is tripped
+	nextProbe    time.Time                      // when the next probe may be let through
+}
+
+// check returns the error the breaker tripped with, unless the breaker
+// isn't tripped or the caller is let through as a probe.
+func (b *replicaBreaker) check(now time.Time, probeInterval time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err == nil {
+		return nil
+	}
+	if !now.Before(b.nextProbe) {
+		b.nextProbe = now.Add(probeInterval)
+		return nil
+	}
+	return b.err
+}
+
+// fail records the failure of a command with the given error. The breaker
+// trips if commands have been failing for at least the given duration.
+// Returns whether the breaker is tripped and whether it was tripped before.
+func (b *replicaBreaker) fail(now time.Time, sustained, probeInterval time.Duration,
+	err *roachpb.RangeUnavailableError) (tripped, wasTripped bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failingSince.IsZero() {
+		b.failingSince = now
+	}
+	wasTripped = b.err != nil
+	if !wasTripped && now.Sub(b.failingSince) < sustained {
+		return false, false
+	}
+	b.err = err
+	b.nextProbe = now.Add(probeInterval)
+	return true, wasTripped
+}
+
+// reset resets the breaker after a command committed and returns whether
+// it was tripped.
+func (b *replicaBreaker) reset() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wasTripped := b.err != nil
+	b.err = nil
+	b.failingSince = time.Time{}
+	return wasTripped
+}
+
+// checkAvailable returns a RangeUnavailableError if the replica's circuit
+// breaker is tripped and the caller should fail without proposing a
+// command.
+func (r *Replica) checkAvailable() error {
+	if r.store.ctx.RangeUnavailableTimeout <= 0 {
+		return nil
+	}
+	return r.breaker.check(time.Now(), r.store.ctx.RangeUnavailableProbeInterval)
+}
+
+// markAvailable resets the replica's circuit breaker after a command
+// committed.
+func (r *Replica) markAvailable() {
+	if r.breaker.reset() {
+		log.Infof("range %d: available again", r.Desc().RangeID)
+	}
+}
+
+// markUnavailable records that a command failed to commit within the given
+// timeout, tripping the replica's circuit breaker if commands have kept
+// failing for another timeout, and returns the error describing the
+// failure.
+func (r *Replica) markUnavailable(timeout time.Duration) *roachpb.RangeUnavailableError {
+	rangeID := r.Desc().RangeID
+	err := &roachpb.RangeUnavailableError{
+		RangeID: rangeID,
+		Message: fmt.Sprintf("command failed to commit within %s", timeout),
+	}
+	if status := r.store.RaftStatus(rangeID); status != nil {
+		err.RaftStatus = status.String()
+	}
+	if tripped, wasTripped := r.breaker.fail(time.Now(), timeout,
+		r.store.ctx.RangeUnavailableProbeInterval, err); tripped && !wasTripped {
+		log.Warningf("range %d: tripped circuit breaker: %s", rangeID, err)
+		r.store.metrics.Counter("range.breaker.trips").Inc(1)
+	}
+	return err
+}
+
+// abandonCmd gives up waiting for a command which failed to commit in
+// time, which may trip the replica's circuit breaker. The command may still
+// commit, so it keeps its place in the command queue until it is known to
+// have committed or failed, or until the store stops, and its client
+// receives an AmbiguousResultError.
+func (r *Replica) abandonCmd(cmdKeys []interface{}, ba roachpb.BatchRequest, timeout time.Duration,
+	errChan <-chan error, pendingCmd *pendingCmd) error {
+	err := r.markUnavailable(timeout)
+	stopper := r.store.Stopper()
+	if !stopper.RunAsyncTask(func() {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				r.removePendingCmd(pendingCmd)
+			} else {
+				r.markAvailable()
+				err = (<-pendingCmd.done).Err
+			}
+			r.endCmds(cmdKeys, ba, err)
+		case <-stopper.ShouldDrain():
+			// The command may never complete; don't hold up the stopper.
+			r.removePendingCmd(pendingCmd)
+			r.endCmds(cmdKeys, ba, err)
+		}
+	}) {
+		r.removePendingCmd(pendingCmd)
+		r.endCmds(cmdKeys, ba, err)
+	}
+	return &roachpb.AmbiguousResultError{Message: err.Error()}
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -33,6 +34,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -59,6 +61,16 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 			return nil, nil, err
 		}
 	}
+	if filter := r.store.ctx.TestingKnobs.TestingCommandFilter; filter != nil {
+		if err := filter(CommandFilterArgs{
+			StoreID: r.store.StoreID(),
+			RangeID: r.Desc().RangeID,
+			Req:     args,
+			Hdr:     h,
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	var reply roachpb.Response
 	var intents []roachpb.Intent
@@ -116,6 +128,10 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.GCResponse
 		resp, err = r.GC(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.AdminSetQueueExclusionRequest:
+		var resp roachpb.AdminSetQueueExclusionResponse
+		resp, err = r.AdminSetQueueExclusion(batch, ms, h, *tArgs)
+		reply = &resp
 	case *roachpb.PushTxnRequest:
 		var resp roachpb.PushTxnResponse
 		resp, err = r.PushTxn(batch, ms, h, *tArgs)
@@ -128,6 +144,14 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.ResolveIntentRangeResponse
 		resp, err = r.ResolveIntentRange(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.IngestRequest:
+		var resp roachpb.IngestResponse
+		resp, err = r.Ingest(batch, ms, h, *tArgs)
+		reply = &resp
+	case *roachpb.ExportRequest:
+		var resp roachpb.ExportResponse
+		resp, err = r.Export(batch, h, *tArgs)
+		reply = &resp
 	case *roachpb.MergeRequest:
 		var resp roachpb.MergeResponse
 		resp, err = r.Merge(batch, ms, h, *tArgs)
@@ -190,6 +214,11 @@ func (r *Replica) Get(batch engine.Engine, h roachpb.Header, args roachpb.GetReq
 func (r *Replica) Put(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.PutRequest) (roachpb.PutResponse, error) {
 	var reply roachpb.PutResponse
 
+	// Verify the checksum computed by the client before writing the value,
+	// so that corruption along the way doesn't make it to disk.
+	if err := args.Value.Verify(args.Key); err != nil {
+		return reply, err
+	}
 	return reply, engine.MVCCPut(batch, ms, args.Key, h.Timestamp, args.Value, h.Txn)
 }
 
@@ -199,6 +228,14 @@ func (r *Replica) Put(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Heade
 func (r *Replica) ConditionalPut(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.ConditionalPutRequest) (roachpb.ConditionalPutResponse, error) {
 	var reply roachpb.ConditionalPutResponse
 
+	if err := args.Value.Verify(args.Key); err != nil {
+		return reply, err
+	}
+	if args.ExpValue != nil {
+		if err := args.ExpValue.Verify(args.Key); err != nil {
+			return reply, err
+		}
+	}
 	return reply, engine.MVCCConditionalPut(batch, ms, args.Key, h.Timestamp, args.Value, args.ExpValue, h.Txn)
 }
 
@@ -231,21 +268,31 @@ func (r *Replica) DeleteRange(batch engine.Engine, ms *engine.MVCCStats, h roach
 }
 
 // Scan scans the key range specified by start key through end key in ascending
-// order up to some maximum number of results.
+// order up to some maximum number of results. If the request is key-only, the
+// values of the returned rows are omitted.
 func (r *Replica) Scan(batch engine.Engine, h roachpb.Header, args roachpb.ScanRequest) (roachpb.ScanResponse, []roachpb.Intent, error) {
 	var reply roachpb.ScanResponse
 
-	rows, intents, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp, h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
+	scan := engine.MVCCScan
+	if args.KeyOnly {
+		scan = engine.MVCCScanKeys
+	}
+	rows, intents, err := scan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp, h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
 	reply.Rows = rows
 	return reply, intents, err
 }
 
 // ReverseScan scans the key range specified by start key through end key in
-// descending order up to some maximum number of results.
+// descending order up to some maximum number of results. If the request is
+// key-only, the values of the returned rows are omitted.
 func (r *Replica) ReverseScan(batch engine.Engine, h roachpb.Header, args roachpb.ReverseScanRequest) (roachpb.ReverseScanResponse, []roachpb.Intent, error) {
 	var reply roachpb.ReverseScanResponse
 
-	rows, intents, err := engine.MVCCReverseScan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp,
+	scan := engine.MVCCReverseScan
+	if args.KeyOnly {
+		scan = engine.MVCCReverseScanKeys
+	}
+	rows, intents, err := scan(batch, args.Key, args.EndKey, args.MaxResults, h.Timestamp,
 		h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
 	reply.Rows = rows
 	return reply, intents, err
@@ -453,6 +500,17 @@ func (r *Replica) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, h ro
 		}
 	}
 
+	// The transaction committed in one phase if it began writing in this
+	// batch (the transaction is only marked as writing once the batch
+	// containing its BeginTransaction has been executed) and none of its
+	// intents are left for asynchronous resolution.
+	reply.OnePhaseCommit = reply.Txn.Status == roachpb.COMMITTED && !h.Txn.Writing &&
+		len(externalIntents) == 0
+	if args.RequireOnePhaseCommit && args.Commit && len(externalIntents) > 0 {
+		return reply, nil, util.Errorf("transaction %s spans multiple ranges and cannot be committed in one phase",
+			h.Txn.Short())
+	}
+
 	// Persist the transaction record with updated status (& possibly timestamp).
 	// If we've already resolved all intents locally, we actually delete the
 	// record right away - no use in keeping it around.
@@ -488,7 +546,7 @@ func (r *Replica) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, h ro
 			}
 		}
 		if ct.GetChangeReplicasTrigger() != nil {
-			if err := r.changeReplicasTrigger(ct.ChangeReplicasTrigger); err != nil {
+			if err := r.changeReplicasTrigger(batch, ct.ChangeReplicasTrigger); err != nil {
 				return reply, nil, err
 			}
 		}
@@ -815,8 +873,21 @@ func (r *Replica) HeartbeatTxn(batch engine.Engine, ms *engine.MVCCStats, h roac
 func (r *Replica) GC(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.GCRequest) (roachpb.GCResponse, error) {
 	var reply roachpb.GCResponse
 
+	// Sequence cache entries are inline values, which are removed outright
+	// unless they were updated after the GC queue found them expired.
+	var gcKeys []roachpb.GCRequest_GCKey
+	for _, gcKey := range args.Keys {
+		if txnID, ok := r.seqCache.decodeSequenceCacheKey(gcKey.Key); ok {
+			if err := r.seqCache.Del(batch, txnID, gcKey.Timestamp); err != nil {
+				return reply, err
+			}
+			continue
+		}
+		gcKeys = append(gcKeys, gcKey)
+	}
+
 	// Garbage collect the specified keys by expiration timestamps.
-	if err := engine.MVCCGarbageCollect(batch, ms, args.Keys, h.Timestamp); err != nil {
+	if err := engine.MVCCGarbageCollect(batch, ms, gcKeys, h.Timestamp); err != nil {
 		return reply, err
 	}
 
@@ -1015,6 +1086,57 @@ func (r *Replica) ResolveIntentRange(batch engine.Engine, ms *engine.MVCCStats,
 	return reply, err
 }
 
+// Ingest writes the rows of the request which fall within its span at the
+// request's timestamp, without transactional intents. The rows outside of
+// the span belong to other ranges which receive the same request. Since
+// the rows are those of a table which is not yet public, the timestamp
+// cache is not consulted.
+func (r *Replica) Ingest(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.IngestRequest) (roachpb.IngestResponse, error) {
+	var reply roachpb.IngestResponse
+
+	for _, kv := range args.Rows {
+		if bytes.Compare(kv.Key, args.Key) < 0 || bytes.Compare(kv.Key, args.EndKey) >= 0 {
+			continue
+		}
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return reply, err
+		}
+		// Rows are never overwritten; any existing version of the key (for
+		// instance one ingested by an earlier request) is reported instead.
+		existing, _, err := engine.MVCCGet(batch, kv.Key, roachpb.MaxTimestamp, false /* !consistent */, nil)
+		if err != nil {
+			return reply, err
+		}
+		if existing != nil {
+			reply.ExistingKeys = append(reply.ExistingKeys, kv.Key)
+			continue
+		}
+		if err := engine.MVCCPut(batch, ms, kv.Key, h.Timestamp, kv.Value, nil); err != nil {
+			return reply, err
+		}
+	}
+	return reply, nil
+}
+
+// Export returns the rows of the request's span whose latest version as of
+// the request's timestamp was written after the request's start time, along
+// with the keys deleted since then, up to a maximum number of results. The
+// returned values are those as of the request's timestamp.
+func (r *Replica) Export(batch engine.Engine, h roachpb.Header, args roachpb.ExportRequest) (roachpb.ExportResponse, error) {
+	var reply roachpb.ExportResponse
+
+	err := engine.MVCCIterateChanges(batch, args.Key, args.EndKey, args.StartTime, h.Timestamp,
+		func(key roachpb.Key, value *roachpb.Value) (bool, error) {
+			if value == nil {
+				reply.DeletedKeys = append(reply.DeletedKeys, key)
+			} else {
+				reply.Rows = append(reply.Rows, roachpb.KeyValue{Key: key, Value: *value})
+			}
+			return args.MaxResults > 0 && int64(len(reply.Rows)+len(reply.DeletedKeys)) >= args.MaxResults, nil
+		})
+	return reply, err
+}
+
 // Merge is used to merge a value into an existing key. Merge is an
 // efficient accumulation operation which is exposed by RocksDB, used by
 // Cockroach for the efficient accumulation of certain values. Due to the
@@ -1073,18 +1195,24 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	}
 
 	// Verify details of new lease request. The start of this lease must
-	// obviously precede its expiration.
-	if !args.Lease.Start.Less(args.Lease.Expiration) {
+	// obviously precede its expiration, unless it is epoch-based.
+	if args.Lease.Epoch == 0 && !args.Lease.Start.Less(args.Lease.Expiration) {
 		rErr.Message = "expiration precedes start"
 		return reply, rErr
 	}
 
 	// Verify that requestion replica is part of the current replica set.
 	desc := r.Desc()
-	if idx, _ := desc.FindReplica(args.Lease.Replica.StoreID); idx == -1 {
+	idx, rep := desc.FindReplica(args.Lease.Replica.StoreID)
+	if idx == -1 {
 		rErr.Message = "replica not found"
 		return reply, rErr
 	}
+	// Witnesses hold no user data and can't serve as leader.
+	if rep.Witness {
+		rErr.Message = "replica is a witness"
+		return reply, rErr
+	}
 
 	// Wind the start timestamp back as far to the previous lease's expiration
 	// as we can. That'll make sure that when multiple leases are requested out
@@ -1099,13 +1227,17 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	// If no old lease exists or this is our lease, we don't need to add an
 	// extra tick. This allows multiple requests from the same replica to
 	// merge without ticking away from the minimal common start timestamp.
-	if prevLease.Replica.StoreID == 0 || isExtension {
-		// TODO(tschottdorf) Think about whether it'd be better to go all the
-		// way back to prevLease.Start(), so that whenever the last lease is
-		// the own one, the original start is preserved.
-		effectiveStart.Backward(prevLease.Expiration)
-	} else {
-		effectiveStart.Backward(prevLease.Expiration.Next())
+	//
+	// Epoch-based leases have no expiration to wind back to.
+	if prevLease.Epoch == 0 {
+		if prevLease.Replica.StoreID == 0 || isExtension {
+			// TODO(tschottdorf) Think about whether it'd be better to go all the
+			// way back to prevLease.Start(), so that whenever the last lease is
+			// the own one, the original start is preserved.
+			effectiveStart.Backward(prevLease.Expiration)
+		} else {
+			effectiveStart.Backward(prevLease.Expiration.Next())
+		}
 	}
 
 	if isExtension {
@@ -1114,7 +1246,17 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 			return reply, rErr
 		}
 		// Note that the lease expiration can be shortened by the holder.
-		// This could be used to effect a faster lease handoff.
+		// This could be used to effect a faster lease handoff. For the same
+		// purpose, the holder may replace an epoch-based lease with an
+		// expiration-based one.
+	} else if prevLease.Epoch != 0 {
+		// An epoch-based lease is taken over only after the holder's
+		// liveness epoch has been incremented, which the proposer has
+		// verified. That is only valid if the lease hasn't changed since.
+		if args.PrevLease == nil || *args.PrevLease != *prevLease {
+			rErr.Message = "previous epoch-based lease changed"
+			return reply, rErr
+		}
 	} else if effectiveStart.Less(prevLease.Expiration) {
 		rErr.Message = "requested lease overlaps previous lease"
 		return reply, rErr
@@ -1129,6 +1271,7 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 		return reply, err
 	}
 	atomic.StorePointer(&r.lease, unsafe.Pointer(&args.Lease))
+	r.leaseHistory.record(*prevLease, args.Lease, r.store.Clock().PhysicalTime())
 
 	// If this replica is a new holder of the lease, update the
 	// low water mark in the timestamp cache. We add the maximum
@@ -1137,7 +1280,13 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	// node.
 	if r.getLease().Replica.StoreID == r.store.StoreID() &&
 		prevLease.Replica.StoreID != r.getLease().Replica.StoreID {
-		r.tsCache.SetLowWater(prevLease.Expiration.Add(int64(r.store.Clock().MaxOffset()), 0))
+		lowWater := prevLease.Expiration
+		if prevLease.Epoch != 0 {
+			// The previous holder's reads all precede the start of the new
+			// lease; see requestLeaderLease.
+			lowWater = args.Lease.Start
+		}
+		r.tsCache.SetLowWater(lowWater.Add(int64(r.store.Clock().MaxOffset()), 0))
 		log.Infof("range %d: new leader lease %s", rangeID, args.Lease)
 	}
 
@@ -1212,6 +1361,15 @@ func (r *Replica) AdminSplit(args roachpb.AdminSplitRequest, desc *roachpb.Range
 
 	log.Infof("initiating a split of %s at key %s", r, splitKey)
 
+	event := &RangeLogEvent{
+		Timestamp:    r.store.Clock().Now(),
+		RangeID:      desc.RangeID,
+		StoreID:      r.store.StoreID(),
+		EventType:    RANGE_SPLIT,
+		OtherRangeID: newDesc.RangeID,
+		UpdatedDesc:  updatedDesc,
+	}
+
 	if err := r.store.DB().Txn(func(txn *client.Txn) error {
 		// Create range descriptor for second half of split.
 		// Note that this put must go first in order to locate the
@@ -1230,6 +1388,7 @@ func (r *Replica) AdminSplit(args roachpb.AdminSplitRequest, desc *roachpb.Range
 		if err := splitRangeAddressing(b, newDesc, &updatedDesc); err != nil {
 			return err
 		}
+		logRangeEvent(b, event)
 		if err := txn.Run(b); err != nil {
 			return err
 		}
@@ -1304,6 +1463,18 @@ func (r *Replica) splitTrigger(batch engine.Engine, split *roachpb.SplitTrigger)
 	if err = r.respCache.CopyInto(batch, split.NewDesc.RangeID); err != nil {
 		return util.Errorf("unable to copy response cache to new split range: %s", err)
 	}
+	// Likewise for the sequence cache.
+	if err = r.seqCache.CopyInto(batch, split.NewDesc.RangeID); err != nil {
+		return util.Errorf("unable to copy sequence cache to new split range: %s", err)
+	}
+
+	// Update the store's replica descriptor index for both ranges.
+	if err := putReplicaDescriptorIndex(batch, &split.UpdatedDesc); err != nil {
+		return util.Errorf("unable to index updated range descriptor: %s", err)
+	}
+	if err := putReplicaDescriptorIndex(batch, &split.NewDesc); err != nil {
+		return util.Errorf("unable to index new range descriptor: %s", err)
+	}
 
 	// Add the new split replica to the store. This step atomically
 	// updates the EndKey of the updated replica and also adds the
@@ -1313,6 +1484,11 @@ func (r *Replica) splitTrigger(batch engine.Engine, split *roachpb.SplitTrigger)
 		return err
 	}
 
+	// Copy the queue exclusions into the new range.
+	if err := r.copyQueueExclusions(batch, newRng); err != nil {
+		return util.Errorf("unable to copy queue exclusions: %s", err)
+	}
+
 	// Compute stats for new range.
 	iter = newReplicaDataIterator(&split.NewDesc, batch)
 	ms, err = engine.MVCCComputeStats(iter, now.WallTime)
@@ -1371,11 +1547,18 @@ func (r *Replica) AdminMerge(args roachpb.AdminMergeRequest, origLeftDesc *roach
 		if rightRng == nil {
 			return reply, util.Errorf("ranges not collocated")
 		}
+		if !replicaSetsEqual(origLeftDesc.Replicas, rightRng.Desc().Replicas) {
+			if err := rightRng.collocateWith(origLeftDesc); err != nil {
+				return reply, util.Errorf("ranges not collocated; unable to move replicas of %s: %s",
+					rightRng, err)
+			}
+		}
 
 		updatedLeftDesc.EndKey = rightRng.Desc().EndKey
 		log.Infof("initiating a merge of %s into %s", rightRng, r)
 	}
 
+	now := r.store.Clock().Now()
 	if err := r.store.DB().Txn(func(txn *client.Txn) error {
 		// Update the range descriptor for the receiving range.
 		{
@@ -1421,6 +1604,15 @@ func (r *Replica) AdminMerge(args roachpb.AdminMergeRequest, origLeftDesc *roach
 			return err
 		}
 
+		logRangeEvent(b, &RangeLogEvent{
+			Timestamp:    now,
+			RangeID:      origLeftDesc.RangeID,
+			StoreID:      r.store.StoreID(),
+			EventType:    RANGE_MERGE,
+			OtherRangeID: rightDesc.RangeID,
+			UpdatedDesc:  updatedLeftDesc,
+		})
+
 		// Update the RangeTree.
 		if err := DeleteRange(txn, b, rightDesc.StartKey); err != nil {
 			return err
@@ -1467,6 +1659,10 @@ func (r *Replica) mergeTrigger(batch engine.Engine, merge *roachpb.MergeTrigger)
 	if err := r.respCache.CopyFrom(batch, merge.SubsumedRangeID); err != nil {
 		return util.Errorf("unable to copy response cache to new split range: %s", err)
 	}
+	// Likewise for the sequence cache.
+	if err := r.seqCache.CopyFrom(batch, merge.SubsumedRangeID); err != nil {
+		return util.Errorf("unable to copy sequence cache to subsuming range: %s", err)
+	}
 
 	// Remove the subsumed range's metadata.
 	localRangeKeyPrefix := keys.MakeRangeIDPrefix(merge.SubsumedRangeID)
@@ -1474,6 +1670,14 @@ func (r *Replica) mergeTrigger(batch engine.Engine, merge *roachpb.MergeTrigger)
 		return util.Errorf("cannot remove range metadata %s", err)
 	}
 
+	// Update the store's replica descriptor index.
+	if err := putReplicaDescriptorIndex(batch, &merge.UpdatedDesc); err != nil {
+		return util.Errorf("unable to index updated range descriptor: %s", err)
+	}
+	if err := deleteReplicaDescriptorIndex(batch, merge.SubsumedRangeID); err != nil {
+		return util.Errorf("unable to remove subsumed range descriptor from index: %s", err)
+	}
+
 	// Compute stats for updated range.
 	now := r.store.Clock().Timestamp()
 	iter := newReplicaDataIterator(&merge.UpdatedDesc, batch)
@@ -1503,11 +1707,25 @@ func (r *Replica) mergeTrigger(batch engine.Engine, merge *roachpb.MergeTrigger)
 	return nil
 }
 
-func (r *Replica) changeReplicasTrigger(change *roachpb.ChangeReplicasTrigger) error {
+func (r *Replica) changeReplicasTrigger(batch engine.Engine, change *roachpb.ChangeReplicasTrigger) error {
 	defer r.clearPendingChangeReplicas()
+	// Reject the change if the descriptor has been modified since the change
+	// was computed. All replicas apply commands in the same order, so they
+	// agree on the outcome.
+	if change.OriginalDesc != nil && !proto.Equal(change.OriginalDesc, r.Desc()) {
+		return util.Errorf("change replicas of range %d computed against stale descriptor %+v; current descriptor is %+v",
+			r.Desc().RangeID, change.OriginalDesc, r.Desc())
+	}
+	if err := validateReplicaChange(r.Desc(), change); err != nil {
+		log.Errorc(r.context(), "refusing invalid replica change: %s", err)
+		return err
+	}
 	cpy := *r.Desc()
 	cpy.Replicas = change.UpdatedReplicas
 	cpy.NextReplicaID = change.NextReplicaID
+	if err := putReplicaDescriptorIndex(batch, &cpy); err != nil {
+		return err
+	}
 	if err := r.setDesc(&cpy); err != nil {
 		return err
 	}
@@ -1522,13 +1740,146 @@ func (r *Replica) changeReplicasTrigger(change *roachpb.ChangeReplicasTrigger) e
 	return nil
 }
 
+// validateReplicaChange verifies that applying the change to the given
+// descriptor allocates replica IDs deterministically: the ID of an added
+// replica must be the descriptor's NextReplicaID, so that IDs strictly
+// increase and IDs of removed (and tombstoned) replicas are never reused,
+// and the IDs of all other replicas must remain unchanged.
+func validateReplicaChange(desc *roachpb.RangeDescriptor, change *roachpb.ChangeReplicasTrigger) error {
+	existing := map[roachpb.StoreID]roachpb.ReplicaID{}
+	for _, rep := range desc.Replicas {
+		existing[rep.StoreID] = rep.ReplicaID
+	}
+	updated := map[roachpb.StoreID]roachpb.ReplicaID{}
+	seen := map[roachpb.ReplicaID]struct{}{}
+	for _, rep := range change.UpdatedReplicas {
+		if rep.ReplicaID == 0 || rep.ReplicaID >= change.NextReplicaID {
+			return util.Errorf("replica %v has an ID outside of the allocated range [1, %d)",
+				rep, change.NextReplicaID)
+		}
+		if _, ok := seen[rep.ReplicaID]; ok {
+			return util.Errorf("replica ID %d is used twice in %v", rep.ReplicaID, change.UpdatedReplicas)
+		}
+		seen[rep.ReplicaID] = struct{}{}
+		updated[rep.StoreID] = rep.ReplicaID
+	}
+
+	switch change.ChangeType {
+	case roachpb.ADD_REPLICA:
+		if change.Replica.ReplicaID != desc.NextReplicaID {
+			return util.Errorf("added replica %v must have ID %d", change.Replica, desc.NextReplicaID)
+		}
+		if change.NextReplicaID != desc.NextReplicaID+1 {
+			return util.Errorf("adding a replica must advance NextReplicaID from %d to %d, not %d",
+				desc.NextReplicaID, desc.NextReplicaID+1, change.NextReplicaID)
+		}
+		if id, ok := updated[change.Replica.StoreID]; !ok || id != change.Replica.ReplicaID {
+			return util.Errorf("added replica %v is missing from %v", change.Replica, change.UpdatedReplicas)
+		}
+		delete(updated, change.Replica.StoreID)
+	case roachpb.REMOVE_REPLICA:
+		if id, ok := existing[change.Replica.StoreID]; !ok || id != change.Replica.ReplicaID {
+			return util.Errorf("removed replica %v is not part of %v", change.Replica, desc.Replicas)
+		}
+		if change.NextReplicaID != desc.NextReplicaID {
+			return util.Errorf("removing a replica must not change NextReplicaID from %d to %d",
+				desc.NextReplicaID, change.NextReplicaID)
+		}
+		if _, ok := updated[change.Replica.StoreID]; ok {
+			return util.Errorf("removed replica %v is still part of %v", change.Replica, change.UpdatedReplicas)
+		}
+		delete(existing, change.Replica.StoreID)
+	}
+
+	// All other replicas are carried over unchanged.
+	if len(existing) != len(updated) {
+		return util.Errorf("replica change %s of %v changes %v into %v",
+			change.ChangeType, change.Replica, desc.Replicas, change.UpdatedReplicas)
+	}
+	for storeID, id := range existing {
+		if updated[storeID] != id {
+			return util.Errorf("replica change %s of %v changes %v into %v",
+				change.ChangeType, change.Replica, desc.Replicas, change.UpdatedReplicas)
+		}
+	}
+	return nil
+}
+
+// AdminScatter adds a replica of the range on a store chosen at random
+// among those satisfying the range's zone config which don't already hold
+// a replica, and queues the range for the replicate queue to remove the
+// excess replica. It does nothing if there is no such store.
+func (r *Replica) AdminScatter(args roachpb.AdminScatterRequest, desc *roachpb.RangeDescriptor) (roachpb.AdminScatterResponse, error) {
+	var reply roachpb.AdminScatterResponse
+	var required roachpb.Attributes
+	if cfg := r.store.Gossip().GetSystemConfig(); cfg != nil {
+		zone, err := cfg.GetZoneConfigForKey(desc.StartKey)
+		if err != nil {
+			return reply, util.Errorf("failed to lookup zone config for range %d: %s", desc.RangeID, err)
+		}
+		if len(zone.ReplicaAttrs) > 0 {
+			required = zone.ReplicaAttrs[0]
+		}
+	}
+	target := r.store.allocator.ScatterTarget(required, desc.Replicas)
+	if target == nil {
+		return reply, nil
+	}
+	replica := roachpb.ReplicaDescriptor{
+		NodeID:  target.Node.NodeID,
+		StoreID: target.StoreID,
+	}
+	if err := r.ChangeReplicas(roachpb.ADD_REPLICA, replica, desc, REASON_REBALANCE); err != nil {
+		return reply, err
+	}
+	r.store.replicateQueue.MaybeAdd(r, r.store.Clock().Now())
+	return reply, nil
+}
+
+// AdminSetQueueExclusion excludes the range from the named replica queue,
+// or lifts such an exclusion. The command is applied through Raft, so
+// that every replica of the range updates its cached exclusions and, when
+// excluding the range, drops itself from the queue on its store.
+func (r *Replica) AdminSetQueueExclusion(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.AdminSetQueueExclusionRequest) (roachpb.AdminSetQueueExclusionResponse, error) {
+	var reply roachpb.AdminSetQueueExclusionResponse
+	var queue *baseQueue
+	for _, q := range r.store.queues() {
+		if q.name == args.Queue {
+			queue = q
+			break
+		}
+	}
+	if queue == nil {
+		return reply, util.Errorf("unknown queue %q", args.Queue)
+	}
+	key := keys.RangeQueueExclusionKey(r.Desc().RangeID, args.Queue)
+	if args.Excluded {
+		if err := engine.MVCCPutProto(batch, ms, key, roachpb.ZeroTimestamp, nil, &h.Timestamp); err != nil {
+			return reply, err
+		}
+	} else if err := engine.MVCCDelete(batch, ms, key, roachpb.ZeroTimestamp, nil); err != nil {
+		return reply, err
+	}
+	batch.Defer(func() {
+		if err := r.loadQueueExclusions(r.store.Engine()); err != nil {
+			log.Warningf("%s: unable to reload queue exclusions: %s", r, err)
+		}
+		if args.Excluded {
+			queue.MaybeRemove(r)
+		}
+	})
+	return reply, nil
+}
+
 // ChangeReplicas adds or removes a replica of a range. The change is performed
 // in a distributed transaction and takes effect when that transaction is committed.
 // When removing a replica, only the NodeID and StoreID fields of the Replica are used.
+// The change is recorded in the range's change history along with the given reason.
 //
 // The supplied RangeDescriptor is used as a form of optimistic lock. See the
 // comment of "AdminSplit" for more information on this pattern.
-func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica roachpb.ReplicaDescriptor, desc *roachpb.RangeDescriptor) error {
+func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica roachpb.ReplicaDescriptor,
+	desc *roachpb.RangeDescriptor, reason ReplicaChangeReason) error {
 	r.Lock()
 	for r.pendingReplica.value.ReplicaID != 0 {
 		r.pendingReplica.Wait()
@@ -1562,6 +1913,11 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 		// We need to be able to look up replica information before the change
 		// is official.
 		r.pendingReplica.value = replica
+		if reason == REASON_REBALANCE {
+			// The snapshot populating the new replica is rate limited as a
+			// rebalance snapshot.
+			r.markRebalanceTarget(replica.ReplicaID, r.store.Clock().PhysicalTime())
+		}
 	} else if changeType == roachpb.REMOVE_REPLICA {
 		// If that exact node-store combination does not have the replica,
 		// abort the removal.
@@ -1571,10 +1927,35 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 		}
 		updatedDesc.Replicas[found] = updatedDesc.Replicas[len(updatedDesc.Replicas)-1]
 		updatedDesc.Replicas = updatedDesc.Replicas[:len(updatedDesc.Replicas)-1]
+		// Witnesses can't make up a range on their own.
+		dataReplicas := 0
+		for _, rep := range updatedDesc.Replicas {
+			if !rep.Witness {
+				dataReplicas++
+			}
+		}
+		if dataReplicas == 0 && len(updatedDesc.Replicas) > 0 {
+			r.Unlock()
+			return util.Errorf("removing replica %v would leave only witnesses in range %d",
+				replica, desc.RangeID)
+		}
 	}
 
 	r.Unlock()
 
+	event := &RangeLogEvent{
+		Timestamp:   r.store.Clock().Now(),
+		RangeID:     desc.RangeID,
+		StoreID:     r.store.StoreID(),
+		EventType:   RANGE_ADD_REPLICA,
+		UpdatedDesc: updatedDesc,
+		Replica:     replica,
+		Reason:      reason,
+	}
+	if changeType == roachpb.REMOVE_REPLICA {
+		event.EventType = RANGE_REMOVE_REPLICA
+	}
+
 	err := r.store.DB().Txn(func(txn *client.Txn) error {
 		// Important: the range descriptor must be the first thing touched in the transaction
 		// so the transaction record is co-located with the range being modified.
@@ -1590,6 +1971,10 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 			return err
 		}
 
+		if err := logReplicaChange(txn, b, event); err != nil {
+			return err
+		}
+
 		// End the transaction manually instead of letting RunTransaction
 		// loop do it, in order to provide a commit trigger.
 		b.InternalAddRequest(&roachpb.EndTransactionRequest{
@@ -1600,6 +1985,7 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 					Replica:         replica,
 					UpdatedReplicas: updatedDesc.Replicas,
 					NextReplicaID:   updatedDesc.NextReplicaID,
+					OriginalDesc:    desc,
 				},
 			},
 		})
@@ -1607,6 +1993,9 @@ func (r *Replica) ChangeReplicas(changeType roachpb.ReplicaChangeType, replica r
 	})
 	if err != nil {
 		r.clearPendingChangeReplicas()
+		if changeType == roachpb.ADD_REPLICA && reason == REASON_REBALANCE {
+			r.unmarkRebalanceTarget(replica.ReplicaID)
+		}
 		return util.Errorf("change replicas of %d failed: %s", desc.RangeID, err)
 	}
 	return nil
@@ -1619,6 +2008,147 @@ func (r *Replica) clearPendingChangeReplicas() {
 	r.Unlock()
 }
 
+const (
+	// mergeCatchUpTimeout bounds the time AdminMerge waits for the replicas
+	// added to collocate the right-hand range to catch up.
+	mergeCatchUpTimeout = 30 * time.Second
+	// mergeCatchUpPollInterval is the interval at which the progress of
+	// those replicas is checked.
+	mergeCatchUpPollInterval = 50 * time.Millisecond
+	// mergeCampaignInterval is the interval at which the local replica of
+	// the right-hand range campaigns for its leadership while waiting for
+	// the added replicas, as long as it isn't the leader.
+	mergeCampaignInterval = time.Second
+)
+
+// collocateWith changes the replicas of the range so that they are on
+// the same stores as the replicas of the range described by target. It is
+// used by AdminMerge to collocate the right-hand range with the range it
+// is merged into. Replicas are added before superfluous ones are removed,
+// unless the node of a new replica already holds one; the allocator picks
+// which superfluous replica to remove first. Returns once the added
+// replicas have caught up with the range's log, so that the merge finds
+// the range's data on all of the stores.
+func (r *Replica) collocateWith(target *roachpb.RangeDescriptor) error {
+	var added []roachpb.ReplicaDescriptor
+	// Each change brings the replica sets closer; stop if concurrent
+	// changes keep the sets from converging.
+	maxChanges := 2 * (len(target.Replicas) + len(r.Desc().Replicas))
+	for i := 0; ; i++ {
+		desc := r.Desc()
+		if replicaSetsEqual(target.Replicas, desc.Replicas) {
+			break
+		}
+		if i == maxChanges {
+			return util.Errorf("replicas %v did not converge to %v", desc.Replicas, target.Replicas)
+		}
+		toAdd := replicaSetDiff(target.Replicas, desc.Replicas)
+		toRemove := replicaSetDiff(desc.Replicas, target.Replicas)
+
+		if dead := r.store.ctx.StorePool.deadReplicas(toAdd); len(dead) > 0 {
+			return util.Errorf("cannot add replicas on dead stores: %v", dead)
+		}
+
+		changeType := roachpb.ADD_REPLICA
+		var change roachpb.ReplicaDescriptor
+		if len(toAdd) > 0 {
+			change = roachpb.ReplicaDescriptor{NodeID: toAdd[0].NodeID, StoreID: toAdd[0].StoreID}
+			// A node holds at most one replica of a range, so a replica
+			// on another store of the node must be removed first.
+			for _, rep := range toRemove {
+				if rep.NodeID == change.NodeID {
+					changeType, change = roachpb.REMOVE_REPLICA, rep
+					break
+				}
+			}
+		} else {
+			changeType = roachpb.REMOVE_REPLICA
+			var err error
+			if change, err = r.store.allocator.RemoveTarget(toRemove); err != nil {
+				change = toRemove[0]
+			}
+		}
+		if err := r.ChangeReplicas(changeType, change, desc, REASON_MERGE); err != nil {
+			return err
+		}
+		if changeType == roachpb.ADD_REPLICA {
+			if _, rep := r.Desc().FindReplica(change.StoreID); rep != nil {
+				added = append(added, *rep)
+			}
+		}
+	}
+	return r.waitForCaughtUp(added)
+}
+
+// waitForCaughtUp waits until the given replicas of the range have
+// caught up with the range's committed log. Only the raft leader knows
+// the progress of the other replicas, so unless the local replica leads
+// the range, it campaigns for the range's leadership first.
+func (r *Replica) waitForCaughtUp(replicas []roachpb.ReplicaDescriptor) error {
+	if len(replicas) == 0 {
+		return nil
+	}
+	desc := r.Desc()
+	if _, local := desc.FindReplica(r.store.StoreID()); local == nil || local.Witness {
+		return util.Errorf("the local replica of range %d cannot lead it", desc.RangeID)
+	}
+	deadline := time.Now().Add(mergeCatchUpTimeout)
+	var lastCampaign time.Time
+	for {
+		status := r.store.RaftStatus(desc.RangeID)
+		if status == nil {
+			return util.Errorf("the raft group doesn't exist for range %d", desc.RangeID)
+		}
+		if status.RaftState == raft.StateLeader {
+			caughtUp := true
+			for _, rep := range replicas {
+				if status.Progress[uint64(rep.ReplicaID)].Match < status.Commit {
+					caughtUp = false
+					break
+				}
+			}
+			if caughtUp {
+				return nil
+			}
+		} else if status.RaftState != raft.StateCandidate && time.Since(lastCampaign) >= mergeCampaignInterval {
+			// The campaign fails while the local replica's log is behind
+			// those of the other replicas, so it is retried.
+			lastCampaign = time.Now()
+			if err := r.store.multiraft.CampaignGroup(desc.RangeID); err != nil {
+				return err
+			}
+		}
+		if time.Now().After(deadline) {
+			return util.Errorf("replicas %v of range %d did not catch up within %s",
+				replicas, desc.RangeID, mergeCatchUpTimeout)
+		}
+		select {
+		case <-time.After(mergeCatchUpPollInterval):
+		case <-r.store.Stopper().ShouldStop():
+			return util.Errorf("store is stopping")
+		}
+	}
+}
+
+// replicaSetDiff returns the replicas of a which are on stores holding no
+// replica of b.
+func replicaSetDiff(a, b []roachpb.ReplicaDescriptor) []roachpb.ReplicaDescriptor {
+	var diff []roachpb.ReplicaDescriptor
+	for _, repA := range a {
+		found := false
+		for _, repB := range b {
+			if repA.StoreID == repB.StoreID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, repA)
+		}
+	}
+	return diff
+}
+
 // replicaSetsEqual is used in AdminMerge to ensure that the ranges are
 // all collocate on the same set of replicas.
 func replicaSetsEqual(a, b []roachpb.ReplicaDescriptor) bool {
@@ -35,7 +35,7 @@ type keyRange struct {
 // all of the range's data.
 //
 // A replicaDataIterator provides the same API as an Engine iterator
-// with the exception of the Seek() method.
+// with the exception of reverse iteration.
 type replicaDataIterator struct {
 	curIndex int
 	ranges   []keyRange
@@ -43,6 +43,13 @@ type replicaDataIterator struct {
 }
 
 func newReplicaDataIterator(d *roachpb.RangeDescriptor, e engine.Engine) *replicaDataIterator {
+	return newKeyRangesIterator(makeReplicaKeyRanges(d), e)
+}
+
+// makeReplicaKeyRanges returns the key ranges which comprise the data of
+// the given range: the range-ID local keys, the range local keys and the
+// user data, in that order.
+func makeReplicaKeyRanges(d *roachpb.RangeDescriptor) []keyRange {
 	// The first range in the keyspace starts at KeyMin, which includes the node-local
 	// space. We need the original StartKey to find the range metadata, but the
 	// actual data starts at LocalMax.
@@ -50,22 +57,28 @@ func newReplicaDataIterator(d *roachpb.RangeDescriptor, e engine.Engine) *replic
 	if d.StartKey.Equal(roachpb.RKeyMin) {
 		dataStartKey = keys.LocalMax
 	}
-	ri := &replicaDataIterator{
-		ranges: []keyRange{
-			{
-				start: engine.MVCCEncodeKey(keys.MakeRangeIDPrefix(d.RangeID)),
-				end:   engine.MVCCEncodeKey(keys.MakeRangeIDPrefix(d.RangeID + 1)),
-			},
-			{
-				start: engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.StartKey)),
-				end:   engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.EndKey)),
-			},
-			{
-				start: engine.MVCCEncodeKey(dataStartKey),
-				end:   engine.MVCCEncodeKey(d.EndKey.AsRawKey()),
-			},
+	return []keyRange{
+		{
+			start: engine.MVCCEncodeKey(keys.MakeRangeIDPrefix(d.RangeID)),
+			end:   engine.MVCCEncodeKey(keys.MakeRangeIDPrefix(d.RangeID + 1)),
+		},
+		{
+			start: engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.StartKey)),
+			end:   engine.MVCCEncodeKey(keys.MakeRangeKeyPrefix(d.EndKey)),
+		},
+		{
+			start: engine.MVCCEncodeKey(dataStartKey),
+			end:   engine.MVCCEncodeKey(d.EndKey.AsRawKey()),
 		},
-		iter: e.NewIterator(),
+	}
+}
+
+// newKeyRangesIterator returns a replicaDataIterator over the given
+// key ranges, which must be sorted and non-overlapping.
+func newKeyRangesIterator(ranges []keyRange, e engine.Engine) *replicaDataIterator {
+	ri := &replicaDataIterator{
+		ranges: ranges,
+		iter:   e.NewIterator(),
 	}
 	ri.iter.Seek(ri.ranges[ri.curIndex].start)
 	ri.advance()
@@ -78,8 +91,22 @@ func (ri *replicaDataIterator) Close() {
 	ri.iter.Close()
 }
 
-// Seek seeks to the specified key.
+// Seek seeks to the first key at or after the specified key which
+// belongs to the range.
 func (ri *replicaDataIterator) Seek(key []byte) {
+	// Find the first key range which ends after the key.
+	ri.curIndex = 0
+	for ri.curIndex < len(ri.ranges) && !roachpb.EncodedKey(key).Less(ri.ranges[ri.curIndex].end) {
+		ri.curIndex++
+	}
+	if ri.curIndex == len(ri.ranges) {
+		// Seek to end to make iterator invalid.
+		ri.iter.Seek(engine.MVCCKeyMax)
+		return
+	}
+	if roachpb.EncodedKey(key).Less(ri.ranges[ri.curIndex].start) {
+		key = ri.ranges[ri.curIndex].start
+	}
 	ri.iter.Seek(key)
 	ri.advance()
 }
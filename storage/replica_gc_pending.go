@@ -0,0 +1,275 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// pendingGCSweepInterval is the interval at which the data of replicas
+// pending GC is checked for expiration of the StoreContext's
+// ReplicaGCDelay.
+const pendingGCSweepInterval = time.Minute
+
+// PendingGCReplicaInfo describes a replica which was removed from its
+// range and whose data is kept on the store until the StoreContext's
+// ReplicaGCDelay has passed.
+type PendingGCReplicaInfo struct {
+	// Desc is the last descriptor of the range known to the replica.
+	Desc roachpb.RangeDescriptor
+	// Since is the time at which the replica was removed.
+	Since time.Time
+}
+
+// A pendingGCOverlapError is returned for a range descriptor whose span
+// overlaps the span of a replica pending GC.
+type pendingGCOverlapError struct {
+	desc, pending roachpb.RangeDescriptor
+}
+
+func (e *pendingGCOverlapError) Error() string {
+	return fmt.Sprintf("snapshot of range %d [%s,%s) overlaps range %d [%s,%s) pending GC",
+		e.desc.RangeID, e.desc.StartKey, e.desc.EndKey,
+		e.pending.RangeID, e.pending.StartKey, e.pending.EndKey)
+}
+
+// checkPendingGCOverlapLocked returns an error if the span of the given
+// descriptor overlaps that of a replica pending GC, whose data is still on
+// the store. This includes a replica of the same range, whose data would
+// be destroyed along with that of the new replica once the
+// ReplicaGCDelay has passed. Requires that s.mu is held.
+func (s *Store) checkPendingGCOverlapLocked(desc *roachpb.RangeDescriptor) *pendingGCOverlapError {
+	for _, info := range s.pendingGC {
+		pending := &info.Desc
+		if pending.RangeID == desc.RangeID ||
+			(pending.StartKey.Less(desc.EndKey) && desc.StartKey.Less(pending.EndKey)) {
+			return &pendingGCOverlapError{desc: *desc, pending: *pending}
+		}
+	}
+	return nil
+}
+
+// destroyRemovedReplica destroys the data of a replica which was removed
+// from its range and from the store or, if the StoreContext specifies a
+// ReplicaGCDelay, marks the replica as pending GC. The marker is
+// persisted, so that the data outlives restarts of the store, along with
+// the replica's tombstone. Requires that the store's GroupLocker is held.
+func (s *Store) destroyRemovedReplica(rng *Replica) error {
+	if s.ctx.ReplicaGCDelay <= 0 {
+		return rng.Destroy()
+	}
+	desc := rng.Desc()
+	now := s.ctx.Clock.Now()
+	batch := s.engine.NewBatch()
+	defer batch.Close()
+	if err := engine.MVCCPutProto(batch, nil, keys.RangePendingGCKey(desc.RangeID),
+		roachpb.ZeroTimestamp, nil, &now); err != nil {
+		return err
+	}
+	tombstone := &roachpb.RaftTombstone{
+		NextReplicaID: desc.NextReplicaID,
+	}
+	if err := engine.MVCCPutProto(batch, nil, keys.RaftTombstoneKey(desc.RangeID),
+		roachpb.ZeroTimestamp, nil, tombstone); err != nil {
+		return err
+	}
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pendingGC[desc.RangeID] = &PendingGCReplicaInfo{
+		Desc:  *desc,
+		Since: time.Unix(0, now.WallTime),
+	}
+	log.Infof("store %s: replica of range %d is pending GC", s, desc.RangeID)
+	return nil
+}
+
+// loadPendingGC returns the time at which the replica of the given range
+// was marked as pending GC and whether it was.
+func loadPendingGC(eng engine.Engine, rangeID roachpb.RangeID) (time.Time, bool, error) {
+	var since roachpb.Timestamp
+	ok, err := engine.MVCCGetProto(eng, keys.RangePendingGCKey(rangeID),
+		roachpb.ZeroTimestamp, true, nil, &since)
+	if err != nil || !ok {
+		return time.Time{}, false, err
+	}
+	return time.Unix(0, since.WallTime), true, nil
+}
+
+// destroyReplicaData clears the data of the given range and leaves a
+// tombstone so that the range cannot be re-replicated onto the store
+// without a replica ID of at least desc.NextReplicaID.
+func destroyReplicaData(eng engine.Engine, desc *roachpb.RangeDescriptor) error {
+	iter := newReplicaDataIterator(desc, eng)
+	defer iter.Close()
+	batch := eng.NewBatch()
+	defer batch.Close()
+	for ; iter.Valid(); iter.Next() {
+		_ = batch.Clear(iter.Key())
+	}
+
+	tombstoneKey := keys.RaftTombstoneKey(desc.RangeID)
+	tombstone := &roachpb.RaftTombstone{
+		NextReplicaID: desc.NextReplicaID,
+	}
+	if err := engine.MVCCPutProto(batch, nil, tombstoneKey, roachpb.ZeroTimestamp, nil, tombstone); err != nil {
+		return err
+	}
+	if err := deleteReplicaDescriptorIndex(batch, desc.RangeID); err != nil {
+		return err
+	}
+
+	return batch.Commit()
+}
+
+// PendingGCReplicas returns descriptions of the replicas pending GC,
+// sorted by range ID.
+func (s *Store) PendingGCReplicas() []PendingGCReplicaInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	infos := make([]PendingGCReplicaInfo, 0, len(s.pendingGC))
+	for _, info := range s.pendingGC {
+		infos = append(infos, *info)
+	}
+	sort.Sort(pendingGCReplicaInfosByRangeID(infos))
+	return infos
+}
+
+type pendingGCReplicaInfosByRangeID []PendingGCReplicaInfo
+
+func (s pendingGCReplicaInfosByRangeID) Len() int { return len(s) }
+func (s pendingGCReplicaInfosByRangeID) Less(i, j int) bool {
+	return s[i].Desc.RangeID < s[j].Desc.RangeID
+}
+func (s pendingGCReplicaInfosByRangeID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// RecoverPendingGCReplica adds the replica of the given range which is
+// pending GC back to the store, with the data it had when it was removed
+// from its range. This is an administrative override for the case in which
+// the replica was removed erroneously. Note that the replica is still not
+// a member of its range and is considered for GC again by the replica GC
+// queue once it has been inactive long enough.
+func (s *Store) RecoverPendingGCReplica(rangeID roachpb.RangeID) error {
+	s.raftGroupLocker.Lock()
+	defer s.raftGroupLocker.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.pendingGC[rangeID]
+	if !ok {
+		return util.Errorf("range %d has no replica pending GC", rangeID)
+	}
+	if _, ok := s.replicas.get(rangeID); ok {
+		return util.Errorf("range %d already has a replica on store %s", rangeID, s)
+	}
+
+	batch := s.engine.NewBatch()
+	defer batch.Close()
+	for _, key := range []roachpb.Key{keys.RangePendingGCKey(rangeID), keys.RaftTombstoneKey(rangeID)} {
+		if err := engine.MVCCDelete(batch, nil, key, roachpb.ZeroTimestamp, nil); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+
+	rng, err := NewReplica(&info.Desc, s)
+	if err != nil {
+		return err
+	}
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
+	if err := s.addReplicaInternal(rng); err != nil {
+		return err
+	}
+	delete(s.pendingGC, rangeID)
+	s.feed.registerRange(rng, false /* scan */)
+	log.Warningf("store %s: recovered replica of range %d pending GC since %s", s, rangeID, info.Since)
+	return nil
+}
+
+// gcPendingReplicas destroys the data of the replicas which have been
+// pending GC since before the given time. Returns the number of replicas
+// destroyed.
+func (s *Store) gcPendingReplicas(removedBefore time.Time) (int, error) {
+	s.raftGroupLocker.Lock()
+	defer s.raftGroupLocker.Unlock()
+	var infos []*PendingGCReplicaInfo
+	s.mu.RLock()
+	for _, info := range s.pendingGC {
+		if info.Since.Before(removedBefore) {
+			infos = append(infos, info)
+		}
+	}
+	s.mu.RUnlock()
+
+	for i, info := range infos {
+		if err := destroyReplicaData(s.engine, &info.Desc); err != nil {
+			return i, err
+		}
+		s.mu.Lock()
+		delete(s.pendingGC, info.Desc.RangeID)
+		s.mu.Unlock()
+		s.metrics.Counter("replicas.pending-gc.destroyed").Inc(1)
+		log.Infof("store %s: destroyed data of replica of range %d pending GC since %s",
+			s, info.Desc.RangeID, info.Since)
+	}
+	return len(infos), nil
+}
+
+// sweepPendingGC destroys the data of the replicas which have been pending
+// GC for longer than the StoreContext's ReplicaGCDelay.
+func (s *Store) sweepPendingGC() (int, error) {
+	delay := s.ctx.ReplicaGCDelay
+	if delay < 0 {
+		delay = 0
+	}
+	now := time.Unix(0, s.ctx.Clock.PhysicalNow())
+	return s.gcPendingReplicas(now.Add(-delay))
+}
+
+// startPendingGCSweep starts a worker which periodically destroys the data
+// of the replicas which have been pending GC for longer than the
+// StoreContext's ReplicaGCDelay.
+func (s *Store) startPendingGCSweep() {
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(pendingGCSweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.stopper.RunTask(func() {
+					if _, err := s.sweepPendingGC(); err != nil {
+						log.Warningf("store %s: unable to destroy replicas pending GC: %s", s, err)
+					}
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
@@ -70,13 +70,13 @@ func (*replicaGCQueue) acceptsUnsplitRanges() bool {
 }
 
 // shouldQueue determines whether a replica should be queued for GC, and
-// if so at what priority. Replicas which have been inactive for longer
-// than ReplicaGCQueueInactivityThreshold are considered for possible GC
-// at equal priority.
+// if so at what priority. Replicas whose leader lease has not been valid
+// for longer than ReplicaGCQueueInactivityThreshold are considered for
+// possible GC at equal priority.
 func (*replicaGCQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 	_ *config.SystemConfig) (bool, float64) {
 
-	return rng.getLease().Expiration.Add(
+	return rng.leaseLastActive(rng.getLease()).Add(
 		ReplicaGCQueueInactivityThreshold.Nanoseconds(), 0,
 	).Less(now), 0
 }
@@ -141,7 +141,7 @@ func (q *replicaGCQueue) process(now roachpb.Timestamp, rng *Replica, _ *config.
 			return nil
 		}
 
-		if err := rng.Destroy(); err != nil {
+		if err := rng.store.destroyRemovedReplica(rng); err != nil {
 			return err
 		}
 	} else if desc.RangeID != desc.RangeID {
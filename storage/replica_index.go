@@ -0,0 +1,130 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/gogo/protobuf/proto"
+)
+
+// The replica descriptor index is a store-local copy of the range
+// descriptor of every replica on the store, keyed by Range ID. It is
+// updated in the same engine batch as any change to a replica's
+// descriptor (splits, merges, replica changes, snapshots and replica
+// removal) and allows a store to load its replicas on startup without
+// iterating over all range-local keys.
+
+// putReplicaDescriptorIndex adds or updates the indexed descriptor of
+// the replica of the range described by desc.
+func putReplicaDescriptorIndex(eng engine.Engine, desc *roachpb.RangeDescriptor) error {
+	return engine.MVCCPutProto(eng, nil, keys.StoreReplicaDescriptorKey(desc.RangeID),
+		roachpb.ZeroTimestamp, nil, desc)
+}
+
+// deleteReplicaDescriptorIndex removes the indexed descriptor of the
+// replica of the specified range.
+func deleteReplicaDescriptorIndex(eng engine.Engine, rangeID roachpb.RangeID) error {
+	return engine.MVCCDelete(eng, nil, keys.StoreReplicaDescriptorKey(rangeID),
+		roachpb.ZeroTimestamp, nil)
+}
+
+// loadReplicaDescriptorIndex returns all descriptors contained in the
+// replica descriptor index, ordered by Range ID.
+func loadReplicaDescriptorIndex(eng engine.Engine) ([]roachpb.RangeDescriptor, error) {
+	var descs []roachpb.RangeDescriptor
+	prefix := keys.StoreReplicaDescriptorPrefix()
+	_, err := engine.MVCCIterate(eng, prefix, prefix.PrefixEnd(), roachpb.ZeroTimestamp,
+		true /* consistent */, nil /* txn */, false /* !reverse */, func(kv roachpb.KeyValue) (bool, error) {
+			var desc roachpb.RangeDescriptor
+			if err := kv.Value.GetProto(&desc); err != nil {
+				return false, err
+			}
+			descs = append(descs, desc)
+			return false, nil
+		})
+	return descs, err
+}
+
+// verifyReplicaDescriptorIndex checks the supplied indexed descriptors,
+// returning false if the index has diverged from the range descriptors:
+// every entry must map back to the descriptor it was loaded from, i.e. be
+// keyed by that descriptor's Range ID, the descriptor must equal the range
+// descriptor stored under its range-local key, and no two descriptors may
+// overlap.
+func verifyReplicaDescriptorIndex(eng engine.Engine, descs []roachpb.RangeDescriptor,
+	now roachpb.Timestamp) (bool, error) {
+	for i := range descs {
+		var indexed roachpb.RangeDescriptor
+		ok, err := engine.MVCCGetProto(eng, keys.StoreReplicaDescriptorKey(descs[i].RangeID),
+			roachpb.ZeroTimestamp, true /* consistent */, nil, &indexed)
+		if err != nil {
+			return false, err
+		}
+		if !ok || !proto.Equal(&indexed, &descs[i]) {
+			return false, nil
+		}
+
+		var desc roachpb.RangeDescriptor
+		ok, err = engine.MVCCGetProto(eng, keys.RangeDescriptorKey(descs[i].StartKey), now,
+			false /* !consistent */, nil, &desc)
+		if err != nil {
+			return false, err
+		}
+		if !ok || !proto.Equal(&desc, &descs[i]) {
+			return false, nil
+		}
+	}
+
+	sorted := make([]*roachpb.RangeDescriptor, len(descs))
+	for i := range descs {
+		sorted[i] = &descs[i]
+	}
+	sort.Sort(descsByStartKey(sorted))
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].StartKey.Less(sorted[i-1].EndKey) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type descsByStartKey []*roachpb.RangeDescriptor
+
+func (d descsByStartKey) Len() int           { return len(d) }
+func (d descsByStartKey) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d descsByStartKey) Less(i, j int) bool { return d[i].StartKey.Less(d[j].StartKey) }
+
+// rebuildReplicaDescriptorIndex replaces the contents of the replica
+// descriptor index with the supplied descriptors.
+func rebuildReplicaDescriptorIndex(eng engine.Engine, descs []roachpb.RangeDescriptor) error {
+	batch := eng.NewBatch()
+	defer batch.Close()
+	prefix := keys.StoreReplicaDescriptorPrefix()
+	if _, err := engine.ClearRange(batch, engine.MVCCEncodeKey(prefix),
+		engine.MVCCEncodeKey(prefix.PrefixEnd())); err != nil {
+		return err
+	}
+	for i := range descs {
+		if err := putReplicaDescriptorIndex(batch, &descs[i]); err != nil {
+			return err
+		}
+	}
+	return batch.Commit()
+}
@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// replicaLoadWindow is the duration of the windows over which the load
+// on a replica is measured.
+const replicaLoadWindow = 10 * time.Second
+
+// replicaLoad measures the rate of the requests served by a replica and
+// of the bytes of those requests and their responses. The rates reported
+// are those of the last complete measurement window; until a first window
+// completes, the counts so far are spread over a whole window.
+type replicaLoad struct {
+	mu          sync.Mutex
+	windowStart int64 // Start of the current window, in physical nanoseconds
+	requests    int64 // Requests in the current window
+	bytes       int64 // Bytes in the current window
+	measured    bool  // Whether a window has completed
+	qps         float64
+	bytesPerSec float64
+}
+
+// rollLocked completes the current window if it has lasted long enough.
+// The caller must hold the mutex.
+func (rl *replicaLoad) rollLocked(now int64) {
+	if rl.windowStart == 0 {
+		rl.windowStart = now
+		return
+	}
+	elapsed := now - rl.windowStart
+	if elapsed < int64(replicaLoadWindow) {
+		return
+	}
+	secs := float64(elapsed) / float64(time.Second)
+	rl.qps = float64(rl.requests) / secs
+	rl.bytesPerSec = float64(rl.bytes) / secs
+	rl.measured = true
+	rl.windowStart, rl.requests, rl.bytes = now, 0, 0
+}
+
+// record accounts for a request served at the given physical time.
+func (rl *replicaLoad) record(now, bytes int64) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.rollLocked(now)
+	rl.requests++
+	rl.bytes += bytes
+}
+
+// rates returns the rates of requests per second and of bytes per second
+// served by the replica as of the given physical time.
+func (rl *replicaLoad) rates(now int64) (qps, bytesPerSec float64) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.rollLocked(now)
+	if rl.measured {
+		return rl.qps, rl.bytesPerSec
+	}
+	secs := replicaLoadWindow.Seconds()
+	return float64(rl.requests) / secs, float64(rl.bytes) / secs
+}
+
+// HotReplica describes the load on a replica, as reported by
+// Store.HottestReplicas.
+type HotReplica struct {
+	RangeID  roachpb.RangeID
+	StartKey roachpb.RKey
+	EndKey   roachpb.RKey
+	// QPS is the rate of the requests served by the replica, and
+	// BytesPerSecond that of the bytes of those requests and their
+	// responses.
+	QPS            float64
+	BytesPerSecond float64
+	// Lease is the leader lease of the range known to the replica, and
+	// LeaseHolder is true if the replica holds that lease.
+	Lease       roachpb.Lease
+	LeaseHolder bool
+}
+
+type hotReplicas []HotReplica
+
+func (h hotReplicas) Len() int      { return len(h) }
+func (h hotReplicas) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h hotReplicas) Less(i, j int) bool {
+	if h[i].QPS != h[j].QPS {
+		return h[i].QPS > h[j].QPS
+	}
+	if h[i].BytesPerSecond != h[j].BytesPerSecond {
+		return h[i].BytesPerSecond > h[j].BytesPerSecond
+	}
+	return h[i].RangeID < h[j].RangeID
+}
+
+// HottestReplicas returns the n replicas of the store serving the most
+// requests per second, breaking ties by the rate of bytes served, in
+// decreasing order of load. All the replicas are returned if n is not
+// positive.
+func (s *Store) HottestReplicas(n int) []HotReplica {
+	now := s.Clock().Now()
+	physNow := s.Clock().PhysicalNow()
+	var hot hotReplicas
+	s.replicas.visit(func(rangeID roachpb.RangeID, r *Replica) bool {
+		if !r.isInitialized() {
+			return true
+		}
+		desc := r.Desc()
+		lease := r.getLease()
+		qps, bytesPerSec := r.load.rates(physNow)
+		hot = append(hot, HotReplica{
+			RangeID:        rangeID,
+			StartKey:       desc.StartKey,
+			EndKey:         desc.EndKey,
+			QPS:            qps,
+			BytesPerSecond: bytesPerSec,
+			Lease:          *lease,
+			LeaseHolder:    lease.OwnedBy(s.StoreID()) && r.leaseCovers(lease, now),
+		})
+		return true
+	})
+	sort.Sort(hot)
+	if n > 0 && len(hot) > n {
+		hot = hot[:n]
+	}
+	return hot
+}
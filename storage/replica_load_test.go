@@ -0,0 +1,114 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestReplicaLoadRates verifies the request and byte rates measured over
+// replicaLoadWindow.
+func TestReplicaLoadRates(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var rl replicaLoad
+	start := int64(time.Hour)
+	window := int64(replicaLoadWindow)
+	secs := replicaLoadWindow.Seconds()
+
+	checkRates := func(now int64, expQPS, expBytes float64) {
+		if qps, bytes := rl.rates(now); qps != expQPS || bytes != expBytes {
+			t.Errorf("expected rates (%f, %f), got (%f, %f)", expQPS, expBytes, qps, bytes)
+		}
+	}
+
+	checkRates(start, 0, 0)
+	// Until a first window completes, the counts are spread over a window.
+	for i := 0; i < 20; i++ {
+		rl.record(start+int64(i), 100)
+	}
+	checkRates(start+window/2, 20/secs, 2000/secs)
+	// Once it completes, the rates of the complete window are reported.
+	rl.record(start+window, 1000)
+	checkRates(start+window, 20/secs, 2000/secs)
+	checkRates(start+window+window/2, 20/secs, 2000/secs)
+	// The next window only saw a single request.
+	checkRates(start+2*window, 1/secs, 1000/secs)
+	// Idle windows decay the rates to zero.
+	checkRates(start+3*window, 0, 0)
+}
+
+// TestHotReplicasOrder verifies that replicas are ordered by decreasing
+// request rate, then by decreasing byte rate.
+func TestHotReplicasOrder(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	hot := hotReplicas{
+		{RangeID: 1, QPS: 1, BytesPerSecond: 10},
+		{RangeID: 2, QPS: 5, BytesPerSecond: 1},
+		{RangeID: 3, QPS: 1, BytesPerSecond: 20},
+		{RangeID: 4, QPS: 1, BytesPerSecond: 10},
+	}
+	sort.Sort(hot)
+	var rangeIDs []roachpb.RangeID
+	for _, h := range hot {
+		rangeIDs = append(rangeIDs, h.RangeID)
+	}
+	expected := []roachpb.RangeID{2, 3, 1, 4}
+	for i := range expected {
+		if rangeIDs[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, rangeIDs)
+		}
+	}
+}
+
+// TestStoreHottestReplicas verifies that the requests served by a replica
+// are reported by Store.HottestReplicas.
+func TestStoreHottestReplicas(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	const numRequests = 10
+	for i := 0; i < numRequests; i++ {
+		pArgs := putArgs([]byte("a"), []byte("value"))
+		if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hot := store.HottestReplicas(1)
+	if len(hot) != 1 {
+		t.Fatalf("expected 1 hot replica, got %d", len(hot))
+	}
+	h := hot[0]
+	if h.RangeID != 1 || !h.StartKey.Equal(roachpb.RKeyMin) || !h.EndKey.Equal(roachpb.RKeyMax) {
+		t.Errorf("unexpected hot replica %+v", h)
+	}
+	if minQPS := numRequests / replicaLoadWindow.Seconds(); h.QPS < minQPS {
+		t.Errorf("expected at least %f requests per second, got %f", minQPS, h.QPS)
+	}
+	if h.BytesPerSecond <= 0 {
+		t.Errorf("expected a positive byte rate, got %f", h.BytesPerSecond)
+	}
+	if !h.LeaseHolder || !h.Lease.OwnedBy(store.StoreID()) {
+		t.Errorf("expected the replica to hold the lease, got %+v", h.Lease)
+	}
+}
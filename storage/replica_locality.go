@@ -0,0 +1,223 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// localitySampleRate is the rate at which requests are sampled to
+	// determine the locality the traffic of a range originates from: one in
+	// localitySampleRate requests is sampled.
+	localitySampleRate = 8
+	// localityMinSamples is the number of samples required before the lease
+	// of a range is moved toward the locality generating its traffic.
+	localityMinSamples = 64
+	// localityMinFraction is the fraction of the samples a locality needs to
+	// account for to attract the lease of a range.
+	localityMinFraction = 0.6
+
+	// leaseHandoffDelay is the time for which a lease holder keeps serving
+	// requests after deciding to hand its lease off.
+	leaseHandoffDelay = DefaultLeaderLeaseDuration / 4
+	// leaseHandoffGrace is the time after a lease handoff during which the
+	// previous holder doesn't acquire the lease again, leaving it to the
+	// replicas the traffic is redirected to.
+	leaseHandoffGrace = 2 * DefaultLeaderLeaseDuration
+)
+
+// requestLocality samples the nodes at which the requests of a replica are
+// issued, which is where the clients generating the range's traffic are.
+type requestLocality struct {
+	requests int64 // accessed atomically
+
+	mu      sync.Mutex
+	samples map[roachpb.NodeID]int64
+}
+
+// record samples a request issued at the given node.
+func (rl *requestLocality) record(nodeID roachpb.NodeID) {
+	if nodeID == 0 || atomic.AddInt64(&rl.requests, 1)%localitySampleRate != 0 {
+		return
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.samples == nil {
+		rl.samples = map[roachpb.NodeID]int64{}
+	}
+	rl.samples[nodeID]++
+}
+
+// snapshot returns a copy of the samples, clearing them if requested.
+func (rl *requestLocality) snapshot(reset bool) map[roachpb.NodeID]int64 {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	samples := make(map[roachpb.NodeID]int64, len(rl.samples))
+	for nodeID, count := range rl.samples {
+		samples[nodeID] = count
+	}
+	if reset {
+		rl.samples = nil
+	}
+	return samples
+}
+
+// topLocality returns the locality accounting for the most samples, along
+// with its share of all the samples and their total. Samples from nodes of
+// unknown locality are ignored.
+func topLocality(samples map[roachpb.NodeID]int64,
+	locality func(roachpb.NodeID) string) (top string, fraction float64, total int64) {
+	counts := map[string]int64{}
+	for nodeID, count := range samples {
+		if l := locality(nodeID); l != "" {
+			counts[l] += count
+			total += count
+		}
+	}
+	var topCount int64
+	for l, count := range counts {
+		// Break ties deterministically.
+		if count > topCount || (count == topCount && l < top) {
+			top, topCount = l, count
+		}
+	}
+	if total == 0 {
+		return "", 0, 0
+	}
+	return top, float64(topCount) / float64(total), total
+}
+
+// nodeLocality returns the locality of the given node, as described by its
+// attributes, or the empty string if it is not known.
+func (s *Store) nodeLocality(nodeID roachpb.NodeID) string {
+	if s.nodeDesc != nil && nodeID == s.nodeDesc.NodeID {
+		return s.nodeDesc.Attrs.SortedString()
+	}
+	if s.ctx.Gossip == nil {
+		return ""
+	}
+	var desc roachpb.NodeDescriptor
+	if err := s.ctx.Gossip.GetInfoProto(gossip.MakeNodeIDKey(nodeID), &desc); err != nil {
+		return ""
+	}
+	return desc.Attrs.SortedString()
+}
+
+// holdsActiveLease returns whether this replica holds an active leader
+// lease, which it may hand off.
+func (r *Replica) holdsActiveLease() bool {
+	lease := r.getLease()
+	return lease.OwnedBy(r.store.StoreID()) && r.leaseCovers(lease, r.store.Clock().Now())
+}
+
+// leaseLocalityTarget returns the replica the leader lease held by this
+// replica should move to, being located in the locality which generates most
+// of the range's traffic while this replica isn't, or nil if the lease is
+// well placed. The lease is only moved to replicas satisfying the lease
+// preferences of the zone. The samples are cleared if requested, so that the
+// next decision is based on fresh traffic.
+func (r *Replica) leaseLocalityTarget(zone config.ZoneConfig, reset bool) *roachpb.ReplicaDescriptor {
+	samples := r.locality.snapshot(reset)
+	if !r.holdsActiveLease() {
+		return nil
+	}
+	top, fraction, total := topLocality(samples, r.store.nodeLocality)
+	if total < localityMinSamples || fraction < localityMinFraction {
+		return nil
+	}
+	if r.store.nodeLocality(r.store.Ident.NodeID) == top {
+		return nil
+	}
+	candidates := r.Desc().Replicas
+	if preferred := preferredLeaseholders(zone.LeasePreferences, candidates,
+		r.store.leaseholderAttrs); preferred != nil {
+		candidates = preferred
+	}
+	for i := range candidates {
+		rep := &candidates[i]
+		if rep.StoreID == r.store.StoreID() || rep.Witness {
+			continue
+		}
+		if r.store.nodeLocality(rep.NodeID) == top {
+			return rep
+		}
+	}
+	return nil
+}
+
+// handOffLeaderLease gives up the leader lease held by this replica
+// shortly: the lease is shortened to expire after leaseHandoffDelay, and
+// requests at later timestamps are redirected right away to the target
+// replica so that none of them is served past the shortened expiration. An
+// epoch-based lease, which has no expiration to shorten, is replaced by an
+// expiration-based one expiring at the same time; the liveness epoch of the
+// node is left alone. The lease is then acquired by the target when it
+// receives the redirected requests. This replica refrains from acquiring it
+// again for leaseHandoffGrace.
+func (r *Replica) handOffLeaderLease(target *roachpb.ReplicaDescriptor) error {
+	r.llMu.Lock()
+	defer r.llMu.Unlock()
+
+	if r.pendingLease != nil {
+		return util.Errorf("range %d: leader lease acquisition in progress", r.Desc().RangeID)
+	}
+	lease := r.getLease()
+	now := r.store.Clock().Now()
+	if !lease.OwnedBy(r.store.StoreID()) || !r.leaseCovers(lease, now) {
+		return util.Errorf("range %d: no leader lease to hand off", r.Desc().RangeID)
+	}
+	handoff := now.Add(int64(leaseHandoffDelay), 0)
+	if lease.Epoch == 0 && !handoff.Less(lease.Expiration) {
+		// The lease expires before the handoff anyway.
+		return nil
+	}
+	r.leaseHandoff, r.handoffTo = handoff, target
+	shortened := *lease
+	shortened.Epoch = 0
+	shortened.Expiration = handoff
+	if err := r.proposeLease(shortened, nil, DefaultLeaderLeaseDuration); err != nil {
+		r.leaseHandoff, r.handoffTo = roachpb.ZeroTimestamp, nil
+		return err
+	}
+	r.store.metrics.Counter("leases.handoffs").Inc(1)
+	if log.V(1) {
+		log.Infof("range %d: handed off leader lease expiring at %s to store %d",
+			r.Desc().RangeID, handoff, target.StoreID)
+	}
+	return nil
+}
+
+// leaseHandedOff returns whether this replica has handed its lease off and
+// must not serve requests at the given timestamp, nor acquire the lease
+// again yet. The caller must hold llMu.
+func (r *Replica) leaseHandedOff(timestamp roachpb.Timestamp) bool {
+	if r.leaseHandoff.Equal(roachpb.ZeroTimestamp) {
+		return false
+	}
+	if r.store.Clock().Now().Less(r.leaseHandoff.Add(int64(leaseHandoffGrace), 0)) {
+		return !timestamp.Less(r.leaseHandoff)
+	}
+	r.leaseHandoff, r.handoffTo = roachpb.ZeroTimestamp, nil
+	return false
+}
@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestRequestLocalitySampling verifies that one in localitySampleRate
+// requests is sampled and that requests of unknown origin are ignored.
+func TestRequestLocalitySampling(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var rl requestLocality
+	for i := 0; i < 10*localitySampleRate; i++ {
+		rl.record(1)
+		rl.record(0)
+	}
+	if samples := rl.snapshot(false); samples[1] != 10 || len(samples) != 1 {
+		t.Fatalf("unexpected samples %v", samples)
+	}
+	if samples := rl.snapshot(true); samples[1] != 10 {
+		t.Fatalf("unexpected samples %v", samples)
+	}
+	if samples := rl.snapshot(false); len(samples) != 0 {
+		t.Fatalf("expected samples to be reset, got %v", samples)
+	}
+}
+
+// TestTopLocality verifies that samples are aggregated by locality.
+func TestTopLocality(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	localities := map[roachpb.NodeID]string{1: "us-east", 2: "us-east", 3: "us-west"}
+	locality := func(nodeID roachpb.NodeID) string { return localities[nodeID] }
+
+	testCases := []struct {
+		samples  map[roachpb.NodeID]int64
+		top      string
+		fraction float64
+		total    int64
+	}{
+		{nil, "", 0, 0},
+		{map[roachpb.NodeID]int64{4: 10}, "", 0, 0},
+		{map[roachpb.NodeID]int64{1: 3, 2: 3, 3: 4}, "us-east", 0.6, 10},
+		{map[roachpb.NodeID]int64{1: 1, 3: 3, 4: 100}, "us-west", 0.75, 4},
+		{map[roachpb.NodeID]int64{1: 2, 3: 2}, "us-east", 0.5, 4},
+	}
+	for i, c := range testCases {
+		top, fraction, total := topLocality(c.samples, locality)
+		if top != c.top || fraction != c.fraction || total != c.total {
+			t.Errorf("%d: expected (%q, %f, %d), got (%q, %f, %d)",
+				i, c.top, c.fraction, c.total, top, fraction, total)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/btree"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// replicaMapShards is the number of shards of a replicaMap. Must be a
+// power of two.
+const replicaMapShards = 32
+
+// replicaMapShard is a single shard of a replicaMap.
+type replicaMapShard struct {
+	sync.RWMutex
+	replicas map[roachpb.RangeID]*Replica
+}
+
+// A replicaMap maps range IDs to replicas. The map is sharded by range
+// ID so that lookups, which happen for every request and every raft
+// message, don't contend on a single lock with tens of thousands of
+// replicas on a store.
+type replicaMap struct {
+	shards [replicaMapShards]replicaMapShard
+}
+
+// newReplicaMap returns a new, empty replicaMap.
+func newReplicaMap() *replicaMap {
+	m := &replicaMap{}
+	for i := range m.shards {
+		m.shards[i].replicas = map[roachpb.RangeID]*Replica{}
+	}
+	return m
+}
+
+func (m *replicaMap) shard(rangeID roachpb.RangeID) *replicaMapShard {
+	return &m.shards[uint64(rangeID)&(replicaMapShards-1)]
+}
+
+// get returns the replica for the given range ID, if any.
+func (m *replicaMap) get(rangeID roachpb.RangeID) (*Replica, bool) {
+	s := m.shard(rangeID)
+	s.RLock()
+	defer s.RUnlock()
+	r, ok := s.replicas[rangeID]
+	return r, ok
+}
+
+// put adds the replica for the given range ID, replacing any existing
+// replica.
+func (m *replicaMap) put(rangeID roachpb.RangeID, r *Replica) {
+	s := m.shard(rangeID)
+	s.Lock()
+	defer s.Unlock()
+	s.replicas[rangeID] = r
+}
+
+// del removes the replica for the given range ID.
+func (m *replicaMap) del(rangeID roachpb.RangeID) {
+	s := m.shard(rangeID)
+	s.Lock()
+	defer s.Unlock()
+	delete(s.replicas, rangeID)
+}
+
+// len returns the number of replicas in the map.
+func (m *replicaMap) len() int {
+	var n int
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.RLock()
+		n += len(s.replicas)
+		s.RUnlock()
+	}
+	return n
+}
+
+// visit invokes visitor with each replica in the map, in no particular
+// order, until visitor returns false. Shard locks are not held while
+// visitor is invoked, so the visitor may access the map. The set of
+// replicas visited is only consistent if the caller prevents
+// concurrent modification, e.g. by holding the store lock.
+func (m *replicaMap) visit(visitor func(roachpb.RangeID, *Replica) bool) {
+	var rangeIDs []roachpb.RangeID
+	var repls []*Replica
+	for i := range m.shards {
+		s := &m.shards[i]
+		rangeIDs, repls = rangeIDs[:0], repls[:0]
+		s.RLock()
+		for rangeID, r := range s.replicas {
+			rangeIDs = append(rangeIDs, rangeID)
+			repls = append(repls, r)
+		}
+		s.RUnlock()
+		for j, r := range repls {
+			if !visitor(rangeIDs[j], r) {
+				return
+			}
+		}
+	}
+}
+
+// replicaKeyMapDegree is the degree of the btree of a replicaKeyMap.
+const replicaKeyMapDegree = 64
+
+// A replicaKeyMap indexes the initialized replicas of a store by the end
+// keys of their ranges. The index is a btree which is never modified once
+// published: lookups, which happen on every request, load the current
+// btree atomically and don't lock. Modifications, which are rare in
+// comparison, are made under a lock to a copy of the btree, which is
+// published when the lock is released, so lookups observe either all or
+// none of the modifications made while the map was locked.
+type replicaKeyMap struct {
+	mu      sync.Mutex   // Serializes modifications
+	tree    atomic.Value // The published *btree.BTree
+	pending *btree.BTree // Copy being modified while locked, if any
+}
+
+// newReplicaKeyMap returns a new, empty replicaKeyMap.
+func newReplicaKeyMap() *replicaKeyMap {
+	m := &replicaKeyMap{}
+	m.tree.Store(btree.New(replicaKeyMapDegree))
+	return m
+}
+
+// load returns the published btree, which must not be modified.
+func (m *replicaKeyMap) load() *btree.BTree {
+	return m.tree.Load().(*btree.BTree)
+}
+
+// lookup returns the replica with the smallest end key greater than the
+// given key, if any.
+func (m *replicaKeyMap) lookup(key roachpb.RKey) *Replica {
+	var rng *Replica
+	m.load().AscendGreaterOrEqual(rangeBTreeKey(key.Next()), func(i btree.Item) bool {
+		rng = i.(*Replica)
+		return false
+	})
+	return rng
+}
+
+// len returns the number of replicas in the map.
+func (m *replicaKeyMap) len() int {
+	return m.load().Len()
+}
+
+// ascend invokes visitor with each replica in the map, in the order of
+// their keys, until visitor returns false. Modifications made while
+// visiting are not observed by the visitor.
+func (m *replicaKeyMap) ascend(visitor func(*Replica) bool) {
+	m.load().Ascend(func(i btree.Item) bool {
+		return visitor(i.(*Replica))
+	})
+}
+
+// lock locks the map for modification. Since lookups don't lock, replicas
+// in the map may only change their end keys while the map is locked, and
+// must be removed and reinserted around the change. The published btree
+// still holds such a replica until the map is unlocked, so the new end key
+// must not move it past any other replica in the map.
+func (m *replicaKeyMap) lock() {
+	m.mu.Lock()
+}
+
+// unlock publishes the modifications made while the map was locked and
+// unlocks it.
+func (m *replicaKeyMap) unlock() {
+	if m.pending != nil {
+		m.tree.Store(m.pending)
+		m.pending = nil
+	}
+	m.mu.Unlock()
+}
+
+// lockedTree returns the btree reflecting the modifications made so far
+// while the map is locked. The map must be locked.
+func (m *replicaKeyMap) lockedTree() *btree.BTree {
+	if m.pending != nil {
+		return m.pending
+	}
+	return m.load()
+}
+
+// mutableLocked returns the copy of the btree to modify while the map is
+// locked, making it on the first modification. The map must be locked.
+func (m *replicaKeyMap) mutableLocked() *btree.BTree {
+	if m.pending == nil {
+		m.pending = btree.New(replicaKeyMapDegree)
+		m.load().Ascend(func(i btree.Item) bool {
+			m.pending.ReplaceOrInsert(i)
+			return true
+		})
+	}
+	return m.pending
+}
+
+// hasLocked returns whether the map contains a replica with the same end
+// key as the given one. The map must be locked.
+func (m *replicaKeyMap) hasLocked(r *Replica) bool {
+	return m.lockedTree().Has(r)
+}
+
+// insertLocked adds the replica to the map, returning the replica with the
+// same end key which it replaced, if any. The map must be locked.
+func (m *replicaKeyMap) insertLocked(r *Replica) *Replica {
+	replaced := m.mutableLocked().ReplaceOrInsert(r)
+	if replaced == nil {
+		return nil
+	}
+	return replaced.(*Replica)
+}
+
+// deleteLocked removes the replica from the map, returning false if it
+// wasn't present. The map must be locked.
+func (m *replicaKeyMap) deleteLocked(r *Replica) bool {
+	return m.mutableLocked().Delete(r) != nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestReplicaMap(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	m := newReplicaMap()
+	const count = 100
+	for i := 1; i <= count; i++ {
+		m.put(roachpb.RangeID(i), &Replica{})
+	}
+	if l := m.len(); l != count {
+		t.Fatalf("expected %d replicas; got %d", count, l)
+	}
+	for i := 1; i <= count; i += 2 {
+		m.del(roachpb.RangeID(i))
+	}
+	for i := 1; i <= count; i++ {
+		if _, ok := m.get(roachpb.RangeID(i)); ok != (i%2 == 0) {
+			t.Errorf("%d: expected present=%t", i, i%2 == 0)
+		}
+	}
+
+	seen := map[roachpb.RangeID]struct{}{}
+	m.visit(func(rangeID roachpb.RangeID, _ *Replica) bool {
+		if _, ok := seen[rangeID]; ok {
+			t.Errorf("visited %d twice", rangeID)
+		}
+		seen[rangeID] = struct{}{}
+		// Visitors may access the map.
+		if _, ok := m.get(rangeID); !ok {
+			t.Errorf("%d: visited replica not found", rangeID)
+		}
+		return true
+	})
+	if len(seen) != count/2 {
+		t.Errorf("expected to visit %d replicas; visited %d", count/2, len(seen))
+	}
+
+	var visited int
+	m.visit(func(roachpb.RangeID, *Replica) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Errorf("expected visit to stop after one replica; visited %d", visited)
+	}
+}
+
+func TestReplicaKeyMap(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	m := newReplicaKeyMap()
+	const count = 100
+	repls := make([]*Replica, count)
+	m.lock()
+	for i := range repls {
+		repls[i] = &Replica{}
+		repls[i].setDescWithoutProcessUpdate(&roachpb.RangeDescriptor{
+			RangeID:  roachpb.RangeID(i + 1),
+			StartKey: roachpb.RKey(fmt.Sprintf("%03d", i)),
+			EndKey:   roachpb.RKey(fmt.Sprintf("%03d", i+1)),
+		})
+		if r := m.insertLocked(repls[i]); r != nil {
+			t.Fatalf("%d: unexpectedly replaced %s", i, r)
+		}
+	}
+	for i := 0; i < count; i += 2 {
+		if !m.deleteLocked(repls[i]) {
+			t.Fatalf("%d: replica not found", i)
+		}
+	}
+	if m.hasLocked(repls[0]) || !m.hasLocked(repls[1]) {
+		t.Fatal("unexpected replicas in map")
+	}
+	// Modifications aren't observed by lookups until the map is unlocked.
+	if l := m.len(); l != 0 {
+		t.Fatalf("expected no published replicas while locked; got %d", l)
+	}
+	m.unlock()
+
+	if l := m.len(); l != count/2 {
+		t.Fatalf("expected %d replicas; got %d", count/2, l)
+	}
+	for i := 0; i < count; i++ {
+		for _, key := range []string{fmt.Sprintf("%03d", i), fmt.Sprintf("%03d-a", i), fmt.Sprintf("%03d-b", i)} {
+			r := m.lookup(roachpb.RKey(key))
+			expected := repls[i]
+			if i%2 == 0 {
+				// The replica containing the key was deleted; the next one
+				// is found instead.
+				if i+1 < count {
+					expected = repls[i+1]
+				} else {
+					expected = nil
+				}
+			}
+			if r != expected {
+				t.Errorf("%s: expected %v; got %v", key, expected, r)
+			}
+		}
+	}
+
+	var last roachpb.RKey
+	var visited int
+	m.ascend(func(r *Replica) bool {
+		if !last.Less(r.Desc().EndKey) {
+			t.Errorf("replica %s visited out of order", r)
+		}
+		last = r.Desc().EndKey
+		visited++
+		return true
+	})
+	if visited != count/2 {
+		t.Errorf("expected to visit %d replicas; visited %d", count/2, visited)
+	}
+}
+
+// newBenchmarkStore returns a store populated with the given number of
+// replicas, each covering a distinct key span, for benchmarking
+// replica lookups.
+func newBenchmarkStore(count int) *Store {
+	s := &Store{
+		replicas:       newReplicaMap(),
+		replicasByKey:  newReplicaKeyMap(),
+		uninitReplicas: map[roachpb.RangeID]*Replica{},
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
+	for i := 0; i < count; i++ {
+		r := &Replica{}
+		r.setDescWithoutProcessUpdate(&roachpb.RangeDescriptor{
+			RangeID:  roachpb.RangeID(i + 1),
+			StartKey: roachpb.RKey(fmt.Sprintf("%08d", i)),
+			EndKey:   roachpb.RKey(fmt.Sprintf("%08d", i+1)),
+		})
+		if err := s.addReplicaInternal(r); err != nil {
+			panic(err)
+		}
+	}
+	return s
+}
+
+func BenchmarkStoreGetReplicaParallel(b *testing.B) {
+	const count = 10000
+	s := newBenchmarkStore(count)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			if _, err := s.GetReplica(roachpb.RangeID(rng.Intn(count) + 1)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkStoreLookupReplicaParallel(b *testing.B) {
+	const count = 10000
+	s := newBenchmarkStore(count)
+	lookupKeys := make([]roachpb.RKey, count)
+	for i := range lookupKeys {
+		lookupKeys[i] = roachpb.RKey(fmt.Sprintf("%08d", i))
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			key := lookupKeys[rng.Intn(count)]
+			if s.LookupReplica(key, nil) == nil {
+				b.Fatalf("no replica found for %q", key)
+			}
+		}
+	})
+}
+
+func BenchmarkStoreReplicasByKeyModify(b *testing.B) {
+	const count = 10000
+	s := newBenchmarkStore(count)
+	repls := make([]*Replica, 0, count)
+	s.replicasByKey.ascend(func(r *Replica) bool {
+		repls = append(repls, r)
+		return true
+	})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r := repls[i%count]
+		s.replicasByKey.lock()
+		if !s.replicasByKey.deleteLocked(r) {
+			b.Fatalf("replica %s not found", r)
+		}
+		s.replicasByKey.insertLocked(r)
+		s.replicasByKey.unlock()
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+var _ multiraft.PausableStorage = &Store{}
+
+// ReplicaPauseStatus describes the pause state of a range as seen by one
+// of its replicas.
+type ReplicaPauseStatus struct {
+	RangeID roachpb.RangeID
+	StoreID roachpb.StoreID
+	// PausedUntil is the deadline of a pause of this replica, or zero if
+	// the replica is not paused.
+	PausedUntil time.Time
+	// PausedFollowers lists the followers which have recently reported
+	// being paused. It is only populated on the leader.
+	PausedFollowers []roachpb.ReplicaID
+}
+
+// Paused returns true if the replica itself is paused.
+func (s ReplicaPauseStatus) Paused() bool {
+	return !s.PausedUntil.IsZero()
+}
+
+// pause pauses the replica until the given deadline, replacing any
+// earlier deadline.
+func (r *Replica) pause(until time.Time) {
+	r.pauseMu.Lock()
+	defer r.pauseMu.Unlock()
+	r.pausedUntil = until
+}
+
+// unpause lifts a pause of the replica, returning whether it was paused.
+func (r *Replica) unpause() bool {
+	r.pauseMu.Lock()
+	defer r.pauseMu.Unlock()
+	paused := !r.pausedUntil.IsZero()
+	r.pausedUntil = time.Time{}
+	return paused
+}
+
+// isPaused returns whether the replica is paused at the given time. A
+// pause whose deadline has passed is lifted.
+func (r *Replica) isPaused(now time.Time) bool {
+	r.pauseMu.Lock()
+	defer r.pauseMu.Unlock()
+	if r.pausedUntil.IsZero() {
+		return false
+	}
+	if !now.Before(r.pausedUntil) {
+		log.Infof("range %d: pause expired at %s; resuming", r.Desc().RangeID, r.pausedUntil)
+		r.pausedUntil = time.Time{}
+		return false
+	}
+	return true
+}
+
+// recordPausedFollower records that the given follower reported being
+// paused at time now (in nanoseconds).
+func (r *Replica) recordPausedFollower(replicaID roachpb.ReplicaID, now int64) {
+	r.pauseMu.Lock()
+	defer r.pauseMu.Unlock()
+	if r.pausedPeers == nil {
+		r.pausedPeers = map[roachpb.ReplicaID]int64{}
+	}
+	r.pausedPeers[replicaID] = now
+}
+
+// pauseStatus returns the replica's pause status at the given time.
+// Followers whose last report is older than ttl are considered resumed
+// and forgotten.
+func (r *Replica) pauseStatus(now time.Time, ttl time.Duration) ReplicaPauseStatus {
+	r.pauseMu.Lock()
+	defer r.pauseMu.Unlock()
+	status := ReplicaPauseStatus{
+		RangeID: r.Desc().RangeID,
+		StoreID: r.store.StoreID(),
+	}
+	if now.Before(r.pausedUntil) {
+		status.PausedUntil = r.pausedUntil
+	}
+	for replicaID, reported := range r.pausedPeers {
+		if now.UnixNano()-reported > ttl.Nanoseconds() {
+			delete(r.pausedPeers, replicaID)
+			continue
+		}
+		status.PausedFollowers = append(status.PausedFollowers, replicaID)
+	}
+	sort.Sort(replicaIDSlice(status.PausedFollowers))
+	return status
+}
+
+// replicaIDSlice implements sort.Interface.
+type replicaIDSlice []roachpb.ReplicaID
+
+func (s replicaIDSlice) Len() int           { return len(s) }
+func (s replicaIDSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s replicaIDSlice) Less(i, j int) bool { return s[i] < s[j] }
+
+// PauseReplica pauses the store's replica of the given range for the
+// given duration, e.g. for disk maintenance. A paused replica rejects
+// raft log appends and snapshots, which its leader reports as a paused
+// follower. The pause is lifted automatically once the duration has
+// elapsed, or by UnpauseReplica. Pauses are not persisted.
+func (s *Store) PauseReplica(rangeID roachpb.RangeID, duration time.Duration) error {
+	if duration <= 0 {
+		return util.Errorf("pause duration must be positive: %s", duration)
+	}
+	rng, err := s.GetReplica(rangeID)
+	if err != nil {
+		return err
+	}
+	until := s.Clock().PhysicalTime().Add(duration)
+	rng.pause(until)
+	log.Infof("store %s: paused replica of range %d until %s", s, rangeID, until)
+	return nil
+}
+
+// UnpauseReplica lifts a pause of the store's replica of the given range.
+func (s *Store) UnpauseReplica(rangeID roachpb.RangeID) error {
+	rng, err := s.GetReplica(rangeID)
+	if err != nil {
+		return err
+	}
+	if rng.unpause() {
+		log.Infof("store %s: unpaused replica of range %d", s, rangeID)
+	}
+	return nil
+}
+
+// ReplicaPaused implements the multiraft.PausableStorage interface.
+func (s *Store) ReplicaPaused(groupID roachpb.RangeID) bool {
+	rng, err := s.GetReplica(groupID)
+	if err != nil {
+		return false
+	}
+	return rng.isPaused(s.Clock().PhysicalTime())
+}
+
+// ReplicaPauseStatus returns the pause status of the store's replica of
+// the given range.
+func (s *Store) ReplicaPauseStatus(rangeID roachpb.RangeID) (ReplicaPauseStatus, error) {
+	rng, err := s.GetReplica(rangeID)
+	if err != nil {
+		return ReplicaPauseStatus{}, err
+	}
+	return rng.pauseStatus(s.Clock().PhysicalTime(), s.pausedFollowerTTL()), nil
+}
+
+// pausedFollowerTTL is the duration for which a report of a paused
+// follower is retained. Paused followers re-report whenever they reject
+// an append, which the leader retries at least once per election
+// timeout while the follower is behind.
+func (s *Store) pausedFollowerTTL() time.Duration {
+	return time.Duration(s.ctx.RaftElectionTimeoutTicks) * s.ctx.RaftTickInterval
+}
+
+// handleReplicaPaused processes a multiraft.EventReplicaPaused.
+func (s *Store) handleReplicaPaused(e *multiraft.EventReplicaPaused) {
+	rng, err := s.GetReplica(e.GroupID)
+	if err != nil {
+		return
+	}
+	if log.V(1) {
+		log.Infof("store %s: follower %s of range %d is paused", s, e.Replica, e.GroupID)
+	}
+	rng.recordPausedFollower(e.Replica.ReplicaID, s.Clock().PhysicalNow())
+}
@@ -253,6 +253,13 @@ func setLastIndex(eng engine.Engine, rangeID roachpb.RangeID, lastIndex uint64)
 
 // Snapshot implements the raft.Storage interface.
 func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
+	if r.isWitness() {
+		// A witness holds no user data to send.
+		return raftpb.Snapshot{}, util.Errorf("range %d: witnesses do not generate snapshots", r.Desc().RangeID)
+	}
+	// Wait for our turn among the snapshots generated by the store.
+	defer r.store.snapshotThrottle.acquire()()
+
 	// Copy all the data from a consistent RocksDB snapshot into a RaftSnapshotData.
 	snap := r.store.NewSnapshot()
 	defer snap.Close()
@@ -324,13 +331,30 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 	batch := r.store.Engine().NewBatch()
 	defer batch.Close()
 
+	lastIndex, err := r.append(batch, entries)
+	if err != nil {
+		return err
+	}
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+
+	atomic.StoreUint64(&r.lastIndex, lastIndex)
+	return nil
+}
+
+// append writes the given log entries to the supplied batch, deletes
+// any previously appended entries which never committed and updates
+// the persisted last index. It returns the new last index, which the
+// caller must store in r.lastIndex once the batch has been committed.
+func (r *Replica) append(batch engine.Engine, entries []raftpb.Entry) (uint64, error) {
 	rangeID := r.Desc().RangeID
 
 	for _, ent := range entries {
 		err := engine.MVCCPutProto(batch, nil, keys.RaftLogKey(rangeID, ent.Index),
 			roachpb.ZeroTimestamp, nil, &ent)
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 	lastIndex := entries[len(entries)-1].Index
@@ -340,22 +364,61 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 		err := engine.MVCCDelete(batch, nil,
 			keys.RaftLogKey(rangeID, i), roachpb.ZeroTimestamp, nil)
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
-	// Commit the batch and update the last index.
 	if err := setLastIndex(batch, rangeID, lastIndex); err != nil {
-		return err
+		return 0, err
 	}
-	if err := batch.Commit(); err != nil {
+	return lastIndex, nil
+}
+
+// appendBatch implements multiraft.AppendBatch by staging the raft
+// writes of multiple replicas in a single engine batch.
+type appendBatch struct {
+	batch     engine.Engine
+	lastIndex map[*Replica]uint64
+}
+
+// SetHardState implements the multiraft.AppendBatch interface.
+func (b *appendBatch) SetHardState(group multiraft.WriteableGroupStorage, st raftpb.HardState) error {
+	r := group.(*Replica)
+	return engine.MVCCPutProto(b.batch, nil, keys.RaftHardStateKey(r.Desc().RangeID),
+		roachpb.ZeroTimestamp, nil, &st)
+}
+
+// Append implements the multiraft.AppendBatch interface.
+func (b *appendBatch) Append(group multiraft.WriteableGroupStorage, entries []raftpb.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	r := group.(*Replica)
+	lastIndex, err := r.append(b.batch, entries)
+	if err != nil {
 		return err
 	}
+	b.lastIndex[r] = lastIndex
+	return nil
+}
 
-	atomic.StoreUint64(&r.lastIndex, lastIndex)
+// Commit implements the multiraft.AppendBatch interface. The last
+// indexes of the replicas are only updated once the batch is durable.
+func (b *appendBatch) Commit() error {
+	if err := b.batch.Commit(); err != nil {
+		return err
+	}
+	for r, lastIndex := range b.lastIndex {
+		atomic.StoreUint64(&r.lastIndex, lastIndex)
+	}
 	return nil
 }
 
+// Close implements the multiraft.AppendBatch interface.
+func (b *appendBatch) Close() {
+	b.batch.Close()
+}
+
 // updateRangeInfo is called whenever a range is updated by ApplySnapshot
 // or is created by range splitting to setup the fields which are
 // uninitialized or need updating.
@@ -396,6 +459,10 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 	}
 
 	rangeID := r.Desc().RangeID
+	knobs := &r.store.ctx.TestingKnobs
+	if knobs.BeforeSnapshotApply != nil {
+		knobs.BeforeSnapshotApply(r.store.StoreID(), rangeID)
+	}
 
 	// First, save the HardState.  The HardState must not be changed
 	// because it may record a previous vote cast by this node.
@@ -408,6 +475,15 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 	// Extract the updated range descriptor.
 	desc := snapData.RangeDescriptor
 
+	// CanApplySnapshot rejects snapshots overlapping replicas pending GC,
+	// but a replica may have been removed from its range since.
+	r.store.mu.RLock()
+	overlapErr := r.store.checkPendingGCOverlapLocked(&desc)
+	r.store.mu.RUnlock()
+	if overlapErr != nil {
+		return overlapErr
+	}
+
 	batch := r.store.Engine().NewBatch()
 	defer batch.Close()
 
@@ -466,6 +542,11 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
+	// Update the store's replica descriptor index.
+	if err := putReplicaDescriptorIndex(batch, &desc); err != nil {
+		return err
+	}
+
 	if err := batch.Commit(); err != nil {
 		return err
 	}
@@ -489,6 +570,12 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 	}
 
 	atomic.StorePointer(&r.lease, unsafe.Pointer(lease))
+	if err := r.loadQueueExclusions(r.store.Engine()); err != nil {
+		return err
+	}
+	if knobs.AfterSnapshotApply != nil {
+		knobs.AfterSnapshotApply(r.store.StoreID(), rangeID)
+	}
 	return nil
 }
 
@@ -151,7 +151,7 @@ func (tc *testContext) Start(t testing.TB) {
 		tc.store.splitQueue.SetDisabled(true)
 
 		if tc.rng == nil && tc.bootstrapMode == bootstrapRangeWithMetadata {
-			if err := tc.store.BootstrapRange(nil); err != nil {
+			if err := tc.store.BootstrapRange(nil, nil); err != nil {
 				t.Fatal(err)
 			}
 		}
@@ -344,6 +344,13 @@ func TestRangeReadConsistency(t *testing.T) {
 		t.Errorf("expected error on inconsistent read within a txn")
 	}
 
+	// Try an inconsistent read which may not be served by a follower.
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+		ReadConsistency: roachpb.INCONSISTENT,
+	}, &roachpb.NoopRequest{}); !testutils.IsError(err, "served by followers") {
+		t.Errorf("expected error on inconsistent noop; got %v", err)
+	}
+
 	// Lose the lease and verify CONSISTENT reads receive NotLeaderError
 	// and INCONSISTENT reads work as expected.
 	start := tc.rng.getLease().Expiration.Add(1, 0)
@@ -507,6 +514,133 @@ func TestRangeLeaderLease(t *testing.T) {
 	}
 }
 
+// TestReplicaLeaseCoalescing verifies that concurrent requests needing the
+// leader lease wait for a single lease acquisition and share its result.
+func TestReplicaLeaseCoalescing(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Let the lease expire and propose lease requests through a replica
+	// whose proposals are held until released.
+	tc.manualClock.Set(int64(DefaultLeaderLeaseDuration + 1))
+	rng, err := NewReplica(testRangeDescriptor(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var proposals int32
+	release := make(chan struct{})
+	rng.proposeRaftCommandFn = func(cmdIDKey, roachpb.RaftCommand) <-chan error {
+		atomic.AddInt32(&proposals, 1)
+		errChan := make(chan error, 1)
+		go func() {
+			<-release
+			errChan <- &roachpb.LeaseRejectedError{Message: "rejected"}
+		}()
+		return errChan
+	}
+
+	const numRequests = 5
+	coalesced := tc.store.metrics.Counter("leases.requests.coalesced")
+	errs := make(chan error, numRequests)
+	now := tc.clock.Now()
+	for i := 0; i < numRequests; i++ {
+		go func() {
+			errs <- rng.redirectOnOrAcquireLeaderLease(nil, now)
+		}()
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if c := coalesced.Count(); c != numRequests-1 {
+			return util.Errorf("expected %d coalesced requests; got %d", numRequests-1, c)
+		}
+		return nil
+	})
+	close(release)
+
+	for i := 0; i < numRequests; i++ {
+		if err := <-errs; err == nil {
+			t.Error("expected the rejected lease request to fail all waiters")
+		} else if _, ok := err.(*roachpb.NotLeaderError); !ok {
+			t.Errorf("expected %T, got %s", &roachpb.NotLeaderError{}, err)
+		}
+	}
+	if p := atomic.LoadInt32(&proposals); p != 1 {
+		t.Errorf("expected a single lease request; got %d", p)
+	}
+}
+
+// TestReplicaCircuitBreaker verifies that commands which keep failing to
+// commit in time trip the replica's circuit breaker, which fails further
+// commands fast until a probe commits.
+func TestReplicaCircuitBreaker(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.store.ctx.RangeUnavailableTimeout = 10 * time.Millisecond
+	tc.store.ctx.RangeUnavailableProbeInterval = 50 * time.Millisecond
+
+	// Acquire the lease before holding the proposals of the replica.
+	pArgs := putArgs(roachpb.Key("a"), []byte("value"))
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+	var blocked int32 = 1
+	release := make(chan struct{})
+	tc.rng.proposeRaftCommandFn = func(idKey cmdIDKey, cmd roachpb.RaftCommand) <-chan error {
+		if atomic.LoadInt32(&blocked) == 0 {
+			return tc.store.ProposeRaftCommand(idKey, cmd)
+		}
+		errChan := make(chan error, 1)
+		go func() {
+			<-release
+			errChan <- errors.New("released")
+		}()
+		return errChan
+	}
+
+	// The failed commands keep their keys in the command queue, so each one
+	// writes another key.
+	trips := tc.store.metrics.Counter("range.breaker.trips")
+	for i, key := range []string{"b", "c"} {
+		args := putArgs(roachpb.Key(key), []byte("value"))
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); err == nil {
+			t.Fatal("expected a command which failed to commit to fail")
+		} else if _, ok := err.(*roachpb.AmbiguousResultError); !ok {
+			t.Fatalf("expected %T, got %s", &roachpb.AmbiguousResultError{}, err)
+		}
+		// A single failure doesn't trip the breaker; a failure a timeout
+		// later does.
+		if c := trips.Count(); c != int64(i) {
+			t.Errorf("%d: expected %d breaker trips; got %d", i, i, c)
+		}
+	}
+	dArgs := putArgs(roachpb.Key("d"), []byte("value"))
+	_, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &dArgs)
+	if uErr, ok := err.(*roachpb.RangeUnavailableError); !ok {
+		t.Fatalf("expected %T, got %v", &roachpb.RangeUnavailableError{}, err)
+	} else if uErr.RangeID != tc.rng.Desc().RangeID || uErr.RaftStatus == "" {
+		t.Errorf("unexpected error %+v", uErr)
+	}
+
+	// Once the range is available again, a probe resets the breaker.
+	atomic.StoreInt32(&blocked, 0)
+	close(release)
+	util.SucceedsWithin(t, time.Second, func() error {
+		_, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs)
+		return err
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c := trips.Count(); c != 1 {
+		t.Errorf("expected the breaker to trip once; got %d", c)
+	}
+}
+
 func TestRangeNotLeaderError(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
@@ -746,6 +880,191 @@ func TestRangeLeaderLeaseRejectUnknownRaftNodeID(t *testing.T) {
 	}
 }
 
+// TestRangeEpochLeaderLease verifies that an epoch-based leader lease can
+// only be taken over by a request which names it as the previous lease.
+func TestRangeEpochLeaderLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	requestLease := func(lease, prevLease *roachpb.Lease) error {
+		ba := roachpb.BatchRequest{}
+		ba.CmdID = ba.GetOrCreateCmdID(0)
+		ba.Add(&roachpb.LeaderLeaseRequest{Lease: *lease, PrevLease: prevLease})
+		errChan, pendingCmd := tc.rng.proposeRaftCommand(tc.rng.context(), ba)
+		err := <-errChan
+		if err == nil {
+			err = (<-pendingCmd.done).Err
+		}
+		return err
+	}
+
+	tc.manualClock.Increment(int64(DefaultLeaderLeaseDuration + 1))
+	epochLease := &roachpb.Lease{
+		Start:   tc.clock.Now(),
+		Epoch:   1,
+		Replica: secondReplica,
+	}
+	if err := requestLease(epochLease, nil); err != nil {
+		t.Fatal(err)
+	}
+	epochLease = tc.rng.getLease()
+	if epochLease.Epoch != 1 || !epochLease.OwnedBy(secondReplica.StoreID) {
+		t.Fatalf("unexpected lease %s", epochLease)
+	}
+
+	now := tc.clock.Now()
+	_, firstReplica := tc.rng.Desc().FindReplica(tc.store.StoreID())
+	lease := &roachpb.Lease{
+		Start:      now,
+		Expiration: now.Add(10, 0),
+		Replica:    *firstReplica,
+	}
+	if err := requestLease(lease, nil); !testutils.IsError(err, "previous epoch-based lease changed") {
+		t.Fatalf("unexpected error taking over epoch-based lease: %v", err)
+	}
+	if err := requestLease(lease, epochLease); err != nil {
+		t.Fatal(err)
+	}
+	if l := tc.rng.getLease(); !l.OwnedBy(tc.store.StoreID()) || l.Epoch != 0 {
+		t.Fatalf("unexpected lease %s", l)
+	}
+}
+
+// TestRangeEpochLeaderLeaseHandoff verifies that the holder of an
+// epoch-based leader lease may replace it with a short expiration-based
+// lease, which another replica can take over once it has expired.
+func TestRangeEpochLeaderLeaseHandoff(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	requestLease := func(lease, prevLease *roachpb.Lease) error {
+		ba := roachpb.BatchRequest{}
+		ba.CmdID = ba.GetOrCreateCmdID(0)
+		ba.Add(&roachpb.LeaderLeaseRequest{Lease: *lease, PrevLease: prevLease})
+		errChan, pendingCmd := tc.rng.proposeRaftCommand(tc.rng.context(), ba)
+		err := <-errChan
+		if err == nil {
+			err = (<-pendingCmd.done).Err
+		}
+		return err
+	}
+
+	tc.manualClock.Increment(int64(DefaultLeaderLeaseDuration + 1))
+	_, firstReplica := tc.rng.Desc().FindReplica(tc.store.StoreID())
+	if err := requestLease(&roachpb.Lease{
+		Start:   tc.clock.Now(),
+		Epoch:   1,
+		Replica: *firstReplica,
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hand the lease off, as handOffLeaderLease does.
+	shortened := *tc.rng.getLease()
+	shortened.Epoch = 0
+	shortened.Expiration = tc.clock.Now().Add(int64(leaseHandoffDelay), 0)
+	if err := requestLease(&shortened, nil); err != nil {
+		t.Fatal(err)
+	}
+	if l := tc.rng.getLease(); l.Epoch != 0 || !l.Expiration.Equal(shortened.Expiration) {
+		t.Fatalf("unexpected lease %s", l)
+	}
+
+	now := tc.clock.Now()
+	lease := &roachpb.Lease{
+		Start:      now,
+		Expiration: now.Add(10, 0),
+		Replica:    secondReplica,
+	}
+	if err := requestLease(lease, nil); !testutils.IsError(err, "overlaps previous lease") {
+		t.Fatalf("unexpected error taking over handed off lease: %v", err)
+	}
+	tc.manualClock.Increment(int64(leaseHandoffDelay) + 1)
+	now = tc.clock.Now()
+	lease.Start, lease.Expiration = now, now.Add(10, 0)
+	if err := requestLease(lease, nil); err != nil {
+		t.Fatal(err)
+	}
+	if l := tc.rng.getLease(); !l.OwnedBy(secondReplica.StoreID) {
+		t.Fatalf("unexpected lease %s", l)
+	}
+}
+
+// TestStoreDrainLeases verifies that a draining store hands the leader
+// leases of its replicas off to other replicas.
+func TestStoreDrainLeases(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	tc.manualClock.Increment(int64(DefaultLeaderLeaseDuration + 1))
+	now := tc.clock.Now()
+	_, firstReplica := tc.rng.Desc().FindReplica(tc.store.StoreID())
+	ba := roachpb.BatchRequest{}
+	ba.CmdID = ba.GetOrCreateCmdID(0)
+	ba.Add(&roachpb.LeaderLeaseRequest{Lease: roachpb.Lease{
+		Start:      now,
+		Expiration: now.Add(int64(DefaultLeaderLeaseDuration), 0),
+		Replica:    *firstReplica,
+	}})
+	errChan, pendingCmd := tc.rng.proposeRaftCommand(tc.rng.context(), ba)
+	if err := <-errChan; err != nil {
+		t.Fatal(err)
+	}
+	if err := (<-pendingCmd.done).Err; err != nil {
+		t.Fatal(err)
+	}
+
+	if count := tc.store.DrainLeases(); count != 1 {
+		t.Fatalf("expected 1 lease to be handed off; got %d", count)
+	}
+	if !tc.store.IsDraining() {
+		t.Fatal("expected store to be draining")
+	}
+	handoff := tc.clock.Now().Add(int64(leaseHandoffDelay), 0)
+	if l := tc.rng.getLease(); handoff.Less(l.Expiration) {
+		t.Fatalf("expected lease to expire by %s; got %s", handoff, l)
+	}
+	tc.rng.llMu.Lock()
+	handoffTo := tc.rng.handoffTo
+	tc.rng.llMu.Unlock()
+	if handoffTo == nil || handoffTo.StoreID != secondReplica.StoreID {
+		t.Fatalf("expected lease to be handed off to store %d; got %+v", secondReplica.StoreID, handoffTo)
+	}
+}
+
 // TestRangeGossipFirstRange verifies that the first range gossips its
 // location and the cluster ID.
 func TestRangeGossipFirstRange(t *testing.T) {
@@ -1066,6 +1385,38 @@ func TestAcquireLeaderLease(t *testing.T) {
 	}
 }
 
+// TestReplicaPutVerifiesChecksum verifies that a put whose value doesn't
+// match its checksum is rejected before it is written.
+func TestReplicaPutVerifiesChecksum(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := roachpb.Key("a")
+	pArgs := putArgs(key, []byte("value"))
+	pArgs.Value.InitChecksum(key)
+	pArgs.Value.SetBytes([]byte("corrupt"))
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); !testutils.IsError(err, "invalid checksum") {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+
+	gArgs := getArgs(key)
+	reply, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &gArgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := reply.(*roachpb.GetResponse).Value; v != nil {
+		t.Errorf("expected corrupt value not to be written, got %s", v)
+	}
+
+	pArgs = putArgs(key, []byte("value"))
+	pArgs.Value.InitChecksum(key)
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestRangeUpdateTSCache verifies that reads and writes update the
 // timestamp cache.
 func TestRangeUpdateTSCache(t *testing.T) {
@@ -1688,6 +2039,123 @@ func TestEndTransactionWithMalformedSplitTrigger(t *testing.T) {
 	}
 }
 
+// TestChangeReplicasTriggerStaleDescriptor verifies that a change replicas
+// trigger computed against a descriptor other than the replica's current
+// one is rejected and leaves the descriptor untouched.
+func TestChangeReplicasTriggerStaleDescriptor(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	origDesc := *tc.rng.Desc()
+	newRep := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: origDesc.NextReplicaID,
+	}
+	staleDesc := origDesc
+	staleDesc.NextReplicaID++
+
+	for i, test := range []struct {
+		originalDesc *roachpb.RangeDescriptor
+		expErr       string
+	}{
+		{&staleDesc, "stale descriptor"},
+		{&origDesc, ""},
+	} {
+		batch := tc.engine.NewBatch()
+		err := tc.rng.changeReplicasTrigger(batch, &roachpb.ChangeReplicasTrigger{
+			ChangeType:      roachpb.ADD_REPLICA,
+			Replica:         newRep,
+			UpdatedReplicas: append(append([]roachpb.ReplicaDescriptor(nil), origDesc.Replicas...), newRep),
+			NextReplicaID:   origDesc.NextReplicaID + 1,
+			OriginalDesc:    test.originalDesc,
+		})
+		batch.Close()
+		if test.expErr != "" {
+			if !testutils.IsError(err, test.expErr) {
+				t.Fatalf("%d: expected error %q; got %v", i, test.expErr, err)
+			}
+			if desc := tc.rng.Desc(); !reflect.DeepEqual(*desc, origDesc) {
+				t.Fatalf("%d: descriptor changed to %+v", i, desc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if desc := tc.rng.Desc(); len(desc.Replicas) != 2 || desc.NextReplicaID != origDesc.NextReplicaID+1 {
+			t.Fatalf("%d: change was not applied: %+v", i, desc)
+		}
+	}
+}
+
+// TestValidateReplicaChange verifies that replica changes which don't
+// allocate replica IDs from the descriptor's NextReplicaID, or which alter
+// the IDs of other replicas, are rejected.
+func TestValidateReplicaChange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rep := func(storeID, replicaID int) roachpb.ReplicaDescriptor {
+		return roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(storeID),
+			StoreID:   roachpb.StoreID(storeID),
+			ReplicaID: roachpb.ReplicaID(replicaID),
+		}
+	}
+	desc := &roachpb.RangeDescriptor{
+		RangeID:       1,
+		Replicas:      []roachpb.ReplicaDescriptor{rep(1, 1), rep(2, 3)},
+		NextReplicaID: 4,
+	}
+	change := func(changeType roachpb.ReplicaChangeType, replica roachpb.ReplicaDescriptor,
+		nextReplicaID int, reps ...roachpb.ReplicaDescriptor) roachpb.ChangeReplicasTrigger {
+		return roachpb.ChangeReplicasTrigger{
+			ChangeType:      changeType,
+			Replica:         replica,
+			UpdatedReplicas: reps,
+			NextReplicaID:   roachpb.ReplicaID(nextReplicaID),
+		}
+	}
+
+	testCases := []struct {
+		change roachpb.ChangeReplicasTrigger
+		expErr string
+	}{
+		// Valid additions and removals.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 5, rep(1, 1), rep(2, 3), rep(3, 4)), ""},
+		{change(roachpb.REMOVE_REPLICA, rep(2, 3), 4, rep(1, 1)), ""},
+		// Reuse of the ID of a removed replica.
+		{change(roachpb.ADD_REPLICA, rep(3, 2), 5, rep(1, 1), rep(2, 3), rep(3, 2)), "must have ID 4"},
+		// Skipped replica ID.
+		{change(roachpb.ADD_REPLICA, rep(3, 5), 6, rep(1, 1), rep(2, 3), rep(3, 5)), "must have ID 4"},
+		// NextReplicaID not advanced.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 4, rep(1, 1), rep(2, 3), rep(3, 4)), "outside of the allocated range"},
+		// NextReplicaID moving backwards.
+		{change(roachpb.REMOVE_REPLICA, rep(2, 3), 3, rep(1, 1)), "must not change NextReplicaID"},
+		// Removal of a replica with a mismatched ID.
+		{change(roachpb.REMOVE_REPLICA, rep(2, 2), 4, rep(1, 1)), "is not part of"},
+		// Added replica missing from the updated replicas.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 5, rep(1, 1), rep(2, 3)), "is missing from"},
+		// Duplicate replica IDs.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 5, rep(1, 1), rep(2, 4), rep(3, 4)), "used twice"},
+		// Another replica's ID changes.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 5, rep(1, 2), rep(2, 3), rep(3, 4)), "changes"},
+		// Another replica disappears.
+		{change(roachpb.ADD_REPLICA, rep(3, 4), 5, rep(1, 1), rep(3, 4)), "changes"},
+	}
+	for i, test := range testCases {
+		err := validateReplicaChange(desc, &test.change)
+		if test.expErr == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+		} else if !testutils.IsError(err, test.expErr) {
+			t.Errorf("%d: expected error %q; got %v", i, test.expErr, err)
+		}
+	}
+}
+
 // TestEndTransactionBeforeHeartbeat verifies that a transaction
 // can be committed/aborted before being heartbeat.
 func TestEndTransactionBeforeHeartbeat(t *testing.T) {
@@ -2043,6 +2511,166 @@ func TestEndTransactionResolveOnlyLocalIntents(t *testing.T) {
 	}
 }
 
+// TestSequenceCacheKeptOnFinalization verifies that the sequence cache
+// entries of a transaction outlive its finalization, so that late replays
+// of its batches are rejected, and that they are removed by GC.
+func TestSequenceCacheKeptOnFinalization(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := roachpb.Key("a")
+	splitKey := roachpb.RKey("b")
+	newRng := splitTestRange(tc.store, splitKey, splitKey, t)
+
+	expectSeq := func(rng *Replica, txnID []byte, expSeq uint32) {
+		if seq, err := rng.seqCache.Get(tc.engine, txnID); err != nil {
+			t.Fatal(err)
+		} else if seq != expSeq {
+			t.Errorf("range %d: expected sequence %d; got %d", rng.Desc().RangeID, expSeq, seq)
+		}
+	}
+
+	txn := newTransaction("test", key, 1, roachpb.SERIALIZABLE, tc.clock)
+	txn.Sequence++
+	bt, btH := beginTxnArgs(key, txn)
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), btH, &bt); err != nil {
+		t.Fatal(err)
+	}
+	txn.Sequence++
+	pArgs := putArgs(key, []byte("value"))
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{Txn: txn}, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+	replayTxn := *txn
+	txn.Sequence++
+	pArgs = putArgs(splitKey.AsRawKey(), []byte("value"))
+	if _, err := client.SendWrappedWith(newRng, newRng.context(), roachpb.Header{Txn: txn}, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+	expectSeq(tc.rng, txn.ID, 2)
+	expectSeq(newRng, txn.ID, 3)
+
+	// Committing the transaction records its final sequence number.
+	txn.Sequence++
+	args, h := endTxnArgs(txn, true /* commit */)
+	args.Intents = []roachpb.Intent{{Key: key}}
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), h, &args); err != nil {
+		t.Fatal(err)
+	}
+	expectSeq(tc.rng, txn.ID, 4)
+
+	// A late replay of an earlier batch is rejected.
+	pArgs = putArgs(key, []byte("value"))
+	_, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{Txn: &replayTxn}, &pArgs)
+	if _, ok := err.(*roachpb.TransactionRetryError); !ok {
+		t.Fatalf("expected replay to be rejected with a retry error; got %v", err)
+	}
+
+	// Resolving the other intent once committed keeps the entry.
+	rArgs := &roachpb.ResolveIntentRequest{
+		Span:      roachpb.Span{Key: splitKey.AsRawKey()},
+		IntentTxn: *txn,
+	}
+	rArgs.IntentTxn.Status = roachpb.COMMITTED
+	if _, err := client.SendWrappedWith(newRng, newRng.context(), roachpb.Header{Timestamp: txn.Timestamp}, rArgs); err != nil {
+		t.Fatal(err)
+	}
+	expectSeq(newRng, txn.ID, 3)
+
+	// GC removes the entry, unless it was updated after the GC queue found
+	// it expired.
+	var entry roachpb.SequenceCacheEntry
+	if ok, err := newRng.seqCache.getEntry(tc.engine, txn.ID, &entry); err != nil || !ok {
+		t.Fatalf("expected a sequence cache entry; got %t, %v", ok, err)
+	}
+	desc := newRng.Desc()
+	for i, gcTS := range []roachpb.Timestamp{entry.Timestamp.Prev(), entry.Timestamp} {
+		gcArgs := &roachpb.GCRequest{
+			Span: roachpb.Span{Key: desc.StartKey.AsRawKey(), EndKey: desc.EndKey.AsRawKey()},
+			Keys: []roachpb.GCRequest_GCKey{{Key: keys.SequenceCacheKey(desc.RangeID, txn.ID), Timestamp: gcTS}},
+		}
+		if _, err := client.SendWrappedWith(newRng, newRng.context(), roachpb.Header{Timestamp: tc.clock.Now()}, gcArgs); err != nil {
+			t.Fatal(err)
+		}
+		expectSeq(newRng, txn.ID, []uint32{3, 0}[i])
+	}
+}
+
+// TestEndTransactionOnePhaseCommit verifies that EndTransaction reports
+// one-phase commits, and that a commit requiring one phase is refused if
+// the transaction's intents span multiple ranges.
+func TestEndTransactionOnePhaseCommit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	splitKey := roachpb.RKey("m")
+	splitTestRange(tc.store, splitKey, splitKey, t)
+
+	// sendBatch sends the supplied requests for txn in a single batch,
+	// returning the response of the final request.
+	sendBatch := func(txn *roachpb.Transaction, args ...roachpb.Request) (*roachpb.BatchResponse, roachpb.Response, error) {
+		ba := roachpb.BatchRequest{}
+		ba.Txn = txn
+		ba.Timestamp = txn.Timestamp
+		ba.Add(args...)
+		br, pErr := tc.Sender().Send(tc.rng.context(), ba)
+		if pErr != nil {
+			return nil, nil, pErr.GoError()
+		}
+		return br, br.Responses[len(br.Responses)-1].GetInner(), nil
+	}
+
+	for i, test := range []struct {
+		singleBatch bool
+		requireOne  bool
+		intentKey   roachpb.Key
+		expOnePhase bool
+		expErr      string
+	}{
+		{true, false, roachpb.Key("b"), true, ""},
+		{true, true, roachpb.Key("b"), true, ""},
+		{false, false, roachpb.Key("b"), false, ""},
+		{false, true, roachpb.Key("b"), false, ""},
+		{true, false, roachpb.Key("x"), false, ""},
+		{true, true, roachpb.Key("x"), false, "cannot be committed in one phase"},
+	} {
+		key := roachpb.Key(fmt.Sprintf("a%d", i))
+		txn := newTransaction("test", key, 1, roachpb.SERIALIZABLE, tc.clock)
+		bt, _ := beginTxnArgs(key, txn)
+		pArgs := putArgs(key, []byte("value"))
+		et, _ := endTxnArgs(txn, true /* commit */)
+		et.Intents = []roachpb.Intent{{Key: key}, {Key: test.intentKey}}
+		et.RequireOnePhaseCommit = test.requireOne
+
+		reqs := []roachpb.Request{&bt, &pArgs}
+		if !test.singleBatch {
+			br, _, err := sendBatch(txn, reqs...)
+			if err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			txn = br.Txn
+			reqs = nil
+		}
+		_, reply, err := sendBatch(txn, append(reqs, &et)...)
+		if test.expErr != "" {
+			if !testutils.IsError(err, test.expErr) {
+				t.Errorf("%d: expected error %q; got %v", i, test.expErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if onePhase := reply.(*roachpb.EndTransactionResponse).OnePhaseCommit; onePhase != test.expOnePhase {
+			t.Errorf("%d: expected one-phase commit %t; got %t", i, test.expOnePhase, onePhase)
+		}
+	}
+}
+
 // TestPushTxnBadKey verifies that args.Key equals args.PusheeTxn.ID.
 func TestPushTxnBadKey(t *testing.T) {
 	defer leaktest.AfterTest(t)
@@ -2478,7 +3106,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{Txn: txn}, &pArgs); err != nil {
 		t.Fatal(err)
 	}
-	expMS = engine.MVCCStats{LiveBytes: 136, KeyBytes: 32, ValBytes: 104, IntentBytes: 26, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 1, SysBytes: 63, SysCount: 1}
+	expMS = engine.MVCCStats{LiveBytes: 138, KeyBytes: 32, ValBytes: 106, IntentBytes: 26, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 1, SysBytes: 63, SysCount: 1}
 	verifyRangeStats(tc.engine, tc.rng.Desc().RangeID, expMS, t)
 
 	// Resolve the 2nd value.
@@ -2714,6 +3342,32 @@ func TestReplicaSetsEqual(t *testing.T) {
 	}
 }
 
+// TestReplicaSetDiff verifies that replicaSetDiff returns the replicas
+// on stores missing from the other set.
+func TestReplicaSetDiff(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testData := []struct {
+		a, b, expected []roachpb.StoreID
+	}{
+		{nil, nil, nil},
+		{[]roachpb.StoreID{1, 2}, []roachpb.StoreID{1, 2}, nil},
+		{[]roachpb.StoreID{1, 2}, []roachpb.StoreID{2, 1}, nil},
+		{[]roachpb.StoreID{1, 2, 3}, []roachpb.StoreID{1, 2, 4}, []roachpb.StoreID{3}},
+		{[]roachpb.StoreID{1, 2, 4}, []roachpb.StoreID{1, 2, 3}, []roachpb.StoreID{4}},
+		{[]roachpb.StoreID{1, 2}, nil, []roachpb.StoreID{1, 2}},
+	}
+	for i, test := range testData {
+		diff := replicaSetDiff(createReplicaSets(test.a), createReplicaSets(test.b))
+		var stores []roachpb.StoreID
+		for _, rep := range diff {
+			stores = append(stores, rep.StoreID)
+		}
+		if !reflect.DeepEqual(stores, test.expected) {
+			t.Errorf("%d: expected %v; got %v", i, test.expected, stores)
+		}
+	}
+}
+
 func TestAppliedIndex(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
@@ -2785,13 +3439,56 @@ func TestChangeReplicasDuplicateError(t *testing.T) {
 	if err := tc.rng.ChangeReplicas(roachpb.ADD_REPLICA, roachpb.ReplicaDescriptor{
 		NodeID:  tc.store.Ident.NodeID,
 		StoreID: 9999,
-	}, tc.rng.Desc()); err == nil || !strings.Contains(err.Error(),
+	}, tc.rng.Desc(), REASON_MANUAL); err == nil || !strings.Contains(err.Error(),
 		"already present") {
 		t.Fatalf("must not be able to add second replica to same node (err=%s)",
 			err)
 	}
 }
 
+// TestReplicaQueueExclusion verifies that a range can be excluded from a
+// queue and that the exclusion is persisted, cached by the replica and
+// respected by the queue.
+func TestReplicaQueueExclusion(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := roachpb.Key("a")
+	if err := tc.store.DB().AdminSetQueueExclusion(key, "unknown", true); !testutils.IsError(err, "unknown queue") {
+		t.Fatalf("expected unknown queue error; got %v", err)
+	}
+	if err := tc.store.DB().AdminSetQueueExclusion(key, "gc", true); err != nil {
+		t.Fatal(err)
+	}
+	if !tc.rng.isQueueExcluded("gc") || tc.rng.isQueueExcluded("split") {
+		t.Fatalf("expected range to be excluded from the gc queue only; got %v", tc.rng.excludedQueues)
+	}
+	if queues := tc.rng.QueueExclusions(); !reflect.DeepEqual(queues, []string{"gc"}) {
+		t.Fatalf("expected exclusion from the gc queue; got %v", queues)
+	}
+	if err := tc.store.gcQueue.Add(tc.rng, 1.0); err != errReplicaExcluded {
+		t.Fatalf("expected %s; got %v", errReplicaExcluded, err)
+	}
+
+	// A replica created from the persisted state has the same exclusions.
+	rng, err := NewReplica(tc.rng.Desc(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rng.isQueueExcluded("gc") {
+		t.Fatal("expected reloaded range to be excluded from the gc queue")
+	}
+
+	if err := tc.store.DB().AdminSetQueueExclusion(key, "gc", false); err != nil {
+		t.Fatal(err)
+	}
+	if tc.rng.isQueueExcluded("gc") {
+		t.Fatal("expected exclusion from the gc queue to be lifted")
+	}
+}
+
 // TestRangeDanglingMetaIntent creates a dangling intent on a meta2
 // record and verifies that RangeLookup requests behave
 // appropriately. Normally, the old value and a write intent error
@@ -3133,6 +3830,28 @@ func BenchmarkWriteCmdWithEventsAndConsumer(b *testing.B) {
 	benchmarkEvents(b, true, true)
 }
 
+// BenchmarkWriteCmdParallel benchmarks concurrent write commands to
+// disjoint keys, which propose their commands to raft concurrently.
+func BenchmarkWriteCmdParallel(b *testing.B) {
+	defer leaktest.AfterTest(b)
+	tc := testContext{}
+	tc.Start(b)
+	defer tc.Stop()
+
+	var seq int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			key := roachpb.Key(fmt.Sprintf("key-%d", atomic.AddInt64(&seq, 1)))
+			args := putArgs(key, []byte("value"))
+			if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.StopTimer()
+}
+
 type mockRangeManager struct {
 	*Store
 	mockProposeRaftCommand func(cmdIDKey, roachpb.RaftCommand) <-chan error
@@ -3184,6 +3903,42 @@ func TestRequestLeaderEncounterGroupDeleteError(t *testing.T) {
 	}
 }
 
+// TestReplicaConvertRaftError verifies the conversion of the errors with
+// which multiraft fails proposals.
+func TestReplicaConvertRaftError(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	leader := roachpb.ReplicaDescriptor{NodeID: 1, StoreID: 1, ReplicaID: 1}
+	testCases := []struct {
+		err      error
+		expected error
+	}{
+		{multiraft.ErrGroupDeleted, &roachpb.RangeNotFoundError{}},
+		{multiraft.ErrGroupNotFound, &roachpb.RangeNotFoundError{}},
+		{multiraft.ErrProposalThrottled, &roachpb.ServerOverloadedError{}},
+		{multiraft.ErrProposalDropped, &roachpb.ServerOverloadedError{}},
+		{multiraft.ErrStopped, &roachpb.NodeUnavailableError{}},
+		{&multiraft.ErrNotLeader{}, &roachpb.NotLeaderError{}},
+		{&multiraft.ErrNotLeader{Leader: leader}, &roachpb.NotLeaderError{}},
+		{util.Errorf("other"), util.Errorf("other")},
+	}
+	for i, c := range testCases {
+		err := tc.rng.convertRaftError(c.err)
+		if reflect.TypeOf(err) != reflect.TypeOf(c.expected) {
+			t.Errorf("%d: expected %T; got %T: %s", i, c.expected, err, err)
+		}
+	}
+
+	// The leader is passed on so that clients are redirected to it.
+	err := tc.rng.convertRaftError(&multiraft.ErrNotLeader{Leader: leader})
+	if nlErr := err.(*roachpb.NotLeaderError); nlErr.Leader == nil || nlErr.Leader.StoreID != leader.StoreID {
+		t.Errorf("expected leader %s; got %v", leader, nlErr.Leader)
+	}
+}
+
 func TestIntentIntersect(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	iPt := roachpb.Intent{
@@ -3413,3 +4168,125 @@ func TestReplicaLoadSystemDBSpanIntent(t *testing.T) {
 		return nil
 	})
 }
+
+// TestReplicaLoadSystemDBSpanConfigOnly verifies that only the system config
+// span of the SystemDBSpan is loaded for gossip, leaving out the rows of the
+// other system tables.
+func TestReplicaLoadSystemDBSpanConfigOnly(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	rng := tc.store.LookupReplica(keys.Addr(keys.SystemDBSpan.Key), nil)
+	if rng == nil {
+		t.Fatalf("no replica contains the SystemDB span")
+	}
+	v := roachpb.MakeValueFromString("foo")
+	configKey := roachpb.Key(keys.MakeTablePrefix(keys.ZonesTableID))
+	eventKey := roachpb.Key(keys.MakeTablePrefix(keys.EventLogTableID))
+	for _, key := range []roachpb.Key{configKey, eventKey} {
+		if err := engine.MVCCPut(rng.store.Engine(), &engine.MVCCStats{},
+			key, rng.store.Clock().Now(), v, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kvs, _, err := rng.loadSystemDBSpan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 || !bytes.Equal(kvs[0].Key, configKey) {
+		t.Fatalf("expected only key %q in SystemDB map: %+v", configKey, kvs)
+	}
+}
+
+// TestIsolatedRaftCommand verifies which commands are applied in an
+// engine batch of their own rather than grouped with other commands.
+func TestIsolatedRaftCommand(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		args     roachpb.Request
+		isolated bool
+	}{
+		{&roachpb.PutRequest{}, false},
+		{&roachpb.EndTransactionRequest{}, false},
+		{&roachpb.EndTransactionRequest{InternalCommitTrigger: &roachpb.InternalCommitTrigger{}}, true},
+		{&roachpb.LeaderLeaseRequest{}, true},
+		{&roachpb.TruncateLogRequest{}, true},
+	}
+	for i, test := range testCases {
+		var ba roachpb.BatchRequest
+		ba.Add(&roachpb.GetRequest{})
+		ba.Add(test.args)
+		if isolated := isolatedRaftCommand(ba); isolated != test.isolated {
+			t.Errorf("%d: expected isolated=%t; got %t", i, test.isolated, isolated)
+		}
+	}
+}
+
+// TestReplicaStarvationWatchdog verifies that a replica with a command
+// pending for too long is reported as starved once, until it recovers.
+func TestReplicaStarvationWatchdog(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const threshold = time.Minute
+	now := time.Now()
+	alerts := tc.store.metrics.Counter("replicas.starvation.alerts")
+	if starved := tc.store.checkStarvation(now, threshold); len(starved) != 0 {
+		t.Fatalf("expected no starved replicas; got %v", starved)
+	}
+
+	idKey := cmdIDKey("starved")
+	tc.rng.Lock()
+	tc.rng.pendingCmds[idKey] = &pendingCmd{idKey: idKey, proposed: now.Add(-2 * threshold)}
+	tc.rng.Unlock()
+	expected := []roachpb.RangeID{tc.rng.Desc().RangeID}
+	for i := 0; i < 2; i++ {
+		if starved := tc.store.checkStarvation(now, threshold); !reflect.DeepEqual(starved, expected) {
+			t.Fatalf("%d: expected starved replicas %v; got %v", i, expected, starved)
+		}
+		if c := alerts.Count(); c != 1 {
+			t.Fatalf("%d: expected one starvation alert; got %d", i, c)
+		}
+	}
+
+	tc.rng.Lock()
+	delete(tc.rng.pendingCmds, idKey)
+	tc.rng.Unlock()
+	if starved := tc.store.checkStarvation(now, threshold); len(starved) != 0 {
+		t.Fatalf("expected no starved replicas; got %v", starved)
+	}
+	if g := tc.store.metrics.Gauge("replicas.starved").Value(); g != 0 {
+		t.Errorf("expected no starved replicas to be counted; got %d", g)
+	}
+}
+
+// TestReplicaMaxCommandSize verifies that commands whose encoding exceeds
+// the maximum command size are refused with a CommandTooLargeError without
+// being proposed, while smaller commands succeed.
+func TestReplicaMaxCommandSize(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.store.ctx.MaxCommandSize = 1 << 10
+
+	oversized := tc.store.metrics.Counter("raft.proposals.oversized")
+	pArgs := putArgs(roachpb.Key("a"), make([]byte, 2<<10))
+	_, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs)
+	if tErr, ok := err.(*roachpb.CommandTooLargeError); !ok {
+		t.Fatalf("expected %T, got %v", &roachpb.CommandTooLargeError{}, err)
+	} else if tErr.RangeID != tc.rng.Desc().RangeID || tErr.CommandSize <= tErr.MaxCommandSize || tErr.MaxCommandSize != 1<<10 {
+		t.Errorf("unexpected error %+v", tErr)
+	}
+	if c := oversized.Count(); c != 1 {
+		t.Errorf("expected one oversized proposal; got %d", c)
+	}
+
+	pArgs = putArgs(roachpb.Key("a"), []byte("value"))
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+}
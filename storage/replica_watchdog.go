@@ -0,0 +1,179 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// starvationWatchdog tracks the replicas of a store whose raft processing
+// appears starved: replicas with a command pending for too long, or
+// without a known leader for too long. It also tracks whether the store's
+// raft processing ticks at all, since a store which doesn't tick neither
+// calls elections nor notices the loss of a leader.
+type starvationWatchdog struct {
+	sync.Mutex
+	ticks        int64                         // Raft tick count at the last change
+	ticked       time.Time                     // When the raft tick count last changed
+	ticksStalled bool                          // Whether stalled ticks were reported
+	leaderless   map[roachpb.RangeID]time.Time // Since when replicas have had no leader
+	starved      map[roachpb.RangeID]struct{}  // The replicas reported as starved
+}
+
+// starvationThreshold returns the time after which a replica is reported
+// as starved, or zero if the watchdog is disabled.
+func (s *Store) starvationThreshold() time.Duration {
+	if s.ctx.RaftStarvationElectionTimeouts <= 0 {
+		return 0
+	}
+	electionTimeout := time.Duration(s.ctx.RaftElectionTimeoutTicks) * s.ctx.RaftTickInterval
+	return time.Duration(s.ctx.RaftStarvationElectionTimeouts) * electionTimeout
+}
+
+// startStarvationWatchdog checks the store's replicas for starvation once
+// per election timeout.
+func (s *Store) startStarvationWatchdog() {
+	threshold := s.starvationThreshold()
+	if threshold <= 0 {
+		return
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(threshold / time.Duration(s.ctx.RaftStarvationElectionTimeouts))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.stopper.RunTask(func() {
+					s.checkStarvation(time.Now(), threshold)
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// checkStarvation reports the replicas which have been starved for longer
+// than threshold as of now, and returns their range IDs. A replica is
+// reported once when it becomes starved and once when it recovers; the
+// "replicas.starvation.alerts" counter is incremented for each replica
+// becoming starved, and the "replicas.starved" gauge holds the number of
+// replicas starved.
+func (s *Store) checkStarvation(now time.Time, threshold time.Duration) []roachpb.RangeID {
+	w := &s.watchdog
+	w.Lock()
+	defer w.Unlock()
+
+	if ticks := s.multiraft.LoopStats()[multiraft.StageTick].Count; ticks != w.ticks || w.ticked.IsZero() {
+		w.ticks, w.ticked = ticks, now
+	}
+	if stalled := now.Sub(w.ticked) > threshold; stalled != w.ticksStalled {
+		w.ticksStalled = stalled
+		if stalled {
+			log.Warningf("store %s: raft has not ticked for %s", s, now.Sub(w.ticked))
+			s.metrics.Counter("raft.ticks.stalls").Inc(1)
+		} else {
+			log.Infof("store %s: raft is ticking again", s)
+		}
+	}
+
+	leaderless := map[roachpb.RangeID]time.Time{}
+	starved := map[roachpb.RangeID]struct{}{}
+	var rangeIDs []roachpb.RangeID
+	s.replicas.visit(func(rangeID roachpb.RangeID, r *Replica) bool {
+		var reasons []string
+		if proposed := r.oldestPendingCmd(); !proposed.IsZero() && now.Sub(proposed) > threshold {
+			reasons = append(reasons, fmt.Sprintf("command pending for %s", now.Sub(proposed)))
+		}
+		if status := s.RaftStatus(rangeID); status != nil && status.Lead == 0 {
+			since, ok := w.leaderless[rangeID]
+			if !ok {
+				since = now
+			}
+			leaderless[rangeID] = since
+			if now.Sub(since) > threshold {
+				reasons = append(reasons, fmt.Sprintf("no leader for %s", now.Sub(since)))
+			}
+		}
+		if len(reasons) == 0 {
+			if _, ok := w.starved[rangeID]; ok {
+				log.Infof("range %d: no longer starved", rangeID)
+			}
+			return true
+		}
+		starved[rangeID] = struct{}{}
+		rangeIDs = append(rangeIDs, rangeID)
+		if _, ok := w.starved[rangeID]; !ok {
+			log.Warningf("range %d: starved replica (%s): %s", rangeID, strings.Join(reasons, ", "),
+				r.starvationDiagnostics())
+			s.metrics.Counter("replicas.starvation.alerts").Inc(1)
+		}
+		return true
+	})
+	w.leaderless, w.starved = leaderless, starved
+	s.metrics.Gauge("replicas.starved").Update(int64(len(starved)))
+
+	sort.Sort(roachpb.RangeIDSlice(rangeIDs))
+	return rangeIDs
+}
+
+// oldestPendingCmd returns the time at which the oldest of the replica's
+// pending commands was proposed, or the zero time if none is pending.
+func (r *Replica) oldestPendingCmd() time.Time {
+	r.RLock()
+	defer r.RUnlock()
+	var oldest time.Time
+	for _, cmd := range r.pendingCmds {
+		if oldest.IsZero() || cmd.proposed.Before(oldest) {
+			oldest = cmd.proposed
+		}
+	}
+	return oldest
+}
+
+// starvationDiagnostics describes the state of a starved replica: its raft
+// status, its leader lease and the queues it is in.
+func (r *Replica) starvationDiagnostics() string {
+	rangeID := r.Desc().RangeID
+	r.RLock()
+	pending := len(r.pendingCmds)
+	r.RUnlock()
+
+	raftStatus := "none"
+	if status := r.store.RaftStatus(rangeID); status != nil {
+		raftStatus = status.String()
+	}
+	lease := "none"
+	if l := r.getLease(); l != nil {
+		lease = l.String()
+	}
+	var queues []string
+	for _, q := range r.store.queues() {
+		if q.contains(rangeID) {
+			queues = append(queues, q.name)
+		}
+	}
+	return fmt.Sprintf("pending commands: %d; raft status: %s; lease: %s; queued in: %v",
+		pending, raftStatus, lease, queues)
+}
@@ -0,0 +1,133 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// A witness replica takes part in raft elections and stores the raft
+// log, but holds none of its range's user data. Witnesses receive
+// snapshots stripped of user data and don't evaluate the commands which
+// touch user data, which keeps their range-local metadata (range
+// descriptor, raft state, leases and so on) and the system keys of their
+// range in sync with the other replicas. They never serve reads, hold the
+// leader lease or lead their raft group: a witness never starts an
+// election, so it can't bring the other replicas up to date with a log it
+// can't evaluate or snapshots without user data. This allows, for
+// instance, a range to survive the loss of either of two datacenters
+// holding full replicas by placing a witness in a third location.
+
+// userDataStart is the encoded key at which user data begins. Encoded
+// keys sort like their unencoded counterparts, so all keys at or after
+// it are user data; local, meta and system keys sort before it.
+var userDataStart = engine.MVCCEncodeKey(keys.SystemMax)
+
+// isUserDataKey returns true if the given encoded key addresses user
+// data, as opposed to local, meta or system data.
+func isUserDataKey(key roachpb.EncodedKey) bool {
+	return bytes.Compare(key, userDataStart) >= 0
+}
+
+// witnessEvaluates returns whether a witness evaluates the given batch
+// when applying it. Evaluating a batch which reads or writes user data
+// without that data would make the witness diverge from the full
+// replicas, so witnesses only evaluate batches addressing local, meta and
+// system keys, which includes the transactions changing range
+// descriptors, and the commands acting on the range's raft state and
+// lease. Other batches are skipped.
+func witnessEvaluates(ba roachpb.BatchRequest) bool {
+	for _, union := range ba.Requests {
+		args := union.GetInner()
+		switch args.(type) {
+		case *roachpb.LeaderLeaseRequest, *roachpb.TruncateLogRequest:
+			continue
+		}
+		header := args.Header()
+		if bytes.Compare(header.Key, keys.SystemMax) >= 0 ||
+			bytes.Compare(header.EndKey, keys.SystemMax) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// witnessEngine wraps an engine, discarding all writes to user data.
+type witnessEngine struct {
+	engine.Engine
+}
+
+func newWitnessEngine(eng engine.Engine) engine.Engine {
+	return witnessEngine{Engine: eng}
+}
+
+// Put implements engine.Engine.
+func (w witnessEngine) Put(key roachpb.EncodedKey, value []byte) error {
+	if isUserDataKey(key) {
+		return nil
+	}
+	return w.Engine.Put(key, value)
+}
+
+// Clear implements engine.Engine.
+func (w witnessEngine) Clear(key roachpb.EncodedKey) error {
+	if isUserDataKey(key) {
+		return nil
+	}
+	return w.Engine.Clear(key)
+}
+
+// Merge implements engine.Engine.
+func (w witnessEngine) Merge(key roachpb.EncodedKey, value []byte) error {
+	if isUserDataKey(key) {
+		return nil
+	}
+	return w.Engine.Merge(key, value)
+}
+
+// NewBatch implements engine.Engine, returning a batch which discards
+// writes to user data as well.
+func (w witnessEngine) NewBatch() engine.Engine {
+	return newWitnessEngine(w.Engine.NewBatch())
+}
+
+// WitnessSnapshot implements the multiraft.Storage interface by
+// stripping all user data from the snapshot.
+func (s *Store) WitnessSnapshot(_ roachpb.RangeID, snap raftpb.Snapshot) (raftpb.Snapshot, error) {
+	var parsedSnap roachpb.RaftSnapshotData
+	if err := parsedSnap.Unmarshal(snap.Data); err != nil {
+		return raftpb.Snapshot{}, err
+	}
+	kvs := parsedSnap.KV[:0]
+	for _, kv := range parsedSnap.KV {
+		if !isUserDataKey(kv.Key) {
+			kvs = append(kvs, kv)
+		}
+	}
+	parsedSnap.KV = kvs
+	data, err := proto.Marshal(&parsedSnap)
+	if err != nil {
+		return raftpb.Snapshot{}, err
+	}
+	snap.Data = data
+	return snap, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestWitnessEngine verifies that a witness engine and its batches
+// discard writes to user data only, keeping local, meta and system keys.
+func TestWitnessEngine(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+
+	localKey := keys.RangeDescriptorKey(roachpb.RKey("a"))
+	metaKey := keys.RangeMetaKey(roachpb.RKey("a"))
+	systemKey := keys.RangeIDGenerator
+	userKey := roachpb.Key("a")
+	value := roachpb.MakeValueFromString("value")
+
+	w := newWitnessEngine(eng)
+	batch := w.NewBatch()
+	defer batch.Close()
+	for _, e := range []engine.Engine{w, batch} {
+		for _, key := range []roachpb.Key{localKey, metaKey, systemKey, userKey} {
+			if err := engine.MVCCPut(e, nil, key, roachpb.ZeroTimestamp, value, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		key    roachpb.Key
+		expect bool
+	}{
+		{localKey, true},
+		{metaKey, true},
+		{systemKey, true},
+		{userKey, false},
+	} {
+		v, _, err := engine.MVCCGet(eng, test.key, roachpb.ZeroTimestamp, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (v != nil) != test.expect {
+			t.Errorf("%s: expected present=%t, got %v", test.key, test.expect, v)
+		}
+	}
+}
+
+// TestStoreWitnessSnapshot verifies that snapshots sent to witnesses
+// contain range metadata but no user data.
+func TestStoreWitnessSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs := putArgs(roachpb.Key("a"), []byte("value"))
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := tc.rng.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	witnessSnap, err := tc.store.WitnessSnapshot(tc.rng.Desc().RangeID, snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if witnessSnap.Metadata.Index != snap.Metadata.Index {
+		t.Errorf("expected snapshot metadata to be retained")
+	}
+
+	var snapData roachpb.RaftSnapshotData
+	if err := snapData.Unmarshal(witnessSnap.Data); err != nil {
+		t.Fatal(err)
+	}
+	if snapData.RangeDescriptor.RangeID != tc.rng.Desc().RangeID {
+		t.Errorf("expected range descriptor to be retained; got %+v", snapData.RangeDescriptor)
+	}
+	if len(snapData.KV) == 0 {
+		t.Fatal("expected range metadata in witness snapshot")
+	}
+	for _, kv := range snapData.KV {
+		if isUserDataKey(kv.Key) {
+			t.Errorf("unexpected user data key %q in witness snapshot", kv.Key)
+		}
+	}
+}
+
+// TestReplicaWitnessRejectsReads verifies that a witness refuses reads,
+// including inconsistent ones.
+func TestReplicaWitnessRejectsReads(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	desc := *tc.rng.Desc()
+	desc.Replicas = append([]roachpb.ReplicaDescriptor(nil), desc.Replicas...)
+	desc.Replicas[0].Witness = true
+	tc.rng.setDescWithoutProcessUpdate(&desc)
+
+	gArgs := getArgs(roachpb.Key("a"))
+	for _, rc := range []roachpb.ReadConsistencyType{roachpb.CONSISTENT, roachpb.INCONSISTENT} {
+		_, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+			ReadConsistency: rc,
+		}, &gArgs)
+		if _, ok := err.(*roachpb.NotLeaderError); !ok {
+			t.Errorf("%s: expected NotLeaderError, got %v", rc, err)
+		}
+	}
+}
+
+// TestWitnessEvaluates verifies that witnesses only evaluate batches which
+// don't address user data.
+func TestWitnessEvaluates(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	descKey := keys.RangeDescriptorKey(roachpb.RKey("a"))
+	metaKey := keys.RangeMetaKey(roachpb.RKey("a"))
+	testCases := []struct {
+		args     []roachpb.Request
+		expected bool
+	}{
+		{[]roachpb.Request{&roachpb.LeaderLeaseRequest{Span: roachpb.Span{Key: roachpb.Key("a")}}}, true},
+		{[]roachpb.Request{&roachpb.TruncateLogRequest{Span: roachpb.Span{Key: roachpb.Key("a")}}}, true},
+		{[]roachpb.Request{&roachpb.PutRequest{Span: roachpb.Span{Key: descKey}},
+			&roachpb.PutRequest{Span: roachpb.Span{Key: metaKey}}}, true},
+		{[]roachpb.Request{&roachpb.IncrementRequest{Span: roachpb.Span{Key: keys.RangeIDGenerator}}}, true},
+		{[]roachpb.Request{&roachpb.PutRequest{Span: roachpb.Span{Key: roachpb.Key("a")}}}, false},
+		{[]roachpb.Request{&roachpb.PutRequest{Span: roachpb.Span{Key: descKey}},
+			&roachpb.PutRequest{Span: roachpb.Span{Key: roachpb.Key("a")}}}, false},
+		{[]roachpb.Request{&roachpb.ScanRequest{Span: roachpb.Span{Key: metaKey, EndKey: roachpb.Key("a")}}}, false},
+	}
+	for i, c := range testCases {
+		var ba roachpb.BatchRequest
+		ba.Add(c.args...)
+		if evaluates := witnessEvaluates(ba); evaluates != c.expected {
+			t.Errorf("%d: expected %t, got %t", i, c.expected, evaluates)
+		}
+	}
+}
+
+// TestReplicaWitnessRefusesSnapshots verifies that a witness doesn't
+// generate snapshots.
+func TestReplicaWitnessRefusesSnapshots(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	desc := *tc.rng.Desc()
+	desc.Replicas = append([]roachpb.ReplicaDescriptor(nil), desc.Replicas...)
+	desc.Replicas[0].Witness = true
+	tc.rng.setDescWithoutProcessUpdate(&desc)
+
+	if _, err := tc.rng.Snapshot(); err == nil {
+		t.Fatal("expected witness to refuse generating a snapshot")
+	}
+}
@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/cockroach/config"
@@ -87,8 +88,12 @@ func (rq *replicateQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
 		return true, priority
 	}
 	// See if there is a rebalancing opportunity present.
-	shouldRebalance := rq.allocator.ShouldRebalance(repl.store.StoreID())
-	return shouldRebalance, 0
+	if rq.allocator.ShouldRebalance(repl.store.StoreID()) {
+		return true, 0
+	}
+	// See if the leader lease should move to honor the zone's lease
+	// preferences or toward the range's traffic.
+	return repl.leasePreferenceTarget(*zone) != nil || repl.leaseLocalityTarget(*zone, false) != nil, 0
 }
 
 func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *config.SystemConfig) error {
@@ -119,7 +124,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 			NodeID:  newStore.Node.NodeID,
 			StoreID: newStore.StoreID,
 		}
-		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, newReplica, desc); err != nil {
+		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, newReplica, desc, REASON_REPAIR); err != nil {
 			return err
 		}
 	case AllocatorRemove:
@@ -127,7 +132,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 		if err != nil {
 			return err
 		}
-		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, removeReplica, desc); err != nil {
+		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, removeReplica, desc, REASON_REPAIR); err != nil {
 			return err
 		}
 		// Do not requeue if we removed ourselves.
@@ -141,12 +146,21 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 			}
 			break
 		}
-		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, deadReplicas[0], desc); err != nil {
+		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, deadReplicas[0], desc, REASON_REPAIR); err != nil {
 			return err
 		}
 	case AllocatorNoop:
 		// The Noop case will result if this replica was queued in order to
-		// rebalance. Attempt to find a rebalancing target.
+		// rebalance or to move its leader lease. A lease held by a replica
+		// which doesn't satisfy the zone's lease preferences, or far from the
+		// traffic of the range, is handed off first.
+		if target := repl.leasePreferenceTarget(*zone); target != nil {
+			return repl.handOffLeaderLease(target)
+		}
+		if target := repl.leaseLocalityTarget(*zone, true); target != nil {
+			return repl.handOffLeaderLease(target)
+		}
+		// Attempt to find a rebalancing target.
 		rebalanceStore := rq.allocator.RebalanceTarget(repl.store.StoreID(), zone.ReplicaAttrs[0], desc.Replicas)
 		if rebalanceStore == nil {
 			// No action was necessary and no rebalance target was found. Return
@@ -157,7 +171,7 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 			NodeID:  rebalanceStore.Node.NodeID,
 			StoreID: rebalanceStore.StoreID,
 		}
-		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, rebalanceReplica, desc); err != nil {
+		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, rebalanceReplica, desc, REASON_REBALANCE); err != nil {
 			return err
 		}
 	}
@@ -167,6 +181,54 @@ func (rq *replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *
 	return nil
 }
 
+// describe returns the replication change process would make to the
+// replica's range, if any.
+func (rq *replicateQueue) describe(now roachpb.Timestamp, repl *Replica, sysCfg *config.SystemConfig) (string, error) {
+	desc := repl.Desc()
+	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		return "", err
+	}
+	action, _ := rq.allocator.ComputeAction(*zone, desc)
+
+	deadReplicas := rq.allocator.storePool.deadReplicas(desc.Replicas)
+	if len(desc.Replicas)-len(deadReplicas) < computeQuorum(len(desc.Replicas)) {
+		return "", util.Errorf("range requires a replication change, but lacks a quorum of live nodes.")
+	}
+
+	switch action {
+	case AllocatorAdd:
+		newStore, err := rq.allocator.AllocateTarget(zone.ReplicaAttrs[0], desc.Replicas, true, nil)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("would add a replica on store %d", newStore.StoreID), nil
+	case AllocatorRemove:
+		removeReplica, err := rq.allocator.RemoveTarget(desc.Replicas)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("would remove the replica on store %d", removeReplica.StoreID), nil
+	case AllocatorRemoveDead:
+		if len(deadReplicas) == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("would remove the dead replica on store %d", deadReplicas[0].StoreID), nil
+	case AllocatorNoop:
+		if target := repl.leasePreferenceTarget(*zone); target != nil {
+			return fmt.Sprintf("would hand off the leader lease to store %d to honor the lease preferences",
+				target.StoreID), nil
+		}
+		if target := repl.leaseLocalityTarget(*zone, false); target != nil {
+			return fmt.Sprintf("would hand off the leader lease to store %d", target.StoreID), nil
+		}
+		if rebalanceStore := rq.allocator.RebalanceTarget(repl.store.StoreID(), zone.ReplicaAttrs[0], desc.Replicas); rebalanceStore != nil {
+			return fmt.Sprintf("would rebalance to store %d", rebalanceStore.StoreID), nil
+		}
+	}
+	return "", nil
+}
+
 func (*replicateQueue) timer() time.Duration {
 	return replicateQueueTimerDuration
 }
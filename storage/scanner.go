@@ -39,7 +39,8 @@ type replicaQueue interface {
 	Start(*hlc.Clock, *stop.Stopper)
 	// MaybeAdd adds the replica to the queue if the replica meets
 	// the queue's inclusion criteria and the queue is not already
-	// too full, etc.
+	// too full, etc. Replicas of ranges excluded from the queue (see
+	// AdminSetQueueExclusion) are never added.
 	MaybeAdd(*Replica, roachpb.Timestamp)
 	// MaybeRemove removes the replica from the queue if it is present.
 	MaybeRemove(*Replica)
@@ -75,6 +76,8 @@ type replicaScanner struct {
 	replicas       replicaSet     // Replicas to be scanned
 	queues         []replicaQueue // Replica queues managed by this scanner
 	removed        chan *Replica  // Replicas to remove from queues
+	// pacer slows down or pauses the scan under load. It may be nil.
+	pacer *backgroundPacer
 	// Count of times and total duration through the scanning loop but locked by the completedScan
 	// mutex.
 	completedScan *sync.Cond
@@ -168,7 +171,9 @@ func (rs *replicaScanner) paceInterval(start, now time.Time) time.Duration {
 // is signaled via the removed channel.
 func (rs *replicaScanner) waitAndProcess(start time.Time, clock *hlc.Clock, stopper *stop.Stopper,
 	repl *Replica) bool {
-	waitInterval := rs.paceInterval(start, time.Now())
+	resumed := rs.pacer.resumeCh()
+	now := time.Now()
+	waitInterval := rs.pacer.pace(now, rs.paceInterval(start, now))
 	nextTime := time.After(waitInterval)
 	if log.V(6) {
 		log.Infof("Wait time interval set to %s", waitInterval)
@@ -186,6 +191,11 @@ func (rs *replicaScanner) waitAndProcess(start time.Time, clock *hlc.Clock, stop
 					q.MaybeAdd(repl, clock.Now())
 				}
 			})
+		case <-resumed:
+			// The pause was lifted; recompute the wait interval.
+			resumed = rs.pacer.resumeCh()
+			now := time.Now()
+			nextTime = time.After(rs.pacer.pace(now, rs.paceInterval(start, now)))
 		case repl := <-rs.removed:
 			// Remove replica from all queues as applicable.
 			for _, q := range rs.queues {
@@ -259,6 +259,38 @@ func TestScannerTiming(t *testing.T) {
 	}
 }
 
+// TestScannerPauseResume verifies that the scanner doesn't add replicas to
+// its queues while background work is paused, and that lifting the pause
+// wakes it up without waiting for the pause to elapse.
+func TestScannerPauseResume(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const count = 3
+	ranges := newTestRangeSet(count, t)
+	q := &testQueue{}
+	q.setDisabled(true)
+	s := newReplicaScanner(1*time.Millisecond, 0, ranges)
+	s.pacer = newBackgroundPacer(0, 0)
+	s.pacer.pause(time.Now(), time.Hour)
+	s.AddQueues(q)
+	mc := hlc.NewManualClock(0)
+	clock := hlc.NewClock(mc.UnixNano)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	s.Start(clock, stopper)
+
+	time.Sleep(10 * time.Millisecond)
+	if c := q.count(); c != 0 {
+		t.Fatalf("expected no replicas to be queued while paused; got %d", c)
+	}
+
+	s.pacer.pause(time.Now(), 0)
+	if err := util.IsTrueWithin(func() bool {
+		return q.count() == count
+	}, 250*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestScannerPaceInterval tests that paceInterval returns the correct interval.
 func TestScannerPaceInterval(t *testing.T) {
 	defer leaktest.AfterTest(t)
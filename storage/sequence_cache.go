@@ -0,0 +1,165 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/gogo/protobuf/proto"
+)
+
+// A SequenceCache provides replay protection for transactional writes.
+// Each transactional batch carries a sequence number which the
+// coordinator increments for every batch it sends. After a batch has
+// been applied, the SequenceCache records its sequence number, keyed by
+// transaction ID; a later batch for the same transaction whose sequence
+// number does not exceed the recorded one is a replay and is rejected.
+//
+// Unlike the ResponseCache, which protects against retries of a specific
+// client command, the SequenceCache protects against the re-application
+// of a batch which was reissued under a different command ID.
+//
+// A SequenceCache is not thread safe. Access to it is serialized
+// through Raft.
+type SequenceCache struct {
+	rangeID roachpb.RangeID
+}
+
+// NewSequenceCache returns a new sequence cache. Every range replica
+// maintains a sequence cache, not just the leader.
+func NewSequenceCache(rangeID roachpb.RangeID) *SequenceCache {
+	return &SequenceCache{
+		rangeID: rangeID,
+	}
+}
+
+// ClearData removes all items stored in the persistent cache.
+func (sc *SequenceCache) ClearData(e engine.Engine) error {
+	p := keys.SequenceCacheKey(sc.rangeID, nil) // prefix for all sequence cache entries with this range ID
+	end := p.PrefixEnd()
+	_, err := engine.ClearRange(e, engine.MVCCEncodeKey(p), engine.MVCCEncodeKey(end))
+	return err
+}
+
+// Get returns the highest sequence number recorded for the given
+// transaction, or zero if none has been recorded.
+func (sc *SequenceCache) Get(e engine.Engine, txnID []byte) (uint32, error) {
+	var entry roachpb.SequenceCacheEntry
+	if _, err := sc.getEntry(e, txnID, &entry); err != nil {
+		return 0, err
+	}
+	return entry.Sequence, nil
+}
+
+// getEntry reads the entry of the given transaction into the supplied
+// entry, returning whether one was found.
+func (sc *SequenceCache) getEntry(e engine.Engine, txnID []byte, entry *roachpb.SequenceCacheEntry) (bool, error) {
+	if len(txnID) == 0 {
+		return false, nil
+	}
+	return engine.MVCCGetProto(e, keys.SequenceCacheKey(sc.rangeID, txnID), roachpb.ZeroTimestamp, true, nil, entry)
+}
+
+// Put records the given sequence number for the transaction, along with
+// the timestamp of the batch which carried it. The entry is kept after the
+// transaction has been finalized, so that late replays of its batches are
+// still rejected, until the GC queue removes it (see Del).
+func (sc *SequenceCache) Put(e engine.Engine, txnID []byte, seq uint32, timestamp roachpb.Timestamp) error {
+	if len(txnID) == 0 || seq == 0 {
+		return nil
+	}
+	entry := roachpb.SequenceCacheEntry{Sequence: seq, Timestamp: timestamp}
+	return engine.MVCCPutProto(e, nil, keys.SequenceCacheKey(sc.rangeID, txnID), roachpb.ZeroTimestamp, nil, &entry)
+}
+
+// Del removes the entry of the given transaction unless it was recorded
+// after the given timestamp. It is invoked by the GC command for entries
+// which the GC queue found to be older than GCResponseCacheExpiration, the
+// horizon beyond which replays are no longer guarded against.
+func (sc *SequenceCache) Del(e engine.Engine, txnID []byte, before roachpb.Timestamp) error {
+	var entry roachpb.SequenceCacheEntry
+	if ok, err := sc.getEntry(e, txnID, &entry); err != nil || !ok {
+		return err
+	}
+	if before.Less(entry.Timestamp) {
+		return nil
+	}
+	return engine.MVCCDelete(e, nil, keys.SequenceCacheKey(sc.rangeID, txnID), roachpb.ZeroTimestamp, nil)
+}
+
+// decodeSequenceCacheKey returns the transaction ID of the given sequence
+// cache key of this range, or false if the key is not one.
+func (sc *SequenceCache) decodeSequenceCacheKey(key roachpb.Key) ([]byte, bool) {
+	prefix := keys.SequenceCacheKey(sc.rangeID, nil)
+	if !bytes.HasPrefix(key, prefix) || len(key) == len(prefix) {
+		return nil, false
+	}
+	return key[len(prefix):], true
+}
+
+// CopyInto copies all the entries from this sequence cache into the
+// destRangeID sequence cache. Existing entries in the destination are only
+// overwritten by higher sequence numbers.
+func (sc *SequenceCache) CopyInto(e engine.Engine, destRangeID roachpb.RangeID) error {
+	return NewSequenceCache(destRangeID).copyFrom(e, sc.rangeID)
+}
+
+// CopyFrom copies all the entries from the originRangeID sequence cache
+// into this one. Existing entries are only overwritten by higher sequence
+// numbers.
+func (sc *SequenceCache) CopyFrom(e engine.Engine, originRangeID roachpb.RangeID) error {
+	return sc.copyFrom(e, originRangeID)
+}
+
+func (sc *SequenceCache) copyFrom(e engine.Engine, originRangeID roachpb.RangeID) error {
+	prefix := keys.SequenceCacheKey(originRangeID, nil) // sequence cache prefix
+	start := engine.MVCCEncodeKey(prefix)
+	end := engine.MVCCEncodeKey(prefix.PrefixEnd())
+
+	return e.Iterate(start, end, func(kv roachpb.RawKeyValue) (bool, error) {
+		key, _, isValue, err := engine.MVCCDecodeKey(kv.Key)
+		if err != nil {
+			return false, util.Errorf("could not decode a sequence cache key %s: %s",
+				roachpb.Key(kv.Key), err)
+		}
+		if isValue || !bytes.HasPrefix(key, prefix) {
+			return false, util.Errorf("invalid sequence cache key %s", key)
+		}
+		// The remainder of the key is the transaction ID.
+		txnID := key[len(prefix):]
+		meta := &engine.MVCCMetadata{}
+		if err := proto.Unmarshal(kv.Value, meta); err != nil {
+			return false, util.Errorf("could not decode sequence cache value %s [% x]: %s",
+				roachpb.Key(kv.Key), kv.Value, err)
+		}
+		var entry roachpb.SequenceCacheEntry
+		if err := meta.Value.GetProto(&entry); err != nil {
+			return false, err
+		}
+		curSeq, err := sc.Get(e, txnID)
+		if err != nil {
+			return false, err
+		}
+		if entry.Sequence <= curSeq {
+			return false, nil
+		}
+		return false, sc.Put(e, txnID, entry.Sequence, entry.Timestamp)
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestSequenceCachePutGetClearData tests basic get, put & delete
+// functionality as well as clearing the cache.
+func TestSequenceCachePutGetClearData(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	sc := NewSequenceCache(1)
+	txnID := []byte("txn-1")
+	ts := roachpb.Timestamp{WallTime: 10}
+
+	if seq, err := sc.Get(e, txnID); err != nil || seq != 0 {
+		t.Fatalf("expected no sequence; got %d, %v", seq, err)
+	}
+	if err := sc.Put(e, txnID, 3, ts); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := sc.Get(e, txnID); err != nil || seq != 3 {
+		t.Fatalf("expected sequence 3; got %d, %v", seq, err)
+	}
+	// An entry recorded after the deletion timestamp is kept.
+	if err := sc.Del(e, txnID, ts.Prev()); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := sc.Get(e, txnID); err != nil || seq != 3 {
+		t.Fatalf("expected sequence 3 after deletion of older entries; got %d, %v", seq, err)
+	}
+	if err := sc.Del(e, txnID, ts); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := sc.Get(e, txnID); err != nil || seq != 0 {
+		t.Fatalf("expected no sequence after deletion; got %d, %v", seq, err)
+	}
+	if err := sc.Put(e, txnID, 4, ts); err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.ClearData(e); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := sc.Get(e, txnID); err != nil || seq != 0 {
+		t.Fatalf("expected no sequence after clear; got %d, %v", seq, err)
+	}
+}
+
+// TestSequenceCacheCopy tests that entries are copied between caches and
+// that existing entries are only replaced by higher sequence numbers.
+func TestSequenceCacheCopy(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	e := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	sc1, sc2 := NewSequenceCache(1), NewSequenceCache(2)
+	txnA, txnB := []byte("txn-a"), []byte("txn-b")
+
+	for _, put := range []struct {
+		sc    *SequenceCache
+		txnID []byte
+		seq   uint32
+	}{
+		{sc1, txnA, 5},
+		{sc1, txnB, 2},
+		{sc2, txnB, 7},
+	} {
+		if err := put.sc.Put(e, put.txnID, put.seq, roachpb.ZeroTimestamp.Add(1, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := sc1.CopyInto(e, 2); err != nil {
+		t.Fatal(err)
+	}
+	for txnID, expSeq := range map[string]uint32{"txn-a": 5, "txn-b": 7} {
+		if seq, err := sc2.Get(e, []byte(txnID)); err != nil || seq != expSeq {
+			t.Errorf("%s: expected sequence %d; got %d, %v", txnID, expSeq, seq, err)
+		}
+	}
+
+	sc3 := NewSequenceCache(3)
+	if err := sc3.CopyFrom(e, 2); err != nil {
+		t.Fatal(err)
+	}
+	for txnID, expSeq := range map[string]uint32{"txn-a": 5, "txn-b": 7} {
+		if seq, err := sc3.Get(e, []byte(txnID)); err != nil || seq != expSeq {
+			t.Errorf("%s: expected sequence %d; got %d, %v", txnID, expSeq, seq, err)
+		}
+	}
+}
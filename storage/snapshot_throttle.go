@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// defaultMaxConcurrentSnapshots is the default number of snapshots a store
+// generates at the same time.
+const defaultMaxConcurrentSnapshots = 2
+
+// A snapshotThrottle limits the number of snapshots a store generates
+// concurrently. Generating a snapshot copies all of the data of a range, so
+// the generations beyond the limit, whether triggered by raft or by the
+// replicate queue adding a replica, wait in line for a running one to
+// finish.
+type snapshotThrottle struct {
+	sem     chan struct{}
+	queued  int64 // accessed atomically
+	metrics *metric.Registry
+}
+
+func newSnapshotThrottle(limit int, metrics *metric.Registry) *snapshotThrottle {
+	return &snapshotThrottle{
+		sem:     make(chan struct{}, limit),
+		metrics: metrics,
+	}
+}
+
+// acquire waits until a snapshot may be generated and returns the function
+// to call once the generation has finished. The number of generations
+// waiting in line, the time spent waiting and the duration of the
+// generations are recorded in the store's metrics.
+func (t *snapshotThrottle) acquire() func() {
+	start := time.Now()
+	select {
+	case t.sem <- struct{}{}:
+	default:
+		t.metrics.Gauge("snapshots.queued").Update(atomic.AddInt64(&t.queued, 1))
+		t.sem <- struct{}{}
+		t.metrics.Gauge("snapshots.queued").Update(atomic.AddInt64(&t.queued, -1))
+	}
+	generationStart := time.Now()
+	t.metrics.Histogram("snapshots.latency.queue").RecordValue(generationStart.Sub(start).Nanoseconds())
+	return func() {
+		t.metrics.Histogram("snapshots.latency.generation").RecordValue(time.Since(generationStart).Nanoseconds())
+		<-t.sem
+	}
+}
+
+// queueDepth returns the number of snapshot generations waiting in line.
+func (t *snapshotThrottle) queueDepth() int64 {
+	return atomic.LoadInt64(&t.queued)
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+)
+
+// TestSnapshotThrottle verifies that snapshot generations beyond the limit
+// wait in line until a running generation finishes.
+func TestSnapshotThrottle(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	registry := metric.NewRegistry()
+	throttle := newSnapshotThrottle(2, registry)
+
+	release1 := throttle.acquire()
+	release2 := throttle.acquire()
+
+	acquired := make(chan func())
+	go func() {
+		acquired <- throttle.acquire()
+	}()
+	util.SucceedsWithin(t, time.Second, func() error {
+		if depth := throttle.queueDepth(); depth != 1 {
+			return util.Errorf("expected 1 queued snapshot, got %d", depth)
+		}
+		return nil
+	})
+	select {
+	case <-acquired:
+		t.Fatal("snapshot generation exceeded the limit")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	release1()
+	var release3 func()
+	select {
+	case release3 = <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("queued snapshot generation was not started")
+	}
+	if depth := throttle.queueDepth(); depth != 0 {
+		t.Errorf("expected no queued snapshots, got %d", depth)
+	}
+	release2()
+	release3()
+}
@@ -0,0 +1,256 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	// defaultRecoverySnapshotRate and defaultRebalanceSnapshotRate are the
+	// default rates, in bytes per second, at which a store sends recovery
+	// and rebalance snapshots, respectively.
+	defaultRecoverySnapshotRate  = 8 << 20
+	defaultRebalanceSnapshotRate = 2 << 20
+
+	// snapshotQueueSize is the number of snapshots of each category which
+	// may wait to be sent before further snapshots are refused.
+	snapshotQueueSize = 16
+
+	// rebalanceSnapshotTTL is the duration after a replica is added to
+	// rebalance a range during which the snapshots sent to it are
+	// considered rebalance snapshots.
+	rebalanceSnapshotTTL = 10 * time.Minute
+
+	// snapshotPriorityPollInterval is the interval at which a rebalance
+	// snapshot ready to be sent checks whether the recovery snapshots it
+	// defers to have been sent.
+	snapshotPriorityPollInterval = 10 * time.Millisecond
+)
+
+// A snapshotCategory is the reason a snapshot is sent, which determines
+// its rate limit and priority.
+type snapshotCategory int
+
+const (
+	// snapshotRecovery snapshots catch up lagging replicas and populate
+	// the replicas added to restore the replication of a range. They take
+	// precedence over rebalance snapshots.
+	snapshotRecovery snapshotCategory = iota
+	// snapshotRebalance snapshots populate the replicas added to even out
+	// the load on the stores.
+	snapshotRebalance
+	numSnapshotCategories
+)
+
+var snapshotCategoryNames = [numSnapshotCategories]string{"recovery", "rebalance"}
+
+// A tokenBucket limits the rate of a flow of bytes. Tokens accumulate at
+// rate per second up to burst; a request larger than the available tokens
+// goes into debt and the following requests wait until it is repaid.
+type tokenBucket struct {
+	rate   float64 // tokens per second; unlimited if not positive
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int64) tokenBucket {
+	return tokenBucket{rate: float64(rate), burst: float64(rate), tokens: float64(rate)}
+}
+
+// reserve takes n tokens from the bucket, returning how long the caller
+// must wait before using them.
+func (b *tokenBucket) reserve(now time.Time, n int64) time.Duration {
+	if b.rate <= 0 {
+		return 0
+	}
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.tokens -= float64(n)
+	return wait
+}
+
+// A snapshotTransport wraps the raft transport of a store to rate limit
+// the snapshots it sends. Snapshots are queued by category and sent by a
+// worker per category, each drawing from its own token bucket. Rebalance
+// snapshots additionally wait for queued recovery snapshots to be sent.
+// All other messages are passed through unchanged.
+type snapshotTransport struct {
+	multiraft.Transport
+	classify func(*multiraft.RaftMessageRequest) snapshotCategory
+	metrics  *metric.Registry
+	queues   [numSnapshotCategories]chan *multiraft.RaftMessageRequest
+	buckets  [numSnapshotCategories]tokenBucket
+	// recoveryPending is the number of recovery snapshots queued or
+	// waiting for tokens. Accessed atomically.
+	recoveryPending int64
+}
+
+func newSnapshotTransport(transport multiraft.Transport, recoveryRate, rebalanceRate int64,
+	classify func(*multiraft.RaftMessageRequest) snapshotCategory,
+	metrics *metric.Registry) *snapshotTransport {
+	t := &snapshotTransport{
+		Transport: transport,
+		classify:  classify,
+		metrics:   metrics,
+	}
+	for i := range t.queues {
+		t.queues[i] = make(chan *multiraft.RaftMessageRequest, snapshotQueueSize)
+	}
+	t.buckets[snapshotRecovery] = newTokenBucket(recoveryRate)
+	t.buckets[snapshotRebalance] = newTokenBucket(rebalanceRate)
+	return t
+}
+
+// start starts the workers sending the queued snapshots.
+func (t *snapshotTransport) start(stopper *stop.Stopper) {
+	for i := range t.queues {
+		category := snapshotCategory(i)
+		stopper.RunWorker(func() {
+			t.sendLoop(category, stopper)
+		})
+	}
+}
+
+// Send implements the multiraft.Transport interface, queueing snapshots to
+// be sent by the worker of their category.
+func (t *snapshotTransport) Send(req *multiraft.RaftMessageRequest) error {
+	if req.Message.Type != raftpb.MsgSnap {
+		return t.Transport.Send(req)
+	}
+	category := t.classify(req)
+	if category == snapshotRecovery {
+		atomic.AddInt64(&t.recoveryPending, 1)
+	}
+	select {
+	case t.queues[category] <- req:
+		return nil
+	default:
+		if category == snapshotRecovery {
+			atomic.AddInt64(&t.recoveryPending, -1)
+		}
+		return util.Errorf("too many %s snapshots queued to be sent",
+			snapshotCategoryNames[category])
+	}
+}
+
+// sendLoop sends the snapshots of the given category at the rate allowed by
+// the category's token bucket.
+func (t *snapshotTransport) sendLoop(category snapshotCategory, stopper *stop.Stopper) {
+	bucket := &t.buckets[category]
+	name := snapshotCategoryNames[category]
+	for {
+		var req *multiraft.RaftMessageRequest
+		select {
+		case req = <-t.queues[category]:
+		case <-stopper.ShouldStop():
+			return
+		}
+		size := int64(len(req.Message.Snapshot.Data))
+		if wait := bucket.reserve(time.Now(), size); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+		if category == snapshotRebalance {
+			for atomic.LoadInt64(&t.recoveryPending) > 0 {
+				select {
+				case <-time.After(snapshotPriorityPollInterval):
+				case <-stopper.ShouldStop():
+					return
+				}
+			}
+		}
+		if err := t.Transport.Send(req); err != nil {
+			log.Warningf("failed to send %s snapshot of range %d to %s: %s",
+				name, req.GroupID, req.ToReplica, err)
+		} else {
+			t.metrics.Counter("snapshots." + name + ".sent").Inc(1)
+			t.metrics.Counter("snapshots." + name + ".sent-bytes").Inc(size)
+		}
+		if category == snapshotRecovery {
+			atomic.AddInt64(&t.recoveryPending, -1)
+		}
+	}
+}
+
+// markRebalanceTarget records that the given replica was added to rebalance
+// the range, so that the snapshots sent to it are rebalance snapshots.
+func (r *Replica) markRebalanceTarget(replicaID roachpb.ReplicaID, now time.Time) {
+	r.rebalanceMu.Lock()
+	defer r.rebalanceMu.Unlock()
+	if r.rebalanceTargets == nil {
+		r.rebalanceTargets = map[roachpb.ReplicaID]time.Time{}
+	}
+	r.rebalanceTargets[replicaID] = now
+}
+
+// unmarkRebalanceTarget undoes markRebalanceTarget.
+func (r *Replica) unmarkRebalanceTarget(replicaID roachpb.ReplicaID) {
+	r.rebalanceMu.Lock()
+	defer r.rebalanceMu.Unlock()
+	delete(r.rebalanceTargets, replicaID)
+}
+
+// isRebalanceTarget returns whether the given replica was recently added to
+// rebalance the range. Expired marks are dropped.
+func (r *Replica) isRebalanceTarget(replicaID roachpb.ReplicaID, now time.Time) bool {
+	r.rebalanceMu.Lock()
+	defer r.rebalanceMu.Unlock()
+	for id, marked := range r.rebalanceTargets {
+		if now.Sub(marked) > rebalanceSnapshotTTL {
+			delete(r.rebalanceTargets, id)
+		}
+	}
+	_, ok := r.rebalanceTargets[replicaID]
+	return ok
+}
+
+// classifySnapshot returns the category of the snapshot sent by the given
+// request: snapshots sent to replicas recently added for rebalancing are
+// rebalance snapshots, all others are recovery snapshots.
+func (s *Store) classifySnapshot(req *multiraft.RaftMessageRequest) snapshotCategory {
+	rng, err := s.GetReplica(req.GroupID)
+	if err != nil {
+		return snapshotRecovery
+	}
+	if rng.isRebalanceTarget(req.ToReplica.ReplicaID, s.Clock().PhysicalTime()) {
+		return snapshotRebalance
+	}
+	return snapshotRecovery
+}
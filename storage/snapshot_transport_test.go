@@ -0,0 +1,147 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/metric"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestTokenBucket verifies that tokens accumulate at the configured rate up
+// to the burst, and that requests wait for the debt of earlier ones.
+func TestTokenBucket(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	now := time.Unix(0, 0)
+	b := newTokenBucket(100)
+
+	testCases := []struct {
+		elapsed time.Duration
+		n       int64
+		wait    time.Duration
+	}{
+		{0, 50, 0},
+		{0, 100, 0}, // goes into debt
+		{0, 10, 500 * time.Millisecond},
+		{time.Second, 10, 0}, // the debt of 60 was repaid
+		{time.Hour, 150, 0},  // the tokens are capped at the burst
+		{0, 1, 500 * time.Millisecond},
+	}
+	for i, test := range testCases {
+		now = now.Add(test.elapsed)
+		if wait := b.reserve(now, test.n); wait != test.wait {
+			t.Errorf("%d: expected to wait %s, got %s", i, test.wait, wait)
+		}
+	}
+
+	unlimited := newTokenBucket(-1)
+	for i := 0; i < 3; i++ {
+		if wait := unlimited.reserve(now, 1<<30); wait != 0 {
+			t.Errorf("expected no wait for an unlimited bucket, got %s", wait)
+		}
+	}
+}
+
+// recordingTransport is a multiraft.Transport which passes the messages
+// sent through it on a channel.
+type recordingTransport struct {
+	sent chan *multiraft.RaftMessageRequest
+}
+
+func (rt *recordingTransport) Listen(roachpb.StoreID, multiraft.ServerInterface) error { return nil }
+func (rt *recordingTransport) Stop(roachpb.StoreID)                                    {}
+func (rt *recordingTransport) Close()                                                  {}
+func (rt *recordingTransport) Send(req *multiraft.RaftMessageRequest) error {
+	rt.sent <- req
+	return nil
+}
+
+// TestSnapshotTransportPriority verifies that snapshots are rate limited by
+// category and that rebalance snapshots wait for recovery snapshots.
+func TestSnapshotTransportPriority(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	inner := &recordingTransport{sent: make(chan *multiraft.RaftMessageRequest, 10)}
+	registry := metric.NewRegistry()
+	// Range 2 is being rebalanced; all other snapshots are recovery snapshots.
+	classify := func(req *multiraft.RaftMessageRequest) snapshotCategory {
+		if req.GroupID == 2 {
+			return snapshotRebalance
+		}
+		return snapshotRecovery
+	}
+	transport := newSnapshotTransport(inner, 10000, -1, classify, registry)
+	transport.start(stopper)
+
+	snapshot := func(rangeID roachpb.RangeID, size int) *multiraft.RaftMessageRequest {
+		return &multiraft.RaftMessageRequest{
+			GroupID: rangeID,
+			Message: raftpb.Message{
+				Type:     raftpb.MsgSnap,
+				Snapshot: raftpb.Snapshot{Data: make([]byte, size)},
+			},
+		}
+	}
+	// Messages other than snapshots are passed through right away.
+	heartbeat := &multiraft.RaftMessageRequest{Message: raftpb.Message{Type: raftpb.MsgHeartbeat}}
+	if err := transport.Send(heartbeat); err != nil {
+		t.Fatal(err)
+	}
+	// The first recovery snapshot puts the recovery bucket into a debt which
+	// takes 50ms to repay. The second one waits for it, and the rebalance
+	// snapshot, although not rate limited, waits for the second one.
+	requests := []*multiraft.RaftMessageRequest{
+		snapshot(1, 10500),
+		snapshot(3, 1),
+		snapshot(2, 100),
+	}
+	for _, req := range requests {
+		if err := transport.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := append([]*multiraft.RaftMessageRequest{heartbeat}, requests...)
+	for i, exp := range expected {
+		select {
+		case req := <-inner.sent:
+			if req != exp {
+				t.Fatalf("%d: expected message %+v, got %+v", i, exp.Message, req.Message)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%d: message was not sent", i)
+		}
+	}
+
+	util.SucceedsWithin(t, time.Second, func() error {
+		if c := registry.Counter("snapshots.recovery.sent-bytes").Count(); c != 10501 {
+			return util.Errorf("expected 10501 recovery snapshot bytes sent, got %d", c)
+		}
+		if c := registry.Counter("snapshots.rebalance.sent").Count(); c != 1 {
+			return util.Errorf("expected 1 rebalance snapshot sent, got %d", c)
+		}
+		return nil
+	})
+}
@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -101,6 +102,7 @@ func (sq *splitQueue) process(now roachpb.Timestamp, rng *Replica,
 				return util.Errorf("unable to split %s at key %q: %s", rng, splitKey, err)
 			}
 		}
+		sq.maybeShed(now, rng, append([]roachpb.RKey{desc.StartKey}, splitKeys...))
 		return nil
 	}
 
@@ -117,10 +119,45 @@ func (sq *splitQueue) process(now roachpb.Timestamp, rng *Replica,
 		}); err != nil {
 			return err
 		}
+		sq.maybeShed(now, rng, []roachpb.RKey{desc.StartKey, rng.Desc().EndKey})
 	}
 	return nil
 }
 
+// describe returns the split process would perform on the range, if any.
+func (sq *splitQueue) describe(now roachpb.Timestamp, rng *Replica,
+	sysCfg *config.SystemConfig) (string, error) {
+
+	desc := rng.Desc()
+	if splitKeys := sysCfg.ComputeSplitKeys(desc.StartKey, desc.EndKey); len(splitKeys) > 0 {
+		return fmt.Sprintf("would split at keys %v", splitKeys), nil
+	}
+	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		return "", err
+	}
+	if size := rng.stats.GetSize(); size > zone.RangeMaxBytes {
+		return fmt.Sprintf("would split by size (size=%d max=%d)", size, zone.RangeMaxBytes), nil
+	}
+	return "", nil
+}
+
+// maybeShed hands the ranges created by a split to the replicate queue if
+// the store holds at least as many replicas as it is configured to accept,
+// so that they are placed on other stores. The ranges are identified by
+// their start keys.
+func (sq *splitQueue) maybeShed(now roachpb.Timestamp, rng *Replica, startKeys []roachpb.RKey) {
+	s := rng.store
+	if s.ctx.MaxReplicas == 0 || s.ReplicaCount() < s.ctx.MaxReplicas {
+		return
+	}
+	for _, key := range startKeys {
+		if repl := s.LookupReplica(key, nil); repl != nil {
+			s.replicateQueue.MaybeAdd(repl, now)
+		}
+	}
+}
+
 // timer returns interval between processing successive queued splits.
 func (*splitQueue) timer() time.Duration {
 	return splitQueueTimerDuration
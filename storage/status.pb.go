@@ -10,6 +10,7 @@
 
 	It has these top-level messages:
 		StoreStatus
+		RangeLogEvent
 */
 package storage
 
@@ -17,6 +18,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import cockroach_roachpb "github.com/cockroachdb/cockroach/roachpb"
+import cockroach_roachpb1 "github.com/cockroachdb/cockroach/roachpb"
 import cockroach_storage_engine "github.com/cockroachdb/cockroach/storage/engine"
 
 // discarding unused import gogoproto "github.com/cockroachdb/gogoproto"
@@ -30,6 +32,100 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// RangeLogEventType specifies the kind of a range lifecycle event.
+type RangeLogEventType int32
+
+const (
+	// RANGE_SPLIT is the split of a range into two ranges.
+	RANGE_SPLIT RangeLogEventType = 0
+	// RANGE_MERGE is the merge of a range into its left neighbor.
+	RANGE_MERGE RangeLogEventType = 1
+	// RANGE_ADD_REPLICA is the addition of a replica to a range.
+	RANGE_ADD_REPLICA RangeLogEventType = 2
+	// RANGE_REMOVE_REPLICA is the removal of a replica from a range.
+	RANGE_REMOVE_REPLICA RangeLogEventType = 3
+)
+
+var RangeLogEventType_name = map[int32]string{
+	0: "RANGE_SPLIT",
+	1: "RANGE_MERGE",
+	2: "RANGE_ADD_REPLICA",
+	3: "RANGE_REMOVE_REPLICA",
+}
+var RangeLogEventType_value = map[string]int32{
+	"RANGE_SPLIT":          0,
+	"RANGE_MERGE":          1,
+	"RANGE_ADD_REPLICA":    2,
+	"RANGE_REMOVE_REPLICA": 3,
+}
+
+func (x RangeLogEventType) Enum() *RangeLogEventType {
+	p := new(RangeLogEventType)
+	*p = x
+	return p
+}
+func (x RangeLogEventType) String() string {
+	return proto.EnumName(RangeLogEventType_name, int32(x))
+}
+func (x *RangeLogEventType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(RangeLogEventType_value, data, "RangeLogEventType")
+	if err != nil {
+		return err
+	}
+	*x = RangeLogEventType(value)
+	return nil
+}
+
+// ReplicaChangeReason specifies why the replicas of a range were changed.
+type ReplicaChangeReason int32
+
+const (
+	// REASON_UNKNOWN is a change made for an unspecified reason.
+	REASON_UNKNOWN ReplicaChangeReason = 0
+	// REASON_REBALANCE is a change made to even out the load on the stores.
+	REASON_REBALANCE ReplicaChangeReason = 1
+	// REASON_REPAIR is a change made to restore the replication configured
+	// for the range, for example after a store died.
+	REASON_REPAIR ReplicaChangeReason = 2
+	// REASON_MANUAL is a change requested by an operator.
+	REASON_MANUAL ReplicaChangeReason = 3
+	// REASON_MERGE is a change made to collocate a range with the range it
+	// is to be merged into.
+	REASON_MERGE ReplicaChangeReason = 4
+)
+
+var ReplicaChangeReason_name = map[int32]string{
+	0: "REASON_UNKNOWN",
+	1: "REASON_REBALANCE",
+	2: "REASON_REPAIR",
+	3: "REASON_MANUAL",
+	4: "REASON_MERGE",
+}
+var ReplicaChangeReason_value = map[string]int32{
+	"REASON_UNKNOWN":   0,
+	"REASON_REBALANCE": 1,
+	"REASON_REPAIR":    2,
+	"REASON_MANUAL":    3,
+	"REASON_MERGE":     4,
+}
+
+func (x ReplicaChangeReason) Enum() *ReplicaChangeReason {
+	p := new(ReplicaChangeReason)
+	*p = x
+	return p
+}
+func (x ReplicaChangeReason) String() string {
+	return proto.EnumName(ReplicaChangeReason_name, int32(x))
+}
+func (x *ReplicaChangeReason) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ReplicaChangeReason_value, data, "ReplicaChangeReason")
+	if err != nil {
+		return err
+	}
+	*x = ReplicaChangeReason(value)
+	return nil
+}
+
 // StoreStatus contains the stats needed to calculate the current status of a
 // store.
 type StoreStatus struct {
@@ -42,12 +138,49 @@ type StoreStatus struct {
 	LeaderRangeCount     int32                                           `protobuf:"varint,7,opt,name=leader_range_count" json:"leader_range_count"`
 	ReplicatedRangeCount int32                                           `protobuf:"varint,8,opt,name=replicated_range_count" json:"replicated_range_count"`
 	AvailableRangeCount  int32                                           `protobuf:"varint,9,opt,name=available_range_count" json:"available_range_count"`
+	// oldest_verified_at is the wall time of the least recent checksum
+	// verification of any of the store's replicas.
+	OldestVerifiedAt int64 `protobuf:"varint,10,opt,name=oldest_verified_at" json:"oldest_verified_at"`
+	// failed_verification_count is the number of replicas whose most recent
+	// checksum verification failed.
+	FailedVerificationCount int32 `protobuf:"varint,11,opt,name=failed_verification_count" json:"failed_verification_count"`
 }
 
 func (m *StoreStatus) Reset()         { *m = StoreStatus{} }
 func (m *StoreStatus) String() string { return proto.CompactTextString(m) }
 func (*StoreStatus) ProtoMessage()    {}
 
+// RangeLogEvent is a structured record of a range lifecycle event, persisted
+// in the range event log.
+type RangeLogEvent struct {
+	// The time at which the event was initiated.
+	Timestamp cockroach_roachpb1.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp"`
+	// The range the event applies to. For a merge, this is the range
+	// which subsumed its right neighbor.
+	RangeID github_com_cockroachdb_cockroach_roachpb.RangeID `protobuf:"varint,2,opt,name=range_id,casttype=github.com/cockroachdb/cockroach/roachpb.RangeID" json:"range_id"`
+	// The store which initiated the event.
+	StoreID   github_com_cockroachdb_cockroach_roachpb.StoreID `protobuf:"varint,3,opt,name=store_id,casttype=github.com/cockroachdb/cockroach/roachpb.StoreID" json:"store_id"`
+	EventType RangeLogEventType                                `protobuf:"varint,4,opt,name=event_type,enum=cockroach.storage.RangeLogEventType" json:"event_type"`
+	// For a split, the range created by the split. For a merge, the range
+	// which was subsumed.
+	OtherRangeID github_com_cockroachdb_cockroach_roachpb.RangeID `protobuf:"varint,5,opt,name=other_range_id,casttype=github.com/cockroachdb/cockroach/roachpb.RangeID" json:"other_range_id"`
+	// The descriptor of the range after the event.
+	UpdatedDesc cockroach_roachpb.RangeDescriptor `protobuf:"bytes,6,opt,name=updated_desc" json:"updated_desc"`
+	// For replica changes, the replica which was added or removed.
+	Replica cockroach_roachpb.ReplicaDescriptor `protobuf:"bytes,7,opt,name=replica" json:"replica"`
+	// For replica changes, the reason the change was made.
+	Reason ReplicaChangeReason `protobuf:"varint,8,opt,name=reason,enum=cockroach.storage.ReplicaChangeReason" json:"reason"`
+}
+
+func (m *RangeLogEvent) Reset()         { *m = RangeLogEvent{} }
+func (m *RangeLogEvent) String() string { return proto.CompactTextString(m) }
+func (*RangeLogEvent) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterEnum("cockroach.storage.RangeLogEventType", RangeLogEventType_name, RangeLogEventType_value)
+	proto.RegisterEnum("cockroach.storage.ReplicaChangeReason", ReplicaChangeReason_name, ReplicaChangeReason_value)
+}
+
 func (m *StoreStatus) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -100,6 +233,69 @@ func (m *StoreStatus) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x48
 	i++
 	i = encodeVarintStatus(data, i, uint64(m.AvailableRangeCount))
+	data[i] = 0x50
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.OldestVerifiedAt))
+	data[i] = 0x58
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.FailedVerificationCount))
+	return i, nil
+}
+
+func (m *RangeLogEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RangeLogEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Timestamp.Size()))
+	n3, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	data[i] = 0x10
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RangeID))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.StoreID))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.EventType))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.OtherRangeID))
+	data[i] = 0x32
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.UpdatedDesc.Size()))
+	n4, err := m.UpdatedDesc.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	data[i] = 0x3a
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Replica.Size()))
+	n5, err := m.Replica.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	data[i] = 0x40
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Reason))
 	return i, nil
 }
 
@@ -144,6 +340,25 @@ func (m *StoreStatus) Size() (n int) {
 	n += 1 + sovStatus(uint64(m.LeaderRangeCount))
 	n += 1 + sovStatus(uint64(m.ReplicatedRangeCount))
 	n += 1 + sovStatus(uint64(m.AvailableRangeCount))
+	n += 1 + sovStatus(uint64(m.OldestVerifiedAt))
+	n += 1 + sovStatus(uint64(m.FailedVerificationCount))
+	return n
+}
+
+func (m *RangeLogEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Timestamp.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	n += 1 + sovStatus(uint64(m.RangeID))
+	n += 1 + sovStatus(uint64(m.StoreID))
+	n += 1 + sovStatus(uint64(m.EventType))
+	n += 1 + sovStatus(uint64(m.OtherRangeID))
+	l = m.UpdatedDesc.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	l = m.Replica.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	n += 1 + sovStatus(uint64(m.Reason))
 	return n
 }
 
@@ -382,6 +597,279 @@ func (m *StoreStatus) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestVerifiedAt", wireType)
+			}
+			m.OldestVerifiedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OldestVerifiedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedVerificationCount", wireType)
+			}
+			m.FailedVerificationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.FailedVerificationCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeLogEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeLogEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeLogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeID", wireType)
+			}
+			m.RangeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RangeID |= (github_com_cockroachdb_cockroach_roachpb.RangeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+			}
+			m.StoreID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StoreID |= (github_com_cockroachdb_cockroach_roachpb.StoreID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.EventType |= (RangeLogEventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherRangeID", wireType)
+			}
+			m.OtherRangeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OtherRangeID |= (github_com_cockroachdb_cockroach_roachpb.RangeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDesc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdatedDesc.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Replica.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Reason |= (ReplicaChangeReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(data[iNdEx:])
@@ -20,6 +20,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metric"
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/tracer"
@@ -54,6 +56,24 @@ const (
 	defaultRaftTickInterval         = 100 * time.Millisecond
 	defaultHeartbeatIntervalTicks   = 3
 	defaultRaftElectionTimeoutTicks = 15
+	// defaultRaftMaxUncommittedBytes is the default bound on the size of
+	// the commands proposed to a range but not committed yet.
+	defaultRaftMaxUncommittedBytes = 32 << 20 // 32 MB
+	// defaultMaxCommandSize is the default bound on the size of an encoded
+	// raft command.
+	defaultMaxCommandSize = 64 << 20 // 64 MB
+	// defaultRangeUnavailableTimeout is the default time after which a
+	// command which failed to commit trips its replica's circuit breaker.
+	defaultRangeUnavailableTimeout = 1 * time.Minute
+	// defaultRangeUnavailableProbeInterval is the default interval at which
+	// a tripped circuit breaker lets a command through to probe the range.
+	defaultRangeUnavailableProbeInterval = 5 * time.Second
+	// defaultUninitializedReplicaGCThreshold is the default age after which
+	// an uninitialized replica is considered for removal.
+	defaultUninitializedReplicaGCThreshold = 10 * time.Minute
+	// defaultRaftStarvationElectionTimeouts is the default number of
+	// election timeouts after which a replica is reported as starved.
+	defaultRaftStarvationElectionTimeouts = 10
 	// ttlStoreGossip is time-to-live for store-related info.
 	ttlStoreGossip = 2 * time.Minute
 )
@@ -194,23 +214,17 @@ func (rs *storeRangeSet) Visit(visitor func(*Replica) bool) {
 	// Copy the  range IDs to a slice and iterate over the slice so
 	// that we can safely (e.g., no race, no range skip) iterate
 	// over ranges regardless of how BTree is implemented.
-	rs.store.mu.RLock()
-	rs.rangeIDs = make([]roachpb.RangeID, rs.store.replicasByKey.Len())
-	i := 0
-	rs.store.replicasByKey.Ascend(func(item btree.Item) bool {
-		rs.rangeIDs[i] = item.(*Replica).Desc().RangeID
-		i++
+	rangeIDs := make([]roachpb.RangeID, 0, rs.store.replicasByKey.len())
+	rs.store.replicasByKey.ascend(func(rng *Replica) bool {
+		rangeIDs = append(rangeIDs, rng.Desc().RangeID)
 		return true
 	})
-	rs.store.mu.RUnlock()
+	rs.rangeIDs = rangeIDs
 
 	rs.visited = 0
 	for _, rangeID := range rs.rangeIDs {
 		rs.visited++
-		rs.store.mu.RLock()
-		rng, ok := rs.store.replicas[rangeID]
-		rs.store.mu.RUnlock()
-		if ok {
+		if rng, ok := rs.store.replicas.get(rangeID); ok {
 			if !visitor(rng) {
 				break
 			}
@@ -220,10 +234,8 @@ func (rs *storeRangeSet) Visit(visitor func(*Replica) bool) {
 }
 
 func (rs *storeRangeSet) EstimatedCount() int {
-	rs.store.mu.RLock()
-	defer rs.store.mu.RUnlock()
 	if rs.visited <= 0 {
-		return rs.store.replicasByKey.Len()
+		return rs.store.replicasByKey.len()
 	}
 	return len(rs.rangeIDs) - rs.visited
 }
@@ -234,37 +246,61 @@ type Store struct {
 	Ident             roachpb.StoreIdent
 	ctx               StoreContext
 	db                *client.DB
-	engine            engine.Engine   // The underlying key-value store
-	allocator         Allocator       // Makes allocation decisions
-	rangeIDAlloc      *idAllocator    // Range ID allocator
-	gcQueue           *gcQueue        // Garbage collection queue
-	splitQueue        *splitQueue     // Range splitting queue
-	verifyQueue       *verifyQueue    // Checksum verification queue
-	replicateQueue    *replicateQueue // Replication queue
-	replicaGCQueue    *replicaGCQueue // Replica GC queue
-	raftLogQueue      *raftLogQueue   // Raft Log Truncation queue
-	scanner           *replicaScanner // Replica scanner
-	feed              StoreEventFeed  // Event Feed
-	removeReplicaChan chan removeReplicaOp
-	proposeChan       chan proposeOp
+	engine            engine.Engine    // The underlying key-value store
+	allocator         Allocator        // Makes allocation decisions
+	rangeIDAlloc      *idAllocator     // Range ID allocator
+	gcQueue           *gcQueue         // Garbage collection queue
+	splitQueue        *splitQueue      // Range splitting queue
+	verifyQueue       *verifyQueue     // Checksum verification queue
+	replicateQueue    *replicateQueue  // Replication queue
+	replicaGCQueue    *replicaGCQueue  // Replica GC queue
+	raftLogQueue      *raftLogQueue    // Raft Log Truncation queue
+	scanner           *replicaScanner  // Replica scanner
+	pacer             *backgroundPacer // Paces the scanner and replica queues
+	intentResolver    *intentResolver  // Asynchronous intent resolution
+	feed              StoreEventFeed   // Event Feed
+	metrics           *metric.Registry
+	applyLatency      *metric.Histogram  // The "raft.latency.apply" histogram of metrics
+	snapshotThrottle  *snapshotThrottle  // Limits concurrent snapshot generations
+	peerBlocklist     *peerBlocklist     // Drops the raft messages of misbehaving stores
+	snapshotTransport *snapshotTransport // Rate limits the snapshots sent
 	multiraft         *multiraft.MultiRaft
 	started           int32
+	draining          int32 // Non-zero while the store is draining leases
+	nearlyFull        int32 // Non-zero while the store's disk is nearly full
 	stopper           *stop.Stopper
 	startedAt         int64
 	nodeDesc          *roachpb.NodeDescriptor
 	initComplete      sync.WaitGroup // Signaled by async init tasks
+	watchdog          starvationWatchdog
 
 	// Synchronizes raft group creation and range GC.
 	raftGroupLocker sync.Mutex
 
-	mu             sync.RWMutex                 // Protects variables below...
-	replicas       map[roachpb.RangeID]*Replica // Map of replicas by Range ID
-	replicasByKey  *btree.BTree                 // btree keyed by ranges end keys.
+	// processRaftMu orders proposals against the application of committed
+	// commands and the removal of replicas. Proposals hold it shared so
+	// that they don't wait on each other; processRaft holds it exclusively
+	// while applying a batch of events, as does RemoveReplica.
+	processRaftMu sync.RWMutex
+
+	// mu protects the replica maps below. Modifications hold mu
+	// exclusively in addition to the finer-grained lock of the map being
+	// modified, so that holding mu yields a consistent view of all of
+	// them. Lookups of a single replica, which happen on every request,
+	// take only the finer-grained locks, or none at all for replicasByKey.
+	mu             sync.RWMutex
+	replicas       *replicaMap                  // Sharded map of replicas by Range ID
+	replicasByKey  *replicaKeyMap               // Copy-on-write index of replicas by end key
 	uninitReplicas map[roachpb.RangeID]*Replica // Map of uninitialized replicas by Range ID
+	// Age and source of the uninitialized replicas, by Range ID.
+	uninitInfo  map[roachpb.RangeID]*UninitializedReplicaInfo
+	quarantined map[roachpb.RangeID]error                 // Replicas failing the integrity check
+	pendingGC   map[roachpb.RangeID]*PendingGCReplicaInfo // Removed replicas whose data is kept
 }
 
 var _ client.Sender = &Store{}
 var _ multiraft.Storage = &Store{}
+var _ multiraft.GroupCommitStorage = &Store{}
 
 // A StoreContext encompasses the auxiliary objects and configuration
 // required to create a store.
@@ -277,6 +313,10 @@ type StoreContext struct {
 	StorePool *StorePool
 	Transport multiraft.Transport
 
+	// NodeLiveness, if set, enables epoch-based leader leases backed by the
+	// node liveness records. Otherwise, all leases are expiration-based.
+	NodeLiveness *NodeLiveness
+
 	// RangeRetryOptions are the retry options when retryable errors are
 	// encountered sending commands to ranges.
 	RangeRetryOptions retry.Options
@@ -294,6 +334,85 @@ type StoreContext struct {
 	// for local networks.
 	RaftElectionTimeoutTicks int
 
+	// RaftElectionTimeoutJitterTicks is the upper bound of a random
+	// number of ticks added to the election timeout of each range, to
+	// keep ranges from calling elections at the same time. Defaults to a
+	// third of RaftElectionTimeoutTicks; negative values disable the
+	// jitter.
+	RaftElectionTimeoutJitterTicks int
+
+	// RaftGroupCommit, if set, persists the raft HardStates and log
+	// appends of all ranges processed in a single Ready cycle with one
+	// engine batch (and thus a single sync), instead of one per range.
+	RaftGroupCommit bool
+
+	// RaftMaxUncommittedBytes bounds the size of the commands proposed to
+	// a range which have not been committed yet. Further commands are
+	// refused with a retryable error until the range catches up, so that a
+	// leader which cannot reach a quorum doesn't buffer an unbounded
+	// number of commands. Defaults to 32 MB.
+	RaftMaxUncommittedBytes int
+
+	// MaxCommandSize bounds the size of an encoded raft command. Larger
+	// commands, which would stall the replication of all of the range's
+	// commands, are refused with a CommandTooLargeError asking the client
+	// to split its batch, and counted in the "raft.proposals.oversized"
+	// metric. Defaults to 64 MB; negative values disable the bound.
+	MaxCommandSize int64
+
+	// RangeUnavailableTimeout is the time within which a command proposed by
+	// a replica must commit. A command which fails to do so trips the
+	// replica's circuit breaker: the command's client receives an
+	// AmbiguousResultError and further commands fail with a
+	// RangeUnavailableError without being proposed, except for one command
+	// every RangeUnavailableProbeInterval, until a command commits. Defaults
+	// to one minute; negative values disable the circuit breakers.
+	RangeUnavailableTimeout       time.Duration
+	RangeUnavailableProbeInterval time.Duration
+
+	// RaftStarvationElectionTimeouts is the number of election timeouts
+	// (see RaftElectionTimeoutTicks) after which a replica with a command
+	// still pending, or without a known leader, is reported as starved:
+	// the store logs the replica's raft status, lease and queue state and
+	// increments the "replicas.starvation.alerts" counter. The store also
+	// reports its raft processing not ticking for as long. Defaults to 10;
+	// negative values disable the watchdog.
+	RaftStarvationElectionTimeouts int
+
+	// PeerBlocklistThreshold is the number of raft messages from another
+	// store which may be rejected as badly addressed or carrying invalid
+	// snapshots within PeerBlocklistWindow before the store is
+	// blocklisted: its messages, except heartbeats, are then dropped
+	// without being processed for PeerBlocklistDuration. Rejected messages
+	// and blocklistings are counted in the "raft.messages.rejected" and
+	// "raft.peers.blocklisted" metrics. The blocklist is disabled unless
+	// the threshold is positive; the window and duration default to 10
+	// and 30 seconds respectively.
+	PeerBlocklistThreshold int
+	PeerBlocklistWindow    time.Duration
+	PeerBlocklistDuration  time.Duration
+
+	// UninitializedReplicaGCThreshold is the age after which an
+	// uninitialized replica, i.e. one created in response to a raft message
+	// which hasn't received a snapshot yet, is removed unless the meta
+	// records show the store to be a member of its range. Defaults to ten
+	// minutes; negative values disable the removal.
+	UninitializedReplicaGCThreshold time.Duration
+
+	// ReplicaGCDelay is the time for which the data of a replica removed
+	// from its range is kept on the store, guarding against erroneous
+	// removals. Until then the replica is pending GC (see
+	// Store.PendingGCReplicas) and can be recovered using
+	// Store.RecoverPendingGCReplica. Zero, the default, destroys the data
+	// immediately.
+	ReplicaGCDelay time.Duration
+
+	// RaftTickSpread is the upper bound of a random delay applied to each
+	// Raft tick, spreading tick processing of different stores across
+	// the tick interval. Defaults to half of RaftTickInterval; negative
+	// values disable the spread.
+	RaftTickSpread time.Duration
+
 	// ScanInterval is the default value for the scan interval
 	ScanInterval time.Duration
 
@@ -302,6 +421,59 @@ type StoreContext struct {
 	// stores.
 	ScanMaxIdleTime time.Duration
 
+	// BackgroundLatencyThreshold and BackgroundIOThreshold are the mean
+	// latencies of foreground requests and of disk writes, respectively,
+	// above which the scanner and replica queues slow down proportionally.
+	// Zero disables the respective check.
+	BackgroundLatencyThreshold time.Duration
+	BackgroundIOThreshold      time.Duration
+
+	// MaxReplicas is the maximum number of replicas the store holds before
+	// refusing new ones. The limit is gossiped with the store's capacity and
+	// honored by the allocators of all stores. Zero means unlimited.
+	MaxReplicas int
+
+	// MaxConcurrentSnapshots is the number of raft snapshots the store
+	// generates at the same time; further generations wait for a running one
+	// to finish.
+	MaxConcurrentSnapshots int
+
+	// RecoverySnapshotRate and RebalanceSnapshotRate are the rates, in bytes
+	// per second, at which the store sends snapshots catching up or
+	// replacing replicas and snapshots populating replicas added to
+	// rebalance, respectively. Recovery snapshots take precedence over
+	// rebalance snapshots. Negative rates are unlimited.
+	RecoverySnapshotRate  int64
+	RebalanceSnapshotRate int64
+
+	// IntentPushLimit is the maximum number of conflicting intents whose
+	// transactions are pushed when resolving a single write intent error.
+	// The remaining intents are returned to the client, which backs off
+	// before retrying.
+	IntentPushLimit int
+
+	// CapacityAlertThreshold is the fraction of the store's disk capacity in
+	// use above which the store is considered nearly full. Nearly full stores
+	// gossip the fact, receive no new replicas and refuse writes of new data
+	// to non-system ranges. Zero disables the check.
+	CapacityAlertThreshold float64
+
+	// QueueDryRun puts the store's replica queues in dry-run mode, in which
+	// they log the splits, replication changes and garbage collection they
+	// would perform instead of performing them.
+	QueueDryRun bool
+
+	// VerifyIntegrity makes the store verify the checksums of its engine's
+	// data and the consistency of each replica's raft state when starting.
+	// The store refuses to start if its data is corrupt; replicas with
+	// inconsistent raft state are quarantined: they are not loaded and
+	// their data is left untouched for inspection.
+	VerifyIntegrity bool
+
+	// RangeLogTTL is the duration for which entries of the range event log
+	// are retained. Zero retains them indefinitely.
+	RangeLogTTL time.Duration
+
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it can be considered dead.
 	TimeUntilStoreDead time.Duration
@@ -319,6 +491,9 @@ type StoreContext struct {
 	// ScannerStopper is used to shut down the background scanner (for tests).
 	// If nil, defaults to the store's own stopper.
 	ScannerStopper *stop.Stopper
+
+	// TestingKnobs hook into the processing of the store in tests.
+	TestingKnobs StoreTestingKnobs
 }
 
 // Valid returns true if the StoreContext is populated correctly.
@@ -345,6 +520,48 @@ func (sc *StoreContext) setDefaults() {
 	if sc.RaftElectionTimeoutTicks == 0 {
 		sc.RaftElectionTimeoutTicks = defaultRaftElectionTimeoutTicks
 	}
+	if sc.RaftElectionTimeoutJitterTicks == 0 {
+		sc.RaftElectionTimeoutJitterTicks = sc.RaftElectionTimeoutTicks / 3
+	}
+	if sc.RaftTickSpread == 0 {
+		sc.RaftTickSpread = sc.RaftTickInterval / 2
+	}
+	if sc.RaftMaxUncommittedBytes == 0 {
+		sc.RaftMaxUncommittedBytes = defaultRaftMaxUncommittedBytes
+	}
+	if sc.MaxCommandSize == 0 {
+		sc.MaxCommandSize = defaultMaxCommandSize
+	}
+	if sc.MaxConcurrentSnapshots == 0 {
+		sc.MaxConcurrentSnapshots = defaultMaxConcurrentSnapshots
+	}
+	if sc.RecoverySnapshotRate == 0 {
+		sc.RecoverySnapshotRate = defaultRecoverySnapshotRate
+	}
+	if sc.RebalanceSnapshotRate == 0 {
+		sc.RebalanceSnapshotRate = defaultRebalanceSnapshotRate
+	}
+	if sc.IntentPushLimit == 0 {
+		sc.IntentPushLimit = defaultIntentPushLimit
+	}
+	if sc.RangeUnavailableTimeout == 0 {
+		sc.RangeUnavailableTimeout = defaultRangeUnavailableTimeout
+	}
+	if sc.RangeUnavailableProbeInterval == 0 {
+		sc.RangeUnavailableProbeInterval = defaultRangeUnavailableProbeInterval
+	}
+	if sc.RaftStarvationElectionTimeouts == 0 {
+		sc.RaftStarvationElectionTimeouts = defaultRaftStarvationElectionTimeouts
+	}
+	if sc.UninitializedReplicaGCThreshold == 0 {
+		sc.UninitializedReplicaGCThreshold = defaultUninitializedReplicaGCThreshold
+	}
+	if sc.PeerBlocklistWindow == 0 {
+		sc.PeerBlocklistWindow = defaultPeerBlocklistWindow
+	}
+	if sc.PeerBlocklistDuration == 0 {
+		sc.PeerBlocklistDuration = defaultPeerBlocklistDuration
+	}
 }
 
 // NewStore returns a new instance of a store.
@@ -357,17 +574,25 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *roachpb.NodeDescrip
 	}
 
 	s := &Store{
-		ctx:               ctx,
-		db:                ctx.DB, // TODO(tschottdorf) remove redundancy.
-		engine:            eng,
-		allocator:         MakeAllocator(ctx.StorePool, ctx.RebalancingOptions),
-		replicas:          map[roachpb.RangeID]*Replica{},
-		replicasByKey:     btree.New(64 /* degree */),
-		uninitReplicas:    map[roachpb.RangeID]*Replica{},
-		nodeDesc:          nodeDesc,
-		removeReplicaChan: make(chan removeReplicaOp),
-		proposeChan:       make(chan proposeOp),
-	}
+		ctx:            ctx,
+		db:             ctx.DB, // TODO(tschottdorf) remove redundancy.
+		engine:         eng,
+		allocator:      MakeAllocator(ctx.StorePool, ctx.RebalancingOptions),
+		replicas:       newReplicaMap(),
+		replicasByKey:  newReplicaKeyMap(),
+		uninitReplicas: map[roachpb.RangeID]*Replica{},
+		uninitInfo:     map[roachpb.RangeID]*UninitializedReplicaInfo{},
+		quarantined:    map[roachpb.RangeID]error{},
+		pendingGC:      map[roachpb.RangeID]*PendingGCReplicaInfo{},
+		nodeDesc:       nodeDesc,
+		metrics:        metric.NewRegistry(),
+	}
+
+	s.applyLatency = s.metrics.Histogram("raft.latency.apply")
+	s.intentResolver = newIntentResolver(s)
+	s.snapshotThrottle = newSnapshotThrottle(ctx.MaxConcurrentSnapshots, s.metrics)
+	s.peerBlocklist = newPeerBlocklist(ctx.PeerBlocklistThreshold, ctx.PeerBlocklistWindow,
+		ctx.PeerBlocklistDuration, s.metrics)
 
 	// Add range scanner and configure with queues.
 	s.scanner = newReplicaScanner(ctx.ScanInterval, ctx.ScanMaxIdleTime, newStoreRangeSet(s))
@@ -379,6 +604,19 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *roachpb.NodeDescrip
 	s.raftLogQueue = newRaftLogQueue(s.db, s.ctx.Gossip)
 	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue, s.replicaGCQueue, s.raftLogQueue)
 
+	// Pace the scanner and queues according to the store's load.
+	s.pacer = newBackgroundPacer(ctx.BackgroundLatencyThreshold, ctx.BackgroundIOThreshold)
+	s.scanner.pacer = s.pacer
+	for _, bq := range []*baseQueue{&s.gcQueue.baseQueue, &s.splitQueue.baseQueue,
+		&s.verifyQueue.baseQueue, &s.replicateQueue.baseQueue, &s.replicaGCQueue.baseQueue,
+		&s.raftLogQueue.baseQueue} {
+		bq.pacer = s.pacer
+		bq.SetDryRun(ctx.QueueDryRun)
+	}
+	if ctx.TestingKnobs.DisableSplitQueue {
+		s.splitQueue.SetDisabled(true)
+	}
+
 	return s
 }
 
@@ -403,6 +641,50 @@ func (s *Store) IsStarted() bool {
 	return atomic.LoadInt32(&s.started) == 1
 }
 
+// SetDraining sets or clears the store's draining mode. A draining store
+// does not acquire new leader leases; the leases it currently holds are
+// left to expire, at which point other replicas will pick them up, unless
+// they are handed off by DrainLeases.
+func (s *Store) SetDraining(drain bool) {
+	var v int32
+	if drain {
+		v = 1
+	}
+	atomic.StoreInt32(&s.draining, v)
+}
+
+// IsDraining returns true if the store is in draining mode.
+func (s *Store) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+// DrainLeases puts the store into draining mode and hands off the leader
+// leases held by its replicas to another replica of their ranges, so that
+// the ranges don't become unavailable until the leases expire when the
+// store goes away. It returns the number of leases handed off.
+func (s *Store) DrainLeases() int {
+	s.SetDraining(true)
+	var count int
+	newStoreRangeSet(s).Visit(func(r *Replica) bool {
+		if !r.holdsActiveLease() {
+			return true
+		}
+		for _, rep := range r.Desc().Replicas {
+			if rep.StoreID == s.StoreID() || rep.Witness {
+				continue
+			}
+			if err := r.handOffLeaderLease(&rep); err != nil {
+				log.Warningf("range %d: unable to hand off leader lease: %s", r.Desc().RangeID, err)
+			} else {
+				count++
+			}
+			break
+		}
+		return true
+	})
+	return count
+}
+
 // StartedAt returns the timestamp at which the store was most recently started.
 func (s *Store) StartedAt() int64 {
 	return s.startedAt
@@ -427,6 +709,12 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 		} else if !ok {
 			return &NotBootstrappedError{}
 		}
+
+		if s.ctx.VerifyIntegrity {
+			if err := s.engine.VerifyChecksums(); err != nil {
+				return util.Errorf("store %s failed the integrity check: %s", s, err)
+			}
+		}
 	}
 
 	// If the nodeID is 0, it has not be assigned yet.
@@ -447,74 +735,103 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 
 	// Start store event feed.
 	s.feed = NewStoreEventFeed(s.Ident.StoreID, s.ctx.EventFeed)
-	s.feed.startStore(s.startedAt)
+	s.feed.startStore(s.startedAt, s.metrics)
 
 	s.startUpdateGC()
 
-	// Iterator over all range-local key-based data.
-	start := keys.RangeDescriptorKey(roachpb.RKeyMin)
-	end := keys.RangeDescriptorKey(roachpb.RKeyMax)
+	s.snapshotTransport = newSnapshotTransport(s.ctx.Transport, s.ctx.RecoverySnapshotRate,
+		s.ctx.RebalanceSnapshotRate, s.classifySnapshot, s.metrics)
+	s.snapshotTransport.start(s.stopper)
 
+	// Multiraft disables the jitter and the spread when they're zero.
+	jitterTicks, tickSpread := s.ctx.RaftElectionTimeoutJitterTicks, s.ctx.RaftTickSpread
+	if jitterTicks < 0 {
+		jitterTicks = 0
+	}
+	if tickSpread < 0 {
+		tickSpread = 0
+	}
 	if s.multiraft, err = multiraft.NewMultiRaft(s.Ident.NodeID, s.Ident.StoreID, &multiraft.Config{
-		Transport:              s.ctx.Transport,
+		Transport:              s.snapshotTransport,
 		Storage:                s,
 		StateMachine:           s,
 		TickInterval:           s.ctx.RaftTickInterval,
 		ElectionTimeoutTicks:   s.ctx.RaftElectionTimeoutTicks,
 		HeartbeatIntervalTicks: s.ctx.RaftHeartbeatIntervalTicks,
 		EntryFormatter:         raftEntryFormatter,
+
+		ElectionTimeoutJitterTicks: jitterTicks,
+		TickSpread:                 tickSpread,
+		MaxUncommittedBytes:        s.ctx.RaftMaxUncommittedBytes,
 	}, s.stopper); err != nil {
 		return err
 	}
 
-	// Iterate over all range descriptors, ignoring uncommitted versions
-	// (consistent=false). Uncommitted intents which have been abandoned
-	// due to a split crashing halfway will simply be resolved on the
-	// next split attempt. They can otherwise be ignored.
+	descs, err := s.loadReplicaDescriptors(now)
+	if err != nil {
+		return err
+	}
+
 	s.mu.Lock()
+	s.replicasByKey.lock()
 	s.feed.beginScanRanges()
-	if _, err := engine.MVCCIterate(s.engine, start, end, now, false /* !consistent */, nil, /* txn */
-		false /* !reverse */, func(kv roachpb.KeyValue) (bool, error) {
-			// Only consider range metadata entries; ignore others.
-			_, suffix, _, err := keys.DecodeRangeKey(kv.Key)
-			if err != nil {
-				return false, err
-			}
-			if !bytes.Equal(suffix, keys.LocalRangeDescriptorSuffix) {
-				return false, nil
-			}
-			var desc roachpb.RangeDescriptor
-			if err := kv.Value.GetProto(&desc); err != nil {
-				return false, err
-			}
-			rng, err := NewReplica(&desc, s)
-			if err != nil {
-				return false, err
-			}
-			if err = s.addReplicaInternal(rng); err != nil {
-				return false, err
+	for i := range descs {
+		since, pending, err := loadPendingGC(s.engine, descs[i].RangeID)
+		if err != nil {
+			return err
+		}
+		if pending {
+			// The replica was removed from its range; its data is only kept
+			// until the replica GC delay has passed.
+			s.pendingGC[descs[i].RangeID] = &PendingGCReplicaInfo{Desc: descs[i], Since: since}
+			continue
+		}
+		rng, err := NewReplica(&descs[i], s)
+		if err != nil {
+			return err
+		}
+		if s.ctx.VerifyIntegrity {
+			if err := rng.verifyRaftState(); err != nil {
+				log.Errorf("store %s: quarantining range %d: %s", s, descs[i].RangeID, err)
+				s.quarantined[descs[i].RangeID] = err
+				continue
 			}
-			s.feed.registerRange(rng, true /* scan */)
-			// Note that we do not create raft groups at this time; they will be created
-			// on-demand the first time they are needed. This helps reduce the amount of
-			// election-related traffic in a cold start.
-			// Raft initialization occurs when we propose a command on this range or
-			// receive a raft message addressed to it.
-			// TODO(bdarnell): Also initialize raft groups when read leases are needed.
-			// TODO(bdarnell): Scan all ranges at startup for unapplied log entries
-			// and initialize those groups.
-			return false, nil
-		}); err != nil {
-		return err
+		}
+		if err = s.addReplicaInternal(rng); err != nil {
+			return err
+		}
+		s.feed.registerRange(rng, true /* scan */)
+		// Note that we do not create raft groups at this time; they will be created
+		// on-demand the first time they are needed. This helps reduce the amount of
+		// election-related traffic in a cold start.
+		// Raft initialization occurs when we propose a command on this range or
+		// receive a raft message addressed to it.
+		// TODO(bdarnell): Also initialize raft groups when read leases are needed.
+		// TODO(bdarnell): Scan all ranges at startup for unapplied log entries
+		// and initialize those groups.
 	}
 	s.feed.endScanRanges()
 
+	s.replicasByKey.unlock()
 	s.mu.Unlock()
 
+	// Look for raft state left behind by replicas which no longer exist. It
+	// is purged by a periodic sweep once verified against the meta records.
+	orphans, err := s.findOrphanedRangeIDs()
+	if err != nil {
+		return err
+	}
+	if len(orphans) > 0 {
+		log.Warningf("store %s: found orphaned raft state for ranges %v", s, orphans)
+	}
+
 	// Start Raft processing goroutines.
 	s.multiraft.Start()
 	s.processRaft()
 
+	// Start reporting replicas whose raft processing is starved.
+	s.startStarvationWatchdog()
+
 	// Gossip is only ever nil while bootstrapping a cluster and
 	// in unittests.
 	if s.ctx.Gossip != nil {
@@ -529,6 +846,20 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 		// running.
 		s.startGossip()
 
+		// Start pruning the range event log.
+		s.startRangeLogGC()
+
+		// Start purging orphaned raft state.
+		s.startOrphanedRaftStateGC(orphans)
+
+		// Start removing uninitialized replicas which never received a
+		// snapshot.
+		s.startUninitializedReplicaGC()
+
+		// Start destroying the data of removed replicas once the replica GC
+		// delay has passed.
+		s.startPendingGCSweep()
+
 		// Start the scanner. The construction here makes sure that the scanner
 		// only starts after Gossip has connected, and that it does not block Start
 		// from returning (as doing so might prevent Gossip from ever connecting).
@@ -668,17 +999,93 @@ func (s *Store) maybeGossipSystemConfig() error {
 	return err
 }
 
+// loadReplicaDescriptors returns the range descriptors of all replicas
+// on the store. They are read from the store-local replica descriptor
+// index if it agrees with the range descriptors. Otherwise, the range
+// descriptors are scanned and the index is rebuilt.
+func (s *Store) loadReplicaDescriptors(now roachpb.Timestamp) ([]roachpb.RangeDescriptor, error) {
+	descs, err := loadReplicaDescriptorIndex(s.engine)
+	if err != nil {
+		return nil, err
+	}
+	if len(descs) > 0 {
+		ok, err := verifyReplicaDescriptorIndex(s.engine, descs, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return descs, nil
+		}
+		log.Warningf("store %s: replica descriptor index diverged from range descriptors; rebuilding", s)
+	}
+
+	descs, err = s.scanRangeDescriptors(now)
+	if err != nil {
+		return nil, err
+	}
+	if err := rebuildReplicaDescriptorIndex(s.engine, descs); err != nil {
+		return nil, err
+	}
+	return descs, nil
+}
+
+// scanRangeDescriptors iterates over all range descriptors on the store.
+func (s *Store) scanRangeDescriptors(now roachpb.Timestamp) ([]roachpb.RangeDescriptor, error) {
+	// Iterator over all range-local key-based data.
+	start := keys.RangeDescriptorKey(roachpb.RKeyMin)
+	end := keys.RangeDescriptorKey(roachpb.RKeyMax)
+
+	// Iterate over all range descriptors, ignoring uncommitted versions
+	// (consistent=false). Uncommitted intents which have been abandoned
+	// due to a split crashing halfway will simply be resolved on the
+	// next split attempt. They can otherwise be ignored.
+	var descs []roachpb.RangeDescriptor
+	_, err := engine.MVCCIterate(s.engine, start, end, now, false /* !consistent */, nil, /* txn */
+		false /* !reverse */, func(kv roachpb.KeyValue) (bool, error) {
+			// Only consider range metadata entries; ignore others.
+			_, suffix, _, err := keys.DecodeRangeKey(kv.Key)
+			if err != nil {
+				return false, err
+			}
+			if !bytes.Equal(suffix, keys.LocalRangeDescriptorSuffix) {
+				return false, nil
+			}
+			var desc roachpb.RangeDescriptor
+			if err := kv.Value.GetProto(&desc); err != nil {
+				return false, err
+			}
+			descs = append(descs, desc)
+			return false, nil
+		})
+	return descs, err
+}
+
 // systemGossipUpdate is a callback for gossip updates to
-// the system config which affect range split boundaries.
+// the system config which affect range split boundaries and
+// replication targets.
 func (s *Store) systemGossipUpdate(cfg *config.SystemConfig) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	// For every range, update its MaxBytes and check if it needs to be split.
-	for _, rng := range s.replicas {
-		if zone, err := cfg.GetZoneConfigForKey(rng.Desc().StartKey); err == nil {
+	now := s.ctx.Clock.Now()
+	var pending int
+	// For every range, update its MaxBytes and check if it needs to be split
+	// or replicated.
+	s.replicas.visit(func(_ roachpb.RangeID, rng *Replica) bool {
+		desc := rng.Desc()
+		if zone, err := cfg.GetZoneConfigForKey(desc.StartKey); err == nil {
 			rng.SetMaxBytes(zone.RangeMaxBytes)
+			// Enqueue ranges whose replica count no longer matches their
+			// zone right away instead of waiting for the scanner.
+			if len(desc.Replicas) != len(zone.ReplicaAttrs) {
+				pending++
+				s.replicateQueue.MaybeAdd(rng, now)
+			}
 		}
-		s.splitQueue.MaybeAdd(rng, s.ctx.Clock.Now())
+		s.splitQueue.MaybeAdd(rng, now)
+		return true
+	})
+	if pending > 0 {
+		log.Infoc(s.Context(nil), "%d replicas require up- or down-replication after system config update", pending)
 	}
 }
 
@@ -705,15 +1112,40 @@ func (s *Store) DisableReplicaGCQueue(disabled bool) {
 	s.replicaGCQueue.SetDisabled(disabled)
 }
 
+// SetQueueDryRun turns dry-run mode of the store's replica queues on or
+// off. In dry-run mode the queues log the actions they would take on the
+// replicas they process instead of taking them.
+func (s *Store) SetQueueDryRun(dryRun bool) {
+	for _, bq := range []*baseQueue{&s.gcQueue.baseQueue, &s.splitQueue.baseQueue,
+		&s.verifyQueue.baseQueue, &s.replicateQueue.baseQueue, &s.replicaGCQueue.baseQueue,
+		&s.raftLogQueue.baseQueue} {
+		bq.SetDryRun(dryRun)
+	}
+}
+
+// PauseBackgroundWork pauses the replica scanner and all replica queues
+// for the given duration, for example during a bulk load. Background work
+// resumes automatically once the duration has elapsed.
+func (s *Store) PauseBackgroundWork(d time.Duration) {
+	s.pacer.pause(time.Now(), d)
+}
+
+// ResumeBackgroundWork resumes background work paused by
+// PauseBackgroundWork.
+func (s *Store) ResumeBackgroundWork() {
+	s.pacer.pause(time.Now(), 0)
+}
+
 // ForceReplicationScan iterates over all ranges and enqueues any that
 // need to be replicated. Exposed only for testing.
 func (s *Store) ForceReplicationScan(t util.Tester) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, r := range s.replicas {
+	s.replicas.visit(func(_ roachpb.RangeID, r *Replica) bool {
 		s.replicateQueue.MaybeAdd(r, s.ctx.Clock.Now())
-	}
+		return true
+	})
 }
 
 // ForceReplicaGCScan iterates over all ranges and enqueues any that
@@ -722,8 +1154,17 @@ func (s *Store) ForceReplicaGCScan(t util.Tester) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, r := range s.replicas {
+	s.replicas.visit(func(_ roachpb.RangeID, r *Replica) bool {
 		s.replicaGCQueue.MaybeAdd(r, s.ctx.Clock.Now())
+		return true
+	})
+}
+
+// ForcePendingGCSweep destroys the data of the replicas which have been
+// pending GC for longer than the replica GC delay. Exposed only for testing.
+func (s *Store) ForcePendingGCSweep(t util.Tester) {
+	if _, err := s.sweepPendingGC(); err != nil {
+		t.Fatal(err)
 	}
 }
 
@@ -733,9 +1174,10 @@ func (s *Store) ForceRaftLogScan(t util.Tester) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, r := range s.replicas {
+	s.replicas.visit(func(_ roachpb.RangeID, r *Replica) bool {
 		s.raftLogQueue.MaybeAdd(r, s.ctx.Clock.Now())
-	}
+		return true
+	})
 }
 
 // Bootstrap writes a new store ident to the underlying engine. To
@@ -771,28 +1213,45 @@ func (s *Store) Bootstrap(ident roachpb.StoreIdent, stopper *stop.Stopper) error
 
 // GetReplica fetches a replica by Range ID. Returns an error if no replica is found.
 func (s *Store) GetReplica(rangeID roachpb.RangeID) (*Replica, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	if rng, ok := s.replicas[rangeID]; ok {
+	if rng, ok := s.replicas.get(rangeID); ok {
 		return rng, nil
 	}
 	return nil, roachpb.NewRangeNotFoundError(rangeID)
 }
 
+// VerifyRange scans the data of the store's replica of the given range,
+// verifying on-disk checksums immediately instead of waiting for the
+// verification queue. If resumeKey is non-nil, the scan starts at that
+// key; it should be the ResumeKey of an earlier, canceled report. If
+// ctx is canceled, the scan stops and the returned report's ResumeKey
+// is set. The replica's last verification timestamp is updated only if
+// the scan ran to completion without error.
+func (s *Store) VerifyRange(ctx context.Context, rangeID roachpb.RangeID,
+	resumeKey roachpb.EncodedKey) (VerifyReport, error) {
+	rng, err := s.GetReplica(rangeID)
+	if err != nil {
+		return VerifyReport{}, err
+	}
+	report := verifyReplica(ctx, rng, resumeKey)
+	if report.Err != nil {
+		rng.setVerifyResult(report.Err)
+	}
+	if report.Done() {
+		rng.setVerifyResult(nil)
+		if err := rng.SetLastVerificationTimestamp(s.Clock().Now()); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
 // LookupReplica looks up a replica via binary search over the
-// "replicasByKey" btree. Returns nil if no replica is found for
+// "replicasByKey" index. Returns nil if no replica is found for
 // specified key range. Note that the specified keys are transformed
 // using Key.Address() to ensure we lookup replicas correctly for local
 // keys. When end is nil, a replica that contains start is looked up.
 func (s *Store) LookupReplica(start, end roachpb.RKey) *Replica {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	var rng *Replica
-	s.replicasByKey.AscendGreaterOrEqual((rangeBTreeKey)(start.Next()), func(i btree.Item) bool {
-		rng = i.(*Replica)
-		return false
-	})
+	rng := s.replicasByKey.lookup(start)
 	if rng == nil || !rng.Desc().ContainsKeyRange(start, end) {
 		return nil
 	}
@@ -813,67 +1272,93 @@ func (s *Store) RaftStatus(rangeID roachpb.RangeID) *raft.Status {
 // It also adds the range tree and the root node, the first range, to it.
 // The 'initialValues' are written as well after each value's checksum
 // is initalized.
-func (s *Store) BootstrapRange(initialValues []roachpb.KeyValue) error {
-	desc := &roachpb.RangeDescriptor{
-		RangeID:       1,
-		StartKey:      roachpb.RKeyMin,
-		EndKey:        roachpb.RKeyMax,
-		NextReplicaID: 2,
-		Replicas: []roachpb.ReplicaDescriptor{
-			{
-				NodeID:    1,
-				StoreID:   1,
-				ReplicaID: 1,
-			},
-		},
-	}
-	if err := desc.Validate(); err != nil {
+//
+// If split keys are given, the key space is split at each of them right
+// away: a range is created for each span between consecutive split keys,
+// along with its addressing records and range tree node, so that a new
+// cluster doesn't need to split its first range under load. The split keys
+// must be sorted and may neither be addressing keys nor split a span which
+// must not be split (see keys.NoSplitSpans).
+func (s *Store) BootstrapRange(initialValues []roachpb.KeyValue, splits []roachpb.RKey) error {
+	descs, err := bootstrapDescriptors(splits)
+	if err != nil {
 		return err
 	}
 	batch := s.engine.NewBatch()
-	ms := &engine.MVCCStats{}
+	stats := make([]engine.MVCCStats, len(descs))
 	now := s.ctx.Clock.Now()
 
-	// Range descriptor.
-	if err := engine.MVCCPutProto(batch, ms, keys.RangeDescriptorKey(desc.StartKey), now, nil, desc); err != nil {
-		return err
-	}
-	// GC Metadata.
-	gcMeta := roachpb.NewGCMetadata(now.WallTime)
-	if err := engine.MVCCPutProto(batch, ms, keys.RangeGCMetadataKey(desc.RangeID), roachpb.ZeroTimestamp, nil, gcMeta); err != nil {
-		return err
-	}
-	// Verification timestamp.
-	if err := engine.MVCCPutProto(batch, ms, keys.RangeLastVerificationTimestampKey(desc.RangeID), roachpb.ZeroTimestamp, nil, &now); err != nil {
-		return err
-	}
-	// Range addressing for meta2.
-	meta2Key := keys.RangeMetaKey(roachpb.RKeyMax)
-	if err := engine.MVCCPutProto(batch, ms, meta2Key, now, nil, desc); err != nil {
-		return err
+	// statsFor returns the stats of the range containing the given key.
+	statsFor := func(key roachpb.Key) *engine.MVCCStats {
+		addr := keys.Addr(key)
+		i := sort.Search(len(descs), func(i int) bool {
+			return addr.Less(descs[i].EndKey)
+		})
+		return &stats[i]
 	}
-	// Range addressing for meta1.
-	meta1Key := keys.RangeMetaKey(keys.Addr(meta2Key))
-	if err := engine.MVCCPutProto(batch, ms, meta1Key, now, nil, desc); err != nil {
-		return err
+
+	startKeys := make([]roachpb.RKey, len(descs))
+	for i, desc := range descs {
+		ms := &stats[i]
+		startKeys[i] = desc.StartKey
+
+		// Range descriptor.
+		if err := engine.MVCCPutProto(batch, ms, keys.RangeDescriptorKey(desc.StartKey), now, nil, desc); err != nil {
+			return err
+		}
+		if err := putReplicaDescriptorIndex(batch, desc); err != nil {
+			return err
+		}
+		// GC Metadata.
+		gcMeta := roachpb.NewGCMetadata(now.WallTime)
+		if err := engine.MVCCPutProto(batch, ms, keys.RangeGCMetadataKey(desc.RangeID), roachpb.ZeroTimestamp, nil, gcMeta); err != nil {
+			return err
+		}
+		// Verification timestamp.
+		if err := engine.MVCCPutProto(batch, ms, keys.RangeLastVerificationTimestampKey(desc.RangeID), roachpb.ZeroTimestamp, nil, &now); err != nil {
+			return err
+		}
+		// Range addressing for meta2.
+		meta2Key := keys.RangeMetaKey(desc.EndKey)
+		if err := engine.MVCCPutProto(batch, statsFor(meta2Key), meta2Key, now, nil, desc); err != nil {
+			return err
+		}
+		// Range addressing for meta1, which only the first range requires
+		// since it holds all of meta2.
+		if i == 0 {
+			if err := engine.MVCCPutProto(batch, statsFor(keys.Meta1KeyMax), keys.Meta1KeyMax, now, nil, desc); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Now add all passed-in default entries.
 	for _, kv := range initialValues {
 		// Initialize the checksums.
 		kv.Value.InitChecksum(kv.Key)
-		if err := engine.MVCCPut(batch, ms, kv.Key, now, kv.Value, nil); err != nil {
+		if err := engine.MVCCPut(batch, statsFor(kv.Key), kv.Key, now, kv.Value, nil); err != nil {
 			return err
 		}
 	}
 
 	// Range Tree setup.
-	if err := SetupRangeTree(batch, ms, now, desc.StartKey); err != nil {
+	if err := SetupRangeTree(batch, statsFor(keys.RangeTreeRoot), now, startKeys); err != nil {
 		return err
 	}
 
-	if err := engine.MVCCSetRangeStats(batch, 1, ms); err != nil {
-		return err
+	// The range IDs of the initial ranges must not be allocated again.
+	if len(descs) > 1 {
+		var v roachpb.Value
+		v.SetInt(int64(len(descs)))
+		if err := engine.MVCCPut(batch, statsFor(keys.RangeIDGenerator), keys.RangeIDGenerator, now, v, nil); err != nil {
+			return err
+		}
+	}
+
+	for i, desc := range descs {
+		if err := engine.MVCCSetRangeStats(batch, desc.RangeID, &stats[i]); err != nil {
+			return err
+		}
 	}
 	if err := batch.Commit(); err != nil {
 		return err
@@ -881,6 +1366,50 @@ func (s *Store) BootstrapRange(initialValues []roachpb.KeyValue) error {
 	return nil
 }
 
+// bootstrapDescriptors returns the descriptors of the ranges created when
+// bootstrapping a cluster with the given split keys, all of which have a
+// single replica on the first store of the first node.
+func bootstrapDescriptors(splits []roachpb.RKey) ([]*roachpb.RangeDescriptor, error) {
+	var descs []*roachpb.RangeDescriptor
+	startKey := roachpb.RKeyMin
+	for i := 0; i <= len(splits); i++ {
+		endKey := roachpb.RKeyMax
+		if i < len(splits) {
+			endKey = splits[i]
+			if endKey.Less(roachpb.RKey(keys.MetaMax)) {
+				return nil, util.Errorf("cannot split at addressing key %s", endKey)
+			}
+			for _, span := range keys.NoSplitSpans {
+				if roachpb.RKey(span.Key).Less(endKey) && endKey.Less(roachpb.RKey(span.EndKey)) {
+					return nil, util.Errorf("cannot split at %s within %s-%s", endKey, span.Key, span.EndKey)
+				}
+			}
+		}
+		if !startKey.Less(endKey) {
+			return nil, util.Errorf("split keys must be sorted and unique: %s", splits)
+		}
+		desc := &roachpb.RangeDescriptor{
+			RangeID:       roachpb.RangeID(i + 1),
+			StartKey:      startKey,
+			EndKey:        endKey,
+			NextReplicaID: 2,
+			Replicas: []roachpb.ReplicaDescriptor{
+				{
+					NodeID:    1,
+					StoreID:   1,
+					ReplicaID: 1,
+				},
+			},
+		}
+		if err := desc.Validate(); err != nil {
+			return nil, err
+		}
+		descs = append(descs, desc)
+		startKey = endKey
+	}
+	return descs, nil
+}
+
 // The following methods implement the RangeManager interface.
 
 // ClusterID accessor.
@@ -907,9 +1436,49 @@ func (s *Store) Stopper() *stop.Stopper { return s.stopper }
 // EventFeed accessor.
 func (s *Store) EventFeed() StoreEventFeed { return s.feed }
 
+// Registry accessor.
+func (s *Store) Registry() *metric.Registry { return s.metrics }
+
+// FlushIntentResolution blocks until all intents which are currently being
+// resolved asynchronously have been processed. For use in tests.
+func (s *Store) FlushIntentResolution() { s.intentResolver.flush() }
+
 // Tracer accessor.
 func (s *Store) Tracer() *tracer.Tracer { return s.ctx.Tracer }
 
+// ClusterNow returns a timestamp which is safe for consistent reads across
+// all ranges of the cluster. See the package-level ClusterNow.
+func (s *Store) ClusterNow() roachpb.Timestamp { return ClusterNow(s.ctx.Clock) }
+
+// ClusterNow returns a timestamp at or above the timestamps of all writes
+// which were committed anywhere in the cluster before the call. As the
+// clocks of the nodes are within the clock's maximum offset of each other,
+// the timestamp is the local physical time plus the maximum offset, or the
+// clock's current time if it is ahead of that. Reading at the returned
+// timestamp observes a consistent cut of the cluster's data, which makes it
+// suitable for backups and other consistent reads outside of transactions.
+//
+// Before returning, ClusterNow waits until the local physical clock has
+// caught up with the offset it added, so that the timestamp isn't rejected
+// as being in the future by any node, and forwards the clock to it, so that
+// the subsequent events of this node are timestamped above it. The wait
+// lasts the maximum offset; it is inherent to the guarantee, so callers
+// like backups obtain a single timestamp per operation.
+func ClusterNow(clock *hlc.Clock) roachpb.Timestamp {
+	readyAt := clock.PhysicalNow() + clock.MaxOffset().Nanoseconds()
+	ts := roachpb.Timestamp{WallTime: readyAt}
+	if now := clock.Now(); ts.Less(now) {
+		// The clock is ahead of the local physical time. Its time was already
+		// accepted by the cluster, so only the offset is waited for.
+		ts = now
+	}
+	if wait := time.Duration(readyAt - clock.PhysicalNow()); wait > 0 {
+		time.Sleep(wait)
+	}
+	clock.Update(ts)
+	return ts
+}
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied roachpb.Replicas slice. It allocates new
 // replica IDs to fill out the supplied replicas.
@@ -945,9 +1514,36 @@ func (s *Store) SplitRange(origRng, newRng *Replica) error {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if err := s.splitReplicasByKeyLocked(origRng, newRng); err != nil {
+		return err
+	}
+
+	// Update the max bytes and other information of the new range.
+	// This may not happen if the system config has not yet been loaded.
+	// Since this is done under the store lock, system config update will
+	// properly set these fields.
+	if err := newRng.updateRangeInfo(); err != nil {
+		return err
+	}
+
+	s.feed.splitRange(origRng, newRng)
+	s.gossipRangeDescriptorChange(origRng, newRng)
+	return s.processRangeDescriptorUpdateLocked(origRng)
+}
+
+// splitReplicasByKeyLocked shortens the original replica in the
+// replicasByKey btree and adds the new replica. Lookups by key observe
+// either the state before or after the split. This method presupposes
+// the store's lock is held.
+func (s *Store) splitReplicasByKeyLocked(origRng, newRng *Replica) error {
+	origDesc := origRng.Desc()
+	newDesc := newRng.Desc()
+
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
 	// Replace the end key of the original range with the start key of
 	// the new range. Reinsert the range since the btree is keyed by range end keys.
-	if s.replicasByKey.Delete(origRng) == nil {
+	if !s.replicasByKey.deleteLocked(origRng) {
 		return util.Errorf("couldn't find range %s in rangesByKey btree", origRng)
 	}
 
@@ -955,7 +1551,7 @@ func (s *Store) SplitRange(origRng, newRng *Replica) error {
 	copyDesc.EndKey = append([]byte(nil), newDesc.StartKey...)
 	origRng.setDescWithoutProcessUpdate(&copyDesc)
 
-	if s.replicasByKey.ReplaceOrInsert(origRng) != nil {
+	if s.replicasByKey.insertLocked(origRng) != nil {
 		return util.Errorf("couldn't insert range %v in rangesByKey btree", origRng)
 	}
 
@@ -963,22 +1559,13 @@ func (s *Store) SplitRange(origRng, newRng *Replica) error {
 	// way for the complete one created by the split.
 	if _, ok := s.uninitReplicas[newDesc.RangeID]; ok {
 		delete(s.uninitReplicas, newDesc.RangeID)
-		delete(s.replicas, newDesc.RangeID)
+		delete(s.uninitInfo, newDesc.RangeID)
+		s.replicas.del(newDesc.RangeID)
 	}
 	if err := s.addReplicaInternal(newRng); err != nil {
 		return util.Errorf("couldn't insert range %v in rangesByKey btree: %s", newRng, err)
 	}
-
-	// Update the max bytes and other information of the new range.
-	// This may not happen if the system config has not yet been loaded.
-	// Since this is done under the store lock, system config update will
-	// properly set these fields.
-	if err := newRng.updateRangeInfo(); err != nil {
-		return err
-	}
-
-	s.feed.splitRange(origRng, newRng)
-	return s.processRangeDescriptorUpdateLocked(origRng)
+	return nil
 }
 
 // MergeRange expands the subsuming range to absorb the subsumed range.
@@ -1004,27 +1591,83 @@ func (s *Store) MergeRange(subsumingRng *Replica, updatedEndKey roachpb.RKey, su
 	}
 
 	// Remove and destroy the subsumed range. Note that we are on the
-	// processRaft goroutine so we can call removeReplicaImpl directly.
+	// processRaft goroutine, which holds processRaftMu, so we can call
+	// removeReplicaImpl directly.
 	if err := s.removeReplicaImpl(subsumedRng); err != nil {
 		return util.Errorf("cannot remove range %s", err)
 	}
 
-	// Update the end key of the subsuming range.
-	copy := *subsumingDesc
-	copy.EndKey = updatedEndKey
-	if err := subsumingRng.setDesc(&copy); err != nil {
+	// Update the end key of the subsuming range. Reinsert the range since
+	// the btree is keyed by range end keys.
+	if err := s.mergeReplicasByKey(subsumingRng, updatedEndKey); err != nil {
 		return err
 	}
 
 	s.feed.mergeRange(subsumingRng, subsumedRng)
+	s.gossipRangeDescriptorChange(subsumingRng)
 	return nil
 }
 
+// mergeReplicasByKey extends the end key of the subsuming replica in the
+// replicasByKey btree to the given key, which the subsumed replica must
+// already have been removed from.
+func (s *Store) mergeReplicasByKey(subsumingRng *Replica, updatedEndKey roachpb.RKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
+	if !s.replicasByKey.deleteLocked(subsumingRng) {
+		return util.Errorf("couldn't find range %s in rangesByKey btree", subsumingRng)
+	}
+
+	copy := *subsumingRng.Desc()
+	copy.EndKey = updatedEndKey
+	subsumingRng.setDescWithoutProcessUpdate(&copy)
+
+	if s.replicasByKey.insertLocked(subsumingRng) != nil {
+		return util.Errorf("couldn't insert range %v in rangesByKey btree", subsumingRng)
+	}
+	return nil
+}
+
+// gossipRangeDescriptorChange gossips the first range descriptor and the
+// system config immediately if any of the supplied replicas, whose
+// descriptors were just changed by a split or merge, hold them. Other
+// nodes' caches would otherwise only converge at the next periodic
+// gossip. The gossip happens asynchronously since acquiring the leader
+// lease requires the processRaft goroutine that splits and merges are
+// applied on.
+func (s *Store) gossipRangeDescriptorChange(rngs ...*Replica) {
+	if s.Gossip() == nil {
+		return
+	}
+	for _, rng := range rngs {
+		rng := rng
+		firstRange := rng.IsFirstRange()
+		systemConfig := rng.ContainsKey(keys.SystemDBSpan.Key)
+		if !firstRange && !systemConfig {
+			continue
+		}
+		s.stopper.RunAsyncTask(func() {
+			if firstRange {
+				if err := rng.maybeGossipFirstRange(); err != nil {
+					log.Warningc(rng.context(), "error gossiping first range data: %s", err)
+				}
+			}
+			if systemConfig {
+				rng.maybeGossipSystemConfig()
+			}
+		})
+	}
+}
+
 // AddReplicaTest adds the replica to the store's replica map and to the sorted
 // replicasByKey slice. To be used only by unittests.
 func (s *Store) AddReplicaTest(rng *Replica) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
 	if err := s.addReplicaInternal(rng); err != nil {
 		return err
 	}
@@ -1033,7 +1676,7 @@ func (s *Store) AddReplicaTest(rng *Replica) error {
 }
 
 // addReplicaInternal adds the replica to the replicas map and the replicasByKey btree.
-// This method presupposes the store's lock is held. Returns a rangeAlreadyExists
+// This method presupposes the store's lock is held and replicasByKey is locked. Returns a rangeAlreadyExists
 // error if a replica with the same Range ID has already been added to this store.
 func (s *Store) addReplicaInternal(rng *Replica) error {
 	if !rng.isInitialized() {
@@ -1046,42 +1689,36 @@ func (s *Store) addReplicaInternal(rng *Replica) error {
 		return err
 	}
 
-	if s.replicasByKey.Has(rng) {
+	if s.replicasByKey.hasLocked(rng) {
 		return rangeAlreadyExists{rng}
 	}
-	if exRngItem := s.replicasByKey.ReplaceOrInsert(rng); exRngItem != nil {
-		return util.Errorf("range for key %v already exists in rangesByKey btree",
-			(exRngItem.(*Replica)).getKey())
+	if exRng := s.replicasByKey.insertLocked(rng); exRng != nil {
+		return util.Errorf("range for key %v already exists in rangesByKey btree", exRng.getKey())
 	}
 	return nil
 }
 
-// addReplicaToRangeMap adds the replica to the replicas map.
+// addReplicaToRangeMap adds the replica to the replicas map. This
+// method presupposes the store's lock is held.
 func (s *Store) addReplicaToRangeMap(rng *Replica) error {
 	rangeID := rng.Desc().RangeID
 
-	if exRng, ok := s.replicas[rangeID]; ok {
+	if exRng, ok := s.replicas.get(rangeID); ok {
 		return rangeAlreadyExists{exRng}
 	}
-	s.replicas[rangeID] = rng
+	s.replicas.put(rangeID, rng)
 	return nil
 }
 
-type removeReplicaOp struct {
-	rep *Replica
-	ch  chan<- error
-}
-
 // RemoveReplica removes the replica from the store's replica map and from
 // the sorted replicasByKey btree.
 func (s *Store) RemoveReplica(rep *Replica) error {
-
-	ch := make(chan error)
-	s.removeReplicaChan <- removeReplicaOp{rep, ch}
-	return <-ch
+	s.processRaftMu.Lock()
+	defer s.processRaftMu.Unlock()
+	return s.removeReplicaImpl(rep)
 }
 
-// removeReplicaImpl runs on the processRaft goroutine.
+// removeReplicaImpl requires that processRaftMu is held exclusively.
 func (s *Store) removeReplicaImpl(rep *Replica) error {
 	rangeID := rep.Desc().RangeID
 
@@ -1095,16 +1732,17 @@ func (s *Store) removeReplicaImpl(rep *Replica) error {
 	// RemoveGroup needs to access the storage, which in turn needs the
 	// lock. Some care is needed to avoid deadlocks. We remove the group
 	// from multiraft outside the scope of s.mu; this is effectively
-	// synchronized by the fact that this method runs on the processRaft
-	// goroutine.
+	// synchronized by processRaftMu, which is held exclusively.
 	if err := s.multiraft.RemoveGroup(rangeID); err != nil {
 		return err
 	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.replicas, rangeID)
-	if s.replicasByKey.Delete(rep) == nil {
+	s.replicas.del(rangeID)
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
+	if !s.replicasByKey.deleteLocked(rep) {
 		return util.Errorf("couldn't find range in replicasByKey btree")
 	}
 	s.scanner.RemoveReplica(rep)
@@ -1131,14 +1769,16 @@ func (s *Store) processRangeDescriptorUpdateLocked(rng *Replica) error {
 		return nil
 	}
 	delete(s.uninitReplicas, rangeID)
+	delete(s.uninitInfo, rangeID)
 	s.feed.registerRange(rng, false /* scan */)
 
-	if s.replicasByKey.Has(rng) {
+	s.replicasByKey.lock()
+	defer s.replicasByKey.unlock()
+	if s.replicasByKey.hasLocked(rng) {
 		return rangeAlreadyExists{rng}
 	}
-	if exRngItem := s.replicasByKey.ReplaceOrInsert(rng); exRngItem != nil {
-		return util.Errorf("range for key %v already exists in rangesByKey btree",
-			(exRngItem.(*Replica)).getKey())
+	if exRng := s.replicasByKey.insertLocked(rng); exRng != nil {
+		return util.Errorf("range for key %v already exists in rangesByKey btree", exRng.getKey())
 	}
 	return nil
 }
@@ -1166,6 +1806,8 @@ func (s *Store) Descriptor() (*roachpb.StoreDescriptor, error) {
 		return nil, err
 	}
 	capacity.RangeCount = int32(s.ReplicaCount())
+	capacity.MaxRangeCount = int32(s.ctx.MaxReplicas)
+	capacity.NearlyFull = s.updateNearlyFull(capacity)
 	// Initialize the store descriptor.
 	return &roachpb.StoreDescriptor{
 		StoreID:  s.Ident.StoreID,
@@ -1177,17 +1819,36 @@ func (s *Store) Descriptor() (*roachpb.StoreDescriptor, error) {
 
 // ReplicaCount returns the number of replicas contained by this store.
 func (s *Store) ReplicaCount() int {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return len(s.replicas)
+	return s.replicas.len()
 }
 
 // Send fetches a range based on the header's replica, assembles
 // method, args & reply into a Raft Cmd struct and executes the
 // command using the fetched range.
-func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (br *roachpb.BatchResponse, pErr *roachpb.Error) {
 	ctx = s.Context(ctx)
 	trace := tracer.FromCtx(ctx)
+	var rng *Replica
+	start := time.Now()
+	// Record where the error originated and our clock reading, which the
+	// client may use to update its clock and route its retries.
+	defer func() {
+		now := time.Now()
+		s.metrics.Histogram("requests.latency").RecordValue(now.Sub(start).Nanoseconds())
+		s.pacer.recordRequestLatency(now, now.Sub(start))
+		if pErr != nil {
+			origin := roachpb.ReplicaDescriptor{NodeID: s.Ident.NodeID, StoreID: s.Ident.StoreID}
+			if rng != nil {
+				if rep := rng.GetReplica(); rep != nil {
+					origin = *rep
+				}
+			}
+			pErr.SetOrigin(origin, s.Clock().Now())
+		}
+	}()
+	if err := ba.Validate(); err != nil {
+		return nil, roachpb.NewError(err)
+	}
 	// If the request has a zero timestamp, initialize to this node's clock.
 	for _, union := range ba.Requests {
 		arg := union.GetInner()
@@ -1232,7 +1893,6 @@ func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.Bat
 		}
 		return r.Next()
 	}
-	var rng *Replica
 	var err error
 
 	// Add the command to the range for execution; exit retry loop on success.
@@ -1242,6 +1902,9 @@ func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.Bat
 		if err != nil {
 			return nil, roachpb.NewError(err)
 		}
+		if err := s.refuseWriteWhenNearlyFull(rng, ba); err != nil {
+			return nil, roachpb.NewError(err)
+		}
 
 		var br *roachpb.BatchResponse
 		{
@@ -1336,17 +1999,23 @@ func (s *Store) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.Bat
 // resolve intent command and set the error's Resolved flag to true so the
 // client retries the command immediately. If the push fails, we set the
 // error's Resolved flag to false so that the client backs off before reissuing
-// the command.
+// the command. At most StoreContext.IntentPushLimit transactions are pushed;
+// if there are more, the remaining intents are returned in an unresolved
+// error, so that the client backs off instead of overwhelming the cluster
+// with pushes.
 // On write/write conflicts, a potential push error is returned; otherwise
 // the updated WriteIntentError.
 //
 // Callers are involved with
 // a) conflict resolution for commands being executed at the Store with the
-//    client waiting,
+//
+//	client waiting,
+//
 // b) resolving intents encountered during inconsistent operations, and
 // c) resolving intents upon EndTransaction which are not local to the given
-//    range. This is the only path in which the transaction is going to be
-//    in non-pending state and doesn't require a push.
+//
+//	range. This is the only path in which the transaction is going to be
+//	in non-pending state and doesn't require a push.
 func (s *Store) resolveWriteIntentError(ctx context.Context, wiErr *roachpb.WriteIntentError, rng *Replica, args roachpb.Request, h roachpb.Header, pushType roachpb.PushTxnType) error {
 	method := args.Method()
 	pusherTxn := h.Txn
@@ -1359,16 +2028,15 @@ func (s *Store) resolveWriteIntentError(ctx context.Context, wiErr *roachpb.Writ
 	trace := tracer.FromCtx(ctx)
 	defer trace.Epoch("intent resolution")()
 
-	// Split intents into those we need to push and those which are good to
-	// resolve.
+	// Split intents into those we need to push, those which are good to
+	// resolve and those exceeding the push limit.
 	// TODO(tschottdorf): can optimize this and use same underlying slice.
-	var pushIntents, resolveIntents []roachpb.Intent
-	for _, intent := range wiErr.Intents {
-		// The current intent does not need conflict resolution.
-		if intent.Txn.Status != roachpb.PENDING {
-			resolveIntents = append(resolveIntents, intent)
-		} else {
-			pushIntents = append(pushIntents, intent)
+	pushIntents, resolveIntents, deferredIntents := partitionIntents(wiErr.Intents, s.ctx.IntentPushLimit)
+	if len(deferredIntents) > 0 {
+		s.metrics.Counter("intents.push.storms").Inc(1)
+		s.metrics.Counter("intents.push.deferred").Inc(int64(len(deferredIntents)))
+		if log.V(1) {
+			log.Infoc(ctx, "on %s: deferring push of %d intents", method, len(deferredIntents))
 		}
 	}
 
@@ -1431,32 +2099,32 @@ func (s *Store) resolveWriteIntentError(ctx context.Context, wiErr *roachpb.Writ
 
 	rng.resolveIntents(ctx, resolveIntents)
 
+	if len(deferredIntents) > 0 {
+		// Hand the remainder back so that the client backs off before
+		// retrying, at which point the next batch of intents is pushed.
+		wiErr.Intents = deferredIntents
+		wiErr.Resolved = false
+	}
 	return wiErr
 }
 
-type proposeOp struct {
-	idKey cmdIDKey
-	cmd   roachpb.RaftCommand
-	ch    chan<- <-chan error
-}
-
 // ProposeRaftCommand submits a command to raft. The command is processed
 // asynchronously and an error or nil will be written to the returned
 // channel when it is committed or aborted (but note that committed does
 // mean that it has been applied to the range yet).
 func (s *Store) ProposeRaftCommand(idKey cmdIDKey, cmd roachpb.RaftCommand) <-chan error {
-	ch := make(chan (<-chan error))
-	s.proposeChan <- proposeOp{idKey, cmd, ch}
-	return <-ch
+	start := time.Now()
+	s.processRaftMu.RLock()
+	defer s.processRaftMu.RUnlock()
+	s.metrics.Histogram("raft.latency.queue").RecordValue(time.Since(start).Nanoseconds())
+	return s.proposeRaftCommandImpl(idKey, cmd)
 }
 
-// proposeRaftCommandImpl runs on the processRaft goroutine.
+// proposeRaftCommandImpl requires that processRaftMu is held, which keeps
+// the replica from being removed while its group is lazily created.
 func (s *Store) proposeRaftCommandImpl(idKey cmdIDKey, cmd roachpb.RaftCommand) <-chan error {
 	// If the range has been removed since the proposal started, drop it now.
-	s.mu.RLock()
-	_, ok := s.replicas[cmd.RangeID]
-	s.mu.RUnlock()
-	if !ok {
+	if _, ok := s.replicas.get(cmd.RangeID); !ok {
 		ch := make(chan error, 1)
 		ch <- roachpb.NewRangeNotFoundError(cmd.RangeID)
 		return ch
@@ -1472,6 +2140,17 @@ func (s *Store) proposeRaftCommandImpl(idKey cmdIDKey, cmd roachpb.RaftCommand)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if max := s.ctx.MaxCommandSize; max > 0 && int64(len(data)) > max {
+		s.metrics.Counter("raft.proposals.oversized").Inc(1)
+		ch := make(chan error, 1)
+		ch <- &roachpb.CommandTooLargeError{
+			RangeID:        cmd.RangeID,
+			CommandSize:    int64(len(data)),
+			MaxCommandSize: max,
+		}
+		return ch
+	}
+	s.metrics.Counter("raft.proposals").Inc(1)
 	for _, union := range cmd.Cmd.Requests {
 		args := union.GetInner()
 		etr, ok := args.(*roachpb.EndTransactionRequest)
@@ -1505,6 +2184,18 @@ func (s *Store) processRaft() {
 		for {
 			select {
 			case events := <-s.multiraft.Events:
+				s.processRaftMu.Lock()
+				// Consecutive committed commands for the same range are
+				// collected and applied together.
+				var run []committedCommand
+				var runReplica *Replica
+				flushRun := func() {
+					if len(run) > 0 {
+						runReplica.processRaftCommands(run)
+					}
+					run, runReplica = nil, nil
+				}
+
 				for _, e := range events {
 					var cmd roachpb.RaftCommand
 					var groupID roachpb.RangeID
@@ -1514,6 +2205,7 @@ func (s *Store) processRaft() {
 
 					switch e := e.(type) {
 					case *multiraft.EventCommandCommitted:
+						s.metrics.Counter("raft.commands.committed").Inc(1)
 						groupID = e.GroupID
 						commandID = e.CommandID
 						index = e.Index
@@ -1538,6 +2230,18 @@ func (s *Store) processRaft() {
 							log.Infof("store %s: new committed membership change at index %d", s, e.Index)
 						}
 
+					case *multiraft.EventReplicaPaused:
+						s.handleReplicaPaused(e)
+						continue
+
+					case *multiraft.EventSnapshotRejected:
+						s.handleSnapshotRejected(e)
+						continue
+
+					case *multiraft.EventSnapshotSent:
+						s.handleSnapshotSent(e)
+						continue
+
 					default:
 						continue
 					}
@@ -1546,9 +2250,19 @@ func (s *Store) processRaft() {
 						log.Fatalf("e.GroupID (%d) should == cmd.RangeID (%d)", groupID, cmd.RangeID)
 					}
 
-					s.mu.RLock()
-					r, ok := s.replicas[groupID]
-					s.mu.RUnlock()
+					// Apply the pending run before looking up the replica if this
+					// command can't join it; the run may add or remove replicas.
+					if len(run) > 0 && (callback != nil || run[0].cmd.RangeID != groupID) {
+						flushRun()
+					}
+					r, ok := s.replicas.get(groupID)
+					if ok && callback == nil {
+						run = append(run, committedCommand{idKey: cmdIDKey(commandID), index: index, cmd: cmd})
+						runReplica = r
+						continue
+					}
+					flushRun()
+
 					var err error
 					if !ok {
 						err = util.Errorf("got committed raft command for %d but have no range with that ID: %+v",
@@ -1561,12 +2275,8 @@ func (s *Store) processRaft() {
 						callback(err)
 					}
 				}
-
-			case op := <-s.removeReplicaChan:
-				op.ch <- s.removeReplicaImpl(op.rep)
-
-			case op := <-s.proposeChan:
-				op.ch <- s.proposeRaftCommandImpl(op.idKey, op.cmd)
+				flushRun()
+				s.processRaftMu.Unlock()
 
 			case <-s.stopper.ShouldStop():
 				return
@@ -1579,8 +2289,12 @@ func (s *Store) processRaft() {
 func (s *Store) GroupStorage(groupID roachpb.RangeID, replicaID roachpb.ReplicaID) (multiraft.WriteableGroupStorage, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	r, ok := s.replicas[groupID]
+	r, ok := s.replicas.get(groupID)
 	if !ok {
+		// Don't recreate a quarantined replica on top of its data.
+		if err, ok := s.quarantined[groupID]; ok {
+			return nil, util.Errorf("range %d is quarantined: %s", groupID, err)
+		}
 		// Before creating the group, see if there is a tombstone which
 		// would indicate that this is a stale message.
 		tombstoneKey := keys.RaftTombstoneKey(groupID)
@@ -1592,6 +2306,18 @@ func (s *Store) GroupStorage(groupID roachpb.RangeID, replicaID roachpb.ReplicaI
 				return nil, multiraft.ErrGroupDeleted
 			}
 		}
+		// A new replica of a range whose previous replica is pending GC
+		// supersedes the latter, whose data is destroyed.
+		if info, ok := s.pendingGC[groupID]; ok {
+			if replicaID == 0 {
+				return nil, util.Errorf("range %d has a replica pending GC", groupID)
+			}
+			if err := destroyReplicaData(s.engine, &info.Desc); err != nil {
+				return nil, err
+			}
+			delete(s.pendingGC, groupID)
+			s.metrics.Counter("replicas.pending-gc.destroyed").Inc(1)
+		}
 
 		var err error
 		r, err = NewReplica(&roachpb.RangeDescriptor{
@@ -1609,10 +2335,26 @@ func (s *Store) GroupStorage(groupID roachpb.RangeID, replicaID roachpb.ReplicaI
 			return nil, err
 		}
 		s.uninitReplicas[r.Desc().RangeID] = r
+		s.uninitInfo[r.Desc().RangeID] = &UninitializedReplicaInfo{
+			RangeID: groupID,
+			Created: time.Now(),
+		}
 	}
 	return r, nil
 }
 
+// NewAppendBatch implements the multiraft.GroupCommitStorage interface.
+// It returns nil unless group commit is enabled in the StoreContext.
+func (s *Store) NewAppendBatch() multiraft.AppendBatch {
+	if !s.ctx.RaftGroupCommit {
+		return nil
+	}
+	return &appendBatch{
+		batch:     s.engine.NewBatch(),
+		lastIndex: map[*Replica]uint64{},
+	}
+}
+
 // ReplicaDescriptor implements the multiraft.Storage interface.
 func (s *Store) ReplicaDescriptor(groupID roachpb.RangeID, replicaID roachpb.ReplicaID) (roachpb.ReplicaDescriptor, error) {
 	rep, err := s.GetReplica(groupID)
@@ -1652,38 +2394,103 @@ func (s *Store) GroupLocker() sync.Locker {
 }
 
 // CanApplySnapshot implements the multiraft.Storage interface.
-func (s *Store) CanApplySnapshot(rangeID roachpb.RangeID, snap raftpb.Snapshot) bool {
+func (s *Store) CanApplySnapshot(rangeID roachpb.RangeID, snap raftpb.Snapshot) *multiraft.SnapshotRejection {
+	rejection := s.canApplySnapshot(rangeID, snap)
+	s.noteUninitializedSnapshot(rangeID, rejection)
+	return rejection
+}
+
+func (s *Store) canApplySnapshot(rangeID roachpb.RangeID, snap raftpb.Snapshot) *multiraft.SnapshotRejection {
+	// TODO(bdarnell): can we avoid parsing this twice?
+	var parsedSnap roachpb.RaftSnapshotData
+	if err := parsedSnap.Unmarshal(snap.Data); err != nil {
+		s.metrics.Counter("raft.snapshots.rejected").Inc(1)
+		return &multiraft.SnapshotRejection{
+			Reason: multiraft.SNAPSHOT_INVALID,
+			Detail: err.Error(),
+		}
+	}
+	desc := &parsedSnap.RangeDescriptor
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	if r, ok := s.replicas[rangeID]; ok && r.isInitialized() {
+	// The data of replicas pending GC is still on the store, so their spans
+	// must not be overlapped, not even by the snapshot of an initialized
+	// replica whose span grew.
+	if err := s.checkPendingGCOverlapLocked(desc); err != nil {
+		s.metrics.Counter("raft.snapshots.rejected").Inc(1)
+		return &multiraft.SnapshotRejection{
+			Reason:             multiraft.SNAPSHOT_AWAITING_GC,
+			ConflictingRangeID: err.pending.RangeID,
+			Detail:             err.Error(),
+		}
+	}
+
+	if r, ok := s.replicas.get(rangeID); ok && r.isInitialized() {
 		// We have the range and it's initialized, so let the snapshot
 		// through.
-		return true
+		return nil
 	}
 
 	// We don't have the range (or we have an uninitialized
 	// placeholder). Will we be able to create/initialize it?
-	// TODO(bdarnell): can we avoid parsing this twice?
-	var parsedSnap roachpb.RaftSnapshotData
-	if err := parsedSnap.Unmarshal(snap.Data); err != nil {
-		return false
-	}
 
-	if s.replicasByKey.Has(rangeBTreeKey(parsedSnap.RangeDescriptor.EndKey)) {
-		// We have a conflicting range, so we must block the snapshot.
-		// When such a conflict exists, it will be resolved by one range
-		// either being split or garbage collected.
-		return false
+	// Find the first replica whose span ends after the start of the
+	// snapshot's span. If it starts before the end of the snapshot's
+	// span, the two overlap, so we must block the snapshot. When such a
+	// conflict exists, it will be resolved by one range either being
+	// split or garbage collected.
+	conflict := s.replicasByKey.lookup(desc.StartKey)
+	if conflict == nil {
+		return nil
+	}
+	conflictDesc := conflict.Desc()
+	if !conflictDesc.StartKey.Less(desc.EndKey) {
+		return nil
+	}
+	rejection := &multiraft.SnapshotRejection{
+		Reason:             multiraft.SNAPSHOT_OVERLAPPING_RANGE,
+		ConflictingRangeID: conflictDesc.RangeID,
+		Detail: fmt.Sprintf("snapshot of range %d [%s,%s) overlaps range %d [%s,%s)",
+			desc.RangeID, desc.StartKey, desc.EndKey,
+			conflictDesc.RangeID, conflictDesc.StartKey, conflictDesc.EndKey),
 	}
+	if _, repDesc := conflictDesc.FindReplica(s.StoreID()); repDesc == nil {
+		// The conflicting replica is no longer a member of its range and
+		// is waiting to be garbage collected.
+		rejection.Reason = multiraft.SNAPSHOT_AWAITING_GC
+	}
+	s.metrics.Counter("raft.snapshots.rejected").Inc(1)
+	return rejection
+}
+
+// handleSnapshotRejected processes a multiraft.EventSnapshotRejected.
+func (s *Store) handleSnapshotRejected(e *multiraft.EventSnapshotRejected) {
+	s.metrics.Counter("raft.snapshots.rejected-by-peer").Inc(1)
+	log.Infof("store %s: replica %s of range %d rejected snapshot: %s",
+		s, e.Replica, e.GroupID, &e.Rejection)
+}
 
-	return true
+// handleSnapshotSent processes a multiraft.EventSnapshotSent. Snapshots
+// should be the exception: a snapshot sent to a follower which was only a
+// few entries behind indicates that the raft log was truncated too
+// aggressively, and is surfaced as such.
+func (s *Store) handleSnapshotSent(e *multiraft.EventSnapshotSent) {
+	s.metrics.Counter("raft.snapshots.sent").Inc(1)
+	s.metrics.Counter("raft.snapshots.sent-bytes").Inc(int64(e.Size))
+	if e.Match > 0 && e.Index > e.Match && e.Index-e.Match <= raftLogCatchUpEntries {
+		s.metrics.Counter("raft.snapshots.avoidable").Inc(1)
+		log.Warningf("store %s: sent snapshot of %d bytes at index %d to replica %s of range %d "+
+			"which was only %d entries behind", s, e.Size, e.Index, e.Replica, e.GroupID, e.Index-e.Match)
+	} else if log.V(1) {
+		log.Infof("store %s: sent snapshot of %d bytes at index %d to replica %s of range %d",
+			s, e.Size, e.Index, e.Replica, e.GroupID)
+	}
 }
 
 // AppliedIndex implements the multiraft.StateMachine interface.
 func (s *Store) AppliedIndex(groupID roachpb.RangeID) (uint64, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	r, ok := s.replicas[groupID]
+	r, ok := s.replicas.get(groupID)
 	if !ok {
 		return 0, util.Errorf("range %d not found", groupID)
 	}
@@ -1737,17 +2544,19 @@ func (s *Store) computeReplicationStatus(now int64) (
 	}
 
 	timestamp := roachpb.Timestamp{WallTime: now}
+	var pendingRangeCount int64
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	for rangeID, rng := range s.replicas {
-		zoneConfig, err := cfg.GetZoneConfigForKey(rng.Desc().StartKey)
+	s.replicas.visit(func(rangeID roachpb.RangeID, rng *Replica) bool {
+		desc := rng.Desc()
+		zoneConfig, err := cfg.GetZoneConfigForKey(desc.StartKey)
 		if err != nil {
 			log.Error(err)
-			continue
+			return true
 		}
 		raftStatus := s.RaftStatus(rangeID)
 		if raftStatus == nil {
-			continue
+			return true
 		}
 		if raftStatus.SoftState.RaftState == raft.StateLeader {
 			leaderRangeCount++
@@ -1757,9 +2566,14 @@ func (s *Store) computeReplicationStatus(now int64) (
 			if len(raftStatus.Progress) >= len(zoneConfig.ReplicaAttrs) {
 				replicatedRangeCount++
 			}
+			// Track ranges which have yet to converge on the replica count
+			// of their zone, e.g. after the zone config was changed.
+			if len(desc.Replicas) != len(zoneConfig.ReplicaAttrs) {
+				pendingRangeCount++
+			}
 
 			// If any replica holds the leader lease, the range is available.
-			if rng.getLease().Covers(timestamp) {
+			if rng.leaseCovers(rng.getLease(), timestamp) {
 				availableRangeCount++
 			} else {
 				// If there is no leader lease, then as long as more than 50%
@@ -1777,7 +2591,32 @@ func (s *Store) computeReplicationStatus(now int64) (
 				}
 			}
 		}
-	}
+		return true
+	})
+	s.metrics.Gauge("ranges.replication-pending").Update(pendingRangeCount)
+	return
+}
+
+// computeVerificationStatus returns the least recent time at which any of
+// the store's replicas was verified and the number of replicas whose most
+// recent verification failed.
+func (s *Store) computeVerificationStatus() (oldest roachpb.Timestamp, failed int32) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.replicas.visit(func(_ roachpb.RangeID, rng *Replica) bool {
+		status, err := rng.VerificationStatus()
+		if err != nil {
+			log.Warningf("%s: unable to fetch verification status: %s", rng, err)
+			return true
+		}
+		if oldest == roachpb.ZeroTimestamp || status.Timestamp.Less(oldest) {
+			oldest = status.Timestamp
+		}
+		if status.Err != nil {
+			failed++
+		}
+		return true
+	})
 	return
 }
 
@@ -1797,6 +2636,22 @@ func (s *Store) PublishStatus() error {
 	leaderRangeCount, replicatedRangeCount, availableRangeCount :=
 		s.computeReplicationStatus(now)
 	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount)
+
+	// broadcast verification status.
+	oldestVerified, failedVerifications := s.computeVerificationStatus()
+	s.feed.verificationStatus(oldestVerified.WallTime, failedVerifications)
+	if oldestVerified != roachpb.ZeroTimestamp {
+		s.metrics.Gauge("ranges.unverified-age").Update(now - oldestVerified.WallTime)
+	}
+
+	// update gauges which are only sampled periodically.
+	s.mu.RLock()
+	s.metrics.Gauge("replicas").Update(int64(s.replicas.len()))
+	s.metrics.Gauge("replicas.uninitialized").Update(int64(len(s.uninitReplicas)))
+	s.metrics.Gauge("replicas.uninitialized.oldest-age").Update(int64(s.oldestUninitializedAgeLocked()))
+	s.metrics.Gauge("replicas.quarantined").Update(int64(len(s.quarantined)))
+	s.metrics.Gauge("replicas.pending-gc").Update(int64(len(s.pendingGC)))
+	s.mu.RUnlock()
 	return nil
 }
 
@@ -0,0 +1,105 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// updateNearlyFull records whether the store is nearly full according to
+// the given capacity and the configured alert threshold, and returns the
+// result.
+func (s *Store) updateNearlyFull(capacity roachpb.StoreCapacity) bool {
+	threshold := s.ctx.CapacityAlertThreshold
+	full := threshold > 0 && capacity.FractionUsed() >= threshold
+	var v int32
+	if full {
+		v = 1
+	}
+	if old := atomic.SwapInt32(&s.nearlyFull, v); old != v {
+		if full {
+			log.Warningf("store %s is nearly full (%.1f%% used); refusing new replicas and writes to non-system ranges",
+				s, capacity.FractionUsed()*100)
+		} else {
+			log.Infof("store %s is no longer nearly full (%.1f%% used)", s, capacity.FractionUsed()*100)
+		}
+	}
+	s.metrics.Gauge("capacity.nearly-full").Update(int64(v))
+	return full
+}
+
+// isNearlyFull returns whether the store was found nearly full the last
+// time its capacity was computed.
+func (s *Store) isNearlyFull() bool {
+	return atomic.LoadInt32(&s.nearlyFull) == 1
+}
+
+// refuseWriteWhenNearlyFull returns an error if the batch adds data to a
+// non-system range and the store of any of the range's replicas is nearly
+// full: the store's own state is known locally, that of the other replicas'
+// stores is taken from their gossiped descriptors. Writes which don't add
+// data, like deletions and intent resolution, are let through so that space
+// can be reclaimed.
+func (s *Store) refuseWriteWhenNearlyFull(rng *Replica, ba roachpb.BatchRequest) error {
+	if !ba.IsWrite() {
+		return nil
+	}
+	desc := rng.Desc()
+	if desc.StartKey.Less(keys.Addr(keys.UserTableDataMin)) {
+		return nil
+	}
+	addsData := false
+	for _, union := range ba.Requests {
+		switch union.GetInner().(type) {
+		case *roachpb.PutRequest, *roachpb.ConditionalPutRequest,
+			*roachpb.IncrementRequest, *roachpb.MergeRequest:
+			addsData = true
+		}
+	}
+	if !addsData {
+		return nil
+	}
+	if storeID, ok := s.nearlyFullReplicaStore(desc); ok {
+		s.metrics.Counter("capacity.writes.refused").Inc(1)
+		return &roachpb.StoreNearlyFullError{StoreID: storeID}
+	}
+	return nil
+}
+
+// nearlyFullReplicaStore returns the ID of a nearly full store holding a
+// replica of the range, if any.
+func (s *Store) nearlyFullReplicaStore(desc *roachpb.RangeDescriptor) (roachpb.StoreID, bool) {
+	for _, rep := range desc.Replicas {
+		if rep.StoreID == s.StoreID() {
+			if s.isNearlyFull() {
+				return rep.StoreID, true
+			}
+			continue
+		}
+		if s.ctx.StorePool == nil {
+			continue
+		}
+		if storeDesc := s.ctx.StorePool.getStoreDescriptor(rep.StoreID); storeDesc != nil &&
+			storeDesc.Capacity.NearlyFull {
+			return rep.StoreID, true
+		}
+	}
+	return 0, false
+}
@@ -300,7 +300,8 @@ func (sl *StoreList) add(s *roachpb.StoreDescriptor) {
 }
 
 // GetStoreList returns a storeList that contains all active stores that
-// contain the required attributes and their associated stats.
+// contain the required attributes and their associated stats. Stores which
+// refuse new replicas are omitted.
 // TODO(embark, spencer): consider using a reverse index map from
 // Attr->stores, for efficiency. Ensure that entries in this map still
 // have an opportunity to be garbage collected.
@@ -320,7 +321,8 @@ func (sp *StorePool) getStoreList(required roachpb.Attributes, deterministic boo
 	sl := StoreList{}
 	for _, storeID := range storeIDs {
 		detail := sp.stores[roachpb.StoreID(storeID)]
-		if !detail.dead && required.IsSubset(*detail.desc.CombinedAttrs()) {
+		if !detail.dead && !detail.desc.Capacity.RefusesReplicas() &&
+			required.IsSubset(*detail.desc.CombinedAttrs()) {
 			desc := detail.desc
 			sl.add(&desc)
 		}
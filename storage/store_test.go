@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"reflect"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -46,6 +47,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/retry"
 	"github.com/cockroachdb/cockroach/util/stop"
 	"github.com/cockroachdb/cockroach/util/uuid"
+	"github.com/coreos/etcd/raft/raftpb"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -127,11 +129,17 @@ func (db *testSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roach
 // clock's manual unix nanos time and a stopper. The caller is
 // responsible for stopping the stopper upon completion.
 func createTestStoreWithoutStart(t *testing.T) (*Store, *hlc.ManualClock, *stop.Stopper) {
+	return createTestStoreWithContextWithoutStart(t, TestStoreContext)
+}
+
+// createTestStoreWithContextWithoutStart is like createTestStoreWithoutStart
+// but creates the store from the given context, whose clock, gossip,
+// transport and DB are replaced.
+func createTestStoreWithContextWithoutStart(t *testing.T, ctx StoreContext) (*Store, *hlc.ManualClock, *stop.Stopper) {
 	stopper := stop.NewStopper()
 	// Setup fake zone config handler.
 	config.TestingSetupZoneConfigHook(stopper)
 	rpcContext := rpc.NewContext(&base.Context{}, hlc.NewClock(hlc.UnixNano), stopper)
-	ctx := TestStoreContext
 	ctx.Gossip = gossip.New(rpcContext, gossip.TestInterval, gossip.TestBootstrap)
 	ctx.StorePool = NewStorePool(ctx.Gossip, TestTimeUntilStoreDeadOff, stopper)
 	manual := hlc.NewManualClock(0)
@@ -146,7 +154,7 @@ func createTestStoreWithoutStart(t *testing.T) (*Store, *hlc.ManualClock, *stop.
 	if err := store.Bootstrap(roachpb.StoreIdent{NodeID: 1, StoreID: 1}, stopper); err != nil {
 		t.Fatal(err)
 	}
-	if err := store.BootstrapRange(nil); err != nil {
+	if err := store.BootstrapRange(nil, nil); err != nil {
 		t.Fatal(err)
 	}
 	return store, manual, stopper
@@ -157,7 +165,13 @@ func createTestStoreWithoutStart(t *testing.T) (*Store, *hlc.ManualClock, *stop.
 // and a stopper. The caller is responsible for stopping the stopper
 // upon completion.
 func createTestStore(t *testing.T) (*Store, *hlc.ManualClock, *stop.Stopper) {
-	store, manual, stopper := createTestStoreWithoutStart(t)
+	return createTestStoreWithContext(t, TestStoreContext)
+}
+
+// createTestStoreWithContext is like createTestStore but creates the store
+// from the given context.
+func createTestStoreWithContext(t *testing.T, ctx StoreContext) (*Store, *hlc.ManualClock, *stop.Stopper) {
+	store, manual, stopper := createTestStoreWithContextWithoutStart(t, ctx)
 	// Put an empty system config into gossip.
 	if err := store.Gossip().AddInfoProto(gossip.KeySystemConfig,
 		&config.SystemConfig{}, 0); err != nil {
@@ -170,6 +184,22 @@ func createTestStore(t *testing.T) (*Store, *hlc.ManualClock, *stop.Stopper) {
 	return store, manual, stopper
 }
 
+// TestStoreRaftJitterDisabled verifies that a store starts with the
+// election timeout jitter and the tick spread disabled.
+func TestStoreRaftJitterDisabled(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := TestStoreContext
+	ctx.RaftElectionTimeoutJitterTicks = -1
+	ctx.RaftTickSpread = -1
+	store, _, stopper := createTestStoreWithContext(t, ctx)
+	defer stopper.Stop()
+
+	pArgs := putArgs([]byte("a"), []byte("value"))
+	if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestStoreInitAndBootstrap verifies store initialization and bootstrap.
 func TestStoreInitAndBootstrap(t *testing.T) {
 	defer leaktest.AfterTest(t)
@@ -199,7 +229,7 @@ func TestStoreInitAndBootstrap(t *testing.T) {
 	}
 
 	// Bootstrap first range.
-	if err := store.BootstrapRange(nil); err != nil {
+	if err := store.BootstrapRange(nil, nil); err != nil {
 		t.Errorf("failure to create first range: %s", err)
 	}
 
@@ -214,6 +244,73 @@ func TestStoreInitAndBootstrap(t *testing.T) {
 	}
 }
 
+// TestStoreBootstrapRangeSplits verifies that bootstrapping with split
+// keys creates a range, along with its addressing record, for each span
+// between consecutive split keys, and that invalid split keys are rejected.
+func TestStoreBootstrapRangeSplits(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := TestStoreContext
+	manual := hlc.NewManualClock(0)
+	ctx.Clock = hlc.NewClock(manual.UnixNano)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	ctx.Transport = multiraft.NewLocalRPCTransport(stopper)
+	stopper.AddCloser(ctx.Transport)
+	store := NewStore(ctx, eng, &roachpb.NodeDescriptor{NodeID: 1})
+	if err := store.Bootstrap(testIdent, stopper); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, splits := range [][]roachpb.RKey{
+		{roachpb.RKey("b"), roachpb.RKey("a")},
+		{roachpb.RKey("a"), roachpb.RKey("a")},
+		{roachpb.RKey(keys.Meta2Prefix)},
+		{roachpb.RKey(keys.MakeTablePrefix(keys.MaxReservedDescID))},
+	} {
+		if err := store.BootstrapRange(nil, splits); err == nil {
+			t.Errorf("%s: expected bootstrap to fail", splits)
+		}
+	}
+
+	splits := []roachpb.RKey{roachpb.RKey("a"), roachpb.RKey("b")}
+	if err := store.BootstrapRange(nil, splits); err != nil {
+		t.Fatal(err)
+	}
+	store = NewStore(ctx, eng, &roachpb.NodeDescriptor{NodeID: 1})
+	if err := store.Start(stopper); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		startKey, endKey roachpb.RKey
+	}{
+		{roachpb.RKeyMin, roachpb.RKey("a")},
+		{roachpb.RKey("a"), roachpb.RKey("b")},
+		{roachpb.RKey("b"), roachpb.RKeyMax},
+	}
+	for i, e := range expected {
+		rng, err := store.GetReplica(roachpb.RangeID(i + 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc := rng.Desc()
+		if !desc.StartKey.Equal(e.startKey) || !desc.EndKey.Equal(e.endKey) {
+			t.Errorf("%d: expected range %s-%s; got %s-%s", i, e.startKey, e.endKey, desc.StartKey, desc.EndKey)
+		}
+		var metaDesc roachpb.RangeDescriptor
+		if ok, err := engine.MVCCGetProto(eng, keys.RangeMetaKey(e.endKey), roachpb.MaxTimestamp, true, nil, &metaDesc); err != nil || !ok {
+			t.Fatalf("%d: failed to read meta2 record: %t, %v", i, ok, err)
+		}
+		if metaDesc.RangeID != desc.RangeID {
+			t.Errorf("%d: expected meta2 record for range %d; got %d", i, desc.RangeID, metaDesc.RangeID)
+		}
+	}
+	if _, err := store.GetReplica(roachpb.RangeID(len(expected) + 1)); err == nil {
+		t.Error("expected no further ranges")
+	}
+}
+
 // TestBootstrapOfNonEmptyStore verifies bootstrap failure if engine
 // is not empty.
 func TestBootstrapOfNonEmptyStore(t *testing.T) {
@@ -244,6 +341,134 @@ func TestBootstrapOfNonEmptyStore(t *testing.T) {
 	}
 }
 
+// TestStoreReplicaDescriptorIndex verifies that bootstrapping a range
+// indexes its descriptor and that a store falls back to scanning range
+// descriptors if the index has diverged from them.
+func TestStoreReplicaDescriptorIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStoreWithoutStart(t)
+	defer stopper.Stop()
+
+	descs, err := loadReplicaDescriptorIndex(store.Engine())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(descs) != 1 || descs[0].RangeID != 1 {
+		t.Fatalf("expected index to contain only range 1; got %+v", descs)
+	}
+	expected := descs[0]
+	now := store.Clock().Now()
+	if ok, err := verifyReplicaDescriptorIndex(store.Engine(), descs, now); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected index to pass verification")
+	}
+
+	// Overlapping entries must fail verification.
+	overlapping := []roachpb.RangeDescriptor{expected, expected}
+	if ok, err := verifyReplicaDescriptorIndex(store.Engine(), overlapping, now); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected overlapping index entries to fail verification")
+	}
+
+	// An entry which isn't keyed by its descriptor's Range ID must fail
+	// verification.
+	if err := deleteReplicaDescriptorIndex(store.Engine(), expected.RangeID); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.MVCCPutProto(store.Engine(), nil, keys.StoreReplicaDescriptorKey(3),
+		roachpb.ZeroTimestamp, nil, &expected); err != nil {
+		t.Fatal(err)
+	}
+	if descs, err = loadReplicaDescriptorIndex(store.Engine()); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := verifyReplicaDescriptorIndex(store.Engine(), descs, now); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected misplaced index entry to fail verification")
+	}
+	if err := deleteReplicaDescriptorIndex(store.Engine(), 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := putReplicaDescriptorIndex(store.Engine(), &expected); err != nil {
+		t.Fatal(err)
+	}
+
+	// Index a bogus replica; it must not be loaded.
+	bogus := roachpb.RangeDescriptor{
+		RangeID:  2,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("b"),
+	}
+	if err := putReplicaDescriptorIndex(store.Engine(), &bogus); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := verifyReplicaDescriptorIndex(store.Engine(), []roachpb.RangeDescriptor{bogus}, now); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected bogus index entry to fail verification")
+	}
+
+	descs, err = store.loadReplicaDescriptors(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(descs) != 1 || !proto.Equal(&descs[0], &expected) {
+		t.Fatalf("expected only %+v; got %+v", expected, descs)
+	}
+	// The index should have been rebuilt.
+	descs, err = loadReplicaDescriptorIndex(store.Engine())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(descs) != 1 || !proto.Equal(&descs[0], &expected) {
+		t.Fatalf("expected rebuilt index to contain only %+v; got %+v", expected, descs)
+	}
+}
+
+// TestStoreVerifyIntegrity verifies that a store started with the
+// integrity check quarantines replicas with inconsistent raft state.
+func TestStoreVerifyIntegrity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStoreWithoutStart(t)
+	defer stopper.Stop()
+
+	if err := store.Engine().VerifyChecksums(); err != nil {
+		t.Fatal(err)
+	}
+	rng, err := NewReplica(testRangeDescriptor(), store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.verifyRaftState(); err != nil {
+		t.Fatalf("expected the bootstrapped range to be consistent: %s", err)
+	}
+
+	// Move the applied index past the end of the raft log.
+	if err := setAppliedIndex(store.Engine(), 1, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.verifyRaftState(); !testutils.IsError(err, "applied index 1000 exceeds last index") {
+		t.Fatalf("expected inconsistent raft state; got %v", err)
+	}
+
+	store.ctx.VerifyIntegrity = true
+	if err := store.Start(stopper); err != nil {
+		t.Fatal(err)
+	}
+	if quarantined := store.QuarantinedReplicas(); len(quarantined) != 1 || quarantined[1] == nil {
+		t.Fatalf("expected range 1 to be quarantined; got %v", quarantined)
+	}
+	if _, err := store.GetReplica(1); err == nil {
+		t.Error("expected the quarantined replica not to be loaded")
+	}
+	if _, err := store.GroupStorage(1, 1); !testutils.IsError(err, "quarantined") {
+		t.Errorf("expected the quarantined replica not to be recreated; got %v", err)
+	}
+}
+
 func createRange(s *Store, rangeID roachpb.RangeID, start, end roachpb.RKey) *Replica {
 	desc := &roachpb.RangeDescriptor{
 		RangeID:  rangeID,
@@ -257,6 +482,34 @@ func createRange(s *Store, rangeID roachpb.RangeID, start, end roachpb.RKey) *Re
 	return r
 }
 
+// TestClusterNow verifies that ClusterNow returns a timestamp which is the
+// maximum offset ahead of the clock and that the clock is forwarded to it.
+func TestClusterNow(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	manual := hlc.NewManualClock(1000)
+	clock := hlc.NewClock(manual.UnixNano)
+	clock.SetMaxOffset(10 * time.Millisecond)
+	before := clock.Now()
+	ts := ClusterNow(clock)
+	if expected := before.Add(clock.MaxOffset().Nanoseconds(), 0); ts.Less(expected) {
+		t.Errorf("expected cluster now to be at least %s; got %s", expected, ts)
+	}
+	if now := clock.Now(); !ts.Less(now) {
+		t.Errorf("expected clock to be forwarded past %s; got %s", ts, now)
+	}
+
+	// A clock ahead of the physical time doesn't lengthen the wait.
+	ahead := roachpb.Timestamp{WallTime: manual.UnixNano() + time.Minute.Nanoseconds()}
+	clock.Update(ahead)
+	start := time.Now()
+	if ts := ClusterNow(clock); ts.Less(ahead) {
+		t.Errorf("expected cluster now to be at least %s; got %s", ahead, ts)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected to wait for the maximum offset only; waited %s", elapsed)
+	}
+}
+
 func TestStoreAddRemoveRanges(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	store, _, stopper := createTestStore(t)
@@ -468,6 +721,75 @@ func TestStoreExecuteNoop(t *testing.T) {
 	}
 }
 
+// TestStoreSendErrorOrigin verifies that errors returned by the store
+// carry their origin and the store's clock reading.
+func TestStoreSendErrorOrigin(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for _, test := range []struct {
+		rangeID      roachpb.RangeID
+		expReplicaID roachpb.ReplicaID
+	}{
+		// A missing range: only the node and store are known.
+		{999, 0},
+		// A failing request on an existing range.
+		{1, store.LookupReplica(roachpb.RKeyMin, nil).GetReplica().ReplicaID},
+	} {
+		ba := roachpb.BatchRequest{}
+		ba.RangeID = test.rangeID
+		ba.Replica = roachpb.ReplicaDescriptor{StoreID: store.StoreID()}
+		ba.Add(&roachpb.ConditionalPutRequest{
+			Span:     roachpb.Span{Key: roachpb.Key("a")},
+			Value:    roachpb.MakeValueFromString("value"),
+			ExpValue: &roachpb.Value{RawBytes: []byte("missing")},
+		})
+		ba.CmdID = roachpb.ClientCmdID{WallTime: 1, Random: 1}
+
+		before := store.ctx.Clock.Now()
+		_, pErr := store.Send(context.Background(), ba)
+		if pErr == nil {
+			t.Fatalf("%d: expected an error", test.rangeID)
+		}
+		expOrigin := roachpb.ReplicaDescriptor{
+			NodeID:    store.Ident.NodeID,
+			StoreID:   store.StoreID(),
+			ReplicaID: test.expReplicaID,
+		}
+		if pErr.OriginReplica == nil || *pErr.OriginReplica != expOrigin {
+			t.Errorf("%d: expected origin %s; got %v", test.rangeID, expOrigin, pErr.OriginReplica)
+		}
+		if pErr.Now == nil || pErr.Now.Less(before) {
+			t.Errorf("%d: expected clock reading after %s; got %v", test.rangeID, before, pErr.Now)
+		}
+	}
+}
+
+// TestStoreRaftLatencyMetrics verifies that writes record the breakdown of
+// their proposal to application latency in the store's metrics.
+func TestStoreRaftLatencyMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	pArgs := putArgs([]byte("a"), []byte("aaa"))
+	if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[string]int64{}
+	store.Registry().Each(func(name string, value int64) {
+		values[name] = value
+	})
+	for _, phase := range []string{"queue", "replication", "apply", "persist"} {
+		name := "raft.latency." + phase + ".p99"
+		if v, ok := values[name]; !ok || v <= 0 {
+			t.Errorf("expected positive %s, got %d (present: %t)", name, v, ok)
+		}
+	}
+}
+
 // TestStoreVerifyKeys checks that key length is enforced and
 // that end keys must sort >= start.
 func TestStoreVerifyKeys(t *testing.T) {
@@ -1537,3 +1859,378 @@ func TestMaybeRemove(t *testing.T) {
 		t.Errorf("Unexpected removed range %v", removedRng)
 	}
 }
+
+// TestStoreUninitializedReplicas verifies that the age and source of
+// uninitialized replicas are tracked and that they are removed unless the
+// meta records indicate that the store is a member of their range.
+func TestStoreUninitializedReplicas(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for _, rangeID := range []roachpb.RangeID{100, 101} {
+		if _, err := store.GroupStorage(rangeID, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	source := roachpb.ReplicaDescriptor{NodeID: 2, StoreID: 2, ReplicaID: 1}
+	store.GroupCreatedByMessage(100, source, raftpb.MsgHeartbeat)
+
+	infos := store.UninitializedReplicas()
+	if len(infos) != 2 || infos[0].RangeID != 100 || infos[1].RangeID != 101 {
+		t.Fatalf("expected uninitialized replicas of ranges 100 and 101, got %+v", infos)
+	}
+	if infos[0].Source != source || infos[0].SourceMessage != raftpb.MsgHeartbeat || infos[0].Created.IsZero() {
+		t.Errorf("expected replica created by heartbeat from %s, got %+v", source, infos[0])
+	}
+
+	// Range 101 includes the store.
+	desc := roachpb.RangeDescriptor{
+		RangeID:       101,
+		Replicas:      []roachpb.ReplicaDescriptor{source, {NodeID: 1, StoreID: 1, ReplicaID: 2}},
+		NextReplicaID: 3,
+	}
+	if err := store.DB().Put(keys.RangeMetaKey(roachpb.RKey("a")), &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replicas younger than the threshold are kept.
+	if removed, err := store.gcUninitializedReplicas(infos[0].Created); err != nil {
+		t.Fatal(err)
+	} else if removed != 0 {
+		t.Fatalf("expected no replicas to be removed, got %d", removed)
+	}
+	if removed, err := store.gcUninitializedReplicas(time.Now()); err != nil {
+		t.Fatal(err)
+	} else if removed != 1 {
+		t.Fatalf("expected 1 replica to be removed, got %d", removed)
+	}
+	if infos := store.UninitializedReplicas(); len(infos) != 1 || infos[0].RangeID != 101 {
+		t.Fatalf("expected uninitialized replica of range 101, got %+v", infos)
+	}
+	if _, err := store.GetReplica(100); err == nil {
+		t.Error("expected replica of range 100 to be removed")
+	}
+}
+
+// TestStoreGCOrphanedRaftState verifies that raft state of ranges which
+// have no replica on the store is detected and purged unless the meta
+// records indicate that the store is a member of the range.
+func TestStoreGCOrphanedRaftState(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for _, rangeID := range []roachpb.RangeID{100, 101, 102} {
+		if err := engine.MVCCPutProto(store.Engine(), nil, keys.RaftTruncatedStateKey(rangeID),
+			roachpb.ZeroTimestamp, nil, &roachpb.RaftTruncatedState{Index: 10, Term: 5}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Range 101 still includes the store, range 102 no longer does.
+	for i, desc := range []roachpb.RangeDescriptor{
+		{
+			RangeID:       101,
+			Replicas:      []roachpb.ReplicaDescriptor{{NodeID: 1, StoreID: 1, ReplicaID: 2}},
+			NextReplicaID: 3,
+		},
+		{
+			RangeID:       102,
+			Replicas:      []roachpb.ReplicaDescriptor{{NodeID: 2, StoreID: 2, ReplicaID: 1}},
+			NextReplicaID: 5,
+		},
+	} {
+		key := keys.RangeMetaKey(roachpb.RKey(fmt.Sprintf("a%d", i)))
+		if err := store.DB().Put(key, &desc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	orphans, err := store.findOrphanedRangeIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []roachpb.RangeID{100, 101, 102}; !reflect.DeepEqual(orphans, expected) {
+		t.Fatalf("expected orphaned ranges %v, got %v", expected, orphans)
+	}
+	if purged, err := store.gcOrphanedRaftState(orphans); err != nil {
+		t.Fatal(err)
+	} else if purged != 2 {
+		t.Fatalf("expected 2 ranges to be purged, got %d", purged)
+	}
+
+	orphans, err = store.findOrphanedRangeIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []roachpb.RangeID{101}; !reflect.DeepEqual(orphans, expected) {
+		t.Fatalf("expected orphaned ranges %v, got %v", expected, orphans)
+	}
+	for rangeID, expected := range map[roachpb.RangeID]bool{100: false, 101: true, 102: false} {
+		ok, err := engine.MVCCGetProto(store.Engine(), keys.RaftTruncatedStateKey(rangeID),
+			roachpb.ZeroTimestamp, true, nil, &roachpb.RaftTruncatedState{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != expected {
+			t.Errorf("range %d: expected raft state to exist: %t, found %t", rangeID, expected, ok)
+		}
+	}
+	var tombstone roachpb.RaftTombstone
+	if ok, err := engine.MVCCGetProto(store.Engine(), keys.RaftTombstoneKey(102),
+		roachpb.ZeroTimestamp, true, nil, &tombstone); err != nil {
+		t.Fatal(err)
+	} else if !ok || tombstone.NextReplicaID != 5 {
+		t.Errorf("expected tombstone with next replica ID 5, got %+v (found: %t)", tombstone, ok)
+	}
+}
+
+// TestStoreVerifyRange verifies that an on-demand verification scans
+// all of a range's data, and that a canceled verification can be
+// resumed where it left off.
+func TestStoreVerifyRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for i := 0; i < 2*verifyCancelCheckInterval; i++ {
+		key := roachpb.Key(fmt.Sprintf("verify-%05d", i))
+		if err := engine.MVCCPut(store.Engine(), nil, key, roachpb.ZeroTimestamp,
+			roachpb.MakeValueFromString("value"), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := store.VerifyRange(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done() || report.KeyCount < 2*verifyCancelCheckInterval {
+		t.Fatalf("expected complete verification of all keys, got %+v", report)
+	}
+	rng, err := store.GetReplica(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts, err := rng.GetLastVerificationTimestamp(); err != nil {
+		t.Fatal(err)
+	} else if ts.Equal(roachpb.ZeroTimestamp) {
+		t.Errorf("expected last verification timestamp to be set")
+	}
+	// Count the keys again, now that the verification timestamp exists.
+	report, err = store.VerifyRange(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := report.KeyCount
+
+	// A canceled verification stops at the next check and reports
+	// where it stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err = store.VerifyRange(ctx, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Done() || report.ResumeKey == nil || report.KeyCount != verifyCancelCheckInterval {
+		t.Fatalf("expected verification to be canceled after %d keys, got %+v",
+			verifyCancelCheckInterval, report)
+	}
+	partial := report.KeyCount
+	report, err = store.VerifyRange(context.Background(), 1, report.ResumeKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done() || partial+report.KeyCount != total {
+		t.Fatalf("expected resumed verification to scan remaining %d keys, got %+v",
+			total-partial, report)
+	}
+
+	if _, err := store.VerifyRange(context.Background(), 999, nil); err == nil {
+		t.Errorf("expected error verifying unknown range")
+	}
+}
+
+// TestStorePauseReplica verifies that a replica can be paused and
+// unpaused, that pauses expire at their deadline and that paused
+// followers reported to the leader are forgotten after a while.
+func TestStorePauseReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	if err := store.PauseReplica(1, 0); err == nil {
+		t.Error("expected error pausing for a zero duration")
+	}
+	if err := store.PauseReplica(2, time.Minute); err == nil {
+		t.Error("expected error pausing unknown range")
+	}
+
+	if err := store.PauseReplica(1, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if !store.ReplicaPaused(1) {
+		t.Fatal("expected replica to be paused")
+	}
+	if err := store.UnpauseReplica(1); err != nil {
+		t.Fatal(err)
+	}
+	if store.ReplicaPaused(1) {
+		t.Fatal("expected replica to be unpaused")
+	}
+
+	// A pause lifts itself at its deadline.
+	if err := store.PauseReplica(1, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if status, err := store.ReplicaPauseStatus(1); err != nil {
+		t.Fatal(err)
+	} else if !status.Paused() {
+		t.Errorf("expected paused status, got %+v", status)
+	}
+	manual.Increment(time.Minute.Nanoseconds())
+	if store.ReplicaPaused(1) {
+		t.Fatal("expected pause to have expired")
+	}
+
+	// Reports of paused followers are retained for a limited time.
+	store.handleReplicaPaused(&multiraft.EventReplicaPaused{
+		GroupID: 1,
+		Replica: roachpb.ReplicaDescriptor{NodeID: 2, StoreID: 2, ReplicaID: 2},
+	})
+	if status, err := store.ReplicaPauseStatus(1); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(status.PausedFollowers, []roachpb.ReplicaID{2}) {
+		t.Errorf("expected follower 2 to be reported paused, got %+v", status)
+	}
+	manual.Increment(store.pausedFollowerTTL().Nanoseconds() + 1)
+	if status, err := store.ReplicaPauseStatus(1); err != nil {
+		t.Fatal(err)
+	} else if len(status.PausedFollowers) != 0 {
+		t.Errorf("expected no paused followers, got %+v", status)
+	}
+}
+
+// TestStoreCanApplySnapshot verifies that snapshots of ranges which
+// overlap the replicas of the store are rejected, and why.
+func TestStoreCanApplySnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	makeSnap := func(desc roachpb.RangeDescriptor) raftpb.Snapshot {
+		data, err := proto.Marshal(&roachpb.RaftSnapshotData{RangeDescriptor: desc})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raftpb.Snapshot{Data: data}
+	}
+	snapDesc := roachpb.RangeDescriptor{
+		RangeID:  2,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("b"),
+	}
+
+	if rej := store.CanApplySnapshot(2, raftpb.Snapshot{Data: []byte("garbage")}); rej == nil {
+		t.Fatal("expected invalid snapshot to be rejected")
+	} else if rej.Reason != multiraft.SNAPSHOT_INVALID {
+		t.Errorf("expected %s; got %s", multiraft.SNAPSHOT_INVALID, rej.Reason)
+	}
+
+	// The snapshot overlaps range 1, which spans all keys.
+	if rej := store.CanApplySnapshot(2, makeSnap(snapDesc)); rej == nil {
+		t.Fatal("expected overlapping snapshot to be rejected")
+	} else if rej.Reason != multiraft.SNAPSHOT_OVERLAPPING_RANGE || rej.ConflictingRangeID != 1 {
+		t.Errorf("expected %s of range 1; got %s", multiraft.SNAPSHOT_OVERLAPPING_RANGE, rej)
+	}
+
+	// A snapshot of range 1 itself is applied over the existing replica.
+	if rej := store.CanApplySnapshot(1, makeSnap(*store.LookupReplica(roachpb.RKeyMin, nil).Desc())); rej != nil {
+		t.Errorf("expected snapshot of range 1 to be accepted; got %s", rej)
+	}
+
+	// Once the store is removed from range 1, its replica awaits GC.
+	rng := store.LookupReplica(roachpb.RKeyMin, nil)
+	desc := *rng.Desc()
+	desc.Replicas = nil
+	rng.setDescWithoutProcessUpdate(&desc)
+	if rej := store.CanApplySnapshot(2, makeSnap(snapDesc)); rej == nil {
+		t.Fatal("expected overlapping snapshot to be rejected")
+	} else if rej.Reason != multiraft.SNAPSHOT_AWAITING_GC {
+		t.Errorf("expected %s; got %s", multiraft.SNAPSHOT_AWAITING_GC, rej)
+	}
+
+	// Without any overlapping replica, the snapshot is accepted.
+	if err := store.RemoveReplica(rng); err != nil {
+		t.Fatal(err)
+	}
+	if rej := store.CanApplySnapshot(2, makeSnap(snapDesc)); rej != nil {
+		t.Errorf("expected snapshot to be accepted; got %s", rej)
+	}
+
+	// The data of a replica pending GC blocks snapshots overlapping it,
+	// including those of its own range.
+	pendingDesc := roachpb.RangeDescriptor{
+		RangeID:  3,
+		StartKey: roachpb.RKey("a0"),
+		EndKey:   roachpb.RKey("c"),
+	}
+	store.mu.Lock()
+	store.pendingGC[pendingDesc.RangeID] = &PendingGCReplicaInfo{Desc: pendingDesc}
+	store.mu.Unlock()
+	for _, d := range []roachpb.RangeDescriptor{snapDesc, pendingDesc} {
+		if rej := store.CanApplySnapshot(d.RangeID, makeSnap(d)); rej == nil {
+			t.Errorf("expected snapshot of range %d to be rejected", d.RangeID)
+		} else if rej.Reason != multiraft.SNAPSHOT_AWAITING_GC || rej.ConflictingRangeID != pendingDesc.RangeID {
+			t.Errorf("expected %s of range %d; got %s", multiraft.SNAPSHOT_AWAITING_GC, pendingDesc.RangeID, rej)
+		}
+	}
+}
+
+// TestStoreTestingKnobs verifies that the command filter of the store's
+// testing knobs can modify commands and inject errors, and that the split
+// queue can be disabled.
+func TestStoreTestingKnobs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := TestStoreContext
+	ctx.TestingKnobs.DisableSplitQueue = true
+	ctx.TestingKnobs.TestingCommandFilter = func(args CommandFilterArgs) error {
+		if args.StoreID != 1 || args.RangeID != 1 {
+			return util.Errorf("unexpected store %d and range %d", args.StoreID, args.RangeID)
+		}
+		if put, ok := args.Req.(*roachpb.PutRequest); ok {
+			switch string(put.Key) {
+			case "a":
+				put.Value.SetInt(2)
+			case "b":
+				return util.Errorf("injected error")
+			}
+		}
+		return nil
+	}
+	store, _, stopper := createTestStoreWithContext(t, ctx)
+	defer stopper.Stop()
+
+	if atomic.LoadInt32(&store.splitQueue.disabled) != 1 {
+		t.Error("expected split queue to be disabled")
+	}
+
+	pArgs := putArgs([]byte("a"), []byte("value"))
+	pArgs.Value.SetInt(1)
+	if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+	gArgs := getArgs([]byte("a"))
+	reply, err := client.SendWrapped(store.testSender(), nil, &gArgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := reply.(*roachpb.GetResponse).Value.GetInt(); err != nil || v != 2 {
+		t.Errorf("expected the filter to rewrite the value to 2; got %d (%v)", v, err)
+	}
+
+	pArgs = putArgs([]byte("b"), []byte("value"))
+	if _, err := client.SendWrapped(store.testSender(), nil, &pArgs); !testutils.IsError(err, "injected error") {
+		t.Errorf("expected injected error; got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import "github.com/cockroachdb/cockroach/roachpb"
+
+// CommandFilterArgs are the arguments passed to a command filter.
+type CommandFilterArgs struct {
+	StoreID roachpb.StoreID
+	RangeID roachpb.RangeID
+	Req     roachpb.Request
+	Hdr     roachpb.Header
+}
+
+// A CommandFilter intercepts a command before it is executed by a
+// replica. It may modify the request, which is executed as modified.
+// Returning an error terminates the processing of the command with that
+// error.
+type CommandFilter func(CommandFilterArgs) error
+
+// A SnapshotFilter is called with the range whose replica on the given
+// store is applying a snapshot. It may block to pause the application.
+type SnapshotFilter func(roachpb.StoreID, roachpb.RangeID)
+
+// StoreTestingKnobs allow tests to intercept the processing of a store at
+// points where rare interleavings are otherwise hard to provoke. The zero
+// value leaves the store's behavior untouched; the knobs must not be set
+// outside of tests.
+type StoreTestingKnobs struct {
+	// TestingCommandFilter is called before each command is executed. Note
+	// that in a multi-replica range the filter runs once for each replica
+	// applying the command and must produce consistent results each time.
+	TestingCommandFilter CommandFilter
+
+	// DisableSplitQueue disables the queue splitting ranges which exceed
+	// their maximum size or span multiple zone configs.
+	DisableSplitQueue bool
+
+	// BeforeSnapshotApply is called before a replica applies a snapshot,
+	// and AfterSnapshotApply after it successfully applied one.
+	BeforeSnapshotApply SnapshotFilter
+	AfterSnapshotApply  SnapshotFilter
+}
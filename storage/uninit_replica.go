@@ -0,0 +1,220 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// UninitializedReplicaInfo describes an uninitialized replica, i.e. one
+// which was created in response to a raft message and hasn't received a
+// snapshot of its range yet.
+type UninitializedReplicaInfo struct {
+	RangeID roachpb.RangeID
+	// Created is the time at which the replica was created.
+	Created time.Time
+	// Source is the replica whose message caused the replica to be
+	// created and SourceMessage the type of that message. Source is zero
+	// if the replica wasn't created in response to a message.
+	Source        roachpb.ReplicaDescriptor
+	SourceMessage raftpb.MessageType
+	// LastSnapshot is the time at which a snapshot for the replica last
+	// arrived, or zero if none has. LastSnapshotRejection is the reason
+	// for which that snapshot was rejected, if it was.
+	LastSnapshot          time.Time
+	LastSnapshotRejection string
+}
+
+var _ multiraft.GroupCreationObserver = &Store{}
+
+// GroupCreatedByMessage implements the multiraft.GroupCreationObserver
+// interface. It records the source of an uninitialized replica.
+func (s *Store) GroupCreatedByMessage(groupID roachpb.RangeID, from roachpb.ReplicaDescriptor,
+	msgType raftpb.MessageType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if info, ok := s.uninitInfo[groupID]; ok {
+		info.Source = from
+		info.SourceMessage = msgType
+	}
+}
+
+// noteUninitializedSnapshot records the arrival of a snapshot for an
+// uninitialized replica and the reason for which it was rejected, if any.
+func (s *Store) noteUninitializedSnapshot(rangeID roachpb.RangeID, rejection *multiraft.SnapshotRejection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.uninitInfo[rangeID]
+	if !ok {
+		return
+	}
+	info.LastSnapshot = time.Now()
+	info.LastSnapshotRejection = ""
+	if rejection != nil {
+		info.LastSnapshotRejection = rejection.Error()
+	}
+}
+
+// UninitializedReplicas returns descriptions of the store's uninitialized
+// replicas, sorted by range ID.
+func (s *Store) UninitializedReplicas() []UninitializedReplicaInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	infos := make([]UninitializedReplicaInfo, 0, len(s.uninitInfo))
+	for _, info := range s.uninitInfo {
+		infos = append(infos, *info)
+	}
+	sort.Sort(uninitReplicaInfosByRangeID(infos))
+	return infos
+}
+
+type uninitReplicaInfosByRangeID []UninitializedReplicaInfo
+
+func (s uninitReplicaInfosByRangeID) Len() int           { return len(s) }
+func (s uninitReplicaInfosByRangeID) Less(i, j int) bool { return s[i].RangeID < s[j].RangeID }
+func (s uninitReplicaInfosByRangeID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// oldestUninitializedAgeLocked returns the age of the oldest uninitialized
+// replica, or zero if there is none. Requires that s.mu is held.
+func (s *Store) oldestUninitializedAgeLocked() time.Duration {
+	var age time.Duration
+	now := time.Now()
+	for _, info := range s.uninitInfo {
+		if a := now.Sub(info.Created); a > age {
+			age = a
+		}
+	}
+	return age
+}
+
+// startUninitializedReplicaGC starts a worker which periodically removes
+// the uninitialized replicas older than the store's
+// UninitializedReplicaGCThreshold (see gcUninitializedReplicas).
+func (s *Store) startUninitializedReplicaGC() {
+	threshold := s.ctx.UninitializedReplicaGCThreshold
+	if threshold <= 0 {
+		return
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(threshold / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.stopper.RunTask(func() {
+					if _, err := s.gcUninitializedReplicas(time.Now().Add(-threshold)); err != nil {
+						log.Warningf("store %s: unable to remove uninitialized replicas: %s", s, err)
+					}
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// gcUninitializedReplicas removes the uninitialized replicas created
+// before the given time, after verifying against the meta records that
+// the store isn't supposed to hold a replica of their ranges. Such
+// replicas are left behind e.g. when the store was removed from a range
+// before the snapshot for its new replica arrived. Their raft state is
+// purged, leaving a tombstone if the range still exists so that stale raft
+// messages cannot recreate them. Returns the number of replicas removed.
+func (s *Store) gcUninitializedReplicas(createdBefore time.Time) (int, error) {
+	var rangeIDs []roachpb.RangeID
+	s.mu.RLock()
+	for rangeID, info := range s.uninitInfo {
+		if info.Created.Before(createdBefore) {
+			rangeIDs = append(rangeIDs, rangeID)
+		}
+	}
+	s.mu.RUnlock()
+	if len(rangeIDs) == 0 {
+		return 0, nil
+	}
+	metaDescs, err := s.lookupMetaDescriptors(rangeIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, rangeID := range rangeIDs {
+		desc, inMeta := metaDescs[rangeID]
+		if inMeta {
+			if idx, _ := desc.FindReplica(s.StoreID()); idx >= 0 {
+				// The store is a member of the range, but the snapshot hasn't
+				// arrived yet.
+				log.Warningf("store %s: replica of range %d is still waiting for a snapshot", s, rangeID)
+				continue
+			}
+		}
+		ok, err := s.removeUninitializedReplica(rangeID)
+		if err != nil {
+			return removed, err
+		}
+		if !ok {
+			continue
+		}
+		removed++
+		if _, err := s.purgeOrphanedRaftState(rangeID, desc.NextReplicaID); err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// removeUninitializedReplica removes the uninitialized replica of the given
+// range from the store and its raft group. Returns false if the replica
+// doesn't exist or has been initialized in the meantime.
+func (s *Store) removeUninitializedReplica(rangeID roachpb.RangeID) (bool, error) {
+	s.processRaftMu.Lock()
+	defer s.processRaftMu.Unlock()
+	s.mu.RLock()
+	rep, ok := s.uninitReplicas[rangeID]
+	s.mu.RUnlock()
+	if !ok {
+		return false, nil
+	}
+
+	rep.Quiesce()
+	// As in removeReplicaImpl, the group is removed outside the scope of
+	// s.mu.
+	if err := s.multiraft.RemoveGroup(rangeID); err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	_, ok = s.uninitReplicas[rangeID]
+	if ok {
+		s.replicas.del(rangeID)
+		delete(s.uninitReplicas, rangeID)
+		delete(s.uninitInfo, rangeID)
+	}
+	s.mu.Unlock()
+	if !ok {
+		// A snapshot was applied before the group was removed; restore the
+		// group of the now initialized replica.
+		return false, s.multiraft.CreateGroup(rangeID)
+	}
+	s.metrics.Counter("replicas.uninitialized.removed").Inc(1)
+	log.Infof("store %s: removed uninitialized replica of range %d", s, rangeID)
+	return true, nil
+}
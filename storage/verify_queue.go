@@ -20,6 +20,8 @@ package storage
 import (
 	"time"
 
+	"golang.org/x/net/context"
+
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
@@ -32,6 +34,9 @@ const (
 	// verificationInterval is the target duration for verifying on-disk
 	// checksums via full scan.
 	verificationInterval = 60 * 24 * time.Hour // 60 days
+	// verifyCancelCheckInterval is the number of keys scanned between
+	// checks of whether a verification has been canceled.
+	verifyCancelCheckInterval = 1000
 )
 
 // rangeCountFn should return the total number of ranges on the store providing
@@ -88,27 +93,106 @@ func (*verifyQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 func (*verifyQueue) process(now roachpb.Timestamp, rng *Replica,
 	_ *config.SystemConfig) error {
 
-	snap := rng.store.Engine().NewSnapshot()
-	iter := newReplicaDataIterator(rng.Desc(), snap)
-	defer iter.Close()
-	defer snap.Close()
-
-	// Iterate through all keys & values.
-	for ; iter.Valid(); iter.Next() {
-	}
-	// An error during iteration is presumed to mean a checksum failure
-	// while iterating over the underlying key/value data.
-	if iter.Error() != nil {
+	report := verifyReplica(context.Background(), rng, nil)
+	if report.Err != nil {
 		// TODO(spencer): do something other than fatal error here. We
 		// want to quarantine this range, make it a non-participating raft
 		// follower until it can be replaced and then destroyed.
-		log.Fatalf("unhandled failure when scanning range %s; probable data corruption: %s", rng, iter.Error())
+		log.Fatalf("unhandled failure when scanning range %s; probable data corruption: %s", rng, report.Err)
 	}
 
 	// Store current timestamp as last verification for this range.
+	rng.setVerifyResult(nil)
 	return rng.SetLastVerificationTimestamp(now)
 }
 
+// A VerificationStatus describes the checksum verifications of a replica.
+type VerificationStatus struct {
+	// Timestamp is the time of the last successful verification. It is
+	// persisted with the replica's data.
+	Timestamp roachpb.Timestamp
+	// Err is the error of the most recent verification if it failed.
+	// Failures are only remembered until the replica is recreated.
+	Err error
+}
+
+// setVerifyResult records the outcome of a completed verification of the
+// replica.
+func (r *Replica) setVerifyResult(err error) {
+	r.verifyMu.Lock()
+	r.lastVerifyErr = err
+	r.verifyMu.Unlock()
+}
+
+// VerificationStatus returns the time of the replica's last successful
+// checksum verification and the error of the most recent one, if it failed.
+func (r *Replica) VerificationStatus() (VerificationStatus, error) {
+	timestamp, err := r.GetLastVerificationTimestamp()
+	if err != nil {
+		return VerificationStatus{}, err
+	}
+	r.verifyMu.Lock()
+	defer r.verifyMu.Unlock()
+	return VerificationStatus{Timestamp: timestamp, Err: r.lastVerifyErr}, nil
+}
+
+// A VerifyReport is the result of verifying the on-disk checksums of
+// a replica's data.
+type VerifyReport struct {
+	RangeID roachpb.RangeID
+	StoreID roachpb.StoreID
+	// KeyCount and ByteCount are the number of keys and the combined
+	// size of keys and values scanned.
+	KeyCount, ByteCount int64
+	Duration            time.Duration
+	// ResumeKey is set if the verification was canceled before it
+	// completed. Passing it to VerifyRange continues the verification
+	// where it left off.
+	ResumeKey roachpb.EncodedKey
+	// Err is set if scanning failed, which is presumed to mean a
+	// checksum failure in the underlying key/value data.
+	Err error
+}
+
+// Done returns true if the verification scanned all of the replica's
+// data without encountering an error.
+func (r VerifyReport) Done() bool {
+	return r.ResumeKey == nil && r.Err == nil
+}
+
+// verifyReplica scans all of the replica's keys and values, starting
+// at resumeKey if it is non-nil. The scan stops early if the context
+// is canceled, in which case the report's ResumeKey is set.
+func verifyReplica(ctx context.Context, rng *Replica, resumeKey roachpb.EncodedKey) (report VerifyReport) {
+	start := time.Now()
+	report.RangeID = rng.Desc().RangeID
+	report.StoreID = rng.store.StoreID()
+	defer func() { report.Duration = time.Since(start) }()
+
+	snap := rng.store.Engine().NewSnapshot()
+	iter := newReplicaDataIterator(rng.Desc(), snap)
+	defer iter.Close()
+	defer snap.Close()
+
+	if resumeKey != nil {
+		iter.Seek(resumeKey)
+	}
+	for ; iter.Valid(); iter.Next() {
+		if report.KeyCount%verifyCancelCheckInterval == 0 && report.KeyCount > 0 {
+			select {
+			case <-ctx.Done():
+				report.ResumeKey = append(roachpb.EncodedKey(nil), iter.Key()...)
+				return report
+			default:
+			}
+		}
+		report.KeyCount++
+		report.ByteCount += int64(len(iter.Key()) + len(iter.Value()))
+	}
+	report.Err = iter.Error()
+	return report
+}
+
 // timer returns the duration of intervals between successive range
 // verification scans. The durations are sized so that the full
 // complement of ranges can be scanned within verificationInterval.
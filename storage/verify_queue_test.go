@@ -21,9 +21,12 @@ import (
 	"math"
 	"testing"
 
+	"golang.org/x/net/context"
+
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -65,3 +68,49 @@ func TestVerifyQueueShouldQueue(t *testing.T) {
 		}
 	}
 }
+
+// TestVerificationStatus verifies that the verification status of a
+// replica reflects its last verification and that the store summarizes
+// the status of its replicas.
+func TestVerificationStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	verifiedAt := makeTS(10, 0)
+	if err := tc.rng.SetLastVerificationTimestamp(verifiedAt); err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.setVerifyResult(util.Errorf("checksum mismatch"))
+	status, err := tc.rng.VerificationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Timestamp != verifiedAt || status.Err == nil {
+		t.Errorf("expected a failed verification after %s; got %+v", verifiedAt, status)
+	}
+	if oldest, failed := tc.store.computeVerificationStatus(); oldest != verifiedAt || failed != 1 {
+		t.Errorf("expected oldest verification %s and 1 failure; got %s and %d", verifiedAt, oldest, failed)
+	}
+
+	// A completed verification clears the failure and advances the
+	// timestamp.
+	tc.manualClock.Set(20)
+	report, err := tc.store.VerifyRange(context.Background(), tc.rng.Desc().RangeID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done() {
+		t.Fatalf("expected verification to complete; got %+v", report)
+	}
+	if status, err = tc.rng.VerificationStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if !verifiedAt.Less(status.Timestamp) || status.Err != nil {
+		t.Errorf("expected a successful verification after %s; got %+v", verifiedAt, status)
+	}
+	if _, failed := tc.store.computeVerificationStatus(); failed != 0 {
+		t.Errorf("expected no failures; got %d", failed)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package budget implements memory accounting for the work in flight on a
+// node, allowing work beyond the node's memory budget to be queued or
+// rejected instead of exhausting the node's memory.
+package budget
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrExhausted is returned when a reservation cannot be satisfied by the
+// remaining budget of a pool.
+var ErrExhausted = errors.New("memory budget exhausted")
+
+// A Pool is a memory budget shared by concurrent operations. Operations
+// reserve the memory they expect to use before starting, and may grow their
+// reservation as they proceed. A nil *Pool imposes no limit.
+type Pool struct {
+	capacity int64
+	maxWait  time.Duration
+
+	mu       sync.Mutex
+	used     int64
+	released chan struct{} // Closed and replaced whenever memory is released
+}
+
+// NewPool creates a pool with a budget of capacity bytes. Reservations
+// which cannot be satisfied immediately wait for at most maxWait for other
+// reservations to be released.
+func NewPool(capacity int64, maxWait time.Duration) *Pool {
+	return &Pool{
+		capacity: capacity,
+		maxWait:  maxWait,
+		released: make(chan struct{}),
+	}
+}
+
+// Used returns the number of bytes currently reserved from the pool.
+func (p *Pool) Used() int64 {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.used
+}
+
+// Reserve reserves n bytes from the pool, waiting for other reservations to
+// be released if necessary. ErrExhausted is returned if the reservation
+// cannot be satisfied within the pool's maximum wait time.
+func (p *Pool) Reserve(n int64) (*Reservation, error) {
+	if p == nil {
+		return nil, nil
+	}
+	var timer *time.Timer
+	for {
+		p.mu.Lock()
+		if p.tryReserveLocked(n) {
+			p.mu.Unlock()
+			if timer != nil {
+				timer.Stop()
+			}
+			return &Reservation{pool: p, n: n}, nil
+		}
+		released := p.released
+		p.mu.Unlock()
+
+		if n > p.capacity || p.maxWait <= 0 {
+			return nil, ErrExhausted
+		}
+		if timer == nil {
+			timer = time.NewTimer(p.maxWait)
+		}
+		select {
+		case <-released:
+		case <-timer.C:
+			return nil, ErrExhausted
+		}
+	}
+}
+
+func (p *Pool) tryReserveLocked(n int64) bool {
+	if p.used+n > p.capacity {
+		return false
+	}
+	p.used += n
+	return true
+}
+
+func (p *Pool) releaseLocked(n int64) {
+	p.used -= n
+	close(p.released)
+	p.released = make(chan struct{})
+}
+
+// A Reservation is an amount of memory reserved from a pool. A nil
+// *Reservation belongs to a nil *Pool and imposes no limit.
+type Reservation struct {
+	pool *Pool
+	n    int64
+}
+
+// Grow increases the reservation by n bytes. Unlike Reserve, it doesn't wait
+// for memory to become available, as the caller already holds memory which
+// might be needed by others: ErrExhausted is returned immediately instead.
+func (r *Reservation) Grow(n int64) error {
+	if r == nil {
+		return nil
+	}
+	r.pool.mu.Lock()
+	defer r.pool.mu.Unlock()
+	if !r.pool.tryReserveLocked(n) {
+		return ErrExhausted
+	}
+	r.n += n
+	return nil
+}
+
+// Shrink decreases the reservation by n bytes, returning them to the pool.
+func (r *Reservation) Shrink(n int64) {
+	if r == nil || n == 0 {
+		return
+	}
+	r.pool.mu.Lock()
+	defer r.pool.mu.Unlock()
+	if n > r.n {
+		panic("cannot shrink reservation below zero")
+	}
+	r.n -= n
+	r.pool.releaseLocked(n)
+}
+
+// Release returns all of the reserved memory to the pool.
+func (r *Reservation) Release() {
+	if r == nil {
+		return
+	}
+	r.pool.mu.Lock()
+	defer r.pool.mu.Unlock()
+	if r.n > 0 {
+		r.pool.releaseLocked(r.n)
+		r.n = 0
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package budget
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPoolReserve(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	p := NewPool(100, 0)
+
+	r1, err := p.Reserve(60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Reserve(50); err != ErrExhausted {
+		t.Fatalf("expected %s, got %v", ErrExhausted, err)
+	}
+	if err := r1.Grow(50); err != ErrExhausted {
+		t.Fatalf("expected %s, got %v", ErrExhausted, err)
+	}
+	if err := r1.Grow(40); err != nil {
+		t.Fatal(err)
+	}
+	r1.Shrink(30)
+	if used := p.Used(); used != 70 {
+		t.Fatalf("expected 70 bytes used, found %d", used)
+	}
+	r1.Release()
+	if used := p.Used(); used != 0 {
+		t.Fatalf("expected no bytes used, found %d", used)
+	}
+}
+
+func TestReservationConcurrent(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	p := NewPool(1000, 0)
+	r, err := p.Reserve(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := r.Grow(10); err != nil {
+					t.Error(err)
+					return
+				}
+				r.Shrink(10)
+			}
+		}()
+	}
+	wg.Wait()
+	if used := p.Used(); used != 0 {
+		t.Fatalf("expected no bytes used, found %d", used)
+	}
+	r.Release()
+}
+
+func TestPoolReserveWait(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	p := NewPool(100, time.Minute)
+
+	// Requests larger than the pool are rejected without waiting.
+	if _, err := p.Reserve(101); err != ErrExhausted {
+		t.Fatalf("expected %s, got %v", ErrExhausted, err)
+	}
+
+	r1, err := p.Reserve(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errC := make(chan error)
+	go func() {
+		r2, err := p.Reserve(10)
+		r2.Release()
+		errC <- err
+	}()
+	select {
+	case err := <-errC:
+		t.Fatalf("expected reservation to wait, got %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	r1.Release()
+	if err := <-errC; err != nil {
+		t.Fatal(err)
+	}
+
+	p = NewPool(100, time.Millisecond)
+	if _, err := p.Reserve(100); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Reserve(1); err != ErrExhausted {
+		t.Fatalf("expected %s, got %v", ErrExhausted, err)
+	}
+}
+
+func TestNilPool(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var p *Pool
+	r, err := p.Reserve(1 << 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Grow(1 << 40); err != nil {
+		t.Fatal(err)
+	}
+	r.Release()
+}
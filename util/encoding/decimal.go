@@ -0,0 +1,189 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package encoding
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// maxDecimalExponent bounds the base-100 exponent of an encoded decimal:
+// like the exponents of floats, it must be encoded in a single varint byte.
+const maxDecimalExponent = 240
+
+// EncodeDecimal returns the resulting byte slice with the encoded decimal
+// unscaled*10^-scale appended to b. Decimals share the encoding of floats
+// (see EncodeFloat): a decimal and a float of equal value have the same
+// encoding, and trailing zeros are not encoded, so 1.5 and 1.50 encode
+// identically. The absolute value of a non-zero decimal must be at least
+// 1e-482 and less than 1e480; an error is returned otherwise.
+func EncodeDecimal(b []byte, unscaled *big.Int, scale int32) ([]byte, error) {
+	if unscaled.Sign() == 0 {
+		return append(b, floatZero), nil
+	}
+	e, m, err := decimalMandE(unscaled, scale)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, len(m)+maxVarintSize+2)
+	negative := unscaled.Sign() < 0
+	switch {
+	case e < 0:
+		return append(b, encodeSmallNumber(negative, e, m, buf)...), nil
+	case e <= 10:
+		return append(b, encodeMediumNumber(negative, e, m, buf)...), nil
+	default:
+		return append(b, encodeLargeNumber(negative, e, m, buf)...), nil
+	}
+}
+
+// EncodeDecimalDecreasing returns the resulting byte slice with the encoded
+// decimal appended to b. The encoded format for a decimal d is the ones
+// complement of EncodeDecimal(d), which sorts in decreasing order.
+func EncodeDecimalDecreasing(b []byte, unscaled *big.Int, scale int32) ([]byte, error) {
+	n := len(b)
+	b, err := EncodeDecimal(b, unscaled, scale)
+	if err != nil {
+		return nil, err
+	}
+	onesComplement(b[n:])
+	return b, nil
+}
+
+// DecodeDecimal returns the remaining byte slice after decoding and the
+// decoded decimal, as its unscaled value and scale, from b. The decoded
+// decimal has no trailing zeros: the scale is the smallest one which
+// represents the value exactly.
+func DecodeDecimal(b []byte) ([]byte, *big.Int, int32, error) {
+	if PeekType(b) != Float {
+		return nil, nil, 0, util.Errorf("did not find marker")
+	}
+	if b[0] == floatZero {
+		return b[1:], new(big.Int), 0, nil
+	}
+	idx := bytes.IndexByte(b, floatTerminator)
+	if idx == -1 {
+		return nil, nil, 0, util.Errorf("did not find terminator")
+	}
+	var negative bool
+	var e int
+	var m []byte
+	switch {
+	case b[0] == floatNegLarge:
+		negative = true
+		e, m = decodeLargeNumber(true, b[:idx+1], nil)
+	case b[0] > floatNegLarge && b[0] <= floatNegMedium:
+		negative = true
+		e, m = decodeMediumNumber(true, b[:idx+1], nil)
+	case b[0] == floatNegSmall:
+		negative = true
+		e, m = decodeSmallNumber(true, b[:idx+1], nil)
+	case b[0] == floatPosLarge:
+		e, m = decodeLargeNumber(false, b[:idx+1], nil)
+	case b[0] >= floatPosMedium && b[0] < floatPosLarge:
+		e, m = decodeMediumNumber(false, b[:idx+1], nil)
+	case b[0] == floatPosSmall:
+		e, m = decodeSmallNumber(false, b[:idx+1], nil)
+	default:
+		return nil, nil, 0, util.Errorf("encoded value is not a decimal: %q", b[:1])
+	}
+	unscaled, scale, err := makeDecimalFromMandE(negative, e, m)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return b[idx+1:], unscaled, scale, nil
+}
+
+// DecodeDecimalDecreasing returns the remaining byte slice after decoding
+// and the decoded decimal from b, which was encoded using
+// EncodeDecimalDecreasing.
+func DecodeDecimalDecreasing(b []byte) ([]byte, *big.Int, int32, error) {
+	t := append([]byte(nil), b...)
+	onesComplement(t)
+	rest, unscaled, scale, err := DecodeDecimal(t)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return b[len(b)-len(rest):], unscaled, scale, nil
+}
+
+// decimalMandE computes and returns the mantissa M and exponent E for the
+// non-zero decimal unscaled*10^-scale. See floatMandE for the definitions
+// of M and E. An error is returned if E is out of range.
+func decimalMandE(unscaled *big.Int, scale int32) (int, []byte, error) {
+	digits := strings.TrimPrefix(unscaled.String(), "-")
+	// Strip the trailing zeros, which would otherwise be encoded as zero
+	// centimal digits.
+	n := len(strings.TrimRight(digits, "0"))
+	e10 := int64(len(digits)) - int64(scale)
+	digits = digits[:n]
+
+	// The value is 0.digits * 10^e10. Convert the power-10 exponent to a
+	// power of 100 exponent, prepending a 0 digit if e10 is odd.
+	var e100 int64
+	if e10 >= 0 {
+		e100 = (e10 + 1) / 2
+	} else {
+		e100 = e10 / 2
+	}
+	if e100 > maxDecimalExponent || e100 < -maxDecimalExponent {
+		return 0, nil, util.Errorf("decimal exponent out of range: %se%d", unscaled, -scale)
+	}
+	b := make([]byte, 0, len(digits)+2)
+	if e100*2 != e10 {
+		b = append(b, '0')
+	}
+	b = append(b, digits...)
+	if len(b)%2 != 0 {
+		b = append(b, '0')
+	}
+
+	// Convert the base-10 'b' slice to a base-100 'm' slice.
+	m := b[:len(b)/2]
+	for i := 0; i < len(b); i += 2 {
+		accum := 10*int(b[i]-'0') + int(b[i+1]-'0')
+		// The bytes are encoded as 2n+1.
+		m[i/2] = byte(2*accum + 1)
+	}
+	// The last byte is encoded as 2n+0.
+	m[len(m)-1]--
+
+	return int(e100), m, nil
+}
+
+// makeDecimalFromMandE reconstructs the decimal from the mantissa M and
+// exponent E.
+func makeDecimalFromMandE(negative bool, e int, m []byte) (*big.Int, int32, error) {
+	b := make([]byte, 0, 2*len(m))
+	for _, v := range m {
+		t := int(v) / 2
+		b = append(b, byte(t/10)+'0', byte(t%10)+'0')
+	}
+	// The value is 0.b * 100^e.
+	b = bytes.TrimRight(b, "0")
+	scale := int32(len(b) - 2*e)
+	unscaled, ok := new(big.Int).SetString(string(b), 10)
+	if !ok {
+		return nil, 0, util.Errorf("malformed decimal mantissa: %q", m)
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return unscaled, scale, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package encoding
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/randutil"
+)
+
+// parseDecimal parses a decimal of the form [-]ddd[.ddd][e[-]dd] into its
+// unscaled value and scale.
+func parseDecimal(t *testing.T, s string) (*big.Int, int32) {
+	var exp int64
+	if i := strings.IndexByte(s, 'e'); i != -1 {
+		e, ok := new(big.Int).SetString(s[i+1:], 10)
+		if !ok {
+			t.Fatalf("malformed decimal %q", s)
+		}
+		exp = e.Int64()
+		s = s[:i]
+	}
+	var scale int64
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		scale = int64(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+	unscaled, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("malformed decimal %q", s)
+	}
+	return unscaled, int32(scale - exp)
+}
+
+// decimalRat returns the value of the decimal unscaled*10^-scale.
+func decimalRat(unscaled *big.Int, scale int32) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(scale))), nil)
+	if scale >= 0 {
+		return new(big.Rat).SetFrac(unscaled, pow)
+	}
+	return new(big.Rat).SetInt(new(big.Int).Mul(unscaled, pow))
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func TestEncodeDecimal(t *testing.T) {
+	testCases := []struct {
+		Value string
+		// Float is the float with the same value as the decimal, or 0 if
+		// there is none.
+		Float float64
+	}{
+		{"-9.99e479", 0},
+		{"-1.5e308", 0},
+		{"-1e308", -1e308},
+		{"-10000", -10000},
+		{"-9999", -9999},
+		{"-100.00", -100},
+		{"-99", -99},
+		{"-1", -1},
+		{"-0.00123", -0.00123},
+		{"-1e-307", -1e-307},
+		{"-1e-400", 0},
+		{"-1e-482", 0},
+		{"0", 0},
+		{"1e-482", 0},
+		{"1e-400", 0},
+		{"1e-307", 1e-307},
+		{"0.00123", 0.00123},
+		{"0.0123", 0.0123},
+		{"0.123", 0.123},
+		{"1", 1},
+		{"10", 10},
+		{"12.345", 12.345},
+		{"99", 99},
+		{"99.0001", 99.0001},
+		{"99.01", 99.01},
+		{"100", 100},
+		{"100.01", 100.01},
+		{"100.1", 100.1},
+		{"1234", 1234},
+		{"1234.5", 1234.5},
+		{"9999.000001", 9999.000001},
+		{"9999.1", 9999.1},
+		{"10000", 10000},
+		{"12345", 12345},
+		{"123450", 123450},
+		{"123456789012345678901234567890", 0},
+		{"1e308", 1e308},
+		{"1e400", 0},
+		{"9.99e479", 0},
+	}
+
+	var last []byte
+	for i, c := range testCases {
+		unscaled, scale := parseDecimal(t, c.Value)
+		enc, err := EncodeDecimal(nil, unscaled, scale)
+		if err != nil {
+			t.Fatalf("%s: %s", c.Value, err)
+		}
+		if c.Float != 0 || c.Value == "0" {
+			if e := EncodeFloat(nil, c.Float); !bytes.Equal(enc, e) {
+				t.Errorf("%s: expected float encoding [% x], got [% x]", c.Value, e, enc)
+			}
+		}
+		if i > 0 && bytes.Compare(last, enc) >= 0 {
+			t.Errorf("%s: expected [% x] to be less than [% x]", c.Value, last, enc)
+		}
+		last = enc
+
+		rest, decUnscaled, decScale, err := DecodeDecimal(append(enc, 'x'))
+		if err != nil {
+			t.Errorf("%s: %s", c.Value, err)
+			continue
+		}
+		if string(rest) != "x" {
+			t.Errorf("%s: expected remainder \"x\", got %q", c.Value, rest)
+		}
+		if decimalRat(unscaled, scale).Cmp(decimalRat(decUnscaled, decScale)) != 0 {
+			t.Errorf("%s: decoded %se%d", c.Value, decUnscaled, -decScale)
+		}
+	}
+}
+
+func TestEncodeDecimalTrailingZeros(t *testing.T) {
+	testCases := []struct {
+		Values        []string
+		Unscaled      int64
+		ExpectedScale int32
+	}{
+		{[]string{"1.5", "1.50", "1.500000", "15e-1"}, 15, 1},
+		{[]string{"100", "100.00", "1e2", "0.001e5"}, 1, -2},
+		{[]string{"-0.25", "-0.250", "-25e-2"}, -25, 2},
+	}
+	for _, c := range testCases {
+		var first []byte
+		for i, s := range c.Values {
+			unscaled, scale := parseDecimal(t, s)
+			enc, err := EncodeDecimal(nil, unscaled, scale)
+			if err != nil {
+				t.Fatalf("%s: %s", s, err)
+			}
+			if i == 0 {
+				first = enc
+			} else if !bytes.Equal(first, enc) {
+				t.Errorf("%s: expected [% x], got [% x]", s, first, enc)
+			}
+			_, decUnscaled, decScale, err := DecodeDecimal(enc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decUnscaled.Int64() != c.Unscaled || decScale != c.ExpectedScale {
+				t.Errorf("%s: expected %de%d, got %se%d",
+					s, c.Unscaled, -c.ExpectedScale, decUnscaled, -decScale)
+			}
+		}
+	}
+}
+
+func TestEncodeDecimalOutOfRange(t *testing.T) {
+	for _, s := range []string{"1e480", "-1e480", "1e-483", "-1e-483"} {
+		unscaled, scale := parseDecimal(t, s)
+		if _, err := EncodeDecimal(nil, unscaled, scale); err == nil {
+			t.Errorf("%s: expected out of range error", s)
+		}
+		if _, err := EncodeDecimalDecreasing(nil, unscaled, scale); err == nil {
+			t.Errorf("%s: expected out of range error", s)
+		}
+	}
+}
+
+// randDecimal returns a random decimal with up to 40 digits and an
+// exponent of up to +/-400.
+func randDecimal(rng *rand.Rand) (*big.Int, int32) {
+	digits := make([]byte, 1+rng.Intn(40))
+	for i := range digits {
+		digits[i] = byte('0' + rng.Intn(10))
+	}
+	unscaled, _ := new(big.Int).SetString(string(digits), 10)
+	if rng.Intn(2) == 0 {
+		unscaled.Neg(unscaled)
+	}
+	return unscaled, int32(rng.Intn(800) - 400)
+}
+
+func mustEncodeDecimal(t *testing.T, encode func([]byte, *big.Int, int32) ([]byte, error),
+	unscaled *big.Int, scale int32) []byte {
+	b, err := encode(nil, unscaled, scale)
+	if err != nil {
+		t.Fatalf("%se%d: %s", unscaled, -scale, err)
+	}
+	return b
+}
+
+func TestEncodeDecimalRandom(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	for i := 0; i < 10000; i++ {
+		u1, s1 := randDecimal(rng)
+		u2, s2 := randDecimal(rng)
+		r1, r2 := decimalRat(u1, s1), decimalRat(u2, s2)
+
+		enc1, enc2 := mustEncodeDecimal(t, EncodeDecimal, u1, s1), mustEncodeDecimal(t, EncodeDecimal, u2, s2)
+		if a, e := bytes.Compare(enc1, enc2), r1.Cmp(r2); a != e {
+			t.Fatalf("%se%d vs %se%d: expected comparison %d, got %d", u1, -s1, u2, -s2, e, a)
+		}
+		dec1, dec2 := mustEncodeDecimal(t, EncodeDecimalDecreasing, u1, s1),
+			mustEncodeDecimal(t, EncodeDecimalDecreasing, u2, s2)
+		if a, e := bytes.Compare(dec1, dec2), r2.Cmp(r1); a != e {
+			t.Fatalf("%se%d vs %se%d: expected decreasing comparison %d, got %d",
+				u1, -s1, u2, -s2, e, a)
+		}
+
+		rest, u, s, err := DecodeDecimal(append(enc1, enc2...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(rest, enc2) {
+			t.Fatalf("%se%d: expected remainder [% x], got [% x]", u1, -s1, enc2, rest)
+		}
+		if decimalRat(u, s).Cmp(r1) != 0 {
+			t.Fatalf("%se%d: decoded %se%d", u1, -s1, u, -s)
+		}
+		rest, u, s, err = DecodeDecimalDecreasing(append(dec1, dec2...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(rest, dec2) {
+			t.Fatalf("%se%d: expected remainder [% x], got [% x]", u1, -s1, dec2, rest)
+		}
+		if decimalRat(u, s).Cmp(r1) != 0 {
+			t.Fatalf("%se%d: decoded decreasing %se%d", u1, -s1, u, -s)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+/*
+Package encoding implements the order-preserving encodings used for the
+keys of the key-value store, and in particular for the keys of SQL tables
+and indexes.
+
+Every Encode<Type> function appends the encoding of a value to a byte slice
+and every Decode<Type> function returns the remainder of the byte slice
+along with the decoded value. The encodings have two properties which
+together make them suitable for composite keys:
+
+  - Ordering: for values a and b of the same type, a < b if and only if
+    bytes.Compare(Encode<Type>(nil, a), Encode<Type>(nil, b)) < 0.
+  - Self-delimitation: no encoding is a prefix of another encoding of the
+    same type, so a sequence of values can be decoded without knowing the
+    length of each encoding.
+
+It follows that the concatenation of the encodings of a tuple of values
+sorts in the lexicographic order of the tuples. Each value may use either
+the increasing encoding or the Encode<Type>Decreasing variant, which sorts
+in the reverse order, so the direction of every column of a composite key
+can be chosen independently.
+
+Values of different types are distinguished by their first byte, which
+PeekType reports for the increasing encodings:
+
+	0x00        NULL (EncodeNull)
+	0x01        not NULL (EncodeNotNull)
+	0x02-0x12   integers (EncodeVarint, EncodeUvarint)
+	0x13-0x30   floats and decimals (EncodeFloat, EncodeDecimal)
+	0x31        byte strings (EncodeBytes, EncodeString)
+	0x32        times (EncodeTime)
+
+NULL sorts before the values of every type. Floats and decimals share an
+encoding, so a float and a decimal with equal values are encoded
+identically and a column may hold either. Byte strings are escaped and
+terminated so that they may contain arbitrary bytes. The fixed-width
+EncodeUint32 and EncodeUint64 encodings have no marker and are used for
+key components whose type is known from context.
+
+These encodings are persisted in keys on disk: changing the encoding of any
+value is not backwards compatible and requires a migration.
+*/
+package encoding
@@ -555,8 +555,38 @@ func DecodeTime(b []byte) ([]byte, time.Time, error) {
 	return b, time.Unix(sec, nsec), nil
 }
 
+// EncodeTimeDecreasing encodes a time value, appends it to the supplied
+// buffer, and returns the final buffer. The encoding is ordered such that
+// if t1.Before(t2) then the encoding of t2 sorts before the encoding of
+// t1.
+func EncodeTimeDecreasing(b []byte, t time.Time) []byte {
+	b = append(b, ^timeMarker)
+	b = EncodeVarintDecreasing(b, t.Unix())
+	b = EncodeVarintDecreasing(b, int64(t.Nanosecond()))
+	return b
+}
+
+// DecodeTimeDecreasing decodes a time.Time value which was encoded using
+// EncodeTimeDecreasing. The remainder of the input buffer and the decoded
+// time.Time are returned.
+func DecodeTimeDecreasing(b []byte) ([]byte, time.Time, error) {
+	if len(b) == 0 || b[0] != ^timeMarker {
+		return nil, time.Time{}, util.Errorf("did not find marker")
+	}
+	b = b[1:]
+	b, sec, err := DecodeVarintDecreasing(b)
+	if err != nil {
+		return b, time.Time{}, err
+	}
+	b, nsec, err := DecodeVarintDecreasing(b)
+	if err != nil {
+		return b, time.Time{}, err
+	}
+	return b, time.Unix(sec, nsec), nil
+}
+
 // Type represents the type of a value encoded by
-// Encode{Null,NotNull,Varint,Uvarint,Float,Bytes}.
+// Encode{Null,NotNull,Varint,Uvarint,Float,Decimal,Bytes,Time}.
 type Type int
 
 // Type values.
@@ -591,3 +621,60 @@ func PeekType(b []byte) Type {
 	}
 	return Unknown
 }
+
+// PeekLength returns the length of the value encoded at the start of b by
+// one of the Encode{Null,NotNull,Varint,Uvarint,Float,Bytes,String,Time}
+// functions. If complemented is true, b instead holds the ones complement
+// of such an encoding, which sorts in reverse order.
+func PeekLength(b []byte, complemented bool) (int, error) {
+	var mask byte
+	if complemented {
+		mask = 0xff
+	}
+	if len(b) == 0 {
+		return 0, util.Errorf("empty slice")
+	}
+	m := b[0] ^ mask
+	switch {
+	case m == encodedNull, m == encodedNotNull:
+		return 1, nil
+	case m == bytesMarker:
+		for i := 1; i+1 < len(b); i++ {
+			if b[i]^mask == escape && b[i+1]^mask == escapedTerm {
+				return i + 2, nil
+			}
+		}
+		return 0, util.Errorf("did not find terminator")
+	case m == timeMarker:
+		n := 1
+		for i := 0; i < 2; i++ {
+			l, err := PeekLength(b[n:], complemented)
+			if err != nil {
+				return 0, err
+			}
+			n += l
+		}
+		return n, nil
+	case m >= intMin && m <= intMax:
+		length := int(m) - intMid
+		if length < 0 {
+			length = -length
+		}
+		if len(b) < 1+length {
+			return 0, util.Errorf("insufficient bytes to decode var uint64 int value")
+		}
+		return 1 + length, nil
+	case m >= floatNaN && m <= floatInfinity:
+		switch m {
+		case floatNaN, floatNegativeInfinity, floatZero, floatInfinity:
+			return 1, nil
+		}
+		for i := 1; i < len(b); i++ {
+			if b[i]^mask == floatTerminator {
+				return i + 1, nil
+			}
+		}
+		return 0, util.Errorf("did not find terminator")
+	}
+	return 0, util.Errorf("unknown prefix of the encoded byte slice: %q", b)
+}
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/randutil"
 )
 
@@ -676,6 +677,171 @@ func TestEncodeDecodeTime(t *testing.T) {
 	}
 }
 
+func TestEncodeDecodeTimeDecreasing(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	randTime := func() time.Time {
+		return time.Unix(rng.Int63n(1<<40)-1<<39, rng.Int63n(int64(time.Second)))
+	}
+	for i := 0; i < 1000; i++ {
+		t1, t2 := randTime(), randTime()
+		var e int
+		switch {
+		case t1.Before(t2):
+			e = -1
+		case t1.After(t2):
+			e = 1
+		}
+		if a := bytes.Compare(EncodeTime(nil, t1), EncodeTime(nil, t2)); a != e {
+			t.Fatalf("%s vs %s: expected comparison %d, got %d", t1, t2, e, a)
+		}
+		enc1, enc2 := EncodeTimeDecreasing(nil, t1), EncodeTimeDecreasing(nil, t2)
+		if a := bytes.Compare(enc1, enc2); a != -e {
+			t.Fatalf("%s vs %s: expected decreasing comparison %d, got %d", t1, t2, -e, a)
+		}
+		rest, decoded, err := DecodeTimeDecreasing(append(enc1, enc2...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(t1) || !bytes.Equal(rest, enc2) {
+			t.Fatalf("%s: decoded %s with remainder [% x]", t1, decoded, rest)
+		}
+	}
+}
+
+// TestEncodeCompositeKeyRandom verifies that the concatenations of the
+// encodings of random tuples of values, with a random direction for each
+// column, sort in the lexicographic order of the tuples and decode back to
+// the tuples.
+func TestEncodeCompositeKeyRandom(t *testing.T) {
+	rng, seed := randutil.NewPseudoRand()
+	type tuple struct {
+		i int64
+		s string
+		f float64
+		t time.Time
+	}
+	// Draw the values from small domains so that tuples often share
+	// prefixes.
+	randTuple := func() tuple {
+		return tuple{
+			i: rng.Int63n(5) - 2,
+			s: string(randutil.RandBytes(rng, rng.Intn(3))),
+			f: float64(rng.Intn(5)-2) / 4,
+			t: time.Unix(rng.Int63n(3), rng.Int63n(3)),
+		}
+	}
+	cmpInt := func(a, b int64) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+	compare := func(a, b tuple, desc []bool) int {
+		cmps := []int{
+			cmpInt(a.i, b.i),
+			bytes.Compare([]byte(a.s), []byte(b.s)),
+			cmpInt(int64(a.f*4), int64(b.f*4)),
+			cmpInt(a.t.UnixNano(), b.t.UnixNano()),
+		}
+		for i, c := range cmps {
+			if desc[i] {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+	encode := func(v tuple, desc []bool) []byte {
+		var b []byte
+		if desc[0] {
+			b = EncodeVarintDecreasing(b, v.i)
+		} else {
+			b = EncodeVarint(b, v.i)
+		}
+		if desc[1] {
+			b = EncodeStringDecreasing(b, v.s)
+		} else {
+			b = EncodeString(b, v.s)
+		}
+		if desc[2] {
+			b = EncodeFloatDecreasing(b, v.f)
+		} else {
+			b = EncodeFloat(b, v.f)
+		}
+		if desc[3] {
+			b = EncodeTimeDecreasing(b, v.t)
+		} else {
+			b = EncodeTime(b, v.t)
+		}
+		return b
+	}
+	decode := func(b []byte, desc []bool) (tuple, error) {
+		var v tuple
+		var err error
+		if desc[0] {
+			b, v.i, err = DecodeVarintDecreasing(b)
+		} else {
+			b, v.i, err = DecodeVarint(b)
+		}
+		if err != nil {
+			return v, err
+		}
+		if desc[1] {
+			b, v.s, err = DecodeStringDecreasing(b, nil)
+		} else {
+			b, v.s, err = DecodeString(b, nil)
+		}
+		if err != nil {
+			return v, err
+		}
+		if desc[2] {
+			b, v.f, err = DecodeFloatDecreasing(b, nil)
+		} else {
+			b, v.f, err = DecodeFloat(b, nil)
+		}
+		if err != nil {
+			return v, err
+		}
+		if desc[3] {
+			b, v.t, err = DecodeTimeDecreasing(b)
+		} else {
+			b, v.t, err = DecodeTime(b)
+		}
+		if err != nil {
+			return v, err
+		}
+		if len(b) != 0 {
+			return v, util.Errorf("unexpected remainder %q", b)
+		}
+		return v, nil
+	}
+
+	for i := 0; i < 10000; i++ {
+		desc := make([]bool, 4)
+		for j := range desc {
+			desc[j] = rng.Intn(2) == 0
+		}
+		a, b := randTuple(), randTuple()
+		encA, encB := encode(a, desc), encode(b, desc)
+		if e, c := compare(a, b, desc), bytes.Compare(encA, encB); e != c {
+			t.Fatalf("seed %d: %+v vs %+v (descending %v): expected comparison %d, got %d",
+				seed, a, b, desc, e, c)
+		}
+		decoded, err := decode(encA, desc)
+		if err != nil {
+			t.Fatalf("seed %d: %+v (descending %v): %s", seed, a, desc, err)
+		}
+		if decoded.i != a.i || decoded.s != a.s || decoded.f != a.f || !decoded.t.Equal(a.t) {
+			t.Fatalf("seed %d: %+v (descending %v): decoded %+v", seed, a, desc, decoded)
+		}
+	}
+}
+
 func TestPeekType(t *testing.T) {
 	testCases := []struct {
 		enc []byte
@@ -697,6 +863,41 @@ func TestPeekType(t *testing.T) {
 	}
 }
 
+func TestPeekLength(t *testing.T) {
+	testCases := [][]byte{
+		EncodeNull(nil),
+		EncodeNotNull(nil),
+		EncodeVarint(nil, 0),
+		EncodeVarint(nil, -1000),
+		EncodeUvarint(nil, math.MaxUint64),
+		EncodeFloat(nil, 0),
+		EncodeFloat(nil, math.Inf(-1)),
+		EncodeFloat(nil, -1.5e-20),
+		EncodeFloat(nil, 123.456),
+		EncodeFloat(nil, 1e300),
+		EncodeBytes(nil, []byte("")),
+		EncodeBytes(nil, []byte("a\x00\x01b\xff")),
+		EncodeTime(nil, time.Unix(1234, 5678)),
+	}
+	for i, enc := range testCases {
+		// A trailing byte verifies that only the value itself is measured.
+		for _, complemented := range []bool{false, true} {
+			b := append(append([]byte(nil), enc...), 0x00)
+			if complemented {
+				onesComplement(b)
+			}
+			if n, err := PeekLength(b, complemented); err != nil {
+				t.Errorf("%d (complemented=%t): unexpected error: %s", i, complemented, err)
+			} else if n != len(enc) {
+				t.Errorf("%d (complemented=%t): expected length %d, but found %d", i, complemented, len(enc), n)
+			}
+			if _, err := PeekLength(b[:len(enc)-1], complemented); len(enc) > 1 && err == nil {
+				t.Errorf("%d (complemented=%t): expected error for truncated value", i, complemented)
+			}
+		}
+	}
+}
+
 func BenchmarkEncodeUint32(b *testing.B) {
 	rng, _ := randutil.NewPseudoRand()
 
@@ -139,6 +139,29 @@ func DecodeFloat(buf []byte, tmp []byte) ([]byte, float64, error) {
 	}
 }
 
+// EncodeFloatDecreasing returns the resulting byte slice with the encoded
+// float64 appended to b. The encoded format for a float64 f is the ones
+// complement of EncodeFloat(f), which sorts in decreasing order.
+func EncodeFloatDecreasing(b []byte, f float64) []byte {
+	n := len(b)
+	b = EncodeFloat(b, f)
+	onesComplement(b[n:])
+	return b
+}
+
+// DecodeFloatDecreasing returns the remaining byte slice after decoding and
+// the decoded float64 from buf, which was encoded using
+// EncodeFloatDecreasing.
+func DecodeFloatDecreasing(buf []byte, tmp []byte) ([]byte, float64, error) {
+	t := append(tmp[:0], buf...)
+	onesComplement(t)
+	rest, f, err := DecodeFloat(t, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return buf[len(buf)-len(rest):], f, nil
+}
+
 // floatMandE computes and returns the mantissa M and exponent E for f.
 //
 // The mantissa is a base-100 representation of the value. The exponent E
@@ -158,6 +158,56 @@ func TestEncodeFloat(t *testing.T) {
 	}
 }
 
+func TestEncodeFloatRandom(t *testing.T) {
+	rng, _ := randutil.NewPseudoRand()
+	randFloat := func() float64 {
+		switch rng.Intn(4) {
+		case 0:
+			return math.Float64frombits(rng.Uint64())
+		case 1:
+			return float64(rng.Int63n(20000) - 10000)
+		default:
+			return rng.NormFloat64() * math.Pow(10, float64(rng.Intn(40)-20))
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		f1, f2 := randFloat(), randFloat()
+		if math.IsNaN(f1) || math.IsNaN(f2) {
+			continue
+		}
+		var e int
+		switch {
+		case f1 < f2:
+			e = -1
+		case f1 > f2:
+			e = 1
+		}
+		enc1, enc2 := EncodeFloat(nil, f1), EncodeFloat(nil, f2)
+		if a := bytes.Compare(enc1, enc2); a != e {
+			t.Fatalf("%v vs %v: expected comparison %d, got %d", f1, f2, e, a)
+		}
+		dec1, dec2 := EncodeFloatDecreasing(nil, f1), EncodeFloatDecreasing(nil, f2)
+		if a := bytes.Compare(dec1, dec2); a != -e {
+			t.Fatalf("%v vs %v: expected decreasing comparison %d, got %d", f1, f2, -e, a)
+		}
+
+		rest, f, err := DecodeFloat(append(enc1, enc2...), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f != f1 || !bytes.Equal(rest, enc2) {
+			t.Fatalf("%v: decoded %v with remainder [% x]", f1, f, rest)
+		}
+		rest, f, err = DecodeFloatDecreasing(append(dec1, dec2...), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f != f1 || !bytes.Equal(rest, dec2) {
+			t.Fatalf("%v: decoded decreasing %v with remainder [% x]", f1, f, rest)
+		}
+	}
+}
+
 func BenchmarkEncodeFloat(b *testing.B) {
 	rng, _ := randutil.NewPseudoRand()
 
@@ -0,0 +1,189 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package metric provides simple, lock-free counters and gauges as well as
+// windowed histograms which can be grouped into a Registry and periodically
+// sampled, for example by the time series recorder.
+package metric
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// A Counter holds a monotonically increasing int64 value.
+type Counter struct {
+	count int64
+}
+
+// Inc increments the counter by the given amount.
+func (c *Counter) Inc(delta int64) {
+	atomic.AddInt64(&c.count, delta)
+}
+
+// Count returns the current value of the counter.
+func (c *Counter) Count() int64 {
+	return atomic.LoadInt64(&c.count)
+}
+
+// A Gauge holds a single int64 value which may be arbitrarily updated.
+type Gauge struct {
+	value int64
+}
+
+// Update sets the gauge's value.
+func (g *Gauge) Update(v int64) {
+	atomic.StoreInt64(&g.value, v)
+}
+
+// Value returns the gauge's current value.
+func (g *Gauge) Value() int64 {
+	return atomic.LoadInt64(&g.value)
+}
+
+// histogramWindow is the number of most recent samples retained by a
+// Histogram.
+const histogramWindow = 1024
+
+// A Histogram holds the most recent int64 samples of a distribution, such
+// as latencies in nanoseconds, and computes quantiles over them.
+type Histogram struct {
+	mu      sync.Mutex
+	samples []int64
+	next    int // index of the oldest sample once the window is full
+}
+
+// RecordValue adds a sample, evicting the oldest one if the window is full.
+func (h *Histogram) RecordValue(v int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.samples) < histogramWindow {
+		h.samples = append(h.samples, v)
+		return
+	}
+	h.samples[h.next] = v
+	h.next = (h.next + 1) % histogramWindow
+}
+
+// Quantile returns the smallest retained sample which is greater than or
+// equal to the given fraction of all retained samples, or zero if there
+// are none.
+func (h *Histogram) Quantile(q float64) int64 {
+	h.mu.Lock()
+	sorted := append([]int64(nil), h.samples...)
+	h.mu.Unlock()
+	if len(sorted) == 0 {
+		return 0
+	}
+	sort.Sort(int64Slice(sorted))
+	idx := int(q * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+type int64Slice []int64
+
+func (s int64Slice) Len() int           { return len(s) }
+func (s int64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int64Slice) Less(i, j int) bool { return s[i] < s[j] }
+
+// A Registry is a named collection of counters, gauges and histograms.
+// Metrics are created on first access and live for the lifetime of the
+// registry.
+type Registry struct {
+	sync.Mutex
+	counters   map[string]*Counter
+	gauges     map[string]*Gauge
+	histograms map[string]*Histogram
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		counters:   map[string]*Counter{},
+		gauges:     map[string]*Gauge{},
+		histograms: map[string]*Histogram{},
+	}
+}
+
+// Counter returns the counter registered under the given name, creating it
+// if necessary.
+func (r *Registry) Counter(name string) *Counter {
+	r.Lock()
+	defer r.Unlock()
+	c, ok := r.counters[name]
+	if !ok {
+		c = &Counter{}
+		r.counters[name] = c
+	}
+	return c
+}
+
+// Gauge returns the gauge registered under the given name, creating it if
+// necessary.
+func (r *Registry) Gauge(name string) *Gauge {
+	r.Lock()
+	defer r.Unlock()
+	g, ok := r.gauges[name]
+	if !ok {
+		g = &Gauge{}
+		r.gauges[name] = g
+	}
+	return g
+}
+
+// Histogram returns the histogram registered under the given name, creating
+// it if necessary.
+func (r *Registry) Histogram(name string) *Histogram {
+	r.Lock()
+	defer r.Unlock()
+	h, ok := r.histograms[name]
+	if !ok {
+		h = &Histogram{}
+		r.histograms[name] = h
+	}
+	return h
+}
+
+// Each calls the supplied function with the name and current value of every
+// metric in the registry, in name order. Histograms are reported through
+// their 50th and 99th percentiles, suffixed ".p50" and ".p99" respectively.
+func (r *Registry) Each(f func(name string, value int64)) {
+	r.Lock()
+	values := make(map[string]int64, len(r.counters)+len(r.gauges)+2*len(r.histograms))
+	for name, c := range r.counters {
+		values[name] = c.Count()
+	}
+	for name, g := range r.gauges {
+		values[name] = g.Value()
+	}
+	for name, h := range r.histograms {
+		values[name+".p50"] = h.Quantile(0.5)
+		values[name+".p99"] = h.Quantile(0.99)
+	}
+	r.Unlock()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f(name, values[name])
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package metric
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("b").Inc(2)
+	r.Counter("b").Inc(3)
+	r.Gauge("a").Update(7)
+	r.Gauge("a").Update(4)
+
+	if c := r.Counter("b").Count(); c != 5 {
+		t.Errorf("expected counter value 5, got %d", c)
+	}
+
+	var names []string
+	var values []int64
+	r.Each(func(name string, value int64) {
+		names = append(names, name)
+		values = append(values, value)
+	})
+	if e := []string{"a", "b"}; !reflect.DeepEqual(names, e) {
+		t.Errorf("expected names %v, got %v", e, names)
+	}
+	if e := []int64{4, 5}; !reflect.DeepEqual(values, e) {
+		t.Errorf("expected values %v, got %v", e, values)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	h := &Histogram{}
+	if q := h.Quantile(0.5); q != 0 {
+		t.Errorf("expected empty histogram to report 0, got %d", q)
+	}
+	for i := int64(1); i <= 100; i++ {
+		h.RecordValue(i)
+	}
+	if q := h.Quantile(0.5); q != 51 {
+		t.Errorf("expected p50 of 51, got %d", q)
+	}
+	if q := h.Quantile(0.99); q != 100 {
+		t.Errorf("expected p99 of 100, got %d", q)
+	}
+
+	// Once the window is full, the oldest samples are evicted.
+	for i := 0; i < histogramWindow; i++ {
+		h.RecordValue(1000)
+	}
+	if q := h.Quantile(0); q != 1000 {
+		t.Errorf("expected old samples to be evicted, got minimum %d", q)
+	}
+
+	r := NewRegistry()
+	r.Histogram("h").RecordValue(5)
+	var names []string
+	r.Each(func(name string, _ int64) {
+		names = append(names, name)
+	})
+	if e := []string{"h.p50", "h.p99"}; !reflect.DeepEqual(names, e) {
+		t.Errorf("expected names %v, got %v", e, names)
+	}
+}
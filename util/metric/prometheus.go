@@ -0,0 +1,148 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package metric
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// A Label is a name/value pair which identifies the origin of exported
+// metrics, for instance the store they belong to.
+type Label struct {
+	Name, Value string
+}
+
+// A LabeledRegistry is a Registry along with the labels attached to each
+// of its metrics when they are exported.
+type LabeledRegistry struct {
+	Registry *Registry
+	Labels   []Label
+}
+
+// prometheusPrefix is prepended to the names of all exported metrics.
+const prometheusPrefix = "cockroach_"
+
+// prometheusQuantiles are the quantiles at which histograms are exported.
+var prometheusQuantiles = []struct {
+	label string
+	q     float64
+}{
+	{"0.5", 0.5},
+	{"0.99", 0.99},
+}
+
+type prometheusSample struct {
+	labels []Label
+	value  int64
+}
+
+type prometheusFamily struct {
+	kind    string // counter, gauge or summary
+	samples []prometheusSample
+}
+
+// WritePrometheus writes the metrics of the supplied registries to w in the
+// Prometheus text exposition format. Metric names are prefixed with
+// "cockroach_", and characters which may not appear in Prometheus metric
+// names are replaced by underscores. Histograms are exported as summaries
+// of their 50th and 99th percentiles. Metrics of the same name in several
+// registries are exported as one family, told apart by the labels of their
+// registries.
+func WritePrometheus(w io.Writer, registries []LabeledRegistry) error {
+	families := map[string]*prometheusFamily{}
+	add := func(name, kind string, labels []Label, value int64) {
+		name = prometheusName(name)
+		f, ok := families[name]
+		if !ok {
+			f = &prometheusFamily{kind: kind}
+			families[name] = f
+		} else if f.kind != kind {
+			// Prometheus requires all metrics of a family to have the
+			// same type; drop conflicting ones.
+			return
+		}
+		f.samples = append(f.samples, prometheusSample{labels: labels, value: value})
+	}
+
+	for _, lr := range registries {
+		r := lr.Registry
+		r.Lock()
+		for name, c := range r.counters {
+			add(name, "counter", lr.Labels, c.Count())
+		}
+		for name, g := range r.gauges {
+			add(name, "gauge", lr.Labels, g.Value())
+		}
+		for name, h := range r.histograms {
+			for _, q := range prometheusQuantiles {
+				labels := append(append([]Label(nil), lr.Labels...), Label{"quantile", q.label})
+				add(name, "summary", labels, h.Quantile(q.q))
+			}
+		}
+		r.Unlock()
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		f := families[name]
+		fmt.Fprintf(bw, "# TYPE %s %s\n", name, f.kind)
+		for _, s := range f.samples {
+			bw.WriteString(name)
+			writePrometheusLabels(bw, s.labels)
+			fmt.Fprintf(bw, " %d\n", s.value)
+		}
+	}
+	return bw.Flush()
+}
+
+// prometheusName returns the Prometheus metric name for the given metric,
+// replacing all characters other than letters, digits, underscores and
+// colons with underscores.
+func prometheusName(name string) string {
+	return prometheusPrefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writePrometheusLabels(w *bufio.Writer, labels []Label) {
+	if len(labels) == 0 {
+		return
+	}
+	w.WriteByte('{')
+	for i, l := range labels {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		fmt.Fprintf(w, "%s=\"%s\"", l.Name, prometheusLabelEscaper.Replace(l.Value))
+	}
+	w.WriteByte('}')
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package metric
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	r1 := NewRegistry()
+	r1.Counter("raft.proposals").Inc(3)
+	r1.Gauge("ranges.replication-pending").Update(1)
+	r1.Histogram("requests.latency").RecordValue(10)
+	r2 := NewRegistry()
+	r2.Counter("raft.proposals").Inc(5)
+	// Conflicts in type with the counter of the same name and is dropped.
+	r2.Gauge("raft.proposals").Update(9)
+
+	var buf bytes.Buffer
+	if err := WritePrometheus(&buf, []LabeledRegistry{
+		{Registry: r1, Labels: []Label{{"node", "1"}, {"store", "1"}}},
+		{Registry: r2, Labels: []Label{{"node", "1"}, {"store", "2"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# TYPE cockroach_raft_proposals counter
+cockroach_raft_proposals{node="1",store="1"} 3
+cockroach_raft_proposals{node="1",store="2"} 5
+# TYPE cockroach_ranges_replication_pending gauge
+cockroach_ranges_replication_pending{node="1",store="1"} 1
+# TYPE cockroach_requests_latency summary
+cockroach_requests_latency{node="1",store="1",quantile="0.5"} 10
+cockroach_requests_latency{node="1",store="1",quantile="0.99"} 10
+`
+	if a := buf.String(); a != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, a)
+	}
+}
+
+func TestPrometheusName(t *testing.T) {
+	testCases := []struct {
+		name, expected string
+	}{
+		{"raft.proposals", "cockroach_raft_proposals"},
+		{"capacity.nearly-full", "cockroach_capacity_nearly_full"},
+		{"client.txn.commit.count", "cockroach_client_txn_commit_count"},
+		{"a:b_C9", "cockroach_a:b_C9"},
+	}
+	for i, test := range testCases {
+		if a := prometheusName(test.name); a != test.expected {
+			t.Errorf("%d: expected %q; got %q", i, test.expected, a)
+		}
+	}
+}
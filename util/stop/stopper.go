@@ -58,6 +58,7 @@ func (f CloserFn) Close() {
 // stopper has stopped.
 type Stopper struct {
 	stopper  chan struct{}  // Closed when stopping
+	drainer  chan struct{}  // Closed when draining
 	stopped  chan struct{}  // Closed when stopped completely
 	stop     sync.WaitGroup // Incremented for outstanding workers
 	mu       sync.Mutex     // Protects the fields below
@@ -72,6 +73,7 @@ type Stopper struct {
 func NewStopper() *Stopper {
 	s := &Stopper{
 		stopper: make(chan struct{}),
+		drainer: make(chan struct{}),
 		stopped: make(chan struct{}),
 		tasks:   map[string]int{},
 	}
@@ -215,6 +217,16 @@ func (s *Stopper) ShouldStop() <-chan struct{} {
 	return s.stopper
 }
 
+// ShouldDrain returns a channel which will be closed when Stop() or
+// Quiesce() has been invoked, before outstanding tasks have drained. Tasks
+// which may wait indefinitely use it to return early.
+func (s *Stopper) ShouldDrain() <-chan struct{} {
+	if s == nil {
+		return nil
+	}
+	return s.drainer
+}
+
 // IsStopped returns a channel which will be closed after Stop() has
 // been invoked to full completion, meaning all workers have completed
 // and all closers have been closed.
@@ -230,7 +242,10 @@ func (s *Stopper) IsStopped() <-chan struct{} {
 func (s *Stopper) Quiesce() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.draining = true
+	if !s.draining {
+		s.draining = true
+		close(s.drainer)
+	}
 	for s.numTasks > 0 {
 		// Use stdlib "log" instead of "cockroach/util/log" due to import cycles.
 		log.Print("draining; tasks left:\n", s.runningTasksLocked())
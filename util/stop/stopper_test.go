@@ -222,6 +222,36 @@ func (tc *testCloser) Close() {
 	*tc = true
 }
 
+// TestStopperShouldDrain verifies that a task waiting for the stopper to
+// drain is released by Stop(), which then completes.
+func TestStopperShouldDrain(t *testing.T) {
+	s := stop.NewStopper()
+	running := make(chan struct{})
+	if !s.RunAsyncTask(func() {
+		close(running)
+		<-s.ShouldDrain()
+	}) {
+		t.Fatal("expected RunAsyncTask to succeed")
+	}
+	<-running
+	select {
+	case <-s.ShouldDrain():
+		t.Fatal("expected the stopper not to drain before Stop()")
+	default:
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(100 * time.Millisecond):
+		t.Errorf("timed out waiting for stop")
+	}
+}
+
 func TestStopperClosers(t *testing.T) {
 	s := stop.NewStopper()
 	var tc1, tc2 testCloser